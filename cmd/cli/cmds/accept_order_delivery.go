@@ -14,7 +14,7 @@ func acceptDeliveryCmd(pvzOrderUseCase abstractions.IPVZOrderUseCase) *cobra.Com
 		Use:     "accept_delivery",
 		Short:   "Accept delivery",
 		Args:    cobra.ExactArgs(6),
-		Example: "hw1 accept_delivery <order_id> <recipient_id> <storage_time: 1h30m> <cost> <weight> <packaging>",
+		Example: "hw1 accept_delivery <order_id> <recipient_id> <storage_time: 1h30m> <cost: 2000 or 2000USD> <weight> <packaging> --dimensions 30x20x10 --measured_weight 1200 --override_reason \"scales checked twice\"",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			orderID := args[0]
 
@@ -29,7 +29,7 @@ func acceptDeliveryCmd(pvzOrderUseCase abstractions.IPVZOrderUseCase) *cobra.Com
 				return fmt.Errorf("storageTime is negative")
 			}
 
-			cost, err := strconv.Atoi(args[3])
+			cost, err := domain.ParseMoney(args[3])
 			if err != nil {
 				return err
 			}
@@ -46,27 +46,55 @@ func acceptDeliveryCmd(pvzOrderUseCase abstractions.IPVZOrderUseCase) *cobra.Com
 
 			additionalFilm, _ := cmd.Flags().GetBool("additional_film")
 
-			err = pvzOrderUseCase.AcceptOrderDelivery(
+			var dimensions domain.Dimensions
+			if value, _ := cmd.Flags().GetString("dimensions"); value != "" {
+				dimensions, err = domain.ParseDimensions(value)
+				if err != nil {
+					return err
+				}
+			}
+
+			var opts []abstractions.AcceptOptFunc
+			if measuredWeight, _ := cmd.Flags().GetInt("measured_weight"); measuredWeight != 0 {
+				opts = append(opts, abstractions.WithMeasuredWeight(measuredWeight))
+			}
+
+			if overrideReason, _ := cmd.Flags().GetString("override_reason"); overrideReason != "" {
+				opts = append(opts, abstractions.WithWeightOverrideReason(overrideReason))
+			}
+
+			order, err := pvzOrderUseCase.AcceptOrderDelivery(
 				cmd.Context(),
 				orderID,
 				recipientID,
 				storageTime,
 				cost,
 				weight,
+				dimensions,
 				packaging,
 				additionalFilm,
+				opts...,
 			)
 			if err != nil {
 				return err
 			}
 
 			cmd.Println("Delivery accepted")
+			cmd.Println("Cost:", order.Cost.String())
+			cmd.Println("Received at:", order.ReceivedAt.Format(time.RFC3339))
+			cmd.Println("Expires at:", order.ExpiresAt().Format(time.RFC3339))
+			if order.WeightDiscrepancy {
+				cmd.Println("Weight discrepancy:", order.MeasuredWeight, "measured,", order.Weight, "declared")
+			}
 
 			return nil
 		},
 	}
 
 	command.Flags().Bool("additional_film", false, "additional film")
+	command.Flags().String("dimensions", "", "parcel dimensions in centimetres, e.g. 30x20x10")
+	command.Flags().Int("measured_weight", 0, "weight of the parcel on the scales")
+	command.Flags().String("override_reason", "", "reason to accept the parcel whose measured weight differs from the declared one")
 
 	return command
 }
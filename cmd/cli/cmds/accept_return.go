@@ -3,6 +3,7 @@ package cmds
 import (
 	"github.com/spf13/cobra"
 	"homework/internal/abstractions"
+	"homework/internal/domain"
 )
 
 func acceptReturnCmd(pvzOrderUseCase abstractions.IPVZOrderUseCase) *cobra.Command {
@@ -10,12 +11,27 @@ func acceptReturnCmd(pvzOrderUseCase abstractions.IPVZOrderUseCase) *cobra.Comma
 		Use:     "accept_return",
 		Short:   "Accept return",
 		Args:    cobra.ExactArgs(2),
-		Example: "hw1 accept_return <recipient_id> <order_id>",
+		Example: "hw1 accept_return <recipient_id> <order_id> --reason defect --inspection damaged --comment \"broken screen\"",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			recipientID := args[0]
 			orderID := args[1]
 
-			err := pvzOrderUseCase.AcceptReturn(cmd.Context(), recipientID, orderID)
+			reason, _ := cmd.Flags().GetString("reason")
+			inspection, _ := cmd.Flags().GetString("inspection")
+			comment, _ := cmd.Flags().GetString("comment")
+
+			details, err := domain.NewReturnDetails(reason, inspection, comment)
+			if err != nil {
+				return err
+			}
+
+			var options []abstractions.MutationOptFunc
+			if cmd.Flags().Changed("expected-version") {
+				version, _ := cmd.Flags().GetInt64("expected-version")
+				options = append(options, abstractions.WithExpectedVersion(version))
+			}
+
+			err = pvzOrderUseCase.AcceptReturn(cmd.Context(), recipientID, orderID, details, options...)
 			if err != nil {
 				return err
 			}
@@ -26,5 +42,10 @@ func acceptReturnCmd(pvzOrderUseCase abstractions.IPVZOrderUseCase) *cobra.Comma
 		},
 	}
 
+	command.Flags().String("reason", "", "return reason (defect, wrong_item, not_as_described, changed_mind, other)")
+	command.Flags().String("inspection", "", "inspection outcome (resellable, damaged, incomplete)")
+	command.Flags().String("comment", "", "comment on the return, required for the other reason")
+	command.Flags().Int64("expected-version", 0, "fail if the order has been changed since this version")
+
 	return command
 }
@@ -0,0 +1,27 @@
+package cmds
+
+import (
+	"github.com/spf13/cobra"
+	"homework/internal/abstractions"
+)
+
+func createReturnShipmentCmd(returnShipmentUseCase abstractions.IReturnShipmentUseCase) *cobra.Command {
+	command := &cobra.Command{
+		Use:     "create_return_shipment",
+		Short:   "Group the returned and refused orders into a shipment back to the seller",
+		Args:    cobra.MinimumNArgs(1),
+		Example: "hw1 create_return_shipment [order_id] [order_id...]",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			shipment, err := returnShipmentUseCase.CreateReturnShipment(cmd.Context(), args)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println("Return shipment", shipment.ID, "created with", len(shipment.OrderIDs), "orders")
+
+			return nil
+		},
+	}
+
+	return command
+}
@@ -0,0 +1,31 @@
+package cmds
+
+import (
+	"github.com/spf13/cobra"
+	"homework/internal/abstractions"
+	"time"
+)
+
+func dispatchReturnShipmentCmd(returnShipmentUseCase abstractions.IReturnShipmentUseCase) *cobra.Command {
+	command := &cobra.Command{
+		Use:     "dispatch_return_shipment",
+		Short:   "Hand the return shipment over to the courier and print its manifest",
+		Args:    cobra.ExactArgs(2),
+		Example: "hw1 dispatch_return_shipment [shipment_id] [courier_id]",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifest, err := returnShipmentUseCase.DispatchReturnShipment(cmd.Context(), args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			cmd.Println("Return shipment", manifest.ShipmentID, "dispatched with courier", manifest.CourierID, "at", manifest.DispatchedAt.Format(time.DateTime)+":")
+			for _, item := range manifest.Items {
+				cmd.Println(item.OrderID, "status:", item.Status, "cost:", item.Cost, "weight:", item.Weight, "reason:", item.ReturnDetails.Reason)
+			}
+
+			return nil
+		},
+	}
+
+	return command
+}
@@ -0,0 +1,47 @@
+package cmds
+
+import (
+	"fmt"
+	"github.com/spf13/cobra"
+	"homework/internal/abstractions"
+	"homework/internal/domain"
+	"time"
+)
+
+func extendStorageCmd(pvzOrderUseCase abstractions.IPVZOrderUseCase) *cobra.Command {
+	command := &cobra.Command{
+		Use:     "extend_storage",
+		Short:   "Extend storage time of the order",
+		Args:    cobra.ExactArgs(3),
+		Example: "hw1 extend_storage <order_id> <extension: 24h> <operator_id>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orderID := args[0]
+
+			extension, err := time.ParseDuration(args[1])
+			if err != nil {
+				return fmt.Errorf("%w: invalid extension: %v", domain.ErrInvalidArgument, err)
+			}
+
+			operatorID := args[2]
+
+			var options []abstractions.MutationOptFunc
+			if cmd.Flags().Changed("expected-version") {
+				version, _ := cmd.Flags().GetInt64("expected-version")
+				options = append(options, abstractions.WithExpectedVersion(version))
+			}
+
+			err = pvzOrderUseCase.ExtendStorage(cmd.Context(), orderID, extension, operatorID, options...)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println("Storage extended")
+
+			return nil
+		},
+	}
+
+	command.Flags().Int64("expected-version", 0, "fail if the order has been changed since this version")
+
+	return command
+}
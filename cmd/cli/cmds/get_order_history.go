@@ -0,0 +1,33 @@
+package cmds
+
+import (
+	"github.com/spf13/cobra"
+	"homework/internal/abstractions"
+	"time"
+)
+
+func getOrderHistoryCmd(pvzOrderUseCase abstractions.IPVZOrderUseCase) *cobra.Command {
+	command := &cobra.Command{
+		Use:     "get_order_history",
+		Short:   "Get order history",
+		Args:    cobra.ExactArgs(1),
+		Example: "hw1 get_order_history <order_id>",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			orderID := args[0]
+
+			events, err := pvzOrderUseCase.GetOrderHistory(cmd.Context(), orderID)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println("History:")
+			for _, event := range events {
+				cmd.Println(event.CreatedAt.Format(time.RFC3339), event.EventType, event.Payload)
+			}
+
+			return nil
+		},
+	}
+
+	return command
+}
@@ -3,6 +3,7 @@ package cmds
 import (
 	"github.com/spf13/cobra"
 	"homework/internal/abstractions"
+	"homework/internal/domain"
 )
 
 func getOrdersCmd(pvzOrderUseCase abstractions.IPVZOrderUseCase) *cobra.Command {
@@ -20,7 +21,7 @@ func getOrdersCmd(pvzOrderUseCase abstractions.IPVZOrderUseCase) *cobra.Command
 			}
 
 			if samePVZ, _ := cmd.Flags().GetBool("samePVZ"); samePVZ {
-				opts = append(opts, abstractions.WithPVZID(cmd.Flag("pvz").Value.String()))
+				opts = append(opts, abstractions.WithSamePVZ())
 			}
 
 			if cursorID, _ := cmd.Flags().GetString("cursorID"); cursorID != "" {
@@ -31,6 +32,22 @@ func getOrdersCmd(pvzOrderUseCase abstractions.IPVZOrderUseCase) *cobra.Command
 				opts = append(opts, abstractions.WithLimit(limit))
 			}
 
+			if rawStatuses, _ := cmd.Flags().GetStringSlice("status"); len(rawStatuses) > 0 {
+				statuses := make([]domain.OrderStatus, 0, len(rawStatuses))
+				for _, rawStatus := range rawStatuses {
+					status, err := domain.NewOrderStatus(rawStatus)
+					if err != nil {
+						return err
+					}
+					statuses = append(statuses, status)
+				}
+				opts = append(opts, abstractions.WithStatuses(statuses...))
+			}
+
+			if weightDiscrepancy, _ := cmd.Flags().GetBool("weightDiscrepancy"); weightDiscrepancy {
+				opts = append(opts, abstractions.WithWeightDiscrepancy())
+			}
+
 			data, err := pvzOrderUseCase.GetOrders(cmd.Context(), userID, opts...)
 			if err != nil {
 				return err
@@ -49,6 +66,8 @@ func getOrdersCmd(pvzOrderUseCase abstractions.IPVZOrderUseCase) *cobra.Command
 	command.Flags().Bool("samePVZ", false, "same PVZ")
 	command.Flags().String("cursorID", "", "cursor ID")
 	command.Flags().Int("limit", 10, "limit")
+	command.Flags().StringSlice("status", nil, "statuses to filter by (accepted, issued, returned_by_client, returned_to_courier, expired, refused, dispatched_to_seller)")
+	command.Flags().Bool("weightDiscrepancy", false, "only orders accepted with the weight discrepancy")
 
 	return command
 }
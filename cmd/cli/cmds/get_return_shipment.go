@@ -0,0 +1,30 @@
+package cmds
+
+import (
+	"github.com/spf13/cobra"
+	"homework/internal/abstractions"
+)
+
+func getReturnShipmentCmd(returnShipmentUseCase abstractions.IReturnShipmentUseCase) *cobra.Command {
+	command := &cobra.Command{
+		Use:     "get_return_shipment",
+		Short:   "Get the return shipment with its orders",
+		Args:    cobra.ExactArgs(1),
+		Example: "hw1 get_return_shipment [shipment_id]",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			shipment, err := returnShipmentUseCase.GetReturnShipment(cmd.Context(), args[0])
+			if err != nil {
+				return err
+			}
+
+			cmd.Println("Return shipment", shipment.ID, "status:", shipment.Status, "orders:", shipment.OrderIDs)
+			if shipment.CourierID != "" {
+				cmd.Println("Dispatched with courier", shipment.CourierID)
+			}
+
+			return nil
+		},
+	}
+
+	return command
+}
@@ -3,6 +3,7 @@ package cmds
 import (
 	"github.com/spf13/cobra"
 	"homework/internal/abstractions"
+	"homework/internal/domain"
 )
 
 func getReturnsCmd(pvzOrderUseCase abstractions.IPVZOrderUseCase) *cobra.Command {
@@ -10,16 +11,41 @@ func getReturnsCmd(pvzOrderUseCase abstractions.IPVZOrderUseCase) *cobra.Command
 		Use:     "get_returns",
 		Short:   "Get returns",
 		Args:    cobra.NoArgs,
-		Example: "hw1 get_returns",
+		Example: "hw1 get_returns --reason defect,wrong_item --inspection damaged",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			page, _ := cmd.Flags().GetInt("page")
 			pageSize, _ := cmd.Flags().GetInt("pageSize")
 
-			data, err := pvzOrderUseCase.GetReturns(
-				cmd.Context(),
+			opts := []abstractions.PagePaginationOptFunc{
 				abstractions.WithPage(page),
 				abstractions.WithPageSize(pageSize),
-			)
+			}
+
+			if rawReasons, _ := cmd.Flags().GetStringSlice("reason"); len(rawReasons) > 0 {
+				reasons := make([]domain.ReturnReason, 0, len(rawReasons))
+				for _, rawReason := range rawReasons {
+					reason, err := domain.NewReturnReason(rawReason)
+					if err != nil {
+						return err
+					}
+					reasons = append(reasons, reason)
+				}
+				opts = append(opts, abstractions.WithReturnReasons(reasons...))
+			}
+
+			if rawOutcomes, _ := cmd.Flags().GetStringSlice("inspection"); len(rawOutcomes) > 0 {
+				outcomes := make([]domain.InspectionOutcome, 0, len(rawOutcomes))
+				for _, rawOutcome := range rawOutcomes {
+					outcome, err := domain.NewInspectionOutcome(rawOutcome)
+					if err != nil {
+						return err
+					}
+					outcomes = append(outcomes, outcome)
+				}
+				opts = append(opts, abstractions.WithInspectionOutcomes(outcomes...))
+			}
+
+			data, err := pvzOrderUseCase.GetReturns(cmd.Context(), opts...)
 			if err != nil {
 				return err
 			}
@@ -35,6 +61,8 @@ func getReturnsCmd(pvzOrderUseCase abstractions.IPVZOrderUseCase) *cobra.Command
 
 	command.Flags().Int("page", 0, "page")
 	command.Flags().Int("pageSize", 10, "page size")
+	command.Flags().StringSlice("reason", nil, "return reasons to filter by (defect, wrong_item, not_as_described, changed_mind, other)")
+	command.Flags().StringSlice("inspection", nil, "inspection outcomes to filter by (resellable, damaged, incomplete)")
 
 	return command
 }
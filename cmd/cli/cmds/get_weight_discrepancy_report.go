@@ -0,0 +1,40 @@
+package cmds
+
+import (
+	"github.com/spf13/cobra"
+	"homework/internal/abstractions"
+	"time"
+)
+
+func getWeightDiscrepancyReportCmd(pvzOrderUseCase abstractions.IPVZOrderUseCase) *cobra.Command {
+	command := &cobra.Command{
+		Use:     "get_weight_discrepancy_report",
+		Short:   "Get orders accepted with the weight discrepancy on the date",
+		Args:    cobra.MaximumNArgs(1),
+		Example: "hw1 get_weight_discrepancy_report [date: 2024-10-01, today by default]",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var date time.Time
+			if len(args) > 0 {
+				var err error
+				date, err = time.Parse(time.DateOnly, args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			report, err := pvzOrderUseCase.GetWeightDiscrepancyReport(cmd.Context(), date)
+			if err != nil {
+				return err
+			}
+
+			cmd.Println("Weight discrepancies on", report.Date.Format(time.DateOnly)+":")
+			for _, order := range report.Orders {
+				cmd.Println(order.OrderID, "declared:", order.Weight, "measured:", order.MeasuredWeight, "reason:", order.WeightOverrideReason)
+			}
+
+			return nil
+		},
+	}
+
+	return command
+}
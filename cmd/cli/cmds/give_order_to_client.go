@@ -3,21 +3,39 @@ package cmds
 import (
 	"github.com/spf13/cobra"
 	"homework/internal/abstractions"
+	"homework/internal/domain"
 )
 
 func giveOrderToClientCmd(pvzOrderUseCase abstractions.IPVZOrderUseCase) *cobra.Command {
 	command := &cobra.Command{
 		Use:     "give_orders",
 		Short:   "Give orders to client",
+		Long:    "Give orders to client. The pickup code follows the order as <order_id>#<pickup_code>, an order can be refused by the client with <order_id>#<pickup_code>:<refusal reason>. The storage fee of the order in the paid storage follows the pickup code as <order_id>#<pickup_code>+<storage_fee>",
 		Args:    cobra.MinimumNArgs(1),
-		Example: "hw1 give_orders <order_id1> <order_id2> ...",
+		Example: "hw1 give_orders <order_id1>#<pickup_code1> <order_id2>#<pickup_code2> \"<order_id3>#<pickup_code3>:<refusal reason>\" <order_id4>#<pickup_code4>+<storage_fee4> ...",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			err := pvzOrderUseCase.GiveOrderToClient(cmd.Context(), args)
+			decisions := make([]domain.IssueDecision, len(args))
+			for i, arg := range args {
+				decision, err := domain.ParseIssueDecision(arg)
+				if err != nil {
+					return err
+				}
+				decisions[i] = decision
+			}
+
+			results, err := pvzOrderUseCase.GiveOrderToClient(cmd.Context(), decisions)
 			if err != nil {
 				return err
 			}
 
-			cmd.Println("Orders given to client")
+			cmd.Println("Results:")
+			for _, result := range results {
+				if result.Err != nil {
+					cmd.Println(result.OrderID, result.Action, "failed:", result.Err)
+					continue
+				}
+				cmd.Println(result.OrderID, result.Action, "ok")
+			}
 
 			return nil
 		},
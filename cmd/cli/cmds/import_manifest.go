@@ -0,0 +1,210 @@
+package cmds
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/spf13/cobra"
+	"homework/internal/abstractions"
+	"homework/internal/domain"
+	"homework/internal/usecases"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+func importManifestCmd(pvzOrderUseCase abstractions.IPVZOrderUseCase) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "import-manifest <file>",
+		Short: "Import courier manifest",
+		Long: "Import the electronic manifest of the courier from a CSV or JSON file. Every parcel is checked against the packaging rules first, " +
+			"the valid ones are accepted after the confirmation and the rest are written to the error report. " +
+			"CSV manifest has a header with the columns " + strings.Join(manifestColumns, ",") + ", JSON manifest is an array of objects with the same keys",
+		Args:    cobra.ExactArgs(1),
+		Example: "hw1 import-manifest manifest.csv --report manifest.errors.csv",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path := args[0]
+
+			format := manifestFormat(strings.ToLower(stringFlag(cmd, "format")))
+			if format == "" {
+				var err error
+				format, err = detectManifestFormat(path)
+				if err != nil {
+					return err
+				}
+			}
+
+			file, err := os.Open(path)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			lines, err := parseManifest(file, format)
+			if err != nil {
+				return err
+			}
+
+			if len(lines) == 0 {
+				return fmt.Errorf("manifest %s has no parcels", path)
+			}
+
+			dryRunManifest(cmd, pvzOrderUseCase, lines)
+
+			valid := countValidLines(lines)
+			cmd.Printf("Valid: %d, invalid: %d\n", valid, len(lines)-valid)
+			for _, line := range lines {
+				if line.Err != nil {
+					cmd.Printf("Line %d (%s): %s\n", line.Number, line.Item.OrderID, line.Err)
+				}
+			}
+
+			if valid > 0 {
+				yes, _ := cmd.Flags().GetBool("yes")
+				if !yes && !confirm(cmd, fmt.Sprintf("Accept %d orders? [y/N] ", valid)) {
+					cmd.Println("Import cancelled")
+					return nil
+				}
+
+				if err := acceptManifest(cmd, pvzOrderUseCase, lines); err != nil {
+					return err
+				}
+
+				accepted := countValidLines(lines)
+				cmd.Printf("Accepted: %d, not accepted: %d\n", accepted, len(lines)-accepted)
+			}
+
+			if countValidLines(lines) == len(lines) {
+				return nil
+			}
+
+			reportPath := stringFlag(cmd, "report")
+			if reportPath == "" {
+				reportPath = strings.TrimSuffix(path, filepath.Ext(path)) + ".errors.csv"
+			}
+
+			if err := writeManifestReportFile(reportPath, lines); err != nil {
+				return err
+			}
+
+			cmd.Println("Error report:", reportPath)
+
+			return nil
+		},
+	}
+
+	command.Flags().String("format", "", "manifest format (csv or json), detected by the file extension by default")
+	command.Flags().String("report", "", "error report file, <file without extension>.errors.csv by default")
+	command.Flags().BoolP("yes", "y", false, "accept the valid orders without the confirmation")
+
+	return command
+}
+
+func stringFlag(cmd *cobra.Command, name string) string {
+	value, _ := cmd.Flags().GetString(name)
+	return value
+}
+
+// dryRunManifest checks the parcels against the packaging rules without accepting them:
+// the packaging of the parcel must be among the ones which fit it
+func dryRunManifest(cmd *cobra.Command, pvzOrderUseCase abstractions.IPVZOrderUseCase, lines []manifestLine) {
+	seen := make(map[string]int, len(lines))
+
+	for i := range lines {
+		line := &lines[i]
+		if line.Err != nil {
+			continue
+		}
+
+		if number, ok := seen[line.Item.OrderID]; ok {
+			line.Err = fmt.Errorf("%w: order is repeated at line %d", domain.ErrInvalidArgument, number)
+			continue
+		}
+		seen[line.Item.OrderID] = line.Number
+
+		quotes, err := pvzOrderUseCase.QuotePackaging(cmd.Context(), line.Item.Cost, line.Item.Weight, line.Item.Dimensions)
+		if err != nil {
+			line.Err = err
+			continue
+		}
+
+		required := []domain.PackagingType{line.Item.Packaging}
+		if line.Item.AdditionalFilm {
+			required = append(required, domain.PackagingTypeFilm)
+		}
+
+		for _, packaging := range required {
+			fits := slices.ContainsFunc(quotes, func(quote domain.PackagingQuote) bool {
+				return quote.Packaging == packaging
+			})
+			if !fits {
+				line.Err = fmt.Errorf("%w: packaging %s does not fit the parcel", domain.ErrInvalidArgument, packaging)
+				break
+			}
+		}
+	}
+}
+
+// acceptManifest accepts the valid parcels in batches and sets the errors of the ones which were not accepted
+func acceptManifest(cmd *cobra.Command, pvzOrderUseCase abstractions.IPVZOrderUseCase, lines []manifestLine) error {
+	var valid []*manifestLine
+	for i := range lines {
+		if lines[i].Err == nil {
+			valid = append(valid, &lines[i])
+		}
+	}
+
+	for batch := range slices.Chunk(valid, usecases.MaxDeliveryBatchSize) {
+		items := make([]domain.DeliveryItem, len(batch))
+		for i, line := range batch {
+			items[i] = line.Item
+		}
+
+		results, err := pvzOrderUseCase.BatchAcceptOrderDelivery(cmd.Context(), items)
+		if err != nil {
+			return err
+		}
+
+		for i, result := range results {
+			batch[i].Err = result.Err
+		}
+	}
+
+	return nil
+}
+
+func countValidLines(lines []manifestLine) int {
+	var valid int
+	for _, line := range lines {
+		if line.Err == nil {
+			valid++
+		}
+	}
+	return valid
+}
+
+func confirm(cmd *cobra.Command, prompt string) bool {
+	cmd.Print(prompt)
+
+	answer, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if err != nil && answer == "" {
+		return false
+	}
+
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+func writeManifestReportFile(path string, lines []manifestLine) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	if err := writeManifestReport(file, lines); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
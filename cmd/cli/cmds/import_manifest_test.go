@@ -0,0 +1,145 @@
+package cmds
+
+import (
+	"bytes"
+	"context"
+	"homework/internal/abstractions"
+	"homework/internal/abstractions/mocks"
+	"homework/internal/domain"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseManifest(t *testing.T) {
+	t.Parallel()
+
+	want := domain.DeliveryItem{
+		OrderID:        "1",
+		RecipientID:    "recipient",
+		StorageTime:    48 * time.Hour,
+		Cost:           domain.NewMoney(2000, domain.CurrencyUSD),
+		Weight:         1000,
+		Dimensions:     domain.Dimensions{Length: 30, Width: 20, Height: 10},
+		Packaging:      domain.PackagingTypeBox,
+		AdditionalFilm: true,
+	}
+
+	tests := []struct {
+		name     string
+		format   manifestFormat
+		manifest string
+		wantErr  bool
+	}{
+		{
+			name:   "CSV",
+			format: manifestFormatCSV,
+			manifest: "order_id,recipient_id,storage_time,cost,weight,packaging,additional_film,dimensions\n" +
+				"1,recipient,48h,2000 USD,1000,box,true,30x20x10\n" +
+				"2,recipient,48h,2000,heavy,box,,\n",
+		},
+		{
+			name:   "JSON",
+			format: manifestFormatJSON,
+			manifest: `[
+				{"order_id": "1", "recipient_id": "recipient", "storage_time": "48h", "cost": "2000 USD", "weight": 1000, "packaging": "box", "additional_film": true, "dimensions": "30x20x10"},
+				{"order_id": "2", "recipient_id": "recipient", "storage_time": "48h", "cost": "2000", "weight": "heavy", "packaging": "box", "dimensions": null}
+			]`,
+		},
+		{
+			name:     "CSV without required column",
+			format:   manifestFormatCSV,
+			manifest: "order_id,recipient_id,storage_time,cost,weight\n1,recipient,48h,2000,1000\n",
+			wantErr:  true,
+		},
+		{
+			name:     "JSON object",
+			format:   manifestFormatJSON,
+			manifest: `{"order_id": "1"}`,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			lines, err := parseManifest(strings.NewReader(tt.manifest), tt.format)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			if !assert.NoError(t, err) || !assert.Len(t, lines, 2) {
+				return
+			}
+
+			assert.NoError(t, lines[0].Err)
+			assert.Equal(t, want, lines[0].Item)
+
+			assert.ErrorIs(t, lines[1].Err, domain.ErrInvalidArgument)
+			assert.Equal(t, "2", lines[1].Item.OrderID)
+		})
+	}
+}
+
+func TestImportManifestCmd(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "manifest.csv")
+	manifest := "order_id,recipient_id,storage_time,cost,weight,packaging\n" +
+		"1,recipient,48h,2000,1000,box\n" +
+		"2,recipient,48h,2000,100000,bag\n" +
+		"3,recipient,48h,2000,1000,box\n" +
+		"1,recipient,48h,2000,1000,box\n" +
+		"4,recipient,48h,2000,1000,unknown\n"
+	if err := os.WriteFile(path, []byte(manifest), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	ctrl := minimock.NewController(t)
+	useCase := mocks.NewIPVZOrderUseCaseMock(ctrl)
+
+	useCase.QuotePackagingMock.Set(func(_ context.Context, _ domain.Money, weight int, _ domain.Dimensions) ([]domain.PackagingQuote, error) {
+		if weight > 10000 {
+			return []domain.PackagingQuote{{Packaging: domain.PackagingTypeBox}}, nil
+		}
+		return []domain.PackagingQuote{{Packaging: domain.PackagingTypeBox}, {Packaging: domain.PackagingTypeBag}}, nil
+	})
+	useCase.BatchAcceptOrderDeliveryMock.Set(func(_ context.Context, items []domain.DeliveryItem) ([]domain.DeliveryResult, error) {
+		assert.Len(t, items, 2)
+		return []domain.DeliveryResult{
+			{OrderID: "1", Order: domain.PVZOrder{OrderID: "1"}},
+			{OrderID: "3", Err: domain.ErrAlreadyExists},
+		}, nil
+	})
+
+	ctx := abstractions.ContextWithPVZID(context.Background(), "pvzID")
+	command := setup(ctx, useCase, nil)
+
+	var out bytes.Buffer
+	command.SetOut(&out)
+	command.SetIn(strings.NewReader("y\n"))
+	command.SetArgs([]string{"import-manifest", path})
+
+	assert.NoError(t, command.Execute())
+	assert.Contains(t, out.String(), "Valid: 2, invalid: 3")
+	assert.Contains(t, out.String(), "Accepted: 1, not accepted: 4")
+
+	report, err := os.ReadFile(filepath.Join(dir, "manifest.errors.csv"))
+	assert.NoError(t, err)
+
+	reportLines := strings.Split(strings.TrimSpace(string(report)), "\n")
+	if assert.Len(t, reportLines, 5) {
+		assert.Equal(t, "line,order_id,error", reportLines[0])
+		assert.True(t, strings.HasPrefix(reportLines[1], "3,2,"))
+		assert.True(t, strings.HasPrefix(reportLines[2], "4,3,"))
+		assert.True(t, strings.HasPrefix(reportLines[3], "5,1,"))
+		assert.True(t, strings.HasPrefix(reportLines[4], "6,4,"))
+	}
+}
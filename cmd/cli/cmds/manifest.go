@@ -0,0 +1,263 @@
+package cmds
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"homework/internal/domain"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// manifestFormat is a format of the courier manifest file
+type manifestFormat string
+
+const (
+	manifestFormatCSV  manifestFormat = "csv"
+	manifestFormatJSON manifestFormat = "json"
+)
+
+// manifestColumns are the columns of the CSV manifest, the header is required
+var manifestColumns = []string{"order_id", "recipient_id", "storage_time", "cost", "weight", "packaging", "additional_film", "dimensions"}
+
+// optionalManifestColumns may be omitted from the header
+var optionalManifestColumns = map[string]struct{}{"additional_film": {}, "dimensions": {}}
+
+// manifestLine is one of the parcels of the manifest. Err is set if the line can not be parsed
+type manifestLine struct {
+	// Number is the line of the CSV file or the position of the item in the JSON array, starting from 1
+	Number int
+	Item   domain.DeliveryItem
+	Err    error
+}
+
+// manifestRecord is a parcel of the manifest as it is written in the file
+type manifestRecord struct {
+	OrderID        string `json:"order_id"`
+	RecipientID    string `json:"recipient_id"`
+	StorageTime    string `json:"storage_time"`
+	Cost           string `json:"cost"`
+	Weight         string `json:"weight"`
+	Packaging      string `json:"packaging"`
+	AdditionalFilm string `json:"additional_film"`
+	Dimensions     string `json:"dimensions"`
+}
+
+// UnmarshalJSON accepts the numbers and booleans as they are, e.g. "weight": 1000 as well as "weight": "1000"
+func (r *manifestRecord) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	values := make(map[string]string, len(fields))
+	for key, raw := range fields {
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			s = string(raw)
+		}
+		if string(raw) == "null" {
+			s = ""
+		}
+		values[key] = s
+	}
+
+	*r = manifestRecord{
+		OrderID:        values["order_id"],
+		RecipientID:    values["recipient_id"],
+		StorageTime:    values["storage_time"],
+		Cost:           values["cost"],
+		Weight:         values["weight"],
+		Packaging:      values["packaging"],
+		AdditionalFilm: values["additional_film"],
+		Dimensions:     values["dimensions"],
+	}
+
+	return nil
+}
+
+// detectManifestFormat detects the format by the file extension
+func detectManifestFormat(path string) (manifestFormat, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return manifestFormatCSV, nil
+	case ".json":
+		return manifestFormatJSON, nil
+	default:
+		return "", fmt.Errorf("unknown manifest format of %s, use --format csv or --format json", path)
+	}
+}
+
+// parseManifest reads all the lines of the manifest. The lines which can not be parsed
+// are returned with an error, so they are reported along with the rest
+func parseManifest(r io.Reader, format manifestFormat) ([]manifestLine, error) {
+	switch format {
+	case manifestFormatCSV:
+		return parseCSVManifest(r)
+	case manifestFormatJSON:
+		return parseJSONManifest(r)
+	default:
+		return nil, fmt.Errorf("unknown manifest format %q (available formats: csv, json)", format)
+	}
+}
+
+func parseCSVManifest(r io.Reader) ([]manifestLine, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("manifest is empty")
+		}
+		return nil, fmt.Errorf("failed to read manifest header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		columns[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	for _, column := range manifestColumns {
+		if _, ok := optionalManifestColumns[column]; ok {
+			continue
+		}
+		if _, ok := columns[column]; !ok {
+			return nil, fmt.Errorf("manifest header must have the %s column (columns: %s)", column, strings.Join(manifestColumns, ","))
+		}
+	}
+
+	var lines []manifestLine
+	for number := 2; ; number++ {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read manifest line %d: %w", number, err)
+		}
+
+		value := func(column string) string {
+			i, ok := columns[column]
+			if !ok || i >= len(fields) {
+				return ""
+			}
+			return strings.TrimSpace(fields[i])
+		}
+
+		record := manifestRecord{
+			OrderID:        value("order_id"),
+			RecipientID:    value("recipient_id"),
+			StorageTime:    value("storage_time"),
+			Cost:           value("cost"),
+			Weight:         value("weight"),
+			Packaging:      value("packaging"),
+			AdditionalFilm: value("additional_film"),
+			Dimensions:     value("dimensions"),
+		}
+
+		item, err := record.toDeliveryItem()
+		lines = append(lines, manifestLine{Number: number, Item: item, Err: err})
+	}
+
+	return lines, nil
+}
+
+func parseJSONManifest(r io.Reader) ([]manifestLine, error) {
+	var records []manifestRecord
+	if err := json.NewDecoder(r).Decode(&records); err != nil {
+		return nil, fmt.Errorf("manifest must be a JSON array of parcels: %w", err)
+	}
+
+	lines := make([]manifestLine, len(records))
+	for i, record := range records {
+		item, err := record.toDeliveryItem()
+		lines[i] = manifestLine{Number: i + 1, Item: item, Err: err}
+	}
+
+	return lines, nil
+}
+
+func (r manifestRecord) toDeliveryItem() (domain.DeliveryItem, error) {
+	item := domain.DeliveryItem{
+		OrderID:     strings.TrimSpace(r.OrderID),
+		RecipientID: strings.TrimSpace(r.RecipientID),
+	}
+
+	if item.OrderID == "" {
+		return item, fmt.Errorf("%w: order_id is empty", domain.ErrInvalidArgument)
+	}
+
+	if item.RecipientID == "" {
+		return item, fmt.Errorf("%w: recipient_id is empty", domain.ErrInvalidArgument)
+	}
+
+	var err error
+
+	item.StorageTime, err = time.ParseDuration(strings.TrimSpace(r.StorageTime))
+	if err != nil {
+		return item, fmt.Errorf("%w: failed to parse storage time: %v", domain.ErrInvalidArgument, err)
+	}
+
+	if item.StorageTime < 0 {
+		return item, fmt.Errorf("%w: storage time is negative", domain.ErrInvalidArgument)
+	}
+
+	item.Cost, err = domain.ParseMoney(r.Cost)
+	if err != nil {
+		return item, err
+	}
+
+	item.Weight, err = strconv.Atoi(strings.TrimSpace(r.Weight))
+	if err != nil {
+		return item, fmt.Errorf("%w: failed to parse weight: %v", domain.ErrInvalidArgument, err)
+	}
+
+	item.Packaging, err = domain.NewPackagingType(strings.TrimSpace(r.Packaging))
+	if err != nil {
+		return item, err
+	}
+
+	if value := strings.TrimSpace(r.AdditionalFilm); value != "" {
+		item.AdditionalFilm, err = strconv.ParseBool(value)
+		if err != nil {
+			return item, fmt.Errorf("%w: failed to parse additional film: %v", domain.ErrInvalidArgument, err)
+		}
+	}
+
+	if value := strings.TrimSpace(r.Dimensions); value != "" {
+		item.Dimensions, err = domain.ParseDimensions(value)
+		if err != nil {
+			return item, err
+		}
+	}
+
+	return item, nil
+}
+
+// writeManifestReport writes the lines which were not accepted along with the reasons as CSV
+func writeManifestReport(w io.Writer, lines []manifestLine) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write([]string{"line", "order_id", "error"}); err != nil {
+		return err
+	}
+
+	for _, line := range lines {
+		if line.Err == nil {
+			continue
+		}
+
+		if err := writer.Write([]string{strconv.Itoa(line.Number), line.Item.OrderID, line.Err.Error()}); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+
+	return writer.Error()
+}
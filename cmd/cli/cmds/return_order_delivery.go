@@ -14,7 +14,13 @@ func returnOrderDeliveryCmd(pvzOrderUseCase abstractions.IPVZOrderUseCase) *cobr
 		RunE: func(cmd *cobra.Command, args []string) error {
 			orderID := args[0]
 
-			err := pvzOrderUseCase.ReturnOrderDelivery(cmd.Context(), orderID)
+			var options []abstractions.MutationOptFunc
+			if cmd.Flags().Changed("expected-version") {
+				version, _ := cmd.Flags().GetInt64("expected-version")
+				options = append(options, abstractions.WithExpectedVersion(version))
+			}
+
+			err := pvzOrderUseCase.ReturnOrderDelivery(cmd.Context(), orderID, options...)
 			if err != nil {
 				return err
 			}
@@ -25,5 +31,7 @@ func returnOrderDeliveryCmd(pvzOrderUseCase abstractions.IPVZOrderUseCase) *cobr
 		},
 	}
 
+	command.Flags().Int64("expected-version", 0, "fail if the order has been changed since this version")
+
 	return command
 }
@@ -37,7 +37,11 @@ func rootCMD(pvzOrderUseCase abstractions.IPVZOrderUseCase) *cobra.Command {
 	return command
 }
 
-func setup(ctx context.Context, pvzOrderUseCase abstractions.IPVZOrderUseCase) *cobra.Command {
+func setup(
+	ctx context.Context,
+	pvzOrderUseCase abstractions.IPVZOrderUseCase,
+	returnShipmentUseCase abstractions.IReturnShipmentUseCase,
+) *cobra.Command {
 	rootCmd := rootCMD(pvzOrderUseCase)
 	rootCmd.SetContext(ctx)
 
@@ -45,13 +49,24 @@ func setup(ctx context.Context, pvzOrderUseCase abstractions.IPVZOrderUseCase) *
 	rootCmd.AddCommand(acceptReturnCmd(pvzOrderUseCase))
 	rootCmd.AddCommand(getOrdersCmd(pvzOrderUseCase))
 	rootCmd.AddCommand(getReturnsCmd(pvzOrderUseCase))
+	rootCmd.AddCommand(getOrderHistoryCmd(pvzOrderUseCase))
+	rootCmd.AddCommand(extendStorageCmd(pvzOrderUseCase))
 	rootCmd.AddCommand(giveOrderToClientCmd(pvzOrderUseCase))
 	rootCmd.AddCommand(returnOrderDeliveryCmd(pvzOrderUseCase))
+	rootCmd.AddCommand(importManifestCmd(pvzOrderUseCase))
+	rootCmd.AddCommand(getWeightDiscrepancyReportCmd(pvzOrderUseCase))
+	rootCmd.AddCommand(createReturnShipmentCmd(returnShipmentUseCase))
+	rootCmd.AddCommand(dispatchReturnShipmentCmd(returnShipmentUseCase))
+	rootCmd.AddCommand(getReturnShipmentCmd(returnShipmentUseCase))
 
 	return rootCmd
 }
 
 // Execute executes the root command.
-func Execute(ctx context.Context, pvzOrderUseCase abstractions.IPVZOrderUseCase) error {
-	return setup(ctx, pvzOrderUseCase).Execute()
+func Execute(
+	ctx context.Context,
+	pvzOrderUseCase abstractions.IPVZOrderUseCase,
+	returnShipmentUseCase abstractions.IReturnShipmentUseCase,
+) error {
+	return setup(ctx, pvzOrderUseCase, returnShipmentUseCase).Execute()
 }
@@ -5,12 +5,17 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strconv"
+	"time"
 
 	"homework/cmd/cli/cmds"
 	"homework/internal/abstractions"
 	"homework/internal/domain"
 	cacheinmem "homework/internal/infrastructure/clients/cache/inmemmory"
+	policy "homework/internal/infrastructure/clients/policy/static"
+	registry "homework/internal/infrastructure/clients/registry/static"
 	"homework/internal/infrastructure/repositories/pvzorder/pgx"
+	pvzpolicy "homework/internal/infrastructure/repositories/pvzpolicy/pgx"
 	"homework/internal/infrastructure/repositories/utils/pgx/txmanager"
 	"homework/internal/usecases"
 	"homework/internal/usecases/packager"
@@ -20,6 +25,9 @@ import (
 	"github.com/joho/godotenv"
 )
 
+// defaultPackagingCatalog is used when PACKAGING_CATALOG is not set
+const defaultPackagingCatalog = "configs/packaging.yaml"
+
 func loadPostgresURL() string {
 	postgresHost := os.Getenv("POSTGRES_HOST")
 	postgresPort := os.Getenv("POSTGRES_PORT")
@@ -30,6 +38,97 @@ func loadPostgresURL() string {
 	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable", postgresHost, postgresPort, postgresUsername, postgresPassword, postgresDatabase)
 }
 
+func loadOrderPackager() (*packager.OrderPackager, error) {
+	path := os.Getenv("PACKAGING_CATALOG")
+	if path == "" {
+		path = defaultPackagingCatalog
+	}
+
+	catalog, err := packager.LoadCatalog(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return packager.NewOrderPackager(catalog, strategies.FromCatalog(catalog))
+}
+
+func loadPVZPolicies() (*policy.PVZPolicies, error) {
+	defaultMaxStorageTime := policy.DefaultMaxStorageTime
+	if value := os.Getenv("MAX_STORAGE_TIME"); value != "" {
+		var err error
+		defaultMaxStorageTime, err = time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MAX_STORAGE_TIME: %w", err)
+		}
+	}
+
+	maxStorageTimes, err := policy.ParseMaxStorageTimes(os.Getenv("PVZ_MAX_STORAGE_TIMES"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid PVZ_MAX_STORAGE_TIMES: %w", err)
+	}
+
+	weightTolerancePercent := policy.DefaultWeightTolerancePercent
+	if value := os.Getenv("WEIGHT_TOLERANCE_PERCENT"); value != "" {
+		weightTolerancePercent, err = strconv.Atoi(value)
+		if err != nil || weightTolerancePercent < 0 {
+			return nil, fmt.Errorf("invalid WEIGHT_TOLERANCE_PERCENT: %s", value)
+		}
+	}
+
+	paidStorage, err := loadPaidStorage()
+	if err != nil {
+		return nil, err
+	}
+
+	calendars, err := loadCalendars()
+	if err != nil {
+		return nil, err
+	}
+
+	return policy.NewPVZPolicies(defaultMaxStorageTime, maxStorageTimes, weightTolerancePercent, paidStorage, calendars), nil
+}
+
+// loadCalendars reads the PVZ calendars, the PVZs work around the clock every day if PVZ_CALENDARS is not set
+func loadCalendars() (policy.Calendars, error) {
+	path := os.Getenv("PVZ_CALENDARS")
+	if path == "" {
+		return policy.Calendars{}, nil
+	}
+
+	return policy.LoadCalendars(path)
+}
+
+func loadPaidStorage() (policy.PaidStorage, error) {
+	var paidStorage policy.PaidStorage
+	var err error
+
+	if value := os.Getenv("PAID_STORAGE_DAYS"); value != "" {
+		paidStorage.DefaultDays, err = strconv.Atoi(value)
+		if err != nil || paidStorage.DefaultDays < 0 {
+			return policy.PaidStorage{}, fmt.Errorf("invalid PAID_STORAGE_DAYS: %s", value)
+		}
+	}
+
+	paidStorage.Days, err = policy.ParsePaidStorageDays(os.Getenv("PVZ_PAID_STORAGE_DAYS"))
+	if err != nil {
+		return policy.PaidStorage{}, fmt.Errorf("invalid PVZ_PAID_STORAGE_DAYS: %w", err)
+	}
+
+	if value := os.Getenv("DAILY_STORAGE_FEE"); value != "" {
+		paidStorage.DefaultDailyFee, err = domain.ParseMoney(value)
+		if err != nil || paidStorage.DefaultDailyFee.IsNegative() {
+			return policy.PaidStorage{}, fmt.Errorf("invalid DAILY_STORAGE_FEE: %s", value)
+		}
+	}
+
+	paidStorage.DailyFees, err = policy.ParseDailyStorageFees(os.Getenv("PACKAGING_DAILY_STORAGE_FEES"))
+	if err != nil {
+		return policy.PaidStorage{}, fmt.Errorf("invalid PACKAGING_DAILY_STORAGE_FEES: %w", err)
+	}
+
+	return paidStorage, nil
+}
+
 func Run() error {
 	err := godotenv.Load()
 	if err != nil {
@@ -41,6 +140,21 @@ func Run() error {
 		return fmt.Errorf("PVZ_ID must be set")
 	}
 
+	pickupCodeKey, err := domain.NewPickupCodeKey(os.Getenv("PICKUP_CODE_KEY"))
+	if err != nil {
+		return fmt.Errorf("invalid PICKUP_CODE_KEY: %w", err)
+	}
+
+	policies, err := loadPVZPolicies()
+	if err != nil {
+		return err
+	}
+
+	orderPackager, err := loadOrderPackager()
+	if err != nil {
+		return err
+	}
+
 	postgresURL := loadPostgresURL()
 
 	ctx := context.Background()
@@ -57,32 +171,38 @@ func Run() error {
 		log.Fatal(err)
 	}
 
-	pvzOrderUseCase := initUseCase(pvzID, pool)
+	pvzOrderUseCase, returnShipmentUseCase := initUseCase(pool, pvzID, orderPackager, policies, pickupCodeKey)
+
+	// The CLI is run at a single PVZ, so every command is served for it
+	ctx = abstractions.ContextWithPVZID(ctx, pvzID)
 
-	return cmds.Execute(ctx, pvzOrderUseCase)
+	return cmds.Execute(ctx, pvzOrderUseCase, returnShipmentUseCase)
 }
 
-func initUseCase(pvzID string, pool *pgxpool.Pool) abstractions.IPVZOrderUseCase {
+func initUseCase(pool *pgxpool.Pool, pvzID string, orderPackager usecases.OrderPackagerInterface, policies usecases.PVZPolicies, pickupCodeKey domain.PickupCodeKey) (abstractions.IPVZOrderUseCase, abstractions.IReturnShipmentUseCase) {
 	txManager := txmanager.NewPGXTXManager(pool)
 	pvzOrderRepoFacade := pgx.NewPgxPvzOrderFacade(txManager)
 
-	orderPackager := packager.NewOrderPackager(
-		map[domain.PackagingType]packager.OrderPackagerStrategy{
-			domain.PackagingTypeBox:  strategies.NewBoxPackager(),
-			domain.PackagingTypeFilm: strategies.NewFilmPackager(),
-			domain.PackagingTypeBag:  strategies.NewBagPackager(),
-		},
+	// The rules set by the administrator override the configured ones
+	pvzPolicies := usecases.NewPVZPolicyUseCase(
+		pvzpolicy.NewPVZPolicyRepository(txManager),
+		cacheinmem.NewPVZPolicy(time.Minute, 100, cacheinmem.NewLRUInvalidationStrategy[string, domain.PVZPolicy]()),
+		policies,
+		registry.NewPVZRegistry([]string{pvzID}),
 	)
 
 	// Simple in-memory cache with TTL and LRU strategy
 	cache := cacheinmem.NewPVZOrder(5*60*1e9, 1000, cacheinmem.NewLRUInvalidationStrategy[string, interface{}]())
 
-	return usecases.NewPVZOrderUseCase(
+	pvzOrderUseCase := usecases.NewPVZOrderUseCase(
 		pvzOrderRepoFacade,
 		orderPackager,
-		pvzID,
 		cache,
+		pvzPolicies,
+		pickupCodeKey,
 	)
+
+	return pvzOrderUseCase, usecases.NewReturnShipmentUseCase(pvzOrderRepoFacade)
 }
 
 func main() {
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"homework/internal/infrastructure/repositories/pvzorder/pgx"
+	"homework/internal/infrastructure/repositories/utils/pgx/txmanager"
+	"homework/internal/usecases"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/joho/godotenv"
+)
+
+const (
+	// defaultExpirySweepInterval is used when EXPIRY_SWEEP_INTERVAL is not set
+	defaultExpirySweepInterval = time.Minute
+	// defaultExpiryReminderBefore is used when EXPIRY_REMINDER_BEFORE is not set
+	defaultExpiryReminderBefore = 24 * time.Hour
+	// expirySweepLimit is a maximum number of orders expired or reminded in one sweep
+	expirySweepLimit = 100
+)
+
+func loadPostgresURL() string {
+	postgresHost := os.Getenv("POSTGRES_HOST")
+	postgresPort := os.Getenv("POSTGRES_PORT")
+	postgresUsername := os.Getenv("POSTGRES_USERNAME")
+	postgresPassword := os.Getenv("POSTGRES_PASSWORD")
+	postgresDatabase := os.Getenv("POSTGRES_DATABASE")
+
+	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable", postgresHost, postgresPort, postgresUsername, postgresPassword, postgresDatabase)
+}
+
+// expirySweeperSettings reads the sweeper settings
+func expirySweeperSettings() (interval, remindBefore time.Duration, courierReturnLists bool, err error) {
+	interval = defaultExpirySweepInterval
+	if value := os.Getenv("EXPIRY_SWEEP_INTERVAL"); value != "" {
+		interval, err = time.ParseDuration(value)
+		if err != nil || interval <= 0 {
+			return 0, 0, false, fmt.Errorf("invalid EXPIRY_SWEEP_INTERVAL: %s", value)
+		}
+	}
+
+	remindBefore = defaultExpiryReminderBefore
+	if value := os.Getenv("EXPIRY_REMINDER_BEFORE"); value != "" {
+		remindBefore, err = time.ParseDuration(value)
+		if err != nil || remindBefore < 0 {
+			return 0, 0, false, fmt.Errorf("invalid EXPIRY_REMINDER_BEFORE: %s", value)
+		}
+	}
+
+	if value := os.Getenv("COURIER_RETURN_LISTS"); value != "" {
+		courierReturnLists, err = strconv.ParseBool(value)
+		if err != nil {
+			return 0, 0, false, fmt.Errorf("invalid COURIER_RETURN_LISTS: %s", value)
+		}
+	}
+
+	return interval, remindBefore, courierReturnLists, nil
+}
+
+// Run sweeps the expired orders until it is stopped. The sweeper is run as a single instance
+// next to the events processor, so the orders are not swept by every replica of the server
+func Run() error {
+	err := godotenv.Load()
+	if err != nil {
+		fmt.Println("Error loading .env file")
+	}
+
+	interval, remindBefore, courierReturnLists, err := expirySweeperSettings()
+	if err != nil {
+		return err
+	}
+
+	postgresURL := loadPostgresURL()
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pool, err := pgxpool.New(ctx, postgresURL)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer pool.Close()
+
+	if err := pool.Ping(ctx); err != nil {
+		log.Fatal(err)
+	}
+
+	txm := txmanager.NewPGXTXManager(pool)
+
+	sweeper := usecases.NewExpirySweeper(pgx.NewPgxPvzOrderFacade(txm), expirySweepLimit, remindBefore, courierReturnLists)
+
+	go func() {
+		stop := make(chan os.Signal, 1)
+		signal.Notify(stop, syscall.SIGTERM, syscall.SIGINT)
+
+		<-stop
+
+		sweeper.Stop()
+	}()
+
+	if err := sweeper.Run(ctx, interval); err != nil {
+		return fmt.Errorf("error running expiry sweeper: %w", err)
+	}
+
+	return nil
+}
+
+func main() {
+	if err := Run(); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
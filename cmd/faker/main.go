@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/joho/godotenv"
-	"homework/internal/usecases"
 	"log"
 	"os"
 	"time"
@@ -71,7 +70,7 @@ func createRandomOrder(ctx context.Context, repo *pgx.PvzOrderFacade) error {
 		order.PVZID = gofakeit.LetterN(10)
 		order.RecipientID = gofakeit.LetterN(10)
 
-		order.Cost = gofakeit.Number(1000, 1000000)
+		order.Cost = domain.RUB(int64(gofakeit.Number(1000, 1000000)))
 		order.Weight = gofakeit.Number(10, 10000)
 
 		order.Packaging = domain.PackagingType(gofakeit.RandomString([]string{"box", "film", "bag"}))
@@ -86,13 +85,14 @@ func createRandomOrder(ctx context.Context, repo *pgx.PvzOrderFacade) error {
 			order.IssuedAt = gofakeit.DateRange(order.ReceivedAt, order.ReceivedAt.Add(order.StorageTime))
 		}
 		if !order.IssuedAt.IsZero() && gofakeit.Bool() {
-			order.ReturnedAt = gofakeit.DateRange(order.IssuedAt, order.IssuedAt.Add(usecases.TimeForReturn))
+			order.ReturnedAt = gofakeit.DateRange(order.IssuedAt, order.IssuedAt.Add(domain.DefaultReturnWindow))
 		}
 	}
 
 	fmt.Printf("Creating order: %+v\n", order)
 
-	err := repo.CreateOrder(ctx, order)
+	// Fake orders are created without a pickup code, so they can be issued without it
+	err := repo.CreateOrder(ctx, order, "")
 	if err != nil {
 		return fmt.Errorf("failed to create order: %w", err)
 	}
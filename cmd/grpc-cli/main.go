@@ -5,8 +5,10 @@ import (
 	"flag"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"homework/internal/infrastructure/server/middleware"
 	desc "homework/pkg/pvz-service/v1"
 	"log"
 )
@@ -15,6 +17,8 @@ var (
 	methodFlag = flag.String("method", "", "The method to call")
 	dataFlag   = flag.String("data", "{}", "The data to send")
 	hostFlag   = flag.String("host", "localhost:8080", "The host to connect to")
+	pvzFlag    = flag.String("pvz", "", "The PVZ ID to send requests for")
+	keyFlag    = flag.String("idempotency-key", "", "The idempotency key to retry the mutating requests with")
 )
 
 func main() {
@@ -35,6 +39,11 @@ func main() {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	ctx = metadata.AppendToOutgoingContext(ctx, middleware.PVZIDMetadataKey, *pvzFlag)
+	if *keyFlag != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, middleware.IdempotencyKeyMetadataKey, *keyFlag)
+	}
+
 	var resp proto.Message
 	switch *methodFlag {
 	case "AcceptOrderDelivery":
@@ -43,6 +52,12 @@ func main() {
 			log.Fatalf("failed to unmarshal data: %v", err)
 		}
 		resp, err = pvzService.AcceptOrderDelivery(ctx, req)
+	case "BatchAcceptOrderDelivery":
+		req := &desc.BatchAcceptOrderDeliveryRequest{}
+		if err := protojson.Unmarshal([]byte(*dataFlag), req); err != nil {
+			log.Fatalf("failed to unmarshal data: %v", err)
+		}
+		resp, err = pvzService.BatchAcceptOrderDelivery(ctx, req)
 	case "AcceptReturn":
 		req := &desc.AcceptReturnRequest{}
 		if err := protojson.Unmarshal([]byte(*dataFlag), req); err != nil {
@@ -73,6 +88,84 @@ func main() {
 			log.Fatalf("failed to unmarshal data: %v", err)
 		}
 		resp, err = pvzService.ReturnOrderDelivery(ctx, req)
+	case "GetOrderHistory":
+		req := &desc.GetOrderHistoryRequest{}
+		if err := protojson.Unmarshal([]byte(*dataFlag), req); err != nil {
+			log.Fatalf("failed to unmarshal data: %v", err)
+		}
+		resp, err = pvzService.GetOrderHistory(ctx, req)
+	case "ExtendStorage":
+		req := &desc.ExtendStorageRequest{}
+		if err := protojson.Unmarshal([]byte(*dataFlag), req); err != nil {
+			log.Fatalf("failed to unmarshal data: %v", err)
+		}
+		resp, err = pvzService.ExtendStorage(ctx, req)
+	case "QuotePackaging":
+		req := &desc.QuotePackagingRequest{}
+		if err := protojson.Unmarshal([]byte(*dataFlag), req); err != nil {
+			log.Fatalf("failed to unmarshal data: %v", err)
+		}
+		resp, err = pvzService.QuotePackaging(ctx, req)
+	case "GetWeightDiscrepancyReport":
+		req := &desc.GetWeightDiscrepancyReportRequest{}
+		if err := protojson.Unmarshal([]byte(*dataFlag), req); err != nil {
+			log.Fatalf("failed to unmarshal data: %v", err)
+		}
+		resp, err = pvzService.GetWeightDiscrepancyReport(ctx, req)
+	case "OpenHandoverSession":
+		req := &desc.OpenHandoverSessionRequest{}
+		if err := protojson.Unmarshal([]byte(*dataFlag), req); err != nil {
+			log.Fatalf("failed to unmarshal data: %v", err)
+		}
+		resp, err = pvzService.OpenHandoverSession(ctx, req)
+	case "ScanHandoverParcel":
+		req := &desc.ScanHandoverParcelRequest{}
+		if err := protojson.Unmarshal([]byte(*dataFlag), req); err != nil {
+			log.Fatalf("failed to unmarshal data: %v", err)
+		}
+		resp, err = pvzService.ScanHandoverParcel(ctx, req)
+	case "CloseHandoverSession":
+		req := &desc.CloseHandoverSessionRequest{}
+		if err := protojson.Unmarshal([]byte(*dataFlag), req); err != nil {
+			log.Fatalf("failed to unmarshal data: %v", err)
+		}
+		resp, err = pvzService.CloseHandoverSession(ctx, req)
+	case "GetHandoverSession":
+		req := &desc.GetHandoverSessionRequest{}
+		if err := protojson.Unmarshal([]byte(*dataFlag), req); err != nil {
+			log.Fatalf("failed to unmarshal data: %v", err)
+		}
+		resp, err = pvzService.GetHandoverSession(ctx, req)
+	case "GetPVZPolicy":
+		req := &desc.GetPVZPolicyRequest{}
+		if err := protojson.Unmarshal([]byte(*dataFlag), req); err != nil {
+			log.Fatalf("failed to unmarshal data: %v", err)
+		}
+		resp, err = pvzService.GetPVZPolicy(ctx, req)
+	case "UpdatePVZPolicy":
+		req := &desc.UpdatePVZPolicyRequest{}
+		if err := protojson.Unmarshal([]byte(*dataFlag), req); err != nil {
+			log.Fatalf("failed to unmarshal data: %v", err)
+		}
+		resp, err = pvzService.UpdatePVZPolicy(ctx, req)
+	case "CreateReturnShipment":
+		req := &desc.CreateReturnShipmentRequest{}
+		if err := protojson.Unmarshal([]byte(*dataFlag), req); err != nil {
+			log.Fatalf("failed to unmarshal data: %v", err)
+		}
+		resp, err = pvzService.CreateReturnShipment(ctx, req)
+	case "DispatchReturnShipment":
+		req := &desc.DispatchReturnShipmentRequest{}
+		if err := protojson.Unmarshal([]byte(*dataFlag), req); err != nil {
+			log.Fatalf("failed to unmarshal data: %v", err)
+		}
+		resp, err = pvzService.DispatchReturnShipment(ctx, req)
+	case "GetReturnShipment":
+		req := &desc.GetReturnShipmentRequest{}
+		if err := protojson.Unmarshal([]byte(*dataFlag), req); err != nil {
+			log.Fatalf("failed to unmarshal data: %v", err)
+		}
+		resp, err = pvzService.GetReturnShipment(ctx, req)
 	default:
 		log.Fatalf("unknown method: %s", *methodFlag)
 	}
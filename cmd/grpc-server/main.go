@@ -8,7 +8,12 @@ import (
 	"homework/internal/abstractions"
 	"homework/internal/domain"
 	"homework/internal/infrastructure/clients/cache/inmemmory"
+	policy "homework/internal/infrastructure/clients/policy/static"
+	"homework/internal/infrastructure/clients/registry/static"
+	handover "homework/internal/infrastructure/repositories/handover/pgx"
+	idempotency "homework/internal/infrastructure/repositories/idempotency/pgx"
 	"homework/internal/infrastructure/repositories/pvzorder/pgx"
+	pvzpolicy "homework/internal/infrastructure/repositories/pvzpolicy/pgx"
 	"homework/internal/infrastructure/repositories/utils/pgx/txmanager"
 	"homework/internal/infrastructure/server"
 	"homework/internal/tracer"
@@ -17,9 +22,14 @@ import (
 	"homework/internal/usecases/packager/strategies"
 	"log"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 )
 
+// defaultPackagingCatalog is used when PACKAGING_CATALOG is not set
+const defaultPackagingCatalog = "configs/packaging.yaml"
+
 func loadPostgresURL() string {
 	postgresHost := os.Getenv("POSTGRES_HOST")
 	postgresPort := os.Getenv("POSTGRES_PORT")
@@ -30,15 +40,132 @@ func loadPostgresURL() string {
 	return fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable", postgresHost, postgresPort, postgresUsername, postgresPassword, postgresDatabase)
 }
 
+func loadPVZIDs() []string {
+	var pvzIDs []string
+	for _, pvzID := range strings.Split(os.Getenv("PVZ_IDS"), ",") {
+		if pvzID = strings.TrimSpace(pvzID); pvzID != "" {
+			pvzIDs = append(pvzIDs, pvzID)
+		}
+	}
+
+	return pvzIDs
+}
+
+func loadOrderPackager() (*packager.OrderPackager, error) {
+	path := os.Getenv("PACKAGING_CATALOG")
+	if path == "" {
+		path = defaultPackagingCatalog
+	}
+
+	catalog, err := packager.LoadCatalog(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return packager.NewOrderPackager(catalog, strategies.FromCatalog(catalog))
+}
+
+func loadPVZPolicies() (*policy.PVZPolicies, error) {
+	defaultMaxStorageTime := policy.DefaultMaxStorageTime
+	if value := os.Getenv("MAX_STORAGE_TIME"); value != "" {
+		var err error
+		defaultMaxStorageTime, err = time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MAX_STORAGE_TIME: %w", err)
+		}
+	}
+
+	maxStorageTimes, err := policy.ParseMaxStorageTimes(os.Getenv("PVZ_MAX_STORAGE_TIMES"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid PVZ_MAX_STORAGE_TIMES: %w", err)
+	}
+
+	weightTolerancePercent := policy.DefaultWeightTolerancePercent
+	if value := os.Getenv("WEIGHT_TOLERANCE_PERCENT"); value != "" {
+		weightTolerancePercent, err = strconv.Atoi(value)
+		if err != nil || weightTolerancePercent < 0 {
+			return nil, fmt.Errorf("invalid WEIGHT_TOLERANCE_PERCENT: %s", value)
+		}
+	}
+
+	paidStorage, err := loadPaidStorage()
+	if err != nil {
+		return nil, err
+	}
+
+	calendars, err := loadCalendars()
+	if err != nil {
+		return nil, err
+	}
+
+	return policy.NewPVZPolicies(defaultMaxStorageTime, maxStorageTimes, weightTolerancePercent, paidStorage, calendars), nil
+}
+
+// loadCalendars reads the PVZ calendars, the PVZs work around the clock every day if PVZ_CALENDARS is not set
+func loadCalendars() (policy.Calendars, error) {
+	path := os.Getenv("PVZ_CALENDARS")
+	if path == "" {
+		return policy.Calendars{}, nil
+	}
+
+	return policy.LoadCalendars(path)
+}
+
+func loadPaidStorage() (policy.PaidStorage, error) {
+	var paidStorage policy.PaidStorage
+	var err error
+
+	if value := os.Getenv("PAID_STORAGE_DAYS"); value != "" {
+		paidStorage.DefaultDays, err = strconv.Atoi(value)
+		if err != nil || paidStorage.DefaultDays < 0 {
+			return policy.PaidStorage{}, fmt.Errorf("invalid PAID_STORAGE_DAYS: %s", value)
+		}
+	}
+
+	paidStorage.Days, err = policy.ParsePaidStorageDays(os.Getenv("PVZ_PAID_STORAGE_DAYS"))
+	if err != nil {
+		return policy.PaidStorage{}, fmt.Errorf("invalid PVZ_PAID_STORAGE_DAYS: %w", err)
+	}
+
+	if value := os.Getenv("DAILY_STORAGE_FEE"); value != "" {
+		paidStorage.DefaultDailyFee, err = domain.ParseMoney(value)
+		if err != nil || paidStorage.DefaultDailyFee.IsNegative() {
+			return policy.PaidStorage{}, fmt.Errorf("invalid DAILY_STORAGE_FEE: %s", value)
+		}
+	}
+
+	paidStorage.DailyFees, err = policy.ParseDailyStorageFees(os.Getenv("PACKAGING_DAILY_STORAGE_FEES"))
+	if err != nil {
+		return policy.PaidStorage{}, fmt.Errorf("invalid PACKAGING_DAILY_STORAGE_FEES: %w", err)
+	}
+
+	return paidStorage, nil
+}
+
 func Run() error {
 	err := godotenv.Load()
 	if err != nil {
 		fmt.Println("Error loading .env file")
 	}
 
-	pvzID := os.Getenv("PVZ_ID")
-	if pvzID == "" {
-		return fmt.Errorf("PVZ_ID must be set")
+	pvzIDs := loadPVZIDs()
+	if len(pvzIDs) == 0 {
+		return fmt.Errorf("PVZ_IDS must be set")
+	}
+
+	pickupCodeKey, err := domain.NewPickupCodeKey(os.Getenv("PICKUP_CODE_KEY"))
+	if err != nil {
+		return fmt.Errorf("invalid PICKUP_CODE_KEY: %w", err)
+	}
+
+	policies, err := loadPVZPolicies()
+	if err != nil {
+		return err
+	}
+
+	orderPackager, err := loadOrderPackager()
+	if err != nil {
+		return err
 	}
 
 	postgresURL := loadPostgresURL()
@@ -59,32 +186,50 @@ func Run() error {
 		log.Fatal(err)
 	}
 
-	pvzOrderUseCase := initUseCase(pvzID, pool)
+	txManager := txmanager.NewPGXTXManager(pool)
+	registry := static.NewPVZRegistry(pvzIDs)
+
+	policyUseCase := usecases.NewPVZPolicyUseCase(
+		pvzpolicy.NewPVZPolicyRepository(txManager),
+		inmemmory.NewPVZPolicy(time.Minute, 100, inmemmory.NewLRUInvalidationStrategy[string, domain.PVZPolicy]()),
+		policies,
+		registry,
+	)
+
+	pvzOrderUseCase := initUseCase(txManager, orderPackager, policyUseCase, pickupCodeKey)
+
+	handoverUseCase := usecases.NewHandoverUseCase(
+		txManager,
+		handover.NewHandoverRepository(txManager),
+		pvzOrderUseCase,
+		policyUseCase,
+	)
+
+	returnShipmentUseCase := usecases.NewReturnShipmentUseCase(pgx.NewPgxPvzOrderFacade(txManager))
 
-	grpcServer := server.NewGRPCServer(pvzOrderUseCase)
+	grpcServer := server.NewGRPCServer(
+		pvzOrderUseCase,
+		handoverUseCase,
+		policyUseCase,
+		returnShipmentUseCase,
+		registry,
+		idempotency.NewIdempotencyRepository(txManager, idempotency.DefaultKeyTTL),
+	)
 
 	return grpcServer.Run(ctx, "localhost", 8080, 8081)
 }
 
-func initUseCase(pvzID string, pool *pgxpool.Pool) abstractions.IPVZOrderUseCase {
-	txManager := txmanager.NewPGXTXManager(pool)
+func initUseCase(txManager *txmanager.PGXTXManager, orderPackager usecases.OrderPackagerInterface, policies usecases.PVZPolicies, pickupCodeKey domain.PickupCodeKey) abstractions.IPVZOrderUseCase {
 	pvzOrderRepoFacade := pgx.NewPgxPvzOrderFacade(txManager)
 
-	orderPackager := packager.NewOrderPackager(
-		map[domain.PackagingType]packager.OrderPackagerStrategy{
-			domain.PackagingTypeBox:  strategies.NewBoxPackager(),
-			domain.PackagingTypeFilm: strategies.NewFilmPackager(),
-			domain.PackagingTypeBag:  strategies.NewBagPackager(),
-		},
-	)
-
 	cache := inmemmory.NewPVZOrder(time.Second, 100, inmemmory.NewLRUInvalidationStrategy[string, interface{}]())
 
 	return usecases.NewPVZOrderUseCase(
 		pvzOrderRepoFacade,
 		orderPackager,
-		pvzID,
 		cache,
+		policies,
+		pickupCodeKey,
 	)
 }
 
@@ -0,0 +1,17 @@
+package abstractions
+
+import (
+	"context"
+
+	"homework/internal/domain"
+)
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i IHandoverUseCase -s _mock.go -o ./mocks
+
+// IHandoverUseCase is an interface for the reconciliation of the parcels the courier hands over
+type IHandoverUseCase interface {
+	OpenHandoverSession(ctx context.Context, courierID string, expected []domain.DeliveryItem) (domain.HandoverSession, error)
+	ScanHandoverParcel(ctx context.Context, sessionID, orderID string, measuredWeight int) (domain.HandoverScan, error)
+	CloseHandoverSession(ctx context.Context, sessionID string) (domain.HandoverReport, error)
+	GetHandoverSession(ctx context.Context, sessionID string) (domain.HandoverSession, error)
+}
@@ -0,0 +1,1577 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"homework/internal/domain"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// IHandoverUseCaseMock implements mm_abstractions.IHandoverUseCase
+type IHandoverUseCaseMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCloseHandoverSession          func(ctx context.Context, sessionID string) (h1 domain.HandoverReport, err error)
+	funcCloseHandoverSessionOrigin    string
+	inspectFuncCloseHandoverSession   func(ctx context.Context, sessionID string)
+	afterCloseHandoverSessionCounter  uint64
+	beforeCloseHandoverSessionCounter uint64
+	CloseHandoverSessionMock          mIHandoverUseCaseMockCloseHandoverSession
+
+	funcGetHandoverSession          func(ctx context.Context, sessionID string) (h1 domain.HandoverSession, err error)
+	funcGetHandoverSessionOrigin    string
+	inspectFuncGetHandoverSession   func(ctx context.Context, sessionID string)
+	afterGetHandoverSessionCounter  uint64
+	beforeGetHandoverSessionCounter uint64
+	GetHandoverSessionMock          mIHandoverUseCaseMockGetHandoverSession
+
+	funcOpenHandoverSession          func(ctx context.Context, courierID string, expected []domain.DeliveryItem) (h1 domain.HandoverSession, err error)
+	funcOpenHandoverSessionOrigin    string
+	inspectFuncOpenHandoverSession   func(ctx context.Context, courierID string, expected []domain.DeliveryItem)
+	afterOpenHandoverSessionCounter  uint64
+	beforeOpenHandoverSessionCounter uint64
+	OpenHandoverSessionMock          mIHandoverUseCaseMockOpenHandoverSession
+
+	funcScanHandoverParcel          func(ctx context.Context, sessionID string, orderID string, measuredWeight int) (h1 domain.HandoverScan, err error)
+	funcScanHandoverParcelOrigin    string
+	inspectFuncScanHandoverParcel   func(ctx context.Context, sessionID string, orderID string, measuredWeight int)
+	afterScanHandoverParcelCounter  uint64
+	beforeScanHandoverParcelCounter uint64
+	ScanHandoverParcelMock          mIHandoverUseCaseMockScanHandoverParcel
+}
+
+// NewIHandoverUseCaseMock returns a mock for mm_abstractions.IHandoverUseCase
+func NewIHandoverUseCaseMock(t minimock.Tester) *IHandoverUseCaseMock {
+	m := &IHandoverUseCaseMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CloseHandoverSessionMock = mIHandoverUseCaseMockCloseHandoverSession{mock: m}
+	m.CloseHandoverSessionMock.callArgs = []*IHandoverUseCaseMockCloseHandoverSessionParams{}
+
+	m.GetHandoverSessionMock = mIHandoverUseCaseMockGetHandoverSession{mock: m}
+	m.GetHandoverSessionMock.callArgs = []*IHandoverUseCaseMockGetHandoverSessionParams{}
+
+	m.OpenHandoverSessionMock = mIHandoverUseCaseMockOpenHandoverSession{mock: m}
+	m.OpenHandoverSessionMock.callArgs = []*IHandoverUseCaseMockOpenHandoverSessionParams{}
+
+	m.ScanHandoverParcelMock = mIHandoverUseCaseMockScanHandoverParcel{mock: m}
+	m.ScanHandoverParcelMock.callArgs = []*IHandoverUseCaseMockScanHandoverParcelParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mIHandoverUseCaseMockCloseHandoverSession struct {
+	optional           bool
+	mock               *IHandoverUseCaseMock
+	defaultExpectation *IHandoverUseCaseMockCloseHandoverSessionExpectation
+	expectations       []*IHandoverUseCaseMockCloseHandoverSessionExpectation
+
+	callArgs []*IHandoverUseCaseMockCloseHandoverSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IHandoverUseCaseMockCloseHandoverSessionExpectation specifies expectation struct of the IHandoverUseCase.CloseHandoverSession
+type IHandoverUseCaseMockCloseHandoverSessionExpectation struct {
+	mock               *IHandoverUseCaseMock
+	params             *IHandoverUseCaseMockCloseHandoverSessionParams
+	paramPtrs          *IHandoverUseCaseMockCloseHandoverSessionParamPtrs
+	expectationOrigins IHandoverUseCaseMockCloseHandoverSessionExpectationOrigins
+	results            *IHandoverUseCaseMockCloseHandoverSessionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IHandoverUseCaseMockCloseHandoverSessionParams contains parameters of the IHandoverUseCase.CloseHandoverSession
+type IHandoverUseCaseMockCloseHandoverSessionParams struct {
+	ctx       context.Context
+	sessionID string
+}
+
+// IHandoverUseCaseMockCloseHandoverSessionParamPtrs contains pointers to parameters of the IHandoverUseCase.CloseHandoverSession
+type IHandoverUseCaseMockCloseHandoverSessionParamPtrs struct {
+	ctx       *context.Context
+	sessionID *string
+}
+
+// IHandoverUseCaseMockCloseHandoverSessionResults contains results of the IHandoverUseCase.CloseHandoverSession
+type IHandoverUseCaseMockCloseHandoverSessionResults struct {
+	h1  domain.HandoverReport
+	err error
+}
+
+// IHandoverUseCaseMockCloseHandoverSessionOrigins contains origins of expectations of the IHandoverUseCase.CloseHandoverSession
+type IHandoverUseCaseMockCloseHandoverSessionExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originSessionID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCloseHandoverSession *mIHandoverUseCaseMockCloseHandoverSession) Optional() *mIHandoverUseCaseMockCloseHandoverSession {
+	mmCloseHandoverSession.optional = true
+	return mmCloseHandoverSession
+}
+
+// Expect sets up expected params for IHandoverUseCase.CloseHandoverSession
+func (mmCloseHandoverSession *mIHandoverUseCaseMockCloseHandoverSession) Expect(ctx context.Context, sessionID string) *mIHandoverUseCaseMockCloseHandoverSession {
+	if mmCloseHandoverSession.mock.funcCloseHandoverSession != nil {
+		mmCloseHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.CloseHandoverSession mock is already set by Set")
+	}
+
+	if mmCloseHandoverSession.defaultExpectation == nil {
+		mmCloseHandoverSession.defaultExpectation = &IHandoverUseCaseMockCloseHandoverSessionExpectation{}
+	}
+
+	if mmCloseHandoverSession.defaultExpectation.paramPtrs != nil {
+		mmCloseHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.CloseHandoverSession mock is already set by ExpectParams functions")
+	}
+
+	mmCloseHandoverSession.defaultExpectation.params = &IHandoverUseCaseMockCloseHandoverSessionParams{ctx, sessionID}
+	mmCloseHandoverSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCloseHandoverSession.expectations {
+		if minimock.Equal(e.params, mmCloseHandoverSession.defaultExpectation.params) {
+			mmCloseHandoverSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCloseHandoverSession.defaultExpectation.params)
+		}
+	}
+
+	return mmCloseHandoverSession
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IHandoverUseCase.CloseHandoverSession
+func (mmCloseHandoverSession *mIHandoverUseCaseMockCloseHandoverSession) ExpectCtxParam1(ctx context.Context) *mIHandoverUseCaseMockCloseHandoverSession {
+	if mmCloseHandoverSession.mock.funcCloseHandoverSession != nil {
+		mmCloseHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.CloseHandoverSession mock is already set by Set")
+	}
+
+	if mmCloseHandoverSession.defaultExpectation == nil {
+		mmCloseHandoverSession.defaultExpectation = &IHandoverUseCaseMockCloseHandoverSessionExpectation{}
+	}
+
+	if mmCloseHandoverSession.defaultExpectation.params != nil {
+		mmCloseHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.CloseHandoverSession mock is already set by Expect")
+	}
+
+	if mmCloseHandoverSession.defaultExpectation.paramPtrs == nil {
+		mmCloseHandoverSession.defaultExpectation.paramPtrs = &IHandoverUseCaseMockCloseHandoverSessionParamPtrs{}
+	}
+	mmCloseHandoverSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCloseHandoverSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCloseHandoverSession
+}
+
+// ExpectSessionIDParam2 sets up expected param sessionID for IHandoverUseCase.CloseHandoverSession
+func (mmCloseHandoverSession *mIHandoverUseCaseMockCloseHandoverSession) ExpectSessionIDParam2(sessionID string) *mIHandoverUseCaseMockCloseHandoverSession {
+	if mmCloseHandoverSession.mock.funcCloseHandoverSession != nil {
+		mmCloseHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.CloseHandoverSession mock is already set by Set")
+	}
+
+	if mmCloseHandoverSession.defaultExpectation == nil {
+		mmCloseHandoverSession.defaultExpectation = &IHandoverUseCaseMockCloseHandoverSessionExpectation{}
+	}
+
+	if mmCloseHandoverSession.defaultExpectation.params != nil {
+		mmCloseHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.CloseHandoverSession mock is already set by Expect")
+	}
+
+	if mmCloseHandoverSession.defaultExpectation.paramPtrs == nil {
+		mmCloseHandoverSession.defaultExpectation.paramPtrs = &IHandoverUseCaseMockCloseHandoverSessionParamPtrs{}
+	}
+	mmCloseHandoverSession.defaultExpectation.paramPtrs.sessionID = &sessionID
+	mmCloseHandoverSession.defaultExpectation.expectationOrigins.originSessionID = minimock.CallerInfo(1)
+
+	return mmCloseHandoverSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the IHandoverUseCase.CloseHandoverSession
+func (mmCloseHandoverSession *mIHandoverUseCaseMockCloseHandoverSession) Inspect(f func(ctx context.Context, sessionID string)) *mIHandoverUseCaseMockCloseHandoverSession {
+	if mmCloseHandoverSession.mock.inspectFuncCloseHandoverSession != nil {
+		mmCloseHandoverSession.mock.t.Fatalf("Inspect function is already set for IHandoverUseCaseMock.CloseHandoverSession")
+	}
+
+	mmCloseHandoverSession.mock.inspectFuncCloseHandoverSession = f
+
+	return mmCloseHandoverSession
+}
+
+// Return sets up results that will be returned by IHandoverUseCase.CloseHandoverSession
+func (mmCloseHandoverSession *mIHandoverUseCaseMockCloseHandoverSession) Return(h1 domain.HandoverReport, err error) *IHandoverUseCaseMock {
+	if mmCloseHandoverSession.mock.funcCloseHandoverSession != nil {
+		mmCloseHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.CloseHandoverSession mock is already set by Set")
+	}
+
+	if mmCloseHandoverSession.defaultExpectation == nil {
+		mmCloseHandoverSession.defaultExpectation = &IHandoverUseCaseMockCloseHandoverSessionExpectation{mock: mmCloseHandoverSession.mock}
+	}
+	mmCloseHandoverSession.defaultExpectation.results = &IHandoverUseCaseMockCloseHandoverSessionResults{h1, err}
+	mmCloseHandoverSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCloseHandoverSession.mock
+}
+
+// Set uses given function f to mock the IHandoverUseCase.CloseHandoverSession method
+func (mmCloseHandoverSession *mIHandoverUseCaseMockCloseHandoverSession) Set(f func(ctx context.Context, sessionID string) (h1 domain.HandoverReport, err error)) *IHandoverUseCaseMock {
+	if mmCloseHandoverSession.defaultExpectation != nil {
+		mmCloseHandoverSession.mock.t.Fatalf("Default expectation is already set for the IHandoverUseCase.CloseHandoverSession method")
+	}
+
+	if len(mmCloseHandoverSession.expectations) > 0 {
+		mmCloseHandoverSession.mock.t.Fatalf("Some expectations are already set for the IHandoverUseCase.CloseHandoverSession method")
+	}
+
+	mmCloseHandoverSession.mock.funcCloseHandoverSession = f
+	mmCloseHandoverSession.mock.funcCloseHandoverSessionOrigin = minimock.CallerInfo(1)
+	return mmCloseHandoverSession.mock
+}
+
+// When sets expectation for the IHandoverUseCase.CloseHandoverSession which will trigger the result defined by the following
+// Then helper
+func (mmCloseHandoverSession *mIHandoverUseCaseMockCloseHandoverSession) When(ctx context.Context, sessionID string) *IHandoverUseCaseMockCloseHandoverSessionExpectation {
+	if mmCloseHandoverSession.mock.funcCloseHandoverSession != nil {
+		mmCloseHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.CloseHandoverSession mock is already set by Set")
+	}
+
+	expectation := &IHandoverUseCaseMockCloseHandoverSessionExpectation{
+		mock:               mmCloseHandoverSession.mock,
+		params:             &IHandoverUseCaseMockCloseHandoverSessionParams{ctx, sessionID},
+		expectationOrigins: IHandoverUseCaseMockCloseHandoverSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCloseHandoverSession.expectations = append(mmCloseHandoverSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IHandoverUseCase.CloseHandoverSession return parameters for the expectation previously defined by the When method
+func (e *IHandoverUseCaseMockCloseHandoverSessionExpectation) Then(h1 domain.HandoverReport, err error) *IHandoverUseCaseMock {
+	e.results = &IHandoverUseCaseMockCloseHandoverSessionResults{h1, err}
+	return e.mock
+}
+
+// Times sets number of times IHandoverUseCase.CloseHandoverSession should be invoked
+func (mmCloseHandoverSession *mIHandoverUseCaseMockCloseHandoverSession) Times(n uint64) *mIHandoverUseCaseMockCloseHandoverSession {
+	if n == 0 {
+		mmCloseHandoverSession.mock.t.Fatalf("Times of IHandoverUseCaseMock.CloseHandoverSession mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCloseHandoverSession.expectedInvocations, n)
+	mmCloseHandoverSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCloseHandoverSession
+}
+
+func (mmCloseHandoverSession *mIHandoverUseCaseMockCloseHandoverSession) invocationsDone() bool {
+	if len(mmCloseHandoverSession.expectations) == 0 && mmCloseHandoverSession.defaultExpectation == nil && mmCloseHandoverSession.mock.funcCloseHandoverSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCloseHandoverSession.mock.afterCloseHandoverSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCloseHandoverSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CloseHandoverSession implements mm_abstractions.IHandoverUseCase
+func (mmCloseHandoverSession *IHandoverUseCaseMock) CloseHandoverSession(ctx context.Context, sessionID string) (h1 domain.HandoverReport, err error) {
+	mm_atomic.AddUint64(&mmCloseHandoverSession.beforeCloseHandoverSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmCloseHandoverSession.afterCloseHandoverSessionCounter, 1)
+
+	mmCloseHandoverSession.t.Helper()
+
+	if mmCloseHandoverSession.inspectFuncCloseHandoverSession != nil {
+		mmCloseHandoverSession.inspectFuncCloseHandoverSession(ctx, sessionID)
+	}
+
+	mm_params := IHandoverUseCaseMockCloseHandoverSessionParams{ctx, sessionID}
+
+	// Record call args
+	mmCloseHandoverSession.CloseHandoverSessionMock.mutex.Lock()
+	mmCloseHandoverSession.CloseHandoverSessionMock.callArgs = append(mmCloseHandoverSession.CloseHandoverSessionMock.callArgs, &mm_params)
+	mmCloseHandoverSession.CloseHandoverSessionMock.mutex.Unlock()
+
+	for _, e := range mmCloseHandoverSession.CloseHandoverSessionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.h1, e.results.err
+		}
+	}
+
+	if mmCloseHandoverSession.CloseHandoverSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCloseHandoverSession.CloseHandoverSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmCloseHandoverSession.CloseHandoverSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmCloseHandoverSession.CloseHandoverSessionMock.defaultExpectation.paramPtrs
+
+		mm_got := IHandoverUseCaseMockCloseHandoverSessionParams{ctx, sessionID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCloseHandoverSession.t.Errorf("IHandoverUseCaseMock.CloseHandoverSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCloseHandoverSession.CloseHandoverSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.sessionID != nil && !minimock.Equal(*mm_want_ptrs.sessionID, mm_got.sessionID) {
+				mmCloseHandoverSession.t.Errorf("IHandoverUseCaseMock.CloseHandoverSession got unexpected parameter sessionID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCloseHandoverSession.CloseHandoverSessionMock.defaultExpectation.expectationOrigins.originSessionID, *mm_want_ptrs.sessionID, mm_got.sessionID, minimock.Diff(*mm_want_ptrs.sessionID, mm_got.sessionID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCloseHandoverSession.t.Errorf("IHandoverUseCaseMock.CloseHandoverSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCloseHandoverSession.CloseHandoverSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCloseHandoverSession.CloseHandoverSessionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCloseHandoverSession.t.Fatal("No results are set for the IHandoverUseCaseMock.CloseHandoverSession")
+		}
+		return (*mm_results).h1, (*mm_results).err
+	}
+	if mmCloseHandoverSession.funcCloseHandoverSession != nil {
+		return mmCloseHandoverSession.funcCloseHandoverSession(ctx, sessionID)
+	}
+	mmCloseHandoverSession.t.Fatalf("Unexpected call to IHandoverUseCaseMock.CloseHandoverSession. %v %v", ctx, sessionID)
+	return
+}
+
+// CloseHandoverSessionAfterCounter returns a count of finished IHandoverUseCaseMock.CloseHandoverSession invocations
+func (mmCloseHandoverSession *IHandoverUseCaseMock) CloseHandoverSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCloseHandoverSession.afterCloseHandoverSessionCounter)
+}
+
+// CloseHandoverSessionBeforeCounter returns a count of IHandoverUseCaseMock.CloseHandoverSession invocations
+func (mmCloseHandoverSession *IHandoverUseCaseMock) CloseHandoverSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCloseHandoverSession.beforeCloseHandoverSessionCounter)
+}
+
+// Calls returns a list of arguments used in each call to IHandoverUseCaseMock.CloseHandoverSession.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCloseHandoverSession *mIHandoverUseCaseMockCloseHandoverSession) Calls() []*IHandoverUseCaseMockCloseHandoverSessionParams {
+	mmCloseHandoverSession.mutex.RLock()
+
+	argCopy := make([]*IHandoverUseCaseMockCloseHandoverSessionParams, len(mmCloseHandoverSession.callArgs))
+	copy(argCopy, mmCloseHandoverSession.callArgs)
+
+	mmCloseHandoverSession.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCloseHandoverSessionDone returns true if the count of the CloseHandoverSession invocations corresponds
+// the number of defined expectations
+func (m *IHandoverUseCaseMock) MinimockCloseHandoverSessionDone() bool {
+	if m.CloseHandoverSessionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CloseHandoverSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CloseHandoverSessionMock.invocationsDone()
+}
+
+// MinimockCloseHandoverSessionInspect logs each unmet expectation
+func (m *IHandoverUseCaseMock) MinimockCloseHandoverSessionInspect() {
+	for _, e := range m.CloseHandoverSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IHandoverUseCaseMock.CloseHandoverSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCloseHandoverSessionCounter := mm_atomic.LoadUint64(&m.afterCloseHandoverSessionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CloseHandoverSessionMock.defaultExpectation != nil && afterCloseHandoverSessionCounter < 1 {
+		if m.CloseHandoverSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IHandoverUseCaseMock.CloseHandoverSession at\n%s", m.CloseHandoverSessionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IHandoverUseCaseMock.CloseHandoverSession at\n%s with params: %#v", m.CloseHandoverSessionMock.defaultExpectation.expectationOrigins.origin, *m.CloseHandoverSessionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCloseHandoverSession != nil && afterCloseHandoverSessionCounter < 1 {
+		m.t.Errorf("Expected call to IHandoverUseCaseMock.CloseHandoverSession at\n%s", m.funcCloseHandoverSessionOrigin)
+	}
+
+	if !m.CloseHandoverSessionMock.invocationsDone() && afterCloseHandoverSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to IHandoverUseCaseMock.CloseHandoverSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CloseHandoverSessionMock.expectedInvocations), m.CloseHandoverSessionMock.expectedInvocationsOrigin, afterCloseHandoverSessionCounter)
+	}
+}
+
+type mIHandoverUseCaseMockGetHandoverSession struct {
+	optional           bool
+	mock               *IHandoverUseCaseMock
+	defaultExpectation *IHandoverUseCaseMockGetHandoverSessionExpectation
+	expectations       []*IHandoverUseCaseMockGetHandoverSessionExpectation
+
+	callArgs []*IHandoverUseCaseMockGetHandoverSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IHandoverUseCaseMockGetHandoverSessionExpectation specifies expectation struct of the IHandoverUseCase.GetHandoverSession
+type IHandoverUseCaseMockGetHandoverSessionExpectation struct {
+	mock               *IHandoverUseCaseMock
+	params             *IHandoverUseCaseMockGetHandoverSessionParams
+	paramPtrs          *IHandoverUseCaseMockGetHandoverSessionParamPtrs
+	expectationOrigins IHandoverUseCaseMockGetHandoverSessionExpectationOrigins
+	results            *IHandoverUseCaseMockGetHandoverSessionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IHandoverUseCaseMockGetHandoverSessionParams contains parameters of the IHandoverUseCase.GetHandoverSession
+type IHandoverUseCaseMockGetHandoverSessionParams struct {
+	ctx       context.Context
+	sessionID string
+}
+
+// IHandoverUseCaseMockGetHandoverSessionParamPtrs contains pointers to parameters of the IHandoverUseCase.GetHandoverSession
+type IHandoverUseCaseMockGetHandoverSessionParamPtrs struct {
+	ctx       *context.Context
+	sessionID *string
+}
+
+// IHandoverUseCaseMockGetHandoverSessionResults contains results of the IHandoverUseCase.GetHandoverSession
+type IHandoverUseCaseMockGetHandoverSessionResults struct {
+	h1  domain.HandoverSession
+	err error
+}
+
+// IHandoverUseCaseMockGetHandoverSessionOrigins contains origins of expectations of the IHandoverUseCase.GetHandoverSession
+type IHandoverUseCaseMockGetHandoverSessionExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originSessionID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetHandoverSession *mIHandoverUseCaseMockGetHandoverSession) Optional() *mIHandoverUseCaseMockGetHandoverSession {
+	mmGetHandoverSession.optional = true
+	return mmGetHandoverSession
+}
+
+// Expect sets up expected params for IHandoverUseCase.GetHandoverSession
+func (mmGetHandoverSession *mIHandoverUseCaseMockGetHandoverSession) Expect(ctx context.Context, sessionID string) *mIHandoverUseCaseMockGetHandoverSession {
+	if mmGetHandoverSession.mock.funcGetHandoverSession != nil {
+		mmGetHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.GetHandoverSession mock is already set by Set")
+	}
+
+	if mmGetHandoverSession.defaultExpectation == nil {
+		mmGetHandoverSession.defaultExpectation = &IHandoverUseCaseMockGetHandoverSessionExpectation{}
+	}
+
+	if mmGetHandoverSession.defaultExpectation.paramPtrs != nil {
+		mmGetHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.GetHandoverSession mock is already set by ExpectParams functions")
+	}
+
+	mmGetHandoverSession.defaultExpectation.params = &IHandoverUseCaseMockGetHandoverSessionParams{ctx, sessionID}
+	mmGetHandoverSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetHandoverSession.expectations {
+		if minimock.Equal(e.params, mmGetHandoverSession.defaultExpectation.params) {
+			mmGetHandoverSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetHandoverSession.defaultExpectation.params)
+		}
+	}
+
+	return mmGetHandoverSession
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IHandoverUseCase.GetHandoverSession
+func (mmGetHandoverSession *mIHandoverUseCaseMockGetHandoverSession) ExpectCtxParam1(ctx context.Context) *mIHandoverUseCaseMockGetHandoverSession {
+	if mmGetHandoverSession.mock.funcGetHandoverSession != nil {
+		mmGetHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.GetHandoverSession mock is already set by Set")
+	}
+
+	if mmGetHandoverSession.defaultExpectation == nil {
+		mmGetHandoverSession.defaultExpectation = &IHandoverUseCaseMockGetHandoverSessionExpectation{}
+	}
+
+	if mmGetHandoverSession.defaultExpectation.params != nil {
+		mmGetHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.GetHandoverSession mock is already set by Expect")
+	}
+
+	if mmGetHandoverSession.defaultExpectation.paramPtrs == nil {
+		mmGetHandoverSession.defaultExpectation.paramPtrs = &IHandoverUseCaseMockGetHandoverSessionParamPtrs{}
+	}
+	mmGetHandoverSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetHandoverSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetHandoverSession
+}
+
+// ExpectSessionIDParam2 sets up expected param sessionID for IHandoverUseCase.GetHandoverSession
+func (mmGetHandoverSession *mIHandoverUseCaseMockGetHandoverSession) ExpectSessionIDParam2(sessionID string) *mIHandoverUseCaseMockGetHandoverSession {
+	if mmGetHandoverSession.mock.funcGetHandoverSession != nil {
+		mmGetHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.GetHandoverSession mock is already set by Set")
+	}
+
+	if mmGetHandoverSession.defaultExpectation == nil {
+		mmGetHandoverSession.defaultExpectation = &IHandoverUseCaseMockGetHandoverSessionExpectation{}
+	}
+
+	if mmGetHandoverSession.defaultExpectation.params != nil {
+		mmGetHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.GetHandoverSession mock is already set by Expect")
+	}
+
+	if mmGetHandoverSession.defaultExpectation.paramPtrs == nil {
+		mmGetHandoverSession.defaultExpectation.paramPtrs = &IHandoverUseCaseMockGetHandoverSessionParamPtrs{}
+	}
+	mmGetHandoverSession.defaultExpectation.paramPtrs.sessionID = &sessionID
+	mmGetHandoverSession.defaultExpectation.expectationOrigins.originSessionID = minimock.CallerInfo(1)
+
+	return mmGetHandoverSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the IHandoverUseCase.GetHandoverSession
+func (mmGetHandoverSession *mIHandoverUseCaseMockGetHandoverSession) Inspect(f func(ctx context.Context, sessionID string)) *mIHandoverUseCaseMockGetHandoverSession {
+	if mmGetHandoverSession.mock.inspectFuncGetHandoverSession != nil {
+		mmGetHandoverSession.mock.t.Fatalf("Inspect function is already set for IHandoverUseCaseMock.GetHandoverSession")
+	}
+
+	mmGetHandoverSession.mock.inspectFuncGetHandoverSession = f
+
+	return mmGetHandoverSession
+}
+
+// Return sets up results that will be returned by IHandoverUseCase.GetHandoverSession
+func (mmGetHandoverSession *mIHandoverUseCaseMockGetHandoverSession) Return(h1 domain.HandoverSession, err error) *IHandoverUseCaseMock {
+	if mmGetHandoverSession.mock.funcGetHandoverSession != nil {
+		mmGetHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.GetHandoverSession mock is already set by Set")
+	}
+
+	if mmGetHandoverSession.defaultExpectation == nil {
+		mmGetHandoverSession.defaultExpectation = &IHandoverUseCaseMockGetHandoverSessionExpectation{mock: mmGetHandoverSession.mock}
+	}
+	mmGetHandoverSession.defaultExpectation.results = &IHandoverUseCaseMockGetHandoverSessionResults{h1, err}
+	mmGetHandoverSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetHandoverSession.mock
+}
+
+// Set uses given function f to mock the IHandoverUseCase.GetHandoverSession method
+func (mmGetHandoverSession *mIHandoverUseCaseMockGetHandoverSession) Set(f func(ctx context.Context, sessionID string) (h1 domain.HandoverSession, err error)) *IHandoverUseCaseMock {
+	if mmGetHandoverSession.defaultExpectation != nil {
+		mmGetHandoverSession.mock.t.Fatalf("Default expectation is already set for the IHandoverUseCase.GetHandoverSession method")
+	}
+
+	if len(mmGetHandoverSession.expectations) > 0 {
+		mmGetHandoverSession.mock.t.Fatalf("Some expectations are already set for the IHandoverUseCase.GetHandoverSession method")
+	}
+
+	mmGetHandoverSession.mock.funcGetHandoverSession = f
+	mmGetHandoverSession.mock.funcGetHandoverSessionOrigin = minimock.CallerInfo(1)
+	return mmGetHandoverSession.mock
+}
+
+// When sets expectation for the IHandoverUseCase.GetHandoverSession which will trigger the result defined by the following
+// Then helper
+func (mmGetHandoverSession *mIHandoverUseCaseMockGetHandoverSession) When(ctx context.Context, sessionID string) *IHandoverUseCaseMockGetHandoverSessionExpectation {
+	if mmGetHandoverSession.mock.funcGetHandoverSession != nil {
+		mmGetHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.GetHandoverSession mock is already set by Set")
+	}
+
+	expectation := &IHandoverUseCaseMockGetHandoverSessionExpectation{
+		mock:               mmGetHandoverSession.mock,
+		params:             &IHandoverUseCaseMockGetHandoverSessionParams{ctx, sessionID},
+		expectationOrigins: IHandoverUseCaseMockGetHandoverSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetHandoverSession.expectations = append(mmGetHandoverSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IHandoverUseCase.GetHandoverSession return parameters for the expectation previously defined by the When method
+func (e *IHandoverUseCaseMockGetHandoverSessionExpectation) Then(h1 domain.HandoverSession, err error) *IHandoverUseCaseMock {
+	e.results = &IHandoverUseCaseMockGetHandoverSessionResults{h1, err}
+	return e.mock
+}
+
+// Times sets number of times IHandoverUseCase.GetHandoverSession should be invoked
+func (mmGetHandoverSession *mIHandoverUseCaseMockGetHandoverSession) Times(n uint64) *mIHandoverUseCaseMockGetHandoverSession {
+	if n == 0 {
+		mmGetHandoverSession.mock.t.Fatalf("Times of IHandoverUseCaseMock.GetHandoverSession mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetHandoverSession.expectedInvocations, n)
+	mmGetHandoverSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetHandoverSession
+}
+
+func (mmGetHandoverSession *mIHandoverUseCaseMockGetHandoverSession) invocationsDone() bool {
+	if len(mmGetHandoverSession.expectations) == 0 && mmGetHandoverSession.defaultExpectation == nil && mmGetHandoverSession.mock.funcGetHandoverSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetHandoverSession.mock.afterGetHandoverSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetHandoverSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetHandoverSession implements mm_abstractions.IHandoverUseCase
+func (mmGetHandoverSession *IHandoverUseCaseMock) GetHandoverSession(ctx context.Context, sessionID string) (h1 domain.HandoverSession, err error) {
+	mm_atomic.AddUint64(&mmGetHandoverSession.beforeGetHandoverSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetHandoverSession.afterGetHandoverSessionCounter, 1)
+
+	mmGetHandoverSession.t.Helper()
+
+	if mmGetHandoverSession.inspectFuncGetHandoverSession != nil {
+		mmGetHandoverSession.inspectFuncGetHandoverSession(ctx, sessionID)
+	}
+
+	mm_params := IHandoverUseCaseMockGetHandoverSessionParams{ctx, sessionID}
+
+	// Record call args
+	mmGetHandoverSession.GetHandoverSessionMock.mutex.Lock()
+	mmGetHandoverSession.GetHandoverSessionMock.callArgs = append(mmGetHandoverSession.GetHandoverSessionMock.callArgs, &mm_params)
+	mmGetHandoverSession.GetHandoverSessionMock.mutex.Unlock()
+
+	for _, e := range mmGetHandoverSession.GetHandoverSessionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.h1, e.results.err
+		}
+	}
+
+	if mmGetHandoverSession.GetHandoverSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetHandoverSession.GetHandoverSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetHandoverSession.GetHandoverSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmGetHandoverSession.GetHandoverSessionMock.defaultExpectation.paramPtrs
+
+		mm_got := IHandoverUseCaseMockGetHandoverSessionParams{ctx, sessionID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetHandoverSession.t.Errorf("IHandoverUseCaseMock.GetHandoverSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetHandoverSession.GetHandoverSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.sessionID != nil && !minimock.Equal(*mm_want_ptrs.sessionID, mm_got.sessionID) {
+				mmGetHandoverSession.t.Errorf("IHandoverUseCaseMock.GetHandoverSession got unexpected parameter sessionID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetHandoverSession.GetHandoverSessionMock.defaultExpectation.expectationOrigins.originSessionID, *mm_want_ptrs.sessionID, mm_got.sessionID, minimock.Diff(*mm_want_ptrs.sessionID, mm_got.sessionID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetHandoverSession.t.Errorf("IHandoverUseCaseMock.GetHandoverSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetHandoverSession.GetHandoverSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetHandoverSession.GetHandoverSessionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetHandoverSession.t.Fatal("No results are set for the IHandoverUseCaseMock.GetHandoverSession")
+		}
+		return (*mm_results).h1, (*mm_results).err
+	}
+	if mmGetHandoverSession.funcGetHandoverSession != nil {
+		return mmGetHandoverSession.funcGetHandoverSession(ctx, sessionID)
+	}
+	mmGetHandoverSession.t.Fatalf("Unexpected call to IHandoverUseCaseMock.GetHandoverSession. %v %v", ctx, sessionID)
+	return
+}
+
+// GetHandoverSessionAfterCounter returns a count of finished IHandoverUseCaseMock.GetHandoverSession invocations
+func (mmGetHandoverSession *IHandoverUseCaseMock) GetHandoverSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetHandoverSession.afterGetHandoverSessionCounter)
+}
+
+// GetHandoverSessionBeforeCounter returns a count of IHandoverUseCaseMock.GetHandoverSession invocations
+func (mmGetHandoverSession *IHandoverUseCaseMock) GetHandoverSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetHandoverSession.beforeGetHandoverSessionCounter)
+}
+
+// Calls returns a list of arguments used in each call to IHandoverUseCaseMock.GetHandoverSession.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetHandoverSession *mIHandoverUseCaseMockGetHandoverSession) Calls() []*IHandoverUseCaseMockGetHandoverSessionParams {
+	mmGetHandoverSession.mutex.RLock()
+
+	argCopy := make([]*IHandoverUseCaseMockGetHandoverSessionParams, len(mmGetHandoverSession.callArgs))
+	copy(argCopy, mmGetHandoverSession.callArgs)
+
+	mmGetHandoverSession.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetHandoverSessionDone returns true if the count of the GetHandoverSession invocations corresponds
+// the number of defined expectations
+func (m *IHandoverUseCaseMock) MinimockGetHandoverSessionDone() bool {
+	if m.GetHandoverSessionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetHandoverSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetHandoverSessionMock.invocationsDone()
+}
+
+// MinimockGetHandoverSessionInspect logs each unmet expectation
+func (m *IHandoverUseCaseMock) MinimockGetHandoverSessionInspect() {
+	for _, e := range m.GetHandoverSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IHandoverUseCaseMock.GetHandoverSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetHandoverSessionCounter := mm_atomic.LoadUint64(&m.afterGetHandoverSessionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetHandoverSessionMock.defaultExpectation != nil && afterGetHandoverSessionCounter < 1 {
+		if m.GetHandoverSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IHandoverUseCaseMock.GetHandoverSession at\n%s", m.GetHandoverSessionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IHandoverUseCaseMock.GetHandoverSession at\n%s with params: %#v", m.GetHandoverSessionMock.defaultExpectation.expectationOrigins.origin, *m.GetHandoverSessionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetHandoverSession != nil && afterGetHandoverSessionCounter < 1 {
+		m.t.Errorf("Expected call to IHandoverUseCaseMock.GetHandoverSession at\n%s", m.funcGetHandoverSessionOrigin)
+	}
+
+	if !m.GetHandoverSessionMock.invocationsDone() && afterGetHandoverSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to IHandoverUseCaseMock.GetHandoverSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetHandoverSessionMock.expectedInvocations), m.GetHandoverSessionMock.expectedInvocationsOrigin, afterGetHandoverSessionCounter)
+	}
+}
+
+type mIHandoverUseCaseMockOpenHandoverSession struct {
+	optional           bool
+	mock               *IHandoverUseCaseMock
+	defaultExpectation *IHandoverUseCaseMockOpenHandoverSessionExpectation
+	expectations       []*IHandoverUseCaseMockOpenHandoverSessionExpectation
+
+	callArgs []*IHandoverUseCaseMockOpenHandoverSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IHandoverUseCaseMockOpenHandoverSessionExpectation specifies expectation struct of the IHandoverUseCase.OpenHandoverSession
+type IHandoverUseCaseMockOpenHandoverSessionExpectation struct {
+	mock               *IHandoverUseCaseMock
+	params             *IHandoverUseCaseMockOpenHandoverSessionParams
+	paramPtrs          *IHandoverUseCaseMockOpenHandoverSessionParamPtrs
+	expectationOrigins IHandoverUseCaseMockOpenHandoverSessionExpectationOrigins
+	results            *IHandoverUseCaseMockOpenHandoverSessionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IHandoverUseCaseMockOpenHandoverSessionParams contains parameters of the IHandoverUseCase.OpenHandoverSession
+type IHandoverUseCaseMockOpenHandoverSessionParams struct {
+	ctx       context.Context
+	courierID string
+	expected  []domain.DeliveryItem
+}
+
+// IHandoverUseCaseMockOpenHandoverSessionParamPtrs contains pointers to parameters of the IHandoverUseCase.OpenHandoverSession
+type IHandoverUseCaseMockOpenHandoverSessionParamPtrs struct {
+	ctx       *context.Context
+	courierID *string
+	expected  *[]domain.DeliveryItem
+}
+
+// IHandoverUseCaseMockOpenHandoverSessionResults contains results of the IHandoverUseCase.OpenHandoverSession
+type IHandoverUseCaseMockOpenHandoverSessionResults struct {
+	h1  domain.HandoverSession
+	err error
+}
+
+// IHandoverUseCaseMockOpenHandoverSessionOrigins contains origins of expectations of the IHandoverUseCase.OpenHandoverSession
+type IHandoverUseCaseMockOpenHandoverSessionExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originCourierID string
+	originExpected  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmOpenHandoverSession *mIHandoverUseCaseMockOpenHandoverSession) Optional() *mIHandoverUseCaseMockOpenHandoverSession {
+	mmOpenHandoverSession.optional = true
+	return mmOpenHandoverSession
+}
+
+// Expect sets up expected params for IHandoverUseCase.OpenHandoverSession
+func (mmOpenHandoverSession *mIHandoverUseCaseMockOpenHandoverSession) Expect(ctx context.Context, courierID string, expected []domain.DeliveryItem) *mIHandoverUseCaseMockOpenHandoverSession {
+	if mmOpenHandoverSession.mock.funcOpenHandoverSession != nil {
+		mmOpenHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.OpenHandoverSession mock is already set by Set")
+	}
+
+	if mmOpenHandoverSession.defaultExpectation == nil {
+		mmOpenHandoverSession.defaultExpectation = &IHandoverUseCaseMockOpenHandoverSessionExpectation{}
+	}
+
+	if mmOpenHandoverSession.defaultExpectation.paramPtrs != nil {
+		mmOpenHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.OpenHandoverSession mock is already set by ExpectParams functions")
+	}
+
+	mmOpenHandoverSession.defaultExpectation.params = &IHandoverUseCaseMockOpenHandoverSessionParams{ctx, courierID, expected}
+	mmOpenHandoverSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmOpenHandoverSession.expectations {
+		if minimock.Equal(e.params, mmOpenHandoverSession.defaultExpectation.params) {
+			mmOpenHandoverSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmOpenHandoverSession.defaultExpectation.params)
+		}
+	}
+
+	return mmOpenHandoverSession
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IHandoverUseCase.OpenHandoverSession
+func (mmOpenHandoverSession *mIHandoverUseCaseMockOpenHandoverSession) ExpectCtxParam1(ctx context.Context) *mIHandoverUseCaseMockOpenHandoverSession {
+	if mmOpenHandoverSession.mock.funcOpenHandoverSession != nil {
+		mmOpenHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.OpenHandoverSession mock is already set by Set")
+	}
+
+	if mmOpenHandoverSession.defaultExpectation == nil {
+		mmOpenHandoverSession.defaultExpectation = &IHandoverUseCaseMockOpenHandoverSessionExpectation{}
+	}
+
+	if mmOpenHandoverSession.defaultExpectation.params != nil {
+		mmOpenHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.OpenHandoverSession mock is already set by Expect")
+	}
+
+	if mmOpenHandoverSession.defaultExpectation.paramPtrs == nil {
+		mmOpenHandoverSession.defaultExpectation.paramPtrs = &IHandoverUseCaseMockOpenHandoverSessionParamPtrs{}
+	}
+	mmOpenHandoverSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmOpenHandoverSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmOpenHandoverSession
+}
+
+// ExpectCourierIDParam2 sets up expected param courierID for IHandoverUseCase.OpenHandoverSession
+func (mmOpenHandoverSession *mIHandoverUseCaseMockOpenHandoverSession) ExpectCourierIDParam2(courierID string) *mIHandoverUseCaseMockOpenHandoverSession {
+	if mmOpenHandoverSession.mock.funcOpenHandoverSession != nil {
+		mmOpenHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.OpenHandoverSession mock is already set by Set")
+	}
+
+	if mmOpenHandoverSession.defaultExpectation == nil {
+		mmOpenHandoverSession.defaultExpectation = &IHandoverUseCaseMockOpenHandoverSessionExpectation{}
+	}
+
+	if mmOpenHandoverSession.defaultExpectation.params != nil {
+		mmOpenHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.OpenHandoverSession mock is already set by Expect")
+	}
+
+	if mmOpenHandoverSession.defaultExpectation.paramPtrs == nil {
+		mmOpenHandoverSession.defaultExpectation.paramPtrs = &IHandoverUseCaseMockOpenHandoverSessionParamPtrs{}
+	}
+	mmOpenHandoverSession.defaultExpectation.paramPtrs.courierID = &courierID
+	mmOpenHandoverSession.defaultExpectation.expectationOrigins.originCourierID = minimock.CallerInfo(1)
+
+	return mmOpenHandoverSession
+}
+
+// ExpectExpectedParam3 sets up expected param expected for IHandoverUseCase.OpenHandoverSession
+func (mmOpenHandoverSession *mIHandoverUseCaseMockOpenHandoverSession) ExpectExpectedParam3(expected []domain.DeliveryItem) *mIHandoverUseCaseMockOpenHandoverSession {
+	if mmOpenHandoverSession.mock.funcOpenHandoverSession != nil {
+		mmOpenHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.OpenHandoverSession mock is already set by Set")
+	}
+
+	if mmOpenHandoverSession.defaultExpectation == nil {
+		mmOpenHandoverSession.defaultExpectation = &IHandoverUseCaseMockOpenHandoverSessionExpectation{}
+	}
+
+	if mmOpenHandoverSession.defaultExpectation.params != nil {
+		mmOpenHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.OpenHandoverSession mock is already set by Expect")
+	}
+
+	if mmOpenHandoverSession.defaultExpectation.paramPtrs == nil {
+		mmOpenHandoverSession.defaultExpectation.paramPtrs = &IHandoverUseCaseMockOpenHandoverSessionParamPtrs{}
+	}
+	mmOpenHandoverSession.defaultExpectation.paramPtrs.expected = &expected
+	mmOpenHandoverSession.defaultExpectation.expectationOrigins.originExpected = minimock.CallerInfo(1)
+
+	return mmOpenHandoverSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the IHandoverUseCase.OpenHandoverSession
+func (mmOpenHandoverSession *mIHandoverUseCaseMockOpenHandoverSession) Inspect(f func(ctx context.Context, courierID string, expected []domain.DeliveryItem)) *mIHandoverUseCaseMockOpenHandoverSession {
+	if mmOpenHandoverSession.mock.inspectFuncOpenHandoverSession != nil {
+		mmOpenHandoverSession.mock.t.Fatalf("Inspect function is already set for IHandoverUseCaseMock.OpenHandoverSession")
+	}
+
+	mmOpenHandoverSession.mock.inspectFuncOpenHandoverSession = f
+
+	return mmOpenHandoverSession
+}
+
+// Return sets up results that will be returned by IHandoverUseCase.OpenHandoverSession
+func (mmOpenHandoverSession *mIHandoverUseCaseMockOpenHandoverSession) Return(h1 domain.HandoverSession, err error) *IHandoverUseCaseMock {
+	if mmOpenHandoverSession.mock.funcOpenHandoverSession != nil {
+		mmOpenHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.OpenHandoverSession mock is already set by Set")
+	}
+
+	if mmOpenHandoverSession.defaultExpectation == nil {
+		mmOpenHandoverSession.defaultExpectation = &IHandoverUseCaseMockOpenHandoverSessionExpectation{mock: mmOpenHandoverSession.mock}
+	}
+	mmOpenHandoverSession.defaultExpectation.results = &IHandoverUseCaseMockOpenHandoverSessionResults{h1, err}
+	mmOpenHandoverSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmOpenHandoverSession.mock
+}
+
+// Set uses given function f to mock the IHandoverUseCase.OpenHandoverSession method
+func (mmOpenHandoverSession *mIHandoverUseCaseMockOpenHandoverSession) Set(f func(ctx context.Context, courierID string, expected []domain.DeliveryItem) (h1 domain.HandoverSession, err error)) *IHandoverUseCaseMock {
+	if mmOpenHandoverSession.defaultExpectation != nil {
+		mmOpenHandoverSession.mock.t.Fatalf("Default expectation is already set for the IHandoverUseCase.OpenHandoverSession method")
+	}
+
+	if len(mmOpenHandoverSession.expectations) > 0 {
+		mmOpenHandoverSession.mock.t.Fatalf("Some expectations are already set for the IHandoverUseCase.OpenHandoverSession method")
+	}
+
+	mmOpenHandoverSession.mock.funcOpenHandoverSession = f
+	mmOpenHandoverSession.mock.funcOpenHandoverSessionOrigin = minimock.CallerInfo(1)
+	return mmOpenHandoverSession.mock
+}
+
+// When sets expectation for the IHandoverUseCase.OpenHandoverSession which will trigger the result defined by the following
+// Then helper
+func (mmOpenHandoverSession *mIHandoverUseCaseMockOpenHandoverSession) When(ctx context.Context, courierID string, expected []domain.DeliveryItem) *IHandoverUseCaseMockOpenHandoverSessionExpectation {
+	if mmOpenHandoverSession.mock.funcOpenHandoverSession != nil {
+		mmOpenHandoverSession.mock.t.Fatalf("IHandoverUseCaseMock.OpenHandoverSession mock is already set by Set")
+	}
+
+	expectation := &IHandoverUseCaseMockOpenHandoverSessionExpectation{
+		mock:               mmOpenHandoverSession.mock,
+		params:             &IHandoverUseCaseMockOpenHandoverSessionParams{ctx, courierID, expected},
+		expectationOrigins: IHandoverUseCaseMockOpenHandoverSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmOpenHandoverSession.expectations = append(mmOpenHandoverSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IHandoverUseCase.OpenHandoverSession return parameters for the expectation previously defined by the When method
+func (e *IHandoverUseCaseMockOpenHandoverSessionExpectation) Then(h1 domain.HandoverSession, err error) *IHandoverUseCaseMock {
+	e.results = &IHandoverUseCaseMockOpenHandoverSessionResults{h1, err}
+	return e.mock
+}
+
+// Times sets number of times IHandoverUseCase.OpenHandoverSession should be invoked
+func (mmOpenHandoverSession *mIHandoverUseCaseMockOpenHandoverSession) Times(n uint64) *mIHandoverUseCaseMockOpenHandoverSession {
+	if n == 0 {
+		mmOpenHandoverSession.mock.t.Fatalf("Times of IHandoverUseCaseMock.OpenHandoverSession mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmOpenHandoverSession.expectedInvocations, n)
+	mmOpenHandoverSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmOpenHandoverSession
+}
+
+func (mmOpenHandoverSession *mIHandoverUseCaseMockOpenHandoverSession) invocationsDone() bool {
+	if len(mmOpenHandoverSession.expectations) == 0 && mmOpenHandoverSession.defaultExpectation == nil && mmOpenHandoverSession.mock.funcOpenHandoverSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmOpenHandoverSession.mock.afterOpenHandoverSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmOpenHandoverSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// OpenHandoverSession implements mm_abstractions.IHandoverUseCase
+func (mmOpenHandoverSession *IHandoverUseCaseMock) OpenHandoverSession(ctx context.Context, courierID string, expected []domain.DeliveryItem) (h1 domain.HandoverSession, err error) {
+	mm_atomic.AddUint64(&mmOpenHandoverSession.beforeOpenHandoverSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmOpenHandoverSession.afterOpenHandoverSessionCounter, 1)
+
+	mmOpenHandoverSession.t.Helper()
+
+	if mmOpenHandoverSession.inspectFuncOpenHandoverSession != nil {
+		mmOpenHandoverSession.inspectFuncOpenHandoverSession(ctx, courierID, expected)
+	}
+
+	mm_params := IHandoverUseCaseMockOpenHandoverSessionParams{ctx, courierID, expected}
+
+	// Record call args
+	mmOpenHandoverSession.OpenHandoverSessionMock.mutex.Lock()
+	mmOpenHandoverSession.OpenHandoverSessionMock.callArgs = append(mmOpenHandoverSession.OpenHandoverSessionMock.callArgs, &mm_params)
+	mmOpenHandoverSession.OpenHandoverSessionMock.mutex.Unlock()
+
+	for _, e := range mmOpenHandoverSession.OpenHandoverSessionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.h1, e.results.err
+		}
+	}
+
+	if mmOpenHandoverSession.OpenHandoverSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmOpenHandoverSession.OpenHandoverSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmOpenHandoverSession.OpenHandoverSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmOpenHandoverSession.OpenHandoverSessionMock.defaultExpectation.paramPtrs
+
+		mm_got := IHandoverUseCaseMockOpenHandoverSessionParams{ctx, courierID, expected}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmOpenHandoverSession.t.Errorf("IHandoverUseCaseMock.OpenHandoverSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpenHandoverSession.OpenHandoverSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.courierID != nil && !minimock.Equal(*mm_want_ptrs.courierID, mm_got.courierID) {
+				mmOpenHandoverSession.t.Errorf("IHandoverUseCaseMock.OpenHandoverSession got unexpected parameter courierID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpenHandoverSession.OpenHandoverSessionMock.defaultExpectation.expectationOrigins.originCourierID, *mm_want_ptrs.courierID, mm_got.courierID, minimock.Diff(*mm_want_ptrs.courierID, mm_got.courierID))
+			}
+
+			if mm_want_ptrs.expected != nil && !minimock.Equal(*mm_want_ptrs.expected, mm_got.expected) {
+				mmOpenHandoverSession.t.Errorf("IHandoverUseCaseMock.OpenHandoverSession got unexpected parameter expected, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpenHandoverSession.OpenHandoverSessionMock.defaultExpectation.expectationOrigins.originExpected, *mm_want_ptrs.expected, mm_got.expected, minimock.Diff(*mm_want_ptrs.expected, mm_got.expected))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmOpenHandoverSession.t.Errorf("IHandoverUseCaseMock.OpenHandoverSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmOpenHandoverSession.OpenHandoverSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmOpenHandoverSession.OpenHandoverSessionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmOpenHandoverSession.t.Fatal("No results are set for the IHandoverUseCaseMock.OpenHandoverSession")
+		}
+		return (*mm_results).h1, (*mm_results).err
+	}
+	if mmOpenHandoverSession.funcOpenHandoverSession != nil {
+		return mmOpenHandoverSession.funcOpenHandoverSession(ctx, courierID, expected)
+	}
+	mmOpenHandoverSession.t.Fatalf("Unexpected call to IHandoverUseCaseMock.OpenHandoverSession. %v %v %v", ctx, courierID, expected)
+	return
+}
+
+// OpenHandoverSessionAfterCounter returns a count of finished IHandoverUseCaseMock.OpenHandoverSession invocations
+func (mmOpenHandoverSession *IHandoverUseCaseMock) OpenHandoverSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOpenHandoverSession.afterOpenHandoverSessionCounter)
+}
+
+// OpenHandoverSessionBeforeCounter returns a count of IHandoverUseCaseMock.OpenHandoverSession invocations
+func (mmOpenHandoverSession *IHandoverUseCaseMock) OpenHandoverSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOpenHandoverSession.beforeOpenHandoverSessionCounter)
+}
+
+// Calls returns a list of arguments used in each call to IHandoverUseCaseMock.OpenHandoverSession.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmOpenHandoverSession *mIHandoverUseCaseMockOpenHandoverSession) Calls() []*IHandoverUseCaseMockOpenHandoverSessionParams {
+	mmOpenHandoverSession.mutex.RLock()
+
+	argCopy := make([]*IHandoverUseCaseMockOpenHandoverSessionParams, len(mmOpenHandoverSession.callArgs))
+	copy(argCopy, mmOpenHandoverSession.callArgs)
+
+	mmOpenHandoverSession.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockOpenHandoverSessionDone returns true if the count of the OpenHandoverSession invocations corresponds
+// the number of defined expectations
+func (m *IHandoverUseCaseMock) MinimockOpenHandoverSessionDone() bool {
+	if m.OpenHandoverSessionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.OpenHandoverSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.OpenHandoverSessionMock.invocationsDone()
+}
+
+// MinimockOpenHandoverSessionInspect logs each unmet expectation
+func (m *IHandoverUseCaseMock) MinimockOpenHandoverSessionInspect() {
+	for _, e := range m.OpenHandoverSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IHandoverUseCaseMock.OpenHandoverSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterOpenHandoverSessionCounter := mm_atomic.LoadUint64(&m.afterOpenHandoverSessionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.OpenHandoverSessionMock.defaultExpectation != nil && afterOpenHandoverSessionCounter < 1 {
+		if m.OpenHandoverSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IHandoverUseCaseMock.OpenHandoverSession at\n%s", m.OpenHandoverSessionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IHandoverUseCaseMock.OpenHandoverSession at\n%s with params: %#v", m.OpenHandoverSessionMock.defaultExpectation.expectationOrigins.origin, *m.OpenHandoverSessionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcOpenHandoverSession != nil && afterOpenHandoverSessionCounter < 1 {
+		m.t.Errorf("Expected call to IHandoverUseCaseMock.OpenHandoverSession at\n%s", m.funcOpenHandoverSessionOrigin)
+	}
+
+	if !m.OpenHandoverSessionMock.invocationsDone() && afterOpenHandoverSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to IHandoverUseCaseMock.OpenHandoverSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.OpenHandoverSessionMock.expectedInvocations), m.OpenHandoverSessionMock.expectedInvocationsOrigin, afterOpenHandoverSessionCounter)
+	}
+}
+
+type mIHandoverUseCaseMockScanHandoverParcel struct {
+	optional           bool
+	mock               *IHandoverUseCaseMock
+	defaultExpectation *IHandoverUseCaseMockScanHandoverParcelExpectation
+	expectations       []*IHandoverUseCaseMockScanHandoverParcelExpectation
+
+	callArgs []*IHandoverUseCaseMockScanHandoverParcelParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IHandoverUseCaseMockScanHandoverParcelExpectation specifies expectation struct of the IHandoverUseCase.ScanHandoverParcel
+type IHandoverUseCaseMockScanHandoverParcelExpectation struct {
+	mock               *IHandoverUseCaseMock
+	params             *IHandoverUseCaseMockScanHandoverParcelParams
+	paramPtrs          *IHandoverUseCaseMockScanHandoverParcelParamPtrs
+	expectationOrigins IHandoverUseCaseMockScanHandoverParcelExpectationOrigins
+	results            *IHandoverUseCaseMockScanHandoverParcelResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IHandoverUseCaseMockScanHandoverParcelParams contains parameters of the IHandoverUseCase.ScanHandoverParcel
+type IHandoverUseCaseMockScanHandoverParcelParams struct {
+	ctx            context.Context
+	sessionID      string
+	orderID        string
+	measuredWeight int
+}
+
+// IHandoverUseCaseMockScanHandoverParcelParamPtrs contains pointers to parameters of the IHandoverUseCase.ScanHandoverParcel
+type IHandoverUseCaseMockScanHandoverParcelParamPtrs struct {
+	ctx            *context.Context
+	sessionID      *string
+	orderID        *string
+	measuredWeight *int
+}
+
+// IHandoverUseCaseMockScanHandoverParcelResults contains results of the IHandoverUseCase.ScanHandoverParcel
+type IHandoverUseCaseMockScanHandoverParcelResults struct {
+	h1  domain.HandoverScan
+	err error
+}
+
+// IHandoverUseCaseMockScanHandoverParcelOrigins contains origins of expectations of the IHandoverUseCase.ScanHandoverParcel
+type IHandoverUseCaseMockScanHandoverParcelExpectationOrigins struct {
+	origin               string
+	originCtx            string
+	originSessionID      string
+	originOrderID        string
+	originMeasuredWeight string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmScanHandoverParcel *mIHandoverUseCaseMockScanHandoverParcel) Optional() *mIHandoverUseCaseMockScanHandoverParcel {
+	mmScanHandoverParcel.optional = true
+	return mmScanHandoverParcel
+}
+
+// Expect sets up expected params for IHandoverUseCase.ScanHandoverParcel
+func (mmScanHandoverParcel *mIHandoverUseCaseMockScanHandoverParcel) Expect(ctx context.Context, sessionID string, orderID string, measuredWeight int) *mIHandoverUseCaseMockScanHandoverParcel {
+	if mmScanHandoverParcel.mock.funcScanHandoverParcel != nil {
+		mmScanHandoverParcel.mock.t.Fatalf("IHandoverUseCaseMock.ScanHandoverParcel mock is already set by Set")
+	}
+
+	if mmScanHandoverParcel.defaultExpectation == nil {
+		mmScanHandoverParcel.defaultExpectation = &IHandoverUseCaseMockScanHandoverParcelExpectation{}
+	}
+
+	if mmScanHandoverParcel.defaultExpectation.paramPtrs != nil {
+		mmScanHandoverParcel.mock.t.Fatalf("IHandoverUseCaseMock.ScanHandoverParcel mock is already set by ExpectParams functions")
+	}
+
+	mmScanHandoverParcel.defaultExpectation.params = &IHandoverUseCaseMockScanHandoverParcelParams{ctx, sessionID, orderID, measuredWeight}
+	mmScanHandoverParcel.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmScanHandoverParcel.expectations {
+		if minimock.Equal(e.params, mmScanHandoverParcel.defaultExpectation.params) {
+			mmScanHandoverParcel.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmScanHandoverParcel.defaultExpectation.params)
+		}
+	}
+
+	return mmScanHandoverParcel
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IHandoverUseCase.ScanHandoverParcel
+func (mmScanHandoverParcel *mIHandoverUseCaseMockScanHandoverParcel) ExpectCtxParam1(ctx context.Context) *mIHandoverUseCaseMockScanHandoverParcel {
+	if mmScanHandoverParcel.mock.funcScanHandoverParcel != nil {
+		mmScanHandoverParcel.mock.t.Fatalf("IHandoverUseCaseMock.ScanHandoverParcel mock is already set by Set")
+	}
+
+	if mmScanHandoverParcel.defaultExpectation == nil {
+		mmScanHandoverParcel.defaultExpectation = &IHandoverUseCaseMockScanHandoverParcelExpectation{}
+	}
+
+	if mmScanHandoverParcel.defaultExpectation.params != nil {
+		mmScanHandoverParcel.mock.t.Fatalf("IHandoverUseCaseMock.ScanHandoverParcel mock is already set by Expect")
+	}
+
+	if mmScanHandoverParcel.defaultExpectation.paramPtrs == nil {
+		mmScanHandoverParcel.defaultExpectation.paramPtrs = &IHandoverUseCaseMockScanHandoverParcelParamPtrs{}
+	}
+	mmScanHandoverParcel.defaultExpectation.paramPtrs.ctx = &ctx
+	mmScanHandoverParcel.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmScanHandoverParcel
+}
+
+// ExpectSessionIDParam2 sets up expected param sessionID for IHandoverUseCase.ScanHandoverParcel
+func (mmScanHandoverParcel *mIHandoverUseCaseMockScanHandoverParcel) ExpectSessionIDParam2(sessionID string) *mIHandoverUseCaseMockScanHandoverParcel {
+	if mmScanHandoverParcel.mock.funcScanHandoverParcel != nil {
+		mmScanHandoverParcel.mock.t.Fatalf("IHandoverUseCaseMock.ScanHandoverParcel mock is already set by Set")
+	}
+
+	if mmScanHandoverParcel.defaultExpectation == nil {
+		mmScanHandoverParcel.defaultExpectation = &IHandoverUseCaseMockScanHandoverParcelExpectation{}
+	}
+
+	if mmScanHandoverParcel.defaultExpectation.params != nil {
+		mmScanHandoverParcel.mock.t.Fatalf("IHandoverUseCaseMock.ScanHandoverParcel mock is already set by Expect")
+	}
+
+	if mmScanHandoverParcel.defaultExpectation.paramPtrs == nil {
+		mmScanHandoverParcel.defaultExpectation.paramPtrs = &IHandoverUseCaseMockScanHandoverParcelParamPtrs{}
+	}
+	mmScanHandoverParcel.defaultExpectation.paramPtrs.sessionID = &sessionID
+	mmScanHandoverParcel.defaultExpectation.expectationOrigins.originSessionID = minimock.CallerInfo(1)
+
+	return mmScanHandoverParcel
+}
+
+// ExpectOrderIDParam3 sets up expected param orderID for IHandoverUseCase.ScanHandoverParcel
+func (mmScanHandoverParcel *mIHandoverUseCaseMockScanHandoverParcel) ExpectOrderIDParam3(orderID string) *mIHandoverUseCaseMockScanHandoverParcel {
+	if mmScanHandoverParcel.mock.funcScanHandoverParcel != nil {
+		mmScanHandoverParcel.mock.t.Fatalf("IHandoverUseCaseMock.ScanHandoverParcel mock is already set by Set")
+	}
+
+	if mmScanHandoverParcel.defaultExpectation == nil {
+		mmScanHandoverParcel.defaultExpectation = &IHandoverUseCaseMockScanHandoverParcelExpectation{}
+	}
+
+	if mmScanHandoverParcel.defaultExpectation.params != nil {
+		mmScanHandoverParcel.mock.t.Fatalf("IHandoverUseCaseMock.ScanHandoverParcel mock is already set by Expect")
+	}
+
+	if mmScanHandoverParcel.defaultExpectation.paramPtrs == nil {
+		mmScanHandoverParcel.defaultExpectation.paramPtrs = &IHandoverUseCaseMockScanHandoverParcelParamPtrs{}
+	}
+	mmScanHandoverParcel.defaultExpectation.paramPtrs.orderID = &orderID
+	mmScanHandoverParcel.defaultExpectation.expectationOrigins.originOrderID = minimock.CallerInfo(1)
+
+	return mmScanHandoverParcel
+}
+
+// ExpectMeasuredWeightParam4 sets up expected param measuredWeight for IHandoverUseCase.ScanHandoverParcel
+func (mmScanHandoverParcel *mIHandoverUseCaseMockScanHandoverParcel) ExpectMeasuredWeightParam4(measuredWeight int) *mIHandoverUseCaseMockScanHandoverParcel {
+	if mmScanHandoverParcel.mock.funcScanHandoverParcel != nil {
+		mmScanHandoverParcel.mock.t.Fatalf("IHandoverUseCaseMock.ScanHandoverParcel mock is already set by Set")
+	}
+
+	if mmScanHandoverParcel.defaultExpectation == nil {
+		mmScanHandoverParcel.defaultExpectation = &IHandoverUseCaseMockScanHandoverParcelExpectation{}
+	}
+
+	if mmScanHandoverParcel.defaultExpectation.params != nil {
+		mmScanHandoverParcel.mock.t.Fatalf("IHandoverUseCaseMock.ScanHandoverParcel mock is already set by Expect")
+	}
+
+	if mmScanHandoverParcel.defaultExpectation.paramPtrs == nil {
+		mmScanHandoverParcel.defaultExpectation.paramPtrs = &IHandoverUseCaseMockScanHandoverParcelParamPtrs{}
+	}
+	mmScanHandoverParcel.defaultExpectation.paramPtrs.measuredWeight = &measuredWeight
+	mmScanHandoverParcel.defaultExpectation.expectationOrigins.originMeasuredWeight = minimock.CallerInfo(1)
+
+	return mmScanHandoverParcel
+}
+
+// Inspect accepts an inspector function that has same arguments as the IHandoverUseCase.ScanHandoverParcel
+func (mmScanHandoverParcel *mIHandoverUseCaseMockScanHandoverParcel) Inspect(f func(ctx context.Context, sessionID string, orderID string, measuredWeight int)) *mIHandoverUseCaseMockScanHandoverParcel {
+	if mmScanHandoverParcel.mock.inspectFuncScanHandoverParcel != nil {
+		mmScanHandoverParcel.mock.t.Fatalf("Inspect function is already set for IHandoverUseCaseMock.ScanHandoverParcel")
+	}
+
+	mmScanHandoverParcel.mock.inspectFuncScanHandoverParcel = f
+
+	return mmScanHandoverParcel
+}
+
+// Return sets up results that will be returned by IHandoverUseCase.ScanHandoverParcel
+func (mmScanHandoverParcel *mIHandoverUseCaseMockScanHandoverParcel) Return(h1 domain.HandoverScan, err error) *IHandoverUseCaseMock {
+	if mmScanHandoverParcel.mock.funcScanHandoverParcel != nil {
+		mmScanHandoverParcel.mock.t.Fatalf("IHandoverUseCaseMock.ScanHandoverParcel mock is already set by Set")
+	}
+
+	if mmScanHandoverParcel.defaultExpectation == nil {
+		mmScanHandoverParcel.defaultExpectation = &IHandoverUseCaseMockScanHandoverParcelExpectation{mock: mmScanHandoverParcel.mock}
+	}
+	mmScanHandoverParcel.defaultExpectation.results = &IHandoverUseCaseMockScanHandoverParcelResults{h1, err}
+	mmScanHandoverParcel.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmScanHandoverParcel.mock
+}
+
+// Set uses given function f to mock the IHandoverUseCase.ScanHandoverParcel method
+func (mmScanHandoverParcel *mIHandoverUseCaseMockScanHandoverParcel) Set(f func(ctx context.Context, sessionID string, orderID string, measuredWeight int) (h1 domain.HandoverScan, err error)) *IHandoverUseCaseMock {
+	if mmScanHandoverParcel.defaultExpectation != nil {
+		mmScanHandoverParcel.mock.t.Fatalf("Default expectation is already set for the IHandoverUseCase.ScanHandoverParcel method")
+	}
+
+	if len(mmScanHandoverParcel.expectations) > 0 {
+		mmScanHandoverParcel.mock.t.Fatalf("Some expectations are already set for the IHandoverUseCase.ScanHandoverParcel method")
+	}
+
+	mmScanHandoverParcel.mock.funcScanHandoverParcel = f
+	mmScanHandoverParcel.mock.funcScanHandoverParcelOrigin = minimock.CallerInfo(1)
+	return mmScanHandoverParcel.mock
+}
+
+// When sets expectation for the IHandoverUseCase.ScanHandoverParcel which will trigger the result defined by the following
+// Then helper
+func (mmScanHandoverParcel *mIHandoverUseCaseMockScanHandoverParcel) When(ctx context.Context, sessionID string, orderID string, measuredWeight int) *IHandoverUseCaseMockScanHandoverParcelExpectation {
+	if mmScanHandoverParcel.mock.funcScanHandoverParcel != nil {
+		mmScanHandoverParcel.mock.t.Fatalf("IHandoverUseCaseMock.ScanHandoverParcel mock is already set by Set")
+	}
+
+	expectation := &IHandoverUseCaseMockScanHandoverParcelExpectation{
+		mock:               mmScanHandoverParcel.mock,
+		params:             &IHandoverUseCaseMockScanHandoverParcelParams{ctx, sessionID, orderID, measuredWeight},
+		expectationOrigins: IHandoverUseCaseMockScanHandoverParcelExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmScanHandoverParcel.expectations = append(mmScanHandoverParcel.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IHandoverUseCase.ScanHandoverParcel return parameters for the expectation previously defined by the When method
+func (e *IHandoverUseCaseMockScanHandoverParcelExpectation) Then(h1 domain.HandoverScan, err error) *IHandoverUseCaseMock {
+	e.results = &IHandoverUseCaseMockScanHandoverParcelResults{h1, err}
+	return e.mock
+}
+
+// Times sets number of times IHandoverUseCase.ScanHandoverParcel should be invoked
+func (mmScanHandoverParcel *mIHandoverUseCaseMockScanHandoverParcel) Times(n uint64) *mIHandoverUseCaseMockScanHandoverParcel {
+	if n == 0 {
+		mmScanHandoverParcel.mock.t.Fatalf("Times of IHandoverUseCaseMock.ScanHandoverParcel mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmScanHandoverParcel.expectedInvocations, n)
+	mmScanHandoverParcel.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmScanHandoverParcel
+}
+
+func (mmScanHandoverParcel *mIHandoverUseCaseMockScanHandoverParcel) invocationsDone() bool {
+	if len(mmScanHandoverParcel.expectations) == 0 && mmScanHandoverParcel.defaultExpectation == nil && mmScanHandoverParcel.mock.funcScanHandoverParcel == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmScanHandoverParcel.mock.afterScanHandoverParcelCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmScanHandoverParcel.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ScanHandoverParcel implements mm_abstractions.IHandoverUseCase
+func (mmScanHandoverParcel *IHandoverUseCaseMock) ScanHandoverParcel(ctx context.Context, sessionID string, orderID string, measuredWeight int) (h1 domain.HandoverScan, err error) {
+	mm_atomic.AddUint64(&mmScanHandoverParcel.beforeScanHandoverParcelCounter, 1)
+	defer mm_atomic.AddUint64(&mmScanHandoverParcel.afterScanHandoverParcelCounter, 1)
+
+	mmScanHandoverParcel.t.Helper()
+
+	if mmScanHandoverParcel.inspectFuncScanHandoverParcel != nil {
+		mmScanHandoverParcel.inspectFuncScanHandoverParcel(ctx, sessionID, orderID, measuredWeight)
+	}
+
+	mm_params := IHandoverUseCaseMockScanHandoverParcelParams{ctx, sessionID, orderID, measuredWeight}
+
+	// Record call args
+	mmScanHandoverParcel.ScanHandoverParcelMock.mutex.Lock()
+	mmScanHandoverParcel.ScanHandoverParcelMock.callArgs = append(mmScanHandoverParcel.ScanHandoverParcelMock.callArgs, &mm_params)
+	mmScanHandoverParcel.ScanHandoverParcelMock.mutex.Unlock()
+
+	for _, e := range mmScanHandoverParcel.ScanHandoverParcelMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.h1, e.results.err
+		}
+	}
+
+	if mmScanHandoverParcel.ScanHandoverParcelMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmScanHandoverParcel.ScanHandoverParcelMock.defaultExpectation.Counter, 1)
+		mm_want := mmScanHandoverParcel.ScanHandoverParcelMock.defaultExpectation.params
+		mm_want_ptrs := mmScanHandoverParcel.ScanHandoverParcelMock.defaultExpectation.paramPtrs
+
+		mm_got := IHandoverUseCaseMockScanHandoverParcelParams{ctx, sessionID, orderID, measuredWeight}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmScanHandoverParcel.t.Errorf("IHandoverUseCaseMock.ScanHandoverParcel got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmScanHandoverParcel.ScanHandoverParcelMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.sessionID != nil && !minimock.Equal(*mm_want_ptrs.sessionID, mm_got.sessionID) {
+				mmScanHandoverParcel.t.Errorf("IHandoverUseCaseMock.ScanHandoverParcel got unexpected parameter sessionID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmScanHandoverParcel.ScanHandoverParcelMock.defaultExpectation.expectationOrigins.originSessionID, *mm_want_ptrs.sessionID, mm_got.sessionID, minimock.Diff(*mm_want_ptrs.sessionID, mm_got.sessionID))
+			}
+
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmScanHandoverParcel.t.Errorf("IHandoverUseCaseMock.ScanHandoverParcel got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmScanHandoverParcel.ScanHandoverParcelMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			}
+
+			if mm_want_ptrs.measuredWeight != nil && !minimock.Equal(*mm_want_ptrs.measuredWeight, mm_got.measuredWeight) {
+				mmScanHandoverParcel.t.Errorf("IHandoverUseCaseMock.ScanHandoverParcel got unexpected parameter measuredWeight, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmScanHandoverParcel.ScanHandoverParcelMock.defaultExpectation.expectationOrigins.originMeasuredWeight, *mm_want_ptrs.measuredWeight, mm_got.measuredWeight, minimock.Diff(*mm_want_ptrs.measuredWeight, mm_got.measuredWeight))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmScanHandoverParcel.t.Errorf("IHandoverUseCaseMock.ScanHandoverParcel got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmScanHandoverParcel.ScanHandoverParcelMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmScanHandoverParcel.ScanHandoverParcelMock.defaultExpectation.results
+		if mm_results == nil {
+			mmScanHandoverParcel.t.Fatal("No results are set for the IHandoverUseCaseMock.ScanHandoverParcel")
+		}
+		return (*mm_results).h1, (*mm_results).err
+	}
+	if mmScanHandoverParcel.funcScanHandoverParcel != nil {
+		return mmScanHandoverParcel.funcScanHandoverParcel(ctx, sessionID, orderID, measuredWeight)
+	}
+	mmScanHandoverParcel.t.Fatalf("Unexpected call to IHandoverUseCaseMock.ScanHandoverParcel. %v %v %v %v", ctx, sessionID, orderID, measuredWeight)
+	return
+}
+
+// ScanHandoverParcelAfterCounter returns a count of finished IHandoverUseCaseMock.ScanHandoverParcel invocations
+func (mmScanHandoverParcel *IHandoverUseCaseMock) ScanHandoverParcelAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScanHandoverParcel.afterScanHandoverParcelCounter)
+}
+
+// ScanHandoverParcelBeforeCounter returns a count of IHandoverUseCaseMock.ScanHandoverParcel invocations
+func (mmScanHandoverParcel *IHandoverUseCaseMock) ScanHandoverParcelBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmScanHandoverParcel.beforeScanHandoverParcelCounter)
+}
+
+// Calls returns a list of arguments used in each call to IHandoverUseCaseMock.ScanHandoverParcel.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmScanHandoverParcel *mIHandoverUseCaseMockScanHandoverParcel) Calls() []*IHandoverUseCaseMockScanHandoverParcelParams {
+	mmScanHandoverParcel.mutex.RLock()
+
+	argCopy := make([]*IHandoverUseCaseMockScanHandoverParcelParams, len(mmScanHandoverParcel.callArgs))
+	copy(argCopy, mmScanHandoverParcel.callArgs)
+
+	mmScanHandoverParcel.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockScanHandoverParcelDone returns true if the count of the ScanHandoverParcel invocations corresponds
+// the number of defined expectations
+func (m *IHandoverUseCaseMock) MinimockScanHandoverParcelDone() bool {
+	if m.ScanHandoverParcelMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ScanHandoverParcelMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ScanHandoverParcelMock.invocationsDone()
+}
+
+// MinimockScanHandoverParcelInspect logs each unmet expectation
+func (m *IHandoverUseCaseMock) MinimockScanHandoverParcelInspect() {
+	for _, e := range m.ScanHandoverParcelMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IHandoverUseCaseMock.ScanHandoverParcel at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterScanHandoverParcelCounter := mm_atomic.LoadUint64(&m.afterScanHandoverParcelCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ScanHandoverParcelMock.defaultExpectation != nil && afterScanHandoverParcelCounter < 1 {
+		if m.ScanHandoverParcelMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IHandoverUseCaseMock.ScanHandoverParcel at\n%s", m.ScanHandoverParcelMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IHandoverUseCaseMock.ScanHandoverParcel at\n%s with params: %#v", m.ScanHandoverParcelMock.defaultExpectation.expectationOrigins.origin, *m.ScanHandoverParcelMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcScanHandoverParcel != nil && afterScanHandoverParcelCounter < 1 {
+		m.t.Errorf("Expected call to IHandoverUseCaseMock.ScanHandoverParcel at\n%s", m.funcScanHandoverParcelOrigin)
+	}
+
+	if !m.ScanHandoverParcelMock.invocationsDone() && afterScanHandoverParcelCounter > 0 {
+		m.t.Errorf("Expected %d calls to IHandoverUseCaseMock.ScanHandoverParcel at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ScanHandoverParcelMock.expectedInvocations), m.ScanHandoverParcelMock.expectedInvocationsOrigin, afterScanHandoverParcelCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *IHandoverUseCaseMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCloseHandoverSessionInspect()
+
+			m.MinimockGetHandoverSessionInspect()
+
+			m.MinimockOpenHandoverSessionInspect()
+
+			m.MinimockScanHandoverParcelInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *IHandoverUseCaseMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *IHandoverUseCaseMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCloseHandoverSessionDone() &&
+		m.MinimockGetHandoverSessionDone() &&
+		m.MinimockOpenHandoverSessionDone() &&
+		m.MinimockScanHandoverParcelDone()
+}
@@ -0,0 +1,1159 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"homework/internal/domain"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// IReturnShipmentUseCaseMock implements mm_abstractions.IReturnShipmentUseCase
+type IReturnShipmentUseCaseMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateReturnShipment          func(ctx context.Context, orderIDs []string) (r1 domain.ReturnShipment, err error)
+	funcCreateReturnShipmentOrigin    string
+	inspectFuncCreateReturnShipment   func(ctx context.Context, orderIDs []string)
+	afterCreateReturnShipmentCounter  uint64
+	beforeCreateReturnShipmentCounter uint64
+	CreateReturnShipmentMock          mIReturnShipmentUseCaseMockCreateReturnShipment
+
+	funcDispatchReturnShipment          func(ctx context.Context, shipmentID string, courierID string) (r1 domain.ReturnManifest, err error)
+	funcDispatchReturnShipmentOrigin    string
+	inspectFuncDispatchReturnShipment   func(ctx context.Context, shipmentID string, courierID string)
+	afterDispatchReturnShipmentCounter  uint64
+	beforeDispatchReturnShipmentCounter uint64
+	DispatchReturnShipmentMock          mIReturnShipmentUseCaseMockDispatchReturnShipment
+
+	funcGetReturnShipment          func(ctx context.Context, shipmentID string) (r1 domain.ReturnShipment, err error)
+	funcGetReturnShipmentOrigin    string
+	inspectFuncGetReturnShipment   func(ctx context.Context, shipmentID string)
+	afterGetReturnShipmentCounter  uint64
+	beforeGetReturnShipmentCounter uint64
+	GetReturnShipmentMock          mIReturnShipmentUseCaseMockGetReturnShipment
+}
+
+// NewIReturnShipmentUseCaseMock returns a mock for mm_abstractions.IReturnShipmentUseCase
+func NewIReturnShipmentUseCaseMock(t minimock.Tester) *IReturnShipmentUseCaseMock {
+	m := &IReturnShipmentUseCaseMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateReturnShipmentMock = mIReturnShipmentUseCaseMockCreateReturnShipment{mock: m}
+	m.CreateReturnShipmentMock.callArgs = []*IReturnShipmentUseCaseMockCreateReturnShipmentParams{}
+
+	m.DispatchReturnShipmentMock = mIReturnShipmentUseCaseMockDispatchReturnShipment{mock: m}
+	m.DispatchReturnShipmentMock.callArgs = []*IReturnShipmentUseCaseMockDispatchReturnShipmentParams{}
+
+	m.GetReturnShipmentMock = mIReturnShipmentUseCaseMockGetReturnShipment{mock: m}
+	m.GetReturnShipmentMock.callArgs = []*IReturnShipmentUseCaseMockGetReturnShipmentParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mIReturnShipmentUseCaseMockCreateReturnShipment struct {
+	optional           bool
+	mock               *IReturnShipmentUseCaseMock
+	defaultExpectation *IReturnShipmentUseCaseMockCreateReturnShipmentExpectation
+	expectations       []*IReturnShipmentUseCaseMockCreateReturnShipmentExpectation
+
+	callArgs []*IReturnShipmentUseCaseMockCreateReturnShipmentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IReturnShipmentUseCaseMockCreateReturnShipmentExpectation specifies expectation struct of the IReturnShipmentUseCase.CreateReturnShipment
+type IReturnShipmentUseCaseMockCreateReturnShipmentExpectation struct {
+	mock               *IReturnShipmentUseCaseMock
+	params             *IReturnShipmentUseCaseMockCreateReturnShipmentParams
+	paramPtrs          *IReturnShipmentUseCaseMockCreateReturnShipmentParamPtrs
+	expectationOrigins IReturnShipmentUseCaseMockCreateReturnShipmentExpectationOrigins
+	results            *IReturnShipmentUseCaseMockCreateReturnShipmentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IReturnShipmentUseCaseMockCreateReturnShipmentParams contains parameters of the IReturnShipmentUseCase.CreateReturnShipment
+type IReturnShipmentUseCaseMockCreateReturnShipmentParams struct {
+	ctx      context.Context
+	orderIDs []string
+}
+
+// IReturnShipmentUseCaseMockCreateReturnShipmentParamPtrs contains pointers to parameters of the IReturnShipmentUseCase.CreateReturnShipment
+type IReturnShipmentUseCaseMockCreateReturnShipmentParamPtrs struct {
+	ctx      *context.Context
+	orderIDs *[]string
+}
+
+// IReturnShipmentUseCaseMockCreateReturnShipmentResults contains results of the IReturnShipmentUseCase.CreateReturnShipment
+type IReturnShipmentUseCaseMockCreateReturnShipmentResults struct {
+	r1  domain.ReturnShipment
+	err error
+}
+
+// IReturnShipmentUseCaseMockCreateReturnShipmentOrigins contains origins of expectations of the IReturnShipmentUseCase.CreateReturnShipment
+type IReturnShipmentUseCaseMockCreateReturnShipmentExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originOrderIDs string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateReturnShipment *mIReturnShipmentUseCaseMockCreateReturnShipment) Optional() *mIReturnShipmentUseCaseMockCreateReturnShipment {
+	mmCreateReturnShipment.optional = true
+	return mmCreateReturnShipment
+}
+
+// Expect sets up expected params for IReturnShipmentUseCase.CreateReturnShipment
+func (mmCreateReturnShipment *mIReturnShipmentUseCaseMockCreateReturnShipment) Expect(ctx context.Context, orderIDs []string) *mIReturnShipmentUseCaseMockCreateReturnShipment {
+	if mmCreateReturnShipment.mock.funcCreateReturnShipment != nil {
+		mmCreateReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.CreateReturnShipment mock is already set by Set")
+	}
+
+	if mmCreateReturnShipment.defaultExpectation == nil {
+		mmCreateReturnShipment.defaultExpectation = &IReturnShipmentUseCaseMockCreateReturnShipmentExpectation{}
+	}
+
+	if mmCreateReturnShipment.defaultExpectation.paramPtrs != nil {
+		mmCreateReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.CreateReturnShipment mock is already set by ExpectParams functions")
+	}
+
+	mmCreateReturnShipment.defaultExpectation.params = &IReturnShipmentUseCaseMockCreateReturnShipmentParams{ctx, orderIDs}
+	mmCreateReturnShipment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateReturnShipment.expectations {
+		if minimock.Equal(e.params, mmCreateReturnShipment.defaultExpectation.params) {
+			mmCreateReturnShipment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateReturnShipment.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateReturnShipment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IReturnShipmentUseCase.CreateReturnShipment
+func (mmCreateReturnShipment *mIReturnShipmentUseCaseMockCreateReturnShipment) ExpectCtxParam1(ctx context.Context) *mIReturnShipmentUseCaseMockCreateReturnShipment {
+	if mmCreateReturnShipment.mock.funcCreateReturnShipment != nil {
+		mmCreateReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.CreateReturnShipment mock is already set by Set")
+	}
+
+	if mmCreateReturnShipment.defaultExpectation == nil {
+		mmCreateReturnShipment.defaultExpectation = &IReturnShipmentUseCaseMockCreateReturnShipmentExpectation{}
+	}
+
+	if mmCreateReturnShipment.defaultExpectation.params != nil {
+		mmCreateReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.CreateReturnShipment mock is already set by Expect")
+	}
+
+	if mmCreateReturnShipment.defaultExpectation.paramPtrs == nil {
+		mmCreateReturnShipment.defaultExpectation.paramPtrs = &IReturnShipmentUseCaseMockCreateReturnShipmentParamPtrs{}
+	}
+	mmCreateReturnShipment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateReturnShipment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateReturnShipment
+}
+
+// ExpectOrderIDsParam2 sets up expected param orderIDs for IReturnShipmentUseCase.CreateReturnShipment
+func (mmCreateReturnShipment *mIReturnShipmentUseCaseMockCreateReturnShipment) ExpectOrderIDsParam2(orderIDs []string) *mIReturnShipmentUseCaseMockCreateReturnShipment {
+	if mmCreateReturnShipment.mock.funcCreateReturnShipment != nil {
+		mmCreateReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.CreateReturnShipment mock is already set by Set")
+	}
+
+	if mmCreateReturnShipment.defaultExpectation == nil {
+		mmCreateReturnShipment.defaultExpectation = &IReturnShipmentUseCaseMockCreateReturnShipmentExpectation{}
+	}
+
+	if mmCreateReturnShipment.defaultExpectation.params != nil {
+		mmCreateReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.CreateReturnShipment mock is already set by Expect")
+	}
+
+	if mmCreateReturnShipment.defaultExpectation.paramPtrs == nil {
+		mmCreateReturnShipment.defaultExpectation.paramPtrs = &IReturnShipmentUseCaseMockCreateReturnShipmentParamPtrs{}
+	}
+	mmCreateReturnShipment.defaultExpectation.paramPtrs.orderIDs = &orderIDs
+	mmCreateReturnShipment.defaultExpectation.expectationOrigins.originOrderIDs = minimock.CallerInfo(1)
+
+	return mmCreateReturnShipment
+}
+
+// Inspect accepts an inspector function that has same arguments as the IReturnShipmentUseCase.CreateReturnShipment
+func (mmCreateReturnShipment *mIReturnShipmentUseCaseMockCreateReturnShipment) Inspect(f func(ctx context.Context, orderIDs []string)) *mIReturnShipmentUseCaseMockCreateReturnShipment {
+	if mmCreateReturnShipment.mock.inspectFuncCreateReturnShipment != nil {
+		mmCreateReturnShipment.mock.t.Fatalf("Inspect function is already set for IReturnShipmentUseCaseMock.CreateReturnShipment")
+	}
+
+	mmCreateReturnShipment.mock.inspectFuncCreateReturnShipment = f
+
+	return mmCreateReturnShipment
+}
+
+// Return sets up results that will be returned by IReturnShipmentUseCase.CreateReturnShipment
+func (mmCreateReturnShipment *mIReturnShipmentUseCaseMockCreateReturnShipment) Return(r1 domain.ReturnShipment, err error) *IReturnShipmentUseCaseMock {
+	if mmCreateReturnShipment.mock.funcCreateReturnShipment != nil {
+		mmCreateReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.CreateReturnShipment mock is already set by Set")
+	}
+
+	if mmCreateReturnShipment.defaultExpectation == nil {
+		mmCreateReturnShipment.defaultExpectation = &IReturnShipmentUseCaseMockCreateReturnShipmentExpectation{mock: mmCreateReturnShipment.mock}
+	}
+	mmCreateReturnShipment.defaultExpectation.results = &IReturnShipmentUseCaseMockCreateReturnShipmentResults{r1, err}
+	mmCreateReturnShipment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateReturnShipment.mock
+}
+
+// Set uses given function f to mock the IReturnShipmentUseCase.CreateReturnShipment method
+func (mmCreateReturnShipment *mIReturnShipmentUseCaseMockCreateReturnShipment) Set(f func(ctx context.Context, orderIDs []string) (r1 domain.ReturnShipment, err error)) *IReturnShipmentUseCaseMock {
+	if mmCreateReturnShipment.defaultExpectation != nil {
+		mmCreateReturnShipment.mock.t.Fatalf("Default expectation is already set for the IReturnShipmentUseCase.CreateReturnShipment method")
+	}
+
+	if len(mmCreateReturnShipment.expectations) > 0 {
+		mmCreateReturnShipment.mock.t.Fatalf("Some expectations are already set for the IReturnShipmentUseCase.CreateReturnShipment method")
+	}
+
+	mmCreateReturnShipment.mock.funcCreateReturnShipment = f
+	mmCreateReturnShipment.mock.funcCreateReturnShipmentOrigin = minimock.CallerInfo(1)
+	return mmCreateReturnShipment.mock
+}
+
+// When sets expectation for the IReturnShipmentUseCase.CreateReturnShipment which will trigger the result defined by the following
+// Then helper
+func (mmCreateReturnShipment *mIReturnShipmentUseCaseMockCreateReturnShipment) When(ctx context.Context, orderIDs []string) *IReturnShipmentUseCaseMockCreateReturnShipmentExpectation {
+	if mmCreateReturnShipment.mock.funcCreateReturnShipment != nil {
+		mmCreateReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.CreateReturnShipment mock is already set by Set")
+	}
+
+	expectation := &IReturnShipmentUseCaseMockCreateReturnShipmentExpectation{
+		mock:               mmCreateReturnShipment.mock,
+		params:             &IReturnShipmentUseCaseMockCreateReturnShipmentParams{ctx, orderIDs},
+		expectationOrigins: IReturnShipmentUseCaseMockCreateReturnShipmentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateReturnShipment.expectations = append(mmCreateReturnShipment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IReturnShipmentUseCase.CreateReturnShipment return parameters for the expectation previously defined by the When method
+func (e *IReturnShipmentUseCaseMockCreateReturnShipmentExpectation) Then(r1 domain.ReturnShipment, err error) *IReturnShipmentUseCaseMock {
+	e.results = &IReturnShipmentUseCaseMockCreateReturnShipmentResults{r1, err}
+	return e.mock
+}
+
+// Times sets number of times IReturnShipmentUseCase.CreateReturnShipment should be invoked
+func (mmCreateReturnShipment *mIReturnShipmentUseCaseMockCreateReturnShipment) Times(n uint64) *mIReturnShipmentUseCaseMockCreateReturnShipment {
+	if n == 0 {
+		mmCreateReturnShipment.mock.t.Fatalf("Times of IReturnShipmentUseCaseMock.CreateReturnShipment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateReturnShipment.expectedInvocations, n)
+	mmCreateReturnShipment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateReturnShipment
+}
+
+func (mmCreateReturnShipment *mIReturnShipmentUseCaseMockCreateReturnShipment) invocationsDone() bool {
+	if len(mmCreateReturnShipment.expectations) == 0 && mmCreateReturnShipment.defaultExpectation == nil && mmCreateReturnShipment.mock.funcCreateReturnShipment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateReturnShipment.mock.afterCreateReturnShipmentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateReturnShipment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateReturnShipment implements mm_abstractions.IReturnShipmentUseCase
+func (mmCreateReturnShipment *IReturnShipmentUseCaseMock) CreateReturnShipment(ctx context.Context, orderIDs []string) (r1 domain.ReturnShipment, err error) {
+	mm_atomic.AddUint64(&mmCreateReturnShipment.beforeCreateReturnShipmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateReturnShipment.afterCreateReturnShipmentCounter, 1)
+
+	mmCreateReturnShipment.t.Helper()
+
+	if mmCreateReturnShipment.inspectFuncCreateReturnShipment != nil {
+		mmCreateReturnShipment.inspectFuncCreateReturnShipment(ctx, orderIDs)
+	}
+
+	mm_params := IReturnShipmentUseCaseMockCreateReturnShipmentParams{ctx, orderIDs}
+
+	// Record call args
+	mmCreateReturnShipment.CreateReturnShipmentMock.mutex.Lock()
+	mmCreateReturnShipment.CreateReturnShipmentMock.callArgs = append(mmCreateReturnShipment.CreateReturnShipmentMock.callArgs, &mm_params)
+	mmCreateReturnShipment.CreateReturnShipmentMock.mutex.Unlock()
+
+	for _, e := range mmCreateReturnShipment.CreateReturnShipmentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.r1, e.results.err
+		}
+	}
+
+	if mmCreateReturnShipment.CreateReturnShipmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateReturnShipment.CreateReturnShipmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateReturnShipment.CreateReturnShipmentMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateReturnShipment.CreateReturnShipmentMock.defaultExpectation.paramPtrs
+
+		mm_got := IReturnShipmentUseCaseMockCreateReturnShipmentParams{ctx, orderIDs}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateReturnShipment.t.Errorf("IReturnShipmentUseCaseMock.CreateReturnShipment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateReturnShipment.CreateReturnShipmentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.orderIDs != nil && !minimock.Equal(*mm_want_ptrs.orderIDs, mm_got.orderIDs) {
+				mmCreateReturnShipment.t.Errorf("IReturnShipmentUseCaseMock.CreateReturnShipment got unexpected parameter orderIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateReturnShipment.CreateReturnShipmentMock.defaultExpectation.expectationOrigins.originOrderIDs, *mm_want_ptrs.orderIDs, mm_got.orderIDs, minimock.Diff(*mm_want_ptrs.orderIDs, mm_got.orderIDs))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateReturnShipment.t.Errorf("IReturnShipmentUseCaseMock.CreateReturnShipment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateReturnShipment.CreateReturnShipmentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateReturnShipment.CreateReturnShipmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateReturnShipment.t.Fatal("No results are set for the IReturnShipmentUseCaseMock.CreateReturnShipment")
+		}
+		return (*mm_results).r1, (*mm_results).err
+	}
+	if mmCreateReturnShipment.funcCreateReturnShipment != nil {
+		return mmCreateReturnShipment.funcCreateReturnShipment(ctx, orderIDs)
+	}
+	mmCreateReturnShipment.t.Fatalf("Unexpected call to IReturnShipmentUseCaseMock.CreateReturnShipment. %v %v", ctx, orderIDs)
+	return
+}
+
+// CreateReturnShipmentAfterCounter returns a count of finished IReturnShipmentUseCaseMock.CreateReturnShipment invocations
+func (mmCreateReturnShipment *IReturnShipmentUseCaseMock) CreateReturnShipmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateReturnShipment.afterCreateReturnShipmentCounter)
+}
+
+// CreateReturnShipmentBeforeCounter returns a count of IReturnShipmentUseCaseMock.CreateReturnShipment invocations
+func (mmCreateReturnShipment *IReturnShipmentUseCaseMock) CreateReturnShipmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateReturnShipment.beforeCreateReturnShipmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to IReturnShipmentUseCaseMock.CreateReturnShipment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateReturnShipment *mIReturnShipmentUseCaseMockCreateReturnShipment) Calls() []*IReturnShipmentUseCaseMockCreateReturnShipmentParams {
+	mmCreateReturnShipment.mutex.RLock()
+
+	argCopy := make([]*IReturnShipmentUseCaseMockCreateReturnShipmentParams, len(mmCreateReturnShipment.callArgs))
+	copy(argCopy, mmCreateReturnShipment.callArgs)
+
+	mmCreateReturnShipment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateReturnShipmentDone returns true if the count of the CreateReturnShipment invocations corresponds
+// the number of defined expectations
+func (m *IReturnShipmentUseCaseMock) MinimockCreateReturnShipmentDone() bool {
+	if m.CreateReturnShipmentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateReturnShipmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateReturnShipmentMock.invocationsDone()
+}
+
+// MinimockCreateReturnShipmentInspect logs each unmet expectation
+func (m *IReturnShipmentUseCaseMock) MinimockCreateReturnShipmentInspect() {
+	for _, e := range m.CreateReturnShipmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IReturnShipmentUseCaseMock.CreateReturnShipment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateReturnShipmentCounter := mm_atomic.LoadUint64(&m.afterCreateReturnShipmentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateReturnShipmentMock.defaultExpectation != nil && afterCreateReturnShipmentCounter < 1 {
+		if m.CreateReturnShipmentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IReturnShipmentUseCaseMock.CreateReturnShipment at\n%s", m.CreateReturnShipmentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IReturnShipmentUseCaseMock.CreateReturnShipment at\n%s with params: %#v", m.CreateReturnShipmentMock.defaultExpectation.expectationOrigins.origin, *m.CreateReturnShipmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateReturnShipment != nil && afterCreateReturnShipmentCounter < 1 {
+		m.t.Errorf("Expected call to IReturnShipmentUseCaseMock.CreateReturnShipment at\n%s", m.funcCreateReturnShipmentOrigin)
+	}
+
+	if !m.CreateReturnShipmentMock.invocationsDone() && afterCreateReturnShipmentCounter > 0 {
+		m.t.Errorf("Expected %d calls to IReturnShipmentUseCaseMock.CreateReturnShipment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateReturnShipmentMock.expectedInvocations), m.CreateReturnShipmentMock.expectedInvocationsOrigin, afterCreateReturnShipmentCounter)
+	}
+}
+
+type mIReturnShipmentUseCaseMockDispatchReturnShipment struct {
+	optional           bool
+	mock               *IReturnShipmentUseCaseMock
+	defaultExpectation *IReturnShipmentUseCaseMockDispatchReturnShipmentExpectation
+	expectations       []*IReturnShipmentUseCaseMockDispatchReturnShipmentExpectation
+
+	callArgs []*IReturnShipmentUseCaseMockDispatchReturnShipmentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IReturnShipmentUseCaseMockDispatchReturnShipmentExpectation specifies expectation struct of the IReturnShipmentUseCase.DispatchReturnShipment
+type IReturnShipmentUseCaseMockDispatchReturnShipmentExpectation struct {
+	mock               *IReturnShipmentUseCaseMock
+	params             *IReturnShipmentUseCaseMockDispatchReturnShipmentParams
+	paramPtrs          *IReturnShipmentUseCaseMockDispatchReturnShipmentParamPtrs
+	expectationOrigins IReturnShipmentUseCaseMockDispatchReturnShipmentExpectationOrigins
+	results            *IReturnShipmentUseCaseMockDispatchReturnShipmentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IReturnShipmentUseCaseMockDispatchReturnShipmentParams contains parameters of the IReturnShipmentUseCase.DispatchReturnShipment
+type IReturnShipmentUseCaseMockDispatchReturnShipmentParams struct {
+	ctx        context.Context
+	shipmentID string
+	courierID  string
+}
+
+// IReturnShipmentUseCaseMockDispatchReturnShipmentParamPtrs contains pointers to parameters of the IReturnShipmentUseCase.DispatchReturnShipment
+type IReturnShipmentUseCaseMockDispatchReturnShipmentParamPtrs struct {
+	ctx        *context.Context
+	shipmentID *string
+	courierID  *string
+}
+
+// IReturnShipmentUseCaseMockDispatchReturnShipmentResults contains results of the IReturnShipmentUseCase.DispatchReturnShipment
+type IReturnShipmentUseCaseMockDispatchReturnShipmentResults struct {
+	r1  domain.ReturnManifest
+	err error
+}
+
+// IReturnShipmentUseCaseMockDispatchReturnShipmentOrigins contains origins of expectations of the IReturnShipmentUseCase.DispatchReturnShipment
+type IReturnShipmentUseCaseMockDispatchReturnShipmentExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originShipmentID string
+	originCourierID  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDispatchReturnShipment *mIReturnShipmentUseCaseMockDispatchReturnShipment) Optional() *mIReturnShipmentUseCaseMockDispatchReturnShipment {
+	mmDispatchReturnShipment.optional = true
+	return mmDispatchReturnShipment
+}
+
+// Expect sets up expected params for IReturnShipmentUseCase.DispatchReturnShipment
+func (mmDispatchReturnShipment *mIReturnShipmentUseCaseMockDispatchReturnShipment) Expect(ctx context.Context, shipmentID string, courierID string) *mIReturnShipmentUseCaseMockDispatchReturnShipment {
+	if mmDispatchReturnShipment.mock.funcDispatchReturnShipment != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.DispatchReturnShipment mock is already set by Set")
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation == nil {
+		mmDispatchReturnShipment.defaultExpectation = &IReturnShipmentUseCaseMockDispatchReturnShipmentExpectation{}
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation.paramPtrs != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.DispatchReturnShipment mock is already set by ExpectParams functions")
+	}
+
+	mmDispatchReturnShipment.defaultExpectation.params = &IReturnShipmentUseCaseMockDispatchReturnShipmentParams{ctx, shipmentID, courierID}
+	mmDispatchReturnShipment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDispatchReturnShipment.expectations {
+		if minimock.Equal(e.params, mmDispatchReturnShipment.defaultExpectation.params) {
+			mmDispatchReturnShipment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDispatchReturnShipment.defaultExpectation.params)
+		}
+	}
+
+	return mmDispatchReturnShipment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IReturnShipmentUseCase.DispatchReturnShipment
+func (mmDispatchReturnShipment *mIReturnShipmentUseCaseMockDispatchReturnShipment) ExpectCtxParam1(ctx context.Context) *mIReturnShipmentUseCaseMockDispatchReturnShipment {
+	if mmDispatchReturnShipment.mock.funcDispatchReturnShipment != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.DispatchReturnShipment mock is already set by Set")
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation == nil {
+		mmDispatchReturnShipment.defaultExpectation = &IReturnShipmentUseCaseMockDispatchReturnShipmentExpectation{}
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation.params != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.DispatchReturnShipment mock is already set by Expect")
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation.paramPtrs == nil {
+		mmDispatchReturnShipment.defaultExpectation.paramPtrs = &IReturnShipmentUseCaseMockDispatchReturnShipmentParamPtrs{}
+	}
+	mmDispatchReturnShipment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDispatchReturnShipment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDispatchReturnShipment
+}
+
+// ExpectShipmentIDParam2 sets up expected param shipmentID for IReturnShipmentUseCase.DispatchReturnShipment
+func (mmDispatchReturnShipment *mIReturnShipmentUseCaseMockDispatchReturnShipment) ExpectShipmentIDParam2(shipmentID string) *mIReturnShipmentUseCaseMockDispatchReturnShipment {
+	if mmDispatchReturnShipment.mock.funcDispatchReturnShipment != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.DispatchReturnShipment mock is already set by Set")
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation == nil {
+		mmDispatchReturnShipment.defaultExpectation = &IReturnShipmentUseCaseMockDispatchReturnShipmentExpectation{}
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation.params != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.DispatchReturnShipment mock is already set by Expect")
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation.paramPtrs == nil {
+		mmDispatchReturnShipment.defaultExpectation.paramPtrs = &IReturnShipmentUseCaseMockDispatchReturnShipmentParamPtrs{}
+	}
+	mmDispatchReturnShipment.defaultExpectation.paramPtrs.shipmentID = &shipmentID
+	mmDispatchReturnShipment.defaultExpectation.expectationOrigins.originShipmentID = minimock.CallerInfo(1)
+
+	return mmDispatchReturnShipment
+}
+
+// ExpectCourierIDParam3 sets up expected param courierID for IReturnShipmentUseCase.DispatchReturnShipment
+func (mmDispatchReturnShipment *mIReturnShipmentUseCaseMockDispatchReturnShipment) ExpectCourierIDParam3(courierID string) *mIReturnShipmentUseCaseMockDispatchReturnShipment {
+	if mmDispatchReturnShipment.mock.funcDispatchReturnShipment != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.DispatchReturnShipment mock is already set by Set")
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation == nil {
+		mmDispatchReturnShipment.defaultExpectation = &IReturnShipmentUseCaseMockDispatchReturnShipmentExpectation{}
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation.params != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.DispatchReturnShipment mock is already set by Expect")
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation.paramPtrs == nil {
+		mmDispatchReturnShipment.defaultExpectation.paramPtrs = &IReturnShipmentUseCaseMockDispatchReturnShipmentParamPtrs{}
+	}
+	mmDispatchReturnShipment.defaultExpectation.paramPtrs.courierID = &courierID
+	mmDispatchReturnShipment.defaultExpectation.expectationOrigins.originCourierID = minimock.CallerInfo(1)
+
+	return mmDispatchReturnShipment
+}
+
+// Inspect accepts an inspector function that has same arguments as the IReturnShipmentUseCase.DispatchReturnShipment
+func (mmDispatchReturnShipment *mIReturnShipmentUseCaseMockDispatchReturnShipment) Inspect(f func(ctx context.Context, shipmentID string, courierID string)) *mIReturnShipmentUseCaseMockDispatchReturnShipment {
+	if mmDispatchReturnShipment.mock.inspectFuncDispatchReturnShipment != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("Inspect function is already set for IReturnShipmentUseCaseMock.DispatchReturnShipment")
+	}
+
+	mmDispatchReturnShipment.mock.inspectFuncDispatchReturnShipment = f
+
+	return mmDispatchReturnShipment
+}
+
+// Return sets up results that will be returned by IReturnShipmentUseCase.DispatchReturnShipment
+func (mmDispatchReturnShipment *mIReturnShipmentUseCaseMockDispatchReturnShipment) Return(r1 domain.ReturnManifest, err error) *IReturnShipmentUseCaseMock {
+	if mmDispatchReturnShipment.mock.funcDispatchReturnShipment != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.DispatchReturnShipment mock is already set by Set")
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation == nil {
+		mmDispatchReturnShipment.defaultExpectation = &IReturnShipmentUseCaseMockDispatchReturnShipmentExpectation{mock: mmDispatchReturnShipment.mock}
+	}
+	mmDispatchReturnShipment.defaultExpectation.results = &IReturnShipmentUseCaseMockDispatchReturnShipmentResults{r1, err}
+	mmDispatchReturnShipment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDispatchReturnShipment.mock
+}
+
+// Set uses given function f to mock the IReturnShipmentUseCase.DispatchReturnShipment method
+func (mmDispatchReturnShipment *mIReturnShipmentUseCaseMockDispatchReturnShipment) Set(f func(ctx context.Context, shipmentID string, courierID string) (r1 domain.ReturnManifest, err error)) *IReturnShipmentUseCaseMock {
+	if mmDispatchReturnShipment.defaultExpectation != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("Default expectation is already set for the IReturnShipmentUseCase.DispatchReturnShipment method")
+	}
+
+	if len(mmDispatchReturnShipment.expectations) > 0 {
+		mmDispatchReturnShipment.mock.t.Fatalf("Some expectations are already set for the IReturnShipmentUseCase.DispatchReturnShipment method")
+	}
+
+	mmDispatchReturnShipment.mock.funcDispatchReturnShipment = f
+	mmDispatchReturnShipment.mock.funcDispatchReturnShipmentOrigin = minimock.CallerInfo(1)
+	return mmDispatchReturnShipment.mock
+}
+
+// When sets expectation for the IReturnShipmentUseCase.DispatchReturnShipment which will trigger the result defined by the following
+// Then helper
+func (mmDispatchReturnShipment *mIReturnShipmentUseCaseMockDispatchReturnShipment) When(ctx context.Context, shipmentID string, courierID string) *IReturnShipmentUseCaseMockDispatchReturnShipmentExpectation {
+	if mmDispatchReturnShipment.mock.funcDispatchReturnShipment != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.DispatchReturnShipment mock is already set by Set")
+	}
+
+	expectation := &IReturnShipmentUseCaseMockDispatchReturnShipmentExpectation{
+		mock:               mmDispatchReturnShipment.mock,
+		params:             &IReturnShipmentUseCaseMockDispatchReturnShipmentParams{ctx, shipmentID, courierID},
+		expectationOrigins: IReturnShipmentUseCaseMockDispatchReturnShipmentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDispatchReturnShipment.expectations = append(mmDispatchReturnShipment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IReturnShipmentUseCase.DispatchReturnShipment return parameters for the expectation previously defined by the When method
+func (e *IReturnShipmentUseCaseMockDispatchReturnShipmentExpectation) Then(r1 domain.ReturnManifest, err error) *IReturnShipmentUseCaseMock {
+	e.results = &IReturnShipmentUseCaseMockDispatchReturnShipmentResults{r1, err}
+	return e.mock
+}
+
+// Times sets number of times IReturnShipmentUseCase.DispatchReturnShipment should be invoked
+func (mmDispatchReturnShipment *mIReturnShipmentUseCaseMockDispatchReturnShipment) Times(n uint64) *mIReturnShipmentUseCaseMockDispatchReturnShipment {
+	if n == 0 {
+		mmDispatchReturnShipment.mock.t.Fatalf("Times of IReturnShipmentUseCaseMock.DispatchReturnShipment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDispatchReturnShipment.expectedInvocations, n)
+	mmDispatchReturnShipment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDispatchReturnShipment
+}
+
+func (mmDispatchReturnShipment *mIReturnShipmentUseCaseMockDispatchReturnShipment) invocationsDone() bool {
+	if len(mmDispatchReturnShipment.expectations) == 0 && mmDispatchReturnShipment.defaultExpectation == nil && mmDispatchReturnShipment.mock.funcDispatchReturnShipment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDispatchReturnShipment.mock.afterDispatchReturnShipmentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDispatchReturnShipment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DispatchReturnShipment implements mm_abstractions.IReturnShipmentUseCase
+func (mmDispatchReturnShipment *IReturnShipmentUseCaseMock) DispatchReturnShipment(ctx context.Context, shipmentID string, courierID string) (r1 domain.ReturnManifest, err error) {
+	mm_atomic.AddUint64(&mmDispatchReturnShipment.beforeDispatchReturnShipmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmDispatchReturnShipment.afterDispatchReturnShipmentCounter, 1)
+
+	mmDispatchReturnShipment.t.Helper()
+
+	if mmDispatchReturnShipment.inspectFuncDispatchReturnShipment != nil {
+		mmDispatchReturnShipment.inspectFuncDispatchReturnShipment(ctx, shipmentID, courierID)
+	}
+
+	mm_params := IReturnShipmentUseCaseMockDispatchReturnShipmentParams{ctx, shipmentID, courierID}
+
+	// Record call args
+	mmDispatchReturnShipment.DispatchReturnShipmentMock.mutex.Lock()
+	mmDispatchReturnShipment.DispatchReturnShipmentMock.callArgs = append(mmDispatchReturnShipment.DispatchReturnShipmentMock.callArgs, &mm_params)
+	mmDispatchReturnShipment.DispatchReturnShipmentMock.mutex.Unlock()
+
+	for _, e := range mmDispatchReturnShipment.DispatchReturnShipmentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.r1, e.results.err
+		}
+	}
+
+	if mmDispatchReturnShipment.DispatchReturnShipmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDispatchReturnShipment.DispatchReturnShipmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmDispatchReturnShipment.DispatchReturnShipmentMock.defaultExpectation.params
+		mm_want_ptrs := mmDispatchReturnShipment.DispatchReturnShipmentMock.defaultExpectation.paramPtrs
+
+		mm_got := IReturnShipmentUseCaseMockDispatchReturnShipmentParams{ctx, shipmentID, courierID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDispatchReturnShipment.t.Errorf("IReturnShipmentUseCaseMock.DispatchReturnShipment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDispatchReturnShipment.DispatchReturnShipmentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.shipmentID != nil && !minimock.Equal(*mm_want_ptrs.shipmentID, mm_got.shipmentID) {
+				mmDispatchReturnShipment.t.Errorf("IReturnShipmentUseCaseMock.DispatchReturnShipment got unexpected parameter shipmentID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDispatchReturnShipment.DispatchReturnShipmentMock.defaultExpectation.expectationOrigins.originShipmentID, *mm_want_ptrs.shipmentID, mm_got.shipmentID, minimock.Diff(*mm_want_ptrs.shipmentID, mm_got.shipmentID))
+			}
+
+			if mm_want_ptrs.courierID != nil && !minimock.Equal(*mm_want_ptrs.courierID, mm_got.courierID) {
+				mmDispatchReturnShipment.t.Errorf("IReturnShipmentUseCaseMock.DispatchReturnShipment got unexpected parameter courierID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDispatchReturnShipment.DispatchReturnShipmentMock.defaultExpectation.expectationOrigins.originCourierID, *mm_want_ptrs.courierID, mm_got.courierID, minimock.Diff(*mm_want_ptrs.courierID, mm_got.courierID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDispatchReturnShipment.t.Errorf("IReturnShipmentUseCaseMock.DispatchReturnShipment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDispatchReturnShipment.DispatchReturnShipmentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDispatchReturnShipment.DispatchReturnShipmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDispatchReturnShipment.t.Fatal("No results are set for the IReturnShipmentUseCaseMock.DispatchReturnShipment")
+		}
+		return (*mm_results).r1, (*mm_results).err
+	}
+	if mmDispatchReturnShipment.funcDispatchReturnShipment != nil {
+		return mmDispatchReturnShipment.funcDispatchReturnShipment(ctx, shipmentID, courierID)
+	}
+	mmDispatchReturnShipment.t.Fatalf("Unexpected call to IReturnShipmentUseCaseMock.DispatchReturnShipment. %v %v %v", ctx, shipmentID, courierID)
+	return
+}
+
+// DispatchReturnShipmentAfterCounter returns a count of finished IReturnShipmentUseCaseMock.DispatchReturnShipment invocations
+func (mmDispatchReturnShipment *IReturnShipmentUseCaseMock) DispatchReturnShipmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDispatchReturnShipment.afterDispatchReturnShipmentCounter)
+}
+
+// DispatchReturnShipmentBeforeCounter returns a count of IReturnShipmentUseCaseMock.DispatchReturnShipment invocations
+func (mmDispatchReturnShipment *IReturnShipmentUseCaseMock) DispatchReturnShipmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDispatchReturnShipment.beforeDispatchReturnShipmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to IReturnShipmentUseCaseMock.DispatchReturnShipment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDispatchReturnShipment *mIReturnShipmentUseCaseMockDispatchReturnShipment) Calls() []*IReturnShipmentUseCaseMockDispatchReturnShipmentParams {
+	mmDispatchReturnShipment.mutex.RLock()
+
+	argCopy := make([]*IReturnShipmentUseCaseMockDispatchReturnShipmentParams, len(mmDispatchReturnShipment.callArgs))
+	copy(argCopy, mmDispatchReturnShipment.callArgs)
+
+	mmDispatchReturnShipment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDispatchReturnShipmentDone returns true if the count of the DispatchReturnShipment invocations corresponds
+// the number of defined expectations
+func (m *IReturnShipmentUseCaseMock) MinimockDispatchReturnShipmentDone() bool {
+	if m.DispatchReturnShipmentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DispatchReturnShipmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DispatchReturnShipmentMock.invocationsDone()
+}
+
+// MinimockDispatchReturnShipmentInspect logs each unmet expectation
+func (m *IReturnShipmentUseCaseMock) MinimockDispatchReturnShipmentInspect() {
+	for _, e := range m.DispatchReturnShipmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IReturnShipmentUseCaseMock.DispatchReturnShipment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDispatchReturnShipmentCounter := mm_atomic.LoadUint64(&m.afterDispatchReturnShipmentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DispatchReturnShipmentMock.defaultExpectation != nil && afterDispatchReturnShipmentCounter < 1 {
+		if m.DispatchReturnShipmentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IReturnShipmentUseCaseMock.DispatchReturnShipment at\n%s", m.DispatchReturnShipmentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IReturnShipmentUseCaseMock.DispatchReturnShipment at\n%s with params: %#v", m.DispatchReturnShipmentMock.defaultExpectation.expectationOrigins.origin, *m.DispatchReturnShipmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDispatchReturnShipment != nil && afterDispatchReturnShipmentCounter < 1 {
+		m.t.Errorf("Expected call to IReturnShipmentUseCaseMock.DispatchReturnShipment at\n%s", m.funcDispatchReturnShipmentOrigin)
+	}
+
+	if !m.DispatchReturnShipmentMock.invocationsDone() && afterDispatchReturnShipmentCounter > 0 {
+		m.t.Errorf("Expected %d calls to IReturnShipmentUseCaseMock.DispatchReturnShipment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DispatchReturnShipmentMock.expectedInvocations), m.DispatchReturnShipmentMock.expectedInvocationsOrigin, afterDispatchReturnShipmentCounter)
+	}
+}
+
+type mIReturnShipmentUseCaseMockGetReturnShipment struct {
+	optional           bool
+	mock               *IReturnShipmentUseCaseMock
+	defaultExpectation *IReturnShipmentUseCaseMockGetReturnShipmentExpectation
+	expectations       []*IReturnShipmentUseCaseMockGetReturnShipmentExpectation
+
+	callArgs []*IReturnShipmentUseCaseMockGetReturnShipmentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IReturnShipmentUseCaseMockGetReturnShipmentExpectation specifies expectation struct of the IReturnShipmentUseCase.GetReturnShipment
+type IReturnShipmentUseCaseMockGetReturnShipmentExpectation struct {
+	mock               *IReturnShipmentUseCaseMock
+	params             *IReturnShipmentUseCaseMockGetReturnShipmentParams
+	paramPtrs          *IReturnShipmentUseCaseMockGetReturnShipmentParamPtrs
+	expectationOrigins IReturnShipmentUseCaseMockGetReturnShipmentExpectationOrigins
+	results            *IReturnShipmentUseCaseMockGetReturnShipmentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IReturnShipmentUseCaseMockGetReturnShipmentParams contains parameters of the IReturnShipmentUseCase.GetReturnShipment
+type IReturnShipmentUseCaseMockGetReturnShipmentParams struct {
+	ctx        context.Context
+	shipmentID string
+}
+
+// IReturnShipmentUseCaseMockGetReturnShipmentParamPtrs contains pointers to parameters of the IReturnShipmentUseCase.GetReturnShipment
+type IReturnShipmentUseCaseMockGetReturnShipmentParamPtrs struct {
+	ctx        *context.Context
+	shipmentID *string
+}
+
+// IReturnShipmentUseCaseMockGetReturnShipmentResults contains results of the IReturnShipmentUseCase.GetReturnShipment
+type IReturnShipmentUseCaseMockGetReturnShipmentResults struct {
+	r1  domain.ReturnShipment
+	err error
+}
+
+// IReturnShipmentUseCaseMockGetReturnShipmentOrigins contains origins of expectations of the IReturnShipmentUseCase.GetReturnShipment
+type IReturnShipmentUseCaseMockGetReturnShipmentExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originShipmentID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetReturnShipment *mIReturnShipmentUseCaseMockGetReturnShipment) Optional() *mIReturnShipmentUseCaseMockGetReturnShipment {
+	mmGetReturnShipment.optional = true
+	return mmGetReturnShipment
+}
+
+// Expect sets up expected params for IReturnShipmentUseCase.GetReturnShipment
+func (mmGetReturnShipment *mIReturnShipmentUseCaseMockGetReturnShipment) Expect(ctx context.Context, shipmentID string) *mIReturnShipmentUseCaseMockGetReturnShipment {
+	if mmGetReturnShipment.mock.funcGetReturnShipment != nil {
+		mmGetReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.GetReturnShipment mock is already set by Set")
+	}
+
+	if mmGetReturnShipment.defaultExpectation == nil {
+		mmGetReturnShipment.defaultExpectation = &IReturnShipmentUseCaseMockGetReturnShipmentExpectation{}
+	}
+
+	if mmGetReturnShipment.defaultExpectation.paramPtrs != nil {
+		mmGetReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.GetReturnShipment mock is already set by ExpectParams functions")
+	}
+
+	mmGetReturnShipment.defaultExpectation.params = &IReturnShipmentUseCaseMockGetReturnShipmentParams{ctx, shipmentID}
+	mmGetReturnShipment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetReturnShipment.expectations {
+		if minimock.Equal(e.params, mmGetReturnShipment.defaultExpectation.params) {
+			mmGetReturnShipment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetReturnShipment.defaultExpectation.params)
+		}
+	}
+
+	return mmGetReturnShipment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IReturnShipmentUseCase.GetReturnShipment
+func (mmGetReturnShipment *mIReturnShipmentUseCaseMockGetReturnShipment) ExpectCtxParam1(ctx context.Context) *mIReturnShipmentUseCaseMockGetReturnShipment {
+	if mmGetReturnShipment.mock.funcGetReturnShipment != nil {
+		mmGetReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.GetReturnShipment mock is already set by Set")
+	}
+
+	if mmGetReturnShipment.defaultExpectation == nil {
+		mmGetReturnShipment.defaultExpectation = &IReturnShipmentUseCaseMockGetReturnShipmentExpectation{}
+	}
+
+	if mmGetReturnShipment.defaultExpectation.params != nil {
+		mmGetReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.GetReturnShipment mock is already set by Expect")
+	}
+
+	if mmGetReturnShipment.defaultExpectation.paramPtrs == nil {
+		mmGetReturnShipment.defaultExpectation.paramPtrs = &IReturnShipmentUseCaseMockGetReturnShipmentParamPtrs{}
+	}
+	mmGetReturnShipment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetReturnShipment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetReturnShipment
+}
+
+// ExpectShipmentIDParam2 sets up expected param shipmentID for IReturnShipmentUseCase.GetReturnShipment
+func (mmGetReturnShipment *mIReturnShipmentUseCaseMockGetReturnShipment) ExpectShipmentIDParam2(shipmentID string) *mIReturnShipmentUseCaseMockGetReturnShipment {
+	if mmGetReturnShipment.mock.funcGetReturnShipment != nil {
+		mmGetReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.GetReturnShipment mock is already set by Set")
+	}
+
+	if mmGetReturnShipment.defaultExpectation == nil {
+		mmGetReturnShipment.defaultExpectation = &IReturnShipmentUseCaseMockGetReturnShipmentExpectation{}
+	}
+
+	if mmGetReturnShipment.defaultExpectation.params != nil {
+		mmGetReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.GetReturnShipment mock is already set by Expect")
+	}
+
+	if mmGetReturnShipment.defaultExpectation.paramPtrs == nil {
+		mmGetReturnShipment.defaultExpectation.paramPtrs = &IReturnShipmentUseCaseMockGetReturnShipmentParamPtrs{}
+	}
+	mmGetReturnShipment.defaultExpectation.paramPtrs.shipmentID = &shipmentID
+	mmGetReturnShipment.defaultExpectation.expectationOrigins.originShipmentID = minimock.CallerInfo(1)
+
+	return mmGetReturnShipment
+}
+
+// Inspect accepts an inspector function that has same arguments as the IReturnShipmentUseCase.GetReturnShipment
+func (mmGetReturnShipment *mIReturnShipmentUseCaseMockGetReturnShipment) Inspect(f func(ctx context.Context, shipmentID string)) *mIReturnShipmentUseCaseMockGetReturnShipment {
+	if mmGetReturnShipment.mock.inspectFuncGetReturnShipment != nil {
+		mmGetReturnShipment.mock.t.Fatalf("Inspect function is already set for IReturnShipmentUseCaseMock.GetReturnShipment")
+	}
+
+	mmGetReturnShipment.mock.inspectFuncGetReturnShipment = f
+
+	return mmGetReturnShipment
+}
+
+// Return sets up results that will be returned by IReturnShipmentUseCase.GetReturnShipment
+func (mmGetReturnShipment *mIReturnShipmentUseCaseMockGetReturnShipment) Return(r1 domain.ReturnShipment, err error) *IReturnShipmentUseCaseMock {
+	if mmGetReturnShipment.mock.funcGetReturnShipment != nil {
+		mmGetReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.GetReturnShipment mock is already set by Set")
+	}
+
+	if mmGetReturnShipment.defaultExpectation == nil {
+		mmGetReturnShipment.defaultExpectation = &IReturnShipmentUseCaseMockGetReturnShipmentExpectation{mock: mmGetReturnShipment.mock}
+	}
+	mmGetReturnShipment.defaultExpectation.results = &IReturnShipmentUseCaseMockGetReturnShipmentResults{r1, err}
+	mmGetReturnShipment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetReturnShipment.mock
+}
+
+// Set uses given function f to mock the IReturnShipmentUseCase.GetReturnShipment method
+func (mmGetReturnShipment *mIReturnShipmentUseCaseMockGetReturnShipment) Set(f func(ctx context.Context, shipmentID string) (r1 domain.ReturnShipment, err error)) *IReturnShipmentUseCaseMock {
+	if mmGetReturnShipment.defaultExpectation != nil {
+		mmGetReturnShipment.mock.t.Fatalf("Default expectation is already set for the IReturnShipmentUseCase.GetReturnShipment method")
+	}
+
+	if len(mmGetReturnShipment.expectations) > 0 {
+		mmGetReturnShipment.mock.t.Fatalf("Some expectations are already set for the IReturnShipmentUseCase.GetReturnShipment method")
+	}
+
+	mmGetReturnShipment.mock.funcGetReturnShipment = f
+	mmGetReturnShipment.mock.funcGetReturnShipmentOrigin = minimock.CallerInfo(1)
+	return mmGetReturnShipment.mock
+}
+
+// When sets expectation for the IReturnShipmentUseCase.GetReturnShipment which will trigger the result defined by the following
+// Then helper
+func (mmGetReturnShipment *mIReturnShipmentUseCaseMockGetReturnShipment) When(ctx context.Context, shipmentID string) *IReturnShipmentUseCaseMockGetReturnShipmentExpectation {
+	if mmGetReturnShipment.mock.funcGetReturnShipment != nil {
+		mmGetReturnShipment.mock.t.Fatalf("IReturnShipmentUseCaseMock.GetReturnShipment mock is already set by Set")
+	}
+
+	expectation := &IReturnShipmentUseCaseMockGetReturnShipmentExpectation{
+		mock:               mmGetReturnShipment.mock,
+		params:             &IReturnShipmentUseCaseMockGetReturnShipmentParams{ctx, shipmentID},
+		expectationOrigins: IReturnShipmentUseCaseMockGetReturnShipmentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetReturnShipment.expectations = append(mmGetReturnShipment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IReturnShipmentUseCase.GetReturnShipment return parameters for the expectation previously defined by the When method
+func (e *IReturnShipmentUseCaseMockGetReturnShipmentExpectation) Then(r1 domain.ReturnShipment, err error) *IReturnShipmentUseCaseMock {
+	e.results = &IReturnShipmentUseCaseMockGetReturnShipmentResults{r1, err}
+	return e.mock
+}
+
+// Times sets number of times IReturnShipmentUseCase.GetReturnShipment should be invoked
+func (mmGetReturnShipment *mIReturnShipmentUseCaseMockGetReturnShipment) Times(n uint64) *mIReturnShipmentUseCaseMockGetReturnShipment {
+	if n == 0 {
+		mmGetReturnShipment.mock.t.Fatalf("Times of IReturnShipmentUseCaseMock.GetReturnShipment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetReturnShipment.expectedInvocations, n)
+	mmGetReturnShipment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetReturnShipment
+}
+
+func (mmGetReturnShipment *mIReturnShipmentUseCaseMockGetReturnShipment) invocationsDone() bool {
+	if len(mmGetReturnShipment.expectations) == 0 && mmGetReturnShipment.defaultExpectation == nil && mmGetReturnShipment.mock.funcGetReturnShipment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetReturnShipment.mock.afterGetReturnShipmentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetReturnShipment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetReturnShipment implements mm_abstractions.IReturnShipmentUseCase
+func (mmGetReturnShipment *IReturnShipmentUseCaseMock) GetReturnShipment(ctx context.Context, shipmentID string) (r1 domain.ReturnShipment, err error) {
+	mm_atomic.AddUint64(&mmGetReturnShipment.beforeGetReturnShipmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetReturnShipment.afterGetReturnShipmentCounter, 1)
+
+	mmGetReturnShipment.t.Helper()
+
+	if mmGetReturnShipment.inspectFuncGetReturnShipment != nil {
+		mmGetReturnShipment.inspectFuncGetReturnShipment(ctx, shipmentID)
+	}
+
+	mm_params := IReturnShipmentUseCaseMockGetReturnShipmentParams{ctx, shipmentID}
+
+	// Record call args
+	mmGetReturnShipment.GetReturnShipmentMock.mutex.Lock()
+	mmGetReturnShipment.GetReturnShipmentMock.callArgs = append(mmGetReturnShipment.GetReturnShipmentMock.callArgs, &mm_params)
+	mmGetReturnShipment.GetReturnShipmentMock.mutex.Unlock()
+
+	for _, e := range mmGetReturnShipment.GetReturnShipmentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.r1, e.results.err
+		}
+	}
+
+	if mmGetReturnShipment.GetReturnShipmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetReturnShipment.GetReturnShipmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetReturnShipment.GetReturnShipmentMock.defaultExpectation.params
+		mm_want_ptrs := mmGetReturnShipment.GetReturnShipmentMock.defaultExpectation.paramPtrs
+
+		mm_got := IReturnShipmentUseCaseMockGetReturnShipmentParams{ctx, shipmentID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetReturnShipment.t.Errorf("IReturnShipmentUseCaseMock.GetReturnShipment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReturnShipment.GetReturnShipmentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.shipmentID != nil && !minimock.Equal(*mm_want_ptrs.shipmentID, mm_got.shipmentID) {
+				mmGetReturnShipment.t.Errorf("IReturnShipmentUseCaseMock.GetReturnShipment got unexpected parameter shipmentID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReturnShipment.GetReturnShipmentMock.defaultExpectation.expectationOrigins.originShipmentID, *mm_want_ptrs.shipmentID, mm_got.shipmentID, minimock.Diff(*mm_want_ptrs.shipmentID, mm_got.shipmentID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetReturnShipment.t.Errorf("IReturnShipmentUseCaseMock.GetReturnShipment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetReturnShipment.GetReturnShipmentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetReturnShipment.GetReturnShipmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetReturnShipment.t.Fatal("No results are set for the IReturnShipmentUseCaseMock.GetReturnShipment")
+		}
+		return (*mm_results).r1, (*mm_results).err
+	}
+	if mmGetReturnShipment.funcGetReturnShipment != nil {
+		return mmGetReturnShipment.funcGetReturnShipment(ctx, shipmentID)
+	}
+	mmGetReturnShipment.t.Fatalf("Unexpected call to IReturnShipmentUseCaseMock.GetReturnShipment. %v %v", ctx, shipmentID)
+	return
+}
+
+// GetReturnShipmentAfterCounter returns a count of finished IReturnShipmentUseCaseMock.GetReturnShipment invocations
+func (mmGetReturnShipment *IReturnShipmentUseCaseMock) GetReturnShipmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReturnShipment.afterGetReturnShipmentCounter)
+}
+
+// GetReturnShipmentBeforeCounter returns a count of IReturnShipmentUseCaseMock.GetReturnShipment invocations
+func (mmGetReturnShipment *IReturnShipmentUseCaseMock) GetReturnShipmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReturnShipment.beforeGetReturnShipmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to IReturnShipmentUseCaseMock.GetReturnShipment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetReturnShipment *mIReturnShipmentUseCaseMockGetReturnShipment) Calls() []*IReturnShipmentUseCaseMockGetReturnShipmentParams {
+	mmGetReturnShipment.mutex.RLock()
+
+	argCopy := make([]*IReturnShipmentUseCaseMockGetReturnShipmentParams, len(mmGetReturnShipment.callArgs))
+	copy(argCopy, mmGetReturnShipment.callArgs)
+
+	mmGetReturnShipment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetReturnShipmentDone returns true if the count of the GetReturnShipment invocations corresponds
+// the number of defined expectations
+func (m *IReturnShipmentUseCaseMock) MinimockGetReturnShipmentDone() bool {
+	if m.GetReturnShipmentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetReturnShipmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetReturnShipmentMock.invocationsDone()
+}
+
+// MinimockGetReturnShipmentInspect logs each unmet expectation
+func (m *IReturnShipmentUseCaseMock) MinimockGetReturnShipmentInspect() {
+	for _, e := range m.GetReturnShipmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IReturnShipmentUseCaseMock.GetReturnShipment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetReturnShipmentCounter := mm_atomic.LoadUint64(&m.afterGetReturnShipmentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetReturnShipmentMock.defaultExpectation != nil && afterGetReturnShipmentCounter < 1 {
+		if m.GetReturnShipmentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IReturnShipmentUseCaseMock.GetReturnShipment at\n%s", m.GetReturnShipmentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IReturnShipmentUseCaseMock.GetReturnShipment at\n%s with params: %#v", m.GetReturnShipmentMock.defaultExpectation.expectationOrigins.origin, *m.GetReturnShipmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetReturnShipment != nil && afterGetReturnShipmentCounter < 1 {
+		m.t.Errorf("Expected call to IReturnShipmentUseCaseMock.GetReturnShipment at\n%s", m.funcGetReturnShipmentOrigin)
+	}
+
+	if !m.GetReturnShipmentMock.invocationsDone() && afterGetReturnShipmentCounter > 0 {
+		m.t.Errorf("Expected %d calls to IReturnShipmentUseCaseMock.GetReturnShipment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetReturnShipmentMock.expectedInvocations), m.GetReturnShipmentMock.expectedInvocationsOrigin, afterGetReturnShipmentCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *IReturnShipmentUseCaseMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateReturnShipmentInspect()
+
+			m.MinimockDispatchReturnShipmentInspect()
+
+			m.MinimockGetReturnShipmentInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *IReturnShipmentUseCaseMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *IReturnShipmentUseCaseMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateReturnShipmentDone() &&
+		m.MinimockDispatchReturnShipmentDone() &&
+		m.MinimockGetReturnShipmentDone()
+}
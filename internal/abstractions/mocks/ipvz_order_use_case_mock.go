@@ -20,20 +20,41 @@ type IPVZOrderUseCaseMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcAcceptOrderDelivery          func(ctx context.Context, orderID string, recipientID string, storageTime time.Duration, cost int, weight int, packaging domain.PackagingType, additionalFilm bool) (err error)
+	funcAcceptOrderDelivery          func(ctx context.Context, orderID string, recipientID string, storageTime time.Duration, cost domain.Money, weight int, dimensions domain.Dimensions, packaging domain.PackagingType, additionalFilm bool, options ...mm_abstractions.AcceptOptFunc) (p1 domain.PVZOrder, err error)
 	funcAcceptOrderDeliveryOrigin    string
-	inspectFuncAcceptOrderDelivery   func(ctx context.Context, orderID string, recipientID string, storageTime time.Duration, cost int, weight int, packaging domain.PackagingType, additionalFilm bool)
+	inspectFuncAcceptOrderDelivery   func(ctx context.Context, orderID string, recipientID string, storageTime time.Duration, cost domain.Money, weight int, dimensions domain.Dimensions, packaging domain.PackagingType, additionalFilm bool, options ...mm_abstractions.AcceptOptFunc)
 	afterAcceptOrderDeliveryCounter  uint64
 	beforeAcceptOrderDeliveryCounter uint64
 	AcceptOrderDeliveryMock          mIPVZOrderUseCaseMockAcceptOrderDelivery
 
-	funcAcceptReturn          func(ctx context.Context, userID string, orderID string) (err error)
+	funcAcceptReturn          func(ctx context.Context, userID string, orderID string, details domain.ReturnDetails, options ...mm_abstractions.MutationOptFunc) (err error)
 	funcAcceptReturnOrigin    string
-	inspectFuncAcceptReturn   func(ctx context.Context, userID string, orderID string)
+	inspectFuncAcceptReturn   func(ctx context.Context, userID string, orderID string, details domain.ReturnDetails, options ...mm_abstractions.MutationOptFunc)
 	afterAcceptReturnCounter  uint64
 	beforeAcceptReturnCounter uint64
 	AcceptReturnMock          mIPVZOrderUseCaseMockAcceptReturn
 
+	funcBatchAcceptOrderDelivery          func(ctx context.Context, items []domain.DeliveryItem) (da1 []domain.DeliveryResult, err error)
+	funcBatchAcceptOrderDeliveryOrigin    string
+	inspectFuncBatchAcceptOrderDelivery   func(ctx context.Context, items []domain.DeliveryItem)
+	afterBatchAcceptOrderDeliveryCounter  uint64
+	beforeBatchAcceptOrderDeliveryCounter uint64
+	BatchAcceptOrderDeliveryMock          mIPVZOrderUseCaseMockBatchAcceptOrderDelivery
+
+	funcExtendStorage          func(ctx context.Context, orderID string, extension time.Duration, extendedBy string, options ...mm_abstractions.MutationOptFunc) (err error)
+	funcExtendStorageOrigin    string
+	inspectFuncExtendStorage   func(ctx context.Context, orderID string, extension time.Duration, extendedBy string, options ...mm_abstractions.MutationOptFunc)
+	afterExtendStorageCounter  uint64
+	beforeExtendStorageCounter uint64
+	ExtendStorageMock          mIPVZOrderUseCaseMockExtendStorage
+
+	funcGetOrderHistory          func(ctx context.Context, orderID string) (ea1 []domain.Event, err error)
+	funcGetOrderHistoryOrigin    string
+	inspectFuncGetOrderHistory   func(ctx context.Context, orderID string)
+	afterGetOrderHistoryCounter  uint64
+	beforeGetOrderHistoryCounter uint64
+	GetOrderHistoryMock          mIPVZOrderUseCaseMockGetOrderHistory
+
 	funcGetOrders          func(ctx context.Context, userID string, options ...mm_abstractions.GetOrdersOptFunc) (pa1 []domain.PVZOrder, err error)
 	funcGetOrdersOrigin    string
 	inspectFuncGetOrders   func(ctx context.Context, userID string, options ...mm_abstractions.GetOrdersOptFunc)
@@ -48,16 +69,30 @@ type IPVZOrderUseCaseMock struct {
 	beforeGetReturnsCounter uint64
 	GetReturnsMock          mIPVZOrderUseCaseMockGetReturns
 
-	funcGiveOrderToClient          func(ctx context.Context, orderIDs []string) (err error)
+	funcGetWeightDiscrepancyReport          func(ctx context.Context, date time.Time) (w1 domain.WeightDiscrepancyReport, err error)
+	funcGetWeightDiscrepancyReportOrigin    string
+	inspectFuncGetWeightDiscrepancyReport   func(ctx context.Context, date time.Time)
+	afterGetWeightDiscrepancyReportCounter  uint64
+	beforeGetWeightDiscrepancyReportCounter uint64
+	GetWeightDiscrepancyReportMock          mIPVZOrderUseCaseMockGetWeightDiscrepancyReport
+
+	funcGiveOrderToClient          func(ctx context.Context, decisions []domain.IssueDecision) (ia1 []domain.IssueResult, err error)
 	funcGiveOrderToClientOrigin    string
-	inspectFuncGiveOrderToClient   func(ctx context.Context, orderIDs []string)
+	inspectFuncGiveOrderToClient   func(ctx context.Context, decisions []domain.IssueDecision)
 	afterGiveOrderToClientCounter  uint64
 	beforeGiveOrderToClientCounter uint64
 	GiveOrderToClientMock          mIPVZOrderUseCaseMockGiveOrderToClient
 
-	funcReturnOrderDelivery          func(ctx context.Context, orderID string) (err error)
+	funcQuotePackaging          func(ctx context.Context, cost domain.Money, weight int, dimensions domain.Dimensions) (pa1 []domain.PackagingQuote, err error)
+	funcQuotePackagingOrigin    string
+	inspectFuncQuotePackaging   func(ctx context.Context, cost domain.Money, weight int, dimensions domain.Dimensions)
+	afterQuotePackagingCounter  uint64
+	beforeQuotePackagingCounter uint64
+	QuotePackagingMock          mIPVZOrderUseCaseMockQuotePackaging
+
+	funcReturnOrderDelivery          func(ctx context.Context, orderID string, options ...mm_abstractions.MutationOptFunc) (err error)
 	funcReturnOrderDeliveryOrigin    string
-	inspectFuncReturnOrderDelivery   func(ctx context.Context, orderID string)
+	inspectFuncReturnOrderDelivery   func(ctx context.Context, orderID string, options ...mm_abstractions.MutationOptFunc)
 	afterReturnOrderDeliveryCounter  uint64
 	beforeReturnOrderDeliveryCounter uint64
 	ReturnOrderDeliveryMock          mIPVZOrderUseCaseMockReturnOrderDelivery
@@ -77,15 +112,30 @@ func NewIPVZOrderUseCaseMock(t minimock.Tester) *IPVZOrderUseCaseMock {
 	m.AcceptReturnMock = mIPVZOrderUseCaseMockAcceptReturn{mock: m}
 	m.AcceptReturnMock.callArgs = []*IPVZOrderUseCaseMockAcceptReturnParams{}
 
+	m.BatchAcceptOrderDeliveryMock = mIPVZOrderUseCaseMockBatchAcceptOrderDelivery{mock: m}
+	m.BatchAcceptOrderDeliveryMock.callArgs = []*IPVZOrderUseCaseMockBatchAcceptOrderDeliveryParams{}
+
+	m.ExtendStorageMock = mIPVZOrderUseCaseMockExtendStorage{mock: m}
+	m.ExtendStorageMock.callArgs = []*IPVZOrderUseCaseMockExtendStorageParams{}
+
+	m.GetOrderHistoryMock = mIPVZOrderUseCaseMockGetOrderHistory{mock: m}
+	m.GetOrderHistoryMock.callArgs = []*IPVZOrderUseCaseMockGetOrderHistoryParams{}
+
 	m.GetOrdersMock = mIPVZOrderUseCaseMockGetOrders{mock: m}
 	m.GetOrdersMock.callArgs = []*IPVZOrderUseCaseMockGetOrdersParams{}
 
 	m.GetReturnsMock = mIPVZOrderUseCaseMockGetReturns{mock: m}
 	m.GetReturnsMock.callArgs = []*IPVZOrderUseCaseMockGetReturnsParams{}
 
+	m.GetWeightDiscrepancyReportMock = mIPVZOrderUseCaseMockGetWeightDiscrepancyReport{mock: m}
+	m.GetWeightDiscrepancyReportMock.callArgs = []*IPVZOrderUseCaseMockGetWeightDiscrepancyReportParams{}
+
 	m.GiveOrderToClientMock = mIPVZOrderUseCaseMockGiveOrderToClient{mock: m}
 	m.GiveOrderToClientMock.callArgs = []*IPVZOrderUseCaseMockGiveOrderToClientParams{}
 
+	m.QuotePackagingMock = mIPVZOrderUseCaseMockQuotePackaging{mock: m}
+	m.QuotePackagingMock.callArgs = []*IPVZOrderUseCaseMockQuotePackagingParams{}
+
 	m.ReturnOrderDeliveryMock = mIPVZOrderUseCaseMockReturnOrderDelivery{mock: m}
 	m.ReturnOrderDeliveryMock.callArgs = []*IPVZOrderUseCaseMockReturnOrderDeliveryParams{}
 
@@ -124,10 +174,12 @@ type IPVZOrderUseCaseMockAcceptOrderDeliveryParams struct {
 	orderID        string
 	recipientID    string
 	storageTime    time.Duration
-	cost           int
+	cost           domain.Money
 	weight         int
+	dimensions     domain.Dimensions
 	packaging      domain.PackagingType
 	additionalFilm bool
+	options        []mm_abstractions.AcceptOptFunc
 }
 
 // IPVZOrderUseCaseMockAcceptOrderDeliveryParamPtrs contains pointers to parameters of the IPVZOrderUseCase.AcceptOrderDelivery
@@ -136,14 +188,17 @@ type IPVZOrderUseCaseMockAcceptOrderDeliveryParamPtrs struct {
 	orderID        *string
 	recipientID    *string
 	storageTime    *time.Duration
-	cost           *int
+	cost           *domain.Money
 	weight         *int
+	dimensions     *domain.Dimensions
 	packaging      *domain.PackagingType
 	additionalFilm *bool
+	options        *[]mm_abstractions.AcceptOptFunc
 }
 
 // IPVZOrderUseCaseMockAcceptOrderDeliveryResults contains results of the IPVZOrderUseCase.AcceptOrderDelivery
 type IPVZOrderUseCaseMockAcceptOrderDeliveryResults struct {
+	p1  domain.PVZOrder
 	err error
 }
 
@@ -156,8 +211,10 @@ type IPVZOrderUseCaseMockAcceptOrderDeliveryExpectationOrigins struct {
 	originStorageTime    string
 	originCost           string
 	originWeight         string
+	originDimensions     string
 	originPackaging      string
 	originAdditionalFilm string
+	originOptions        string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -171,7 +228,7 @@ func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) Optional(
 }
 
 // Expect sets up expected params for IPVZOrderUseCase.AcceptOrderDelivery
-func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) Expect(ctx context.Context, orderID string, recipientID string, storageTime time.Duration, cost int, weight int, packaging domain.PackagingType, additionalFilm bool) *mIPVZOrderUseCaseMockAcceptOrderDelivery {
+func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) Expect(ctx context.Context, orderID string, recipientID string, storageTime time.Duration, cost domain.Money, weight int, dimensions domain.Dimensions, packaging domain.PackagingType, additionalFilm bool, options ...mm_abstractions.AcceptOptFunc) *mIPVZOrderUseCaseMockAcceptOrderDelivery {
 	if mmAcceptOrderDelivery.mock.funcAcceptOrderDelivery != nil {
 		mmAcceptOrderDelivery.mock.t.Fatalf("IPVZOrderUseCaseMock.AcceptOrderDelivery mock is already set by Set")
 	}
@@ -184,7 +241,7 @@ func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) Expect(ct
 		mmAcceptOrderDelivery.mock.t.Fatalf("IPVZOrderUseCaseMock.AcceptOrderDelivery mock is already set by ExpectParams functions")
 	}
 
-	mmAcceptOrderDelivery.defaultExpectation.params = &IPVZOrderUseCaseMockAcceptOrderDeliveryParams{ctx, orderID, recipientID, storageTime, cost, weight, packaging, additionalFilm}
+	mmAcceptOrderDelivery.defaultExpectation.params = &IPVZOrderUseCaseMockAcceptOrderDeliveryParams{ctx, orderID, recipientID, storageTime, cost, weight, dimensions, packaging, additionalFilm, options}
 	mmAcceptOrderDelivery.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmAcceptOrderDelivery.expectations {
 		if minimock.Equal(e.params, mmAcceptOrderDelivery.defaultExpectation.params) {
@@ -288,7 +345,7 @@ func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) ExpectSto
 }
 
 // ExpectCostParam5 sets up expected param cost for IPVZOrderUseCase.AcceptOrderDelivery
-func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) ExpectCostParam5(cost int) *mIPVZOrderUseCaseMockAcceptOrderDelivery {
+func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) ExpectCostParam5(cost domain.Money) *mIPVZOrderUseCaseMockAcceptOrderDelivery {
 	if mmAcceptOrderDelivery.mock.funcAcceptOrderDelivery != nil {
 		mmAcceptOrderDelivery.mock.t.Fatalf("IPVZOrderUseCaseMock.AcceptOrderDelivery mock is already set by Set")
 	}
@@ -333,8 +390,31 @@ func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) ExpectWei
 	return mmAcceptOrderDelivery
 }
 
-// ExpectPackagingParam7 sets up expected param packaging for IPVZOrderUseCase.AcceptOrderDelivery
-func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) ExpectPackagingParam7(packaging domain.PackagingType) *mIPVZOrderUseCaseMockAcceptOrderDelivery {
+// ExpectDimensionsParam7 sets up expected param dimensions for IPVZOrderUseCase.AcceptOrderDelivery
+func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) ExpectDimensionsParam7(dimensions domain.Dimensions) *mIPVZOrderUseCaseMockAcceptOrderDelivery {
+	if mmAcceptOrderDelivery.mock.funcAcceptOrderDelivery != nil {
+		mmAcceptOrderDelivery.mock.t.Fatalf("IPVZOrderUseCaseMock.AcceptOrderDelivery mock is already set by Set")
+	}
+
+	if mmAcceptOrderDelivery.defaultExpectation == nil {
+		mmAcceptOrderDelivery.defaultExpectation = &IPVZOrderUseCaseMockAcceptOrderDeliveryExpectation{}
+	}
+
+	if mmAcceptOrderDelivery.defaultExpectation.params != nil {
+		mmAcceptOrderDelivery.mock.t.Fatalf("IPVZOrderUseCaseMock.AcceptOrderDelivery mock is already set by Expect")
+	}
+
+	if mmAcceptOrderDelivery.defaultExpectation.paramPtrs == nil {
+		mmAcceptOrderDelivery.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockAcceptOrderDeliveryParamPtrs{}
+	}
+	mmAcceptOrderDelivery.defaultExpectation.paramPtrs.dimensions = &dimensions
+	mmAcceptOrderDelivery.defaultExpectation.expectationOrigins.originDimensions = minimock.CallerInfo(1)
+
+	return mmAcceptOrderDelivery
+}
+
+// ExpectPackagingParam8 sets up expected param packaging for IPVZOrderUseCase.AcceptOrderDelivery
+func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) ExpectPackagingParam8(packaging domain.PackagingType) *mIPVZOrderUseCaseMockAcceptOrderDelivery {
 	if mmAcceptOrderDelivery.mock.funcAcceptOrderDelivery != nil {
 		mmAcceptOrderDelivery.mock.t.Fatalf("IPVZOrderUseCaseMock.AcceptOrderDelivery mock is already set by Set")
 	}
@@ -356,8 +436,8 @@ func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) ExpectPac
 	return mmAcceptOrderDelivery
 }
 
-// ExpectAdditionalFilmParam8 sets up expected param additionalFilm for IPVZOrderUseCase.AcceptOrderDelivery
-func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) ExpectAdditionalFilmParam8(additionalFilm bool) *mIPVZOrderUseCaseMockAcceptOrderDelivery {
+// ExpectAdditionalFilmParam9 sets up expected param additionalFilm for IPVZOrderUseCase.AcceptOrderDelivery
+func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) ExpectAdditionalFilmParam9(additionalFilm bool) *mIPVZOrderUseCaseMockAcceptOrderDelivery {
 	if mmAcceptOrderDelivery.mock.funcAcceptOrderDelivery != nil {
 		mmAcceptOrderDelivery.mock.t.Fatalf("IPVZOrderUseCaseMock.AcceptOrderDelivery mock is already set by Set")
 	}
@@ -379,8 +459,31 @@ func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) ExpectAdd
 	return mmAcceptOrderDelivery
 }
 
+// ExpectOptionsParam10 sets up expected param options for IPVZOrderUseCase.AcceptOrderDelivery
+func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) ExpectOptionsParam10(options ...mm_abstractions.AcceptOptFunc) *mIPVZOrderUseCaseMockAcceptOrderDelivery {
+	if mmAcceptOrderDelivery.mock.funcAcceptOrderDelivery != nil {
+		mmAcceptOrderDelivery.mock.t.Fatalf("IPVZOrderUseCaseMock.AcceptOrderDelivery mock is already set by Set")
+	}
+
+	if mmAcceptOrderDelivery.defaultExpectation == nil {
+		mmAcceptOrderDelivery.defaultExpectation = &IPVZOrderUseCaseMockAcceptOrderDeliveryExpectation{}
+	}
+
+	if mmAcceptOrderDelivery.defaultExpectation.params != nil {
+		mmAcceptOrderDelivery.mock.t.Fatalf("IPVZOrderUseCaseMock.AcceptOrderDelivery mock is already set by Expect")
+	}
+
+	if mmAcceptOrderDelivery.defaultExpectation.paramPtrs == nil {
+		mmAcceptOrderDelivery.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockAcceptOrderDeliveryParamPtrs{}
+	}
+	mmAcceptOrderDelivery.defaultExpectation.paramPtrs.options = &options
+	mmAcceptOrderDelivery.defaultExpectation.expectationOrigins.originOptions = minimock.CallerInfo(1)
+
+	return mmAcceptOrderDelivery
+}
+
 // Inspect accepts an inspector function that has same arguments as the IPVZOrderUseCase.AcceptOrderDelivery
-func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) Inspect(f func(ctx context.Context, orderID string, recipientID string, storageTime time.Duration, cost int, weight int, packaging domain.PackagingType, additionalFilm bool)) *mIPVZOrderUseCaseMockAcceptOrderDelivery {
+func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) Inspect(f func(ctx context.Context, orderID string, recipientID string, storageTime time.Duration, cost domain.Money, weight int, dimensions domain.Dimensions, packaging domain.PackagingType, additionalFilm bool, options ...mm_abstractions.AcceptOptFunc)) *mIPVZOrderUseCaseMockAcceptOrderDelivery {
 	if mmAcceptOrderDelivery.mock.inspectFuncAcceptOrderDelivery != nil {
 		mmAcceptOrderDelivery.mock.t.Fatalf("Inspect function is already set for IPVZOrderUseCaseMock.AcceptOrderDelivery")
 	}
@@ -391,7 +494,7 @@ func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) Inspect(f
 }
 
 // Return sets up results that will be returned by IPVZOrderUseCase.AcceptOrderDelivery
-func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) Return(err error) *IPVZOrderUseCaseMock {
+func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) Return(p1 domain.PVZOrder, err error) *IPVZOrderUseCaseMock {
 	if mmAcceptOrderDelivery.mock.funcAcceptOrderDelivery != nil {
 		mmAcceptOrderDelivery.mock.t.Fatalf("IPVZOrderUseCaseMock.AcceptOrderDelivery mock is already set by Set")
 	}
@@ -399,13 +502,13 @@ func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) Return(er
 	if mmAcceptOrderDelivery.defaultExpectation == nil {
 		mmAcceptOrderDelivery.defaultExpectation = &IPVZOrderUseCaseMockAcceptOrderDeliveryExpectation{mock: mmAcceptOrderDelivery.mock}
 	}
-	mmAcceptOrderDelivery.defaultExpectation.results = &IPVZOrderUseCaseMockAcceptOrderDeliveryResults{err}
+	mmAcceptOrderDelivery.defaultExpectation.results = &IPVZOrderUseCaseMockAcceptOrderDeliveryResults{p1, err}
 	mmAcceptOrderDelivery.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
 	return mmAcceptOrderDelivery.mock
 }
 
 // Set uses given function f to mock the IPVZOrderUseCase.AcceptOrderDelivery method
-func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) Set(f func(ctx context.Context, orderID string, recipientID string, storageTime time.Duration, cost int, weight int, packaging domain.PackagingType, additionalFilm bool) (err error)) *IPVZOrderUseCaseMock {
+func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) Set(f func(ctx context.Context, orderID string, recipientID string, storageTime time.Duration, cost domain.Money, weight int, dimensions domain.Dimensions, packaging domain.PackagingType, additionalFilm bool, options ...mm_abstractions.AcceptOptFunc) (p1 domain.PVZOrder, err error)) *IPVZOrderUseCaseMock {
 	if mmAcceptOrderDelivery.defaultExpectation != nil {
 		mmAcceptOrderDelivery.mock.t.Fatalf("Default expectation is already set for the IPVZOrderUseCase.AcceptOrderDelivery method")
 	}
@@ -421,14 +524,14 @@ func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) Set(f fun
 
 // When sets expectation for the IPVZOrderUseCase.AcceptOrderDelivery which will trigger the result defined by the following
 // Then helper
-func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) When(ctx context.Context, orderID string, recipientID string, storageTime time.Duration, cost int, weight int, packaging domain.PackagingType, additionalFilm bool) *IPVZOrderUseCaseMockAcceptOrderDeliveryExpectation {
+func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) When(ctx context.Context, orderID string, recipientID string, storageTime time.Duration, cost domain.Money, weight int, dimensions domain.Dimensions, packaging domain.PackagingType, additionalFilm bool, options ...mm_abstractions.AcceptOptFunc) *IPVZOrderUseCaseMockAcceptOrderDeliveryExpectation {
 	if mmAcceptOrderDelivery.mock.funcAcceptOrderDelivery != nil {
 		mmAcceptOrderDelivery.mock.t.Fatalf("IPVZOrderUseCaseMock.AcceptOrderDelivery mock is already set by Set")
 	}
 
 	expectation := &IPVZOrderUseCaseMockAcceptOrderDeliveryExpectation{
 		mock:               mmAcceptOrderDelivery.mock,
-		params:             &IPVZOrderUseCaseMockAcceptOrderDeliveryParams{ctx, orderID, recipientID, storageTime, cost, weight, packaging, additionalFilm},
+		params:             &IPVZOrderUseCaseMockAcceptOrderDeliveryParams{ctx, orderID, recipientID, storageTime, cost, weight, dimensions, packaging, additionalFilm, options},
 		expectationOrigins: IPVZOrderUseCaseMockAcceptOrderDeliveryExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmAcceptOrderDelivery.expectations = append(mmAcceptOrderDelivery.expectations, expectation)
@@ -436,8 +539,8 @@ func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) When(ctx
 }
 
 // Then sets up IPVZOrderUseCase.AcceptOrderDelivery return parameters for the expectation previously defined by the When method
-func (e *IPVZOrderUseCaseMockAcceptOrderDeliveryExpectation) Then(err error) *IPVZOrderUseCaseMock {
-	e.results = &IPVZOrderUseCaseMockAcceptOrderDeliveryResults{err}
+func (e *IPVZOrderUseCaseMockAcceptOrderDeliveryExpectation) Then(p1 domain.PVZOrder, err error) *IPVZOrderUseCaseMock {
+	e.results = &IPVZOrderUseCaseMockAcceptOrderDeliveryResults{p1, err}
 	return e.mock
 }
 
@@ -463,17 +566,17 @@ func (mmAcceptOrderDelivery *mIPVZOrderUseCaseMockAcceptOrderDelivery) invocatio
 }
 
 // AcceptOrderDelivery implements mm_abstractions.IPVZOrderUseCase
-func (mmAcceptOrderDelivery *IPVZOrderUseCaseMock) AcceptOrderDelivery(ctx context.Context, orderID string, recipientID string, storageTime time.Duration, cost int, weight int, packaging domain.PackagingType, additionalFilm bool) (err error) {
+func (mmAcceptOrderDelivery *IPVZOrderUseCaseMock) AcceptOrderDelivery(ctx context.Context, orderID string, recipientID string, storageTime time.Duration, cost domain.Money, weight int, dimensions domain.Dimensions, packaging domain.PackagingType, additionalFilm bool, options ...mm_abstractions.AcceptOptFunc) (p1 domain.PVZOrder, err error) {
 	mm_atomic.AddUint64(&mmAcceptOrderDelivery.beforeAcceptOrderDeliveryCounter, 1)
 	defer mm_atomic.AddUint64(&mmAcceptOrderDelivery.afterAcceptOrderDeliveryCounter, 1)
 
 	mmAcceptOrderDelivery.t.Helper()
 
 	if mmAcceptOrderDelivery.inspectFuncAcceptOrderDelivery != nil {
-		mmAcceptOrderDelivery.inspectFuncAcceptOrderDelivery(ctx, orderID, recipientID, storageTime, cost, weight, packaging, additionalFilm)
+		mmAcceptOrderDelivery.inspectFuncAcceptOrderDelivery(ctx, orderID, recipientID, storageTime, cost, weight, dimensions, packaging, additionalFilm, options...)
 	}
 
-	mm_params := IPVZOrderUseCaseMockAcceptOrderDeliveryParams{ctx, orderID, recipientID, storageTime, cost, weight, packaging, additionalFilm}
+	mm_params := IPVZOrderUseCaseMockAcceptOrderDeliveryParams{ctx, orderID, recipientID, storageTime, cost, weight, dimensions, packaging, additionalFilm, options}
 
 	// Record call args
 	mmAcceptOrderDelivery.AcceptOrderDeliveryMock.mutex.Lock()
@@ -483,7 +586,7 @@ func (mmAcceptOrderDelivery *IPVZOrderUseCaseMock) AcceptOrderDelivery(ctx conte
 	for _, e := range mmAcceptOrderDelivery.AcceptOrderDeliveryMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.p1, e.results.err
 		}
 	}
 
@@ -492,7 +595,7 @@ func (mmAcceptOrderDelivery *IPVZOrderUseCaseMock) AcceptOrderDelivery(ctx conte
 		mm_want := mmAcceptOrderDelivery.AcceptOrderDeliveryMock.defaultExpectation.params
 		mm_want_ptrs := mmAcceptOrderDelivery.AcceptOrderDeliveryMock.defaultExpectation.paramPtrs
 
-		mm_got := IPVZOrderUseCaseMockAcceptOrderDeliveryParams{ctx, orderID, recipientID, storageTime, cost, weight, packaging, additionalFilm}
+		mm_got := IPVZOrderUseCaseMockAcceptOrderDeliveryParams{ctx, orderID, recipientID, storageTime, cost, weight, dimensions, packaging, additionalFilm, options}
 
 		if mm_want_ptrs != nil {
 
@@ -526,6 +629,11 @@ func (mmAcceptOrderDelivery *IPVZOrderUseCaseMock) AcceptOrderDelivery(ctx conte
 					mmAcceptOrderDelivery.AcceptOrderDeliveryMock.defaultExpectation.expectationOrigins.originWeight, *mm_want_ptrs.weight, mm_got.weight, minimock.Diff(*mm_want_ptrs.weight, mm_got.weight))
 			}
 
+			if mm_want_ptrs.dimensions != nil && !minimock.Equal(*mm_want_ptrs.dimensions, mm_got.dimensions) {
+				mmAcceptOrderDelivery.t.Errorf("IPVZOrderUseCaseMock.AcceptOrderDelivery got unexpected parameter dimensions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAcceptOrderDelivery.AcceptOrderDeliveryMock.defaultExpectation.expectationOrigins.originDimensions, *mm_want_ptrs.dimensions, mm_got.dimensions, minimock.Diff(*mm_want_ptrs.dimensions, mm_got.dimensions))
+			}
+
 			if mm_want_ptrs.packaging != nil && !minimock.Equal(*mm_want_ptrs.packaging, mm_got.packaging) {
 				mmAcceptOrderDelivery.t.Errorf("IPVZOrderUseCaseMock.AcceptOrderDelivery got unexpected parameter packaging, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 					mmAcceptOrderDelivery.AcceptOrderDeliveryMock.defaultExpectation.expectationOrigins.originPackaging, *mm_want_ptrs.packaging, mm_got.packaging, minimock.Diff(*mm_want_ptrs.packaging, mm_got.packaging))
@@ -536,6 +644,11 @@ func (mmAcceptOrderDelivery *IPVZOrderUseCaseMock) AcceptOrderDelivery(ctx conte
 					mmAcceptOrderDelivery.AcceptOrderDeliveryMock.defaultExpectation.expectationOrigins.originAdditionalFilm, *mm_want_ptrs.additionalFilm, mm_got.additionalFilm, minimock.Diff(*mm_want_ptrs.additionalFilm, mm_got.additionalFilm))
 			}
 
+			if mm_want_ptrs.options != nil && !minimock.Equal(*mm_want_ptrs.options, mm_got.options) {
+				mmAcceptOrderDelivery.t.Errorf("IPVZOrderUseCaseMock.AcceptOrderDelivery got unexpected parameter options, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAcceptOrderDelivery.AcceptOrderDeliveryMock.defaultExpectation.expectationOrigins.originOptions, *mm_want_ptrs.options, mm_got.options, minimock.Diff(*mm_want_ptrs.options, mm_got.options))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmAcceptOrderDelivery.t.Errorf("IPVZOrderUseCaseMock.AcceptOrderDelivery got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmAcceptOrderDelivery.AcceptOrderDeliveryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -545,12 +658,12 @@ func (mmAcceptOrderDelivery *IPVZOrderUseCaseMock) AcceptOrderDelivery(ctx conte
 		if mm_results == nil {
 			mmAcceptOrderDelivery.t.Fatal("No results are set for the IPVZOrderUseCaseMock.AcceptOrderDelivery")
 		}
-		return (*mm_results).err
+		return (*mm_results).p1, (*mm_results).err
 	}
 	if mmAcceptOrderDelivery.funcAcceptOrderDelivery != nil {
-		return mmAcceptOrderDelivery.funcAcceptOrderDelivery(ctx, orderID, recipientID, storageTime, cost, weight, packaging, additionalFilm)
+		return mmAcceptOrderDelivery.funcAcceptOrderDelivery(ctx, orderID, recipientID, storageTime, cost, weight, dimensions, packaging, additionalFilm, options...)
 	}
-	mmAcceptOrderDelivery.t.Fatalf("Unexpected call to IPVZOrderUseCaseMock.AcceptOrderDelivery. %v %v %v %v %v %v %v %v", ctx, orderID, recipientID, storageTime, cost, weight, packaging, additionalFilm)
+	mmAcceptOrderDelivery.t.Fatalf("Unexpected call to IPVZOrderUseCaseMock.AcceptOrderDelivery. %v %v %v %v %v %v %v %v %v %v", ctx, orderID, recipientID, storageTime, cost, weight, dimensions, packaging, additionalFilm, options)
 	return
 }
 
@@ -651,6 +764,8 @@ type IPVZOrderUseCaseMockAcceptReturnParams struct {
 	ctx     context.Context
 	userID  string
 	orderID string
+	details domain.ReturnDetails
+	options []mm_abstractions.MutationOptFunc
 }
 
 // IPVZOrderUseCaseMockAcceptReturnParamPtrs contains pointers to parameters of the IPVZOrderUseCase.AcceptReturn
@@ -658,6 +773,8 @@ type IPVZOrderUseCaseMockAcceptReturnParamPtrs struct {
 	ctx     *context.Context
 	userID  *string
 	orderID *string
+	details *domain.ReturnDetails
+	options *[]mm_abstractions.MutationOptFunc
 }
 
 // IPVZOrderUseCaseMockAcceptReturnResults contains results of the IPVZOrderUseCase.AcceptReturn
@@ -671,6 +788,8 @@ type IPVZOrderUseCaseMockAcceptReturnExpectationOrigins struct {
 	originCtx     string
 	originUserID  string
 	originOrderID string
+	originDetails string
+	originOptions string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -684,7 +803,7 @@ func (mmAcceptReturn *mIPVZOrderUseCaseMockAcceptReturn) Optional() *mIPVZOrderU
 }
 
 // Expect sets up expected params for IPVZOrderUseCase.AcceptReturn
-func (mmAcceptReturn *mIPVZOrderUseCaseMockAcceptReturn) Expect(ctx context.Context, userID string, orderID string) *mIPVZOrderUseCaseMockAcceptReturn {
+func (mmAcceptReturn *mIPVZOrderUseCaseMockAcceptReturn) Expect(ctx context.Context, userID string, orderID string, details domain.ReturnDetails, options ...mm_abstractions.MutationOptFunc) *mIPVZOrderUseCaseMockAcceptReturn {
 	if mmAcceptReturn.mock.funcAcceptReturn != nil {
 		mmAcceptReturn.mock.t.Fatalf("IPVZOrderUseCaseMock.AcceptReturn mock is already set by Set")
 	}
@@ -697,7 +816,7 @@ func (mmAcceptReturn *mIPVZOrderUseCaseMockAcceptReturn) Expect(ctx context.Cont
 		mmAcceptReturn.mock.t.Fatalf("IPVZOrderUseCaseMock.AcceptReturn mock is already set by ExpectParams functions")
 	}
 
-	mmAcceptReturn.defaultExpectation.params = &IPVZOrderUseCaseMockAcceptReturnParams{ctx, userID, orderID}
+	mmAcceptReturn.defaultExpectation.params = &IPVZOrderUseCaseMockAcceptReturnParams{ctx, userID, orderID, details, options}
 	mmAcceptReturn.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmAcceptReturn.expectations {
 		if minimock.Equal(e.params, mmAcceptReturn.defaultExpectation.params) {
@@ -777,8 +896,54 @@ func (mmAcceptReturn *mIPVZOrderUseCaseMockAcceptReturn) ExpectOrderIDParam3(ord
 	return mmAcceptReturn
 }
 
+// ExpectDetailsParam4 sets up expected param details for IPVZOrderUseCase.AcceptReturn
+func (mmAcceptReturn *mIPVZOrderUseCaseMockAcceptReturn) ExpectDetailsParam4(details domain.ReturnDetails) *mIPVZOrderUseCaseMockAcceptReturn {
+	if mmAcceptReturn.mock.funcAcceptReturn != nil {
+		mmAcceptReturn.mock.t.Fatalf("IPVZOrderUseCaseMock.AcceptReturn mock is already set by Set")
+	}
+
+	if mmAcceptReturn.defaultExpectation == nil {
+		mmAcceptReturn.defaultExpectation = &IPVZOrderUseCaseMockAcceptReturnExpectation{}
+	}
+
+	if mmAcceptReturn.defaultExpectation.params != nil {
+		mmAcceptReturn.mock.t.Fatalf("IPVZOrderUseCaseMock.AcceptReturn mock is already set by Expect")
+	}
+
+	if mmAcceptReturn.defaultExpectation.paramPtrs == nil {
+		mmAcceptReturn.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockAcceptReturnParamPtrs{}
+	}
+	mmAcceptReturn.defaultExpectation.paramPtrs.details = &details
+	mmAcceptReturn.defaultExpectation.expectationOrigins.originDetails = minimock.CallerInfo(1)
+
+	return mmAcceptReturn
+}
+
+// ExpectOptionsParam5 sets up expected param options for IPVZOrderUseCase.AcceptReturn
+func (mmAcceptReturn *mIPVZOrderUseCaseMockAcceptReturn) ExpectOptionsParam5(options ...mm_abstractions.MutationOptFunc) *mIPVZOrderUseCaseMockAcceptReturn {
+	if mmAcceptReturn.mock.funcAcceptReturn != nil {
+		mmAcceptReturn.mock.t.Fatalf("IPVZOrderUseCaseMock.AcceptReturn mock is already set by Set")
+	}
+
+	if mmAcceptReturn.defaultExpectation == nil {
+		mmAcceptReturn.defaultExpectation = &IPVZOrderUseCaseMockAcceptReturnExpectation{}
+	}
+
+	if mmAcceptReturn.defaultExpectation.params != nil {
+		mmAcceptReturn.mock.t.Fatalf("IPVZOrderUseCaseMock.AcceptReturn mock is already set by Expect")
+	}
+
+	if mmAcceptReturn.defaultExpectation.paramPtrs == nil {
+		mmAcceptReturn.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockAcceptReturnParamPtrs{}
+	}
+	mmAcceptReturn.defaultExpectation.paramPtrs.options = &options
+	mmAcceptReturn.defaultExpectation.expectationOrigins.originOptions = minimock.CallerInfo(1)
+
+	return mmAcceptReturn
+}
+
 // Inspect accepts an inspector function that has same arguments as the IPVZOrderUseCase.AcceptReturn
-func (mmAcceptReturn *mIPVZOrderUseCaseMockAcceptReturn) Inspect(f func(ctx context.Context, userID string, orderID string)) *mIPVZOrderUseCaseMockAcceptReturn {
+func (mmAcceptReturn *mIPVZOrderUseCaseMockAcceptReturn) Inspect(f func(ctx context.Context, userID string, orderID string, details domain.ReturnDetails, options ...mm_abstractions.MutationOptFunc)) *mIPVZOrderUseCaseMockAcceptReturn {
 	if mmAcceptReturn.mock.inspectFuncAcceptReturn != nil {
 		mmAcceptReturn.mock.t.Fatalf("Inspect function is already set for IPVZOrderUseCaseMock.AcceptReturn")
 	}
@@ -803,7 +968,7 @@ func (mmAcceptReturn *mIPVZOrderUseCaseMockAcceptReturn) Return(err error) *IPVZ
 }
 
 // Set uses given function f to mock the IPVZOrderUseCase.AcceptReturn method
-func (mmAcceptReturn *mIPVZOrderUseCaseMockAcceptReturn) Set(f func(ctx context.Context, userID string, orderID string) (err error)) *IPVZOrderUseCaseMock {
+func (mmAcceptReturn *mIPVZOrderUseCaseMockAcceptReturn) Set(f func(ctx context.Context, userID string, orderID string, details domain.ReturnDetails, options ...mm_abstractions.MutationOptFunc) (err error)) *IPVZOrderUseCaseMock {
 	if mmAcceptReturn.defaultExpectation != nil {
 		mmAcceptReturn.mock.t.Fatalf("Default expectation is already set for the IPVZOrderUseCase.AcceptReturn method")
 	}
@@ -819,14 +984,14 @@ func (mmAcceptReturn *mIPVZOrderUseCaseMockAcceptReturn) Set(f func(ctx context.
 
 // When sets expectation for the IPVZOrderUseCase.AcceptReturn which will trigger the result defined by the following
 // Then helper
-func (mmAcceptReturn *mIPVZOrderUseCaseMockAcceptReturn) When(ctx context.Context, userID string, orderID string) *IPVZOrderUseCaseMockAcceptReturnExpectation {
+func (mmAcceptReturn *mIPVZOrderUseCaseMockAcceptReturn) When(ctx context.Context, userID string, orderID string, details domain.ReturnDetails, options ...mm_abstractions.MutationOptFunc) *IPVZOrderUseCaseMockAcceptReturnExpectation {
 	if mmAcceptReturn.mock.funcAcceptReturn != nil {
 		mmAcceptReturn.mock.t.Fatalf("IPVZOrderUseCaseMock.AcceptReturn mock is already set by Set")
 	}
 
 	expectation := &IPVZOrderUseCaseMockAcceptReturnExpectation{
 		mock:               mmAcceptReturn.mock,
-		params:             &IPVZOrderUseCaseMockAcceptReturnParams{ctx, userID, orderID},
+		params:             &IPVZOrderUseCaseMockAcceptReturnParams{ctx, userID, orderID, details, options},
 		expectationOrigins: IPVZOrderUseCaseMockAcceptReturnExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmAcceptReturn.expectations = append(mmAcceptReturn.expectations, expectation)
@@ -861,17 +1026,17 @@ func (mmAcceptReturn *mIPVZOrderUseCaseMockAcceptReturn) invocationsDone() bool
 }
 
 // AcceptReturn implements mm_abstractions.IPVZOrderUseCase
-func (mmAcceptReturn *IPVZOrderUseCaseMock) AcceptReturn(ctx context.Context, userID string, orderID string) (err error) {
+func (mmAcceptReturn *IPVZOrderUseCaseMock) AcceptReturn(ctx context.Context, userID string, orderID string, details domain.ReturnDetails, options ...mm_abstractions.MutationOptFunc) (err error) {
 	mm_atomic.AddUint64(&mmAcceptReturn.beforeAcceptReturnCounter, 1)
 	defer mm_atomic.AddUint64(&mmAcceptReturn.afterAcceptReturnCounter, 1)
 
 	mmAcceptReturn.t.Helper()
 
 	if mmAcceptReturn.inspectFuncAcceptReturn != nil {
-		mmAcceptReturn.inspectFuncAcceptReturn(ctx, userID, orderID)
+		mmAcceptReturn.inspectFuncAcceptReturn(ctx, userID, orderID, details, options...)
 	}
 
-	mm_params := IPVZOrderUseCaseMockAcceptReturnParams{ctx, userID, orderID}
+	mm_params := IPVZOrderUseCaseMockAcceptReturnParams{ctx, userID, orderID, details, options}
 
 	// Record call args
 	mmAcceptReturn.AcceptReturnMock.mutex.Lock()
@@ -890,7 +1055,7 @@ func (mmAcceptReturn *IPVZOrderUseCaseMock) AcceptReturn(ctx context.Context, us
 		mm_want := mmAcceptReturn.AcceptReturnMock.defaultExpectation.params
 		mm_want_ptrs := mmAcceptReturn.AcceptReturnMock.defaultExpectation.paramPtrs
 
-		mm_got := IPVZOrderUseCaseMockAcceptReturnParams{ctx, userID, orderID}
+		mm_got := IPVZOrderUseCaseMockAcceptReturnParams{ctx, userID, orderID, details, options}
 
 		if mm_want_ptrs != nil {
 
@@ -909,6 +1074,16 @@ func (mmAcceptReturn *IPVZOrderUseCaseMock) AcceptReturn(ctx context.Context, us
 					mmAcceptReturn.AcceptReturnMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
 			}
 
+			if mm_want_ptrs.details != nil && !minimock.Equal(*mm_want_ptrs.details, mm_got.details) {
+				mmAcceptReturn.t.Errorf("IPVZOrderUseCaseMock.AcceptReturn got unexpected parameter details, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAcceptReturn.AcceptReturnMock.defaultExpectation.expectationOrigins.originDetails, *mm_want_ptrs.details, mm_got.details, minimock.Diff(*mm_want_ptrs.details, mm_got.details))
+			}
+
+			if mm_want_ptrs.options != nil && !minimock.Equal(*mm_want_ptrs.options, mm_got.options) {
+				mmAcceptReturn.t.Errorf("IPVZOrderUseCaseMock.AcceptReturn got unexpected parameter options, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAcceptReturn.AcceptReturnMock.defaultExpectation.expectationOrigins.originOptions, *mm_want_ptrs.options, mm_got.options, minimock.Diff(*mm_want_ptrs.options, mm_got.options))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmAcceptReturn.t.Errorf("IPVZOrderUseCaseMock.AcceptReturn got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmAcceptReturn.AcceptReturnMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -921,9 +1096,9 @@ func (mmAcceptReturn *IPVZOrderUseCaseMock) AcceptReturn(ctx context.Context, us
 		return (*mm_results).err
 	}
 	if mmAcceptReturn.funcAcceptReturn != nil {
-		return mmAcceptReturn.funcAcceptReturn(ctx, userID, orderID)
+		return mmAcceptReturn.funcAcceptReturn(ctx, userID, orderID, details, options...)
 	}
-	mmAcceptReturn.t.Fatalf("Unexpected call to IPVZOrderUseCaseMock.AcceptReturn. %v %v %v", ctx, userID, orderID)
+	mmAcceptReturn.t.Fatalf("Unexpected call to IPVZOrderUseCaseMock.AcceptReturn. %v %v %v %v %v", ctx, userID, orderID, details, options)
 	return
 }
 
@@ -995,56 +1170,53 @@ func (m *IPVZOrderUseCaseMock) MinimockAcceptReturnInspect() {
 	}
 }
 
-type mIPVZOrderUseCaseMockGetOrders struct {
+type mIPVZOrderUseCaseMockBatchAcceptOrderDelivery struct {
 	optional           bool
 	mock               *IPVZOrderUseCaseMock
-	defaultExpectation *IPVZOrderUseCaseMockGetOrdersExpectation
-	expectations       []*IPVZOrderUseCaseMockGetOrdersExpectation
+	defaultExpectation *IPVZOrderUseCaseMockBatchAcceptOrderDeliveryExpectation
+	expectations       []*IPVZOrderUseCaseMockBatchAcceptOrderDeliveryExpectation
 
-	callArgs []*IPVZOrderUseCaseMockGetOrdersParams
+	callArgs []*IPVZOrderUseCaseMockBatchAcceptOrderDeliveryParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// IPVZOrderUseCaseMockGetOrdersExpectation specifies expectation struct of the IPVZOrderUseCase.GetOrders
-type IPVZOrderUseCaseMockGetOrdersExpectation struct {
+// IPVZOrderUseCaseMockBatchAcceptOrderDeliveryExpectation specifies expectation struct of the IPVZOrderUseCase.BatchAcceptOrderDelivery
+type IPVZOrderUseCaseMockBatchAcceptOrderDeliveryExpectation struct {
 	mock               *IPVZOrderUseCaseMock
-	params             *IPVZOrderUseCaseMockGetOrdersParams
-	paramPtrs          *IPVZOrderUseCaseMockGetOrdersParamPtrs
-	expectationOrigins IPVZOrderUseCaseMockGetOrdersExpectationOrigins
-	results            *IPVZOrderUseCaseMockGetOrdersResults
+	params             *IPVZOrderUseCaseMockBatchAcceptOrderDeliveryParams
+	paramPtrs          *IPVZOrderUseCaseMockBatchAcceptOrderDeliveryParamPtrs
+	expectationOrigins IPVZOrderUseCaseMockBatchAcceptOrderDeliveryExpectationOrigins
+	results            *IPVZOrderUseCaseMockBatchAcceptOrderDeliveryResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// IPVZOrderUseCaseMockGetOrdersParams contains parameters of the IPVZOrderUseCase.GetOrders
-type IPVZOrderUseCaseMockGetOrdersParams struct {
-	ctx     context.Context
-	userID  string
-	options []mm_abstractions.GetOrdersOptFunc
+// IPVZOrderUseCaseMockBatchAcceptOrderDeliveryParams contains parameters of the IPVZOrderUseCase.BatchAcceptOrderDelivery
+type IPVZOrderUseCaseMockBatchAcceptOrderDeliveryParams struct {
+	ctx   context.Context
+	items []domain.DeliveryItem
 }
 
-// IPVZOrderUseCaseMockGetOrdersParamPtrs contains pointers to parameters of the IPVZOrderUseCase.GetOrders
-type IPVZOrderUseCaseMockGetOrdersParamPtrs struct {
-	ctx     *context.Context
-	userID  *string
-	options *[]mm_abstractions.GetOrdersOptFunc
+// IPVZOrderUseCaseMockBatchAcceptOrderDeliveryParamPtrs contains pointers to parameters of the IPVZOrderUseCase.BatchAcceptOrderDelivery
+type IPVZOrderUseCaseMockBatchAcceptOrderDeliveryParamPtrs struct {
+	ctx   *context.Context
+	items *[]domain.DeliveryItem
 }
 
-// IPVZOrderUseCaseMockGetOrdersResults contains results of the IPVZOrderUseCase.GetOrders
-type IPVZOrderUseCaseMockGetOrdersResults struct {
-	pa1 []domain.PVZOrder
+// IPVZOrderUseCaseMockBatchAcceptOrderDeliveryResults contains results of the IPVZOrderUseCase.BatchAcceptOrderDelivery
+type IPVZOrderUseCaseMockBatchAcceptOrderDeliveryResults struct {
+	da1 []domain.DeliveryResult
 	err error
 }
 
-// IPVZOrderUseCaseMockGetOrdersOrigins contains origins of expectations of the IPVZOrderUseCase.GetOrders
-type IPVZOrderUseCaseMockGetOrdersExpectationOrigins struct {
-	origin        string
-	originCtx     string
-	originUserID  string
-	originOptions string
+// IPVZOrderUseCaseMockBatchAcceptOrderDeliveryOrigins contains origins of expectations of the IPVZOrderUseCase.BatchAcceptOrderDelivery
+type IPVZOrderUseCaseMockBatchAcceptOrderDeliveryExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originItems string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1052,219 +1224,1343 @@ type IPVZOrderUseCaseMockGetOrdersExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetOrders *mIPVZOrderUseCaseMockGetOrders) Optional() *mIPVZOrderUseCaseMockGetOrders {
-	mmGetOrders.optional = true
-	return mmGetOrders
+func (mmBatchAcceptOrderDelivery *mIPVZOrderUseCaseMockBatchAcceptOrderDelivery) Optional() *mIPVZOrderUseCaseMockBatchAcceptOrderDelivery {
+	mmBatchAcceptOrderDelivery.optional = true
+	return mmBatchAcceptOrderDelivery
 }
 
-// Expect sets up expected params for IPVZOrderUseCase.GetOrders
-func (mmGetOrders *mIPVZOrderUseCaseMockGetOrders) Expect(ctx context.Context, userID string, options ...mm_abstractions.GetOrdersOptFunc) *mIPVZOrderUseCaseMockGetOrders {
-	if mmGetOrders.mock.funcGetOrders != nil {
-		mmGetOrders.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrders mock is already set by Set")
+// Expect sets up expected params for IPVZOrderUseCase.BatchAcceptOrderDelivery
+func (mmBatchAcceptOrderDelivery *mIPVZOrderUseCaseMockBatchAcceptOrderDelivery) Expect(ctx context.Context, items []domain.DeliveryItem) *mIPVZOrderUseCaseMockBatchAcceptOrderDelivery {
+	if mmBatchAcceptOrderDelivery.mock.funcBatchAcceptOrderDelivery != nil {
+		mmBatchAcceptOrderDelivery.mock.t.Fatalf("IPVZOrderUseCaseMock.BatchAcceptOrderDelivery mock is already set by Set")
 	}
 
-	if mmGetOrders.defaultExpectation == nil {
-		mmGetOrders.defaultExpectation = &IPVZOrderUseCaseMockGetOrdersExpectation{}
+	if mmBatchAcceptOrderDelivery.defaultExpectation == nil {
+		mmBatchAcceptOrderDelivery.defaultExpectation = &IPVZOrderUseCaseMockBatchAcceptOrderDeliveryExpectation{}
 	}
 
-	if mmGetOrders.defaultExpectation.paramPtrs != nil {
-		mmGetOrders.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrders mock is already set by ExpectParams functions")
+	if mmBatchAcceptOrderDelivery.defaultExpectation.paramPtrs != nil {
+		mmBatchAcceptOrderDelivery.mock.t.Fatalf("IPVZOrderUseCaseMock.BatchAcceptOrderDelivery mock is already set by ExpectParams functions")
 	}
 
-	mmGetOrders.defaultExpectation.params = &IPVZOrderUseCaseMockGetOrdersParams{ctx, userID, options}
-	mmGetOrders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetOrders.expectations {
-		if minimock.Equal(e.params, mmGetOrders.defaultExpectation.params) {
-			mmGetOrders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrders.defaultExpectation.params)
+	mmBatchAcceptOrderDelivery.defaultExpectation.params = &IPVZOrderUseCaseMockBatchAcceptOrderDeliveryParams{ctx, items}
+	mmBatchAcceptOrderDelivery.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmBatchAcceptOrderDelivery.expectations {
+		if minimock.Equal(e.params, mmBatchAcceptOrderDelivery.defaultExpectation.params) {
+			mmBatchAcceptOrderDelivery.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmBatchAcceptOrderDelivery.defaultExpectation.params)
 		}
 	}
 
-	return mmGetOrders
-}
-
-// ExpectCtxParam1 sets up expected param ctx for IPVZOrderUseCase.GetOrders
-func (mmGetOrders *mIPVZOrderUseCaseMockGetOrders) ExpectCtxParam1(ctx context.Context) *mIPVZOrderUseCaseMockGetOrders {
-	if mmGetOrders.mock.funcGetOrders != nil {
-		mmGetOrders.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrders mock is already set by Set")
-	}
-
-	if mmGetOrders.defaultExpectation == nil {
-		mmGetOrders.defaultExpectation = &IPVZOrderUseCaseMockGetOrdersExpectation{}
-	}
-
-	if mmGetOrders.defaultExpectation.params != nil {
-		mmGetOrders.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrders mock is already set by Expect")
-	}
-
-	if mmGetOrders.defaultExpectation.paramPtrs == nil {
-		mmGetOrders.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockGetOrdersParamPtrs{}
-	}
-	mmGetOrders.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetOrders.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
-
-	return mmGetOrders
+	return mmBatchAcceptOrderDelivery
 }
 
-// ExpectUserIDParam2 sets up expected param userID for IPVZOrderUseCase.GetOrders
-func (mmGetOrders *mIPVZOrderUseCaseMockGetOrders) ExpectUserIDParam2(userID string) *mIPVZOrderUseCaseMockGetOrders {
-	if mmGetOrders.mock.funcGetOrders != nil {
-		mmGetOrders.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrders mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for IPVZOrderUseCase.BatchAcceptOrderDelivery
+func (mmBatchAcceptOrderDelivery *mIPVZOrderUseCaseMockBatchAcceptOrderDelivery) ExpectCtxParam1(ctx context.Context) *mIPVZOrderUseCaseMockBatchAcceptOrderDelivery {
+	if mmBatchAcceptOrderDelivery.mock.funcBatchAcceptOrderDelivery != nil {
+		mmBatchAcceptOrderDelivery.mock.t.Fatalf("IPVZOrderUseCaseMock.BatchAcceptOrderDelivery mock is already set by Set")
 	}
 
-	if mmGetOrders.defaultExpectation == nil {
-		mmGetOrders.defaultExpectation = &IPVZOrderUseCaseMockGetOrdersExpectation{}
+	if mmBatchAcceptOrderDelivery.defaultExpectation == nil {
+		mmBatchAcceptOrderDelivery.defaultExpectation = &IPVZOrderUseCaseMockBatchAcceptOrderDeliveryExpectation{}
 	}
 
-	if mmGetOrders.defaultExpectation.params != nil {
-		mmGetOrders.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrders mock is already set by Expect")
+	if mmBatchAcceptOrderDelivery.defaultExpectation.params != nil {
+		mmBatchAcceptOrderDelivery.mock.t.Fatalf("IPVZOrderUseCaseMock.BatchAcceptOrderDelivery mock is already set by Expect")
 	}
 
-	if mmGetOrders.defaultExpectation.paramPtrs == nil {
-		mmGetOrders.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockGetOrdersParamPtrs{}
+	if mmBatchAcceptOrderDelivery.defaultExpectation.paramPtrs == nil {
+		mmBatchAcceptOrderDelivery.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockBatchAcceptOrderDeliveryParamPtrs{}
 	}
-	mmGetOrders.defaultExpectation.paramPtrs.userID = &userID
-	mmGetOrders.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmBatchAcceptOrderDelivery.defaultExpectation.paramPtrs.ctx = &ctx
+	mmBatchAcceptOrderDelivery.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetOrders
+	return mmBatchAcceptOrderDelivery
 }
 
-// ExpectOptionsParam3 sets up expected param options for IPVZOrderUseCase.GetOrders
-func (mmGetOrders *mIPVZOrderUseCaseMockGetOrders) ExpectOptionsParam3(options ...mm_abstractions.GetOrdersOptFunc) *mIPVZOrderUseCaseMockGetOrders {
-	if mmGetOrders.mock.funcGetOrders != nil {
-		mmGetOrders.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrders mock is already set by Set")
+// ExpectItemsParam2 sets up expected param items for IPVZOrderUseCase.BatchAcceptOrderDelivery
+func (mmBatchAcceptOrderDelivery *mIPVZOrderUseCaseMockBatchAcceptOrderDelivery) ExpectItemsParam2(items []domain.DeliveryItem) *mIPVZOrderUseCaseMockBatchAcceptOrderDelivery {
+	if mmBatchAcceptOrderDelivery.mock.funcBatchAcceptOrderDelivery != nil {
+		mmBatchAcceptOrderDelivery.mock.t.Fatalf("IPVZOrderUseCaseMock.BatchAcceptOrderDelivery mock is already set by Set")
 	}
 
-	if mmGetOrders.defaultExpectation == nil {
-		mmGetOrders.defaultExpectation = &IPVZOrderUseCaseMockGetOrdersExpectation{}
+	if mmBatchAcceptOrderDelivery.defaultExpectation == nil {
+		mmBatchAcceptOrderDelivery.defaultExpectation = &IPVZOrderUseCaseMockBatchAcceptOrderDeliveryExpectation{}
 	}
 
-	if mmGetOrders.defaultExpectation.params != nil {
-		mmGetOrders.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrders mock is already set by Expect")
+	if mmBatchAcceptOrderDelivery.defaultExpectation.params != nil {
+		mmBatchAcceptOrderDelivery.mock.t.Fatalf("IPVZOrderUseCaseMock.BatchAcceptOrderDelivery mock is already set by Expect")
 	}
 
-	if mmGetOrders.defaultExpectation.paramPtrs == nil {
-		mmGetOrders.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockGetOrdersParamPtrs{}
+	if mmBatchAcceptOrderDelivery.defaultExpectation.paramPtrs == nil {
+		mmBatchAcceptOrderDelivery.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockBatchAcceptOrderDeliveryParamPtrs{}
 	}
-	mmGetOrders.defaultExpectation.paramPtrs.options = &options
-	mmGetOrders.defaultExpectation.expectationOrigins.originOptions = minimock.CallerInfo(1)
+	mmBatchAcceptOrderDelivery.defaultExpectation.paramPtrs.items = &items
+	mmBatchAcceptOrderDelivery.defaultExpectation.expectationOrigins.originItems = minimock.CallerInfo(1)
 
-	return mmGetOrders
+	return mmBatchAcceptOrderDelivery
 }
 
-// Inspect accepts an inspector function that has same arguments as the IPVZOrderUseCase.GetOrders
-func (mmGetOrders *mIPVZOrderUseCaseMockGetOrders) Inspect(f func(ctx context.Context, userID string, options ...mm_abstractions.GetOrdersOptFunc)) *mIPVZOrderUseCaseMockGetOrders {
-	if mmGetOrders.mock.inspectFuncGetOrders != nil {
-		mmGetOrders.mock.t.Fatalf("Inspect function is already set for IPVZOrderUseCaseMock.GetOrders")
+// Inspect accepts an inspector function that has same arguments as the IPVZOrderUseCase.BatchAcceptOrderDelivery
+func (mmBatchAcceptOrderDelivery *mIPVZOrderUseCaseMockBatchAcceptOrderDelivery) Inspect(f func(ctx context.Context, items []domain.DeliveryItem)) *mIPVZOrderUseCaseMockBatchAcceptOrderDelivery {
+	if mmBatchAcceptOrderDelivery.mock.inspectFuncBatchAcceptOrderDelivery != nil {
+		mmBatchAcceptOrderDelivery.mock.t.Fatalf("Inspect function is already set for IPVZOrderUseCaseMock.BatchAcceptOrderDelivery")
 	}
 
-	mmGetOrders.mock.inspectFuncGetOrders = f
+	mmBatchAcceptOrderDelivery.mock.inspectFuncBatchAcceptOrderDelivery = f
 
-	return mmGetOrders
+	return mmBatchAcceptOrderDelivery
 }
 
-// Return sets up results that will be returned by IPVZOrderUseCase.GetOrders
-func (mmGetOrders *mIPVZOrderUseCaseMockGetOrders) Return(pa1 []domain.PVZOrder, err error) *IPVZOrderUseCaseMock {
-	if mmGetOrders.mock.funcGetOrders != nil {
-		mmGetOrders.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrders mock is already set by Set")
+// Return sets up results that will be returned by IPVZOrderUseCase.BatchAcceptOrderDelivery
+func (mmBatchAcceptOrderDelivery *mIPVZOrderUseCaseMockBatchAcceptOrderDelivery) Return(da1 []domain.DeliveryResult, err error) *IPVZOrderUseCaseMock {
+	if mmBatchAcceptOrderDelivery.mock.funcBatchAcceptOrderDelivery != nil {
+		mmBatchAcceptOrderDelivery.mock.t.Fatalf("IPVZOrderUseCaseMock.BatchAcceptOrderDelivery mock is already set by Set")
 	}
 
-	if mmGetOrders.defaultExpectation == nil {
-		mmGetOrders.defaultExpectation = &IPVZOrderUseCaseMockGetOrdersExpectation{mock: mmGetOrders.mock}
+	if mmBatchAcceptOrderDelivery.defaultExpectation == nil {
+		mmBatchAcceptOrderDelivery.defaultExpectation = &IPVZOrderUseCaseMockBatchAcceptOrderDeliveryExpectation{mock: mmBatchAcceptOrderDelivery.mock}
 	}
-	mmGetOrders.defaultExpectation.results = &IPVZOrderUseCaseMockGetOrdersResults{pa1, err}
-	mmGetOrders.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetOrders.mock
+	mmBatchAcceptOrderDelivery.defaultExpectation.results = &IPVZOrderUseCaseMockBatchAcceptOrderDeliveryResults{da1, err}
+	mmBatchAcceptOrderDelivery.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmBatchAcceptOrderDelivery.mock
 }
 
-// Set uses given function f to mock the IPVZOrderUseCase.GetOrders method
-func (mmGetOrders *mIPVZOrderUseCaseMockGetOrders) Set(f func(ctx context.Context, userID string, options ...mm_abstractions.GetOrdersOptFunc) (pa1 []domain.PVZOrder, err error)) *IPVZOrderUseCaseMock {
-	if mmGetOrders.defaultExpectation != nil {
-		mmGetOrders.mock.t.Fatalf("Default expectation is already set for the IPVZOrderUseCase.GetOrders method")
+// Set uses given function f to mock the IPVZOrderUseCase.BatchAcceptOrderDelivery method
+func (mmBatchAcceptOrderDelivery *mIPVZOrderUseCaseMockBatchAcceptOrderDelivery) Set(f func(ctx context.Context, items []domain.DeliveryItem) (da1 []domain.DeliveryResult, err error)) *IPVZOrderUseCaseMock {
+	if mmBatchAcceptOrderDelivery.defaultExpectation != nil {
+		mmBatchAcceptOrderDelivery.mock.t.Fatalf("Default expectation is already set for the IPVZOrderUseCase.BatchAcceptOrderDelivery method")
 	}
 
-	if len(mmGetOrders.expectations) > 0 {
-		mmGetOrders.mock.t.Fatalf("Some expectations are already set for the IPVZOrderUseCase.GetOrders method")
+	if len(mmBatchAcceptOrderDelivery.expectations) > 0 {
+		mmBatchAcceptOrderDelivery.mock.t.Fatalf("Some expectations are already set for the IPVZOrderUseCase.BatchAcceptOrderDelivery method")
 	}
 
-	mmGetOrders.mock.funcGetOrders = f
-	mmGetOrders.mock.funcGetOrdersOrigin = minimock.CallerInfo(1)
-	return mmGetOrders.mock
+	mmBatchAcceptOrderDelivery.mock.funcBatchAcceptOrderDelivery = f
+	mmBatchAcceptOrderDelivery.mock.funcBatchAcceptOrderDeliveryOrigin = minimock.CallerInfo(1)
+	return mmBatchAcceptOrderDelivery.mock
 }
 
-// When sets expectation for the IPVZOrderUseCase.GetOrders which will trigger the result defined by the following
+// When sets expectation for the IPVZOrderUseCase.BatchAcceptOrderDelivery which will trigger the result defined by the following
 // Then helper
-func (mmGetOrders *mIPVZOrderUseCaseMockGetOrders) When(ctx context.Context, userID string, options ...mm_abstractions.GetOrdersOptFunc) *IPVZOrderUseCaseMockGetOrdersExpectation {
-	if mmGetOrders.mock.funcGetOrders != nil {
-		mmGetOrders.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrders mock is already set by Set")
+func (mmBatchAcceptOrderDelivery *mIPVZOrderUseCaseMockBatchAcceptOrderDelivery) When(ctx context.Context, items []domain.DeliveryItem) *IPVZOrderUseCaseMockBatchAcceptOrderDeliveryExpectation {
+	if mmBatchAcceptOrderDelivery.mock.funcBatchAcceptOrderDelivery != nil {
+		mmBatchAcceptOrderDelivery.mock.t.Fatalf("IPVZOrderUseCaseMock.BatchAcceptOrderDelivery mock is already set by Set")
 	}
 
-	expectation := &IPVZOrderUseCaseMockGetOrdersExpectation{
-		mock:               mmGetOrders.mock,
-		params:             &IPVZOrderUseCaseMockGetOrdersParams{ctx, userID, options},
-		expectationOrigins: IPVZOrderUseCaseMockGetOrdersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &IPVZOrderUseCaseMockBatchAcceptOrderDeliveryExpectation{
+		mock:               mmBatchAcceptOrderDelivery.mock,
+		params:             &IPVZOrderUseCaseMockBatchAcceptOrderDeliveryParams{ctx, items},
+		expectationOrigins: IPVZOrderUseCaseMockBatchAcceptOrderDeliveryExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetOrders.expectations = append(mmGetOrders.expectations, expectation)
+	mmBatchAcceptOrderDelivery.expectations = append(mmBatchAcceptOrderDelivery.expectations, expectation)
 	return expectation
 }
 
-// Then sets up IPVZOrderUseCase.GetOrders return parameters for the expectation previously defined by the When method
-func (e *IPVZOrderUseCaseMockGetOrdersExpectation) Then(pa1 []domain.PVZOrder, err error) *IPVZOrderUseCaseMock {
-	e.results = &IPVZOrderUseCaseMockGetOrdersResults{pa1, err}
+// Then sets up IPVZOrderUseCase.BatchAcceptOrderDelivery return parameters for the expectation previously defined by the When method
+func (e *IPVZOrderUseCaseMockBatchAcceptOrderDeliveryExpectation) Then(da1 []domain.DeliveryResult, err error) *IPVZOrderUseCaseMock {
+	e.results = &IPVZOrderUseCaseMockBatchAcceptOrderDeliveryResults{da1, err}
 	return e.mock
 }
 
-// Times sets number of times IPVZOrderUseCase.GetOrders should be invoked
-func (mmGetOrders *mIPVZOrderUseCaseMockGetOrders) Times(n uint64) *mIPVZOrderUseCaseMockGetOrders {
+// Times sets number of times IPVZOrderUseCase.BatchAcceptOrderDelivery should be invoked
+func (mmBatchAcceptOrderDelivery *mIPVZOrderUseCaseMockBatchAcceptOrderDelivery) Times(n uint64) *mIPVZOrderUseCaseMockBatchAcceptOrderDelivery {
 	if n == 0 {
-		mmGetOrders.mock.t.Fatalf("Times of IPVZOrderUseCaseMock.GetOrders mock can not be zero")
+		mmBatchAcceptOrderDelivery.mock.t.Fatalf("Times of IPVZOrderUseCaseMock.BatchAcceptOrderDelivery mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetOrders.expectedInvocations, n)
-	mmGetOrders.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetOrders
+	mm_atomic.StoreUint64(&mmBatchAcceptOrderDelivery.expectedInvocations, n)
+	mmBatchAcceptOrderDelivery.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmBatchAcceptOrderDelivery
 }
 
-func (mmGetOrders *mIPVZOrderUseCaseMockGetOrders) invocationsDone() bool {
-	if len(mmGetOrders.expectations) == 0 && mmGetOrders.defaultExpectation == nil && mmGetOrders.mock.funcGetOrders == nil {
+func (mmBatchAcceptOrderDelivery *mIPVZOrderUseCaseMockBatchAcceptOrderDelivery) invocationsDone() bool {
+	if len(mmBatchAcceptOrderDelivery.expectations) == 0 && mmBatchAcceptOrderDelivery.defaultExpectation == nil && mmBatchAcceptOrderDelivery.mock.funcBatchAcceptOrderDelivery == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetOrders.mock.afterGetOrdersCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetOrders.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmBatchAcceptOrderDelivery.mock.afterBatchAcceptOrderDeliveryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmBatchAcceptOrderDelivery.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetOrders implements mm_abstractions.IPVZOrderUseCase
-func (mmGetOrders *IPVZOrderUseCaseMock) GetOrders(ctx context.Context, userID string, options ...mm_abstractions.GetOrdersOptFunc) (pa1 []domain.PVZOrder, err error) {
-	mm_atomic.AddUint64(&mmGetOrders.beforeGetOrdersCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetOrders.afterGetOrdersCounter, 1)
+// BatchAcceptOrderDelivery implements mm_abstractions.IPVZOrderUseCase
+func (mmBatchAcceptOrderDelivery *IPVZOrderUseCaseMock) BatchAcceptOrderDelivery(ctx context.Context, items []domain.DeliveryItem) (da1 []domain.DeliveryResult, err error) {
+	mm_atomic.AddUint64(&mmBatchAcceptOrderDelivery.beforeBatchAcceptOrderDeliveryCounter, 1)
+	defer mm_atomic.AddUint64(&mmBatchAcceptOrderDelivery.afterBatchAcceptOrderDeliveryCounter, 1)
 
-	mmGetOrders.t.Helper()
+	mmBatchAcceptOrderDelivery.t.Helper()
 
-	if mmGetOrders.inspectFuncGetOrders != nil {
-		mmGetOrders.inspectFuncGetOrders(ctx, userID, options...)
+	if mmBatchAcceptOrderDelivery.inspectFuncBatchAcceptOrderDelivery != nil {
+		mmBatchAcceptOrderDelivery.inspectFuncBatchAcceptOrderDelivery(ctx, items)
 	}
 
-	mm_params := IPVZOrderUseCaseMockGetOrdersParams{ctx, userID, options}
+	mm_params := IPVZOrderUseCaseMockBatchAcceptOrderDeliveryParams{ctx, items}
 
 	// Record call args
-	mmGetOrders.GetOrdersMock.mutex.Lock()
-	mmGetOrders.GetOrdersMock.callArgs = append(mmGetOrders.GetOrdersMock.callArgs, &mm_params)
-	mmGetOrders.GetOrdersMock.mutex.Unlock()
+	mmBatchAcceptOrderDelivery.BatchAcceptOrderDeliveryMock.mutex.Lock()
+	mmBatchAcceptOrderDelivery.BatchAcceptOrderDeliveryMock.callArgs = append(mmBatchAcceptOrderDelivery.BatchAcceptOrderDeliveryMock.callArgs, &mm_params)
+	mmBatchAcceptOrderDelivery.BatchAcceptOrderDeliveryMock.mutex.Unlock()
 
-	for _, e := range mmGetOrders.GetOrdersMock.expectations {
+	for _, e := range mmBatchAcceptOrderDelivery.BatchAcceptOrderDeliveryMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.pa1, e.results.err
+			return e.results.da1, e.results.err
 		}
 	}
 
-	if mmGetOrders.GetOrdersMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetOrders.GetOrdersMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetOrders.GetOrdersMock.defaultExpectation.params
-		mm_want_ptrs := mmGetOrders.GetOrdersMock.defaultExpectation.paramPtrs
+	if mmBatchAcceptOrderDelivery.BatchAcceptOrderDeliveryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmBatchAcceptOrderDelivery.BatchAcceptOrderDeliveryMock.defaultExpectation.Counter, 1)
+		mm_want := mmBatchAcceptOrderDelivery.BatchAcceptOrderDeliveryMock.defaultExpectation.params
+		mm_want_ptrs := mmBatchAcceptOrderDelivery.BatchAcceptOrderDeliveryMock.defaultExpectation.paramPtrs
 
-		mm_got := IPVZOrderUseCaseMockGetOrdersParams{ctx, userID, options}
+		mm_got := IPVZOrderUseCaseMockBatchAcceptOrderDeliveryParams{ctx, items}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmBatchAcceptOrderDelivery.t.Errorf("IPVZOrderUseCaseMock.BatchAcceptOrderDelivery got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBatchAcceptOrderDelivery.BatchAcceptOrderDeliveryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.items != nil && !minimock.Equal(*mm_want_ptrs.items, mm_got.items) {
+				mmBatchAcceptOrderDelivery.t.Errorf("IPVZOrderUseCaseMock.BatchAcceptOrderDelivery got unexpected parameter items, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmBatchAcceptOrderDelivery.BatchAcceptOrderDeliveryMock.defaultExpectation.expectationOrigins.originItems, *mm_want_ptrs.items, mm_got.items, minimock.Diff(*mm_want_ptrs.items, mm_got.items))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmBatchAcceptOrderDelivery.t.Errorf("IPVZOrderUseCaseMock.BatchAcceptOrderDelivery got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmBatchAcceptOrderDelivery.BatchAcceptOrderDeliveryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmBatchAcceptOrderDelivery.BatchAcceptOrderDeliveryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmBatchAcceptOrderDelivery.t.Fatal("No results are set for the IPVZOrderUseCaseMock.BatchAcceptOrderDelivery")
+		}
+		return (*mm_results).da1, (*mm_results).err
+	}
+	if mmBatchAcceptOrderDelivery.funcBatchAcceptOrderDelivery != nil {
+		return mmBatchAcceptOrderDelivery.funcBatchAcceptOrderDelivery(ctx, items)
+	}
+	mmBatchAcceptOrderDelivery.t.Fatalf("Unexpected call to IPVZOrderUseCaseMock.BatchAcceptOrderDelivery. %v %v", ctx, items)
+	return
+}
+
+// BatchAcceptOrderDeliveryAfterCounter returns a count of finished IPVZOrderUseCaseMock.BatchAcceptOrderDelivery invocations
+func (mmBatchAcceptOrderDelivery *IPVZOrderUseCaseMock) BatchAcceptOrderDeliveryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBatchAcceptOrderDelivery.afterBatchAcceptOrderDeliveryCounter)
+}
+
+// BatchAcceptOrderDeliveryBeforeCounter returns a count of IPVZOrderUseCaseMock.BatchAcceptOrderDelivery invocations
+func (mmBatchAcceptOrderDelivery *IPVZOrderUseCaseMock) BatchAcceptOrderDeliveryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmBatchAcceptOrderDelivery.beforeBatchAcceptOrderDeliveryCounter)
+}
+
+// Calls returns a list of arguments used in each call to IPVZOrderUseCaseMock.BatchAcceptOrderDelivery.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmBatchAcceptOrderDelivery *mIPVZOrderUseCaseMockBatchAcceptOrderDelivery) Calls() []*IPVZOrderUseCaseMockBatchAcceptOrderDeliveryParams {
+	mmBatchAcceptOrderDelivery.mutex.RLock()
+
+	argCopy := make([]*IPVZOrderUseCaseMockBatchAcceptOrderDeliveryParams, len(mmBatchAcceptOrderDelivery.callArgs))
+	copy(argCopy, mmBatchAcceptOrderDelivery.callArgs)
+
+	mmBatchAcceptOrderDelivery.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockBatchAcceptOrderDeliveryDone returns true if the count of the BatchAcceptOrderDelivery invocations corresponds
+// the number of defined expectations
+func (m *IPVZOrderUseCaseMock) MinimockBatchAcceptOrderDeliveryDone() bool {
+	if m.BatchAcceptOrderDeliveryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.BatchAcceptOrderDeliveryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.BatchAcceptOrderDeliveryMock.invocationsDone()
+}
+
+// MinimockBatchAcceptOrderDeliveryInspect logs each unmet expectation
+func (m *IPVZOrderUseCaseMock) MinimockBatchAcceptOrderDeliveryInspect() {
+	for _, e := range m.BatchAcceptOrderDeliveryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IPVZOrderUseCaseMock.BatchAcceptOrderDelivery at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterBatchAcceptOrderDeliveryCounter := mm_atomic.LoadUint64(&m.afterBatchAcceptOrderDeliveryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.BatchAcceptOrderDeliveryMock.defaultExpectation != nil && afterBatchAcceptOrderDeliveryCounter < 1 {
+		if m.BatchAcceptOrderDeliveryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IPVZOrderUseCaseMock.BatchAcceptOrderDelivery at\n%s", m.BatchAcceptOrderDeliveryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IPVZOrderUseCaseMock.BatchAcceptOrderDelivery at\n%s with params: %#v", m.BatchAcceptOrderDeliveryMock.defaultExpectation.expectationOrigins.origin, *m.BatchAcceptOrderDeliveryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcBatchAcceptOrderDelivery != nil && afterBatchAcceptOrderDeliveryCounter < 1 {
+		m.t.Errorf("Expected call to IPVZOrderUseCaseMock.BatchAcceptOrderDelivery at\n%s", m.funcBatchAcceptOrderDeliveryOrigin)
+	}
+
+	if !m.BatchAcceptOrderDeliveryMock.invocationsDone() && afterBatchAcceptOrderDeliveryCounter > 0 {
+		m.t.Errorf("Expected %d calls to IPVZOrderUseCaseMock.BatchAcceptOrderDelivery at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.BatchAcceptOrderDeliveryMock.expectedInvocations), m.BatchAcceptOrderDeliveryMock.expectedInvocationsOrigin, afterBatchAcceptOrderDeliveryCounter)
+	}
+}
+
+type mIPVZOrderUseCaseMockExtendStorage struct {
+	optional           bool
+	mock               *IPVZOrderUseCaseMock
+	defaultExpectation *IPVZOrderUseCaseMockExtendStorageExpectation
+	expectations       []*IPVZOrderUseCaseMockExtendStorageExpectation
+
+	callArgs []*IPVZOrderUseCaseMockExtendStorageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IPVZOrderUseCaseMockExtendStorageExpectation specifies expectation struct of the IPVZOrderUseCase.ExtendStorage
+type IPVZOrderUseCaseMockExtendStorageExpectation struct {
+	mock               *IPVZOrderUseCaseMock
+	params             *IPVZOrderUseCaseMockExtendStorageParams
+	paramPtrs          *IPVZOrderUseCaseMockExtendStorageParamPtrs
+	expectationOrigins IPVZOrderUseCaseMockExtendStorageExpectationOrigins
+	results            *IPVZOrderUseCaseMockExtendStorageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IPVZOrderUseCaseMockExtendStorageParams contains parameters of the IPVZOrderUseCase.ExtendStorage
+type IPVZOrderUseCaseMockExtendStorageParams struct {
+	ctx        context.Context
+	orderID    string
+	extension  time.Duration
+	extendedBy string
+	options    []mm_abstractions.MutationOptFunc
+}
+
+// IPVZOrderUseCaseMockExtendStorageParamPtrs contains pointers to parameters of the IPVZOrderUseCase.ExtendStorage
+type IPVZOrderUseCaseMockExtendStorageParamPtrs struct {
+	ctx        *context.Context
+	orderID    *string
+	extension  *time.Duration
+	extendedBy *string
+	options    *[]mm_abstractions.MutationOptFunc
+}
+
+// IPVZOrderUseCaseMockExtendStorageResults contains results of the IPVZOrderUseCase.ExtendStorage
+type IPVZOrderUseCaseMockExtendStorageResults struct {
+	err error
+}
+
+// IPVZOrderUseCaseMockExtendStorageOrigins contains origins of expectations of the IPVZOrderUseCase.ExtendStorage
+type IPVZOrderUseCaseMockExtendStorageExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originOrderID    string
+	originExtension  string
+	originExtendedBy string
+	originOptions    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExtendStorage *mIPVZOrderUseCaseMockExtendStorage) Optional() *mIPVZOrderUseCaseMockExtendStorage {
+	mmExtendStorage.optional = true
+	return mmExtendStorage
+}
+
+// Expect sets up expected params for IPVZOrderUseCase.ExtendStorage
+func (mmExtendStorage *mIPVZOrderUseCaseMockExtendStorage) Expect(ctx context.Context, orderID string, extension time.Duration, extendedBy string, options ...mm_abstractions.MutationOptFunc) *mIPVZOrderUseCaseMockExtendStorage {
+	if mmExtendStorage.mock.funcExtendStorage != nil {
+		mmExtendStorage.mock.t.Fatalf("IPVZOrderUseCaseMock.ExtendStorage mock is already set by Set")
+	}
+
+	if mmExtendStorage.defaultExpectation == nil {
+		mmExtendStorage.defaultExpectation = &IPVZOrderUseCaseMockExtendStorageExpectation{}
+	}
+
+	if mmExtendStorage.defaultExpectation.paramPtrs != nil {
+		mmExtendStorage.mock.t.Fatalf("IPVZOrderUseCaseMock.ExtendStorage mock is already set by ExpectParams functions")
+	}
+
+	mmExtendStorage.defaultExpectation.params = &IPVZOrderUseCaseMockExtendStorageParams{ctx, orderID, extension, extendedBy, options}
+	mmExtendStorage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExtendStorage.expectations {
+		if minimock.Equal(e.params, mmExtendStorage.defaultExpectation.params) {
+			mmExtendStorage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExtendStorage.defaultExpectation.params)
+		}
+	}
+
+	return mmExtendStorage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IPVZOrderUseCase.ExtendStorage
+func (mmExtendStorage *mIPVZOrderUseCaseMockExtendStorage) ExpectCtxParam1(ctx context.Context) *mIPVZOrderUseCaseMockExtendStorage {
+	if mmExtendStorage.mock.funcExtendStorage != nil {
+		mmExtendStorage.mock.t.Fatalf("IPVZOrderUseCaseMock.ExtendStorage mock is already set by Set")
+	}
+
+	if mmExtendStorage.defaultExpectation == nil {
+		mmExtendStorage.defaultExpectation = &IPVZOrderUseCaseMockExtendStorageExpectation{}
+	}
+
+	if mmExtendStorage.defaultExpectation.params != nil {
+		mmExtendStorage.mock.t.Fatalf("IPVZOrderUseCaseMock.ExtendStorage mock is already set by Expect")
+	}
+
+	if mmExtendStorage.defaultExpectation.paramPtrs == nil {
+		mmExtendStorage.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockExtendStorageParamPtrs{}
+	}
+	mmExtendStorage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExtendStorage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExtendStorage
+}
+
+// ExpectOrderIDParam2 sets up expected param orderID for IPVZOrderUseCase.ExtendStorage
+func (mmExtendStorage *mIPVZOrderUseCaseMockExtendStorage) ExpectOrderIDParam2(orderID string) *mIPVZOrderUseCaseMockExtendStorage {
+	if mmExtendStorage.mock.funcExtendStorage != nil {
+		mmExtendStorage.mock.t.Fatalf("IPVZOrderUseCaseMock.ExtendStorage mock is already set by Set")
+	}
+
+	if mmExtendStorage.defaultExpectation == nil {
+		mmExtendStorage.defaultExpectation = &IPVZOrderUseCaseMockExtendStorageExpectation{}
+	}
+
+	if mmExtendStorage.defaultExpectation.params != nil {
+		mmExtendStorage.mock.t.Fatalf("IPVZOrderUseCaseMock.ExtendStorage mock is already set by Expect")
+	}
+
+	if mmExtendStorage.defaultExpectation.paramPtrs == nil {
+		mmExtendStorage.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockExtendStorageParamPtrs{}
+	}
+	mmExtendStorage.defaultExpectation.paramPtrs.orderID = &orderID
+	mmExtendStorage.defaultExpectation.expectationOrigins.originOrderID = minimock.CallerInfo(1)
+
+	return mmExtendStorage
+}
+
+// ExpectExtensionParam3 sets up expected param extension for IPVZOrderUseCase.ExtendStorage
+func (mmExtendStorage *mIPVZOrderUseCaseMockExtendStorage) ExpectExtensionParam3(extension time.Duration) *mIPVZOrderUseCaseMockExtendStorage {
+	if mmExtendStorage.mock.funcExtendStorage != nil {
+		mmExtendStorage.mock.t.Fatalf("IPVZOrderUseCaseMock.ExtendStorage mock is already set by Set")
+	}
+
+	if mmExtendStorage.defaultExpectation == nil {
+		mmExtendStorage.defaultExpectation = &IPVZOrderUseCaseMockExtendStorageExpectation{}
+	}
+
+	if mmExtendStorage.defaultExpectation.params != nil {
+		mmExtendStorage.mock.t.Fatalf("IPVZOrderUseCaseMock.ExtendStorage mock is already set by Expect")
+	}
+
+	if mmExtendStorage.defaultExpectation.paramPtrs == nil {
+		mmExtendStorage.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockExtendStorageParamPtrs{}
+	}
+	mmExtendStorage.defaultExpectation.paramPtrs.extension = &extension
+	mmExtendStorage.defaultExpectation.expectationOrigins.originExtension = minimock.CallerInfo(1)
+
+	return mmExtendStorage
+}
+
+// ExpectExtendedByParam4 sets up expected param extendedBy for IPVZOrderUseCase.ExtendStorage
+func (mmExtendStorage *mIPVZOrderUseCaseMockExtendStorage) ExpectExtendedByParam4(extendedBy string) *mIPVZOrderUseCaseMockExtendStorage {
+	if mmExtendStorage.mock.funcExtendStorage != nil {
+		mmExtendStorage.mock.t.Fatalf("IPVZOrderUseCaseMock.ExtendStorage mock is already set by Set")
+	}
+
+	if mmExtendStorage.defaultExpectation == nil {
+		mmExtendStorage.defaultExpectation = &IPVZOrderUseCaseMockExtendStorageExpectation{}
+	}
+
+	if mmExtendStorage.defaultExpectation.params != nil {
+		mmExtendStorage.mock.t.Fatalf("IPVZOrderUseCaseMock.ExtendStorage mock is already set by Expect")
+	}
+
+	if mmExtendStorage.defaultExpectation.paramPtrs == nil {
+		mmExtendStorage.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockExtendStorageParamPtrs{}
+	}
+	mmExtendStorage.defaultExpectation.paramPtrs.extendedBy = &extendedBy
+	mmExtendStorage.defaultExpectation.expectationOrigins.originExtendedBy = minimock.CallerInfo(1)
+
+	return mmExtendStorage
+}
+
+// ExpectOptionsParam5 sets up expected param options for IPVZOrderUseCase.ExtendStorage
+func (mmExtendStorage *mIPVZOrderUseCaseMockExtendStorage) ExpectOptionsParam5(options ...mm_abstractions.MutationOptFunc) *mIPVZOrderUseCaseMockExtendStorage {
+	if mmExtendStorage.mock.funcExtendStorage != nil {
+		mmExtendStorage.mock.t.Fatalf("IPVZOrderUseCaseMock.ExtendStorage mock is already set by Set")
+	}
+
+	if mmExtendStorage.defaultExpectation == nil {
+		mmExtendStorage.defaultExpectation = &IPVZOrderUseCaseMockExtendStorageExpectation{}
+	}
+
+	if mmExtendStorage.defaultExpectation.params != nil {
+		mmExtendStorage.mock.t.Fatalf("IPVZOrderUseCaseMock.ExtendStorage mock is already set by Expect")
+	}
+
+	if mmExtendStorage.defaultExpectation.paramPtrs == nil {
+		mmExtendStorage.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockExtendStorageParamPtrs{}
+	}
+	mmExtendStorage.defaultExpectation.paramPtrs.options = &options
+	mmExtendStorage.defaultExpectation.expectationOrigins.originOptions = minimock.CallerInfo(1)
+
+	return mmExtendStorage
+}
+
+// Inspect accepts an inspector function that has same arguments as the IPVZOrderUseCase.ExtendStorage
+func (mmExtendStorage *mIPVZOrderUseCaseMockExtendStorage) Inspect(f func(ctx context.Context, orderID string, extension time.Duration, extendedBy string, options ...mm_abstractions.MutationOptFunc)) *mIPVZOrderUseCaseMockExtendStorage {
+	if mmExtendStorage.mock.inspectFuncExtendStorage != nil {
+		mmExtendStorage.mock.t.Fatalf("Inspect function is already set for IPVZOrderUseCaseMock.ExtendStorage")
+	}
+
+	mmExtendStorage.mock.inspectFuncExtendStorage = f
+
+	return mmExtendStorage
+}
+
+// Return sets up results that will be returned by IPVZOrderUseCase.ExtendStorage
+func (mmExtendStorage *mIPVZOrderUseCaseMockExtendStorage) Return(err error) *IPVZOrderUseCaseMock {
+	if mmExtendStorage.mock.funcExtendStorage != nil {
+		mmExtendStorage.mock.t.Fatalf("IPVZOrderUseCaseMock.ExtendStorage mock is already set by Set")
+	}
+
+	if mmExtendStorage.defaultExpectation == nil {
+		mmExtendStorage.defaultExpectation = &IPVZOrderUseCaseMockExtendStorageExpectation{mock: mmExtendStorage.mock}
+	}
+	mmExtendStorage.defaultExpectation.results = &IPVZOrderUseCaseMockExtendStorageResults{err}
+	mmExtendStorage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExtendStorage.mock
+}
+
+// Set uses given function f to mock the IPVZOrderUseCase.ExtendStorage method
+func (mmExtendStorage *mIPVZOrderUseCaseMockExtendStorage) Set(f func(ctx context.Context, orderID string, extension time.Duration, extendedBy string, options ...mm_abstractions.MutationOptFunc) (err error)) *IPVZOrderUseCaseMock {
+	if mmExtendStorage.defaultExpectation != nil {
+		mmExtendStorage.mock.t.Fatalf("Default expectation is already set for the IPVZOrderUseCase.ExtendStorage method")
+	}
+
+	if len(mmExtendStorage.expectations) > 0 {
+		mmExtendStorage.mock.t.Fatalf("Some expectations are already set for the IPVZOrderUseCase.ExtendStorage method")
+	}
+
+	mmExtendStorage.mock.funcExtendStorage = f
+	mmExtendStorage.mock.funcExtendStorageOrigin = minimock.CallerInfo(1)
+	return mmExtendStorage.mock
+}
+
+// When sets expectation for the IPVZOrderUseCase.ExtendStorage which will trigger the result defined by the following
+// Then helper
+func (mmExtendStorage *mIPVZOrderUseCaseMockExtendStorage) When(ctx context.Context, orderID string, extension time.Duration, extendedBy string, options ...mm_abstractions.MutationOptFunc) *IPVZOrderUseCaseMockExtendStorageExpectation {
+	if mmExtendStorage.mock.funcExtendStorage != nil {
+		mmExtendStorage.mock.t.Fatalf("IPVZOrderUseCaseMock.ExtendStorage mock is already set by Set")
+	}
+
+	expectation := &IPVZOrderUseCaseMockExtendStorageExpectation{
+		mock:               mmExtendStorage.mock,
+		params:             &IPVZOrderUseCaseMockExtendStorageParams{ctx, orderID, extension, extendedBy, options},
+		expectationOrigins: IPVZOrderUseCaseMockExtendStorageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExtendStorage.expectations = append(mmExtendStorage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IPVZOrderUseCase.ExtendStorage return parameters for the expectation previously defined by the When method
+func (e *IPVZOrderUseCaseMockExtendStorageExpectation) Then(err error) *IPVZOrderUseCaseMock {
+	e.results = &IPVZOrderUseCaseMockExtendStorageResults{err}
+	return e.mock
+}
+
+// Times sets number of times IPVZOrderUseCase.ExtendStorage should be invoked
+func (mmExtendStorage *mIPVZOrderUseCaseMockExtendStorage) Times(n uint64) *mIPVZOrderUseCaseMockExtendStorage {
+	if n == 0 {
+		mmExtendStorage.mock.t.Fatalf("Times of IPVZOrderUseCaseMock.ExtendStorage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExtendStorage.expectedInvocations, n)
+	mmExtendStorage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExtendStorage
+}
+
+func (mmExtendStorage *mIPVZOrderUseCaseMockExtendStorage) invocationsDone() bool {
+	if len(mmExtendStorage.expectations) == 0 && mmExtendStorage.defaultExpectation == nil && mmExtendStorage.mock.funcExtendStorage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExtendStorage.mock.afterExtendStorageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExtendStorage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ExtendStorage implements mm_abstractions.IPVZOrderUseCase
+func (mmExtendStorage *IPVZOrderUseCaseMock) ExtendStorage(ctx context.Context, orderID string, extension time.Duration, extendedBy string, options ...mm_abstractions.MutationOptFunc) (err error) {
+	mm_atomic.AddUint64(&mmExtendStorage.beforeExtendStorageCounter, 1)
+	defer mm_atomic.AddUint64(&mmExtendStorage.afterExtendStorageCounter, 1)
+
+	mmExtendStorage.t.Helper()
+
+	if mmExtendStorage.inspectFuncExtendStorage != nil {
+		mmExtendStorage.inspectFuncExtendStorage(ctx, orderID, extension, extendedBy, options...)
+	}
+
+	mm_params := IPVZOrderUseCaseMockExtendStorageParams{ctx, orderID, extension, extendedBy, options}
+
+	// Record call args
+	mmExtendStorage.ExtendStorageMock.mutex.Lock()
+	mmExtendStorage.ExtendStorageMock.callArgs = append(mmExtendStorage.ExtendStorageMock.callArgs, &mm_params)
+	mmExtendStorage.ExtendStorageMock.mutex.Unlock()
+
+	for _, e := range mmExtendStorage.ExtendStorageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmExtendStorage.ExtendStorageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExtendStorage.ExtendStorageMock.defaultExpectation.Counter, 1)
+		mm_want := mmExtendStorage.ExtendStorageMock.defaultExpectation.params
+		mm_want_ptrs := mmExtendStorage.ExtendStorageMock.defaultExpectation.paramPtrs
+
+		mm_got := IPVZOrderUseCaseMockExtendStorageParams{ctx, orderID, extension, extendedBy, options}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExtendStorage.t.Errorf("IPVZOrderUseCaseMock.ExtendStorage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExtendStorage.ExtendStorageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmExtendStorage.t.Errorf("IPVZOrderUseCaseMock.ExtendStorage got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExtendStorage.ExtendStorageMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			}
+
+			if mm_want_ptrs.extension != nil && !minimock.Equal(*mm_want_ptrs.extension, mm_got.extension) {
+				mmExtendStorage.t.Errorf("IPVZOrderUseCaseMock.ExtendStorage got unexpected parameter extension, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExtendStorage.ExtendStorageMock.defaultExpectation.expectationOrigins.originExtension, *mm_want_ptrs.extension, mm_got.extension, minimock.Diff(*mm_want_ptrs.extension, mm_got.extension))
+			}
+
+			if mm_want_ptrs.extendedBy != nil && !minimock.Equal(*mm_want_ptrs.extendedBy, mm_got.extendedBy) {
+				mmExtendStorage.t.Errorf("IPVZOrderUseCaseMock.ExtendStorage got unexpected parameter extendedBy, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExtendStorage.ExtendStorageMock.defaultExpectation.expectationOrigins.originExtendedBy, *mm_want_ptrs.extendedBy, mm_got.extendedBy, minimock.Diff(*mm_want_ptrs.extendedBy, mm_got.extendedBy))
+			}
+
+			if mm_want_ptrs.options != nil && !minimock.Equal(*mm_want_ptrs.options, mm_got.options) {
+				mmExtendStorage.t.Errorf("IPVZOrderUseCaseMock.ExtendStorage got unexpected parameter options, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExtendStorage.ExtendStorageMock.defaultExpectation.expectationOrigins.originOptions, *mm_want_ptrs.options, mm_got.options, minimock.Diff(*mm_want_ptrs.options, mm_got.options))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExtendStorage.t.Errorf("IPVZOrderUseCaseMock.ExtendStorage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExtendStorage.ExtendStorageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExtendStorage.ExtendStorageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExtendStorage.t.Fatal("No results are set for the IPVZOrderUseCaseMock.ExtendStorage")
+		}
+		return (*mm_results).err
+	}
+	if mmExtendStorage.funcExtendStorage != nil {
+		return mmExtendStorage.funcExtendStorage(ctx, orderID, extension, extendedBy, options...)
+	}
+	mmExtendStorage.t.Fatalf("Unexpected call to IPVZOrderUseCaseMock.ExtendStorage. %v %v %v %v %v", ctx, orderID, extension, extendedBy, options)
+	return
+}
+
+// ExtendStorageAfterCounter returns a count of finished IPVZOrderUseCaseMock.ExtendStorage invocations
+func (mmExtendStorage *IPVZOrderUseCaseMock) ExtendStorageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExtendStorage.afterExtendStorageCounter)
+}
+
+// ExtendStorageBeforeCounter returns a count of IPVZOrderUseCaseMock.ExtendStorage invocations
+func (mmExtendStorage *IPVZOrderUseCaseMock) ExtendStorageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExtendStorage.beforeExtendStorageCounter)
+}
+
+// Calls returns a list of arguments used in each call to IPVZOrderUseCaseMock.ExtendStorage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExtendStorage *mIPVZOrderUseCaseMockExtendStorage) Calls() []*IPVZOrderUseCaseMockExtendStorageParams {
+	mmExtendStorage.mutex.RLock()
+
+	argCopy := make([]*IPVZOrderUseCaseMockExtendStorageParams, len(mmExtendStorage.callArgs))
+	copy(argCopy, mmExtendStorage.callArgs)
+
+	mmExtendStorage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExtendStorageDone returns true if the count of the ExtendStorage invocations corresponds
+// the number of defined expectations
+func (m *IPVZOrderUseCaseMock) MinimockExtendStorageDone() bool {
+	if m.ExtendStorageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExtendStorageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExtendStorageMock.invocationsDone()
+}
+
+// MinimockExtendStorageInspect logs each unmet expectation
+func (m *IPVZOrderUseCaseMock) MinimockExtendStorageInspect() {
+	for _, e := range m.ExtendStorageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IPVZOrderUseCaseMock.ExtendStorage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExtendStorageCounter := mm_atomic.LoadUint64(&m.afterExtendStorageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExtendStorageMock.defaultExpectation != nil && afterExtendStorageCounter < 1 {
+		if m.ExtendStorageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IPVZOrderUseCaseMock.ExtendStorage at\n%s", m.ExtendStorageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IPVZOrderUseCaseMock.ExtendStorage at\n%s with params: %#v", m.ExtendStorageMock.defaultExpectation.expectationOrigins.origin, *m.ExtendStorageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExtendStorage != nil && afterExtendStorageCounter < 1 {
+		m.t.Errorf("Expected call to IPVZOrderUseCaseMock.ExtendStorage at\n%s", m.funcExtendStorageOrigin)
+	}
+
+	if !m.ExtendStorageMock.invocationsDone() && afterExtendStorageCounter > 0 {
+		m.t.Errorf("Expected %d calls to IPVZOrderUseCaseMock.ExtendStorage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExtendStorageMock.expectedInvocations), m.ExtendStorageMock.expectedInvocationsOrigin, afterExtendStorageCounter)
+	}
+}
+
+type mIPVZOrderUseCaseMockGetOrderHistory struct {
+	optional           bool
+	mock               *IPVZOrderUseCaseMock
+	defaultExpectation *IPVZOrderUseCaseMockGetOrderHistoryExpectation
+	expectations       []*IPVZOrderUseCaseMockGetOrderHistoryExpectation
+
+	callArgs []*IPVZOrderUseCaseMockGetOrderHistoryParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IPVZOrderUseCaseMockGetOrderHistoryExpectation specifies expectation struct of the IPVZOrderUseCase.GetOrderHistory
+type IPVZOrderUseCaseMockGetOrderHistoryExpectation struct {
+	mock               *IPVZOrderUseCaseMock
+	params             *IPVZOrderUseCaseMockGetOrderHistoryParams
+	paramPtrs          *IPVZOrderUseCaseMockGetOrderHistoryParamPtrs
+	expectationOrigins IPVZOrderUseCaseMockGetOrderHistoryExpectationOrigins
+	results            *IPVZOrderUseCaseMockGetOrderHistoryResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IPVZOrderUseCaseMockGetOrderHistoryParams contains parameters of the IPVZOrderUseCase.GetOrderHistory
+type IPVZOrderUseCaseMockGetOrderHistoryParams struct {
+	ctx     context.Context
+	orderID string
+}
+
+// IPVZOrderUseCaseMockGetOrderHistoryParamPtrs contains pointers to parameters of the IPVZOrderUseCase.GetOrderHistory
+type IPVZOrderUseCaseMockGetOrderHistoryParamPtrs struct {
+	ctx     *context.Context
+	orderID *string
+}
+
+// IPVZOrderUseCaseMockGetOrderHistoryResults contains results of the IPVZOrderUseCase.GetOrderHistory
+type IPVZOrderUseCaseMockGetOrderHistoryResults struct {
+	ea1 []domain.Event
+	err error
+}
+
+// IPVZOrderUseCaseMockGetOrderHistoryOrigins contains origins of expectations of the IPVZOrderUseCase.GetOrderHistory
+type IPVZOrderUseCaseMockGetOrderHistoryExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originOrderID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetOrderHistory *mIPVZOrderUseCaseMockGetOrderHistory) Optional() *mIPVZOrderUseCaseMockGetOrderHistory {
+	mmGetOrderHistory.optional = true
+	return mmGetOrderHistory
+}
+
+// Expect sets up expected params for IPVZOrderUseCase.GetOrderHistory
+func (mmGetOrderHistory *mIPVZOrderUseCaseMockGetOrderHistory) Expect(ctx context.Context, orderID string) *mIPVZOrderUseCaseMockGetOrderHistory {
+	if mmGetOrderHistory.mock.funcGetOrderHistory != nil {
+		mmGetOrderHistory.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrderHistory mock is already set by Set")
+	}
+
+	if mmGetOrderHistory.defaultExpectation == nil {
+		mmGetOrderHistory.defaultExpectation = &IPVZOrderUseCaseMockGetOrderHistoryExpectation{}
+	}
+
+	if mmGetOrderHistory.defaultExpectation.paramPtrs != nil {
+		mmGetOrderHistory.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrderHistory mock is already set by ExpectParams functions")
+	}
+
+	mmGetOrderHistory.defaultExpectation.params = &IPVZOrderUseCaseMockGetOrderHistoryParams{ctx, orderID}
+	mmGetOrderHistory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOrderHistory.expectations {
+		if minimock.Equal(e.params, mmGetOrderHistory.defaultExpectation.params) {
+			mmGetOrderHistory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrderHistory.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOrderHistory
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IPVZOrderUseCase.GetOrderHistory
+func (mmGetOrderHistory *mIPVZOrderUseCaseMockGetOrderHistory) ExpectCtxParam1(ctx context.Context) *mIPVZOrderUseCaseMockGetOrderHistory {
+	if mmGetOrderHistory.mock.funcGetOrderHistory != nil {
+		mmGetOrderHistory.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrderHistory mock is already set by Set")
+	}
+
+	if mmGetOrderHistory.defaultExpectation == nil {
+		mmGetOrderHistory.defaultExpectation = &IPVZOrderUseCaseMockGetOrderHistoryExpectation{}
+	}
+
+	if mmGetOrderHistory.defaultExpectation.params != nil {
+		mmGetOrderHistory.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrderHistory mock is already set by Expect")
+	}
+
+	if mmGetOrderHistory.defaultExpectation.paramPtrs == nil {
+		mmGetOrderHistory.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockGetOrderHistoryParamPtrs{}
+	}
+	mmGetOrderHistory.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOrderHistory.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetOrderHistory
+}
+
+// ExpectOrderIDParam2 sets up expected param orderID for IPVZOrderUseCase.GetOrderHistory
+func (mmGetOrderHistory *mIPVZOrderUseCaseMockGetOrderHistory) ExpectOrderIDParam2(orderID string) *mIPVZOrderUseCaseMockGetOrderHistory {
+	if mmGetOrderHistory.mock.funcGetOrderHistory != nil {
+		mmGetOrderHistory.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrderHistory mock is already set by Set")
+	}
+
+	if mmGetOrderHistory.defaultExpectation == nil {
+		mmGetOrderHistory.defaultExpectation = &IPVZOrderUseCaseMockGetOrderHistoryExpectation{}
+	}
+
+	if mmGetOrderHistory.defaultExpectation.params != nil {
+		mmGetOrderHistory.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrderHistory mock is already set by Expect")
+	}
+
+	if mmGetOrderHistory.defaultExpectation.paramPtrs == nil {
+		mmGetOrderHistory.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockGetOrderHistoryParamPtrs{}
+	}
+	mmGetOrderHistory.defaultExpectation.paramPtrs.orderID = &orderID
+	mmGetOrderHistory.defaultExpectation.expectationOrigins.originOrderID = minimock.CallerInfo(1)
+
+	return mmGetOrderHistory
+}
+
+// Inspect accepts an inspector function that has same arguments as the IPVZOrderUseCase.GetOrderHistory
+func (mmGetOrderHistory *mIPVZOrderUseCaseMockGetOrderHistory) Inspect(f func(ctx context.Context, orderID string)) *mIPVZOrderUseCaseMockGetOrderHistory {
+	if mmGetOrderHistory.mock.inspectFuncGetOrderHistory != nil {
+		mmGetOrderHistory.mock.t.Fatalf("Inspect function is already set for IPVZOrderUseCaseMock.GetOrderHistory")
+	}
+
+	mmGetOrderHistory.mock.inspectFuncGetOrderHistory = f
+
+	return mmGetOrderHistory
+}
+
+// Return sets up results that will be returned by IPVZOrderUseCase.GetOrderHistory
+func (mmGetOrderHistory *mIPVZOrderUseCaseMockGetOrderHistory) Return(ea1 []domain.Event, err error) *IPVZOrderUseCaseMock {
+	if mmGetOrderHistory.mock.funcGetOrderHistory != nil {
+		mmGetOrderHistory.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrderHistory mock is already set by Set")
+	}
+
+	if mmGetOrderHistory.defaultExpectation == nil {
+		mmGetOrderHistory.defaultExpectation = &IPVZOrderUseCaseMockGetOrderHistoryExpectation{mock: mmGetOrderHistory.mock}
+	}
+	mmGetOrderHistory.defaultExpectation.results = &IPVZOrderUseCaseMockGetOrderHistoryResults{ea1, err}
+	mmGetOrderHistory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOrderHistory.mock
+}
+
+// Set uses given function f to mock the IPVZOrderUseCase.GetOrderHistory method
+func (mmGetOrderHistory *mIPVZOrderUseCaseMockGetOrderHistory) Set(f func(ctx context.Context, orderID string) (ea1 []domain.Event, err error)) *IPVZOrderUseCaseMock {
+	if mmGetOrderHistory.defaultExpectation != nil {
+		mmGetOrderHistory.mock.t.Fatalf("Default expectation is already set for the IPVZOrderUseCase.GetOrderHistory method")
+	}
+
+	if len(mmGetOrderHistory.expectations) > 0 {
+		mmGetOrderHistory.mock.t.Fatalf("Some expectations are already set for the IPVZOrderUseCase.GetOrderHistory method")
+	}
+
+	mmGetOrderHistory.mock.funcGetOrderHistory = f
+	mmGetOrderHistory.mock.funcGetOrderHistoryOrigin = minimock.CallerInfo(1)
+	return mmGetOrderHistory.mock
+}
+
+// When sets expectation for the IPVZOrderUseCase.GetOrderHistory which will trigger the result defined by the following
+// Then helper
+func (mmGetOrderHistory *mIPVZOrderUseCaseMockGetOrderHistory) When(ctx context.Context, orderID string) *IPVZOrderUseCaseMockGetOrderHistoryExpectation {
+	if mmGetOrderHistory.mock.funcGetOrderHistory != nil {
+		mmGetOrderHistory.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrderHistory mock is already set by Set")
+	}
+
+	expectation := &IPVZOrderUseCaseMockGetOrderHistoryExpectation{
+		mock:               mmGetOrderHistory.mock,
+		params:             &IPVZOrderUseCaseMockGetOrderHistoryParams{ctx, orderID},
+		expectationOrigins: IPVZOrderUseCaseMockGetOrderHistoryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetOrderHistory.expectations = append(mmGetOrderHistory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IPVZOrderUseCase.GetOrderHistory return parameters for the expectation previously defined by the When method
+func (e *IPVZOrderUseCaseMockGetOrderHistoryExpectation) Then(ea1 []domain.Event, err error) *IPVZOrderUseCaseMock {
+	e.results = &IPVZOrderUseCaseMockGetOrderHistoryResults{ea1, err}
+	return e.mock
+}
+
+// Times sets number of times IPVZOrderUseCase.GetOrderHistory should be invoked
+func (mmGetOrderHistory *mIPVZOrderUseCaseMockGetOrderHistory) Times(n uint64) *mIPVZOrderUseCaseMockGetOrderHistory {
+	if n == 0 {
+		mmGetOrderHistory.mock.t.Fatalf("Times of IPVZOrderUseCaseMock.GetOrderHistory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetOrderHistory.expectedInvocations, n)
+	mmGetOrderHistory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOrderHistory
+}
+
+func (mmGetOrderHistory *mIPVZOrderUseCaseMockGetOrderHistory) invocationsDone() bool {
+	if len(mmGetOrderHistory.expectations) == 0 && mmGetOrderHistory.defaultExpectation == nil && mmGetOrderHistory.mock.funcGetOrderHistory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOrderHistory.mock.afterGetOrderHistoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOrderHistory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetOrderHistory implements mm_abstractions.IPVZOrderUseCase
+func (mmGetOrderHistory *IPVZOrderUseCaseMock) GetOrderHistory(ctx context.Context, orderID string) (ea1 []domain.Event, err error) {
+	mm_atomic.AddUint64(&mmGetOrderHistory.beforeGetOrderHistoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOrderHistory.afterGetOrderHistoryCounter, 1)
+
+	mmGetOrderHistory.t.Helper()
+
+	if mmGetOrderHistory.inspectFuncGetOrderHistory != nil {
+		mmGetOrderHistory.inspectFuncGetOrderHistory(ctx, orderID)
+	}
+
+	mm_params := IPVZOrderUseCaseMockGetOrderHistoryParams{ctx, orderID}
+
+	// Record call args
+	mmGetOrderHistory.GetOrderHistoryMock.mutex.Lock()
+	mmGetOrderHistory.GetOrderHistoryMock.callArgs = append(mmGetOrderHistory.GetOrderHistoryMock.callArgs, &mm_params)
+	mmGetOrderHistory.GetOrderHistoryMock.mutex.Unlock()
+
+	for _, e := range mmGetOrderHistory.GetOrderHistoryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ea1, e.results.err
+		}
+	}
+
+	if mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation.paramPtrs
+
+		mm_got := IPVZOrderUseCaseMockGetOrderHistoryParams{ctx, orderID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetOrderHistory.t.Errorf("IPVZOrderUseCaseMock.GetOrderHistory got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmGetOrderHistory.t.Errorf("IPVZOrderUseCaseMock.GetOrderHistory got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOrderHistory.t.Errorf("IPVZOrderUseCaseMock.GetOrderHistory got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOrderHistory.t.Fatal("No results are set for the IPVZOrderUseCaseMock.GetOrderHistory")
+		}
+		return (*mm_results).ea1, (*mm_results).err
+	}
+	if mmGetOrderHistory.funcGetOrderHistory != nil {
+		return mmGetOrderHistory.funcGetOrderHistory(ctx, orderID)
+	}
+	mmGetOrderHistory.t.Fatalf("Unexpected call to IPVZOrderUseCaseMock.GetOrderHistory. %v %v", ctx, orderID)
+	return
+}
+
+// GetOrderHistoryAfterCounter returns a count of finished IPVZOrderUseCaseMock.GetOrderHistory invocations
+func (mmGetOrderHistory *IPVZOrderUseCaseMock) GetOrderHistoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrderHistory.afterGetOrderHistoryCounter)
+}
+
+// GetOrderHistoryBeforeCounter returns a count of IPVZOrderUseCaseMock.GetOrderHistory invocations
+func (mmGetOrderHistory *IPVZOrderUseCaseMock) GetOrderHistoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrderHistory.beforeGetOrderHistoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to IPVZOrderUseCaseMock.GetOrderHistory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOrderHistory *mIPVZOrderUseCaseMockGetOrderHistory) Calls() []*IPVZOrderUseCaseMockGetOrderHistoryParams {
+	mmGetOrderHistory.mutex.RLock()
+
+	argCopy := make([]*IPVZOrderUseCaseMockGetOrderHistoryParams, len(mmGetOrderHistory.callArgs))
+	copy(argCopy, mmGetOrderHistory.callArgs)
+
+	mmGetOrderHistory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOrderHistoryDone returns true if the count of the GetOrderHistory invocations corresponds
+// the number of defined expectations
+func (m *IPVZOrderUseCaseMock) MinimockGetOrderHistoryDone() bool {
+	if m.GetOrderHistoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetOrderHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetOrderHistoryMock.invocationsDone()
+}
+
+// MinimockGetOrderHistoryInspect logs each unmet expectation
+func (m *IPVZOrderUseCaseMock) MinimockGetOrderHistoryInspect() {
+	for _, e := range m.GetOrderHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IPVZOrderUseCaseMock.GetOrderHistory at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetOrderHistoryCounter := mm_atomic.LoadUint64(&m.afterGetOrderHistoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrderHistoryMock.defaultExpectation != nil && afterGetOrderHistoryCounter < 1 {
+		if m.GetOrderHistoryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IPVZOrderUseCaseMock.GetOrderHistory at\n%s", m.GetOrderHistoryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IPVZOrderUseCaseMock.GetOrderHistory at\n%s with params: %#v", m.GetOrderHistoryMock.defaultExpectation.expectationOrigins.origin, *m.GetOrderHistoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrderHistory != nil && afterGetOrderHistoryCounter < 1 {
+		m.t.Errorf("Expected call to IPVZOrderUseCaseMock.GetOrderHistory at\n%s", m.funcGetOrderHistoryOrigin)
+	}
+
+	if !m.GetOrderHistoryMock.invocationsDone() && afterGetOrderHistoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to IPVZOrderUseCaseMock.GetOrderHistory at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetOrderHistoryMock.expectedInvocations), m.GetOrderHistoryMock.expectedInvocationsOrigin, afterGetOrderHistoryCounter)
+	}
+}
+
+type mIPVZOrderUseCaseMockGetOrders struct {
+	optional           bool
+	mock               *IPVZOrderUseCaseMock
+	defaultExpectation *IPVZOrderUseCaseMockGetOrdersExpectation
+	expectations       []*IPVZOrderUseCaseMockGetOrdersExpectation
+
+	callArgs []*IPVZOrderUseCaseMockGetOrdersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IPVZOrderUseCaseMockGetOrdersExpectation specifies expectation struct of the IPVZOrderUseCase.GetOrders
+type IPVZOrderUseCaseMockGetOrdersExpectation struct {
+	mock               *IPVZOrderUseCaseMock
+	params             *IPVZOrderUseCaseMockGetOrdersParams
+	paramPtrs          *IPVZOrderUseCaseMockGetOrdersParamPtrs
+	expectationOrigins IPVZOrderUseCaseMockGetOrdersExpectationOrigins
+	results            *IPVZOrderUseCaseMockGetOrdersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IPVZOrderUseCaseMockGetOrdersParams contains parameters of the IPVZOrderUseCase.GetOrders
+type IPVZOrderUseCaseMockGetOrdersParams struct {
+	ctx     context.Context
+	userID  string
+	options []mm_abstractions.GetOrdersOptFunc
+}
+
+// IPVZOrderUseCaseMockGetOrdersParamPtrs contains pointers to parameters of the IPVZOrderUseCase.GetOrders
+type IPVZOrderUseCaseMockGetOrdersParamPtrs struct {
+	ctx     *context.Context
+	userID  *string
+	options *[]mm_abstractions.GetOrdersOptFunc
+}
+
+// IPVZOrderUseCaseMockGetOrdersResults contains results of the IPVZOrderUseCase.GetOrders
+type IPVZOrderUseCaseMockGetOrdersResults struct {
+	pa1 []domain.PVZOrder
+	err error
+}
+
+// IPVZOrderUseCaseMockGetOrdersOrigins contains origins of expectations of the IPVZOrderUseCase.GetOrders
+type IPVZOrderUseCaseMockGetOrdersExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originOptions string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetOrders *mIPVZOrderUseCaseMockGetOrders) Optional() *mIPVZOrderUseCaseMockGetOrders {
+	mmGetOrders.optional = true
+	return mmGetOrders
+}
+
+// Expect sets up expected params for IPVZOrderUseCase.GetOrders
+func (mmGetOrders *mIPVZOrderUseCaseMockGetOrders) Expect(ctx context.Context, userID string, options ...mm_abstractions.GetOrdersOptFunc) *mIPVZOrderUseCaseMockGetOrders {
+	if mmGetOrders.mock.funcGetOrders != nil {
+		mmGetOrders.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrders mock is already set by Set")
+	}
+
+	if mmGetOrders.defaultExpectation == nil {
+		mmGetOrders.defaultExpectation = &IPVZOrderUseCaseMockGetOrdersExpectation{}
+	}
+
+	if mmGetOrders.defaultExpectation.paramPtrs != nil {
+		mmGetOrders.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrders mock is already set by ExpectParams functions")
+	}
+
+	mmGetOrders.defaultExpectation.params = &IPVZOrderUseCaseMockGetOrdersParams{ctx, userID, options}
+	mmGetOrders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOrders.expectations {
+		if minimock.Equal(e.params, mmGetOrders.defaultExpectation.params) {
+			mmGetOrders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrders.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOrders
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IPVZOrderUseCase.GetOrders
+func (mmGetOrders *mIPVZOrderUseCaseMockGetOrders) ExpectCtxParam1(ctx context.Context) *mIPVZOrderUseCaseMockGetOrders {
+	if mmGetOrders.mock.funcGetOrders != nil {
+		mmGetOrders.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrders mock is already set by Set")
+	}
+
+	if mmGetOrders.defaultExpectation == nil {
+		mmGetOrders.defaultExpectation = &IPVZOrderUseCaseMockGetOrdersExpectation{}
+	}
+
+	if mmGetOrders.defaultExpectation.params != nil {
+		mmGetOrders.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrders mock is already set by Expect")
+	}
+
+	if mmGetOrders.defaultExpectation.paramPtrs == nil {
+		mmGetOrders.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockGetOrdersParamPtrs{}
+	}
+	mmGetOrders.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOrders.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetOrders
+}
+
+// ExpectUserIDParam2 sets up expected param userID for IPVZOrderUseCase.GetOrders
+func (mmGetOrders *mIPVZOrderUseCaseMockGetOrders) ExpectUserIDParam2(userID string) *mIPVZOrderUseCaseMockGetOrders {
+	if mmGetOrders.mock.funcGetOrders != nil {
+		mmGetOrders.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrders mock is already set by Set")
+	}
+
+	if mmGetOrders.defaultExpectation == nil {
+		mmGetOrders.defaultExpectation = &IPVZOrderUseCaseMockGetOrdersExpectation{}
+	}
+
+	if mmGetOrders.defaultExpectation.params != nil {
+		mmGetOrders.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrders mock is already set by Expect")
+	}
+
+	if mmGetOrders.defaultExpectation.paramPtrs == nil {
+		mmGetOrders.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockGetOrdersParamPtrs{}
+	}
+	mmGetOrders.defaultExpectation.paramPtrs.userID = &userID
+	mmGetOrders.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetOrders
+}
+
+// ExpectOptionsParam3 sets up expected param options for IPVZOrderUseCase.GetOrders
+func (mmGetOrders *mIPVZOrderUseCaseMockGetOrders) ExpectOptionsParam3(options ...mm_abstractions.GetOrdersOptFunc) *mIPVZOrderUseCaseMockGetOrders {
+	if mmGetOrders.mock.funcGetOrders != nil {
+		mmGetOrders.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrders mock is already set by Set")
+	}
+
+	if mmGetOrders.defaultExpectation == nil {
+		mmGetOrders.defaultExpectation = &IPVZOrderUseCaseMockGetOrdersExpectation{}
+	}
+
+	if mmGetOrders.defaultExpectation.params != nil {
+		mmGetOrders.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrders mock is already set by Expect")
+	}
+
+	if mmGetOrders.defaultExpectation.paramPtrs == nil {
+		mmGetOrders.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockGetOrdersParamPtrs{}
+	}
+	mmGetOrders.defaultExpectation.paramPtrs.options = &options
+	mmGetOrders.defaultExpectation.expectationOrigins.originOptions = minimock.CallerInfo(1)
+
+	return mmGetOrders
+}
+
+// Inspect accepts an inspector function that has same arguments as the IPVZOrderUseCase.GetOrders
+func (mmGetOrders *mIPVZOrderUseCaseMockGetOrders) Inspect(f func(ctx context.Context, userID string, options ...mm_abstractions.GetOrdersOptFunc)) *mIPVZOrderUseCaseMockGetOrders {
+	if mmGetOrders.mock.inspectFuncGetOrders != nil {
+		mmGetOrders.mock.t.Fatalf("Inspect function is already set for IPVZOrderUseCaseMock.GetOrders")
+	}
+
+	mmGetOrders.mock.inspectFuncGetOrders = f
+
+	return mmGetOrders
+}
+
+// Return sets up results that will be returned by IPVZOrderUseCase.GetOrders
+func (mmGetOrders *mIPVZOrderUseCaseMockGetOrders) Return(pa1 []domain.PVZOrder, err error) *IPVZOrderUseCaseMock {
+	if mmGetOrders.mock.funcGetOrders != nil {
+		mmGetOrders.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrders mock is already set by Set")
+	}
+
+	if mmGetOrders.defaultExpectation == nil {
+		mmGetOrders.defaultExpectation = &IPVZOrderUseCaseMockGetOrdersExpectation{mock: mmGetOrders.mock}
+	}
+	mmGetOrders.defaultExpectation.results = &IPVZOrderUseCaseMockGetOrdersResults{pa1, err}
+	mmGetOrders.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOrders.mock
+}
+
+// Set uses given function f to mock the IPVZOrderUseCase.GetOrders method
+func (mmGetOrders *mIPVZOrderUseCaseMockGetOrders) Set(f func(ctx context.Context, userID string, options ...mm_abstractions.GetOrdersOptFunc) (pa1 []domain.PVZOrder, err error)) *IPVZOrderUseCaseMock {
+	if mmGetOrders.defaultExpectation != nil {
+		mmGetOrders.mock.t.Fatalf("Default expectation is already set for the IPVZOrderUseCase.GetOrders method")
+	}
+
+	if len(mmGetOrders.expectations) > 0 {
+		mmGetOrders.mock.t.Fatalf("Some expectations are already set for the IPVZOrderUseCase.GetOrders method")
+	}
+
+	mmGetOrders.mock.funcGetOrders = f
+	mmGetOrders.mock.funcGetOrdersOrigin = minimock.CallerInfo(1)
+	return mmGetOrders.mock
+}
+
+// When sets expectation for the IPVZOrderUseCase.GetOrders which will trigger the result defined by the following
+// Then helper
+func (mmGetOrders *mIPVZOrderUseCaseMockGetOrders) When(ctx context.Context, userID string, options ...mm_abstractions.GetOrdersOptFunc) *IPVZOrderUseCaseMockGetOrdersExpectation {
+	if mmGetOrders.mock.funcGetOrders != nil {
+		mmGetOrders.mock.t.Fatalf("IPVZOrderUseCaseMock.GetOrders mock is already set by Set")
+	}
+
+	expectation := &IPVZOrderUseCaseMockGetOrdersExpectation{
+		mock:               mmGetOrders.mock,
+		params:             &IPVZOrderUseCaseMockGetOrdersParams{ctx, userID, options},
+		expectationOrigins: IPVZOrderUseCaseMockGetOrdersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetOrders.expectations = append(mmGetOrders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IPVZOrderUseCase.GetOrders return parameters for the expectation previously defined by the When method
+func (e *IPVZOrderUseCaseMockGetOrdersExpectation) Then(pa1 []domain.PVZOrder, err error) *IPVZOrderUseCaseMock {
+	e.results = &IPVZOrderUseCaseMockGetOrdersResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times IPVZOrderUseCase.GetOrders should be invoked
+func (mmGetOrders *mIPVZOrderUseCaseMockGetOrders) Times(n uint64) *mIPVZOrderUseCaseMockGetOrders {
+	if n == 0 {
+		mmGetOrders.mock.t.Fatalf("Times of IPVZOrderUseCaseMock.GetOrders mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetOrders.expectedInvocations, n)
+	mmGetOrders.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOrders
+}
+
+func (mmGetOrders *mIPVZOrderUseCaseMockGetOrders) invocationsDone() bool {
+	if len(mmGetOrders.expectations) == 0 && mmGetOrders.defaultExpectation == nil && mmGetOrders.mock.funcGetOrders == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOrders.mock.afterGetOrdersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOrders.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetOrders implements mm_abstractions.IPVZOrderUseCase
+func (mmGetOrders *IPVZOrderUseCaseMock) GetOrders(ctx context.Context, userID string, options ...mm_abstractions.GetOrdersOptFunc) (pa1 []domain.PVZOrder, err error) {
+	mm_atomic.AddUint64(&mmGetOrders.beforeGetOrdersCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOrders.afterGetOrdersCounter, 1)
+
+	mmGetOrders.t.Helper()
+
+	if mmGetOrders.inspectFuncGetOrders != nil {
+		mmGetOrders.inspectFuncGetOrders(ctx, userID, options...)
+	}
+
+	mm_params := IPVZOrderUseCaseMockGetOrdersParams{ctx, userID, options}
+
+	// Record call args
+	mmGetOrders.GetOrdersMock.mutex.Lock()
+	mmGetOrders.GetOrdersMock.callArgs = append(mmGetOrders.GetOrdersMock.callArgs, &mm_params)
+	mmGetOrders.GetOrdersMock.mutex.Unlock()
+
+	for _, e := range mmGetOrders.GetOrdersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmGetOrders.GetOrdersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOrders.GetOrdersMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOrders.GetOrdersMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOrders.GetOrdersMock.defaultExpectation.paramPtrs
+
+		mm_got := IPVZOrderUseCaseMockGetOrdersParams{ctx, userID, options}
 
 		if mm_want_ptrs != nil {
 
@@ -1640,75 +2936,418 @@ func (mmGetReturns *IPVZOrderUseCaseMock) GetReturns(ctx context.Context, option
 	if mmGetReturns.funcGetReturns != nil {
 		return mmGetReturns.funcGetReturns(ctx, options...)
 	}
-	mmGetReturns.t.Fatalf("Unexpected call to IPVZOrderUseCaseMock.GetReturns. %v %v", ctx, options)
+	mmGetReturns.t.Fatalf("Unexpected call to IPVZOrderUseCaseMock.GetReturns. %v %v", ctx, options)
+	return
+}
+
+// GetReturnsAfterCounter returns a count of finished IPVZOrderUseCaseMock.GetReturns invocations
+func (mmGetReturns *IPVZOrderUseCaseMock) GetReturnsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReturns.afterGetReturnsCounter)
+}
+
+// GetReturnsBeforeCounter returns a count of IPVZOrderUseCaseMock.GetReturns invocations
+func (mmGetReturns *IPVZOrderUseCaseMock) GetReturnsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReturns.beforeGetReturnsCounter)
+}
+
+// Calls returns a list of arguments used in each call to IPVZOrderUseCaseMock.GetReturns.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetReturns *mIPVZOrderUseCaseMockGetReturns) Calls() []*IPVZOrderUseCaseMockGetReturnsParams {
+	mmGetReturns.mutex.RLock()
+
+	argCopy := make([]*IPVZOrderUseCaseMockGetReturnsParams, len(mmGetReturns.callArgs))
+	copy(argCopy, mmGetReturns.callArgs)
+
+	mmGetReturns.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetReturnsDone returns true if the count of the GetReturns invocations corresponds
+// the number of defined expectations
+func (m *IPVZOrderUseCaseMock) MinimockGetReturnsDone() bool {
+	if m.GetReturnsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetReturnsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetReturnsMock.invocationsDone()
+}
+
+// MinimockGetReturnsInspect logs each unmet expectation
+func (m *IPVZOrderUseCaseMock) MinimockGetReturnsInspect() {
+	for _, e := range m.GetReturnsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IPVZOrderUseCaseMock.GetReturns at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetReturnsCounter := mm_atomic.LoadUint64(&m.afterGetReturnsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetReturnsMock.defaultExpectation != nil && afterGetReturnsCounter < 1 {
+		if m.GetReturnsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IPVZOrderUseCaseMock.GetReturns at\n%s", m.GetReturnsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IPVZOrderUseCaseMock.GetReturns at\n%s with params: %#v", m.GetReturnsMock.defaultExpectation.expectationOrigins.origin, *m.GetReturnsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetReturns != nil && afterGetReturnsCounter < 1 {
+		m.t.Errorf("Expected call to IPVZOrderUseCaseMock.GetReturns at\n%s", m.funcGetReturnsOrigin)
+	}
+
+	if !m.GetReturnsMock.invocationsDone() && afterGetReturnsCounter > 0 {
+		m.t.Errorf("Expected %d calls to IPVZOrderUseCaseMock.GetReturns at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetReturnsMock.expectedInvocations), m.GetReturnsMock.expectedInvocationsOrigin, afterGetReturnsCounter)
+	}
+}
+
+type mIPVZOrderUseCaseMockGetWeightDiscrepancyReport struct {
+	optional           bool
+	mock               *IPVZOrderUseCaseMock
+	defaultExpectation *IPVZOrderUseCaseMockGetWeightDiscrepancyReportExpectation
+	expectations       []*IPVZOrderUseCaseMockGetWeightDiscrepancyReportExpectation
+
+	callArgs []*IPVZOrderUseCaseMockGetWeightDiscrepancyReportParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IPVZOrderUseCaseMockGetWeightDiscrepancyReportExpectation specifies expectation struct of the IPVZOrderUseCase.GetWeightDiscrepancyReport
+type IPVZOrderUseCaseMockGetWeightDiscrepancyReportExpectation struct {
+	mock               *IPVZOrderUseCaseMock
+	params             *IPVZOrderUseCaseMockGetWeightDiscrepancyReportParams
+	paramPtrs          *IPVZOrderUseCaseMockGetWeightDiscrepancyReportParamPtrs
+	expectationOrigins IPVZOrderUseCaseMockGetWeightDiscrepancyReportExpectationOrigins
+	results            *IPVZOrderUseCaseMockGetWeightDiscrepancyReportResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IPVZOrderUseCaseMockGetWeightDiscrepancyReportParams contains parameters of the IPVZOrderUseCase.GetWeightDiscrepancyReport
+type IPVZOrderUseCaseMockGetWeightDiscrepancyReportParams struct {
+	ctx  context.Context
+	date time.Time
+}
+
+// IPVZOrderUseCaseMockGetWeightDiscrepancyReportParamPtrs contains pointers to parameters of the IPVZOrderUseCase.GetWeightDiscrepancyReport
+type IPVZOrderUseCaseMockGetWeightDiscrepancyReportParamPtrs struct {
+	ctx  *context.Context
+	date *time.Time
+}
+
+// IPVZOrderUseCaseMockGetWeightDiscrepancyReportResults contains results of the IPVZOrderUseCase.GetWeightDiscrepancyReport
+type IPVZOrderUseCaseMockGetWeightDiscrepancyReportResults struct {
+	w1  domain.WeightDiscrepancyReport
+	err error
+}
+
+// IPVZOrderUseCaseMockGetWeightDiscrepancyReportOrigins contains origins of expectations of the IPVZOrderUseCase.GetWeightDiscrepancyReport
+type IPVZOrderUseCaseMockGetWeightDiscrepancyReportExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originDate string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetWeightDiscrepancyReport *mIPVZOrderUseCaseMockGetWeightDiscrepancyReport) Optional() *mIPVZOrderUseCaseMockGetWeightDiscrepancyReport {
+	mmGetWeightDiscrepancyReport.optional = true
+	return mmGetWeightDiscrepancyReport
+}
+
+// Expect sets up expected params for IPVZOrderUseCase.GetWeightDiscrepancyReport
+func (mmGetWeightDiscrepancyReport *mIPVZOrderUseCaseMockGetWeightDiscrepancyReport) Expect(ctx context.Context, date time.Time) *mIPVZOrderUseCaseMockGetWeightDiscrepancyReport {
+	if mmGetWeightDiscrepancyReport.mock.funcGetWeightDiscrepancyReport != nil {
+		mmGetWeightDiscrepancyReport.mock.t.Fatalf("IPVZOrderUseCaseMock.GetWeightDiscrepancyReport mock is already set by Set")
+	}
+
+	if mmGetWeightDiscrepancyReport.defaultExpectation == nil {
+		mmGetWeightDiscrepancyReport.defaultExpectation = &IPVZOrderUseCaseMockGetWeightDiscrepancyReportExpectation{}
+	}
+
+	if mmGetWeightDiscrepancyReport.defaultExpectation.paramPtrs != nil {
+		mmGetWeightDiscrepancyReport.mock.t.Fatalf("IPVZOrderUseCaseMock.GetWeightDiscrepancyReport mock is already set by ExpectParams functions")
+	}
+
+	mmGetWeightDiscrepancyReport.defaultExpectation.params = &IPVZOrderUseCaseMockGetWeightDiscrepancyReportParams{ctx, date}
+	mmGetWeightDiscrepancyReport.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetWeightDiscrepancyReport.expectations {
+		if minimock.Equal(e.params, mmGetWeightDiscrepancyReport.defaultExpectation.params) {
+			mmGetWeightDiscrepancyReport.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetWeightDiscrepancyReport.defaultExpectation.params)
+		}
+	}
+
+	return mmGetWeightDiscrepancyReport
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IPVZOrderUseCase.GetWeightDiscrepancyReport
+func (mmGetWeightDiscrepancyReport *mIPVZOrderUseCaseMockGetWeightDiscrepancyReport) ExpectCtxParam1(ctx context.Context) *mIPVZOrderUseCaseMockGetWeightDiscrepancyReport {
+	if mmGetWeightDiscrepancyReport.mock.funcGetWeightDiscrepancyReport != nil {
+		mmGetWeightDiscrepancyReport.mock.t.Fatalf("IPVZOrderUseCaseMock.GetWeightDiscrepancyReport mock is already set by Set")
+	}
+
+	if mmGetWeightDiscrepancyReport.defaultExpectation == nil {
+		mmGetWeightDiscrepancyReport.defaultExpectation = &IPVZOrderUseCaseMockGetWeightDiscrepancyReportExpectation{}
+	}
+
+	if mmGetWeightDiscrepancyReport.defaultExpectation.params != nil {
+		mmGetWeightDiscrepancyReport.mock.t.Fatalf("IPVZOrderUseCaseMock.GetWeightDiscrepancyReport mock is already set by Expect")
+	}
+
+	if mmGetWeightDiscrepancyReport.defaultExpectation.paramPtrs == nil {
+		mmGetWeightDiscrepancyReport.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockGetWeightDiscrepancyReportParamPtrs{}
+	}
+	mmGetWeightDiscrepancyReport.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetWeightDiscrepancyReport.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetWeightDiscrepancyReport
+}
+
+// ExpectDateParam2 sets up expected param date for IPVZOrderUseCase.GetWeightDiscrepancyReport
+func (mmGetWeightDiscrepancyReport *mIPVZOrderUseCaseMockGetWeightDiscrepancyReport) ExpectDateParam2(date time.Time) *mIPVZOrderUseCaseMockGetWeightDiscrepancyReport {
+	if mmGetWeightDiscrepancyReport.mock.funcGetWeightDiscrepancyReport != nil {
+		mmGetWeightDiscrepancyReport.mock.t.Fatalf("IPVZOrderUseCaseMock.GetWeightDiscrepancyReport mock is already set by Set")
+	}
+
+	if mmGetWeightDiscrepancyReport.defaultExpectation == nil {
+		mmGetWeightDiscrepancyReport.defaultExpectation = &IPVZOrderUseCaseMockGetWeightDiscrepancyReportExpectation{}
+	}
+
+	if mmGetWeightDiscrepancyReport.defaultExpectation.params != nil {
+		mmGetWeightDiscrepancyReport.mock.t.Fatalf("IPVZOrderUseCaseMock.GetWeightDiscrepancyReport mock is already set by Expect")
+	}
+
+	if mmGetWeightDiscrepancyReport.defaultExpectation.paramPtrs == nil {
+		mmGetWeightDiscrepancyReport.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockGetWeightDiscrepancyReportParamPtrs{}
+	}
+	mmGetWeightDiscrepancyReport.defaultExpectation.paramPtrs.date = &date
+	mmGetWeightDiscrepancyReport.defaultExpectation.expectationOrigins.originDate = minimock.CallerInfo(1)
+
+	return mmGetWeightDiscrepancyReport
+}
+
+// Inspect accepts an inspector function that has same arguments as the IPVZOrderUseCase.GetWeightDiscrepancyReport
+func (mmGetWeightDiscrepancyReport *mIPVZOrderUseCaseMockGetWeightDiscrepancyReport) Inspect(f func(ctx context.Context, date time.Time)) *mIPVZOrderUseCaseMockGetWeightDiscrepancyReport {
+	if mmGetWeightDiscrepancyReport.mock.inspectFuncGetWeightDiscrepancyReport != nil {
+		mmGetWeightDiscrepancyReport.mock.t.Fatalf("Inspect function is already set for IPVZOrderUseCaseMock.GetWeightDiscrepancyReport")
+	}
+
+	mmGetWeightDiscrepancyReport.mock.inspectFuncGetWeightDiscrepancyReport = f
+
+	return mmGetWeightDiscrepancyReport
+}
+
+// Return sets up results that will be returned by IPVZOrderUseCase.GetWeightDiscrepancyReport
+func (mmGetWeightDiscrepancyReport *mIPVZOrderUseCaseMockGetWeightDiscrepancyReport) Return(w1 domain.WeightDiscrepancyReport, err error) *IPVZOrderUseCaseMock {
+	if mmGetWeightDiscrepancyReport.mock.funcGetWeightDiscrepancyReport != nil {
+		mmGetWeightDiscrepancyReport.mock.t.Fatalf("IPVZOrderUseCaseMock.GetWeightDiscrepancyReport mock is already set by Set")
+	}
+
+	if mmGetWeightDiscrepancyReport.defaultExpectation == nil {
+		mmGetWeightDiscrepancyReport.defaultExpectation = &IPVZOrderUseCaseMockGetWeightDiscrepancyReportExpectation{mock: mmGetWeightDiscrepancyReport.mock}
+	}
+	mmGetWeightDiscrepancyReport.defaultExpectation.results = &IPVZOrderUseCaseMockGetWeightDiscrepancyReportResults{w1, err}
+	mmGetWeightDiscrepancyReport.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetWeightDiscrepancyReport.mock
+}
+
+// Set uses given function f to mock the IPVZOrderUseCase.GetWeightDiscrepancyReport method
+func (mmGetWeightDiscrepancyReport *mIPVZOrderUseCaseMockGetWeightDiscrepancyReport) Set(f func(ctx context.Context, date time.Time) (w1 domain.WeightDiscrepancyReport, err error)) *IPVZOrderUseCaseMock {
+	if mmGetWeightDiscrepancyReport.defaultExpectation != nil {
+		mmGetWeightDiscrepancyReport.mock.t.Fatalf("Default expectation is already set for the IPVZOrderUseCase.GetWeightDiscrepancyReport method")
+	}
+
+	if len(mmGetWeightDiscrepancyReport.expectations) > 0 {
+		mmGetWeightDiscrepancyReport.mock.t.Fatalf("Some expectations are already set for the IPVZOrderUseCase.GetWeightDiscrepancyReport method")
+	}
+
+	mmGetWeightDiscrepancyReport.mock.funcGetWeightDiscrepancyReport = f
+	mmGetWeightDiscrepancyReport.mock.funcGetWeightDiscrepancyReportOrigin = minimock.CallerInfo(1)
+	return mmGetWeightDiscrepancyReport.mock
+}
+
+// When sets expectation for the IPVZOrderUseCase.GetWeightDiscrepancyReport which will trigger the result defined by the following
+// Then helper
+func (mmGetWeightDiscrepancyReport *mIPVZOrderUseCaseMockGetWeightDiscrepancyReport) When(ctx context.Context, date time.Time) *IPVZOrderUseCaseMockGetWeightDiscrepancyReportExpectation {
+	if mmGetWeightDiscrepancyReport.mock.funcGetWeightDiscrepancyReport != nil {
+		mmGetWeightDiscrepancyReport.mock.t.Fatalf("IPVZOrderUseCaseMock.GetWeightDiscrepancyReport mock is already set by Set")
+	}
+
+	expectation := &IPVZOrderUseCaseMockGetWeightDiscrepancyReportExpectation{
+		mock:               mmGetWeightDiscrepancyReport.mock,
+		params:             &IPVZOrderUseCaseMockGetWeightDiscrepancyReportParams{ctx, date},
+		expectationOrigins: IPVZOrderUseCaseMockGetWeightDiscrepancyReportExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetWeightDiscrepancyReport.expectations = append(mmGetWeightDiscrepancyReport.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IPVZOrderUseCase.GetWeightDiscrepancyReport return parameters for the expectation previously defined by the When method
+func (e *IPVZOrderUseCaseMockGetWeightDiscrepancyReportExpectation) Then(w1 domain.WeightDiscrepancyReport, err error) *IPVZOrderUseCaseMock {
+	e.results = &IPVZOrderUseCaseMockGetWeightDiscrepancyReportResults{w1, err}
+	return e.mock
+}
+
+// Times sets number of times IPVZOrderUseCase.GetWeightDiscrepancyReport should be invoked
+func (mmGetWeightDiscrepancyReport *mIPVZOrderUseCaseMockGetWeightDiscrepancyReport) Times(n uint64) *mIPVZOrderUseCaseMockGetWeightDiscrepancyReport {
+	if n == 0 {
+		mmGetWeightDiscrepancyReport.mock.t.Fatalf("Times of IPVZOrderUseCaseMock.GetWeightDiscrepancyReport mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetWeightDiscrepancyReport.expectedInvocations, n)
+	mmGetWeightDiscrepancyReport.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetWeightDiscrepancyReport
+}
+
+func (mmGetWeightDiscrepancyReport *mIPVZOrderUseCaseMockGetWeightDiscrepancyReport) invocationsDone() bool {
+	if len(mmGetWeightDiscrepancyReport.expectations) == 0 && mmGetWeightDiscrepancyReport.defaultExpectation == nil && mmGetWeightDiscrepancyReport.mock.funcGetWeightDiscrepancyReport == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetWeightDiscrepancyReport.mock.afterGetWeightDiscrepancyReportCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetWeightDiscrepancyReport.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetWeightDiscrepancyReport implements mm_abstractions.IPVZOrderUseCase
+func (mmGetWeightDiscrepancyReport *IPVZOrderUseCaseMock) GetWeightDiscrepancyReport(ctx context.Context, date time.Time) (w1 domain.WeightDiscrepancyReport, err error) {
+	mm_atomic.AddUint64(&mmGetWeightDiscrepancyReport.beforeGetWeightDiscrepancyReportCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetWeightDiscrepancyReport.afterGetWeightDiscrepancyReportCounter, 1)
+
+	mmGetWeightDiscrepancyReport.t.Helper()
+
+	if mmGetWeightDiscrepancyReport.inspectFuncGetWeightDiscrepancyReport != nil {
+		mmGetWeightDiscrepancyReport.inspectFuncGetWeightDiscrepancyReport(ctx, date)
+	}
+
+	mm_params := IPVZOrderUseCaseMockGetWeightDiscrepancyReportParams{ctx, date}
+
+	// Record call args
+	mmGetWeightDiscrepancyReport.GetWeightDiscrepancyReportMock.mutex.Lock()
+	mmGetWeightDiscrepancyReport.GetWeightDiscrepancyReportMock.callArgs = append(mmGetWeightDiscrepancyReport.GetWeightDiscrepancyReportMock.callArgs, &mm_params)
+	mmGetWeightDiscrepancyReport.GetWeightDiscrepancyReportMock.mutex.Unlock()
+
+	for _, e := range mmGetWeightDiscrepancyReport.GetWeightDiscrepancyReportMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.w1, e.results.err
+		}
+	}
+
+	if mmGetWeightDiscrepancyReport.GetWeightDiscrepancyReportMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetWeightDiscrepancyReport.GetWeightDiscrepancyReportMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetWeightDiscrepancyReport.GetWeightDiscrepancyReportMock.defaultExpectation.params
+		mm_want_ptrs := mmGetWeightDiscrepancyReport.GetWeightDiscrepancyReportMock.defaultExpectation.paramPtrs
+
+		mm_got := IPVZOrderUseCaseMockGetWeightDiscrepancyReportParams{ctx, date}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetWeightDiscrepancyReport.t.Errorf("IPVZOrderUseCaseMock.GetWeightDiscrepancyReport got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetWeightDiscrepancyReport.GetWeightDiscrepancyReportMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.date != nil && !minimock.Equal(*mm_want_ptrs.date, mm_got.date) {
+				mmGetWeightDiscrepancyReport.t.Errorf("IPVZOrderUseCaseMock.GetWeightDiscrepancyReport got unexpected parameter date, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetWeightDiscrepancyReport.GetWeightDiscrepancyReportMock.defaultExpectation.expectationOrigins.originDate, *mm_want_ptrs.date, mm_got.date, minimock.Diff(*mm_want_ptrs.date, mm_got.date))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetWeightDiscrepancyReport.t.Errorf("IPVZOrderUseCaseMock.GetWeightDiscrepancyReport got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetWeightDiscrepancyReport.GetWeightDiscrepancyReportMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetWeightDiscrepancyReport.GetWeightDiscrepancyReportMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetWeightDiscrepancyReport.t.Fatal("No results are set for the IPVZOrderUseCaseMock.GetWeightDiscrepancyReport")
+		}
+		return (*mm_results).w1, (*mm_results).err
+	}
+	if mmGetWeightDiscrepancyReport.funcGetWeightDiscrepancyReport != nil {
+		return mmGetWeightDiscrepancyReport.funcGetWeightDiscrepancyReport(ctx, date)
+	}
+	mmGetWeightDiscrepancyReport.t.Fatalf("Unexpected call to IPVZOrderUseCaseMock.GetWeightDiscrepancyReport. %v %v", ctx, date)
 	return
 }
 
-// GetReturnsAfterCounter returns a count of finished IPVZOrderUseCaseMock.GetReturns invocations
-func (mmGetReturns *IPVZOrderUseCaseMock) GetReturnsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetReturns.afterGetReturnsCounter)
+// GetWeightDiscrepancyReportAfterCounter returns a count of finished IPVZOrderUseCaseMock.GetWeightDiscrepancyReport invocations
+func (mmGetWeightDiscrepancyReport *IPVZOrderUseCaseMock) GetWeightDiscrepancyReportAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetWeightDiscrepancyReport.afterGetWeightDiscrepancyReportCounter)
 }
 
-// GetReturnsBeforeCounter returns a count of IPVZOrderUseCaseMock.GetReturns invocations
-func (mmGetReturns *IPVZOrderUseCaseMock) GetReturnsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetReturns.beforeGetReturnsCounter)
+// GetWeightDiscrepancyReportBeforeCounter returns a count of IPVZOrderUseCaseMock.GetWeightDiscrepancyReport invocations
+func (mmGetWeightDiscrepancyReport *IPVZOrderUseCaseMock) GetWeightDiscrepancyReportBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetWeightDiscrepancyReport.beforeGetWeightDiscrepancyReportCounter)
 }
 
-// Calls returns a list of arguments used in each call to IPVZOrderUseCaseMock.GetReturns.
+// Calls returns a list of arguments used in each call to IPVZOrderUseCaseMock.GetWeightDiscrepancyReport.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetReturns *mIPVZOrderUseCaseMockGetReturns) Calls() []*IPVZOrderUseCaseMockGetReturnsParams {
-	mmGetReturns.mutex.RLock()
+func (mmGetWeightDiscrepancyReport *mIPVZOrderUseCaseMockGetWeightDiscrepancyReport) Calls() []*IPVZOrderUseCaseMockGetWeightDiscrepancyReportParams {
+	mmGetWeightDiscrepancyReport.mutex.RLock()
 
-	argCopy := make([]*IPVZOrderUseCaseMockGetReturnsParams, len(mmGetReturns.callArgs))
-	copy(argCopy, mmGetReturns.callArgs)
+	argCopy := make([]*IPVZOrderUseCaseMockGetWeightDiscrepancyReportParams, len(mmGetWeightDiscrepancyReport.callArgs))
+	copy(argCopy, mmGetWeightDiscrepancyReport.callArgs)
 
-	mmGetReturns.mutex.RUnlock()
+	mmGetWeightDiscrepancyReport.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetReturnsDone returns true if the count of the GetReturns invocations corresponds
+// MinimockGetWeightDiscrepancyReportDone returns true if the count of the GetWeightDiscrepancyReport invocations corresponds
 // the number of defined expectations
-func (m *IPVZOrderUseCaseMock) MinimockGetReturnsDone() bool {
-	if m.GetReturnsMock.optional {
+func (m *IPVZOrderUseCaseMock) MinimockGetWeightDiscrepancyReportDone() bool {
+	if m.GetWeightDiscrepancyReportMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetReturnsMock.expectations {
+	for _, e := range m.GetWeightDiscrepancyReportMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetReturnsMock.invocationsDone()
+	return m.GetWeightDiscrepancyReportMock.invocationsDone()
 }
 
-// MinimockGetReturnsInspect logs each unmet expectation
-func (m *IPVZOrderUseCaseMock) MinimockGetReturnsInspect() {
-	for _, e := range m.GetReturnsMock.expectations {
+// MinimockGetWeightDiscrepancyReportInspect logs each unmet expectation
+func (m *IPVZOrderUseCaseMock) MinimockGetWeightDiscrepancyReportInspect() {
+	for _, e := range m.GetWeightDiscrepancyReportMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to IPVZOrderUseCaseMock.GetReturns at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to IPVZOrderUseCaseMock.GetWeightDiscrepancyReport at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetReturnsCounter := mm_atomic.LoadUint64(&m.afterGetReturnsCounter)
+	afterGetWeightDiscrepancyReportCounter := mm_atomic.LoadUint64(&m.afterGetWeightDiscrepancyReportCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetReturnsMock.defaultExpectation != nil && afterGetReturnsCounter < 1 {
-		if m.GetReturnsMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to IPVZOrderUseCaseMock.GetReturns at\n%s", m.GetReturnsMock.defaultExpectation.returnOrigin)
+	if m.GetWeightDiscrepancyReportMock.defaultExpectation != nil && afterGetWeightDiscrepancyReportCounter < 1 {
+		if m.GetWeightDiscrepancyReportMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IPVZOrderUseCaseMock.GetWeightDiscrepancyReport at\n%s", m.GetWeightDiscrepancyReportMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to IPVZOrderUseCaseMock.GetReturns at\n%s with params: %#v", m.GetReturnsMock.defaultExpectation.expectationOrigins.origin, *m.GetReturnsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to IPVZOrderUseCaseMock.GetWeightDiscrepancyReport at\n%s with params: %#v", m.GetWeightDiscrepancyReportMock.defaultExpectation.expectationOrigins.origin, *m.GetWeightDiscrepancyReportMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetReturns != nil && afterGetReturnsCounter < 1 {
-		m.t.Errorf("Expected call to IPVZOrderUseCaseMock.GetReturns at\n%s", m.funcGetReturnsOrigin)
+	if m.funcGetWeightDiscrepancyReport != nil && afterGetWeightDiscrepancyReportCounter < 1 {
+		m.t.Errorf("Expected call to IPVZOrderUseCaseMock.GetWeightDiscrepancyReport at\n%s", m.funcGetWeightDiscrepancyReportOrigin)
 	}
 
-	if !m.GetReturnsMock.invocationsDone() && afterGetReturnsCounter > 0 {
-		m.t.Errorf("Expected %d calls to IPVZOrderUseCaseMock.GetReturns at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetReturnsMock.expectedInvocations), m.GetReturnsMock.expectedInvocationsOrigin, afterGetReturnsCounter)
+	if !m.GetWeightDiscrepancyReportMock.invocationsDone() && afterGetWeightDiscrepancyReportCounter > 0 {
+		m.t.Errorf("Expected %d calls to IPVZOrderUseCaseMock.GetWeightDiscrepancyReport at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetWeightDiscrepancyReportMock.expectedInvocations), m.GetWeightDiscrepancyReportMock.expectedInvocationsOrigin, afterGetWeightDiscrepancyReportCounter)
 	}
 }
 
@@ -1738,26 +3377,27 @@ type IPVZOrderUseCaseMockGiveOrderToClientExpectation struct {
 
 // IPVZOrderUseCaseMockGiveOrderToClientParams contains parameters of the IPVZOrderUseCase.GiveOrderToClient
 type IPVZOrderUseCaseMockGiveOrderToClientParams struct {
-	ctx      context.Context
-	orderIDs []string
+	ctx       context.Context
+	decisions []domain.IssueDecision
 }
 
 // IPVZOrderUseCaseMockGiveOrderToClientParamPtrs contains pointers to parameters of the IPVZOrderUseCase.GiveOrderToClient
 type IPVZOrderUseCaseMockGiveOrderToClientParamPtrs struct {
-	ctx      *context.Context
-	orderIDs *[]string
+	ctx       *context.Context
+	decisions *[]domain.IssueDecision
 }
 
 // IPVZOrderUseCaseMockGiveOrderToClientResults contains results of the IPVZOrderUseCase.GiveOrderToClient
 type IPVZOrderUseCaseMockGiveOrderToClientResults struct {
+	ia1 []domain.IssueResult
 	err error
 }
 
 // IPVZOrderUseCaseMockGiveOrderToClientOrigins contains origins of expectations of the IPVZOrderUseCase.GiveOrderToClient
 type IPVZOrderUseCaseMockGiveOrderToClientExpectationOrigins struct {
-	origin         string
-	originCtx      string
-	originOrderIDs string
+	origin          string
+	originCtx       string
+	originDecisions string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1771,7 +3411,7 @@ func (mmGiveOrderToClient *mIPVZOrderUseCaseMockGiveOrderToClient) Optional() *m
 }
 
 // Expect sets up expected params for IPVZOrderUseCase.GiveOrderToClient
-func (mmGiveOrderToClient *mIPVZOrderUseCaseMockGiveOrderToClient) Expect(ctx context.Context, orderIDs []string) *mIPVZOrderUseCaseMockGiveOrderToClient {
+func (mmGiveOrderToClient *mIPVZOrderUseCaseMockGiveOrderToClient) Expect(ctx context.Context, decisions []domain.IssueDecision) *mIPVZOrderUseCaseMockGiveOrderToClient {
 	if mmGiveOrderToClient.mock.funcGiveOrderToClient != nil {
 		mmGiveOrderToClient.mock.t.Fatalf("IPVZOrderUseCaseMock.GiveOrderToClient mock is already set by Set")
 	}
@@ -1784,7 +3424,7 @@ func (mmGiveOrderToClient *mIPVZOrderUseCaseMockGiveOrderToClient) Expect(ctx co
 		mmGiveOrderToClient.mock.t.Fatalf("IPVZOrderUseCaseMock.GiveOrderToClient mock is already set by ExpectParams functions")
 	}
 
-	mmGiveOrderToClient.defaultExpectation.params = &IPVZOrderUseCaseMockGiveOrderToClientParams{ctx, orderIDs}
+	mmGiveOrderToClient.defaultExpectation.params = &IPVZOrderUseCaseMockGiveOrderToClientParams{ctx, decisions}
 	mmGiveOrderToClient.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmGiveOrderToClient.expectations {
 		if minimock.Equal(e.params, mmGiveOrderToClient.defaultExpectation.params) {
@@ -1818,8 +3458,8 @@ func (mmGiveOrderToClient *mIPVZOrderUseCaseMockGiveOrderToClient) ExpectCtxPara
 	return mmGiveOrderToClient
 }
 
-// ExpectOrderIDsParam2 sets up expected param orderIDs for IPVZOrderUseCase.GiveOrderToClient
-func (mmGiveOrderToClient *mIPVZOrderUseCaseMockGiveOrderToClient) ExpectOrderIDsParam2(orderIDs []string) *mIPVZOrderUseCaseMockGiveOrderToClient {
+// ExpectDecisionsParam2 sets up expected param decisions for IPVZOrderUseCase.GiveOrderToClient
+func (mmGiveOrderToClient *mIPVZOrderUseCaseMockGiveOrderToClient) ExpectDecisionsParam2(decisions []domain.IssueDecision) *mIPVZOrderUseCaseMockGiveOrderToClient {
 	if mmGiveOrderToClient.mock.funcGiveOrderToClient != nil {
 		mmGiveOrderToClient.mock.t.Fatalf("IPVZOrderUseCaseMock.GiveOrderToClient mock is already set by Set")
 	}
@@ -1835,14 +3475,14 @@ func (mmGiveOrderToClient *mIPVZOrderUseCaseMockGiveOrderToClient) ExpectOrderID
 	if mmGiveOrderToClient.defaultExpectation.paramPtrs == nil {
 		mmGiveOrderToClient.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockGiveOrderToClientParamPtrs{}
 	}
-	mmGiveOrderToClient.defaultExpectation.paramPtrs.orderIDs = &orderIDs
-	mmGiveOrderToClient.defaultExpectation.expectationOrigins.originOrderIDs = minimock.CallerInfo(1)
+	mmGiveOrderToClient.defaultExpectation.paramPtrs.decisions = &decisions
+	mmGiveOrderToClient.defaultExpectation.expectationOrigins.originDecisions = minimock.CallerInfo(1)
 
 	return mmGiveOrderToClient
 }
 
 // Inspect accepts an inspector function that has same arguments as the IPVZOrderUseCase.GiveOrderToClient
-func (mmGiveOrderToClient *mIPVZOrderUseCaseMockGiveOrderToClient) Inspect(f func(ctx context.Context, orderIDs []string)) *mIPVZOrderUseCaseMockGiveOrderToClient {
+func (mmGiveOrderToClient *mIPVZOrderUseCaseMockGiveOrderToClient) Inspect(f func(ctx context.Context, decisions []domain.IssueDecision)) *mIPVZOrderUseCaseMockGiveOrderToClient {
 	if mmGiveOrderToClient.mock.inspectFuncGiveOrderToClient != nil {
 		mmGiveOrderToClient.mock.t.Fatalf("Inspect function is already set for IPVZOrderUseCaseMock.GiveOrderToClient")
 	}
@@ -1853,7 +3493,7 @@ func (mmGiveOrderToClient *mIPVZOrderUseCaseMockGiveOrderToClient) Inspect(f fun
 }
 
 // Return sets up results that will be returned by IPVZOrderUseCase.GiveOrderToClient
-func (mmGiveOrderToClient *mIPVZOrderUseCaseMockGiveOrderToClient) Return(err error) *IPVZOrderUseCaseMock {
+func (mmGiveOrderToClient *mIPVZOrderUseCaseMockGiveOrderToClient) Return(ia1 []domain.IssueResult, err error) *IPVZOrderUseCaseMock {
 	if mmGiveOrderToClient.mock.funcGiveOrderToClient != nil {
 		mmGiveOrderToClient.mock.t.Fatalf("IPVZOrderUseCaseMock.GiveOrderToClient mock is already set by Set")
 	}
@@ -1861,13 +3501,13 @@ func (mmGiveOrderToClient *mIPVZOrderUseCaseMockGiveOrderToClient) Return(err er
 	if mmGiveOrderToClient.defaultExpectation == nil {
 		mmGiveOrderToClient.defaultExpectation = &IPVZOrderUseCaseMockGiveOrderToClientExpectation{mock: mmGiveOrderToClient.mock}
 	}
-	mmGiveOrderToClient.defaultExpectation.results = &IPVZOrderUseCaseMockGiveOrderToClientResults{err}
+	mmGiveOrderToClient.defaultExpectation.results = &IPVZOrderUseCaseMockGiveOrderToClientResults{ia1, err}
 	mmGiveOrderToClient.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
 	return mmGiveOrderToClient.mock
 }
 
 // Set uses given function f to mock the IPVZOrderUseCase.GiveOrderToClient method
-func (mmGiveOrderToClient *mIPVZOrderUseCaseMockGiveOrderToClient) Set(f func(ctx context.Context, orderIDs []string) (err error)) *IPVZOrderUseCaseMock {
+func (mmGiveOrderToClient *mIPVZOrderUseCaseMockGiveOrderToClient) Set(f func(ctx context.Context, decisions []domain.IssueDecision) (ia1 []domain.IssueResult, err error)) *IPVZOrderUseCaseMock {
 	if mmGiveOrderToClient.defaultExpectation != nil {
 		mmGiveOrderToClient.mock.t.Fatalf("Default expectation is already set for the IPVZOrderUseCase.GiveOrderToClient method")
 	}
@@ -1883,14 +3523,14 @@ func (mmGiveOrderToClient *mIPVZOrderUseCaseMockGiveOrderToClient) Set(f func(ct
 
 // When sets expectation for the IPVZOrderUseCase.GiveOrderToClient which will trigger the result defined by the following
 // Then helper
-func (mmGiveOrderToClient *mIPVZOrderUseCaseMockGiveOrderToClient) When(ctx context.Context, orderIDs []string) *IPVZOrderUseCaseMockGiveOrderToClientExpectation {
+func (mmGiveOrderToClient *mIPVZOrderUseCaseMockGiveOrderToClient) When(ctx context.Context, decisions []domain.IssueDecision) *IPVZOrderUseCaseMockGiveOrderToClientExpectation {
 	if mmGiveOrderToClient.mock.funcGiveOrderToClient != nil {
 		mmGiveOrderToClient.mock.t.Fatalf("IPVZOrderUseCaseMock.GiveOrderToClient mock is already set by Set")
 	}
 
 	expectation := &IPVZOrderUseCaseMockGiveOrderToClientExpectation{
 		mock:               mmGiveOrderToClient.mock,
-		params:             &IPVZOrderUseCaseMockGiveOrderToClientParams{ctx, orderIDs},
+		params:             &IPVZOrderUseCaseMockGiveOrderToClientParams{ctx, decisions},
 		expectationOrigins: IPVZOrderUseCaseMockGiveOrderToClientExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmGiveOrderToClient.expectations = append(mmGiveOrderToClient.expectations, expectation)
@@ -1898,8 +3538,8 @@ func (mmGiveOrderToClient *mIPVZOrderUseCaseMockGiveOrderToClient) When(ctx cont
 }
 
 // Then sets up IPVZOrderUseCase.GiveOrderToClient return parameters for the expectation previously defined by the When method
-func (e *IPVZOrderUseCaseMockGiveOrderToClientExpectation) Then(err error) *IPVZOrderUseCaseMock {
-	e.results = &IPVZOrderUseCaseMockGiveOrderToClientResults{err}
+func (e *IPVZOrderUseCaseMockGiveOrderToClientExpectation) Then(ia1 []domain.IssueResult, err error) *IPVZOrderUseCaseMock {
+	e.results = &IPVZOrderUseCaseMockGiveOrderToClientResults{ia1, err}
 	return e.mock
 }
 
@@ -1925,17 +3565,17 @@ func (mmGiveOrderToClient *mIPVZOrderUseCaseMockGiveOrderToClient) invocationsDo
 }
 
 // GiveOrderToClient implements mm_abstractions.IPVZOrderUseCase
-func (mmGiveOrderToClient *IPVZOrderUseCaseMock) GiveOrderToClient(ctx context.Context, orderIDs []string) (err error) {
+func (mmGiveOrderToClient *IPVZOrderUseCaseMock) GiveOrderToClient(ctx context.Context, decisions []domain.IssueDecision) (ia1 []domain.IssueResult, err error) {
 	mm_atomic.AddUint64(&mmGiveOrderToClient.beforeGiveOrderToClientCounter, 1)
 	defer mm_atomic.AddUint64(&mmGiveOrderToClient.afterGiveOrderToClientCounter, 1)
 
 	mmGiveOrderToClient.t.Helper()
 
 	if mmGiveOrderToClient.inspectFuncGiveOrderToClient != nil {
-		mmGiveOrderToClient.inspectFuncGiveOrderToClient(ctx, orderIDs)
+		mmGiveOrderToClient.inspectFuncGiveOrderToClient(ctx, decisions)
 	}
 
-	mm_params := IPVZOrderUseCaseMockGiveOrderToClientParams{ctx, orderIDs}
+	mm_params := IPVZOrderUseCaseMockGiveOrderToClientParams{ctx, decisions}
 
 	// Record call args
 	mmGiveOrderToClient.GiveOrderToClientMock.mutex.Lock()
@@ -1945,7 +3585,7 @@ func (mmGiveOrderToClient *IPVZOrderUseCaseMock) GiveOrderToClient(ctx context.C
 	for _, e := range mmGiveOrderToClient.GiveOrderToClientMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.ia1, e.results.err
 		}
 	}
 
@@ -1954,7 +3594,7 @@ func (mmGiveOrderToClient *IPVZOrderUseCaseMock) GiveOrderToClient(ctx context.C
 		mm_want := mmGiveOrderToClient.GiveOrderToClientMock.defaultExpectation.params
 		mm_want_ptrs := mmGiveOrderToClient.GiveOrderToClientMock.defaultExpectation.paramPtrs
 
-		mm_got := IPVZOrderUseCaseMockGiveOrderToClientParams{ctx, orderIDs}
+		mm_got := IPVZOrderUseCaseMockGiveOrderToClientParams{ctx, decisions}
 
 		if mm_want_ptrs != nil {
 
@@ -1963,9 +3603,9 @@ func (mmGiveOrderToClient *IPVZOrderUseCaseMock) GiveOrderToClient(ctx context.C
 					mmGiveOrderToClient.GiveOrderToClientMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.orderIDs != nil && !minimock.Equal(*mm_want_ptrs.orderIDs, mm_got.orderIDs) {
-				mmGiveOrderToClient.t.Errorf("IPVZOrderUseCaseMock.GiveOrderToClient got unexpected parameter orderIDs, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGiveOrderToClient.GiveOrderToClientMock.defaultExpectation.expectationOrigins.originOrderIDs, *mm_want_ptrs.orderIDs, mm_got.orderIDs, minimock.Diff(*mm_want_ptrs.orderIDs, mm_got.orderIDs))
+			if mm_want_ptrs.decisions != nil && !minimock.Equal(*mm_want_ptrs.decisions, mm_got.decisions) {
+				mmGiveOrderToClient.t.Errorf("IPVZOrderUseCaseMock.GiveOrderToClient got unexpected parameter decisions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGiveOrderToClient.GiveOrderToClientMock.defaultExpectation.expectationOrigins.originDecisions, *mm_want_ptrs.decisions, mm_got.decisions, minimock.Diff(*mm_want_ptrs.decisions, mm_got.decisions))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
@@ -1977,12 +3617,12 @@ func (mmGiveOrderToClient *IPVZOrderUseCaseMock) GiveOrderToClient(ctx context.C
 		if mm_results == nil {
 			mmGiveOrderToClient.t.Fatal("No results are set for the IPVZOrderUseCaseMock.GiveOrderToClient")
 		}
-		return (*mm_results).err
+		return (*mm_results).ia1, (*mm_results).err
 	}
 	if mmGiveOrderToClient.funcGiveOrderToClient != nil {
-		return mmGiveOrderToClient.funcGiveOrderToClient(ctx, orderIDs)
+		return mmGiveOrderToClient.funcGiveOrderToClient(ctx, decisions)
 	}
-	mmGiveOrderToClient.t.Fatalf("Unexpected call to IPVZOrderUseCaseMock.GiveOrderToClient. %v %v", ctx, orderIDs)
+	mmGiveOrderToClient.t.Fatalf("Unexpected call to IPVZOrderUseCaseMock.GiveOrderToClient. %v %v", ctx, decisions)
 	return
 }
 
@@ -2054,6 +3694,411 @@ func (m *IPVZOrderUseCaseMock) MinimockGiveOrderToClientInspect() {
 	}
 }
 
+type mIPVZOrderUseCaseMockQuotePackaging struct {
+	optional           bool
+	mock               *IPVZOrderUseCaseMock
+	defaultExpectation *IPVZOrderUseCaseMockQuotePackagingExpectation
+	expectations       []*IPVZOrderUseCaseMockQuotePackagingExpectation
+
+	callArgs []*IPVZOrderUseCaseMockQuotePackagingParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IPVZOrderUseCaseMockQuotePackagingExpectation specifies expectation struct of the IPVZOrderUseCase.QuotePackaging
+type IPVZOrderUseCaseMockQuotePackagingExpectation struct {
+	mock               *IPVZOrderUseCaseMock
+	params             *IPVZOrderUseCaseMockQuotePackagingParams
+	paramPtrs          *IPVZOrderUseCaseMockQuotePackagingParamPtrs
+	expectationOrigins IPVZOrderUseCaseMockQuotePackagingExpectationOrigins
+	results            *IPVZOrderUseCaseMockQuotePackagingResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IPVZOrderUseCaseMockQuotePackagingParams contains parameters of the IPVZOrderUseCase.QuotePackaging
+type IPVZOrderUseCaseMockQuotePackagingParams struct {
+	ctx        context.Context
+	cost       domain.Money
+	weight     int
+	dimensions domain.Dimensions
+}
+
+// IPVZOrderUseCaseMockQuotePackagingParamPtrs contains pointers to parameters of the IPVZOrderUseCase.QuotePackaging
+type IPVZOrderUseCaseMockQuotePackagingParamPtrs struct {
+	ctx        *context.Context
+	cost       *domain.Money
+	weight     *int
+	dimensions *domain.Dimensions
+}
+
+// IPVZOrderUseCaseMockQuotePackagingResults contains results of the IPVZOrderUseCase.QuotePackaging
+type IPVZOrderUseCaseMockQuotePackagingResults struct {
+	pa1 []domain.PackagingQuote
+	err error
+}
+
+// IPVZOrderUseCaseMockQuotePackagingOrigins contains origins of expectations of the IPVZOrderUseCase.QuotePackaging
+type IPVZOrderUseCaseMockQuotePackagingExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originCost       string
+	originWeight     string
+	originDimensions string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmQuotePackaging *mIPVZOrderUseCaseMockQuotePackaging) Optional() *mIPVZOrderUseCaseMockQuotePackaging {
+	mmQuotePackaging.optional = true
+	return mmQuotePackaging
+}
+
+// Expect sets up expected params for IPVZOrderUseCase.QuotePackaging
+func (mmQuotePackaging *mIPVZOrderUseCaseMockQuotePackaging) Expect(ctx context.Context, cost domain.Money, weight int, dimensions domain.Dimensions) *mIPVZOrderUseCaseMockQuotePackaging {
+	if mmQuotePackaging.mock.funcQuotePackaging != nil {
+		mmQuotePackaging.mock.t.Fatalf("IPVZOrderUseCaseMock.QuotePackaging mock is already set by Set")
+	}
+
+	if mmQuotePackaging.defaultExpectation == nil {
+		mmQuotePackaging.defaultExpectation = &IPVZOrderUseCaseMockQuotePackagingExpectation{}
+	}
+
+	if mmQuotePackaging.defaultExpectation.paramPtrs != nil {
+		mmQuotePackaging.mock.t.Fatalf("IPVZOrderUseCaseMock.QuotePackaging mock is already set by ExpectParams functions")
+	}
+
+	mmQuotePackaging.defaultExpectation.params = &IPVZOrderUseCaseMockQuotePackagingParams{ctx, cost, weight, dimensions}
+	mmQuotePackaging.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmQuotePackaging.expectations {
+		if minimock.Equal(e.params, mmQuotePackaging.defaultExpectation.params) {
+			mmQuotePackaging.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmQuotePackaging.defaultExpectation.params)
+		}
+	}
+
+	return mmQuotePackaging
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IPVZOrderUseCase.QuotePackaging
+func (mmQuotePackaging *mIPVZOrderUseCaseMockQuotePackaging) ExpectCtxParam1(ctx context.Context) *mIPVZOrderUseCaseMockQuotePackaging {
+	if mmQuotePackaging.mock.funcQuotePackaging != nil {
+		mmQuotePackaging.mock.t.Fatalf("IPVZOrderUseCaseMock.QuotePackaging mock is already set by Set")
+	}
+
+	if mmQuotePackaging.defaultExpectation == nil {
+		mmQuotePackaging.defaultExpectation = &IPVZOrderUseCaseMockQuotePackagingExpectation{}
+	}
+
+	if mmQuotePackaging.defaultExpectation.params != nil {
+		mmQuotePackaging.mock.t.Fatalf("IPVZOrderUseCaseMock.QuotePackaging mock is already set by Expect")
+	}
+
+	if mmQuotePackaging.defaultExpectation.paramPtrs == nil {
+		mmQuotePackaging.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockQuotePackagingParamPtrs{}
+	}
+	mmQuotePackaging.defaultExpectation.paramPtrs.ctx = &ctx
+	mmQuotePackaging.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmQuotePackaging
+}
+
+// ExpectCostParam2 sets up expected param cost for IPVZOrderUseCase.QuotePackaging
+func (mmQuotePackaging *mIPVZOrderUseCaseMockQuotePackaging) ExpectCostParam2(cost domain.Money) *mIPVZOrderUseCaseMockQuotePackaging {
+	if mmQuotePackaging.mock.funcQuotePackaging != nil {
+		mmQuotePackaging.mock.t.Fatalf("IPVZOrderUseCaseMock.QuotePackaging mock is already set by Set")
+	}
+
+	if mmQuotePackaging.defaultExpectation == nil {
+		mmQuotePackaging.defaultExpectation = &IPVZOrderUseCaseMockQuotePackagingExpectation{}
+	}
+
+	if mmQuotePackaging.defaultExpectation.params != nil {
+		mmQuotePackaging.mock.t.Fatalf("IPVZOrderUseCaseMock.QuotePackaging mock is already set by Expect")
+	}
+
+	if mmQuotePackaging.defaultExpectation.paramPtrs == nil {
+		mmQuotePackaging.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockQuotePackagingParamPtrs{}
+	}
+	mmQuotePackaging.defaultExpectation.paramPtrs.cost = &cost
+	mmQuotePackaging.defaultExpectation.expectationOrigins.originCost = minimock.CallerInfo(1)
+
+	return mmQuotePackaging
+}
+
+// ExpectWeightParam3 sets up expected param weight for IPVZOrderUseCase.QuotePackaging
+func (mmQuotePackaging *mIPVZOrderUseCaseMockQuotePackaging) ExpectWeightParam3(weight int) *mIPVZOrderUseCaseMockQuotePackaging {
+	if mmQuotePackaging.mock.funcQuotePackaging != nil {
+		mmQuotePackaging.mock.t.Fatalf("IPVZOrderUseCaseMock.QuotePackaging mock is already set by Set")
+	}
+
+	if mmQuotePackaging.defaultExpectation == nil {
+		mmQuotePackaging.defaultExpectation = &IPVZOrderUseCaseMockQuotePackagingExpectation{}
+	}
+
+	if mmQuotePackaging.defaultExpectation.params != nil {
+		mmQuotePackaging.mock.t.Fatalf("IPVZOrderUseCaseMock.QuotePackaging mock is already set by Expect")
+	}
+
+	if mmQuotePackaging.defaultExpectation.paramPtrs == nil {
+		mmQuotePackaging.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockQuotePackagingParamPtrs{}
+	}
+	mmQuotePackaging.defaultExpectation.paramPtrs.weight = &weight
+	mmQuotePackaging.defaultExpectation.expectationOrigins.originWeight = minimock.CallerInfo(1)
+
+	return mmQuotePackaging
+}
+
+// ExpectDimensionsParam4 sets up expected param dimensions for IPVZOrderUseCase.QuotePackaging
+func (mmQuotePackaging *mIPVZOrderUseCaseMockQuotePackaging) ExpectDimensionsParam4(dimensions domain.Dimensions) *mIPVZOrderUseCaseMockQuotePackaging {
+	if mmQuotePackaging.mock.funcQuotePackaging != nil {
+		mmQuotePackaging.mock.t.Fatalf("IPVZOrderUseCaseMock.QuotePackaging mock is already set by Set")
+	}
+
+	if mmQuotePackaging.defaultExpectation == nil {
+		mmQuotePackaging.defaultExpectation = &IPVZOrderUseCaseMockQuotePackagingExpectation{}
+	}
+
+	if mmQuotePackaging.defaultExpectation.params != nil {
+		mmQuotePackaging.mock.t.Fatalf("IPVZOrderUseCaseMock.QuotePackaging mock is already set by Expect")
+	}
+
+	if mmQuotePackaging.defaultExpectation.paramPtrs == nil {
+		mmQuotePackaging.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockQuotePackagingParamPtrs{}
+	}
+	mmQuotePackaging.defaultExpectation.paramPtrs.dimensions = &dimensions
+	mmQuotePackaging.defaultExpectation.expectationOrigins.originDimensions = minimock.CallerInfo(1)
+
+	return mmQuotePackaging
+}
+
+// Inspect accepts an inspector function that has same arguments as the IPVZOrderUseCase.QuotePackaging
+func (mmQuotePackaging *mIPVZOrderUseCaseMockQuotePackaging) Inspect(f func(ctx context.Context, cost domain.Money, weight int, dimensions domain.Dimensions)) *mIPVZOrderUseCaseMockQuotePackaging {
+	if mmQuotePackaging.mock.inspectFuncQuotePackaging != nil {
+		mmQuotePackaging.mock.t.Fatalf("Inspect function is already set for IPVZOrderUseCaseMock.QuotePackaging")
+	}
+
+	mmQuotePackaging.mock.inspectFuncQuotePackaging = f
+
+	return mmQuotePackaging
+}
+
+// Return sets up results that will be returned by IPVZOrderUseCase.QuotePackaging
+func (mmQuotePackaging *mIPVZOrderUseCaseMockQuotePackaging) Return(pa1 []domain.PackagingQuote, err error) *IPVZOrderUseCaseMock {
+	if mmQuotePackaging.mock.funcQuotePackaging != nil {
+		mmQuotePackaging.mock.t.Fatalf("IPVZOrderUseCaseMock.QuotePackaging mock is already set by Set")
+	}
+
+	if mmQuotePackaging.defaultExpectation == nil {
+		mmQuotePackaging.defaultExpectation = &IPVZOrderUseCaseMockQuotePackagingExpectation{mock: mmQuotePackaging.mock}
+	}
+	mmQuotePackaging.defaultExpectation.results = &IPVZOrderUseCaseMockQuotePackagingResults{pa1, err}
+	mmQuotePackaging.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmQuotePackaging.mock
+}
+
+// Set uses given function f to mock the IPVZOrderUseCase.QuotePackaging method
+func (mmQuotePackaging *mIPVZOrderUseCaseMockQuotePackaging) Set(f func(ctx context.Context, cost domain.Money, weight int, dimensions domain.Dimensions) (pa1 []domain.PackagingQuote, err error)) *IPVZOrderUseCaseMock {
+	if mmQuotePackaging.defaultExpectation != nil {
+		mmQuotePackaging.mock.t.Fatalf("Default expectation is already set for the IPVZOrderUseCase.QuotePackaging method")
+	}
+
+	if len(mmQuotePackaging.expectations) > 0 {
+		mmQuotePackaging.mock.t.Fatalf("Some expectations are already set for the IPVZOrderUseCase.QuotePackaging method")
+	}
+
+	mmQuotePackaging.mock.funcQuotePackaging = f
+	mmQuotePackaging.mock.funcQuotePackagingOrigin = minimock.CallerInfo(1)
+	return mmQuotePackaging.mock
+}
+
+// When sets expectation for the IPVZOrderUseCase.QuotePackaging which will trigger the result defined by the following
+// Then helper
+func (mmQuotePackaging *mIPVZOrderUseCaseMockQuotePackaging) When(ctx context.Context, cost domain.Money, weight int, dimensions domain.Dimensions) *IPVZOrderUseCaseMockQuotePackagingExpectation {
+	if mmQuotePackaging.mock.funcQuotePackaging != nil {
+		mmQuotePackaging.mock.t.Fatalf("IPVZOrderUseCaseMock.QuotePackaging mock is already set by Set")
+	}
+
+	expectation := &IPVZOrderUseCaseMockQuotePackagingExpectation{
+		mock:               mmQuotePackaging.mock,
+		params:             &IPVZOrderUseCaseMockQuotePackagingParams{ctx, cost, weight, dimensions},
+		expectationOrigins: IPVZOrderUseCaseMockQuotePackagingExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmQuotePackaging.expectations = append(mmQuotePackaging.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IPVZOrderUseCase.QuotePackaging return parameters for the expectation previously defined by the When method
+func (e *IPVZOrderUseCaseMockQuotePackagingExpectation) Then(pa1 []domain.PackagingQuote, err error) *IPVZOrderUseCaseMock {
+	e.results = &IPVZOrderUseCaseMockQuotePackagingResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times IPVZOrderUseCase.QuotePackaging should be invoked
+func (mmQuotePackaging *mIPVZOrderUseCaseMockQuotePackaging) Times(n uint64) *mIPVZOrderUseCaseMockQuotePackaging {
+	if n == 0 {
+		mmQuotePackaging.mock.t.Fatalf("Times of IPVZOrderUseCaseMock.QuotePackaging mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmQuotePackaging.expectedInvocations, n)
+	mmQuotePackaging.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmQuotePackaging
+}
+
+func (mmQuotePackaging *mIPVZOrderUseCaseMockQuotePackaging) invocationsDone() bool {
+	if len(mmQuotePackaging.expectations) == 0 && mmQuotePackaging.defaultExpectation == nil && mmQuotePackaging.mock.funcQuotePackaging == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmQuotePackaging.mock.afterQuotePackagingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmQuotePackaging.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// QuotePackaging implements mm_abstractions.IPVZOrderUseCase
+func (mmQuotePackaging *IPVZOrderUseCaseMock) QuotePackaging(ctx context.Context, cost domain.Money, weight int, dimensions domain.Dimensions) (pa1 []domain.PackagingQuote, err error) {
+	mm_atomic.AddUint64(&mmQuotePackaging.beforeQuotePackagingCounter, 1)
+	defer mm_atomic.AddUint64(&mmQuotePackaging.afterQuotePackagingCounter, 1)
+
+	mmQuotePackaging.t.Helper()
+
+	if mmQuotePackaging.inspectFuncQuotePackaging != nil {
+		mmQuotePackaging.inspectFuncQuotePackaging(ctx, cost, weight, dimensions)
+	}
+
+	mm_params := IPVZOrderUseCaseMockQuotePackagingParams{ctx, cost, weight, dimensions}
+
+	// Record call args
+	mmQuotePackaging.QuotePackagingMock.mutex.Lock()
+	mmQuotePackaging.QuotePackagingMock.callArgs = append(mmQuotePackaging.QuotePackagingMock.callArgs, &mm_params)
+	mmQuotePackaging.QuotePackagingMock.mutex.Unlock()
+
+	for _, e := range mmQuotePackaging.QuotePackagingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmQuotePackaging.QuotePackagingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmQuotePackaging.QuotePackagingMock.defaultExpectation.Counter, 1)
+		mm_want := mmQuotePackaging.QuotePackagingMock.defaultExpectation.params
+		mm_want_ptrs := mmQuotePackaging.QuotePackagingMock.defaultExpectation.paramPtrs
+
+		mm_got := IPVZOrderUseCaseMockQuotePackagingParams{ctx, cost, weight, dimensions}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmQuotePackaging.t.Errorf("IPVZOrderUseCaseMock.QuotePackaging got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmQuotePackaging.QuotePackagingMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.cost != nil && !minimock.Equal(*mm_want_ptrs.cost, mm_got.cost) {
+				mmQuotePackaging.t.Errorf("IPVZOrderUseCaseMock.QuotePackaging got unexpected parameter cost, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmQuotePackaging.QuotePackagingMock.defaultExpectation.expectationOrigins.originCost, *mm_want_ptrs.cost, mm_got.cost, minimock.Diff(*mm_want_ptrs.cost, mm_got.cost))
+			}
+
+			if mm_want_ptrs.weight != nil && !minimock.Equal(*mm_want_ptrs.weight, mm_got.weight) {
+				mmQuotePackaging.t.Errorf("IPVZOrderUseCaseMock.QuotePackaging got unexpected parameter weight, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmQuotePackaging.QuotePackagingMock.defaultExpectation.expectationOrigins.originWeight, *mm_want_ptrs.weight, mm_got.weight, minimock.Diff(*mm_want_ptrs.weight, mm_got.weight))
+			}
+
+			if mm_want_ptrs.dimensions != nil && !minimock.Equal(*mm_want_ptrs.dimensions, mm_got.dimensions) {
+				mmQuotePackaging.t.Errorf("IPVZOrderUseCaseMock.QuotePackaging got unexpected parameter dimensions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmQuotePackaging.QuotePackagingMock.defaultExpectation.expectationOrigins.originDimensions, *mm_want_ptrs.dimensions, mm_got.dimensions, minimock.Diff(*mm_want_ptrs.dimensions, mm_got.dimensions))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmQuotePackaging.t.Errorf("IPVZOrderUseCaseMock.QuotePackaging got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmQuotePackaging.QuotePackagingMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmQuotePackaging.QuotePackagingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmQuotePackaging.t.Fatal("No results are set for the IPVZOrderUseCaseMock.QuotePackaging")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmQuotePackaging.funcQuotePackaging != nil {
+		return mmQuotePackaging.funcQuotePackaging(ctx, cost, weight, dimensions)
+	}
+	mmQuotePackaging.t.Fatalf("Unexpected call to IPVZOrderUseCaseMock.QuotePackaging. %v %v %v %v", ctx, cost, weight, dimensions)
+	return
+}
+
+// QuotePackagingAfterCounter returns a count of finished IPVZOrderUseCaseMock.QuotePackaging invocations
+func (mmQuotePackaging *IPVZOrderUseCaseMock) QuotePackagingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmQuotePackaging.afterQuotePackagingCounter)
+}
+
+// QuotePackagingBeforeCounter returns a count of IPVZOrderUseCaseMock.QuotePackaging invocations
+func (mmQuotePackaging *IPVZOrderUseCaseMock) QuotePackagingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmQuotePackaging.beforeQuotePackagingCounter)
+}
+
+// Calls returns a list of arguments used in each call to IPVZOrderUseCaseMock.QuotePackaging.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmQuotePackaging *mIPVZOrderUseCaseMockQuotePackaging) Calls() []*IPVZOrderUseCaseMockQuotePackagingParams {
+	mmQuotePackaging.mutex.RLock()
+
+	argCopy := make([]*IPVZOrderUseCaseMockQuotePackagingParams, len(mmQuotePackaging.callArgs))
+	copy(argCopy, mmQuotePackaging.callArgs)
+
+	mmQuotePackaging.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockQuotePackagingDone returns true if the count of the QuotePackaging invocations corresponds
+// the number of defined expectations
+func (m *IPVZOrderUseCaseMock) MinimockQuotePackagingDone() bool {
+	if m.QuotePackagingMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.QuotePackagingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.QuotePackagingMock.invocationsDone()
+}
+
+// MinimockQuotePackagingInspect logs each unmet expectation
+func (m *IPVZOrderUseCaseMock) MinimockQuotePackagingInspect() {
+	for _, e := range m.QuotePackagingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IPVZOrderUseCaseMock.QuotePackaging at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterQuotePackagingCounter := mm_atomic.LoadUint64(&m.afterQuotePackagingCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.QuotePackagingMock.defaultExpectation != nil && afterQuotePackagingCounter < 1 {
+		if m.QuotePackagingMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IPVZOrderUseCaseMock.QuotePackaging at\n%s", m.QuotePackagingMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IPVZOrderUseCaseMock.QuotePackaging at\n%s with params: %#v", m.QuotePackagingMock.defaultExpectation.expectationOrigins.origin, *m.QuotePackagingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcQuotePackaging != nil && afterQuotePackagingCounter < 1 {
+		m.t.Errorf("Expected call to IPVZOrderUseCaseMock.QuotePackaging at\n%s", m.funcQuotePackagingOrigin)
+	}
+
+	if !m.QuotePackagingMock.invocationsDone() && afterQuotePackagingCounter > 0 {
+		m.t.Errorf("Expected %d calls to IPVZOrderUseCaseMock.QuotePackaging at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.QuotePackagingMock.expectedInvocations), m.QuotePackagingMock.expectedInvocationsOrigin, afterQuotePackagingCounter)
+	}
+}
+
 type mIPVZOrderUseCaseMockReturnOrderDelivery struct {
 	optional           bool
 	mock               *IPVZOrderUseCaseMock
@@ -2082,12 +4127,14 @@ type IPVZOrderUseCaseMockReturnOrderDeliveryExpectation struct {
 type IPVZOrderUseCaseMockReturnOrderDeliveryParams struct {
 	ctx     context.Context
 	orderID string
+	options []mm_abstractions.MutationOptFunc
 }
 
 // IPVZOrderUseCaseMockReturnOrderDeliveryParamPtrs contains pointers to parameters of the IPVZOrderUseCase.ReturnOrderDelivery
 type IPVZOrderUseCaseMockReturnOrderDeliveryParamPtrs struct {
 	ctx     *context.Context
 	orderID *string
+	options *[]mm_abstractions.MutationOptFunc
 }
 
 // IPVZOrderUseCaseMockReturnOrderDeliveryResults contains results of the IPVZOrderUseCase.ReturnOrderDelivery
@@ -2100,6 +4147,7 @@ type IPVZOrderUseCaseMockReturnOrderDeliveryExpectationOrigins struct {
 	origin        string
 	originCtx     string
 	originOrderID string
+	originOptions string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -2113,7 +4161,7 @@ func (mmReturnOrderDelivery *mIPVZOrderUseCaseMockReturnOrderDelivery) Optional(
 }
 
 // Expect sets up expected params for IPVZOrderUseCase.ReturnOrderDelivery
-func (mmReturnOrderDelivery *mIPVZOrderUseCaseMockReturnOrderDelivery) Expect(ctx context.Context, orderID string) *mIPVZOrderUseCaseMockReturnOrderDelivery {
+func (mmReturnOrderDelivery *mIPVZOrderUseCaseMockReturnOrderDelivery) Expect(ctx context.Context, orderID string, options ...mm_abstractions.MutationOptFunc) *mIPVZOrderUseCaseMockReturnOrderDelivery {
 	if mmReturnOrderDelivery.mock.funcReturnOrderDelivery != nil {
 		mmReturnOrderDelivery.mock.t.Fatalf("IPVZOrderUseCaseMock.ReturnOrderDelivery mock is already set by Set")
 	}
@@ -2126,7 +4174,7 @@ func (mmReturnOrderDelivery *mIPVZOrderUseCaseMockReturnOrderDelivery) Expect(ct
 		mmReturnOrderDelivery.mock.t.Fatalf("IPVZOrderUseCaseMock.ReturnOrderDelivery mock is already set by ExpectParams functions")
 	}
 
-	mmReturnOrderDelivery.defaultExpectation.params = &IPVZOrderUseCaseMockReturnOrderDeliveryParams{ctx, orderID}
+	mmReturnOrderDelivery.defaultExpectation.params = &IPVZOrderUseCaseMockReturnOrderDeliveryParams{ctx, orderID, options}
 	mmReturnOrderDelivery.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmReturnOrderDelivery.expectations {
 		if minimock.Equal(e.params, mmReturnOrderDelivery.defaultExpectation.params) {
@@ -2183,8 +4231,31 @@ func (mmReturnOrderDelivery *mIPVZOrderUseCaseMockReturnOrderDelivery) ExpectOrd
 	return mmReturnOrderDelivery
 }
 
+// ExpectOptionsParam3 sets up expected param options for IPVZOrderUseCase.ReturnOrderDelivery
+func (mmReturnOrderDelivery *mIPVZOrderUseCaseMockReturnOrderDelivery) ExpectOptionsParam3(options ...mm_abstractions.MutationOptFunc) *mIPVZOrderUseCaseMockReturnOrderDelivery {
+	if mmReturnOrderDelivery.mock.funcReturnOrderDelivery != nil {
+		mmReturnOrderDelivery.mock.t.Fatalf("IPVZOrderUseCaseMock.ReturnOrderDelivery mock is already set by Set")
+	}
+
+	if mmReturnOrderDelivery.defaultExpectation == nil {
+		mmReturnOrderDelivery.defaultExpectation = &IPVZOrderUseCaseMockReturnOrderDeliveryExpectation{}
+	}
+
+	if mmReturnOrderDelivery.defaultExpectation.params != nil {
+		mmReturnOrderDelivery.mock.t.Fatalf("IPVZOrderUseCaseMock.ReturnOrderDelivery mock is already set by Expect")
+	}
+
+	if mmReturnOrderDelivery.defaultExpectation.paramPtrs == nil {
+		mmReturnOrderDelivery.defaultExpectation.paramPtrs = &IPVZOrderUseCaseMockReturnOrderDeliveryParamPtrs{}
+	}
+	mmReturnOrderDelivery.defaultExpectation.paramPtrs.options = &options
+	mmReturnOrderDelivery.defaultExpectation.expectationOrigins.originOptions = minimock.CallerInfo(1)
+
+	return mmReturnOrderDelivery
+}
+
 // Inspect accepts an inspector function that has same arguments as the IPVZOrderUseCase.ReturnOrderDelivery
-func (mmReturnOrderDelivery *mIPVZOrderUseCaseMockReturnOrderDelivery) Inspect(f func(ctx context.Context, orderID string)) *mIPVZOrderUseCaseMockReturnOrderDelivery {
+func (mmReturnOrderDelivery *mIPVZOrderUseCaseMockReturnOrderDelivery) Inspect(f func(ctx context.Context, orderID string, options ...mm_abstractions.MutationOptFunc)) *mIPVZOrderUseCaseMockReturnOrderDelivery {
 	if mmReturnOrderDelivery.mock.inspectFuncReturnOrderDelivery != nil {
 		mmReturnOrderDelivery.mock.t.Fatalf("Inspect function is already set for IPVZOrderUseCaseMock.ReturnOrderDelivery")
 	}
@@ -2209,7 +4280,7 @@ func (mmReturnOrderDelivery *mIPVZOrderUseCaseMockReturnOrderDelivery) Return(er
 }
 
 // Set uses given function f to mock the IPVZOrderUseCase.ReturnOrderDelivery method
-func (mmReturnOrderDelivery *mIPVZOrderUseCaseMockReturnOrderDelivery) Set(f func(ctx context.Context, orderID string) (err error)) *IPVZOrderUseCaseMock {
+func (mmReturnOrderDelivery *mIPVZOrderUseCaseMockReturnOrderDelivery) Set(f func(ctx context.Context, orderID string, options ...mm_abstractions.MutationOptFunc) (err error)) *IPVZOrderUseCaseMock {
 	if mmReturnOrderDelivery.defaultExpectation != nil {
 		mmReturnOrderDelivery.mock.t.Fatalf("Default expectation is already set for the IPVZOrderUseCase.ReturnOrderDelivery method")
 	}
@@ -2225,14 +4296,14 @@ func (mmReturnOrderDelivery *mIPVZOrderUseCaseMockReturnOrderDelivery) Set(f fun
 
 // When sets expectation for the IPVZOrderUseCase.ReturnOrderDelivery which will trigger the result defined by the following
 // Then helper
-func (mmReturnOrderDelivery *mIPVZOrderUseCaseMockReturnOrderDelivery) When(ctx context.Context, orderID string) *IPVZOrderUseCaseMockReturnOrderDeliveryExpectation {
+func (mmReturnOrderDelivery *mIPVZOrderUseCaseMockReturnOrderDelivery) When(ctx context.Context, orderID string, options ...mm_abstractions.MutationOptFunc) *IPVZOrderUseCaseMockReturnOrderDeliveryExpectation {
 	if mmReturnOrderDelivery.mock.funcReturnOrderDelivery != nil {
 		mmReturnOrderDelivery.mock.t.Fatalf("IPVZOrderUseCaseMock.ReturnOrderDelivery mock is already set by Set")
 	}
 
 	expectation := &IPVZOrderUseCaseMockReturnOrderDeliveryExpectation{
 		mock:               mmReturnOrderDelivery.mock,
-		params:             &IPVZOrderUseCaseMockReturnOrderDeliveryParams{ctx, orderID},
+		params:             &IPVZOrderUseCaseMockReturnOrderDeliveryParams{ctx, orderID, options},
 		expectationOrigins: IPVZOrderUseCaseMockReturnOrderDeliveryExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmReturnOrderDelivery.expectations = append(mmReturnOrderDelivery.expectations, expectation)
@@ -2267,17 +4338,17 @@ func (mmReturnOrderDelivery *mIPVZOrderUseCaseMockReturnOrderDelivery) invocatio
 }
 
 // ReturnOrderDelivery implements mm_abstractions.IPVZOrderUseCase
-func (mmReturnOrderDelivery *IPVZOrderUseCaseMock) ReturnOrderDelivery(ctx context.Context, orderID string) (err error) {
+func (mmReturnOrderDelivery *IPVZOrderUseCaseMock) ReturnOrderDelivery(ctx context.Context, orderID string, options ...mm_abstractions.MutationOptFunc) (err error) {
 	mm_atomic.AddUint64(&mmReturnOrderDelivery.beforeReturnOrderDeliveryCounter, 1)
 	defer mm_atomic.AddUint64(&mmReturnOrderDelivery.afterReturnOrderDeliveryCounter, 1)
 
 	mmReturnOrderDelivery.t.Helper()
 
 	if mmReturnOrderDelivery.inspectFuncReturnOrderDelivery != nil {
-		mmReturnOrderDelivery.inspectFuncReturnOrderDelivery(ctx, orderID)
+		mmReturnOrderDelivery.inspectFuncReturnOrderDelivery(ctx, orderID, options...)
 	}
 
-	mm_params := IPVZOrderUseCaseMockReturnOrderDeliveryParams{ctx, orderID}
+	mm_params := IPVZOrderUseCaseMockReturnOrderDeliveryParams{ctx, orderID, options}
 
 	// Record call args
 	mmReturnOrderDelivery.ReturnOrderDeliveryMock.mutex.Lock()
@@ -2296,7 +4367,7 @@ func (mmReturnOrderDelivery *IPVZOrderUseCaseMock) ReturnOrderDelivery(ctx conte
 		mm_want := mmReturnOrderDelivery.ReturnOrderDeliveryMock.defaultExpectation.params
 		mm_want_ptrs := mmReturnOrderDelivery.ReturnOrderDeliveryMock.defaultExpectation.paramPtrs
 
-		mm_got := IPVZOrderUseCaseMockReturnOrderDeliveryParams{ctx, orderID}
+		mm_got := IPVZOrderUseCaseMockReturnOrderDeliveryParams{ctx, orderID, options}
 
 		if mm_want_ptrs != nil {
 
@@ -2310,6 +4381,11 @@ func (mmReturnOrderDelivery *IPVZOrderUseCaseMock) ReturnOrderDelivery(ctx conte
 					mmReturnOrderDelivery.ReturnOrderDeliveryMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
 			}
 
+			if mm_want_ptrs.options != nil && !minimock.Equal(*mm_want_ptrs.options, mm_got.options) {
+				mmReturnOrderDelivery.t.Errorf("IPVZOrderUseCaseMock.ReturnOrderDelivery got unexpected parameter options, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReturnOrderDelivery.ReturnOrderDeliveryMock.defaultExpectation.expectationOrigins.originOptions, *mm_want_ptrs.options, mm_got.options, minimock.Diff(*mm_want_ptrs.options, mm_got.options))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmReturnOrderDelivery.t.Errorf("IPVZOrderUseCaseMock.ReturnOrderDelivery got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmReturnOrderDelivery.ReturnOrderDeliveryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -2322,9 +4398,9 @@ func (mmReturnOrderDelivery *IPVZOrderUseCaseMock) ReturnOrderDelivery(ctx conte
 		return (*mm_results).err
 	}
 	if mmReturnOrderDelivery.funcReturnOrderDelivery != nil {
-		return mmReturnOrderDelivery.funcReturnOrderDelivery(ctx, orderID)
+		return mmReturnOrderDelivery.funcReturnOrderDelivery(ctx, orderID, options...)
 	}
-	mmReturnOrderDelivery.t.Fatalf("Unexpected call to IPVZOrderUseCaseMock.ReturnOrderDelivery. %v %v", ctx, orderID)
+	mmReturnOrderDelivery.t.Fatalf("Unexpected call to IPVZOrderUseCaseMock.ReturnOrderDelivery. %v %v %v", ctx, orderID, options)
 	return
 }
 
@@ -2404,12 +4480,22 @@ func (m *IPVZOrderUseCaseMock) MinimockFinish() {
 
 			m.MinimockAcceptReturnInspect()
 
+			m.MinimockBatchAcceptOrderDeliveryInspect()
+
+			m.MinimockExtendStorageInspect()
+
+			m.MinimockGetOrderHistoryInspect()
+
 			m.MinimockGetOrdersInspect()
 
 			m.MinimockGetReturnsInspect()
 
+			m.MinimockGetWeightDiscrepancyReportInspect()
+
 			m.MinimockGiveOrderToClientInspect()
 
+			m.MinimockQuotePackagingInspect()
+
 			m.MinimockReturnOrderDeliveryInspect()
 		}
 	})
@@ -2436,8 +4522,13 @@ func (m *IPVZOrderUseCaseMock) minimockDone() bool {
 	return done &&
 		m.MinimockAcceptOrderDeliveryDone() &&
 		m.MinimockAcceptReturnDone() &&
+		m.MinimockBatchAcceptOrderDeliveryDone() &&
+		m.MinimockExtendStorageDone() &&
+		m.MinimockGetOrderHistoryDone() &&
 		m.MinimockGetOrdersDone() &&
 		m.MinimockGetReturnsDone() &&
+		m.MinimockGetWeightDiscrepancyReportDone() &&
 		m.MinimockGiveOrderToClientDone() &&
+		m.MinimockQuotePackagingDone() &&
 		m.MinimockReturnOrderDeliveryDone()
 }
@@ -0,0 +1,804 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	mm_abstractions "homework/internal/abstractions"
+	"homework/internal/domain"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// IPVZPolicyUseCaseMock implements mm_abstractions.IPVZPolicyUseCase
+type IPVZPolicyUseCaseMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetPVZPolicy          func(ctx context.Context, pvzID string) (p1 domain.PVZPolicy, err error)
+	funcGetPVZPolicyOrigin    string
+	inspectFuncGetPVZPolicy   func(ctx context.Context, pvzID string)
+	afterGetPVZPolicyCounter  uint64
+	beforeGetPVZPolicyCounter uint64
+	GetPVZPolicyMock          mIPVZPolicyUseCaseMockGetPVZPolicy
+
+	funcUpdatePVZPolicy          func(ctx context.Context, policy domain.PVZPolicy, options ...mm_abstractions.MutationOptFunc) (p1 domain.PVZPolicy, err error)
+	funcUpdatePVZPolicyOrigin    string
+	inspectFuncUpdatePVZPolicy   func(ctx context.Context, policy domain.PVZPolicy, options ...mm_abstractions.MutationOptFunc)
+	afterUpdatePVZPolicyCounter  uint64
+	beforeUpdatePVZPolicyCounter uint64
+	UpdatePVZPolicyMock          mIPVZPolicyUseCaseMockUpdatePVZPolicy
+}
+
+// NewIPVZPolicyUseCaseMock returns a mock for mm_abstractions.IPVZPolicyUseCase
+func NewIPVZPolicyUseCaseMock(t minimock.Tester) *IPVZPolicyUseCaseMock {
+	m := &IPVZPolicyUseCaseMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetPVZPolicyMock = mIPVZPolicyUseCaseMockGetPVZPolicy{mock: m}
+	m.GetPVZPolicyMock.callArgs = []*IPVZPolicyUseCaseMockGetPVZPolicyParams{}
+
+	m.UpdatePVZPolicyMock = mIPVZPolicyUseCaseMockUpdatePVZPolicy{mock: m}
+	m.UpdatePVZPolicyMock.callArgs = []*IPVZPolicyUseCaseMockUpdatePVZPolicyParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mIPVZPolicyUseCaseMockGetPVZPolicy struct {
+	optional           bool
+	mock               *IPVZPolicyUseCaseMock
+	defaultExpectation *IPVZPolicyUseCaseMockGetPVZPolicyExpectation
+	expectations       []*IPVZPolicyUseCaseMockGetPVZPolicyExpectation
+
+	callArgs []*IPVZPolicyUseCaseMockGetPVZPolicyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IPVZPolicyUseCaseMockGetPVZPolicyExpectation specifies expectation struct of the IPVZPolicyUseCase.GetPVZPolicy
+type IPVZPolicyUseCaseMockGetPVZPolicyExpectation struct {
+	mock               *IPVZPolicyUseCaseMock
+	params             *IPVZPolicyUseCaseMockGetPVZPolicyParams
+	paramPtrs          *IPVZPolicyUseCaseMockGetPVZPolicyParamPtrs
+	expectationOrigins IPVZPolicyUseCaseMockGetPVZPolicyExpectationOrigins
+	results            *IPVZPolicyUseCaseMockGetPVZPolicyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IPVZPolicyUseCaseMockGetPVZPolicyParams contains parameters of the IPVZPolicyUseCase.GetPVZPolicy
+type IPVZPolicyUseCaseMockGetPVZPolicyParams struct {
+	ctx   context.Context
+	pvzID string
+}
+
+// IPVZPolicyUseCaseMockGetPVZPolicyParamPtrs contains pointers to parameters of the IPVZPolicyUseCase.GetPVZPolicy
+type IPVZPolicyUseCaseMockGetPVZPolicyParamPtrs struct {
+	ctx   *context.Context
+	pvzID *string
+}
+
+// IPVZPolicyUseCaseMockGetPVZPolicyResults contains results of the IPVZPolicyUseCase.GetPVZPolicy
+type IPVZPolicyUseCaseMockGetPVZPolicyResults struct {
+	p1  domain.PVZPolicy
+	err error
+}
+
+// IPVZPolicyUseCaseMockGetPVZPolicyOrigins contains origins of expectations of the IPVZPolicyUseCase.GetPVZPolicy
+type IPVZPolicyUseCaseMockGetPVZPolicyExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originPvzID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPVZPolicy *mIPVZPolicyUseCaseMockGetPVZPolicy) Optional() *mIPVZPolicyUseCaseMockGetPVZPolicy {
+	mmGetPVZPolicy.optional = true
+	return mmGetPVZPolicy
+}
+
+// Expect sets up expected params for IPVZPolicyUseCase.GetPVZPolicy
+func (mmGetPVZPolicy *mIPVZPolicyUseCaseMockGetPVZPolicy) Expect(ctx context.Context, pvzID string) *mIPVZPolicyUseCaseMockGetPVZPolicy {
+	if mmGetPVZPolicy.mock.funcGetPVZPolicy != nil {
+		mmGetPVZPolicy.mock.t.Fatalf("IPVZPolicyUseCaseMock.GetPVZPolicy mock is already set by Set")
+	}
+
+	if mmGetPVZPolicy.defaultExpectation == nil {
+		mmGetPVZPolicy.defaultExpectation = &IPVZPolicyUseCaseMockGetPVZPolicyExpectation{}
+	}
+
+	if mmGetPVZPolicy.defaultExpectation.paramPtrs != nil {
+		mmGetPVZPolicy.mock.t.Fatalf("IPVZPolicyUseCaseMock.GetPVZPolicy mock is already set by ExpectParams functions")
+	}
+
+	mmGetPVZPolicy.defaultExpectation.params = &IPVZPolicyUseCaseMockGetPVZPolicyParams{ctx, pvzID}
+	mmGetPVZPolicy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPVZPolicy.expectations {
+		if minimock.Equal(e.params, mmGetPVZPolicy.defaultExpectation.params) {
+			mmGetPVZPolicy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPVZPolicy.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPVZPolicy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IPVZPolicyUseCase.GetPVZPolicy
+func (mmGetPVZPolicy *mIPVZPolicyUseCaseMockGetPVZPolicy) ExpectCtxParam1(ctx context.Context) *mIPVZPolicyUseCaseMockGetPVZPolicy {
+	if mmGetPVZPolicy.mock.funcGetPVZPolicy != nil {
+		mmGetPVZPolicy.mock.t.Fatalf("IPVZPolicyUseCaseMock.GetPVZPolicy mock is already set by Set")
+	}
+
+	if mmGetPVZPolicy.defaultExpectation == nil {
+		mmGetPVZPolicy.defaultExpectation = &IPVZPolicyUseCaseMockGetPVZPolicyExpectation{}
+	}
+
+	if mmGetPVZPolicy.defaultExpectation.params != nil {
+		mmGetPVZPolicy.mock.t.Fatalf("IPVZPolicyUseCaseMock.GetPVZPolicy mock is already set by Expect")
+	}
+
+	if mmGetPVZPolicy.defaultExpectation.paramPtrs == nil {
+		mmGetPVZPolicy.defaultExpectation.paramPtrs = &IPVZPolicyUseCaseMockGetPVZPolicyParamPtrs{}
+	}
+	mmGetPVZPolicy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPVZPolicy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPVZPolicy
+}
+
+// ExpectPvzIDParam2 sets up expected param pvzID for IPVZPolicyUseCase.GetPVZPolicy
+func (mmGetPVZPolicy *mIPVZPolicyUseCaseMockGetPVZPolicy) ExpectPvzIDParam2(pvzID string) *mIPVZPolicyUseCaseMockGetPVZPolicy {
+	if mmGetPVZPolicy.mock.funcGetPVZPolicy != nil {
+		mmGetPVZPolicy.mock.t.Fatalf("IPVZPolicyUseCaseMock.GetPVZPolicy mock is already set by Set")
+	}
+
+	if mmGetPVZPolicy.defaultExpectation == nil {
+		mmGetPVZPolicy.defaultExpectation = &IPVZPolicyUseCaseMockGetPVZPolicyExpectation{}
+	}
+
+	if mmGetPVZPolicy.defaultExpectation.params != nil {
+		mmGetPVZPolicy.mock.t.Fatalf("IPVZPolicyUseCaseMock.GetPVZPolicy mock is already set by Expect")
+	}
+
+	if mmGetPVZPolicy.defaultExpectation.paramPtrs == nil {
+		mmGetPVZPolicy.defaultExpectation.paramPtrs = &IPVZPolicyUseCaseMockGetPVZPolicyParamPtrs{}
+	}
+	mmGetPVZPolicy.defaultExpectation.paramPtrs.pvzID = &pvzID
+	mmGetPVZPolicy.defaultExpectation.expectationOrigins.originPvzID = minimock.CallerInfo(1)
+
+	return mmGetPVZPolicy
+}
+
+// Inspect accepts an inspector function that has same arguments as the IPVZPolicyUseCase.GetPVZPolicy
+func (mmGetPVZPolicy *mIPVZPolicyUseCaseMockGetPVZPolicy) Inspect(f func(ctx context.Context, pvzID string)) *mIPVZPolicyUseCaseMockGetPVZPolicy {
+	if mmGetPVZPolicy.mock.inspectFuncGetPVZPolicy != nil {
+		mmGetPVZPolicy.mock.t.Fatalf("Inspect function is already set for IPVZPolicyUseCaseMock.GetPVZPolicy")
+	}
+
+	mmGetPVZPolicy.mock.inspectFuncGetPVZPolicy = f
+
+	return mmGetPVZPolicy
+}
+
+// Return sets up results that will be returned by IPVZPolicyUseCase.GetPVZPolicy
+func (mmGetPVZPolicy *mIPVZPolicyUseCaseMockGetPVZPolicy) Return(p1 domain.PVZPolicy, err error) *IPVZPolicyUseCaseMock {
+	if mmGetPVZPolicy.mock.funcGetPVZPolicy != nil {
+		mmGetPVZPolicy.mock.t.Fatalf("IPVZPolicyUseCaseMock.GetPVZPolicy mock is already set by Set")
+	}
+
+	if mmGetPVZPolicy.defaultExpectation == nil {
+		mmGetPVZPolicy.defaultExpectation = &IPVZPolicyUseCaseMockGetPVZPolicyExpectation{mock: mmGetPVZPolicy.mock}
+	}
+	mmGetPVZPolicy.defaultExpectation.results = &IPVZPolicyUseCaseMockGetPVZPolicyResults{p1, err}
+	mmGetPVZPolicy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPVZPolicy.mock
+}
+
+// Set uses given function f to mock the IPVZPolicyUseCase.GetPVZPolicy method
+func (mmGetPVZPolicy *mIPVZPolicyUseCaseMockGetPVZPolicy) Set(f func(ctx context.Context, pvzID string) (p1 domain.PVZPolicy, err error)) *IPVZPolicyUseCaseMock {
+	if mmGetPVZPolicy.defaultExpectation != nil {
+		mmGetPVZPolicy.mock.t.Fatalf("Default expectation is already set for the IPVZPolicyUseCase.GetPVZPolicy method")
+	}
+
+	if len(mmGetPVZPolicy.expectations) > 0 {
+		mmGetPVZPolicy.mock.t.Fatalf("Some expectations are already set for the IPVZPolicyUseCase.GetPVZPolicy method")
+	}
+
+	mmGetPVZPolicy.mock.funcGetPVZPolicy = f
+	mmGetPVZPolicy.mock.funcGetPVZPolicyOrigin = minimock.CallerInfo(1)
+	return mmGetPVZPolicy.mock
+}
+
+// When sets expectation for the IPVZPolicyUseCase.GetPVZPolicy which will trigger the result defined by the following
+// Then helper
+func (mmGetPVZPolicy *mIPVZPolicyUseCaseMockGetPVZPolicy) When(ctx context.Context, pvzID string) *IPVZPolicyUseCaseMockGetPVZPolicyExpectation {
+	if mmGetPVZPolicy.mock.funcGetPVZPolicy != nil {
+		mmGetPVZPolicy.mock.t.Fatalf("IPVZPolicyUseCaseMock.GetPVZPolicy mock is already set by Set")
+	}
+
+	expectation := &IPVZPolicyUseCaseMockGetPVZPolicyExpectation{
+		mock:               mmGetPVZPolicy.mock,
+		params:             &IPVZPolicyUseCaseMockGetPVZPolicyParams{ctx, pvzID},
+		expectationOrigins: IPVZPolicyUseCaseMockGetPVZPolicyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPVZPolicy.expectations = append(mmGetPVZPolicy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IPVZPolicyUseCase.GetPVZPolicy return parameters for the expectation previously defined by the When method
+func (e *IPVZPolicyUseCaseMockGetPVZPolicyExpectation) Then(p1 domain.PVZPolicy, err error) *IPVZPolicyUseCaseMock {
+	e.results = &IPVZPolicyUseCaseMockGetPVZPolicyResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times IPVZPolicyUseCase.GetPVZPolicy should be invoked
+func (mmGetPVZPolicy *mIPVZPolicyUseCaseMockGetPVZPolicy) Times(n uint64) *mIPVZPolicyUseCaseMockGetPVZPolicy {
+	if n == 0 {
+		mmGetPVZPolicy.mock.t.Fatalf("Times of IPVZPolicyUseCaseMock.GetPVZPolicy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPVZPolicy.expectedInvocations, n)
+	mmGetPVZPolicy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPVZPolicy
+}
+
+func (mmGetPVZPolicy *mIPVZPolicyUseCaseMockGetPVZPolicy) invocationsDone() bool {
+	if len(mmGetPVZPolicy.expectations) == 0 && mmGetPVZPolicy.defaultExpectation == nil && mmGetPVZPolicy.mock.funcGetPVZPolicy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPVZPolicy.mock.afterGetPVZPolicyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPVZPolicy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPVZPolicy implements mm_abstractions.IPVZPolicyUseCase
+func (mmGetPVZPolicy *IPVZPolicyUseCaseMock) GetPVZPolicy(ctx context.Context, pvzID string) (p1 domain.PVZPolicy, err error) {
+	mm_atomic.AddUint64(&mmGetPVZPolicy.beforeGetPVZPolicyCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPVZPolicy.afterGetPVZPolicyCounter, 1)
+
+	mmGetPVZPolicy.t.Helper()
+
+	if mmGetPVZPolicy.inspectFuncGetPVZPolicy != nil {
+		mmGetPVZPolicy.inspectFuncGetPVZPolicy(ctx, pvzID)
+	}
+
+	mm_params := IPVZPolicyUseCaseMockGetPVZPolicyParams{ctx, pvzID}
+
+	// Record call args
+	mmGetPVZPolicy.GetPVZPolicyMock.mutex.Lock()
+	mmGetPVZPolicy.GetPVZPolicyMock.callArgs = append(mmGetPVZPolicy.GetPVZPolicyMock.callArgs, &mm_params)
+	mmGetPVZPolicy.GetPVZPolicyMock.mutex.Unlock()
+
+	for _, e := range mmGetPVZPolicy.GetPVZPolicyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmGetPVZPolicy.GetPVZPolicyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPVZPolicy.GetPVZPolicyMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPVZPolicy.GetPVZPolicyMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPVZPolicy.GetPVZPolicyMock.defaultExpectation.paramPtrs
+
+		mm_got := IPVZPolicyUseCaseMockGetPVZPolicyParams{ctx, pvzID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPVZPolicy.t.Errorf("IPVZPolicyUseCaseMock.GetPVZPolicy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPVZPolicy.GetPVZPolicyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pvzID != nil && !minimock.Equal(*mm_want_ptrs.pvzID, mm_got.pvzID) {
+				mmGetPVZPolicy.t.Errorf("IPVZPolicyUseCaseMock.GetPVZPolicy got unexpected parameter pvzID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPVZPolicy.GetPVZPolicyMock.defaultExpectation.expectationOrigins.originPvzID, *mm_want_ptrs.pvzID, mm_got.pvzID, minimock.Diff(*mm_want_ptrs.pvzID, mm_got.pvzID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPVZPolicy.t.Errorf("IPVZPolicyUseCaseMock.GetPVZPolicy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPVZPolicy.GetPVZPolicyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPVZPolicy.GetPVZPolicyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPVZPolicy.t.Fatal("No results are set for the IPVZPolicyUseCaseMock.GetPVZPolicy")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmGetPVZPolicy.funcGetPVZPolicy != nil {
+		return mmGetPVZPolicy.funcGetPVZPolicy(ctx, pvzID)
+	}
+	mmGetPVZPolicy.t.Fatalf("Unexpected call to IPVZPolicyUseCaseMock.GetPVZPolicy. %v %v", ctx, pvzID)
+	return
+}
+
+// GetPVZPolicyAfterCounter returns a count of finished IPVZPolicyUseCaseMock.GetPVZPolicy invocations
+func (mmGetPVZPolicy *IPVZPolicyUseCaseMock) GetPVZPolicyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPVZPolicy.afterGetPVZPolicyCounter)
+}
+
+// GetPVZPolicyBeforeCounter returns a count of IPVZPolicyUseCaseMock.GetPVZPolicy invocations
+func (mmGetPVZPolicy *IPVZPolicyUseCaseMock) GetPVZPolicyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPVZPolicy.beforeGetPVZPolicyCounter)
+}
+
+// Calls returns a list of arguments used in each call to IPVZPolicyUseCaseMock.GetPVZPolicy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPVZPolicy *mIPVZPolicyUseCaseMockGetPVZPolicy) Calls() []*IPVZPolicyUseCaseMockGetPVZPolicyParams {
+	mmGetPVZPolicy.mutex.RLock()
+
+	argCopy := make([]*IPVZPolicyUseCaseMockGetPVZPolicyParams, len(mmGetPVZPolicy.callArgs))
+	copy(argCopy, mmGetPVZPolicy.callArgs)
+
+	mmGetPVZPolicy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPVZPolicyDone returns true if the count of the GetPVZPolicy invocations corresponds
+// the number of defined expectations
+func (m *IPVZPolicyUseCaseMock) MinimockGetPVZPolicyDone() bool {
+	if m.GetPVZPolicyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPVZPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPVZPolicyMock.invocationsDone()
+}
+
+// MinimockGetPVZPolicyInspect logs each unmet expectation
+func (m *IPVZPolicyUseCaseMock) MinimockGetPVZPolicyInspect() {
+	for _, e := range m.GetPVZPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IPVZPolicyUseCaseMock.GetPVZPolicy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPVZPolicyCounter := mm_atomic.LoadUint64(&m.afterGetPVZPolicyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPVZPolicyMock.defaultExpectation != nil && afterGetPVZPolicyCounter < 1 {
+		if m.GetPVZPolicyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IPVZPolicyUseCaseMock.GetPVZPolicy at\n%s", m.GetPVZPolicyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IPVZPolicyUseCaseMock.GetPVZPolicy at\n%s with params: %#v", m.GetPVZPolicyMock.defaultExpectation.expectationOrigins.origin, *m.GetPVZPolicyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPVZPolicy != nil && afterGetPVZPolicyCounter < 1 {
+		m.t.Errorf("Expected call to IPVZPolicyUseCaseMock.GetPVZPolicy at\n%s", m.funcGetPVZPolicyOrigin)
+	}
+
+	if !m.GetPVZPolicyMock.invocationsDone() && afterGetPVZPolicyCounter > 0 {
+		m.t.Errorf("Expected %d calls to IPVZPolicyUseCaseMock.GetPVZPolicy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPVZPolicyMock.expectedInvocations), m.GetPVZPolicyMock.expectedInvocationsOrigin, afterGetPVZPolicyCounter)
+	}
+}
+
+type mIPVZPolicyUseCaseMockUpdatePVZPolicy struct {
+	optional           bool
+	mock               *IPVZPolicyUseCaseMock
+	defaultExpectation *IPVZPolicyUseCaseMockUpdatePVZPolicyExpectation
+	expectations       []*IPVZPolicyUseCaseMockUpdatePVZPolicyExpectation
+
+	callArgs []*IPVZPolicyUseCaseMockUpdatePVZPolicyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IPVZPolicyUseCaseMockUpdatePVZPolicyExpectation specifies expectation struct of the IPVZPolicyUseCase.UpdatePVZPolicy
+type IPVZPolicyUseCaseMockUpdatePVZPolicyExpectation struct {
+	mock               *IPVZPolicyUseCaseMock
+	params             *IPVZPolicyUseCaseMockUpdatePVZPolicyParams
+	paramPtrs          *IPVZPolicyUseCaseMockUpdatePVZPolicyParamPtrs
+	expectationOrigins IPVZPolicyUseCaseMockUpdatePVZPolicyExpectationOrigins
+	results            *IPVZPolicyUseCaseMockUpdatePVZPolicyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IPVZPolicyUseCaseMockUpdatePVZPolicyParams contains parameters of the IPVZPolicyUseCase.UpdatePVZPolicy
+type IPVZPolicyUseCaseMockUpdatePVZPolicyParams struct {
+	ctx     context.Context
+	policy  domain.PVZPolicy
+	options []mm_abstractions.MutationOptFunc
+}
+
+// IPVZPolicyUseCaseMockUpdatePVZPolicyParamPtrs contains pointers to parameters of the IPVZPolicyUseCase.UpdatePVZPolicy
+type IPVZPolicyUseCaseMockUpdatePVZPolicyParamPtrs struct {
+	ctx     *context.Context
+	policy  *domain.PVZPolicy
+	options *[]mm_abstractions.MutationOptFunc
+}
+
+// IPVZPolicyUseCaseMockUpdatePVZPolicyResults contains results of the IPVZPolicyUseCase.UpdatePVZPolicy
+type IPVZPolicyUseCaseMockUpdatePVZPolicyResults struct {
+	p1  domain.PVZPolicy
+	err error
+}
+
+// IPVZPolicyUseCaseMockUpdatePVZPolicyOrigins contains origins of expectations of the IPVZPolicyUseCase.UpdatePVZPolicy
+type IPVZPolicyUseCaseMockUpdatePVZPolicyExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originPolicy  string
+	originOptions string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUpdatePVZPolicy *mIPVZPolicyUseCaseMockUpdatePVZPolicy) Optional() *mIPVZPolicyUseCaseMockUpdatePVZPolicy {
+	mmUpdatePVZPolicy.optional = true
+	return mmUpdatePVZPolicy
+}
+
+// Expect sets up expected params for IPVZPolicyUseCase.UpdatePVZPolicy
+func (mmUpdatePVZPolicy *mIPVZPolicyUseCaseMockUpdatePVZPolicy) Expect(ctx context.Context, policy domain.PVZPolicy, options ...mm_abstractions.MutationOptFunc) *mIPVZPolicyUseCaseMockUpdatePVZPolicy {
+	if mmUpdatePVZPolicy.mock.funcUpdatePVZPolicy != nil {
+		mmUpdatePVZPolicy.mock.t.Fatalf("IPVZPolicyUseCaseMock.UpdatePVZPolicy mock is already set by Set")
+	}
+
+	if mmUpdatePVZPolicy.defaultExpectation == nil {
+		mmUpdatePVZPolicy.defaultExpectation = &IPVZPolicyUseCaseMockUpdatePVZPolicyExpectation{}
+	}
+
+	if mmUpdatePVZPolicy.defaultExpectation.paramPtrs != nil {
+		mmUpdatePVZPolicy.mock.t.Fatalf("IPVZPolicyUseCaseMock.UpdatePVZPolicy mock is already set by ExpectParams functions")
+	}
+
+	mmUpdatePVZPolicy.defaultExpectation.params = &IPVZPolicyUseCaseMockUpdatePVZPolicyParams{ctx, policy, options}
+	mmUpdatePVZPolicy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUpdatePVZPolicy.expectations {
+		if minimock.Equal(e.params, mmUpdatePVZPolicy.defaultExpectation.params) {
+			mmUpdatePVZPolicy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUpdatePVZPolicy.defaultExpectation.params)
+		}
+	}
+
+	return mmUpdatePVZPolicy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IPVZPolicyUseCase.UpdatePVZPolicy
+func (mmUpdatePVZPolicy *mIPVZPolicyUseCaseMockUpdatePVZPolicy) ExpectCtxParam1(ctx context.Context) *mIPVZPolicyUseCaseMockUpdatePVZPolicy {
+	if mmUpdatePVZPolicy.mock.funcUpdatePVZPolicy != nil {
+		mmUpdatePVZPolicy.mock.t.Fatalf("IPVZPolicyUseCaseMock.UpdatePVZPolicy mock is already set by Set")
+	}
+
+	if mmUpdatePVZPolicy.defaultExpectation == nil {
+		mmUpdatePVZPolicy.defaultExpectation = &IPVZPolicyUseCaseMockUpdatePVZPolicyExpectation{}
+	}
+
+	if mmUpdatePVZPolicy.defaultExpectation.params != nil {
+		mmUpdatePVZPolicy.mock.t.Fatalf("IPVZPolicyUseCaseMock.UpdatePVZPolicy mock is already set by Expect")
+	}
+
+	if mmUpdatePVZPolicy.defaultExpectation.paramPtrs == nil {
+		mmUpdatePVZPolicy.defaultExpectation.paramPtrs = &IPVZPolicyUseCaseMockUpdatePVZPolicyParamPtrs{}
+	}
+	mmUpdatePVZPolicy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUpdatePVZPolicy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUpdatePVZPolicy
+}
+
+// ExpectPolicyParam2 sets up expected param policy for IPVZPolicyUseCase.UpdatePVZPolicy
+func (mmUpdatePVZPolicy *mIPVZPolicyUseCaseMockUpdatePVZPolicy) ExpectPolicyParam2(policy domain.PVZPolicy) *mIPVZPolicyUseCaseMockUpdatePVZPolicy {
+	if mmUpdatePVZPolicy.mock.funcUpdatePVZPolicy != nil {
+		mmUpdatePVZPolicy.mock.t.Fatalf("IPVZPolicyUseCaseMock.UpdatePVZPolicy mock is already set by Set")
+	}
+
+	if mmUpdatePVZPolicy.defaultExpectation == nil {
+		mmUpdatePVZPolicy.defaultExpectation = &IPVZPolicyUseCaseMockUpdatePVZPolicyExpectation{}
+	}
+
+	if mmUpdatePVZPolicy.defaultExpectation.params != nil {
+		mmUpdatePVZPolicy.mock.t.Fatalf("IPVZPolicyUseCaseMock.UpdatePVZPolicy mock is already set by Expect")
+	}
+
+	if mmUpdatePVZPolicy.defaultExpectation.paramPtrs == nil {
+		mmUpdatePVZPolicy.defaultExpectation.paramPtrs = &IPVZPolicyUseCaseMockUpdatePVZPolicyParamPtrs{}
+	}
+	mmUpdatePVZPolicy.defaultExpectation.paramPtrs.policy = &policy
+	mmUpdatePVZPolicy.defaultExpectation.expectationOrigins.originPolicy = minimock.CallerInfo(1)
+
+	return mmUpdatePVZPolicy
+}
+
+// ExpectOptionsParam3 sets up expected param options for IPVZPolicyUseCase.UpdatePVZPolicy
+func (mmUpdatePVZPolicy *mIPVZPolicyUseCaseMockUpdatePVZPolicy) ExpectOptionsParam3(options ...mm_abstractions.MutationOptFunc) *mIPVZPolicyUseCaseMockUpdatePVZPolicy {
+	if mmUpdatePVZPolicy.mock.funcUpdatePVZPolicy != nil {
+		mmUpdatePVZPolicy.mock.t.Fatalf("IPVZPolicyUseCaseMock.UpdatePVZPolicy mock is already set by Set")
+	}
+
+	if mmUpdatePVZPolicy.defaultExpectation == nil {
+		mmUpdatePVZPolicy.defaultExpectation = &IPVZPolicyUseCaseMockUpdatePVZPolicyExpectation{}
+	}
+
+	if mmUpdatePVZPolicy.defaultExpectation.params != nil {
+		mmUpdatePVZPolicy.mock.t.Fatalf("IPVZPolicyUseCaseMock.UpdatePVZPolicy mock is already set by Expect")
+	}
+
+	if mmUpdatePVZPolicy.defaultExpectation.paramPtrs == nil {
+		mmUpdatePVZPolicy.defaultExpectation.paramPtrs = &IPVZPolicyUseCaseMockUpdatePVZPolicyParamPtrs{}
+	}
+	mmUpdatePVZPolicy.defaultExpectation.paramPtrs.options = &options
+	mmUpdatePVZPolicy.defaultExpectation.expectationOrigins.originOptions = minimock.CallerInfo(1)
+
+	return mmUpdatePVZPolicy
+}
+
+// Inspect accepts an inspector function that has same arguments as the IPVZPolicyUseCase.UpdatePVZPolicy
+func (mmUpdatePVZPolicy *mIPVZPolicyUseCaseMockUpdatePVZPolicy) Inspect(f func(ctx context.Context, policy domain.PVZPolicy, options ...mm_abstractions.MutationOptFunc)) *mIPVZPolicyUseCaseMockUpdatePVZPolicy {
+	if mmUpdatePVZPolicy.mock.inspectFuncUpdatePVZPolicy != nil {
+		mmUpdatePVZPolicy.mock.t.Fatalf("Inspect function is already set for IPVZPolicyUseCaseMock.UpdatePVZPolicy")
+	}
+
+	mmUpdatePVZPolicy.mock.inspectFuncUpdatePVZPolicy = f
+
+	return mmUpdatePVZPolicy
+}
+
+// Return sets up results that will be returned by IPVZPolicyUseCase.UpdatePVZPolicy
+func (mmUpdatePVZPolicy *mIPVZPolicyUseCaseMockUpdatePVZPolicy) Return(p1 domain.PVZPolicy, err error) *IPVZPolicyUseCaseMock {
+	if mmUpdatePVZPolicy.mock.funcUpdatePVZPolicy != nil {
+		mmUpdatePVZPolicy.mock.t.Fatalf("IPVZPolicyUseCaseMock.UpdatePVZPolicy mock is already set by Set")
+	}
+
+	if mmUpdatePVZPolicy.defaultExpectation == nil {
+		mmUpdatePVZPolicy.defaultExpectation = &IPVZPolicyUseCaseMockUpdatePVZPolicyExpectation{mock: mmUpdatePVZPolicy.mock}
+	}
+	mmUpdatePVZPolicy.defaultExpectation.results = &IPVZPolicyUseCaseMockUpdatePVZPolicyResults{p1, err}
+	mmUpdatePVZPolicy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUpdatePVZPolicy.mock
+}
+
+// Set uses given function f to mock the IPVZPolicyUseCase.UpdatePVZPolicy method
+func (mmUpdatePVZPolicy *mIPVZPolicyUseCaseMockUpdatePVZPolicy) Set(f func(ctx context.Context, policy domain.PVZPolicy, options ...mm_abstractions.MutationOptFunc) (p1 domain.PVZPolicy, err error)) *IPVZPolicyUseCaseMock {
+	if mmUpdatePVZPolicy.defaultExpectation != nil {
+		mmUpdatePVZPolicy.mock.t.Fatalf("Default expectation is already set for the IPVZPolicyUseCase.UpdatePVZPolicy method")
+	}
+
+	if len(mmUpdatePVZPolicy.expectations) > 0 {
+		mmUpdatePVZPolicy.mock.t.Fatalf("Some expectations are already set for the IPVZPolicyUseCase.UpdatePVZPolicy method")
+	}
+
+	mmUpdatePVZPolicy.mock.funcUpdatePVZPolicy = f
+	mmUpdatePVZPolicy.mock.funcUpdatePVZPolicyOrigin = minimock.CallerInfo(1)
+	return mmUpdatePVZPolicy.mock
+}
+
+// When sets expectation for the IPVZPolicyUseCase.UpdatePVZPolicy which will trigger the result defined by the following
+// Then helper
+func (mmUpdatePVZPolicy *mIPVZPolicyUseCaseMockUpdatePVZPolicy) When(ctx context.Context, policy domain.PVZPolicy, options ...mm_abstractions.MutationOptFunc) *IPVZPolicyUseCaseMockUpdatePVZPolicyExpectation {
+	if mmUpdatePVZPolicy.mock.funcUpdatePVZPolicy != nil {
+		mmUpdatePVZPolicy.mock.t.Fatalf("IPVZPolicyUseCaseMock.UpdatePVZPolicy mock is already set by Set")
+	}
+
+	expectation := &IPVZPolicyUseCaseMockUpdatePVZPolicyExpectation{
+		mock:               mmUpdatePVZPolicy.mock,
+		params:             &IPVZPolicyUseCaseMockUpdatePVZPolicyParams{ctx, policy, options},
+		expectationOrigins: IPVZPolicyUseCaseMockUpdatePVZPolicyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUpdatePVZPolicy.expectations = append(mmUpdatePVZPolicy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IPVZPolicyUseCase.UpdatePVZPolicy return parameters for the expectation previously defined by the When method
+func (e *IPVZPolicyUseCaseMockUpdatePVZPolicyExpectation) Then(p1 domain.PVZPolicy, err error) *IPVZPolicyUseCaseMock {
+	e.results = &IPVZPolicyUseCaseMockUpdatePVZPolicyResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times IPVZPolicyUseCase.UpdatePVZPolicy should be invoked
+func (mmUpdatePVZPolicy *mIPVZPolicyUseCaseMockUpdatePVZPolicy) Times(n uint64) *mIPVZPolicyUseCaseMockUpdatePVZPolicy {
+	if n == 0 {
+		mmUpdatePVZPolicy.mock.t.Fatalf("Times of IPVZPolicyUseCaseMock.UpdatePVZPolicy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUpdatePVZPolicy.expectedInvocations, n)
+	mmUpdatePVZPolicy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUpdatePVZPolicy
+}
+
+func (mmUpdatePVZPolicy *mIPVZPolicyUseCaseMockUpdatePVZPolicy) invocationsDone() bool {
+	if len(mmUpdatePVZPolicy.expectations) == 0 && mmUpdatePVZPolicy.defaultExpectation == nil && mmUpdatePVZPolicy.mock.funcUpdatePVZPolicy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUpdatePVZPolicy.mock.afterUpdatePVZPolicyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUpdatePVZPolicy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UpdatePVZPolicy implements mm_abstractions.IPVZPolicyUseCase
+func (mmUpdatePVZPolicy *IPVZPolicyUseCaseMock) UpdatePVZPolicy(ctx context.Context, policy domain.PVZPolicy, options ...mm_abstractions.MutationOptFunc) (p1 domain.PVZPolicy, err error) {
+	mm_atomic.AddUint64(&mmUpdatePVZPolicy.beforeUpdatePVZPolicyCounter, 1)
+	defer mm_atomic.AddUint64(&mmUpdatePVZPolicy.afterUpdatePVZPolicyCounter, 1)
+
+	mmUpdatePVZPolicy.t.Helper()
+
+	if mmUpdatePVZPolicy.inspectFuncUpdatePVZPolicy != nil {
+		mmUpdatePVZPolicy.inspectFuncUpdatePVZPolicy(ctx, policy, options...)
+	}
+
+	mm_params := IPVZPolicyUseCaseMockUpdatePVZPolicyParams{ctx, policy, options}
+
+	// Record call args
+	mmUpdatePVZPolicy.UpdatePVZPolicyMock.mutex.Lock()
+	mmUpdatePVZPolicy.UpdatePVZPolicyMock.callArgs = append(mmUpdatePVZPolicy.UpdatePVZPolicyMock.callArgs, &mm_params)
+	mmUpdatePVZPolicy.UpdatePVZPolicyMock.mutex.Unlock()
+
+	for _, e := range mmUpdatePVZPolicy.UpdatePVZPolicyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmUpdatePVZPolicy.UpdatePVZPolicyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUpdatePVZPolicy.UpdatePVZPolicyMock.defaultExpectation.Counter, 1)
+		mm_want := mmUpdatePVZPolicy.UpdatePVZPolicyMock.defaultExpectation.params
+		mm_want_ptrs := mmUpdatePVZPolicy.UpdatePVZPolicyMock.defaultExpectation.paramPtrs
+
+		mm_got := IPVZPolicyUseCaseMockUpdatePVZPolicyParams{ctx, policy, options}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUpdatePVZPolicy.t.Errorf("IPVZPolicyUseCaseMock.UpdatePVZPolicy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePVZPolicy.UpdatePVZPolicyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.policy != nil && !minimock.Equal(*mm_want_ptrs.policy, mm_got.policy) {
+				mmUpdatePVZPolicy.t.Errorf("IPVZPolicyUseCaseMock.UpdatePVZPolicy got unexpected parameter policy, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePVZPolicy.UpdatePVZPolicyMock.defaultExpectation.expectationOrigins.originPolicy, *mm_want_ptrs.policy, mm_got.policy, minimock.Diff(*mm_want_ptrs.policy, mm_got.policy))
+			}
+
+			if mm_want_ptrs.options != nil && !minimock.Equal(*mm_want_ptrs.options, mm_got.options) {
+				mmUpdatePVZPolicy.t.Errorf("IPVZPolicyUseCaseMock.UpdatePVZPolicy got unexpected parameter options, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUpdatePVZPolicy.UpdatePVZPolicyMock.defaultExpectation.expectationOrigins.originOptions, *mm_want_ptrs.options, mm_got.options, minimock.Diff(*mm_want_ptrs.options, mm_got.options))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUpdatePVZPolicy.t.Errorf("IPVZPolicyUseCaseMock.UpdatePVZPolicy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUpdatePVZPolicy.UpdatePVZPolicyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUpdatePVZPolicy.UpdatePVZPolicyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUpdatePVZPolicy.t.Fatal("No results are set for the IPVZPolicyUseCaseMock.UpdatePVZPolicy")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmUpdatePVZPolicy.funcUpdatePVZPolicy != nil {
+		return mmUpdatePVZPolicy.funcUpdatePVZPolicy(ctx, policy, options...)
+	}
+	mmUpdatePVZPolicy.t.Fatalf("Unexpected call to IPVZPolicyUseCaseMock.UpdatePVZPolicy. %v %v %v", ctx, policy, options)
+	return
+}
+
+// UpdatePVZPolicyAfterCounter returns a count of finished IPVZPolicyUseCaseMock.UpdatePVZPolicy invocations
+func (mmUpdatePVZPolicy *IPVZPolicyUseCaseMock) UpdatePVZPolicyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePVZPolicy.afterUpdatePVZPolicyCounter)
+}
+
+// UpdatePVZPolicyBeforeCounter returns a count of IPVZPolicyUseCaseMock.UpdatePVZPolicy invocations
+func (mmUpdatePVZPolicy *IPVZPolicyUseCaseMock) UpdatePVZPolicyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUpdatePVZPolicy.beforeUpdatePVZPolicyCounter)
+}
+
+// Calls returns a list of arguments used in each call to IPVZPolicyUseCaseMock.UpdatePVZPolicy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUpdatePVZPolicy *mIPVZPolicyUseCaseMockUpdatePVZPolicy) Calls() []*IPVZPolicyUseCaseMockUpdatePVZPolicyParams {
+	mmUpdatePVZPolicy.mutex.RLock()
+
+	argCopy := make([]*IPVZPolicyUseCaseMockUpdatePVZPolicyParams, len(mmUpdatePVZPolicy.callArgs))
+	copy(argCopy, mmUpdatePVZPolicy.callArgs)
+
+	mmUpdatePVZPolicy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUpdatePVZPolicyDone returns true if the count of the UpdatePVZPolicy invocations corresponds
+// the number of defined expectations
+func (m *IPVZPolicyUseCaseMock) MinimockUpdatePVZPolicyDone() bool {
+	if m.UpdatePVZPolicyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UpdatePVZPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UpdatePVZPolicyMock.invocationsDone()
+}
+
+// MinimockUpdatePVZPolicyInspect logs each unmet expectation
+func (m *IPVZPolicyUseCaseMock) MinimockUpdatePVZPolicyInspect() {
+	for _, e := range m.UpdatePVZPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IPVZPolicyUseCaseMock.UpdatePVZPolicy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUpdatePVZPolicyCounter := mm_atomic.LoadUint64(&m.afterUpdatePVZPolicyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UpdatePVZPolicyMock.defaultExpectation != nil && afterUpdatePVZPolicyCounter < 1 {
+		if m.UpdatePVZPolicyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IPVZPolicyUseCaseMock.UpdatePVZPolicy at\n%s", m.UpdatePVZPolicyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IPVZPolicyUseCaseMock.UpdatePVZPolicy at\n%s with params: %#v", m.UpdatePVZPolicyMock.defaultExpectation.expectationOrigins.origin, *m.UpdatePVZPolicyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUpdatePVZPolicy != nil && afterUpdatePVZPolicyCounter < 1 {
+		m.t.Errorf("Expected call to IPVZPolicyUseCaseMock.UpdatePVZPolicy at\n%s", m.funcUpdatePVZPolicyOrigin)
+	}
+
+	if !m.UpdatePVZPolicyMock.invocationsDone() && afterUpdatePVZPolicyCounter > 0 {
+		m.t.Errorf("Expected %d calls to IPVZPolicyUseCaseMock.UpdatePVZPolicy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UpdatePVZPolicyMock.expectedInvocations), m.UpdatePVZPolicyMock.expectedInvocationsOrigin, afterUpdatePVZPolicyCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *IPVZPolicyUseCaseMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetPVZPolicyInspect()
+
+			m.MinimockUpdatePVZPolicyInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *IPVZPolicyUseCaseMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *IPVZPolicyUseCaseMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetPVZPolicyDone() &&
+		m.MinimockUpdatePVZPolicyDone()
+}
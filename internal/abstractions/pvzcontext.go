@@ -0,0 +1,20 @@
+package abstractions
+
+import "context"
+
+type pvzIDKey struct{}
+
+// ContextWithPVZID returns a copy of ctx which carries the PVZ ID the request is served for
+func ContextWithPVZID(ctx context.Context, pvzID string) context.Context {
+	return context.WithValue(ctx, pvzIDKey{}, pvzID)
+}
+
+// PVZIDFromContext returns the PVZ ID the request is served for
+func PVZIDFromContext(ctx context.Context) (string, bool) {
+	pvzID, ok := ctx.Value(pvzIDKey{}).(string)
+	if !ok || pvzID == "" {
+		return "", false
+	}
+
+	return pvzID, true
+}
@@ -2,6 +2,7 @@ package abstractions
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"homework/internal/domain"
@@ -13,6 +14,10 @@ import (
 type PagePaginationOptions struct {
 	Page     int
 	PageSize int
+	// ReturnReasons limits the returns to the ones with the given reasons, empty means any reason
+	ReturnReasons []domain.ReturnReason
+	// InspectionOutcomes limits the returns to the ones with the given inspection outcomes, empty means any outcome
+	InspectionOutcomes []domain.InspectionOutcome
 }
 
 // PagePaginationOptFunc is a type for pagination options
@@ -34,6 +39,22 @@ func WithPageSize(pageSize int) PagePaginationOptFunc {
 	}
 }
 
+// WithReturnReasons is an option to get only returns with the given reasons
+func WithReturnReasons(reasons ...domain.ReturnReason) PagePaginationOptFunc {
+	return func(o *PagePaginationOptions) error {
+		o.ReturnReasons = append(o.ReturnReasons, reasons...)
+		return nil
+	}
+}
+
+// WithInspectionOutcomes is an option to get only returns with the given inspection outcomes
+func WithInspectionOutcomes(outcomes ...domain.InspectionOutcome) PagePaginationOptFunc {
+	return func(o *PagePaginationOptions) error {
+		o.InspectionOutcomes = append(o.InspectionOutcomes, outcomes...)
+		return nil
+	}
+}
+
 // NewPaginationOptions creates new pagination options
 func NewPaginationOptions(options ...PagePaginationOptFunc) (*PagePaginationOptions, error) {
 	opts := &PagePaginationOptions{
@@ -55,6 +76,9 @@ type GetOrdersOptions struct {
 	SamePVZ     bool
 	CursorID    string
 	Limit       int
+	Statuses    []domain.OrderStatus
+	// WeightDiscrepancy limits the orders to the ones accepted with the weight discrepancy
+	WeightDiscrepancy bool
 }
 
 // GetOrdersOptFunc is a type for order options
@@ -99,6 +123,22 @@ func WithLimit(limit int) GetOrdersOptFunc {
 	}
 }
 
+// WithStatuses is an option to get only orders in the given statuses
+func WithStatuses(statuses ...domain.OrderStatus) GetOrdersOptFunc {
+	return func(o *GetOrdersOptions) error {
+		o.Statuses = append(o.Statuses, statuses...)
+		return nil
+	}
+}
+
+// WithWeightDiscrepancy is an option to get only orders accepted with the weight discrepancy
+func WithWeightDiscrepancy() GetOrdersOptFunc {
+	return func(o *GetOrdersOptions) error {
+		o.WeightDiscrepancy = true
+		return nil
+	}
+}
+
 // NewGetOrdersOptions creates new get orders options
 func NewGetOrdersOptions(options ...GetOrdersOptFunc) (*GetOrdersOptions, error) {
 	opts := GetOrdersOptions{}
@@ -110,14 +150,90 @@ func NewGetOrdersOptions(options ...GetOrdersOptFunc) (*GetOrdersOptions, error)
 	return &opts, nil
 }
 
+// MutationOptions is a struct for options of the operations which change an order
+type MutationOptions struct {
+	ExpectedVersion int64
+}
+
+// MutationOptFunc is a type for mutation options
+type MutationOptFunc func(*MutationOptions) error
+
+// WithExpectedVersion is an option to change the order only if it still has the given version
+func WithExpectedVersion(version int64) MutationOptFunc {
+	return func(o *MutationOptions) error {
+		if version < 0 {
+			return fmt.Errorf("%w: expected version is negative", domain.ErrInvalidArgument)
+		}
+		o.ExpectedVersion = version
+		return nil
+	}
+}
+
+// NewMutationOptions creates new mutation options
+func NewMutationOptions(options ...MutationOptFunc) (*MutationOptions, error) {
+	opts := MutationOptions{}
+	for _, opt := range options {
+		if err := opt(&opts); err != nil {
+			return nil, err
+		}
+	}
+	return &opts, nil
+}
+
+// AcceptOptions is a struct for options of the acceptance of the order
+type AcceptOptions struct {
+	// MeasuredWeight is the weight of the parcel on the scales, 0 if it was not weighed
+	MeasuredWeight int
+	// WeightOverrideReason is why the operator accepts the parcel which weighs not what is declared
+	WeightOverrideReason string
+}
+
+// AcceptOptFunc is a type for accept options
+type AcceptOptFunc func(*AcceptOptions) error
+
+// WithMeasuredWeight is an option to record the weight of the parcel on the scales
+func WithMeasuredWeight(weight int) AcceptOptFunc {
+	return func(o *AcceptOptions) error {
+		if weight < 0 {
+			return fmt.Errorf("%w: measured weight is negative", domain.ErrInvalidArgument)
+		}
+		o.MeasuredWeight = weight
+		return nil
+	}
+}
+
+// WithWeightOverrideReason is an option to accept the parcel whose measured weight differs from the declared one
+func WithWeightOverrideReason(reason string) AcceptOptFunc {
+	return func(o *AcceptOptions) error {
+		o.WeightOverrideReason = reason
+		return nil
+	}
+}
+
+// NewAcceptOptions creates new accept options
+func NewAcceptOptions(options ...AcceptOptFunc) (*AcceptOptions, error) {
+	opts := AcceptOptions{}
+	for _, opt := range options {
+		if err := opt(&opts); err != nil {
+			return nil, err
+		}
+	}
+	return &opts, nil
+}
+
 //go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i IPVZOrderUseCase -s _mock.go -o ./mocks
 
 // IPVZOrderUseCase is an interface for order use cases
 type IPVZOrderUseCase interface {
-	AcceptOrderDelivery(ctx context.Context, orderID, recipientID string, storageTime time.Duration, cost, weight int, packaging domain.PackagingType, additionalFilm bool) error
-	ReturnOrderDelivery(ctx context.Context, orderID string) error
-	GiveOrderToClient(ctx context.Context, orderIDs []string) error
+	AcceptOrderDelivery(ctx context.Context, orderID, recipientID string, storageTime time.Duration, cost domain.Money, weight int, dimensions domain.Dimensions, packaging domain.PackagingType, additionalFilm bool, options ...AcceptOptFunc) (domain.PVZOrder, error)
+	BatchAcceptOrderDelivery(ctx context.Context, items []domain.DeliveryItem) ([]domain.DeliveryResult, error)
+	ReturnOrderDelivery(ctx context.Context, orderID string, options ...MutationOptFunc) error
+	GiveOrderToClient(ctx context.Context, decisions []domain.IssueDecision) ([]domain.IssueResult, error)
 	GetOrders(ctx context.Context, userID string, options ...GetOrdersOptFunc) ([]domain.PVZOrder, error)
-	AcceptReturn(ctx context.Context, userID, orderID string) error
+	AcceptReturn(ctx context.Context, userID, orderID string, details domain.ReturnDetails, options ...MutationOptFunc) error
 	GetReturns(ctx context.Context, options ...PagePaginationOptFunc) ([]domain.PVZOrder, error)
+	GetOrderHistory(ctx context.Context, orderID string) ([]domain.Event, error)
+	ExtendStorage(ctx context.Context, orderID string, extension time.Duration, extendedBy string, options ...MutationOptFunc) error
+	QuotePackaging(ctx context.Context, cost domain.Money, weight int, dimensions domain.Dimensions) ([]domain.PackagingQuote, error)
+	GetWeightDiscrepancyReport(ctx context.Context, date time.Time) (domain.WeightDiscrepancyReport, error)
 }
@@ -0,0 +1,17 @@
+package abstractions
+
+import (
+	"context"
+
+	"homework/internal/domain"
+)
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i IPVZPolicyUseCase -s _mock.go -o ./mocks
+
+// IPVZPolicyUseCase is an interface for the administration of the PVZ rules
+type IPVZPolicyUseCase interface {
+	// GetPVZPolicy returns the rules in effect in the PVZ, the defaults are used for the rules which are not set
+	GetPVZPolicy(ctx context.Context, pvzID string) (domain.PVZPolicy, error)
+	// UpdatePVZPolicy replaces the stored rules of the PVZ and returns the rules in effect
+	UpdatePVZPolicy(ctx context.Context, policy domain.PVZPolicy, options ...MutationOptFunc) (domain.PVZPolicy, error)
+}
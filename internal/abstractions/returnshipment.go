@@ -0,0 +1,18 @@
+package abstractions
+
+import (
+	"context"
+
+	"homework/internal/domain"
+)
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i IReturnShipmentUseCase -s _mock.go -o ./mocks
+
+// IReturnShipmentUseCase is an interface for the dispatch of the returned orders back to the seller
+type IReturnShipmentUseCase interface {
+	// CreateReturnShipment groups the orders returned by the clients or refused at pickup into a shipment
+	CreateReturnShipment(ctx context.Context, orderIDs []string) (domain.ReturnShipment, error)
+	// DispatchReturnShipment hands the shipment over to the courier and returns its manifest
+	DispatchReturnShipment(ctx context.Context, shipmentID, courierID string) (domain.ReturnManifest, error)
+	GetReturnShipment(ctx context.Context, shipmentID string) (domain.ReturnShipment, error)
+}
@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Calendar is a working calendar of the PVZ. The storage and return periods only run on the business days,
+// so the weekends and the holidays do not eat into the client's window.
+// The zero Calendar works around the clock every day in UTC
+type Calendar struct {
+	Location *time.Location
+	// OpensAt and ClosesAt are the working hours as the time since midnight, both are zero if the PVZ works
+	// around the clock
+	OpensAt  time.Duration
+	ClosesAt time.Duration
+	Weekends []time.Weekday
+	// Holidays are the dates of the public holidays in the time zone of the PVZ
+	Holidays []time.Time
+}
+
+// NewCalendar creates a calendar of the PVZ and checks it has at least one business day a week
+func NewCalendar(location *time.Location, opensAt, closesAt time.Duration, weekends []time.Weekday, holidays []time.Time) (Calendar, error) {
+	if location == nil {
+		location = time.UTC
+	}
+
+	if opensAt != 0 || closesAt != 0 {
+		if opensAt < 0 || closesAt > 24*time.Hour || opensAt >= closesAt {
+			return Calendar{}, fmt.Errorf("%w: working hours %s-%s are invalid", ErrInvalidArgument, opensAt, closesAt)
+		}
+	}
+
+	days := make(map[time.Weekday]struct{}, len(weekends))
+	for _, weekday := range weekends {
+		days[weekday] = struct{}{}
+	}
+	if len(days) >= 7 {
+		return Calendar{}, fmt.Errorf("%w: calendar has no business days", ErrInvalidArgument)
+	}
+
+	dates := make([]time.Time, 0, len(holidays))
+	for _, holiday := range holidays {
+		year, month, day := holiday.Date()
+		dates = append(dates, time.Date(year, month, day, 0, 0, 0, 0, location))
+	}
+
+	return Calendar{
+		Location: location,
+		OpensAt:  opensAt,
+		ClosesAt: closesAt,
+		Weekends: weekends,
+		Holidays: dates,
+	}, nil
+}
+
+// ParseWeekday parses the English name of the day of the week, e.g. "sunday"
+func ParseWeekday(s string) (time.Weekday, error) {
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		if strings.EqualFold(strings.TrimSpace(s), weekday.String()) {
+			return weekday, nil
+		}
+	}
+	return time.Sunday, fmt.Errorf("%w: unknown day of the week %q", ErrInvalidArgument, s)
+}
+
+func (c Calendar) location() *time.Location {
+	if c.Location == nil {
+		return time.UTC
+	}
+	return c.Location
+}
+
+// hasWorkingHours checks if the PVZ does not work around the clock
+func (c Calendar) hasWorkingHours() bool {
+	return c.OpensAt != 0 || c.ClosesAt != 0
+}
+
+// IsBusinessDay checks if the day of t in the time zone of the PVZ is neither a weekend nor a holiday
+func (c Calendar) IsBusinessDay(t time.Time) bool {
+	t = t.In(c.location())
+
+	for _, weekend := range c.Weekends {
+		if t.Weekday() == weekend {
+			return false
+		}
+	}
+
+	year, month, day := t.Date()
+	for _, holiday := range c.Holidays {
+		if hYear, hMonth, hDay := holiday.Date(); hYear == year && hMonth == month && hDay == day {
+			return false
+		}
+	}
+
+	return true
+}
+
+// startOfDay returns the midnight of the day of t in the time zone of the PVZ
+func (c Calendar) startOfDay(t time.Time) time.Time {
+	year, month, day := t.In(c.location()).Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, c.location())
+}
+
+// nextDay returns the midnight after t in the time zone of the PVZ
+func (c Calendar) nextDay(t time.Time) time.Time {
+	start := c.startOfDay(t)
+	return time.Date(start.Year(), start.Month(), start.Day()+1, 0, 0, 0, 0, c.location())
+}
+
+// Day returns the bounds [from, to) of the day in the time zone of the PVZ. Only the date of t is taken,
+// so the date parsed in UTC means the same day in the PVZ
+func (c Calendar) Day(t time.Time) (time.Time, time.Time) {
+	year, month, day := t.Date()
+	from := time.Date(year, month, day, 0, 0, 0, 0, c.location())
+	return from, c.nextDay(from)
+}
+
+// Today returns the current time in the time zone of the PVZ
+func (c Calendar) Today() time.Time {
+	return time.Now().In(c.location())
+}
+
+// closingTime returns the end of the working hours of the day of t
+func (c Calendar) closingTime(t time.Time) time.Time {
+	return c.startOfDay(t).Add(c.ClosesAt)
+}
+
+// Deadline adds the period to from counting only the time of the business days. If the PVZ has the working hours,
+// the deadline is moved to the closing time, so the client always has the whole last day.
+// The deadline of the zero Calendar is from plus the period
+func (c Calendar) Deadline(from time.Time, period time.Duration) time.Time {
+	if len(c.Weekends) == 0 && len(c.Holidays) == 0 && !c.hasWorkingHours() {
+		return from.Add(period)
+	}
+
+	cursor := from
+	remaining := period
+
+	for {
+		next := c.nextDay(cursor)
+		if !c.IsBusinessDay(cursor) {
+			cursor = next
+			continue
+		}
+
+		left := next.Sub(cursor)
+		if remaining <= left {
+			cursor = cursor.Add(remaining)
+			break
+		}
+
+		remaining -= left
+		cursor = next
+	}
+
+	if !c.hasWorkingHours() {
+		return cursor
+	}
+
+	// The last moment of the period belongs to the business day it has been counted on
+	lastDay := cursor.Add(-time.Nanosecond)
+	if closing := c.closingTime(lastDay); !cursor.After(closing) {
+		return closing
+	}
+
+	day := c.nextDay(lastDay)
+	for !c.IsBusinessDay(day) {
+		day = c.nextDay(day)
+	}
+	return c.closingTime(day)
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+	"slices"
+	"time"
+)
+
+// CourierReturnList is a list of the expired orders of the PVZ the courier takes back
+type CourierReturnList struct {
+	ID        string
+	PVZID     string
+	OrderIDs  []string
+	CreatedAt time.Time
+}
+
+// NewCourierReturnLists groups the expired orders into one list per PVZ, the lists are sorted by PVZ
+func NewCourierReturnLists(orders []PVZOrder) []CourierReturnList {
+	byPVZ := make(map[string][]string)
+	for _, order := range orders {
+		byPVZ[order.PVZID] = append(byPVZ[order.PVZID], order.OrderID)
+	}
+
+	pvzIDs := make([]string, 0, len(byPVZ))
+	for pvzID := range byPVZ {
+		pvzIDs = append(pvzIDs, pvzID)
+	}
+	slices.Sort(pvzIDs)
+
+	now := time.Now()
+	lists := make([]CourierReturnList, 0, len(pvzIDs))
+	for _, pvzID := range pvzIDs {
+		lists = append(lists, CourierReturnList{
+			ID:        uuid.NewString(),
+			PVZID:     pvzID,
+			OrderIDs:  byPVZ[pvzID],
+			CreatedAt: now,
+		})
+	}
+
+	return lists
+}
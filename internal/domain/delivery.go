@@ -0,0 +1,27 @@
+package domain
+
+import "time"
+
+// DeliveryItem is one of the parcels the courier hands over to the PVZ
+type DeliveryItem struct {
+	OrderID        string
+	RecipientID    string
+	StorageTime    time.Duration
+	Cost           Money
+	Weight         int
+	Dimensions     Dimensions
+	Packaging      PackagingType
+	AdditionalFilm bool
+	// MeasuredWeight is the weight of the parcel on the scales, 0 if it was not weighed
+	MeasuredWeight int
+	// WeightOverrideReason is required if the measured weight differs from the declared Weight too much
+	WeightOverrideReason string
+}
+
+// DeliveryResult is a result of accepting the parcel.
+// Err is nil if the order was accepted, Order is the accepted order then
+type DeliveryResult struct {
+	OrderID string
+	Order   PVZOrder
+	Err     error
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// volumetricWeightDivisor converts the volume in cubic centimetres to the volumetric weight in grams,
+// it is the common courier rate of 5000 cm³ per kilogram
+const volumetricWeightDivisor = 5
+
+// Dimensions are the sizes of the parcel in centimetres.
+// Zero dimensions mean the sizes are unknown, e.g. for the orders accepted before they were introduced
+type Dimensions struct {
+	Length int
+	Width  int
+	Height int
+}
+
+// NewDimensions validates the sizes of the parcel, either all of them are set or none
+func NewDimensions(length, width, height int) (Dimensions, error) {
+	d := Dimensions{Length: length, Width: width, Height: height}
+	if d.IsZero() {
+		return d, nil
+	}
+
+	if length <= 0 || width <= 0 || height <= 0 {
+		return Dimensions{}, fmt.Errorf("%w: dimensions must be positive, got %s", ErrInvalidArgument, d)
+	}
+
+	return d, nil
+}
+
+// ParseDimensions parses the dimensions in the LxWxH format, e.g. "30x20x10"
+func ParseDimensions(s string) (Dimensions, error) {
+	parts := strings.Split(strings.ToLower(strings.TrimSpace(s)), "x")
+	if len(parts) != 3 {
+		return Dimensions{}, fmt.Errorf("%w: dimensions %q must be in the LxWxH format", ErrInvalidArgument, s)
+	}
+
+	sizes := make([]int, len(parts))
+	for i, part := range parts {
+		size, err := strconv.Atoi(part)
+		if err != nil {
+			return Dimensions{}, fmt.Errorf("%w: dimensions %q must be in the LxWxH format", ErrInvalidArgument, s)
+		}
+		sizes[i] = size
+	}
+
+	return NewDimensions(sizes[0], sizes[1], sizes[2])
+}
+
+func (d Dimensions) String() string {
+	return fmt.Sprintf("%dx%dx%d", d.Length, d.Width, d.Height)
+}
+
+// IsZero checks if the dimensions are unknown
+func (d Dimensions) IsZero() bool {
+	return d == Dimensions{}
+}
+
+// Volume returns the volume in cubic centimetres
+func (d Dimensions) Volume() int {
+	return d.Length * d.Width * d.Height
+}
+
+// VolumetricWeight returns the weight in grams which the courier charges for the space the parcel takes
+func (d Dimensions) VolumetricWeight() int {
+	return d.Volume() / volumetricWeightDivisor
+}
+
+// FitsInto checks if the parcel fits into the space of the given dimensions, the parcel may be rotated
+func (d Dimensions) FitsInto(limit Dimensions) bool {
+	sizes := d.sorted()
+	limits := limit.sorted()
+	for i := range sizes {
+		if sizes[i] > limits[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (d Dimensions) sorted() []int {
+	sizes := []int{d.Length, d.Width, d.Height}
+	slices.Sort(sizes)
+	return sizes
+}
@@ -9,6 +9,12 @@ var (
 	ErrNotFound = errors.New("entity not found")
 	// ErrInvalidArgument is an error for invalid argument
 	ErrInvalidArgument = errors.New("invalid argument")
+	// ErrConflict is an error for entity which state has been changed and does not allow the operation anymore
+	ErrConflict = errors.New("entity state conflict")
+	// ErrVersionMismatch is an error for entity which has been changed since the version the caller has seen
+	ErrVersionMismatch = errors.New("entity version mismatch")
+	// ErrTooManyAttempts is an error for operation which is locked after too many failed attempts
+	ErrTooManyAttempts = errors.New("too many attempts")
 	// ErrInternal is an error for internal server error
 	ErrInternal = errors.New("internal server error")
 )
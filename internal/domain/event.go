@@ -18,6 +18,38 @@ func NewEventType(eventType string) (EventType, error) {
 		return EventTypeOrderDeliveryReturned, nil
 	case EventTypeOrderReturned.String():
 		return EventTypeOrderReturned, nil
+	case EventTypeOrderRefused.String():
+		return EventTypeOrderRefused, nil
+	case EventTypeOrderStorageExtended.String():
+		return EventTypeOrderStorageExtended, nil
+	case EventTypeOrderPickupCodeIssued.String():
+		return EventTypeOrderPickupCodeIssued, nil
+	case EventTypeOrderPickupLocked.String():
+		return EventTypeOrderPickupLocked, nil
+	case EventTypeOrderWeightDiscrepancy.String():
+		return EventTypeOrderWeightDiscrepancy, nil
+	case EventTypeOrderStorageFeePaid.String():
+		return EventTypeOrderStorageFeePaid, nil
+	case EventTypeOrderExpired.String():
+		return EventTypeOrderExpired, nil
+	case EventTypeOrderExpiresSoon.String():
+		return EventTypeOrderExpiresSoon, nil
+	case EventTypeCourierReturnListCreated.String():
+		return EventTypeCourierReturnListCreated, nil
+	case EventTypeHandoverSessionOpened.String():
+		return EventTypeHandoverSessionOpened, nil
+	case EventTypeHandoverParcelScanned.String():
+		return EventTypeHandoverParcelScanned, nil
+	case EventTypeHandoverSessionClosed.String():
+		return EventTypeHandoverSessionClosed, nil
+	case EventTypePVZPolicyUpdated.String():
+		return EventTypePVZPolicyUpdated, nil
+	case EventTypeReturnShipmentCreated.String():
+		return EventTypeReturnShipmentCreated, nil
+	case EventTypeReturnShipmentDispatched.String():
+		return EventTypeReturnShipmentDispatched, nil
+	case EventTypeOrderDispatchedToSeller.String():
+		return EventTypeOrderDispatchedToSeller, nil
 	default:
 		return EventTypeUnknown, fmt.Errorf("unknown event type %s: %w", eventType, ErrInvalidArgument)
 	}
@@ -28,13 +60,33 @@ func (e EventType) String() string {
 }
 
 const (
-	EventTypeUnknown               EventType = "unknown"
-	EventTypeOrderDeliveryAccepted EventType = "order_delivery_accepted"
-	EventTypeOrderIssued           EventType = "order_issued"
-	EventTypeOrderDeliveryReturned EventType = "order_delivery_returned"
-	EventTypeOrderReturned         EventType = "order_returned"
+	EventTypeUnknown                  EventType = "unknown"
+	EventTypeOrderDeliveryAccepted    EventType = "order_delivery_accepted"
+	EventTypeOrderIssued              EventType = "order_issued"
+	EventTypeOrderDeliveryReturned    EventType = "order_delivery_returned"
+	EventTypeOrderReturned            EventType = "order_returned"
+	EventTypeOrderRefused             EventType = "order_refused"
+	EventTypeOrderStorageExtended     EventType = "order_storage_extended"
+	EventTypeOrderPickupCodeIssued    EventType = "order_pickup_code_issued"
+	EventTypeOrderPickupLocked        EventType = "order_pickup_locked"
+	EventTypeOrderWeightDiscrepancy   EventType = "order_weight_discrepancy"
+	EventTypeOrderStorageFeePaid      EventType = "order_storage_fee_paid"
+	EventTypeOrderExpired             EventType = "order_expired"
+	EventTypeOrderExpiresSoon         EventType = "order_expires_soon"
+	EventTypeCourierReturnListCreated EventType = "courier_return_list_created"
+	EventTypeHandoverSessionOpened    EventType = "handover_session_opened"
+	EventTypeHandoverParcelScanned    EventType = "handover_parcel_scanned"
+	EventTypeHandoverSessionClosed    EventType = "handover_session_closed"
+	EventTypePVZPolicyUpdated         EventType = "pvz_policy_updated"
+	EventTypeReturnShipmentCreated    EventType = "return_shipment_created"
+	EventTypeReturnShipmentDispatched EventType = "return_shipment_dispatched"
+	EventTypeOrderDispatchedToSeller  EventType = "order_dispatched_to_seller"
 )
 
+// pickupCodePayloadKey is a key of the plain pickup code in the event payload.
+// The code is only meant for the notification of the recipient, it is dropped from the event once it is sent
+const pickupCodePayloadKey = "pickup_code"
+
 type Event struct {
 	ID        uuid.UUID
 	EventType EventType
@@ -53,13 +105,15 @@ func NewEvent(eventType EventType, payload map[string]interface{}) Event {
 	}
 }
 
-func NewOrderDeliveryAcceptedEvent(orderID, pvzID, recipientID string, cost, weight int, packaging PackagingType, additionalFilm bool, receivedAt time.Time, storageTime time.Duration) Event {
+func NewOrderDeliveryAcceptedEvent(orderID, pvzID, recipientID string, cost Money, weight int, dimensions Dimensions, packaging PackagingType, additionalFilm bool, receivedAt time.Time, storageTime time.Duration) Event {
 	return NewEvent(EventTypeOrderDeliveryAccepted, map[string]interface{}{
 		"order_id":        orderID,
 		"pvz_id":          pvzID,
 		"recipient_id":    recipientID,
-		"cost":            cost,
+		"cost":            cost.Amount,
+		"currency":        cost.Currency.String(),
 		"weight":          weight,
+		"dimensions":      dimensions.String(),
 		"packaging":       packaging,
 		"additional_film": additionalFilm,
 		"received_at":     receivedAt,
@@ -79,8 +133,209 @@ func NewOrderDeliveryReturnedEvent(orderID string) Event {
 	})
 }
 
-func NewOrderReturnedEvent(orderID string) Event {
+// NewOrderReturnedEvent creates an event of the order returned by the client, the seller refunds it by the details
+func NewOrderReturnedEvent(orderID string, details ReturnDetails) Event {
 	return NewEvent(EventTypeOrderReturned, map[string]interface{}{
+		"order_id":   orderID,
+		"reason":     details.Reason.String(),
+		"inspection": details.Inspection.String(),
+		"comment":    details.Comment,
+	})
+}
+
+func NewOrderRefusedEvent(orderID, reason string) Event {
+	return NewEvent(EventTypeOrderRefused, map[string]interface{}{
 		"order_id": orderID,
+		"reason":   reason,
+	})
+}
+
+func NewOrderStorageExtendedEvent(orderID string, extension time.Duration, extendedBy string) Event {
+	return NewEvent(EventTypeOrderStorageExtended, map[string]interface{}{
+		"order_id":    orderID,
+		"extension":   extension,
+		"extended_by": extendedBy,
+	})
+}
+
+// NewOrderPickupCodeIssuedEvent creates an event which delivers the pickup code to the recipient
+func NewOrderPickupCodeIssuedEvent(orderID, recipientID, pickupCode string) Event {
+	return NewEvent(EventTypeOrderPickupCodeIssued, map[string]interface{}{
+		"order_id":           orderID,
+		"recipient_id":       recipientID,
+		pickupCodePayloadKey: pickupCode,
+	})
+}
+
+func NewOrderPickupLockedEvent(orderID string, lockedUntil time.Time) Event {
+	return NewEvent(EventTypeOrderPickupLocked, map[string]interface{}{
+		"order_id":     orderID,
+		"locked_until": lockedUntil,
+	})
+}
+
+// NewOrderWeightDiscrepancyEvent creates an event of the order accepted with the weight discrepancy by the override of the operator
+func NewOrderWeightDiscrepancyEvent(orderID, pvzID string, declaredWeight, measuredWeight int, overrideReason string) Event {
+	return NewEvent(EventTypeOrderWeightDiscrepancy, map[string]interface{}{
+		"order_id":        orderID,
+		"pvz_id":          pvzID,
+		"declared_weight": declaredWeight,
+		"measured_weight": measuredWeight,
+		"override_reason": overrideReason,
+	})
+}
+
+// NewOrderStorageFeePaidEvent creates an event of the storage fee the client paid for the paid storage of the order
+func NewOrderStorageFeePaidEvent(orderID string, fee Money) Event {
+	return NewEvent(EventTypeOrderStorageFeePaid, map[string]interface{}{
+		"order_id": orderID,
+		"fee":      fee.Amount,
+		"currency": fee.Currency.String(),
+	})
+}
+
+func NewOrderExpiredEvent(orderID, pvzID, recipientID string, expiredAt time.Time) Event {
+	return NewEvent(EventTypeOrderExpired, map[string]interface{}{
+		"order_id":     orderID,
+		"pvz_id":       pvzID,
+		"recipient_id": recipientID,
+		"expired_at":   expiredAt,
+	})
+}
+
+// NewOrderExpiresSoonEvent creates an event which reminds the recipient to pick up the order before it expires
+func NewOrderExpiresSoonEvent(orderID, pvzID, recipientID string, expiresAt time.Time) Event {
+	return NewEvent(EventTypeOrderExpiresSoon, map[string]interface{}{
+		"order_id":     orderID,
+		"pvz_id":       pvzID,
+		"recipient_id": recipientID,
+		"expires_at":   expiresAt,
+	})
+}
+
+func NewCourierReturnListCreatedEvent(list CourierReturnList) Event {
+	return NewEvent(EventTypeCourierReturnListCreated, map[string]interface{}{
+		"list_id":   list.ID,
+		"pvz_id":    list.PVZID,
+		"order_ids": list.OrderIDs,
+	})
+}
+
+func NewHandoverSessionOpenedEvent(session HandoverSession) Event {
+	orderIDs := make([]string, len(session.Expected))
+	for i, item := range session.Expected {
+		orderIDs[i] = item.OrderID
+	}
+
+	return NewEvent(EventTypeHandoverSessionOpened, map[string]interface{}{
+		"session_id": session.ID,
+		"pvz_id":     session.PVZID,
+		"courier_id": session.CourierID,
+		"order_ids":  orderIDs,
+	})
+}
+
+func NewHandoverParcelScannedEvent(scan HandoverScan) Event {
+	return NewEvent(EventTypeHandoverParcelScanned, map[string]interface{}{
+		"session_id":      scan.SessionID,
+		"order_id":        scan.OrderID,
+		"measured_weight": scan.MeasuredWeight,
+		"result":          scan.Result.String(),
+		"reason":          scan.Reason,
+	})
+}
+
+func NewHandoverSessionClosedEvent(report HandoverReport) Event {
+	return NewEvent(EventTypeHandoverSessionClosed, map[string]interface{}{
+		"session_id":        report.SessionID,
+		"accepted":          report.Accepted,
+		"missing":           report.Missing,
+		"unexpected":        report.Unexpected,
+		"weight_mismatches": report.WeightMismatches,
+		"rejected":          report.Rejected,
 	})
 }
+
+// NewPVZPolicyUpdatedEvent creates an event which records who changed the rules of the PVZ and how
+func NewPVZPolicyUpdatedEvent(policy PVZPolicy) Event {
+	allowedPackaging := make([]string, len(policy.AllowedPackaging))
+	for i, packaging := range policy.AllowedPackaging {
+		allowedPackaging[i] = packaging.String()
+	}
+
+	setRules := make([]string, len(policy.SetRules))
+	for i, rule := range policy.SetRules {
+		setRules[i] = rule.String()
+	}
+
+	return NewEvent(EventTypePVZPolicyUpdated, map[string]interface{}{
+		"pvz_id":            policy.PVZID,
+		"version":           policy.Version,
+		"updated_by":        policy.UpdatedBy,
+		"return_window":     policy.ReturnWindow,
+		"max_storage_time":  policy.MaxStorageTime,
+		"max_order_weight":  policy.MaxOrderWeight,
+		"allowed_packaging": allowedPackaging,
+		"set_rules":         setRules,
+	})
+}
+
+func NewReturnShipmentCreatedEvent(shipment ReturnShipment) Event {
+	return NewEvent(EventTypeReturnShipmentCreated, map[string]interface{}{
+		"shipment_id": shipment.ID,
+		"pvz_id":      shipment.PVZID,
+		"order_ids":   shipment.OrderIDs,
+	})
+}
+
+// NewReturnShipmentDispatchedEvent creates an event with the manifest of the shipment the courier takes to the seller
+func NewReturnShipmentDispatchedEvent(manifest ReturnManifest) Event {
+	items := make([]map[string]interface{}, len(manifest.Items))
+	for i, item := range manifest.Items {
+		items[i] = map[string]interface{}{
+			"order_id":     item.OrderID,
+			"recipient_id": item.RecipientID,
+			"status":       item.Status.String(),
+			"cost":         item.Cost.Amount,
+			"currency":     item.Cost.Currency.String(),
+			"weight":       item.Weight,
+			"packaging":    item.Packaging.String(),
+			"reason":       item.ReturnDetails.Reason.String(),
+			"inspection":   item.ReturnDetails.Inspection.String(),
+			"comment":      item.ReturnDetails.Comment,
+			"returned_at":  item.ReturnedAt,
+		}
+	}
+
+	return NewEvent(EventTypeReturnShipmentDispatched, map[string]interface{}{
+		"shipment_id":   manifest.ShipmentID,
+		"pvz_id":        manifest.PVZID,
+		"courier_id":    manifest.CourierID,
+		"items":         items,
+		"dispatched_at": manifest.DispatchedAt,
+	})
+}
+
+func NewOrderDispatchedToSellerEvent(orderID, shipmentID, courierID string) Event {
+	return NewEvent(EventTypeOrderDispatchedToSeller, map[string]interface{}{
+		"order_id":    orderID,
+		"shipment_id": shipmentID,
+		"courier_id":  courierID,
+	})
+}
+
+// Redacted returns a copy of the event without secrets, so it can be shown to the operator
+func (e Event) Redacted() Event {
+	if _, ok := e.Payload[pickupCodePayloadKey]; !ok {
+		return e
+	}
+
+	payload := make(map[string]interface{}, len(e.Payload))
+	for key, value := range e.Payload {
+		payload[key] = value
+	}
+	delete(payload, pickupCodePayloadKey)
+
+	e.Payload = payload
+	return e
+}
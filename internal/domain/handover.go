@@ -0,0 +1,237 @@
+package domain
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+)
+
+// HandoverSessionStatus is a status of the reconciliation of the parcels the courier hands over
+type HandoverSessionStatus string
+
+const (
+	HandoverSessionStatusUnknown HandoverSessionStatus = "unknown"
+	HandoverSessionStatusOpen    HandoverSessionStatus = "open"
+	HandoverSessionStatusClosed  HandoverSessionStatus = "closed"
+)
+
+func (s HandoverSessionStatus) String() string {
+	return string(s)
+}
+
+func NewHandoverSessionStatus(s string) (HandoverSessionStatus, error) {
+	switch s {
+	case HandoverSessionStatusOpen.String():
+		return HandoverSessionStatusOpen, nil
+	case HandoverSessionStatusClosed.String():
+		return HandoverSessionStatusClosed, nil
+	default:
+		return HandoverSessionStatusUnknown, fmt.Errorf("%w: unknown handover session status %s", ErrInvalidArgument, s)
+	}
+}
+
+// HandoverScanResult is an outcome of scanning the parcel against the manifest
+type HandoverScanResult string
+
+const (
+	HandoverScanResultUnknown HandoverScanResult = "unknown"
+	// HandoverScanResultMatched means the parcel is in the manifest and the order is accepted
+	HandoverScanResultMatched HandoverScanResult = "matched"
+	// HandoverScanResultUnexpected means the parcel is not in the manifest
+	HandoverScanResultUnexpected HandoverScanResult = "unexpected"
+	// HandoverScanResultWeightMismatch means the measured weight differs from the declared one too much,
+	// the parcel is not accepted and may be weighed and scanned again
+	HandoverScanResultWeightMismatch HandoverScanResult = "weight_mismatch"
+	// HandoverScanResultDuplicate means the order has already been accepted in the session
+	HandoverScanResultDuplicate HandoverScanResult = "duplicate"
+	// HandoverScanResultRejected means the parcel is in the manifest, but the order can not be accepted
+	HandoverScanResultRejected HandoverScanResult = "rejected"
+)
+
+func (r HandoverScanResult) String() string {
+	return string(r)
+}
+
+func NewHandoverScanResult(s string) (HandoverScanResult, error) {
+	switch s {
+	case HandoverScanResultMatched.String():
+		return HandoverScanResultMatched, nil
+	case HandoverScanResultUnexpected.String():
+		return HandoverScanResultUnexpected, nil
+	case HandoverScanResultWeightMismatch.String():
+		return HandoverScanResultWeightMismatch, nil
+	case HandoverScanResultDuplicate.String():
+		return HandoverScanResultDuplicate, nil
+	case HandoverScanResultRejected.String():
+		return HandoverScanResultRejected, nil
+	default:
+		return HandoverScanResultUnknown, fmt.Errorf("%w: unknown handover scan result %s", ErrInvalidArgument, s)
+	}
+}
+
+// HandoverScan is a parcel scanned during the handover
+type HandoverScan struct {
+	SessionID string
+	OrderID   string
+	// MeasuredWeight is the weight of the parcel on the scales in grams, 0 if it was not weighed
+	MeasuredWeight int
+	Result         HandoverScanResult
+	// Reason explains why the parcel was not accepted
+	Reason    string
+	ScannedAt time.Time
+}
+
+// HandoverSession is a reconciliation of the parcels the courier hands over against the expected manifest
+type HandoverSession struct {
+	ID        string
+	PVZID     string
+	CourierID string
+	Status    HandoverSessionStatus
+	Expected  []DeliveryItem
+	Scans     []HandoverScan
+	OpenedAt  time.Time
+	ClosedAt  time.Time
+	// Report is set when the session is closed
+	Report *HandoverReport
+}
+
+// NewHandoverSession opens a session for the expected manifest of the courier
+func NewHandoverSession(pvzID, courierID string, expected []DeliveryItem) HandoverSession {
+	return HandoverSession{
+		ID:        uuid.NewString(),
+		PVZID:     pvzID,
+		CourierID: courierID,
+		Status:    HandoverSessionStatusOpen,
+		Expected:  expected,
+		OpenedAt:  time.Now(),
+	}
+}
+
+// IsOpen checks if the parcels can still be scanned
+func (s HandoverSession) IsOpen() bool {
+	return s.Status == HandoverSessionStatusOpen
+}
+
+// ExpectedItem finds the parcel in the manifest
+func (s HandoverSession) ExpectedItem(orderID string) (DeliveryItem, bool) {
+	for _, item := range s.Expected {
+		if item.OrderID == orderID {
+			return item, true
+		}
+	}
+	return DeliveryItem{}, false
+}
+
+// IsAccepted checks if the order has already been accepted in the session
+func (s HandoverSession) IsAccepted(orderID string) bool {
+	for _, scan := range s.Scans {
+		if scan.OrderID == orderID && scan.Result == HandoverScanResultMatched {
+			return true
+		}
+	}
+	return false
+}
+
+// NewScan checks the scanned parcel against the manifest. The scan is matched if the order may be accepted,
+// the weight is checked only if the parcel was weighed
+func (s HandoverSession) NewScan(orderID string, measuredWeight, weightTolerancePercent int) HandoverScan {
+	scan := HandoverScan{
+		SessionID:      s.ID,
+		OrderID:        orderID,
+		MeasuredWeight: measuredWeight,
+		Result:         HandoverScanResultMatched,
+		ScannedAt:      time.Now(),
+	}
+
+	item, ok := s.ExpectedItem(orderID)
+	switch {
+	case !ok:
+		scan.Result = HandoverScanResultUnexpected
+		scan.Reason = "parcel is not in the manifest"
+	case s.IsAccepted(orderID):
+		scan.Result = HandoverScanResultDuplicate
+		scan.Reason = "order has already been accepted"
+	case measuredWeight > 0 && WeightMismatch(item.Weight, measuredWeight, weightTolerancePercent):
+		scan.Result = HandoverScanResultWeightMismatch
+		scan.Reason = fmt.Sprintf("measured weight %d differs from declared %d by more than %d%%", measuredWeight, item.Weight, weightTolerancePercent)
+	}
+
+	return scan
+}
+
+// HandoverWeightMismatch is a parcel which weighs not what the manifest declares
+type HandoverWeightMismatch struct {
+	OrderID        string
+	DeclaredWeight int
+	MeasuredWeight int
+}
+
+// HandoverRejection is a parcel of the manifest which could not be accepted
+type HandoverRejection struct {
+	OrderID string
+	Reason  string
+}
+
+// HandoverReport is a discrepancy report of the closed session
+type HandoverReport struct {
+	SessionID string
+	// Accepted are the parcels of the manifest which were accepted
+	Accepted []string
+	// Missing are the parcels of the manifest which were not scanned
+	Missing []string
+	// Unexpected are the scanned parcels which are not in the manifest
+	Unexpected []string
+	// WeightMismatches are the parcels of the manifest which were not accepted because of the weight
+	WeightMismatches []HandoverWeightMismatch
+	// Rejected are the parcels of the manifest which were scanned, but could not be accepted
+	Rejected []HandoverRejection
+}
+
+// NewReport reconciles the scans with the manifest. The last scan of the parcel decides,
+// unless the order has already been accepted
+func (s HandoverSession) NewReport() HandoverReport {
+	report := HandoverReport{SessionID: s.ID}
+
+	last := make(map[string]HandoverScan, len(s.Scans))
+	unexpected := make(map[string]struct{})
+	for _, scan := range s.Scans {
+		switch scan.Result {
+		case HandoverScanResultDuplicate:
+			continue
+		case HandoverScanResultUnexpected:
+			if _, ok := unexpected[scan.OrderID]; !ok {
+				unexpected[scan.OrderID] = struct{}{}
+				report.Unexpected = append(report.Unexpected, scan.OrderID)
+			}
+			continue
+		}
+
+		if s.IsAccepted(scan.OrderID) {
+			continue
+		}
+		last[scan.OrderID] = scan
+	}
+
+	for _, item := range s.Expected {
+		if s.IsAccepted(item.OrderID) {
+			report.Accepted = append(report.Accepted, item.OrderID)
+			continue
+		}
+
+		scan, ok := last[item.OrderID]
+		switch {
+		case !ok:
+			report.Missing = append(report.Missing, item.OrderID)
+		case scan.Result == HandoverScanResultWeightMismatch:
+			report.WeightMismatches = append(report.WeightMismatches, HandoverWeightMismatch{
+				OrderID:        item.OrderID,
+				DeclaredWeight: item.Weight,
+				MeasuredWeight: scan.MeasuredWeight,
+			})
+		default:
+			report.Rejected = append(report.Rejected, HandoverRejection{OrderID: item.OrderID, Reason: scan.Reason})
+		}
+	}
+
+	return report
+}
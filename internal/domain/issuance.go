@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// IssueAction is an action taken on the order when the client picks it up
+type IssueAction string
+
+const (
+	IssueActionUnknown IssueAction = "unknown"
+	IssueActionIssue   IssueAction = "issue"
+	IssueActionRefuse  IssueAction = "refuse"
+)
+
+func (a IssueAction) String() string {
+	return string(a)
+}
+
+func NewIssueAction(a string) (IssueAction, error) {
+	switch a {
+	case "issue":
+		return IssueActionIssue, nil
+	case "refuse":
+		return IssueActionRefuse, nil
+	default:
+		return IssueActionUnknown, fmt.Errorf(
+			"unknown issue action %s (available actions: issue, refuse): %w", a, ErrInvalidArgument,
+		)
+	}
+}
+
+// IssueDecision is a decision of the client about one of the orders at pickup
+type IssueDecision struct {
+	OrderID       string
+	Action        IssueAction
+	RefusalReason string
+	// PickupCode is the one-time code the client shows to pick the order up
+	PickupCode string
+	// ExpectedVersion is the version of the order the decision was made for, 0 means any version
+	ExpectedVersion int64
+	// StorageFeePaid is the storage fee the client pays for the paid storage of the order, it must match the accrued fee
+	StorageFeePaid Money
+}
+
+// NewIssueDecision creates a decision to give the order to the client
+func NewIssueDecision(orderID string) IssueDecision {
+	return IssueDecision{
+		OrderID: orderID,
+		Action:  IssueActionIssue,
+	}
+}
+
+// NewRefuseDecision creates a decision to take the order back as refused by the client
+func NewRefuseDecision(orderID, reason string) IssueDecision {
+	return IssueDecision{
+		OrderID:       orderID,
+		Action:        IssueActionRefuse,
+		RefusalReason: reason,
+	}
+}
+
+// ParseIssueDecision parses a decision from the "<order_id>" (issue)
+// or "<order_id>:<refusal reason>" (refuse) form used by the CLI.
+// The pickup code may follow the order ID after "#": "<order_id>#<pickup_code>",
+// and the paid storage fee after "+": "<order_id>#<pickup_code>+<storage_fee>"
+func ParseIssueDecision(s string) (IssueDecision, error) {
+	order, reason, found := strings.Cut(s, ":")
+	order, storageFee, paid := strings.Cut(order, "+")
+	orderID, pickupCode, _ := strings.Cut(order, "#")
+
+	decision := NewIssueDecision(strings.TrimSpace(orderID))
+	if found {
+		decision = NewRefuseDecision(strings.TrimSpace(orderID), strings.TrimSpace(reason))
+	}
+	decision.PickupCode = strings.TrimSpace(pickupCode)
+
+	if paid {
+		storageFeePaid, err := ParseMoney(storageFee)
+		if err != nil {
+			return IssueDecision{}, err
+		}
+		decision.StorageFeePaid = storageFeePaid
+	}
+
+	return decision, nil
+}
+
+// IssueResult is a result of applying the decision to the order.
+// Err is nil if the decision was applied
+type IssueResult struct {
+	OrderID string
+	Action  IssueAction
+	Err     error
+}
@@ -0,0 +1,150 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Currency is an ISO 4217 currency code
+type Currency string
+
+const (
+	CurrencyRUB Currency = "RUB"
+	CurrencyBYN Currency = "BYN"
+	CurrencyKZT Currency = "KZT"
+	CurrencyUSD Currency = "USD"
+	CurrencyEUR Currency = "EUR"
+	CurrencyCNY Currency = "CNY"
+)
+
+// DefaultCurrency is a currency of the costs which were stored or sent without it
+const DefaultCurrency = CurrencyRUB
+
+// currencyExponents are the numbers of digits after the decimal point of the supported currencies,
+// e.g. 2 for roubles, so 2000 minor units are 20.00 roubles
+var currencyExponents = map[Currency]int{
+	CurrencyRUB: 2,
+	CurrencyBYN: 2,
+	CurrencyKZT: 2,
+	CurrencyUSD: 2,
+	CurrencyEUR: 2,
+	CurrencyCNY: 2,
+}
+
+// NewCurrency parses the currency code, only the supported currencies are accepted
+func NewCurrency(code string) (Currency, error) {
+	currency := Currency(strings.ToUpper(strings.TrimSpace(code)))
+	if _, ok := currencyExponents[currency]; !ok {
+		return "", fmt.Errorf("%w: unsupported currency %q", ErrInvalidArgument, code)
+	}
+	return currency, nil
+}
+
+func (c Currency) String() string {
+	return string(c)
+}
+
+// Exponent returns the number of digits after the decimal point
+func (c Currency) Exponent() int {
+	return currencyExponents[c]
+}
+
+// moneyPattern is a format of the money in minor units with an optional currency, e.g. "2000" or "2000 USD"
+var moneyPattern = regexp.MustCompile(`^(-?[0-9]+)\s*([A-Za-z]{3})?$`)
+
+// ParseMoney parses the amount in minor units with an optional currency code,
+// the amount without the code is in DefaultCurrency
+func ParseMoney(s string) (Money, error) {
+	matches := moneyPattern.FindStringSubmatch(strings.TrimSpace(s))
+	if matches == nil {
+		return Money{}, fmt.Errorf("%w: money %q must be an amount in minor units with an optional currency, e.g. 2000 RUB", ErrInvalidArgument, s)
+	}
+
+	amount, err := strconv.ParseInt(matches[1], 10, 64)
+	if err != nil {
+		return Money{}, fmt.Errorf("%w: invalid money amount %q", ErrInvalidArgument, matches[1])
+	}
+
+	currency := DefaultCurrency
+	if matches[2] != "" {
+		currency, err = NewCurrency(matches[2])
+		if err != nil {
+			return Money{}, err
+		}
+	}
+
+	return NewMoney(amount, currency), nil
+}
+
+// Money is an amount of money in minor units of the currency, e.g. kopecks
+type Money struct {
+	Amount   int64
+	Currency Currency
+}
+
+// NewMoney creates money of the given amount in minor units
+func NewMoney(amount int64, currency Currency) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// RUB creates money of the given amount in kopecks
+func RUB(amount int64) Money {
+	return NewMoney(amount, CurrencyRUB)
+}
+
+// IsZero checks if the amount is zero
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// IsNegative checks if the amount is less than zero
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+// Add returns the sum of the amounts, the currencies must be the same.
+// Zero amount may be added to any currency
+func (m Money) Add(other Money) (Money, error) {
+	if other.IsZero() {
+		return m, nil
+	}
+	if m.IsZero() && m.Currency == "" {
+		return other, nil
+	}
+
+	if m.Currency != other.Currency {
+		return Money{}, fmt.Errorf("%w: can not add %s to %s", ErrInvalidArgument, other.Currency, m.Currency)
+	}
+
+	if (other.Amount > 0 && m.Amount > math.MaxInt64-other.Amount) || (other.Amount < 0 && m.Amount < math.MinInt64-other.Amount) {
+		return Money{}, fmt.Errorf("%w: amount overflow", ErrInvalidArgument)
+	}
+
+	return NewMoney(m.Amount+other.Amount, m.Currency), nil
+}
+
+// Less compares the amounts, it is only meaningful for the same currency
+func (m Money) Less(other Money) bool {
+	return m.Amount < other.Amount
+}
+
+// String formats the money in major units, e.g. "20.00 RUB"
+func (m Money) String() string {
+	exponent := m.Currency.Exponent()
+	if exponent == 0 {
+		return fmt.Sprintf("%d %s", m.Amount, m.Currency)
+	}
+
+	sign := ""
+	amount := m.Amount
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	divisor := int64(math.Pow10(exponent))
+	return fmt.Sprintf("%s%d.%0*d %s", sign, amount/divisor, exponent, amount%divisor, m.Currency)
+}
@@ -0,0 +1,125 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxReturnCommentLength is the maximum length of the comment on the returned order in characters
+const MaxReturnCommentLength = 1000
+
+// ReturnReason is why the client returns the issued order
+type ReturnReason string
+
+const (
+	ReturnReasonUnknown        ReturnReason = "unknown"
+	ReturnReasonDefect         ReturnReason = "defect"
+	ReturnReasonWrongItem      ReturnReason = "wrong_item"
+	ReturnReasonNotAsDescribed ReturnReason = "not_as_described"
+	ReturnReasonChangedMind    ReturnReason = "changed_mind"
+	// ReturnReasonOther requires the comment explaining the reason
+	ReturnReasonOther ReturnReason = "other"
+)
+
+func (r ReturnReason) String() string {
+	return string(r)
+}
+
+func NewReturnReason(s string) (ReturnReason, error) {
+	switch s {
+	case ReturnReasonDefect.String():
+		return ReturnReasonDefect, nil
+	case ReturnReasonWrongItem.String():
+		return ReturnReasonWrongItem, nil
+	case ReturnReasonNotAsDescribed.String():
+		return ReturnReasonNotAsDescribed, nil
+	case ReturnReasonChangedMind.String():
+		return ReturnReasonChangedMind, nil
+	case ReturnReasonOther.String():
+		return ReturnReasonOther, nil
+	default:
+		return ReturnReasonUnknown, fmt.Errorf(
+			"%w: unknown return reason %s (available reasons: defect, wrong_item, not_as_described, changed_mind, other)",
+			ErrInvalidArgument, s,
+		)
+	}
+}
+
+// InspectionOutcome is the state of the returned order the operator finds on the inspection at the PVZ
+type InspectionOutcome string
+
+const (
+	InspectionOutcomeUnknown InspectionOutcome = "unknown"
+	// InspectionOutcomeResellable means the order is complete and undamaged and may be sold again
+	InspectionOutcomeResellable InspectionOutcome = "resellable"
+	InspectionOutcomeDamaged    InspectionOutcome = "damaged"
+	// InspectionOutcomeIncomplete means some of the items or accessories of the order are missing
+	InspectionOutcomeIncomplete InspectionOutcome = "incomplete"
+)
+
+func (o InspectionOutcome) String() string {
+	return string(o)
+}
+
+func NewInspectionOutcome(s string) (InspectionOutcome, error) {
+	switch s {
+	case InspectionOutcomeResellable.String():
+		return InspectionOutcomeResellable, nil
+	case InspectionOutcomeDamaged.String():
+		return InspectionOutcomeDamaged, nil
+	case InspectionOutcomeIncomplete.String():
+		return InspectionOutcomeIncomplete, nil
+	default:
+		return InspectionOutcomeUnknown, fmt.Errorf(
+			"%w: unknown inspection outcome %s (available outcomes: resellable, damaged, incomplete)",
+			ErrInvalidArgument, s,
+		)
+	}
+}
+
+// ReturnDetails are recorded by the operator when the client returns the order, the seller processes the refund by them
+type ReturnDetails struct {
+	Reason     ReturnReason
+	Inspection InspectionOutcome
+	Comment    string
+}
+
+// NewReturnDetails parses the reason and the inspection outcome of the return and validates the details
+func NewReturnDetails(reason, inspection, comment string) (ReturnDetails, error) {
+	details := ReturnDetails{
+		Reason:     ReturnReason(strings.TrimSpace(reason)),
+		Inspection: InspectionOutcome(strings.TrimSpace(inspection)),
+		Comment:    strings.TrimSpace(comment),
+	}
+
+	if err := details.Validate(); err != nil {
+		return ReturnDetails{}, err
+	}
+
+	return details, nil
+}
+
+// Validate checks the reason and the inspection outcome are known and the comment is given if it is required
+func (d ReturnDetails) Validate() error {
+	if _, err := NewReturnReason(d.Reason.String()); err != nil {
+		return err
+	}
+	if _, err := NewInspectionOutcome(d.Inspection.String()); err != nil {
+		return err
+	}
+
+	if d.Reason == ReturnReasonOther && d.Comment == "" {
+		return fmt.Errorf("%w: comment is required for the return reason %s", ErrInvalidArgument, d.Reason)
+	}
+	if utf8.RuneCountInString(d.Comment) > MaxReturnCommentLength {
+		return fmt.Errorf("%w: comment is longer than %d characters", ErrInvalidArgument, MaxReturnCommentLength)
+	}
+
+	return nil
+}
+
+// IsZero checks if the details were not recorded, e.g. for the orders returned before they were introduced
+func (d ReturnDetails) IsZero() bool {
+	return d == ReturnDetails{}
+}
@@ -0,0 +1,73 @@
+package domain
+
+import "fmt"
+
+// OrderStatus is a status of the order lifecycle
+type OrderStatus string
+
+const (
+	OrderStatusUnknown           OrderStatus = "unknown"
+	OrderStatusAccepted          OrderStatus = "accepted"
+	OrderStatusIssued            OrderStatus = "issued"
+	OrderStatusReturnedByClient  OrderStatus = "returned_by_client"
+	OrderStatusReturnedToCourier OrderStatus = "returned_to_courier"
+	OrderStatusExpired           OrderStatus = "expired"
+	OrderStatusRefused           OrderStatus = "refused"
+	// OrderStatusDispatchedToSeller is the order returned by the client or refused at pickup
+	// which the courier has taken back to the seller in a return shipment
+	OrderStatusDispatchedToSeller OrderStatus = "dispatched_to_seller"
+)
+
+// orderStatusTransitions is the single source of truth for the allowed order status changes
+var orderStatusTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusAccepted: {
+		OrderStatusIssued,
+		OrderStatusRefused,
+		OrderStatusExpired,
+		OrderStatusReturnedToCourier,
+	},
+	OrderStatusExpired: {
+		OrderStatusReturnedToCourier,
+	},
+	OrderStatusIssued: {
+		OrderStatusReturnedByClient,
+	},
+	OrderStatusReturnedByClient: {
+		OrderStatusDispatchedToSeller,
+	},
+	OrderStatusRefused: {
+		OrderStatusDispatchedToSeller,
+	},
+	OrderStatusReturnedToCourier:  {},
+	OrderStatusDispatchedToSeller: {},
+}
+
+func (s OrderStatus) String() string {
+	return string(s)
+}
+
+func NewOrderStatus(s string) (OrderStatus, error) {
+	status := OrderStatus(s)
+	if _, ok := orderStatusTransitions[status]; !ok {
+		return OrderStatusUnknown, fmt.Errorf("unknown order status %s: %w", s, ErrInvalidArgument)
+	}
+	return status, nil
+}
+
+// CanTransitionTo checks if the order in this status can be moved to the next one
+func (s OrderStatus) CanTransitionTo(next OrderStatus) bool {
+	for _, allowed := range orderStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateTransition returns an error if the order in this status can not be moved to the next one
+func (s OrderStatus) ValidateTransition(next OrderStatus) error {
+	if !s.CanTransitionTo(next) {
+		return fmt.Errorf("%w: order in status %s can not become %s", ErrConflict, s, next)
+	}
+	return nil
+}
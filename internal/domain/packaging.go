@@ -0,0 +1,40 @@
+package domain
+
+import "slices"
+
+// PackagingSpec describes a packaging type of the catalog
+type PackagingSpec struct {
+	Type PackagingType
+	// Cost is added to the cost of the order
+	Cost Money
+	// WeightLimit is the maximum weight of the order, 0 means there is no limit
+	WeightLimit int
+	// MaxDimensions are the sizes of the largest parcel which may be packed, zero means there is no limit
+	MaxDimensions Dimensions
+	// CombinableWith is the list of packaging types which may be added on top of this one
+	CombinableWith []PackagingType
+}
+
+// CanCombineWith checks if the packaging type may be added on top of this one
+func (s PackagingSpec) CanCombineWith(packaging PackagingType) bool {
+	return slices.Contains(s.CombinableWith, packaging)
+}
+
+// FitsWeight checks if the order of the given weight may be packed
+func (s PackagingSpec) FitsWeight(weight int) bool {
+	return s.WeightLimit == 0 || weight <= s.WeightLimit
+}
+
+// FitsDimensions checks if the parcel of the given dimensions may be packed.
+// Parcels with unknown dimensions are only checked by weight
+func (s PackagingSpec) FitsDimensions(dimensions Dimensions) bool {
+	return s.MaxDimensions.IsZero() || dimensions.IsZero() || dimensions.FitsInto(s.MaxDimensions)
+}
+
+// PackagingQuote is a packaging option which is valid for the order, with the final price of the order
+type PackagingQuote struct {
+	Packaging PackagingType
+	Price     Money
+	// Cheapest marks the option with the lowest price
+	Cheapest bool
+}
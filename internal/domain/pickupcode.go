@@ -0,0 +1,73 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// PickupCodeLength is the number of digits in the pickup code
+const PickupCodeLength = 6
+
+// NewPickupCode generates a random numeric one-time pickup code
+func NewPickupCode() (string, error) {
+	limit := big.NewInt(1)
+	for i := 0; i < PickupCodeLength; i++ {
+		limit.Mul(limit, big.NewInt(10))
+	}
+
+	n, err := rand.Int(rand.Reader, limit)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate pickup code: %w", err)
+	}
+
+	return fmt.Sprintf("%0*d", PickupCodeLength, n), nil
+}
+
+// MinPickupCodeKeyLength is the minimal length of the key the pickup codes are hashed with
+const MinPickupCodeKeyLength = 16
+
+// PickupCodeKey is a secret key the pickup codes are hashed with. The codes are short, so a slow hash
+// does not protect them: the key and the lockout after MaxPickupAttempts failed attempts do
+type PickupCodeKey []byte
+
+// NewPickupCodeKey checks the secret is long enough to be the key
+func NewPickupCodeKey(secret string) (PickupCodeKey, error) {
+	if len(secret) < MinPickupCodeKeyLength {
+		return nil, fmt.Errorf("%w: pickup code key must be at least %d bytes long", ErrInvalidArgument, MinPickupCodeKeyLength)
+	}
+	return PickupCodeKey(secret), nil
+}
+
+// HashPickupCode hashes the pickup code with HMAC-SHA256, so it is never stored in plain text
+func (k PickupCodeKey) HashPickupCode(code string) string {
+	mac := hmac.New(sha256.New, k)
+	mac.Write([]byte(code))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// RequiresPickupCode checks if the order can be picked up only with the pickup code
+func (o PVZOrder) RequiresPickupCode() bool {
+	return o.PickupCodeHash != ""
+}
+
+// CheckPickupCode checks if the code matches the pickup code of the order.
+// The codes hashed with bcrypt before the key was introduced are still checked
+func (o PVZOrder) CheckPickupCode(key PickupCodeKey, code string) bool {
+	if strings.HasPrefix(o.PickupCodeHash, "$2") {
+		return bcrypt.CompareHashAndPassword([]byte(o.PickupCodeHash), []byte(code)) == nil
+	}
+	return hmac.Equal([]byte(o.PickupCodeHash), []byte(key.HashPickupCode(code)))
+}
+
+// PickupLocked checks if the pickup is locked after too many failed attempts
+func (o PVZOrder) PickupLocked(now time.Time) bool {
+	return o.PickupLockedUntil.After(now)
+}
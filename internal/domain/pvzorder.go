@@ -2,11 +2,15 @@ package domain
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"time"
 )
 
 type PackagingType string
 
+// Well-known packaging types. Box, bag and film are in the default catalog,
+// film is also used as the additional packaging of the order
 const (
 	PackagingTypeUnknown PackagingType = "unknown"
 	PackagingTypeBox     PackagingType = "box"
@@ -18,19 +22,19 @@ func (p PackagingType) String() string {
 	return string(p)
 }
 
+// packagingTypePattern is a format of the packaging type code, e.g. "large_box"
+var packagingTypePattern = regexp.MustCompile(`^[a-z0-9_]{1,32}$`)
+
+// NewPackagingType parses the packaging type code. The available types are configured
+// in the packaging catalog, so only the format of the code is checked here
 func NewPackagingType(p string) (PackagingType, error) {
-	switch p {
-	case "box":
-		return PackagingTypeBox, nil
-	case "bag":
-		return PackagingTypeBag, nil
-	case "film":
-		return PackagingTypeFilm, nil
-	default:
+	p = strings.ToLower(strings.TrimSpace(p))
+	if !packagingTypePattern.MatchString(p) {
 		return PackagingTypeUnknown, fmt.Errorf(
-			"unknown packaging type %s (available types: box, bag, film): %w", p, ErrInvalidArgument,
+			"invalid packaging type %q (lowercase letters, digits and underscores are allowed): %w", p, ErrInvalidArgument,
 		)
 	}
+	return PackagingType(p), nil
 }
 
 // PVZOrder is a struct for PVZ order
@@ -39,29 +43,137 @@ type PVZOrder struct {
 	PVZID       string
 	RecipientID string
 
-	Cost   int
+	// Cost includes the packaging fees
+	Cost Money
+	// Weight is the weight declared by the courier
 	Weight int
+	// MeasuredWeight is the weight of the parcel on the scales at the acceptance, 0 if it was not weighed
+	MeasuredWeight int
+	// WeightDiscrepancy is set if the measured weight differs from the declared one by more than the tolerance,
+	// such an order is only accepted with the override reason of the operator
+	WeightDiscrepancy    bool
+	WeightOverrideReason string
+	// Dimensions are zero for the orders accepted without them
+	Dimensions Dimensions
 
 	Packaging      PackagingType
 	AdditionalFilm bool
 
+	Status OrderStatus
+	// Version is incremented on every change of the order and is used for optimistic concurrency
+	Version int64
+
 	ReceivedAt  time.Time
 	StorageTime time.Duration
+	// Expiry is the end of the storage time counted in the business days of the PVZ calendar.
+	// If it is zero, the storage time is counted in calendar days from ReceivedAt
+	Expiry time.Time
+	// StorageExtensions is how many times the storage time has been extended
+	StorageExtensions int
+	// ExtendedBy is who extended the storage time last
+	ExtendedBy string
+	// PaidStorage is the paid period after the storage time, it is fixed at the acceptance
+	PaidStorage PaidStorage
+	// StorageFeePaid is the storage fee the client paid at the pickup
+	StorageFeePaid Money
+
+	// PickupCodeHash is a hash of the one-time code the recipient shows at pickup,
+	// empty for orders accepted before the codes were introduced
+	PickupCodeHash string
+	// PickupAttempts is the number of failed pickup attempts since the last lockout
+	PickupAttempts int
+	// PickupLockedUntil is the time until which the order can not be picked up after too many failed attempts
+	PickupLockedUntil time.Time
 
 	IssuedAt   time.Time
 	ReturnedAt time.Time
+	// ReturnDetails are recorded when the client returns the order, they are zero for the other orders
+	ReturnDetails ReturnDetails
+	// ReturnDeadline is the time until which the issued order may be returned by the client,
+	// it is counted by the PVZ calendar when the order is read and is not stored
+	ReturnDeadline time.Time
 }
 
-func NewPVZOrder(orderID, pvzID, recipientID string, cost, weight int, storageTime time.Duration, packaging PackagingType, additionalFilm bool) PVZOrder {
+func NewPVZOrder(orderID, pvzID, recipientID string, cost Money, weight int, dimensions Dimensions, storageTime time.Duration, packaging PackagingType, additionalFilm bool) PVZOrder {
 	return PVZOrder{
 		OrderID:        orderID,
 		PVZID:          pvzID,
 		RecipientID:    recipientID,
 		Cost:           cost,
 		Weight:         weight,
+		Dimensions:     dimensions,
 		Packaging:      packaging,
 		AdditionalFilm: additionalFilm,
+		Status:         OrderStatusAccepted,
+		Version:        1,
 		ReceivedAt:     time.Now().UTC(),
 		StorageTime:    storageTime,
 	}
 }
+
+// ActualWeight returns the measured weight if the parcel was weighed and the declared one otherwise
+func (o PVZOrder) ActualWeight() int {
+	if o.MeasuredWeight > 0 {
+		return o.MeasuredWeight
+	}
+	return o.Weight
+}
+
+// ChargeableWeight returns the greater of the actual and the volumetric weight,
+// so a light but large parcel is treated as a heavy one
+func (o PVZOrder) ChargeableWeight() int {
+	return max(o.ActualWeight(), o.Dimensions.VolumetricWeight())
+}
+
+// SetMeasuredWeight records the weight of the parcel on the scales. If it differs from the declared one
+// by more than the tolerance in percent, the order is flagged and the override reason of the operator is required
+func (o *PVZOrder) SetMeasuredWeight(measured, tolerancePercent int, overrideReason string) error {
+	if measured < 0 {
+		return fmt.Errorf("%w: measured weight is negative", ErrInvalidArgument)
+	}
+
+	o.MeasuredWeight = measured
+	o.WeightDiscrepancy = false
+	o.WeightOverrideReason = ""
+
+	if measured == 0 || !WeightMismatch(o.Weight, measured, tolerancePercent) {
+		return nil
+	}
+
+	if overrideReason == "" {
+		return fmt.Errorf(
+			"%w: measured weight %d differs from declared %d by more than %d%%, override reason is required",
+			ErrInvalidArgument, measured, o.Weight, tolerancePercent,
+		)
+	}
+
+	o.WeightDiscrepancy = true
+	o.WeightOverrideReason = overrideReason
+
+	return nil
+}
+
+// WeightMismatch checks if the measured weight differs from the declared one by more than the tolerance in percent
+func WeightMismatch(declared, measured, tolerancePercent int) bool {
+	diff := measured - declared
+	if diff < 0 {
+		diff = -diff
+	}
+	return diff*100 > declared*tolerancePercent
+}
+
+// WeightDiscrepancyReport is a daily report of the orders of the PVZ accepted with the weight discrepancy
+type WeightDiscrepancyReport struct {
+	PVZID string
+	// Date is the start of the day the orders were accepted on
+	Date   time.Time
+	Orders []PVZOrder
+}
+
+// ExpiresAt returns the time when the storage time of the order is over
+func (o PVZOrder) ExpiresAt() time.Time {
+	if !o.Expiry.IsZero() {
+		return o.Expiry
+	}
+	return o.ReceivedAt.Add(o.StorageTime)
+}
@@ -0,0 +1,158 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// DefaultReturnWindow is how long the client may return the issued order in the PVZs without their own return window
+const DefaultReturnWindow = 2 * 24 * time.Hour
+
+// PVZPolicyRule is a rule of the PVZ policy the administrator may set
+type PVZPolicyRule string
+
+const (
+	PVZPolicyRuleReturnWindow     PVZPolicyRule = "return_window"
+	PVZPolicyRuleMaxStorageTime   PVZPolicyRule = "max_storage_time"
+	PVZPolicyRuleMaxOrderWeight   PVZPolicyRule = "max_order_weight"
+	PVZPolicyRuleAllowedPackaging PVZPolicyRule = "allowed_packaging"
+)
+
+func (r PVZPolicyRule) String() string {
+	return string(r)
+}
+
+// PVZPolicy is a set of the rules of the PVZ the administrator may change.
+// Only the rules listed in SetRules are taken from the stored policy, the defaults of the service are used for the rest
+type PVZPolicy struct {
+	PVZID string
+
+	// ReturnWindow is how long the client may return the issued order, counted in the business days of the PVZ calendar
+	ReturnWindow time.Duration
+	// MaxStorageTime is the maximum storage time of an order including the extensions
+	MaxStorageTime time.Duration
+	// MaxOrderWeight is the maximum weight of an order the PVZ accepts, 0 means there is no limit
+	MaxOrderWeight int
+	// AllowedPackaging are the packaging types the PVZ accepts, empty means all types of the catalog
+	AllowedPackaging []PackagingType
+	// SetRules are the rules the administrator has set, so the zero limits and the empty list of packaging
+	// of the stored policy are in effect as is instead of the defaults
+	SetRules []PVZPolicyRule
+
+	// Version is incremented on every update of the stored policy, 0 means the PVZ has no stored policy
+	Version   int64
+	UpdatedBy string
+	UpdatedAt time.Time
+}
+
+// Validate checks the rules of the policy are consistent
+func (p PVZPolicy) Validate() error {
+	if p.PVZID == "" {
+		return fmt.Errorf("%w: pvz id is empty", ErrInvalidArgument)
+	}
+	if p.ReturnWindow < 0 || p.MaxStorageTime < 0 {
+		return fmt.Errorf("%w: return window and max storage time must not be negative", ErrInvalidArgument)
+	}
+	if p.MaxOrderWeight < 0 {
+		return fmt.Errorf("%w: max order weight must not be negative", ErrInvalidArgument)
+	}
+	if p.IsSet(PVZPolicyRuleReturnWindow) && p.ReturnWindow == 0 {
+		return fmt.Errorf("%w: return window must be positive", ErrInvalidArgument)
+	}
+
+	set := make(map[PVZPolicyRule]struct{}, len(p.SetRules))
+	for _, rule := range p.SetRules {
+		switch rule {
+		case PVZPolicyRuleReturnWindow, PVZPolicyRuleMaxStorageTime, PVZPolicyRuleMaxOrderWeight, PVZPolicyRuleAllowedPackaging:
+		default:
+			return fmt.Errorf("%w: unknown policy rule %s", ErrInvalidArgument, rule)
+		}
+		if _, ok := set[rule]; ok {
+			return fmt.Errorf("%w: duplicate policy rule %s", ErrInvalidArgument, rule)
+		}
+		set[rule] = struct{}{}
+	}
+
+	// The rule with a value which is not set would be silently replaced by the default
+	values := []struct {
+		rule     PVZPolicyRule
+		hasValue bool
+	}{
+		{PVZPolicyRuleReturnWindow, p.ReturnWindow != 0},
+		{PVZPolicyRuleMaxStorageTime, p.MaxStorageTime != 0},
+		{PVZPolicyRuleMaxOrderWeight, p.MaxOrderWeight != 0},
+		{PVZPolicyRuleAllowedPackaging, len(p.AllowedPackaging) > 0},
+	}
+	for _, v := range values {
+		if _, ok := set[v.rule]; v.hasValue && !ok {
+			return fmt.Errorf("%w: policy rule %s has a value but is not set", ErrInvalidArgument, v.rule)
+		}
+	}
+
+	known := make(map[PackagingType]struct{}, len(p.AllowedPackaging))
+	for _, packaging := range p.AllowedPackaging {
+		if _, err := NewPackagingType(packaging.String()); err != nil {
+			return err
+		}
+		if _, ok := known[packaging]; ok {
+			return fmt.Errorf("%w: duplicate allowed packaging type %s", ErrInvalidArgument, packaging)
+		}
+		known[packaging] = struct{}{}
+	}
+
+	return nil
+}
+
+// IsSet checks if the administrator has set the rule
+func (p PVZPolicy) IsSet(rule PVZPolicyRule) bool {
+	return slices.Contains(p.SetRules, rule)
+}
+
+// WithOverrides returns the policy with the rules set in the stored policy of the PVZ
+func (p PVZPolicy) WithOverrides(stored PVZPolicy) PVZPolicy {
+	if stored.IsSet(PVZPolicyRuleReturnWindow) {
+		p.ReturnWindow = stored.ReturnWindow
+	}
+	if stored.IsSet(PVZPolicyRuleMaxStorageTime) {
+		p.MaxStorageTime = stored.MaxStorageTime
+	}
+	if stored.IsSet(PVZPolicyRuleMaxOrderWeight) {
+		p.MaxOrderWeight = stored.MaxOrderWeight
+	}
+	if stored.IsSet(PVZPolicyRuleAllowedPackaging) {
+		p.AllowedPackaging = stored.AllowedPackaging
+	}
+
+	p.SetRules = stored.SetRules
+	p.Version = stored.Version
+	p.UpdatedBy = stored.UpdatedBy
+	p.UpdatedAt = stored.UpdatedAt
+
+	return p
+}
+
+// AllowsPackaging checks if the PVZ accepts the orders in the packaging
+func (p PVZPolicy) AllowsPackaging(packaging PackagingType) bool {
+	return len(p.AllowedPackaging) == 0 || slices.Contains(p.AllowedPackaging, packaging)
+}
+
+// ValidateDelivery checks the PVZ accepts the order for the storage time
+func (p PVZPolicy) ValidateDelivery(order PVZOrder) error {
+	if p.MaxStorageTime > 0 && order.StorageTime > p.MaxStorageTime {
+		return fmt.Errorf("%w: storage time can not exceed %s in this PVZ", ErrInvalidArgument, p.MaxStorageTime)
+	}
+
+	if p.MaxOrderWeight > 0 && order.ActualWeight() > p.MaxOrderWeight {
+		return fmt.Errorf("%w: order weight %d exceeds the limit %d of this PVZ", ErrInvalidArgument, order.ActualWeight(), p.MaxOrderWeight)
+	}
+
+	if !p.AllowsPackaging(order.Packaging) {
+		return fmt.Errorf("%w: packaging type %s is not accepted in this PVZ", ErrInvalidArgument, order.Packaging)
+	}
+	if order.AdditionalFilm && !p.AllowsPackaging(PackagingTypeFilm) {
+		return fmt.Errorf("%w: packaging type %s is not accepted in this PVZ", ErrInvalidArgument, PackagingTypeFilm)
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"fmt"
+	"github.com/google/uuid"
+	"time"
+)
+
+// ReturnShipmentStatus is a status of the shipment of the returned orders back to the seller
+type ReturnShipmentStatus string
+
+const (
+	ReturnShipmentStatusUnknown ReturnShipmentStatus = "unknown"
+	// ReturnShipmentStatusOpen means the orders are grouped, but the courier has not taken them yet
+	ReturnShipmentStatusOpen       ReturnShipmentStatus = "open"
+	ReturnShipmentStatusDispatched ReturnShipmentStatus = "dispatched"
+)
+
+func (s ReturnShipmentStatus) String() string {
+	return string(s)
+}
+
+func NewReturnShipmentStatus(s string) (ReturnShipmentStatus, error) {
+	switch s {
+	case ReturnShipmentStatusOpen.String():
+		return ReturnShipmentStatusOpen, nil
+	case ReturnShipmentStatusDispatched.String():
+		return ReturnShipmentStatusDispatched, nil
+	default:
+		return ReturnShipmentStatusUnknown, fmt.Errorf("%w: unknown return shipment status %s", ErrInvalidArgument, s)
+	}
+}
+
+// ReturnShipment is a group of the orders returned by the clients or refused at pickup
+// the courier takes from the PVZ back to the seller. An order is included in one shipment at most
+type ReturnShipment struct {
+	ID       string
+	PVZID    string
+	Status   ReturnShipmentStatus
+	OrderIDs []string
+	// CourierID is set when the shipment is dispatched
+	CourierID    string
+	CreatedAt    time.Time
+	DispatchedAt time.Time
+	// Manifest is set when the shipment is dispatched
+	Manifest *ReturnManifest
+}
+
+// NewReturnShipment opens a shipment of the returned orders of the PVZ
+func NewReturnShipment(pvzID string, orderIDs []string) ReturnShipment {
+	return ReturnShipment{
+		ID:        uuid.NewString(),
+		PVZID:     pvzID,
+		Status:    ReturnShipmentStatusOpen,
+		OrderIDs:  orderIDs,
+		CreatedAt: time.Now(),
+	}
+}
+
+// IsOpen checks if the shipment can still be dispatched
+func (s ReturnShipment) IsOpen() bool {
+	return s.Status == ReturnShipmentStatusOpen
+}
+
+// ValidateOrders checks every order of the shipment is found, belongs to the PVZ of the shipment
+// and can be dispatched to the seller
+func (s ReturnShipment) ValidateOrders(orders []PVZOrder) error {
+	byID := make(map[string]PVZOrder, len(orders))
+	for _, order := range orders {
+		byID[order.OrderID] = order
+	}
+
+	for _, orderID := range s.OrderIDs {
+		order, ok := byID[orderID]
+		if !ok {
+			return fmt.Errorf("%w: order %s not found", ErrNotFound, orderID)
+		}
+
+		if order.PVZID != s.PVZID {
+			return fmt.Errorf("%w: order %s does not belong to this PVZ", ErrInvalidArgument, orderID)
+		}
+
+		if !order.Status.CanTransitionTo(OrderStatusDispatchedToSeller) {
+			return fmt.Errorf("%w: order %s in status %s can not become %s", ErrConflict, orderID, order.Status, OrderStatusDispatchedToSeller)
+		}
+	}
+
+	return nil
+}
+
+// ReturnManifestItem is an order of the return shipment as the seller receives it
+type ReturnManifestItem struct {
+	OrderID     string
+	RecipientID string
+	// Status is the status of the order before it was dispatched: returned by the client or refused at pickup
+	Status    OrderStatus
+	Cost      Money
+	Weight    int
+	Packaging PackagingType
+	// ReturnDetails are zero for the refused orders
+	ReturnDetails ReturnDetails
+	ReturnedAt    time.Time
+}
+
+// ReturnManifest is a list of the orders of the shipment the courier takes to the seller
+type ReturnManifest struct {
+	ShipmentID   string
+	PVZID        string
+	CourierID    string
+	Items        []ReturnManifestItem
+	DispatchedAt time.Time
+}
+
+// NewManifest makes the manifest of the shipment for the courier, the items are in the order of the shipment
+func (s ReturnShipment) NewManifest(courierID string, orders []PVZOrder, dispatchedAt time.Time) ReturnManifest {
+	byID := make(map[string]PVZOrder, len(orders))
+	for _, order := range orders {
+		byID[order.OrderID] = order
+	}
+
+	manifest := ReturnManifest{
+		ShipmentID:   s.ID,
+		PVZID:        s.PVZID,
+		CourierID:    courierID,
+		Items:        make([]ReturnManifestItem, 0, len(s.OrderIDs)),
+		DispatchedAt: dispatchedAt,
+	}
+
+	for _, orderID := range s.OrderIDs {
+		order, ok := byID[orderID]
+		if !ok {
+			continue
+		}
+
+		manifest.Items = append(manifest.Items, ReturnManifestItem{
+			OrderID:       order.OrderID,
+			RecipientID:   order.RecipientID,
+			Status:        order.Status,
+			Cost:          order.Cost,
+			Weight:        order.ActualWeight(),
+			Packaging:     order.Packaging,
+			ReturnDetails: order.ReturnDetails,
+			ReturnedAt:    order.ReturnedAt,
+		})
+	}
+
+	return manifest
+}
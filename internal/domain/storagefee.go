@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// storageFeeDay is a period the storage fee is charged for
+const storageFeeDay = 24 * time.Hour
+
+// PaidStorage is a paid period after the free storage time during which the order may still be issued
+type PaidStorage struct {
+	// Days is the length of the paid period, 0 means the order can not be issued after the free storage time
+	Days int
+	// DailyFee is charged for every started day of the paid period
+	DailyFee Money
+}
+
+// IsEnabled checks if the order may be stored after the free storage time
+func (p PaidStorage) IsEnabled() bool {
+	return p.Days > 0
+}
+
+// Validate checks the paid storage is consistent
+func (p PaidStorage) Validate() error {
+	if p.Days < 0 {
+		return fmt.Errorf("%w: paid storage days must not be negative", ErrInvalidArgument)
+	}
+	if p.DailyFee.IsNegative() {
+		return fmt.Errorf("%w: daily storage fee must not be negative", ErrInvalidArgument)
+	}
+	return nil
+}
+
+// PaidStorageUntil returns the time until which the order may be issued, it is the end of the free storage time
+// if there is no paid storage
+func (o PVZOrder) PaidStorageUntil() time.Time {
+	return o.ExpiresAt().Add(time.Duration(o.PaidStorage.Days) * storageFeeDay)
+}
+
+// IsStorageOver checks if the order can no longer be issued and may only be returned to the courier
+func (o PVZOrder) IsStorageOver(now time.Time) bool {
+	return o.PaidStorageUntil().Before(now)
+}
+
+// StorageFee returns the fee accrued on the order by now: the daily fee for every started day after the free storage
+// time, but not more than for the paid storage days. The fee is in the currency of the daily fee
+func (o PVZOrder) StorageFee(now time.Time) Money {
+	fee := NewMoney(0, o.PaidStorage.DailyFee.Currency)
+
+	overdue := now.Sub(o.ExpiresAt())
+	if overdue <= 0 || !o.PaidStorage.IsEnabled() {
+		return fee
+	}
+
+	days := int64((overdue + storageFeeDay - 1) / storageFeeDay)
+	days = min(days, int64(o.PaidStorage.Days))
+
+	if o.PaidStorage.DailyFee.Amount > math.MaxInt64/days {
+		fee.Amount = math.MaxInt64
+		return fee
+	}
+
+	fee.Amount = o.PaidStorage.DailyFee.Amount * days
+	return fee
+}
+
+// ValidateStorageFeePaid checks the client pays exactly the storage fee accrued on the order by now
+func (o PVZOrder) ValidateStorageFeePaid(now time.Time, paid Money) error {
+	fee := o.StorageFee(now)
+
+	if fee.IsZero() {
+		if !paid.IsZero() {
+			return fmt.Errorf("%w: order %s has no storage fee, but %s is paid", ErrInvalidArgument, o.OrderID, paid)
+		}
+		return nil
+	}
+
+	if paid.Amount != fee.Amount || paid.Currency != fee.Currency {
+		return fmt.Errorf("%w: storage fee %s of order %s must be settled, %s is paid", ErrInvalidArgument, fee, o.OrderID, paid)
+	}
+
+	return nil
+}
@@ -29,11 +29,34 @@ func NewPVZOrder(ttl time.Duration, maxItems int, invalidationStrategy Invalidat
 	}
 }
 
+// getOrdersKey builds a cache key from the resolved options, since the option
+// functions themselves can not be told apart once formatted
+func getOrdersKey(userID string, options ...abstractions.GetOrdersOptFunc) (string, error) {
+	opts, err := abstractions.NewGetOrdersOptions(options...)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("GetOrders:%s:%+v", userID, *opts), nil
+}
+
+func getReturnsKey(pvzID string, options ...abstractions.PagePaginationOptFunc) (string, error) {
+	opts, err := abstractions.NewPaginationOptions(options...)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("GetReturns:%s:%+v", pvzID, *opts), nil
+}
+
 func (P PVZOrder) GetOrders(ctx context.Context, userID string, options ...abstractions.GetOrdersOptFunc) ([]domain.PVZOrder, error, bool) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "pvzOrderCache.GetOrders")
 	defer span.Finish()
 
-	key := fmt.Sprintf("GetOrders:%s:%v", userID, options)
+	key, err := getOrdersKey(userID, options...)
+	if err != nil {
+		return nil, err, false
+	}
 	log.Printf("key: %v\n", key)
 
 	v, ok := P.cache.Get(key)
@@ -50,11 +73,14 @@ func (P PVZOrder) GetOrders(ctx context.Context, userID string, options ...abstr
 	return result, nil, true
 }
 
-func (P PVZOrder) GetReturns(ctx context.Context, options ...abstractions.PagePaginationOptFunc) ([]domain.PVZOrder, error, bool) {
+func (P PVZOrder) GetReturns(ctx context.Context, pvzID string, options ...abstractions.PagePaginationOptFunc) ([]domain.PVZOrder, error, bool) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "pvzOrderCache.GetReturns")
 	defer span.Finish()
 
-	key := fmt.Sprintf("GetReturns:%v", options)
+	key, err := getReturnsKey(pvzID, options...)
+	if err != nil {
+		return nil, err, false
+	}
 	log.Printf("key: %v\n", key)
 
 	v, ok := P.cache.Get(key)
@@ -75,7 +101,10 @@ func (P PVZOrder) SetGetOrders(ctx context.Context, userID string, orders []doma
 	span, ctx := opentracing.StartSpanFromContext(ctx, "pvzOrderCache.SetGetOrders")
 	defer span.Finish()
 
-	key := fmt.Sprintf("GetOrders:%s:%v", userID, options)
+	key, err := getOrdersKey(userID, options...)
+	if err != nil {
+		return err
+	}
 	log.Printf("key: %v\n", key)
 
 	P.cache.Set(key, orders)
@@ -83,11 +112,14 @@ func (P PVZOrder) SetGetOrders(ctx context.Context, userID string, orders []doma
 	return nil
 }
 
-func (P PVZOrder) SetGetReturns(ctx context.Context, orders []domain.PVZOrder, options ...abstractions.PagePaginationOptFunc) error {
+func (P PVZOrder) SetGetReturns(ctx context.Context, pvzID string, orders []domain.PVZOrder, options ...abstractions.PagePaginationOptFunc) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "pvzOrderCache.SetGetReturns")
 	defer span.Finish()
 
-	key := fmt.Sprintf("GetReturns:%v", options)
+	key, err := getReturnsKey(pvzID, options...)
+	if err != nil {
+		return err
+	}
 	log.Printf("key: %v\n", key)
 
 	P.cache.Set(key, orders)
@@ -0,0 +1,48 @@
+package inmemmory
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+
+	"homework/internal/domain"
+	"homework/internal/usecases"
+)
+
+var _ usecases.PVZPolicyCache = &PVZPolicy{}
+
+// PVZPolicy is a cache for the stored PVZ policies. The policy updated by another instance of the service
+// is seen here after the TTL
+type PVZPolicy struct {
+	cache *Cache[string, domain.PVZPolicy]
+}
+
+// NewPVZPolicy creates a new PVZ policy cache
+func NewPVZPolicy(ttl time.Duration, maxItems int, invalidationStrategy InvalidationStrategy[string, domain.PVZPolicy]) *PVZPolicy {
+	return &PVZPolicy{
+		cache: NewCache[string, domain.PVZPolicy](
+			ttl,
+			maxItems,
+			invalidationStrategy,
+		),
+	}
+}
+
+func (P PVZPolicy) GetPolicy(ctx context.Context, pvzID string) (domain.PVZPolicy, error, bool) {
+	span, _ := opentracing.StartSpanFromContext(ctx, "pvzPolicyCache.GetPolicy")
+	defer span.Finish()
+
+	policy, ok := P.cache.Get(fmt.Sprintf("GetPolicy:%s", pvzID))
+	return policy, nil, ok
+}
+
+func (P PVZPolicy) SetPolicy(ctx context.Context, policy domain.PVZPolicy) error {
+	span, _ := opentracing.StartSpanFromContext(ctx, "pvzPolicyCache.SetPolicy")
+	defer span.Finish()
+
+	P.cache.Set(fmt.Sprintf("GetPolicy:%s", policy.PVZID), policy)
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package static
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	// The time zones of the calendars are loaded without the system zoneinfo
+	_ "time/tzdata"
+
+	"gopkg.in/yaml.v3"
+
+	"homework/internal/domain"
+)
+
+// Calendars are the working calendars of the PVZs
+type Calendars struct {
+	// Default is the calendar of the PVZs not in PVZs, the zero calendar works around the clock every day
+	Default domain.Calendar
+	PVZs    map[string]domain.Calendar
+}
+
+// calendarsFile is a format of the calendars config
+type calendarsFile struct {
+	Default *calendarItem           `json:"default" yaml:"default"`
+	PVZs    map[string]calendarItem `json:"pvzs" yaml:"pvzs"`
+}
+
+type calendarItem struct {
+	// TimeZone is the IANA time zone name, UTC if it is not set
+	TimeZone string `json:"time_zone" yaml:"time_zone"`
+	// OpensAt and ClosesAt are the working hours in format "09:00", the PVZ works around the clock if they are not set
+	OpensAt  string   `json:"opens_at" yaml:"opens_at"`
+	ClosesAt string   `json:"closes_at" yaml:"closes_at"`
+	Weekends []string `json:"weekends" yaml:"weekends"`
+	// Holidays are the dates in format "2006-01-02"
+	Holidays []string `json:"holidays" yaml:"holidays"`
+}
+
+// LoadCalendars loads the PVZ calendars from the YAML or JSON file, the format is chosen by the extension
+func LoadCalendars(path string) (Calendars, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Calendars{}, fmt.Errorf("failed to read calendars: %w", err)
+	}
+
+	var file calendarsFile
+	switch filepath.Ext(path) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &file)
+	case ".json":
+		err = json.Unmarshal(data, &file)
+	default:
+		return Calendars{}, fmt.Errorf("unsupported calendars format %s (available formats: yaml, json)", filepath.Ext(path))
+	}
+	if err != nil {
+		return Calendars{}, fmt.Errorf("failed to parse calendars: %w", err)
+	}
+
+	return newCalendars(file)
+}
+
+func newCalendars(file calendarsFile) (Calendars, error) {
+	calendars := Calendars{PVZs: make(map[string]domain.Calendar, len(file.PVZs))}
+
+	if file.Default != nil {
+		calendar, err := newCalendar(*file.Default)
+		if err != nil {
+			return Calendars{}, fmt.Errorf("default calendar: %w", err)
+		}
+		calendars.Default = calendar
+	}
+
+	for pvzID, item := range file.PVZs {
+		calendar, err := newCalendar(item)
+		if err != nil {
+			return Calendars{}, fmt.Errorf("calendar of PVZ %s: %w", pvzID, err)
+		}
+		calendars.PVZs[pvzID] = calendar
+	}
+
+	return calendars, nil
+}
+
+func newCalendar(item calendarItem) (domain.Calendar, error) {
+	location := time.UTC
+	if item.TimeZone != "" {
+		var err error
+		location, err = time.LoadLocation(item.TimeZone)
+		if err != nil {
+			return domain.Calendar{}, fmt.Errorf("%w: unknown time zone %q", domain.ErrInvalidArgument, item.TimeZone)
+		}
+	}
+
+	opensAt, err := parseTimeOfDay(item.OpensAt)
+	if err != nil {
+		return domain.Calendar{}, err
+	}
+	closesAt, err := parseTimeOfDay(item.ClosesAt)
+	if err != nil {
+		return domain.Calendar{}, err
+	}
+	// "24:00" can not be parsed, so the PVZ closing at midnight has no closing time
+	if opensAt != 0 && item.ClosesAt == "" {
+		closesAt = 24 * time.Hour
+	}
+
+	weekends := make([]time.Weekday, 0, len(item.Weekends))
+	for _, name := range item.Weekends {
+		weekday, err := domain.ParseWeekday(name)
+		if err != nil {
+			return domain.Calendar{}, err
+		}
+		weekends = append(weekends, weekday)
+	}
+
+	holidays := make([]time.Time, 0, len(item.Holidays))
+	for _, date := range item.Holidays {
+		holiday, err := time.Parse(time.DateOnly, date)
+		if err != nil {
+			return domain.Calendar{}, fmt.Errorf("%w: invalid holiday %q", domain.ErrInvalidArgument, date)
+		}
+		holidays = append(holidays, holiday)
+	}
+
+	return domain.NewCalendar(location, opensAt, closesAt, weekends, holidays)
+}
+
+// parseTimeOfDay parses the time in format "09:00" as the time since midnight, the empty string is midnight
+func parseTimeOfDay(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid time of day %q", domain.ErrInvalidArgument, s)
+	}
+
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
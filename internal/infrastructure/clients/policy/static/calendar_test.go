@@ -0,0 +1,129 @@
+package static
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"homework/internal/domain"
+)
+
+func TestLoadCalendars(t *testing.T) {
+	t.Parallel()
+
+	const yamlCalendars = `
+default:
+  time_zone: Europe/Moscow
+  opens_at: "09:00"
+  closes_at: "21:00"
+  weekends: [sunday]
+  holidays: ["2026-01-01"]
+pvzs:
+  PVZ-1:
+    weekends: [saturday, sunday]
+`
+
+	const jsonCalendars = `{"pvzs": {"PVZ-1": {"opens_at": "10:00"}}}`
+
+	moscow, err := time.LoadLocation("Europe/Moscow")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	isInvalidArgument := func(t assert.TestingT, err error, i ...interface{}) bool {
+		return assert.Error(t, err) && errors.Is(err, domain.ErrInvalidArgument)
+	}
+
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		want    Calendars
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "yaml",
+			file:    "calendars.yaml",
+			content: yamlCalendars,
+			want: Calendars{
+				Default: domain.Calendar{
+					Location: moscow,
+					OpensAt:  9 * time.Hour,
+					ClosesAt: 21 * time.Hour,
+					Weekends: []time.Weekday{time.Sunday},
+					Holidays: []time.Time{time.Date(2026, 1, 1, 0, 0, 0, 0, moscow)},
+				},
+				PVZs: map[string]domain.Calendar{
+					"PVZ-1": {Location: time.UTC, Weekends: []time.Weekday{time.Saturday, time.Sunday}, Holidays: []time.Time{}},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "json, the PVZ closes at midnight",
+			file:    "calendars.json",
+			content: jsonCalendars,
+			want: Calendars{
+				PVZs: map[string]domain.Calendar{
+					"PVZ-1": {Location: time.UTC, OpensAt: 10 * time.Hour, ClosesAt: 24 * time.Hour, Weekends: []time.Weekday{}, Holidays: []time.Time{}},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "unknown time zone",
+			file:    "calendars.yaml",
+			content: "default:\n  time_zone: Mars/Olympus\n",
+			wantErr: isInvalidArgument,
+		},
+		{
+			name:    "unknown day of the week",
+			file:    "calendars.yaml",
+			content: "pvzs:\n  PVZ-1:\n    weekends: [funday]\n",
+			wantErr: isInvalidArgument,
+		},
+		{
+			name:    "invalid holiday",
+			file:    "calendars.yaml",
+			content: "default:\n  holidays: [\"01.01.2026\"]\n",
+			wantErr: isInvalidArgument,
+		},
+		{
+			name:    "closes before it opens",
+			file:    "calendars.yaml",
+			content: "default:\n  opens_at: \"21:00\"\n  closes_at: \"09:00\"\n",
+			wantErr: isInvalidArgument,
+		},
+		{
+			name:    "no business days",
+			file:    "calendars.yaml",
+			content: "default:\n  weekends: [monday, tuesday, wednesday, thursday, friday, saturday, sunday]\n",
+			wantErr: isInvalidArgument,
+		},
+		{
+			name:    "unsupported format",
+			file:    "calendars.toml",
+			content: "",
+			wantErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), tt.file)
+			assert.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+
+			got, err := LoadCalendars(path)
+			if !tt.wantErr(t, err) || err != nil {
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package static
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"homework/internal/domain"
+	"homework/internal/usecases"
+)
+
+// DefaultMaxStorageTime is the maximum storage time used when it is not configured
+const DefaultMaxStorageTime = 30 * 24 * time.Hour
+
+// DefaultWeightTolerancePercent is how much the measured weight may differ from the declared one when it is not configured
+const DefaultWeightTolerancePercent = 10
+
+// PaidStorage configures the paid storage after the storage time. The days are per PVZ and the fees are per packaging
+type PaidStorage struct {
+	// DefaultDays is the paid storage of the PVZs not in Days, 0 disables the paid storage
+	DefaultDays int
+	Days        map[string]int
+	// DefaultDailyFee is the fee for the packagings not in DailyFees
+	DefaultDailyFee domain.Money
+	DailyFees       map[domain.PackagingType]domain.Money
+}
+
+var _ usecases.PVZPolicies = &PVZPolicies{}
+
+// PVZPolicies is a set of PVZ policies known at startup
+type PVZPolicies struct {
+	defaultMaxStorageTime  time.Duration
+	maxStorageTimes        map[string]time.Duration
+	weightTolerancePercent int
+	paidStorage            PaidStorage
+	calendars              Calendars
+}
+
+// NewPVZPolicies creates new static PVZ policies.
+// maxStorageTimes overrides defaultMaxStorageTime for the given PVZs
+func NewPVZPolicies(defaultMaxStorageTime time.Duration, maxStorageTimes map[string]time.Duration, weightTolerancePercent int, paidStorage PaidStorage, calendars Calendars) *PVZPolicies {
+	if maxStorageTimes == nil {
+		maxStorageTimes = make(map[string]time.Duration)
+	}
+	if paidStorage.Days == nil {
+		paidStorage.Days = make(map[string]int)
+	}
+	if paidStorage.DailyFees == nil {
+		paidStorage.DailyFees = make(map[domain.PackagingType]domain.Money)
+	}
+	if calendars.PVZs == nil {
+		calendars.PVZs = make(map[string]domain.Calendar)
+	}
+
+	return &PVZPolicies{
+		defaultMaxStorageTime:  defaultMaxStorageTime,
+		maxStorageTimes:        maxStorageTimes,
+		weightTolerancePercent: weightTolerancePercent,
+		paidStorage:            paidStorage,
+		calendars:              calendars,
+	}
+}
+
+// Policy returns the maximum storage time of an order in the PVZ with the default return window,
+// there are no limits on the orders
+func (p *PVZPolicies) Policy(_ context.Context, pvzID string) (domain.PVZPolicy, error) {
+	maxStorageTime, ok := p.maxStorageTimes[pvzID]
+	if !ok {
+		maxStorageTime = p.defaultMaxStorageTime
+	}
+
+	return domain.PVZPolicy{
+		PVZID:          pvzID,
+		ReturnWindow:   domain.DefaultReturnWindow,
+		MaxStorageTime: maxStorageTime,
+	}, nil
+}
+
+// WeightTolerancePercent returns how much the measured weight may differ from the declared one, the same for all PVZs
+func (p *PVZPolicies) WeightTolerancePercent(_ context.Context, _ string) (int, error) {
+	return p.weightTolerancePercent, nil
+}
+
+// PaidStorage returns the paid storage days of the PVZ with the daily fee of the packaging
+func (p *PVZPolicies) PaidStorage(_ context.Context, pvzID string, packaging domain.PackagingType) (domain.PaidStorage, error) {
+	days, ok := p.paidStorage.Days[pvzID]
+	if !ok {
+		days = p.paidStorage.DefaultDays
+	}
+	if days == 0 {
+		return domain.PaidStorage{}, nil
+	}
+
+	dailyFee, ok := p.paidStorage.DailyFees[packaging]
+	if !ok {
+		dailyFee = p.paidStorage.DefaultDailyFee
+	}
+	if dailyFee.Currency == "" {
+		dailyFee.Currency = domain.DefaultCurrency
+	}
+
+	return domain.PaidStorage{Days: days, DailyFee: dailyFee}, nil
+}
+
+// Calendar returns the working calendar of the PVZ
+func (p *PVZPolicies) Calendar(_ context.Context, pvzID string) (domain.Calendar, error) {
+	if calendar, ok := p.calendars.PVZs[pvzID]; ok {
+		return calendar, nil
+	}
+	return p.calendars.Default, nil
+}
+
+// ParseMaxStorageTimes parses the per-PVZ maximum storage times in format "PVZ-1=480h,PVZ-2=720h"
+func ParseMaxStorageTimes(s string) (map[string]time.Duration, error) {
+	maxStorageTimes := make(map[string]time.Duration)
+
+	for _, pair := range strings.Split(s, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+
+		pvzID, value, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(pvzID) == "" {
+			return nil, fmt.Errorf("%w: invalid max storage time %q", domain.ErrInvalidArgument, pair)
+		}
+
+		maxStorageTime, err := time.ParseDuration(strings.TrimSpace(value))
+		if err != nil || maxStorageTime <= 0 {
+			return nil, fmt.Errorf("%w: invalid max storage time %q", domain.ErrInvalidArgument, pair)
+		}
+
+		maxStorageTimes[strings.TrimSpace(pvzID)] = maxStorageTime
+	}
+
+	return maxStorageTimes, nil
+}
+
+// ParsePaidStorageDays parses the per-PVZ paid storage days in format "PVZ-1=3,PVZ-2=0"
+func ParsePaidStorageDays(s string) (map[string]int, error) {
+	days := make(map[string]int)
+
+	for _, pair := range strings.Split(s, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+
+		pvzID, value, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(pvzID) == "" {
+			return nil, fmt.Errorf("%w: invalid paid storage days %q", domain.ErrInvalidArgument, pair)
+		}
+
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("%w: invalid paid storage days %q", domain.ErrInvalidArgument, pair)
+		}
+
+		days[strings.TrimSpace(pvzID)] = n
+	}
+
+	return days, nil
+}
+
+// ParseDailyStorageFees parses the per-packaging daily storage fees in minor units in format "box=5000,bag=3000 RUB"
+func ParseDailyStorageFees(s string) (map[domain.PackagingType]domain.Money, error) {
+	fees := make(map[domain.PackagingType]domain.Money)
+
+	for _, pair := range strings.Split(s, ",") {
+		if pair = strings.TrimSpace(pair); pair == "" {
+			continue
+		}
+
+		code, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("%w: invalid daily storage fee %q", domain.ErrInvalidArgument, pair)
+		}
+
+		packaging, err := domain.NewPackagingType(code)
+		if err != nil {
+			return nil, err
+		}
+
+		fee, err := domain.ParseMoney(value)
+		if err != nil {
+			return nil, err
+		}
+		if fee.IsNegative() {
+			return nil, fmt.Errorf("%w: invalid daily storage fee %q", domain.ErrInvalidArgument, pair)
+		}
+
+		fees[packaging] = fee
+	}
+
+	return fees, nil
+}
@@ -0,0 +1,36 @@
+package static
+
+import (
+	"context"
+
+	"homework/internal/infrastructure/server/middleware"
+	"homework/internal/usecases"
+)
+
+var (
+	_ middleware.PVZRegistry = &PVZRegistry{}
+	_ usecases.PVZRegistry   = &PVZRegistry{}
+)
+
+// PVZRegistry is a registry of PVZs known at startup
+type PVZRegistry struct {
+	pvzIDs map[string]struct{}
+}
+
+// NewPVZRegistry creates a new static PVZ registry
+func NewPVZRegistry(pvzIDs []string) *PVZRegistry {
+	registry := &PVZRegistry{
+		pvzIDs: make(map[string]struct{}, len(pvzIDs)),
+	}
+	for _, pvzID := range pvzIDs {
+		registry.pvzIDs[pvzID] = struct{}{}
+	}
+
+	return registry
+}
+
+// Exists checks if the PVZ is known
+func (r *PVZRegistry) Exists(_ context.Context, pvzID string) (bool, error) {
+	_, ok := r.pvzIDs[pvzID]
+	return ok, nil
+}
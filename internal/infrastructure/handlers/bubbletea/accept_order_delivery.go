@@ -7,6 +7,7 @@ import (
 	"homework/internal/abstractions"
 	"homework/internal/domain"
 	"strconv"
+	"strings"
 	"time"
 )
 
@@ -16,12 +17,13 @@ const (
 	acceptOrderModelStorageTimeInput
 	acceptOrderModelWeightInput
 	acceptOrderModelCostInput
+	acceptOrderModelDimensionsInput
 	acceptOrderModelPackagingInput
 	acceptOrderModelAdditionalFilmInput
 )
 
 func initInputs() []textinput.Model {
-	inputs := make([]textinput.Model, 7)
+	inputs := make([]textinput.Model, 8)
 
 	inputs[acceptOrderModelOrderIDInput] = textinput.New()
 	inputs[acceptOrderModelOrderIDInput].Focus()
@@ -42,7 +44,11 @@ func initInputs() []textinput.Model {
 
 	inputs[acceptOrderModelCostInput] = textinput.New()
 	inputs[acceptOrderModelCostInput].Prompt = "Cost: "
-	inputs[acceptOrderModelCostInput].Placeholder = "Enter cost"
+	inputs[acceptOrderModelCostInput].Placeholder = "Enter cost in kopecks or cents with currency, e.g. 2000 USD"
+
+	inputs[acceptOrderModelDimensionsInput] = textinput.New()
+	inputs[acceptOrderModelDimensionsInput].Prompt = "Dimensions (optional): "
+	inputs[acceptOrderModelDimensionsInput].Placeholder = "Enter dimensions in centimetres, e.g. 30x20x10"
 
 	inputs[acceptOrderModelPackagingInput] = textinput.New()
 	inputs[acceptOrderModelPackagingInput].Prompt = "Packaging: "
@@ -61,6 +67,7 @@ type inputValues struct {
 	StorageTime    string
 	Weight         string
 	Cost           string
+	Dimensions     string
 	Packaging      string
 	AdditionalFilm string
 }
@@ -70,7 +77,8 @@ type validatedInputValues struct {
 	RecipientID    string
 	StorageTime    time.Duration
 	Weight         int
-	Cost           int
+	Cost           domain.Money
+	Dimensions     domain.Dimensions
 	Packaging      domain.PackagingType
 	AdditionalFilm bool
 }
@@ -107,12 +115,20 @@ func validateWeight(weight string) (int, error) {
 	return strconv.Atoi(weight)
 }
 
-func validateCost(cost string) (int, error) {
+func validateCost(cost string) (domain.Money, error) {
 	if cost == "" {
-		return 0, fmt.Errorf("cost is empty")
+		return domain.Money{}, fmt.Errorf("cost is empty")
+	}
+
+	return domain.ParseMoney(cost)
+}
+
+func validateDimensions(dimensions string) (domain.Dimensions, error) {
+	if dimensions == "" {
+		return domain.Dimensions{}, nil
 	}
 
-	return strconv.Atoi(cost)
+	return domain.ParseDimensions(dimensions)
 }
 
 func validatePackaging(packaging string) (domain.PackagingType, error) {
@@ -136,7 +152,7 @@ func validateAdditionalFilm(additionalFilm string) (bool, error) {
 }
 
 func validateInputValues(input inputValues) (validatedInputValues, error) {
-	errs := make([]error, 0, 7)
+	errs := make([]error, 0, 8)
 
 	var err error
 	var validated validatedInputValues
@@ -156,6 +172,9 @@ func validateInputValues(input inputValues) (validatedInputValues, error) {
 	validated.Cost, err = validateCost(input.Cost)
 	errs = append(errs, err)
 
+	validated.Dimensions, err = validateDimensions(input.Dimensions)
+	errs = append(errs, err)
+
 	validated.Packaging, err = validatePackaging(input.Packaging)
 	errs = append(errs, err)
 
@@ -171,7 +190,7 @@ func validateInputValues(input inputValues) (validatedInputValues, error) {
 	return validated, nil
 }
 
-func acceptOrderModelSubmit(useCase abstractions.IPVZOrderUseCase) func(values []string) error {
+func acceptOrderModelSubmit(ctx context.Context, useCase abstractions.IPVZOrderUseCase) func(values []string) error {
 	return func(values []string) error {
 		input := inputValues{
 			OrderID:        values[acceptOrderModelOrderIDInput],
@@ -179,6 +198,7 @@ func acceptOrderModelSubmit(useCase abstractions.IPVZOrderUseCase) func(values [
 			StorageTime:    values[acceptOrderModelStorageTimeInput],
 			Weight:         values[acceptOrderModelWeightInput],
 			Cost:           values[acceptOrderModelCostInput],
+			Dimensions:     values[acceptOrderModelDimensionsInput],
 			Packaging:      values[acceptOrderModelPackagingInput],
 			AdditionalFilm: values[acceptOrderModelAdditionalFilmInput],
 		}
@@ -188,18 +208,58 @@ func acceptOrderModelSubmit(useCase abstractions.IPVZOrderUseCase) func(values [
 			return err
 		}
 
-		return useCase.AcceptOrderDelivery(
-			context.Background(),
+		_, err = useCase.AcceptOrderDelivery(
+			ctx,
 			validated.OrderID, validated.RecipientID, validated.StorageTime,
-			validated.Cost, validated.Weight, validated.Packaging, validated.AdditionalFilm,
+			validated.Cost, validated.Weight, validated.Dimensions, validated.Packaging, validated.AdditionalFilm,
 		)
+		return err
+	}
+}
+
+// acceptOrderModelHint shows the packaging options with their prices as soon as weight and cost are entered,
+// the dimensions are taken into account once they are valid
+func acceptOrderModelHint(ctx context.Context, useCase abstractions.IPVZOrderUseCase) func(values []string) string {
+	return func(values []string) string {
+		weight, err := validateWeight(values[acceptOrderModelWeightInput])
+		if err != nil {
+			return ""
+		}
+		cost, err := validateCost(values[acceptOrderModelCostInput])
+		if err != nil {
+			return ""
+		}
+
+		dimensions, err := validateDimensions(values[acceptOrderModelDimensionsInput])
+		if err != nil {
+			dimensions = domain.Dimensions{}
+		}
+
+		quotes, err := useCase.QuotePackaging(ctx, cost, weight, dimensions)
+		if err != nil {
+			return ""
+		}
+		if len(quotes) == 0 {
+			return "No packaging fits the order"
+		}
+
+		lines := []string{"Packaging options:"}
+		for _, quote := range quotes {
+			line := fmt.Sprintf("  %s: %s", quote.Packaging, quote.Price)
+			if quote.Cheapest {
+				line += " (cheapest)"
+			}
+			lines = append(lines, line)
+		}
+
+		return strings.Join(lines, "\n")
 	}
 }
 
-func newAcceptOrderModel(useCase abstractions.IPVZOrderUseCase) *FormModel {
+func newAcceptOrderModel(ctx context.Context, useCase abstractions.IPVZOrderUseCase) *FormModel {
 	inputs := initInputs()
 
-	submit := acceptOrderModelSubmit(useCase)
+	submit := acceptOrderModelSubmit(ctx, useCase)
 
-	return NewFormModel(inputs, submit)
+	return NewFormModel(inputs, submit).WithHint(acceptOrderModelHint(ctx, useCase))
 }
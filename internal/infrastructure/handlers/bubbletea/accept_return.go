@@ -5,15 +5,20 @@ import (
 	"fmt"
 	"github.com/charmbracelet/bubbles/textinput"
 	"homework/internal/abstractions"
+	"homework/internal/domain"
 )
 
-func newAcceptReturnModel(useCase abstractions.IPVZOrderUseCase) *FormModel {
+func newAcceptReturnModel(ctx context.Context, useCase abstractions.IPVZOrderUseCase) *FormModel {
 	const (
 		recipientIDInput = iota
 		orderIDInput
+		reasonInput
+		inspectionInput
+		commentInput
+		versionInput
 	)
 
-	inputs := make([]textinput.Model, 2)
+	inputs := make([]textinput.Model, 6)
 
 	inputs[recipientIDInput] = textinput.New()
 	inputs[recipientIDInput].Focus()
@@ -24,6 +29,20 @@ func newAcceptReturnModel(useCase abstractions.IPVZOrderUseCase) *FormModel {
 	inputs[orderIDInput].Prompt = "Order ID: "
 	inputs[orderIDInput].Placeholder = "Enter order ID"
 
+	inputs[reasonInput] = textinput.New()
+	inputs[reasonInput].Prompt = "Reason: "
+	inputs[reasonInput].Placeholder = "defect, wrong_item, not_as_described, changed_mind or other"
+
+	inputs[inspectionInput] = textinput.New()
+	inputs[inspectionInput].Prompt = "Inspection: "
+	inputs[inspectionInput].Placeholder = "resellable, damaged or incomplete"
+
+	inputs[commentInput] = textinput.New()
+	inputs[commentInput].Prompt = "Comment: "
+	inputs[commentInput].Placeholder = "Required for the other reason"
+
+	inputs[versionInput] = newVersionInput()
+
 	submit := func(values []string) error {
 		recipientIDValue := values[recipientIDInput]
 		orderIDValue := values[orderIDInput]
@@ -36,9 +55,21 @@ func newAcceptReturnModel(useCase abstractions.IPVZOrderUseCase) *FormModel {
 			return fmt.Errorf("orderID is empty")
 		}
 
+		details, err := domain.NewReturnDetails(values[reasonInput], values[inspectionInput], values[commentInput])
+		if err != nil {
+			return err
+		}
+
+		options, err := expectedVersionOptions(values[versionInput])
+		if err != nil {
+			return err
+		}
+
 		return useCase.AcceptReturn(
-			context.Background(),
+			ctx,
 			recipientIDValue, orderIDValue,
+			details,
+			options...,
 		)
 	}
 
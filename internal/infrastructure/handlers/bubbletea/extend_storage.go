@@ -0,0 +1,66 @@
+package bubbletea
+
+import (
+	"context"
+	"fmt"
+	"github.com/charmbracelet/bubbles/textinput"
+	"homework/internal/abstractions"
+	"time"
+)
+
+func newExtendStorageModel(ctx context.Context, useCase abstractions.IPVZOrderUseCase) *FormModel {
+	const (
+		orderIDInput = iota
+		extensionInput
+		operatorIDInput
+		versionInput
+	)
+
+	inputs := make([]textinput.Model, 4)
+
+	inputs[orderIDInput] = textinput.New()
+	inputs[orderIDInput].Focus()
+	inputs[orderIDInput].Prompt = "Order ID: "
+	inputs[orderIDInput].Placeholder = "Enter order ID"
+
+	inputs[extensionInput] = textinput.New()
+	inputs[extensionInput].Prompt = "Extension: "
+	inputs[extensionInput].Placeholder = "Enter extension (e.g. 24h)"
+
+	inputs[operatorIDInput] = textinput.New()
+	inputs[operatorIDInput].Prompt = "Operator ID: "
+	inputs[operatorIDInput].Placeholder = "Enter your operator ID"
+
+	inputs[versionInput] = newVersionInput()
+
+	submit := func(values []string) error {
+		orderIDValue := values[orderIDInput]
+		operatorIDValue := values[operatorIDInput]
+
+		if orderIDValue == "" {
+			return fmt.Errorf("orderID is empty")
+		}
+
+		extension, err := time.ParseDuration(values[extensionInput])
+		if err != nil {
+			return fmt.Errorf("failed to parse extension: %w", err)
+		}
+
+		if operatorIDValue == "" {
+			return fmt.Errorf("operatorID is empty")
+		}
+
+		options, err := expectedVersionOptions(values[versionInput])
+		if err != nil {
+			return err
+		}
+
+		return useCase.ExtendStorage(
+			ctx,
+			orderIDValue, extension, operatorIDValue,
+			options...,
+		)
+	}
+
+	return NewFormModel(inputs, submit)
+}
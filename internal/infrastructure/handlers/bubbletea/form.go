@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"homework/internal/abstractions"
+	"strconv"
 )
 
 // FormModel is a model for form
@@ -15,6 +17,15 @@ type FormModel struct {
 	// submit is a function which will be called after all inputs are filled
 	// arguments are values of inputs in order they are stored in inputs slice
 	submit func(values []string) error
+
+	// hint is an optional function which describes the current values of inputs to the operator
+	hint     func(values []string) string
+	hintText string
+
+	// repeat keeps the form open after the submit, so the operator can submit it again,
+	// the inputs with the kept indexes are not cleared
+	repeat bool
+	kept   map[int]struct{}
 }
 
 // NewFormModel is a constructor for FormModel
@@ -25,6 +36,24 @@ func NewFormModel(inputs []textinput.Model, submit func(values []string) error)
 	}
 }
 
+// WithHint sets a function which is called after every change of inputs,
+// its result is shown under the inputs
+func (m *FormModel) WithHint(hint func(values []string) string) *FormModel {
+	m.hint = hint
+	return m
+}
+
+// WithRepeat keeps the form open after every successful submit and clears the inputs except the kept ones,
+// e.g. the session ID while the parcels are scanned one by one
+func (m *FormModel) WithRepeat(kept ...int) *FormModel {
+	m.repeat = true
+	m.kept = make(map[int]struct{}, len(kept))
+	for _, i := range kept {
+		m.kept[i] = struct{}{}
+	}
+	return m
+}
+
 // Init is an initialization function
 func (m *FormModel) Init() tea.Cmd {
 	return textinput.Blink
@@ -48,11 +77,23 @@ func (m *FormModel) prevInput() {
 	m.inputs[m.focusedInput].Focus()
 }
 
-func (m *FormModel) submitForm() error {
+func (m *FormModel) values() []string {
 	values := make([]string, len(m.inputs))
 	for i, input := range m.inputs {
 		values[i] = input.Value()
 	}
+	return values
+}
+
+func (m *FormModel) updateHint() {
+	if m.hint == nil {
+		return
+	}
+	m.hintText = m.hint(m.values())
+}
+
+func (m *FormModel) submitForm() error {
+	values := m.values()
 	if err := m.submit(values); err != nil {
 		return err
 	}
@@ -65,10 +106,15 @@ func (m *FormModel) reset() {
 		m.inputs[i].SetValue("")
 	}
 	m.err = nil
+	m.hintText = ""
 }
 
 func (m *FormModel) handleEnter() tea.Cmd {
 	if m.focusedInput == len(m.inputs)-1 {
+		if m.repeat {
+			m.submitAgain()
+			return nil
+		}
 		if err := m.submitForm(); err != nil {
 			m.err = err
 			return nil
@@ -79,6 +125,31 @@ func (m *FormModel) handleEnter() tea.Cmd {
 	return nil
 }
 
+// submitAgain submits the form and prepares it for the next submit
+func (m *FormModel) submitAgain() {
+	m.err = m.submit(m.values())
+	if m.err != nil {
+		return
+	}
+
+	first := -1
+	for i := range m.inputs {
+		if _, ok := m.kept[i]; ok {
+			continue
+		}
+		m.inputs[i].SetValue("")
+		if first < 0 {
+			first = i
+		}
+	}
+
+	if first >= 0 {
+		m.inputs[m.focusedInput].Blur()
+		m.focusedInput = first
+		m.inputs[m.focusedInput].Focus()
+	}
+}
+
 func (m *FormModel) handleKeyboard(msg tea.KeyMsg) tea.Cmd {
 	switch msg.Type {
 	case tea.KeyEnter:
@@ -109,6 +180,8 @@ func (m *FormModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.inputs[i], cmds[i] = m.inputs[i].Update(msg)
 	}
 
+	m.updateHint()
+
 	return m, tea.Batch(cmds...)
 }
 
@@ -121,8 +194,34 @@ func (m *FormModel) View() string {
 			s += "\n"
 		}
 	}
+	if m.hintText != "" {
+		s += fmt.Sprintf("%s\n", m.hintText)
+	}
 	if m.err != nil {
 		s += fmt.Sprintf("Error: %s\n", m.err.Error())
 	}
 	return s
 }
+
+// newVersionInput creates an optional input for the version of the order the operator has seen
+func newVersionInput() textinput.Model {
+	input := textinput.New()
+	input.Prompt = "Expected version (optional): "
+	input.Placeholder = "Enter version from the orders table"
+	return input
+}
+
+// expectedVersionOptions turns the value of the version input into mutation options,
+// so the change fails if the order has been changed while the operator was looking at it
+func expectedVersionOptions(value string) ([]abstractions.MutationOptFunc, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	version, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse expected version: %w", err)
+	}
+
+	return []abstractions.MutationOptFunc{abstractions.WithExpectedVersion(version)}, nil
+}
@@ -16,6 +16,7 @@ var _ tea.Model = &getOrdersModel{}
 
 // getOrdersModel is a model for getting orders
 type getOrdersModel struct {
+	ctx     context.Context
 	useCase abstractions.IPVZOrderUseCase
 
 	settingsForm       *FormModel
@@ -36,19 +37,21 @@ type getOrdersModel struct {
 }
 
 // newGetOrdersModel creates a new getOrdersModel
-func newGetOrdersModel(useCase abstractions.IPVZOrderUseCase, pageSize int) *getOrdersModel {
+func newGetOrdersModel(ctx context.Context, useCase abstractions.IPVZOrderUseCase, pageSize int) *getOrdersModel {
 	columns := []table.Column{
 		{Title: "ID", Width: 10},
 		{Title: "PVZ ID", Width: 10},
 		{Title: "Recipient ID", Width: 15},
 		{Title: "ReceivedAt", Width: 20},
 		{Title: "StorageTime", Width: 15},
+		{Title: "Extensions", Width: 10},
 		{Title: "IssuedAt", Width: 20},
 		{Title: "ReturnedAt", Width: 20},
 		{Title: "Weight", Width: 10},
 		{Title: "Cost", Width: 10},
 		{Title: "Packaging", Width: 10},
 		{Title: "AdditionalFilm", Width: 15},
+		{Title: "Version", Width: 10},
 	}
 	dataTable := table.New(
 		table.WithColumns(columns),
@@ -62,6 +65,7 @@ func newGetOrdersModel(useCase abstractions.IPVZOrderUseCase, pageSize int) *get
 	input.Focus()
 
 	model := &getOrdersModel{
+		ctx:     ctx,
 		useCase: useCase,
 
 		settingsFormActive: true,
@@ -300,7 +304,7 @@ func (m *getOrdersModel) updateData() error {
 		opts = append(opts, abstractions.WithSamePVZ())
 	}
 	orders, err := m.useCase.GetOrders(
-		context.Background(),
+		m.ctx,
 		m.userID,
 		opts...,
 	)
@@ -315,12 +319,14 @@ func (m *getOrdersModel) updateData() error {
 			order.RecipientID,
 			order.ReceivedAt.Format("2006-01-02 15:04:05"),
 			order.StorageTime.String(),
+			strconv.Itoa(order.StorageExtensions),
 			order.IssuedAt.Format("2006-01-02 15:04:05"),
 			order.ReturnedAt.Format("2006-01-02 15:04:05"),
 			strconv.Itoa(order.Weight),
-			strconv.Itoa(order.Cost),
+			order.Cost.String(),
 			order.Packaging.String(),
 			strconv.FormatBool(order.AdditionalFilm),
+			strconv.FormatInt(order.Version, 10),
 		}
 	}
 	m.table.SetRows(rows)
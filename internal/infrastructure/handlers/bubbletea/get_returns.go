@@ -9,6 +9,7 @@ import (
 )
 
 type getReturnsModel struct {
+	ctx     context.Context
 	useCase abstractions.IPVZOrderUseCase
 
 	table table.Model
@@ -19,7 +20,7 @@ type getReturnsModel struct {
 	changed bool
 }
 
-func newGetReturnsModel(useCase abstractions.IPVZOrderUseCase, pageSize int) *getReturnsModel {
+func newGetReturnsModel(ctx context.Context, useCase abstractions.IPVZOrderUseCase, pageSize int) *getReturnsModel {
 	columns := []table.Column{
 		{Title: "ID", Width: 10},
 		{Title: "Recipient ID", Width: 15},
@@ -32,6 +33,7 @@ func newGetReturnsModel(useCase abstractions.IPVZOrderUseCase, pageSize int) *ge
 		{Title: "Cost", Width: 10},
 		{Title: "Packaging", Width: 10},
 		{Title: "AdditionalFilm", Width: 15},
+		{Title: "Version", Width: 10},
 	}
 	dataTable := table.New(
 		table.WithColumns(columns),
@@ -40,6 +42,7 @@ func newGetReturnsModel(useCase abstractions.IPVZOrderUseCase, pageSize int) *ge
 	)
 
 	return &getReturnsModel{
+		ctx:      ctx,
 		useCase:  useCase,
 		table:    dataTable,
 		pageSize: pageSize,
@@ -103,7 +106,7 @@ func (m *getReturnsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 func (m *getReturnsModel) View() string {
 	if m.changed {
 		orders, err := m.useCase.GetReturns(
-			context.Background(),
+			m.ctx,
 			abstractions.WithPage(m.page), abstractions.WithPageSize(m.pageSize),
 		)
 		if err != nil {
@@ -120,9 +123,10 @@ func (m *getReturnsModel) View() string {
 				order.IssuedAt.Format("2006-01-02 15:04:05"),
 				order.ReturnedAt.Format("2006-01-02 15:04:05"),
 				strconv.Itoa(order.Weight),
-				strconv.Itoa(order.Cost),
+				order.Cost.String(),
 				order.Packaging.String(),
 				strconv.FormatBool(order.AdditionalFilm),
+				strconv.FormatInt(order.Version, 10),
 			}
 		}
 		m.table.SetRows(rows)
@@ -2,13 +2,15 @@ package bubbletea
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/charmbracelet/bubbles/textinput"
 	"homework/internal/abstractions"
+	"homework/internal/domain"
 	"strings"
 )
 
-func newGiveOrderToClientModel(useCase abstractions.IPVZOrderUseCase) *FormModel {
+func newGiveOrderToClientModel(ctx context.Context, useCase abstractions.IPVZOrderUseCase) *FormModel {
 	const (
 		orderIDsInput = iota
 	)
@@ -17,7 +19,7 @@ func newGiveOrderToClientModel(useCase abstractions.IPVZOrderUseCase) *FormModel
 
 	inputs[orderIDsInput] = textinput.New()
 	inputs[orderIDsInput].Focus()
-	inputs[orderIDsInput].Prompt = "Order IDs (comma separated): "
+	inputs[orderIDsInput].Prompt = "Order IDs (comma separated, order_id#pickup_code, +fee of paid storage, :reason to refuse): "
 	inputs[orderIDsInput].Placeholder = "Enter order ID"
 
 	submit := func(values []string) error {
@@ -28,11 +30,28 @@ func newGiveOrderToClientModel(useCase abstractions.IPVZOrderUseCase) *FormModel
 		}
 
 		orderIDs := strings.Split(orderIDsValue, ",")
+		decisions := make([]domain.IssueDecision, len(orderIDs))
 		for i := range orderIDs {
-			orderIDs[i] = strings.TrimSpace(orderIDs[i])
+			decision, err := domain.ParseIssueDecision(orderIDs[i])
+			if err != nil {
+				return err
+			}
+			decisions[i] = decision
 		}
 
-		return useCase.GiveOrderToClient(context.Background(), orderIDs)
+		results, err := useCase.GiveOrderToClient(ctx, decisions)
+		if err != nil {
+			return err
+		}
+
+		var errs []error
+		for _, result := range results {
+			if result.Err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", result.OrderID, result.Err))
+			}
+		}
+
+		return errors.Join(errs...)
 	}
 
 	return NewFormModel(inputs, submit)
@@ -8,13 +8,15 @@ import (
 
 // Handler is a handler for bubbletea
 type Handler struct {
-	useCase abstractions.IPVZOrderUseCase
+	useCase         abstractions.IPVZOrderUseCase
+	handoverUseCase abstractions.IHandoverUseCase
 }
 
 // NewHandler is a constructor for Handler
-func NewHandler(useCase abstractions.IPVZOrderUseCase) *Handler {
+func NewHandler(useCase abstractions.IPVZOrderUseCase, handoverUseCase abstractions.IHandoverUseCase) *Handler {
 	return &Handler{
-		useCase: useCase,
+		useCase:         useCase,
+		handoverUseCase: handoverUseCase,
 	}
 }
 
@@ -22,42 +24,60 @@ func NewHandler(useCase abstractions.IPVZOrderUseCase) *Handler {
 func (h *Handler) Run(ctx context.Context) error {
 	models := make([]MyModel, 0)
 
-	acceptOrderModel := newAcceptOrderModel(h.useCase)
+	acceptOrderModel := newAcceptOrderModel(ctx, h.useCase)
 	models = append(models, MyModel{
 		Title: "Accept order",
 		Model: acceptOrderModel,
 	})
 
-	returnOrderModel := newReturnOrderModel(h.useCase)
+	returnOrderModel := newReturnOrderModel(ctx, h.useCase)
 	models = append(models, MyModel{
 		Title: "Return order",
 		Model: returnOrderModel,
 	})
 
-	giveOrderToClientModel := newGiveOrderToClientModel(h.useCase)
+	giveOrderToClientModel := newGiveOrderToClientModel(ctx, h.useCase)
 	models = append(models, MyModel{
 		Title: "Give order to client",
 		Model: giveOrderToClientModel,
 	})
 
-	getOrdersModel := newGetOrdersModel(h.useCase, 10)
+	extendStorageModel := newExtendStorageModel(ctx, h.useCase)
+	models = append(models, MyModel{
+		Title: "Extend storage",
+		Model: extendStorageModel,
+	})
+
+	getOrdersModel := newGetOrdersModel(ctx, h.useCase, 10)
 	models = append(models, MyModel{
 		Title: "Get orders",
 		Model: getOrdersModel,
 	})
 
-	acceptReturnModel := newAcceptReturnModel(h.useCase)
+	acceptReturnModel := newAcceptReturnModel(ctx, h.useCase)
 	models = append(models, MyModel{
 		Title: "Accept return",
 		Model: acceptReturnModel,
 	})
 
-	getReturnsModel := newGetReturnsModel(h.useCase, 10)
+	getReturnsModel := newGetReturnsModel(ctx, h.useCase, 10)
 	models = append(models, MyModel{
 		Title: "Get returns",
 		Model: getReturnsModel,
 	})
 
+	scanHandoverParcelModel := newScanHandoverParcelModel(ctx, h.handoverUseCase)
+	models = append(models, MyModel{
+		Title: "Scan handover parcels",
+		Model: scanHandoverParcelModel,
+	})
+
+	closeHandoverSessionModel := newCloseHandoverSessionModel(ctx, h.handoverUseCase)
+	models = append(models, MyModel{
+		Title: "Close handover session",
+		Model: closeHandoverSessionModel,
+	})
+
 	p := tea.NewProgram(
 		NewEntryPointModel(models),
 		tea.WithMouseCellMotion(),
@@ -0,0 +1,134 @@
+package bubbletea
+
+import (
+	"context"
+	"fmt"
+	"github.com/charmbracelet/bubbles/textinput"
+	"homework/internal/abstractions"
+	"homework/internal/domain"
+	"strconv"
+	"strings"
+)
+
+// newScanHandoverParcelModel scans the parcels of the open handover session one by one,
+// the session ID is kept between the scans and the result of the last scan is shown under the inputs
+func newScanHandoverParcelModel(ctx context.Context, useCase abstractions.IHandoverUseCase) *FormModel {
+	const (
+		sessionIDInput = iota
+		orderIDInput
+		weightInput
+	)
+
+	inputs := make([]textinput.Model, 3)
+
+	inputs[sessionIDInput] = textinput.New()
+	inputs[sessionIDInput].Focus()
+	inputs[sessionIDInput].Prompt = "Session ID: "
+	inputs[sessionIDInput].Placeholder = "Enter handover session ID"
+
+	inputs[orderIDInput] = textinput.New()
+	inputs[orderIDInput].Prompt = "Order ID: "
+	inputs[orderIDInput].Placeholder = "Scan order ID"
+
+	inputs[weightInput] = textinput.New()
+	inputs[weightInput].Prompt = "Measured weight (optional): "
+	inputs[weightInput].Placeholder = "Enter weight on the scales"
+
+	var lastScan string
+
+	submit := func(values []string) error {
+		sessionIDValue := values[sessionIDInput]
+		orderIDValue := values[orderIDInput]
+
+		if sessionIDValue == "" {
+			return fmt.Errorf("sessionID is empty")
+		}
+
+		if orderIDValue == "" {
+			return fmt.Errorf("orderID is empty")
+		}
+
+		var weight int
+		if values[weightInput] != "" {
+			var err error
+			weight, err = strconv.Atoi(values[weightInput])
+			if err != nil {
+				return fmt.Errorf("failed to parse weight: %w", err)
+			}
+		}
+
+		scan, err := useCase.ScanHandoverParcel(ctx, sessionIDValue, orderIDValue, weight)
+		if err != nil {
+			return err
+		}
+
+		lastScan = fmt.Sprintf("Order %s: %s", scan.OrderID, scan.Result)
+		if scan.Reason != "" {
+			lastScan += " (" + scan.Reason + ")"
+		}
+
+		return nil
+	}
+
+	hint := func([]string) string {
+		return lastScan
+	}
+
+	return NewFormModel(inputs, submit).WithHint(hint).WithRepeat(sessionIDInput)
+}
+
+// newCloseHandoverSessionModel closes the handover session and shows the discrepancy report
+func newCloseHandoverSessionModel(ctx context.Context, useCase abstractions.IHandoverUseCase) *FormModel {
+	inputs := make([]textinput.Model, 1)
+
+	inputs[0] = textinput.New()
+	inputs[0].Focus()
+	inputs[0].Prompt = "Session ID: "
+	inputs[0].Placeholder = "Enter handover session ID"
+
+	var report string
+
+	submit := func(values []string) error {
+		if values[0] == "" {
+			return fmt.Errorf("sessionID is empty")
+		}
+
+		closed, err := useCase.CloseHandoverSession(ctx, values[0])
+		if err != nil {
+			return err
+		}
+
+		report = formatHandoverReport(closed)
+
+		return nil
+	}
+
+	hint := func([]string) string {
+		return report
+	}
+
+	return NewFormModel(inputs, submit).WithHint(hint).WithRepeat()
+}
+
+func formatHandoverReport(report domain.HandoverReport) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "Session %s is closed\n", report.SessionID)
+	fmt.Fprintf(&b, "Accepted: %s\n", strings.Join(report.Accepted, ", "))
+	fmt.Fprintf(&b, "Missing: %s\n", strings.Join(report.Missing, ", "))
+	fmt.Fprintf(&b, "Unexpected: %s\n", strings.Join(report.Unexpected, ", "))
+
+	mismatches := make([]string, 0, len(report.WeightMismatches))
+	for _, mismatch := range report.WeightMismatches {
+		mismatches = append(mismatches, fmt.Sprintf("%s (declared %d, measured %d)", mismatch.OrderID, mismatch.DeclaredWeight, mismatch.MeasuredWeight))
+	}
+	fmt.Fprintf(&b, "Weight mismatches: %s\n", strings.Join(mismatches, ", "))
+
+	rejected := make([]string, 0, len(report.Rejected))
+	for _, rejection := range report.Rejected {
+		rejected = append(rejected, fmt.Sprintf("%s (%s)", rejection.OrderID, rejection.Reason))
+	}
+	fmt.Fprintf(&b, "Rejected: %s", strings.Join(rejected, ", "))
+
+	return b.String()
+}
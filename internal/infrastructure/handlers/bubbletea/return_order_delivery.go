@@ -7,18 +7,21 @@ import (
 	"homework/internal/abstractions"
 )
 
-func newReturnOrderModel(useCase abstractions.IPVZOrderUseCase) *FormModel {
+func newReturnOrderModel(ctx context.Context, useCase abstractions.IPVZOrderUseCase) *FormModel {
 	const (
 		orderIDInput = iota
+		versionInput
 	)
 
-	inputs := make([]textinput.Model, 1)
+	inputs := make([]textinput.Model, 2)
 
 	inputs[orderIDInput] = textinput.New()
 	inputs[orderIDInput].Focus()
 	inputs[orderIDInput].Prompt = "Order ID: "
 	inputs[orderIDInput].Placeholder = "Enter order ID"
 
+	inputs[versionInput] = newVersionInput()
+
 	submit := func(values []string) error {
 		orderIDValue := values[orderIDInput]
 
@@ -26,9 +29,15 @@ func newReturnOrderModel(useCase abstractions.IPVZOrderUseCase) *FormModel {
 			return fmt.Errorf("orderID is empty")
 		}
 
+		options, err := expectedVersionOptions(values[versionInput])
+		if err != nil {
+			return err
+		}
+
 		return useCase.ReturnOrderDelivery(
-			context.Background(),
+			ctx,
 			orderIDValue,
+			options...,
 		)
 	}
 
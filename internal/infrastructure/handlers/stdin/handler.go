@@ -12,11 +12,15 @@ import (
 
 const (
 	AcceptDeliveryCommand      Command = "accept-delivery"
+	BatchAcceptDeliveryCommand Command = "batch-accept-delivery"
 	AcceptReturnCommand        Command = "accept-return"
 	GetOrdersCommand           Command = "get-orders"
 	GetReturnsCommand          Command = "get-returns"
 	GiveOrderToClientCommand   Command = "give-orders"
 	ReturnOrderDeliveryCommand Command = "return-delivery"
+	GetOrderHistoryCommand     Command = "get-order-history"
+	ExtendStorageCommand       Command = "extend-storage"
+	WeightReportCommand        Command = "weight-report"
 )
 
 type Handler struct {
@@ -35,11 +39,15 @@ func NewHandler(useCase abstractions.IPVZOrderUseCase, numOfWorkers int) *Handle
 
 func (h *Handler) Run(ctx context.Context) error {
 	h.srv.AddHandler(AcceptDeliveryCommand, h.AcceptDeliveryHandler)
+	h.srv.AddHandler(BatchAcceptDeliveryCommand, h.BatchAcceptDeliveryHandler)
 	h.srv.AddHandler(AcceptReturnCommand, h.AcceptReturnHandler)
 	h.srv.AddHandler(GetOrdersCommand, h.GetOrdersHandler)
 	h.srv.AddHandler(GetReturnsCommand, h.GetReturnsHandler)
 	h.srv.AddHandler(GiveOrderToClientCommand, h.GiveOrderToClientHandler)
 	h.srv.AddHandler(ReturnOrderDeliveryCommand, h.ReturnOrderDeliveryHandler)
+	h.srv.AddHandler(GetOrderHistoryCommand, h.GetOrderHistoryHandler)
+	h.srv.AddHandler(ExtendStorageCommand, h.ExtendStorageHandler)
+	h.srv.AddHandler(WeightReportCommand, h.WeightReportHandler)
 
 	return h.srv.Run(ctx)
 }
@@ -48,89 +56,205 @@ func (h *Handler) Stop() {
 	h.srv.Stop()
 }
 
-func (h *Handler) AcceptDeliveryHandler(ctx context.Context, args []string) (string, error) {
-	usage := "<order_id> <recipient_id> <storage_time: 1h30m> <cost> <weight> <packaging> ?<additional_film: bool>"
+// acceptDeliveryUsage is a format of one parcel in the accept-delivery commands
+const acceptDeliveryUsage = "<order_id> <recipient_id> <storage_time: 1h30m> <cost: 2000 or 2000USD> <weight> <packaging> ?<additional_film: bool> ?<dimensions: 30x20x10 or -> ?<measured_weight> ?<override_reason...>"
+
+// batchItemSeparator separates the parcels in the batch-accept-delivery command
+const batchItemSeparator = ";"
 
-	if len(args) < 6 || len(args) > 7 {
-		return "", fmt.Errorf("invalid number of arguments, expected 6 or 7, got %d. Usage: %s", len(args), usage)
+func parseDeliveryItem(args []string) (domain.DeliveryItem, error) {
+	if len(args) < 6 {
+		return domain.DeliveryItem{}, fmt.Errorf("invalid number of arguments, expected at least 6, got %d. Usage: %s", len(args), acceptDeliveryUsage)
 	}
 
-	var input struct {
-		OrderID        string
-		RecipientID    string
-		StorageTime    time.Duration
-		Cost           int
-		Weight         int
-		Packaging      domain.PackagingType
-		AdditionalFilm bool
+	var (
+		item domain.DeliveryItem
+		err  error
+	)
+
+	item.OrderID = args[0]
+
+	item.RecipientID = args[1]
+
+	item.StorageTime, err = time.ParseDuration(args[2])
+	if err != nil {
+		return domain.DeliveryItem{}, fmt.Errorf("failed to parse storage time: %w", err)
 	}
-	{
-		var err error
 
-		input.OrderID = args[0]
+	if item.StorageTime < 0 {
+		return domain.DeliveryItem{}, fmt.Errorf("storage time is negative")
+	}
 
-		input.RecipientID = args[1]
+	item.Cost, err = domain.ParseMoney(args[3])
+	if err != nil {
+		return domain.DeliveryItem{}, fmt.Errorf("failed to parse cost: %w", err)
+	}
 
-		input.StorageTime, err = time.ParseDuration(args[2])
-		if err != nil {
-			return "", fmt.Errorf("failed to parse storage time: %w", err)
-		}
+	item.Weight, err = strconv.Atoi(args[4])
+	if err != nil {
+		return domain.DeliveryItem{}, fmt.Errorf("failed to parse weight: %w", err)
+	}
 
-		if input.StorageTime < 0 {
-			return "", fmt.Errorf("storage time is negative")
-		}
+	item.Packaging, err = domain.NewPackagingType(args[5])
+	if err != nil {
+		return domain.DeliveryItem{}, fmt.Errorf("failed to parse packaging: %w", err)
+	}
 
-		input.Cost, err = strconv.Atoi(args[3])
+	if len(args) >= 7 {
+		item.AdditionalFilm, err = strconv.ParseBool(args[6])
 		if err != nil {
-			return "", fmt.Errorf("failed to parse cost: %w", err)
+			return domain.DeliveryItem{}, fmt.Errorf("failed to parse additional film: %w", err)
 		}
+	}
 
-		input.Weight, err = strconv.Atoi(args[4])
+	// "-" skips the dimensions if only the measured weight is known
+	if len(args) >= 8 && args[7] != "-" {
+		item.Dimensions, err = domain.ParseDimensions(args[7])
 		if err != nil {
-			return "", fmt.Errorf("failed to parse weight: %w", err)
+			return domain.DeliveryItem{}, fmt.Errorf("failed to parse dimensions: %w", err)
 		}
+	}
 
-		input.Packaging, err = domain.NewPackagingType(args[5])
+	if len(args) >= 9 {
+		item.MeasuredWeight, err = strconv.Atoi(args[8])
 		if err != nil {
-			return "", fmt.Errorf("failed to parse packaging: %w", err)
+			return domain.DeliveryItem{}, fmt.Errorf("failed to parse measured weight: %w", err)
 		}
+	}
 
-		if len(args) == 7 {
-			input.AdditionalFilm, err = strconv.ParseBool(args[6])
-			if err != nil {
-				return "", fmt.Errorf("failed to parse additional film: %w", err)
-			}
-		}
+	// The override reason is the rest of the arguments, so it may contain spaces
+	if len(args) >= 10 {
+		item.WeightOverrideReason = strings.Join(args[9:], " ")
 	}
 
-	err := h.useCase.AcceptOrderDelivery(
+	return item, nil
+}
+
+// acceptOptions passes the measured weight and the override reason of the parcel if they are set
+func acceptOptions(item domain.DeliveryItem) []abstractions.AcceptOptFunc {
+	var options []abstractions.AcceptOptFunc
+	if item.MeasuredWeight != 0 {
+		options = append(options, abstractions.WithMeasuredWeight(item.MeasuredWeight))
+	}
+	if item.WeightOverrideReason != "" {
+		options = append(options, abstractions.WithWeightOverrideReason(item.WeightOverrideReason))
+	}
+	return options
+}
+
+func (h *Handler) AcceptDeliveryHandler(ctx context.Context, args []string) (string, error) {
+	item, err := parseDeliveryItem(args)
+	if err != nil {
+		return "", err
+	}
+
+	order, err := h.useCase.AcceptOrderDelivery(
 		ctx,
-		input.OrderID,
-		input.RecipientID,
-		input.StorageTime,
-		input.Cost,
-		input.Weight,
-		input.Packaging,
-		input.AdditionalFilm,
+		item.OrderID,
+		item.RecipientID,
+		item.StorageTime,
+		item.Cost,
+		item.Weight,
+		item.Dimensions,
+		item.Packaging,
+		item.AdditionalFilm,
+		acceptOptions(item)...,
 	)
 	if err != nil {
 		return "", err
 	}
 
-	return "Delivery accepted", nil
+	return fmt.Sprintf("Delivery accepted, cost %s, received at %s, expires at %s",
+		order.Cost,
+		order.ReceivedAt.Format(time.RFC3339),
+		order.ExpiresAt().Format(time.RFC3339),
+	), nil
+}
+
+// splitBatchItems splits the arguments into the parcels separated by ";", e.g. "1 ... box ; 2 ... bag"
+func splitBatchItems(args []string) [][]string {
+	var (
+		items   [][]string
+		current []string
+	)
+	for _, arg := range args {
+		last := strings.HasSuffix(arg, batchItemSeparator)
+		if arg = strings.TrimSuffix(arg, batchItemSeparator); arg != "" {
+			current = append(current, arg)
+		}
+		if last && len(current) > 0 {
+			items = append(items, current)
+			current = nil
+		}
+	}
+	if len(current) > 0 {
+		items = append(items, current)
+	}
+
+	return items
+}
+
+func (h *Handler) BatchAcceptDeliveryHandler(ctx context.Context, args []string) (string, error) {
+	usage := acceptDeliveryUsage + " ; " + acceptDeliveryUsage + " ; ..."
+
+	itemArgs := splitBatchItems(args)
+	if len(itemArgs) == 0 {
+		return "", fmt.Errorf("invalid number of arguments, expected at least 1 parcel. Usage: %s", usage)
+	}
+
+	// The parcels which can not be parsed are reported along with the results of the others
+	strResults := make([]string, len(itemArgs))
+	items := make([]domain.DeliveryItem, 0, len(itemArgs))
+	indexes := make([]int, 0, len(itemArgs))
+	for i, arg := range itemArgs {
+		item, err := parseDeliveryItem(arg)
+		if err != nil {
+			strResults[i] = fmt.Sprintf("%s failed: %s", arg[0], err)
+			continue
+		}
+
+		items = append(items, item)
+		indexes = append(indexes, i)
+	}
+
+	if len(items) > 0 {
+		results, err := h.useCase.BatchAcceptOrderDelivery(ctx, items)
+		if err != nil {
+			return "", err
+		}
+
+		for i, result := range results {
+			if result.Err != nil {
+				strResults[indexes[i]] = fmt.Sprintf("%s failed: %s", result.OrderID, result.Err)
+				continue
+			}
+			strResults[indexes[i]] = fmt.Sprintf("%s accepted, cost %s, expires at %s",
+				result.OrderID,
+				result.Order.Cost,
+				result.Order.ExpiresAt().Format(time.RFC3339),
+			)
+		}
+	}
+
+	return strings.Join(strResults, "\n"), nil
 }
 
 func (h *Handler) AcceptReturnHandler(ctx context.Context, args []string) (string, error) {
-	usage := "<recipient_id> <order_id>"
+	usage := "<recipient_id> <order_id> <reason> <inspection> [comment]"
 
-	if len(args) != 2 {
-		return "", fmt.Errorf("invalid number of arguments, expected 2, got %d. Usage: %s", len(args), usage)
+	if len(args) < 4 {
+		return "", fmt.Errorf("invalid number of arguments, expected at least 4, got %d. Usage: %s", len(args), usage)
 	}
 
 	recipientID := args[0]
 	orderID := args[1]
 
-	err := h.useCase.AcceptReturn(ctx, recipientID, orderID)
+	details, err := domain.NewReturnDetails(args[2], args[3], strings.Join(args[4:], " "))
+	if err != nil {
+		return "", err
+	}
+
+	err = h.useCase.AcceptReturn(ctx, recipientID, orderID, details)
 	if err != nil {
 		return "", err
 	}
@@ -155,7 +279,7 @@ func (h *Handler) GetOrdersHandler(ctx context.Context, args []string) (string,
 
 	strOrders := make([]string, len(orders))
 	for i, order := range orders {
-		strOrders[i] = fmt.Sprintf("%s %s %s %d %d %s %t",
+		strOrders[i] = fmt.Sprintf("%s %s %s %s %d %s %t",
 			order.OrderID,
 			order.RecipientID,
 			order.PVZID,
@@ -177,7 +301,7 @@ func (h *Handler) GetReturnsHandler(ctx context.Context, _ []string) (string, er
 
 	strOrders := make([]string, len(orders))
 	for i, order := range orders {
-		strOrders[i] = fmt.Sprintf("%s %s %s %d %d %s %t",
+		strOrders[i] = fmt.Sprintf("%s %s %s %s %d %s %t",
 			order.OrderID,
 			order.RecipientID,
 			order.PVZID,
@@ -192,18 +316,36 @@ func (h *Handler) GetReturnsHandler(ctx context.Context, _ []string) (string, er
 }
 
 func (h *Handler) GiveOrderToClientHandler(ctx context.Context, args []string) (string, error) {
-	usage := "<order_id1> <order_id2> ..."
+	usage := "<order_id1#pickup_code1> <order_id2#pickup_code2+storage_fee2> <order_id3#pickup_code3:refusal_reason> ..."
 
 	if len(args) < 1 {
 		return "", fmt.Errorf("invalid number of arguments, expected at least 1, got %d. Usage: %s", len(args), usage)
 	}
 
-	err := h.useCase.GiveOrderToClient(ctx, args)
+	decisions := make([]domain.IssueDecision, len(args))
+	for i, arg := range args {
+		decision, err := domain.ParseIssueDecision(arg)
+		if err != nil {
+			return "", err
+		}
+		decisions[i] = decision
+	}
+
+	results, err := h.useCase.GiveOrderToClient(ctx, decisions)
 	if err != nil {
 		return "", err
 	}
 
-	return "Orders given to client", nil
+	strResults := make([]string, len(results))
+	for i, result := range results {
+		if result.Err != nil {
+			strResults[i] = fmt.Sprintf("%s %s failed: %s", result.OrderID, result.Action, result.Err)
+			continue
+		}
+		strResults[i] = fmt.Sprintf("%s %s ok", result.OrderID, result.Action)
+	}
+
+	return strings.Join(strResults, "\n"), nil
 }
 
 func (h *Handler) ReturnOrderDeliveryHandler(ctx context.Context, args []string) (string, error) {
@@ -222,3 +364,88 @@ func (h *Handler) ReturnOrderDeliveryHandler(ctx context.Context, args []string)
 
 	return "Delivery returned", nil
 }
+
+func (h *Handler) GetOrderHistoryHandler(ctx context.Context, args []string) (string, error) {
+	usage := "<order_id>"
+
+	if len(args) != 1 {
+		return "", fmt.Errorf("invalid number of arguments, expected 1, got %d. Usage: %s", len(args), usage)
+	}
+
+	orderID := args[0]
+
+	events, err := h.useCase.GetOrderHistory(ctx, orderID)
+	if err != nil {
+		return "", err
+	}
+
+	strEvents := make([]string, len(events))
+	for i, event := range events {
+		strEvents[i] = fmt.Sprintf("%s %s %v",
+			event.CreatedAt.Format(time.RFC3339),
+			event.EventType,
+			event.Payload,
+		)
+	}
+
+	return strings.Join(strEvents, "\n"), nil
+}
+
+func (h *Handler) ExtendStorageHandler(ctx context.Context, args []string) (string, error) {
+	usage := "<order_id> <extension: 24h> <operator_id>"
+
+	if len(args) != 3 {
+		return "", fmt.Errorf("invalid number of arguments, expected 3, got %d. Usage: %s", len(args), usage)
+	}
+
+	orderID := args[0]
+
+	extension, err := time.ParseDuration(args[1])
+	if err != nil {
+		return "", fmt.Errorf("failed to parse extension: %w", err)
+	}
+
+	operatorID := args[2]
+
+	err = h.useCase.ExtendStorage(ctx, orderID, extension, operatorID)
+	if err != nil {
+		return "", err
+	}
+
+	return "Storage extended", nil
+}
+
+func (h *Handler) WeightReportHandler(ctx context.Context, args []string) (string, error) {
+	usage := "?<date: 2024-10-01>"
+
+	if len(args) > 1 {
+		return "", fmt.Errorf("invalid number of arguments, expected 0 or 1, got %d. Usage: %s", len(args), usage)
+	}
+
+	var date time.Time
+	if len(args) == 1 {
+		var err error
+		date, err = time.Parse(time.DateOnly, args[0])
+		if err != nil {
+			return "", fmt.Errorf("failed to parse date: %w", err)
+		}
+	}
+
+	report, err := h.useCase.GetWeightDiscrepancyReport(ctx, date)
+	if err != nil {
+		return "", err
+	}
+
+	strOrders := make([]string, 0, len(report.Orders)+1)
+	strOrders = append(strOrders, fmt.Sprintf("Weight discrepancies on %s: %d", report.Date.Format(time.DateOnly), len(report.Orders)))
+	for _, order := range report.Orders {
+		strOrders = append(strOrders, fmt.Sprintf("%s declared %d measured %d: %s",
+			order.OrderID,
+			order.Weight,
+			order.MeasuredWeight,
+			order.WeightOverrideReason,
+		))
+	}
+
+	return strings.Join(strOrders, "\n"), nil
+}
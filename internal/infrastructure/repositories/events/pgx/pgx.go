@@ -10,6 +10,7 @@ import (
 	"homework/internal/domain"
 	"homework/internal/infrastructure/repositories/utils/pgx/txmanager"
 	"homework/internal/usecases"
+	"strings"
 )
 
 var _ usecases.EventsRepository = &EventsRepository{}
@@ -48,6 +49,45 @@ func (r *EventsRepository) Create(ctx context.Context, event domain.Event) error
 	return nil
 }
 
+// eventColumns is a number of the columns CreateMany inserts for every event
+const eventColumns = 5
+
+// CreateMany inserts the events with one multi-row statement
+func (r *EventsRepository) CreateMany(ctx context.Context, events []domain.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	var query strings.Builder
+	query.WriteString(`
+		INSERT INTO events (id, event_type, payload, created_at, sent_at)
+		VALUES `)
+
+	args := make([]any, 0, len(events)*eventColumns)
+	for i, event := range events {
+		if i > 0 {
+			query.WriteString(", ")
+		}
+
+		n := i * eventColumns
+		fmt.Fprintf(&query, "($%d, $%d, $%d, $%d, $%d)", n+1, n+2, n+3, n+4, n+5)
+
+		entity := NewEvent(event)
+		args = append(args,
+			entity.ID,
+			entity.EventType,
+			entity.Payload,
+			entity.CreatedAt,
+			entity.SentAt,
+		)
+	}
+
+	engine := r.manager.GetQueryEngine(ctx)
+
+	_, err := engine.Exec(ctx, query.String(), args...)
+	return err
+}
+
 func (r *EventsRepository) GetPendingEvents(ctx context.Context, limit int) ([]domain.Event, error) {
 	const query = `SELECT * FROM get_pending_events($1)`
 
@@ -70,6 +110,36 @@ func (r *EventsRepository) GetPendingEvents(ctx context.Context, limit int) ([]d
 	return result, nil
 }
 
+// GetOrderEvents returns the events of the order together with the events of the lists, sessions
+// and shipments which have the order among their order_ids
+func (r *EventsRepository) GetOrderEvents(ctx context.Context, orderID string) ([]domain.Event, error) {
+	const query = `
+		SELECT id, event_type, payload, created_at, sent_at
+		FROM events
+		WHERE payload->>'order_id' = $1 OR payload->'order_ids' ? $1
+		ORDER BY created_at, id
+	`
+
+	engine := r.manager.GetQueryEngine(ctx)
+
+	var events []Event
+
+	if err := pgxscan.Select(ctx, engine, &events, query, orderID); err != nil {
+		return nil, err
+	}
+
+	if len(events) == 0 {
+		return nil, fmt.Errorf("%w: no events found for order %s", domain.ErrNotFound, orderID)
+	}
+
+	result := make([]domain.Event, 0, len(events))
+	for _, event := range events {
+		result = append(result, event.ToDomain())
+	}
+
+	return result, nil
+}
+
 func (r *EventsRepository) MarkAsSent(ctx context.Context, id uuid.UUID) error {
 	const query = `SELECT mark_event_as_sent($1)`
 
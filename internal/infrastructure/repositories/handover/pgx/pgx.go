@@ -0,0 +1,192 @@
+package pgx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"homework/internal/domain"
+	events "homework/internal/infrastructure/repositories/events/pgx"
+	"homework/internal/infrastructure/repositories/utils/pgx/txmanager"
+	"homework/internal/usecases"
+	"time"
+)
+
+var _ usecases.HandoverRepository = &HandoverRepository{}
+
+// HandoverRepository stores the handover sessions and their scans and writes their events in the same transaction
+type HandoverRepository struct {
+	manager    *txmanager.PGXTXManager
+	eventsRepo *events.EventsRepository
+}
+
+func NewHandoverRepository(manager *txmanager.PGXTXManager) *HandoverRepository {
+	return &HandoverRepository{
+		manager:    manager,
+		eventsRepo: events.NewEventsRepository(manager),
+	}
+}
+
+func (r *HandoverRepository) CreateSession(ctx context.Context, session domain.HandoverSession) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "HandoverRepository.CreateSession")
+	defer span.Finish()
+
+	const query = `
+		INSERT INTO handover_sessions (id, pvz_id, courier_id, status, expected, opened_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`
+
+	id, err := uuid.Parse(session.ID)
+	if err != nil {
+		return fmt.Errorf("%w: invalid session id: %v", domain.ErrInvalidArgument, err)
+	}
+
+	return r.manager.RunReadCommittedTransaction(ctx, func(ctx context.Context) error {
+		engine := r.manager.GetQueryEngine(ctx)
+
+		_, err := engine.Exec(ctx, query,
+			id,
+			session.PVZID,
+			session.CourierID,
+			session.Status.String(),
+			newHandoverItems(session.Expected),
+			newTimestamptz(session.OpenedAt),
+		)
+		if err != nil {
+			return err
+		}
+
+		return r.eventsRepo.Create(ctx, domain.NewHandoverSessionOpenedEvent(session))
+	})
+}
+
+const sessionQuery = `
+	SELECT id, pvz_id, courier_id, status, expected, report, opened_at, closed_at
+	FROM handover_sessions
+	WHERE id = $1
+`
+
+func (r *HandoverRepository) GetSession(ctx context.Context, sessionID string) (domain.HandoverSession, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "HandoverRepository.GetSession")
+	defer span.Finish()
+
+	return r.getSession(ctx, sessionQuery, sessionID)
+}
+
+func (r *HandoverRepository) LockSession(ctx context.Context, sessionID string) (domain.HandoverSession, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "HandoverRepository.LockSession")
+	defer span.Finish()
+
+	return r.getSession(ctx, sessionQuery+` FOR UPDATE`, sessionID)
+}
+
+func (r *HandoverRepository) getSession(ctx context.Context, sessionQuery string, sessionID string) (domain.HandoverSession, error) {
+	const scansQuery = `
+		SELECT session_id, order_id, measured_weight, result, reason, scanned_at
+		FROM handover_scans
+		WHERE session_id = $1
+		ORDER BY id
+	`
+
+	id, err := uuid.Parse(sessionID)
+	if err != nil {
+		return domain.HandoverSession{}, fmt.Errorf("%w: session not found", domain.ErrNotFound)
+	}
+
+	engine := r.manager.GetQueryEngine(ctx)
+
+	var session pgxHandoverSession
+	if err := pgxscan.Get(ctx, engine, &session, sessionQuery, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.HandoverSession{}, fmt.Errorf("%w: session not found", domain.ErrNotFound)
+		}
+		return domain.HandoverSession{}, err
+	}
+
+	var scans []pgxHandoverScan
+	if err := pgxscan.Select(ctx, engine, &scans, scansQuery, id); err != nil {
+		return domain.HandoverSession{}, err
+	}
+
+	return session.ToDomain(scans), nil
+}
+
+func (r *HandoverRepository) AddScan(ctx context.Context, scan domain.HandoverScan) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "HandoverRepository.AddScan")
+	defer span.Finish()
+
+	const query = `
+		INSERT INTO handover_scans (session_id, order_id, measured_weight, result, reason, scanned_at)
+		SELECT id, $2, $3, $4, $5, $6
+		FROM handover_sessions
+		WHERE id = $1 AND status = $7
+	`
+
+	id, err := uuid.Parse(scan.SessionID)
+	if err != nil {
+		return fmt.Errorf("%w: session not found", domain.ErrNotFound)
+	}
+
+	return r.manager.RunReadCommittedTransaction(ctx, func(ctx context.Context) error {
+		engine := r.manager.GetQueryEngine(ctx)
+
+		tag, err := engine.Exec(ctx, query,
+			id,
+			scan.OrderID,
+			scan.MeasuredWeight,
+			scan.Result.String(),
+			scan.Reason,
+			newTimestamptz(scan.ScannedAt),
+			domain.HandoverSessionStatusOpen.String(),
+		)
+		if err != nil {
+			return err
+		}
+
+		if tag.RowsAffected() == 0 {
+			return fmt.Errorf("%w: session is closed or does not exist", domain.ErrConflict)
+		}
+
+		return r.eventsRepo.Create(ctx, domain.NewHandoverParcelScannedEvent(scan))
+	})
+}
+
+func (r *HandoverRepository) CloseSession(ctx context.Context, report domain.HandoverReport) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "HandoverRepository.CloseSession")
+	defer span.Finish()
+
+	const query = `
+		UPDATE handover_sessions
+		SET status = $2, report = $3, closed_at = $4
+		WHERE id = $1 AND status = $5
+	`
+
+	id, err := uuid.Parse(report.SessionID)
+	if err != nil {
+		return fmt.Errorf("%w: session not found", domain.ErrNotFound)
+	}
+
+	return r.manager.RunReadCommittedTransaction(ctx, func(ctx context.Context) error {
+		engine := r.manager.GetQueryEngine(ctx)
+
+		tag, err := engine.Exec(ctx, query,
+			id,
+			domain.HandoverSessionStatusClosed.String(),
+			newHandoverReport(report),
+			newTimestamptz(time.Now()),
+			domain.HandoverSessionStatusOpen.String(),
+		)
+		if err != nil {
+			return err
+		}
+
+		if tag.RowsAffected() == 0 {
+			return fmt.Errorf("%w: session is closed or does not exist", domain.ErrConflict)
+		}
+
+		return r.eventsRepo.Create(ctx, domain.NewHandoverSessionClosedEvent(report))
+	})
+}
@@ -0,0 +1,174 @@
+package pgx
+
+import (
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"homework/internal/domain"
+	"time"
+)
+
+type pgxHandoverSession struct {
+	ID        uuid.UUID          `db:"id"`
+	PVZID     string             `db:"pvz_id"`
+	CourierID string             `db:"courier_id"`
+	Status    string             `db:"status"`
+	Expected  []handoverItem     `db:"expected"`
+	Report    *handoverReport    `db:"report"`
+	OpenedAt  pgtype.Timestamptz `db:"opened_at"`
+	ClosedAt  pgtype.Timestamptz `db:"closed_at"`
+}
+
+type pgxHandoverScan struct {
+	SessionID      uuid.UUID          `db:"session_id"`
+	OrderID        string             `db:"order_id"`
+	MeasuredWeight int                `db:"measured_weight"`
+	Result         string             `db:"result"`
+	Reason         string             `db:"reason"`
+	ScannedAt      pgtype.Timestamptz `db:"scanned_at"`
+}
+
+// handoverItem is a parcel of the manifest as it is stored in the expected column
+type handoverItem struct {
+	OrderID        string        `json:"order_id"`
+	RecipientID    string        `json:"recipient_id"`
+	StorageTime    time.Duration `json:"storage_time"`
+	Cost           int64         `json:"cost"`
+	Currency       string        `json:"currency"`
+	Weight         int           `json:"weight"`
+	Length         int           `json:"length"`
+	Width          int           `json:"width"`
+	Height         int           `json:"height"`
+	Packaging      string        `json:"packaging"`
+	AdditionalFilm bool          `json:"additional_film"`
+}
+
+// handoverReport is the discrepancy report as it is stored in the report column
+type handoverReport struct {
+	Accepted         []string                 `json:"accepted"`
+	Missing          []string                 `json:"missing"`
+	Unexpected       []string                 `json:"unexpected"`
+	WeightMismatches []handoverWeightMismatch `json:"weight_mismatches"`
+	Rejected         []handoverRejection      `json:"rejected"`
+}
+
+type handoverWeightMismatch struct {
+	OrderID        string `json:"order_id"`
+	DeclaredWeight int    `json:"declared_weight"`
+	MeasuredWeight int    `json:"measured_weight"`
+}
+
+type handoverRejection struct {
+	OrderID string `json:"order_id"`
+	Reason  string `json:"reason"`
+}
+
+func newTimestamptz(t time.Time) pgtype.Timestamptz {
+	return pgtype.Timestamptz{Time: t, Valid: !t.IsZero()}
+}
+
+func newHandoverItems(items []domain.DeliveryItem) []handoverItem {
+	result := make([]handoverItem, len(items))
+	for i, item := range items {
+		result[i] = handoverItem{
+			OrderID:        item.OrderID,
+			RecipientID:    item.RecipientID,
+			StorageTime:    item.StorageTime,
+			Cost:           item.Cost.Amount,
+			Currency:       item.Cost.Currency.String(),
+			Weight:         item.Weight,
+			Length:         item.Dimensions.Length,
+			Width:          item.Dimensions.Width,
+			Height:         item.Dimensions.Height,
+			Packaging:      item.Packaging.String(),
+			AdditionalFilm: item.AdditionalFilm,
+		}
+	}
+	return result
+}
+
+func (i handoverItem) ToDomain() domain.DeliveryItem {
+	return domain.DeliveryItem{
+		OrderID:     i.OrderID,
+		RecipientID: i.RecipientID,
+		StorageTime: i.StorageTime,
+		Cost:        domain.NewMoney(i.Cost, domain.Currency(i.Currency)),
+		Weight:      i.Weight,
+		Dimensions: domain.Dimensions{
+			Length: i.Length,
+			Width:  i.Width,
+			Height: i.Height,
+		},
+		Packaging:      domain.PackagingType(i.Packaging),
+		AdditionalFilm: i.AdditionalFilm,
+	}
+}
+
+func newHandoverReport(report domain.HandoverReport) handoverReport {
+	result := handoverReport{
+		Accepted:   report.Accepted,
+		Missing:    report.Missing,
+		Unexpected: report.Unexpected,
+	}
+	for _, mismatch := range report.WeightMismatches {
+		result.WeightMismatches = append(result.WeightMismatches, handoverWeightMismatch(mismatch))
+	}
+	for _, rejection := range report.Rejected {
+		result.Rejected = append(result.Rejected, handoverRejection(rejection))
+	}
+	return result
+}
+
+func (r handoverReport) ToDomain(sessionID string) domain.HandoverReport {
+	result := domain.HandoverReport{
+		SessionID:  sessionID,
+		Accepted:   r.Accepted,
+		Missing:    r.Missing,
+		Unexpected: r.Unexpected,
+	}
+	for _, mismatch := range r.WeightMismatches {
+		result.WeightMismatches = append(result.WeightMismatches, domain.HandoverWeightMismatch(mismatch))
+	}
+	for _, rejection := range r.Rejected {
+		result.Rejected = append(result.Rejected, domain.HandoverRejection(rejection))
+	}
+	return result
+}
+
+func (s pgxHandoverSession) ToDomain(scans []pgxHandoverScan) domain.HandoverSession {
+	session := domain.HandoverSession{
+		ID:        s.ID.String(),
+		PVZID:     s.PVZID,
+		CourierID: s.CourierID,
+		Status:    domain.HandoverSessionStatus(s.Status),
+		Expected:  make([]domain.DeliveryItem, len(s.Expected)),
+		Scans:     make([]domain.HandoverScan, len(scans)),
+		OpenedAt:  s.OpenedAt.Time,
+		ClosedAt:  s.ClosedAt.Time,
+	}
+
+	for i, item := range s.Expected {
+		session.Expected[i] = item.ToDomain()
+	}
+
+	for i, scan := range scans {
+		session.Scans[i] = scan.ToDomain()
+	}
+
+	if s.Report != nil {
+		report := s.Report.ToDomain(session.ID)
+		session.Report = &report
+	}
+
+	return session
+}
+
+func (s pgxHandoverScan) ToDomain() domain.HandoverScan {
+	return domain.HandoverScan{
+		SessionID:      s.SessionID.String(),
+		OrderID:        s.OrderID,
+		MeasuredWeight: s.MeasuredWeight,
+		Result:         domain.HandoverScanResult(s.Result),
+		Reason:         s.Reason,
+		ScannedAt:      s.ScannedAt.Time,
+	}
+}
@@ -0,0 +1,118 @@
+package pgx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"homework/internal/domain"
+	"homework/internal/infrastructure/repositories/utils/pgx/txmanager"
+	"homework/internal/infrastructure/server/middleware"
+	"time"
+)
+
+var _ middleware.IdempotencyStore = &IdempotencyRepository{}
+
+// DefaultKeyTTL is how long the responses are replayed, the key may be used for another request after it
+const DefaultKeyTTL = 24 * time.Hour
+
+type IdempotencyRepository struct {
+	manager *txmanager.PGXTXManager
+	ttl     time.Duration
+}
+
+func NewIdempotencyRepository(manager *txmanager.PGXTXManager, ttl time.Duration) *IdempotencyRepository {
+	return &IdempotencyRepository{
+		manager: manager,
+		ttl:     ttl,
+	}
+}
+
+// Do reserves the key and runs f in the same transaction, so the writes of f and the stored response
+// are committed together. A concurrent request with the same key waits for the reservation and fails to serialize
+// once it is committed, its retry gets the stored response. If f fails, the whole transaction is rolled back along with the reservation, so the retry runs f from scratch.
+// The transaction is serializable, so the handlers which need the strictest isolation level can join it
+func (r *IdempotencyRepository) Do(ctx context.Context, key, fingerprint string, f func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "IdempotencyRepository.Do")
+	defer span.Finish()
+
+	var response []byte
+	err := r.manager.RunSerializableTransaction(ctx, func(ctx context.Context) error {
+		reserved, err := r.reserve(ctx, key, fingerprint)
+		if err != nil {
+			return err
+		}
+
+		if !reserved {
+			response, err = r.get(ctx, key, fingerprint)
+			return err
+		}
+
+		response, err = f(ctx)
+		if err != nil {
+			return err
+		}
+
+		return r.saveResponse(ctx, key, response)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// reserve inserts the key or takes over the expired one. It waits for the transaction
+// which has reserved the key concurrently and returns false if the key is in use
+func (r *IdempotencyRepository) reserve(ctx context.Context, key, fingerprint string) (bool, error) {
+	const query = `
+		INSERT INTO idempotency_keys (key, fingerprint, response, created_at)
+		VALUES ($1, $2, NULL, NOW())
+		ON CONFLICT (key) DO UPDATE
+		SET fingerprint = EXCLUDED.fingerprint, response = NULL, created_at = EXCLUDED.created_at
+		WHERE idempotency_keys.created_at < NOW() - $3::interval
+	`
+
+	engine := r.manager.GetQueryEngine(ctx)
+
+	tag, err := engine.Exec(ctx, query, key, fingerprint, r.ttl)
+	if err != nil {
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (r *IdempotencyRepository) get(ctx context.Context, key, fingerprint string) ([]byte, error) {
+	const query = `SELECT fingerprint, response FROM idempotency_keys WHERE key = $1`
+
+	engine := r.manager.GetQueryEngine(ctx)
+
+	var (
+		storedFingerprint string
+		response          []byte
+	)
+	err := engine.QueryRow(ctx, query, key).Scan(&storedFingerprint, &response)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("%w: idempotency key %s", domain.ErrNotFound, key)
+		}
+		return nil, err
+	}
+
+	if storedFingerprint != fingerprint {
+		return nil, fmt.Errorf("%w: idempotency key %s is already used for another request", domain.ErrConflict, key)
+	}
+
+	return response, nil
+}
+
+func (r *IdempotencyRepository) saveResponse(ctx context.Context, key string, response []byte) error {
+	const query = `UPDATE idempotency_keys SET response = $2 WHERE key = $1`
+
+	engine := r.manager.GetQueryEngine(ctx)
+
+	_, err := engine.Exec(ctx, query, key, response)
+	return err
+}
@@ -2,15 +2,22 @@ package pgx
 
 import (
 	"context"
+	"fmt"
 	"github.com/opentracing/opentracing-go"
 	"homework/internal/abstractions"
 	"homework/internal/domain"
 	"homework/internal/infrastructure/repositories/events/pgx"
 	"homework/internal/infrastructure/repositories/utils/pgx/txmanager"
 	"homework/internal/usecases"
+	"time"
 )
 
-var _ usecases.PVZOrderRepository = &PvzOrderFacade{}
+var (
+	_ usecases.PVZOrderRepository = &PvzOrderFacade{}
+	_ usecases.ExpiryRepository   = &PvzOrderFacade{}
+
+	_ usecases.ReturnShipmentRepository = &PvzOrderFacade{}
+)
 
 type PvzOrderFacade struct {
 	manager    *txmanager.PGXTXManager
@@ -26,7 +33,8 @@ func NewPgxPvzOrderFacade(manager *txmanager.PGXTXManager) *PvzOrderFacade {
 	}
 }
 
-func (p *PvzOrderFacade) CreateOrder(ctx context.Context, order domain.PVZOrder) error {
+// CreateOrder creates the order. The pickup code is not stored, it is only sent to the recipient through the events
+func (p *PvzOrderFacade) CreateOrder(ctx context.Context, order domain.PVZOrder, pickupCode string) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "PvzOrderFacade.CreateOrder")
 	defer span.Finish()
 
@@ -37,6 +45,7 @@ func (p *PvzOrderFacade) CreateOrder(ctx context.Context, order domain.PVZOrder)
 			order.RecipientID,
 			order.Cost,
 			order.Weight,
+			order.Dimensions,
 			order.Packaging,
 			order.AdditionalFilm,
 			order.ReceivedAt,
@@ -45,43 +54,189 @@ func (p *PvzOrderFacade) CreateOrder(ctx context.Context, order domain.PVZOrder)
 		if err := p.repo.CreateOrder(ctx, order); err != nil {
 			return err
 		}
-		return p.eventsRepo.Create(ctx, event)
+		if err := p.eventsRepo.Create(ctx, event); err != nil {
+			return err
+		}
+		if order.WeightDiscrepancy {
+			if err := p.eventsRepo.Create(ctx, newOrderWeightDiscrepancyEvent(order)); err != nil {
+				return err
+			}
+		}
+		if pickupCode == "" {
+			return nil
+		}
+		return p.eventsRepo.Create(ctx, domain.NewOrderPickupCodeIssuedEvent(order.OrderID, order.RecipientID, pickupCode))
+	})
+}
+
+// CreateOrders creates the orders and writes the events of the created ones in one transaction.
+// The orders which already exist are skipped, the IDs of the created ones are returned
+func (p *PvzOrderFacade) CreateOrders(ctx context.Context, orders []domain.PVZOrder, pickupCodes map[string]string) ([]string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PvzOrderFacade.CreateOrders")
+	defer span.Finish()
+
+	var created []string
+	err := p.manager.RunReadCommittedTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		created, err = p.repo.CreateOrders(ctx, orders)
+		if err != nil {
+			return err
+		}
+
+		byID := make(map[string]domain.PVZOrder, len(orders))
+		for _, order := range orders {
+			byID[order.OrderID] = order
+		}
+
+		events := make([]domain.Event, 0, 2*len(created))
+		for _, orderID := range created {
+			order := byID[orderID]
+			events = append(events, domain.NewOrderDeliveryAcceptedEvent(
+				order.OrderID,
+				order.PVZID,
+				order.RecipientID,
+				order.Cost,
+				order.Weight,
+				order.Dimensions,
+				order.Packaging,
+				order.AdditionalFilm,
+				order.ReceivedAt,
+				order.StorageTime,
+			))
+			if order.WeightDiscrepancy {
+				events = append(events, newOrderWeightDiscrepancyEvent(order))
+			}
+			if pickupCode := pickupCodes[orderID]; pickupCode != "" {
+				events = append(events, domain.NewOrderPickupCodeIssuedEvent(order.OrderID, order.RecipientID, pickupCode))
+			}
+		}
+
+		return p.eventsRepo.CreateMany(ctx, events)
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func newOrderWeightDiscrepancyEvent(order domain.PVZOrder) domain.Event {
+	return domain.NewOrderWeightDiscrepancyEvent(order.OrderID, order.PVZID, order.Weight, order.MeasuredWeight, order.WeightOverrideReason)
 }
 
-func (p *PvzOrderFacade) DeleteOrder(ctx context.Context, orderID string) error {
+func (p *PvzOrderFacade) DeleteOrder(ctx context.Context, orderID string, expectedVersion int64) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "PvzOrderFacade.DeleteOrder")
 	defer span.Finish()
 
 	return p.manager.RunReadCommittedTransaction(ctx, func(ctx context.Context) error {
 		event := domain.NewOrderDeliveryReturnedEvent(orderID)
-		if err := p.repo.DeleteOrder(ctx, orderID); err != nil {
+		if err := p.repo.DeleteOrder(ctx, orderID, expectedVersion); err != nil {
 			return err
 		}
 		return p.eventsRepo.Create(ctx, event)
 	})
 }
 
-func (p *PvzOrderFacade) SetOrderIssued(ctx context.Context, orderID string) error {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "PvzOrderFacade.SetOrderIssued")
+// SetOrdersIssued issues all the orders of the decisions and writes their events in one transaction:
+// either every order is issued or none of them is
+func (p *PvzOrderFacade) SetOrdersIssued(ctx context.Context, decisions []domain.IssueDecision) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PvzOrderFacade.SetOrdersIssued")
 	defer span.Finish()
 
+	orderIDs := make([]string, len(decisions))
+	storageFeesPaid := make([]int64, len(decisions))
+	for i, decision := range decisions {
+		orderIDs[i] = decision.OrderID
+		storageFeesPaid[i] = decision.StorageFeePaid.Amount
+	}
+
 	return p.manager.RunReadCommittedTransaction(ctx, func(ctx context.Context) error {
-		event := domain.NewOrderIssuedEvent(orderID)
-		if err := p.repo.SetOrderIssued(ctx, orderID); err != nil {
+		orders, err := p.repo.LockOrders(ctx, orderIDs)
+		if err != nil {
 			return err
 		}
-		return p.eventsRepo.Create(ctx, event)
+
+		if err := validateLockedOrders(decisions, orders, domain.OrderStatusIssued); err != nil {
+			return err
+		}
+
+		if err := p.repo.SetOrdersIssued(ctx, orderIDs, storageFeesPaid); err != nil {
+			return err
+		}
+
+		for _, decision := range decisions {
+			if err := p.eventsRepo.Create(ctx, domain.NewOrderIssuedEvent(decision.OrderID)); err != nil {
+				return err
+			}
+
+			if decision.StorageFeePaid.IsZero() {
+				continue
+			}
+			if err := p.eventsRepo.Create(ctx, domain.NewOrderStorageFeePaidEvent(decision.OrderID, decision.StorageFeePaid)); err != nil {
+				return err
+			}
+		}
+
+		return nil
 	})
 }
 
-func (p *PvzOrderFacade) SetOrderReturned(ctx context.Context, orderID string) error {
+// validateLockedOrders checks that every requested order was locked, has the expected version
+// and can be moved to the next status
+func validateLockedOrders(decisions []domain.IssueDecision, orders []domain.PVZOrder, next domain.OrderStatus) error {
+	byID := make(map[string]domain.PVZOrder, len(orders))
+	for _, order := range orders {
+		byID[order.OrderID] = order
+	}
+
+	for _, decision := range decisions {
+		order, ok := byID[decision.OrderID]
+		if !ok {
+			return fmt.Errorf("%w: order %s not found", domain.ErrNotFound, decision.OrderID)
+		}
+
+		if decision.ExpectedVersion != 0 && order.Version != decision.ExpectedVersion {
+			return fmt.Errorf("%w: order %s has version %d, expected %d", domain.ErrVersionMismatch, order.OrderID, order.Version, decision.ExpectedVersion)
+		}
+
+		if !order.Status.CanTransitionTo(next) {
+			return fmt.Errorf("%w: order %s in status %s can not become %s", domain.ErrConflict, order.OrderID, order.Status, next)
+		}
+
+		if order.PickupLocked(time.Now()) {
+			return fmt.Errorf("%w: pickup of order %s is locked", domain.ErrTooManyAttempts, order.OrderID)
+		}
+
+		if next == domain.OrderStatusIssued {
+			if err := order.ValidateStorageFeePaid(time.Now(), decision.StorageFeePaid); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (p *PvzOrderFacade) SetOrderReturned(ctx context.Context, orderID string, details domain.ReturnDetails, expectedVersion int64) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "PvzOrderFacade.SetOrderReturned")
 	defer span.Finish()
 
 	return p.manager.RunSerializableTransaction(ctx, func(ctx context.Context) error {
-		event := domain.NewOrderReturnedEvent(orderID)
-		if err := p.repo.SetOrderReturned(ctx, orderID); err != nil {
+		event := domain.NewOrderReturnedEvent(orderID, details)
+		if err := p.repo.SetOrderReturned(ctx, orderID, details, expectedVersion); err != nil {
+			return err
+		}
+		return p.eventsRepo.Create(ctx, event)
+	})
+}
+
+func (p *PvzOrderFacade) SetOrderRefused(ctx context.Context, orderID, reason string, expectedVersion int64) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PvzOrderFacade.SetOrderRefused")
+	defer span.Finish()
+
+	return p.manager.RunReadCommittedTransaction(ctx, func(ctx context.Context) error {
+		event := domain.NewOrderRefusedEvent(orderID, reason)
+		if err := p.repo.SetOrderRefused(ctx, orderID, expectedVersion); err != nil {
 			return err
 		}
 		return p.eventsRepo.Create(ctx, event)
@@ -118,7 +273,7 @@ func (p *PvzOrderFacade) GetOrder(ctx context.Context, orderID string) (domain.P
 	return result, err
 }
 
-func (p *PvzOrderFacade) GetReturns(ctx context.Context, options ...abstractions.PagePaginationOptFunc) ([]domain.PVZOrder, error) {
+func (p *PvzOrderFacade) GetReturns(ctx context.Context, pvzID string, options ...abstractions.PagePaginationOptFunc) ([]domain.PVZOrder, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "PvzOrderFacade.GetReturns")
 	defer span.Finish()
 
@@ -126,9 +281,222 @@ func (p *PvzOrderFacade) GetReturns(ctx context.Context, options ...abstractions
 	var err error
 	err = p.manager.RunReadCommittedTransaction(ctx, func(ctx context.Context) error {
 		var innerErr error
-		result, innerErr = p.repo.GetReturns(ctx, options...)
+		result, innerErr = p.repo.GetReturns(ctx, pvzID, options...)
+		return innerErr
+	})
+
+	return result, err
+}
+
+func (p *PvzOrderFacade) GetOrderHistory(ctx context.Context, orderID string) ([]domain.Event, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PvzOrderFacade.GetOrderHistory")
+	defer span.Finish()
+
+	return p.eventsRepo.GetOrderEvents(ctx, orderID)
+}
+
+func (p *PvzOrderFacade) ExtendStorage(ctx context.Context, orderID string, extension time.Duration, expiresAt time.Time, extendedBy string, expectedVersion int64) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PvzOrderFacade.ExtendStorage")
+	defer span.Finish()
+
+	return p.manager.RunReadCommittedTransaction(ctx, func(ctx context.Context) error {
+		event := domain.NewOrderStorageExtendedEvent(orderID, extension, extendedBy)
+		if err := p.repo.ExtendStorage(ctx, orderID, extension, expiresAt, extendedBy, expectedVersion); err != nil {
+			return err
+		}
+		return p.eventsRepo.Create(ctx, event)
+	})
+}
+
+func (p *PvzOrderFacade) RegisterFailedPickupAttempt(ctx context.Context, orderID string, maxAttempts int, lockoutTime time.Duration) (time.Time, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PvzOrderFacade.RegisterFailedPickupAttempt")
+	defer span.Finish()
+
+	var lockedUntil time.Time
+
+	err := p.manager.RunReadCommittedTransaction(ctx, func(ctx context.Context) error {
+		var (
+			counted bool
+			err     error
+		)
+		lockedUntil, counted, err = p.repo.RegisterFailedPickupAttempt(ctx, orderID, maxAttempts, lockoutTime)
+		if err != nil {
+			return err
+		}
+
+		if !counted || !lockedUntil.After(time.Now()) {
+			return nil
+		}
+		return p.eventsRepo.Create(ctx, domain.NewOrderPickupLockedEvent(orderID, lockedUntil))
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return lockedUntil, nil
+}
+
+func (p *PvzOrderFacade) GetWeightDiscrepancies(ctx context.Context, pvzID string, from, to time.Time) ([]domain.PVZOrder, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PvzOrderFacade.GetWeightDiscrepancies")
+	defer span.Finish()
+
+	var result []domain.PVZOrder
+	var err error
+	err = p.manager.RunReadCommittedTransaction(ctx, func(ctx context.Context) error {
+		var innerErr error
+		result, innerErr = p.repo.GetWeightDiscrepancies(ctx, pvzID, from, to)
 		return innerErr
 	})
 
 	return result, err
 }
+
+// ExpireOrders expires the overdue orders and, if courierReturnLists is set, groups them into the courier return lists.
+// The events of the orders and the lists are written in the same transaction
+func (p *PvzOrderFacade) ExpireOrders(ctx context.Context, now time.Time, limit int, courierReturnLists bool) ([]domain.PVZOrder, []domain.CourierReturnList, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PvzOrderFacade.ExpireOrders")
+	defer span.Finish()
+
+	var (
+		expired []domain.PVZOrder
+		lists   []domain.CourierReturnList
+	)
+	err := p.manager.RunReadCommittedTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		expired, err = p.repo.ExpireOrders(ctx, now, limit)
+		if err != nil || len(expired) == 0 {
+			return err
+		}
+
+		events := make([]domain.Event, 0, len(expired))
+		for _, order := range expired {
+			events = append(events, domain.NewOrderExpiredEvent(order.OrderID, order.PVZID, order.RecipientID, order.ExpiresAt()))
+		}
+
+		if courierReturnLists {
+			lists = domain.NewCourierReturnLists(expired)
+			for _, list := range lists {
+				events = append(events, domain.NewCourierReturnListCreatedEvent(list))
+			}
+		}
+
+		return p.eventsRepo.CreateMany(ctx, events)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return expired, lists, nil
+}
+
+// RemindExpiringOrders marks the orders which expire soon as reminded and writes the reminder events in the same transaction
+func (p *PvzOrderFacade) RemindExpiringOrders(ctx context.Context, now, before time.Time, limit int) ([]domain.PVZOrder, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PvzOrderFacade.RemindExpiringOrders")
+	defer span.Finish()
+
+	var reminded []domain.PVZOrder
+	err := p.manager.RunReadCommittedTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		reminded, err = p.repo.RemindExpiringOrders(ctx, now, before, limit)
+		if err != nil || len(reminded) == 0 {
+			return err
+		}
+
+		events := make([]domain.Event, 0, len(reminded))
+		for _, order := range reminded {
+			events = append(events, domain.NewOrderExpiresSoonEvent(order.OrderID, order.PVZID, order.RecipientID, order.ExpiresAt()))
+		}
+
+		return p.eventsRepo.CreateMany(ctx, events)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return reminded, nil
+}
+
+// CreateReturnShipment includes the orders in the shipment if all of them can be dispatched to the seller.
+// The orders are locked, so they can not be changed until the shipment is stored
+func (p *PvzOrderFacade) CreateReturnShipment(ctx context.Context, shipment domain.ReturnShipment) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PvzOrderFacade.CreateReturnShipment")
+	defer span.Finish()
+
+	return p.manager.RunReadCommittedTransaction(ctx, func(ctx context.Context) error {
+		orders, err := p.repo.LockOrders(ctx, shipment.OrderIDs)
+		if err != nil {
+			return err
+		}
+
+		if err := shipment.ValidateOrders(orders); err != nil {
+			return err
+		}
+
+		if err := p.repo.CreateReturnShipment(ctx, shipment); err != nil {
+			return err
+		}
+
+		return p.eventsRepo.Create(ctx, domain.NewReturnShipmentCreatedEvent(shipment))
+	})
+}
+
+func (p *PvzOrderFacade) GetReturnShipment(ctx context.Context, shipmentID string) (domain.ReturnShipment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PvzOrderFacade.GetReturnShipment")
+	defer span.Finish()
+
+	var result domain.ReturnShipment
+	var err error
+	err = p.manager.RunReadCommittedTransaction(ctx, func(ctx context.Context) error {
+		var innerErr error
+		result, innerErr = p.repo.GetReturnShipment(ctx, shipmentID)
+		return innerErr
+	})
+
+	return result, err
+}
+
+// DispatchReturnShipment dispatches the open shipment and all its orders to the seller and writes the events
+// of the shipment and the orders in one transaction: either every order is dispatched or none of them is
+func (p *PvzOrderFacade) DispatchReturnShipment(ctx context.Context, shipmentID, courierID string, dispatchedAt time.Time) (domain.ReturnManifest, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PvzOrderFacade.DispatchReturnShipment")
+	defer span.Finish()
+
+	var manifest domain.ReturnManifest
+	err := p.manager.RunReadCommittedTransaction(ctx, func(ctx context.Context) error {
+		shipment, err := p.repo.GetReturnShipment(ctx, shipmentID)
+		if err != nil {
+			return err
+		}
+
+		orders, err := p.repo.LockOrders(ctx, shipment.OrderIDs)
+		if err != nil {
+			return err
+		}
+
+		if err := shipment.ValidateOrders(orders); err != nil {
+			return err
+		}
+
+		manifest = shipment.NewManifest(courierID, orders, dispatchedAt)
+		if err := p.repo.DispatchReturnShipment(ctx, manifest); err != nil {
+			return err
+		}
+
+		if err := p.repo.SetOrdersDispatched(ctx, shipment.OrderIDs); err != nil {
+			return err
+		}
+
+		events := make([]domain.Event, 0, len(shipment.OrderIDs)+1)
+		for _, orderID := range shipment.OrderIDs {
+			events = append(events, domain.NewOrderDispatchedToSellerEvent(orderID, shipment.ID, courierID))
+		}
+		events = append(events, domain.NewReturnShipmentDispatchedEvent(manifest))
+
+		return p.eventsRepo.CreateMany(ctx, events)
+	})
+	if err != nil {
+		return domain.ReturnManifest{}, err
+	}
+
+	return manifest, nil
+}
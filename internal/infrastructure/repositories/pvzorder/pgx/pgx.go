@@ -5,15 +5,17 @@ import (
 	"errors"
 	"fmt"
 	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"homework/internal/abstractions"
 	"homework/internal/domain"
 	"homework/internal/infrastructure/repositories/utils/pgx/txmanager"
-	"homework/internal/usecases"
+	"strconv"
+	"strings"
+	"time"
 )
 
-var _ usecases.PVZOrderRepository = &PostgresRepository{}
-
 /*
 1. idx_pvz_order_recipient_id:
    Этот индекс ускорит запросы, где происходит фильтрация по получателю (`recipient_id`). Пример из твоего запроса:
@@ -90,8 +92,8 @@ func NewPostgresRepository(manager *txmanager.PGXTXManager) *PostgresRepository
 
 func (p *PostgresRepository) CreateOrder(ctx context.Context, order domain.PVZOrder) error {
 	const query = `
-		INSERT INTO pvz_orders (order_id, pvz_id, recipient_id, cost, weight, packaging, additional_film, received_at, storage_time, issued_at, returned_at, deleted_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		INSERT INTO pvz_orders (order_id, pvz_id, recipient_id, cost, currency, weight, measured_weight, weight_discrepancy, weight_override_reason, length, width, height, packaging, additional_film, status, version, received_at, storage_time, expires_at, storage_extensions, extended_by, paid_storage_days, daily_storage_fee, storage_fee_currency, storage_fee_paid, pickup_code_hash, pickup_attempts, pickup_locked_until, issued_at, returned_at, return_reason, return_inspection, return_comment, deleted_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34)
 	`
 
 	engine := p.manager.GetQueryEngine(ctx)
@@ -103,13 +105,35 @@ func (p *PostgresRepository) CreateOrder(ctx context.Context, order domain.PVZOr
 		entity.PVZID,
 		entity.RecipientID,
 		entity.Cost,
+		entity.Currency,
 		entity.Weight,
+		entity.MeasuredWeight,
+		entity.WeightDiscrepancy,
+		entity.WeightOverrideReason,
+		entity.Length,
+		entity.Width,
+		entity.Height,
 		entity.Packaging,
 		entity.AdditionalFilm,
+		entity.Status,
+		entity.Version,
 		entity.ReceivedAt,
 		entity.StorageTime,
+		entity.ExpiresAt,
+		entity.StorageExtensions,
+		entity.ExtendedBy,
+		entity.PaidStorageDays,
+		entity.DailyStorageFee,
+		entity.StorageFeeCurrency,
+		entity.StorageFeePaid,
+		entity.PickupCodeHash,
+		entity.PickupAttempts,
+		entity.PickupLockedUntil,
 		entity.IssuedAt,
 		entity.ReturnedAt,
+		entity.ReturnReason,
+		entity.ReturnInspection,
+		entity.ReturnComment,
 		entity.DeletedAt,
 	)
 	if err != nil {
@@ -119,61 +143,312 @@ func (p *PostgresRepository) CreateOrder(ctx context.Context, order domain.PVZOr
 	return nil
 }
 
-func (p *PostgresRepository) DeleteOrder(ctx context.Context, orderID string) error {
+// orderColumns is a number of the columns CreateOrders inserts for every order
+const orderColumns = 34
+
+// CreateOrders inserts the orders with one multi-row statement. The orders which already exist are skipped,
+// the IDs of the inserted ones are returned
+func (p *PostgresRepository) CreateOrders(ctx context.Context, orders []domain.PVZOrder) ([]string, error) {
+	if len(orders) == 0 {
+		return nil, nil
+	}
+
+	var query strings.Builder
+	query.WriteString(`
+		INSERT INTO pvz_orders (order_id, pvz_id, recipient_id, cost, currency, weight, measured_weight, weight_discrepancy, weight_override_reason, length, width, height, packaging, additional_film, status, version, received_at, storage_time, expires_at, storage_extensions, extended_by, paid_storage_days, daily_storage_fee, storage_fee_currency, storage_fee_paid, pickup_code_hash, pickup_attempts, pickup_locked_until, issued_at, returned_at, return_reason, return_inspection, return_comment, deleted_at)
+		VALUES `)
+
+	args := make([]any, 0, len(orders)*orderColumns)
+	for i, order := range orders {
+		if i > 0 {
+			query.WriteString(", ")
+		}
+
+		query.WriteString("(")
+		for column := 0; column < orderColumns; column++ {
+			if column > 0 {
+				query.WriteString(", ")
+			}
+			query.WriteString("$" + strconv.Itoa(i*orderColumns+column+1))
+		}
+		query.WriteString(")")
+
+		entity := newPgxPvzOrder(order)
+		args = append(args,
+			entity.OrderID,
+			entity.PVZID,
+			entity.RecipientID,
+			entity.Cost,
+			entity.Currency,
+			entity.Weight,
+			entity.MeasuredWeight,
+			entity.WeightDiscrepancy,
+			entity.WeightOverrideReason,
+			entity.Length,
+			entity.Width,
+			entity.Height,
+			entity.Packaging,
+			entity.AdditionalFilm,
+			entity.Status,
+			entity.Version,
+			entity.ReceivedAt,
+			entity.StorageTime,
+			entity.ExpiresAt,
+			entity.StorageExtensions,
+			entity.ExtendedBy,
+			entity.PaidStorageDays,
+			entity.DailyStorageFee,
+			entity.StorageFeeCurrency,
+			entity.StorageFeePaid,
+			entity.PickupCodeHash,
+			entity.PickupAttempts,
+			entity.PickupLockedUntil,
+			entity.IssuedAt,
+			entity.ReturnedAt,
+			entity.ReturnReason,
+			entity.ReturnInspection,
+			entity.ReturnComment,
+			entity.DeletedAt,
+		)
+	}
+	query.WriteString(`
+		ON CONFLICT (order_id) DO NOTHING
+		RETURNING order_id
+	`)
+
+	engine := p.manager.GetQueryEngine(ctx)
+
+	var created []string
+	if err := pgxscan.Select(ctx, engine, &created, query.String(), args...); err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (p *PostgresRepository) DeleteOrder(ctx context.Context, orderID string, expectedVersion int64) error {
 	const query = `
 		UPDATE pvz_orders
-		SET deleted_at = NOW()
-		WHERE order_id = $1
+		SET deleted_at = NOW(), status = 'returned_to_courier', version = version + 1
+		WHERE order_id = $1 AND ($2::bigint = 0 OR version = $2) AND status IN ('accepted', 'expired') AND deleted_at IS NULL
 	`
 
+	return p.execTransition(ctx, query, orderID, expectedVersion, "returned to courier")
+}
+
+// execTransition runs an UPDATE which moves the order to the next status. The query must guard
+// the precondition of the transition itself, so it is safe against concurrent requests: if no rows
+// were updated, the order either does not exist, has been changed since the expected version
+// or has already been moved by someone else
+func (p *PostgresRepository) execTransition(ctx context.Context, query string, orderID string, expectedVersion int64, action string, args ...any) error {
 	engine := p.manager.GetQueryEngine(ctx)
 
-	_, err := engine.Exec(ctx, query, orderID)
+	tag, err := engine.Exec(ctx, query, append([]any{orderID, expectedVersion}, args...)...)
 	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() > 0 {
+		return nil
+	}
+
+	const versionQuery = `SELECT version FROM pvz_orders WHERE order_id = $1`
+
+	var version int64
+	if err := engine.QueryRow(ctx, versionQuery, orderID).Scan(&version); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return fmt.Errorf("%w: order not found", domain.ErrNotFound)
 		}
+		return err
 	}
 
-	return nil
+	if expectedVersion != 0 && version != expectedVersion {
+		return fmt.Errorf("%w: order %s has version %d, expected %d", domain.ErrVersionMismatch, orderID, version, expectedVersion)
+	}
+
+	return fmt.Errorf("%w: order %s can not be %s in its current status", domain.ErrConflict, orderID, action)
 }
 
-func (p *PostgresRepository) SetOrderIssued(ctx context.Context, orderID string) error {
+// SetOrdersIssued marks all the given orders as issued with the storage fees the client paid for them
+// in minor units of the storage fee currency. The orders are expected to be locked by LockOrders
+func (p *PostgresRepository) SetOrdersIssued(ctx context.Context, orderIDs []string, storageFeesPaid []int64) error {
 	const query = `
-		UPDATE pvz_orders
-		SET issued_at = NOW()
-		WHERE order_id = $1
+		UPDATE pvz_orders AS o
+		SET issued_at = NOW(), status = 'issued', storage_fee_paid = paid.fee, version = o.version + 1
+		FROM unnest($1::text[], $2::bigint[]) AS paid(order_id, fee)
+		WHERE o.order_id = paid.order_id AND o.status = 'accepted' AND o.deleted_at IS NULL
 	`
 
 	engine := p.manager.GetQueryEngine(ctx)
 
-	_, err := engine.Exec(ctx, query, orderID)
+	tag, err := engine.Exec(ctx, query, orderIDs, storageFeesPaid)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return fmt.Errorf("%w: order not found", domain.ErrNotFound)
-		}
+		return err
+	}
+
+	if tag.RowsAffected() != int64(len(orderIDs)) {
+		return fmt.Errorf("%w: %d of %d orders can not be issued", domain.ErrConflict, int64(len(orderIDs))-tag.RowsAffected(), len(orderIDs))
 	}
 
 	return nil
 }
 
-func (p *PostgresRepository) SetOrderReturned(ctx context.Context, orderID string) error {
+// LockOrders selects the orders with FOR UPDATE, so they can not be changed by
+// concurrent transactions until the current one is finished.
+// Must be called inside a transaction
+func (p *PostgresRepository) LockOrders(ctx context.Context, orderIDs []string) ([]domain.PVZOrder, error) {
+	// Rows are locked in the same order by every caller to avoid deadlocks
+	const query = `
+		SELECT order_id, pvz_id, recipient_id, cost, currency, weight, measured_weight, weight_discrepancy, weight_override_reason, length, width, height, packaging, additional_film, status, version, received_at, storage_time, expires_at, storage_extensions, extended_by, paid_storage_days, daily_storage_fee, storage_fee_currency, storage_fee_paid, pickup_code_hash, pickup_attempts, pickup_locked_until, issued_at, returned_at, return_reason, return_inspection, return_comment, deleted_at
+		FROM pvz_orders
+		WHERE order_id = ANY($1) AND deleted_at IS NULL
+		ORDER BY order_id
+		FOR UPDATE
+	`
+
+	engine := p.manager.GetQueryEngine(ctx)
+
+	var rows []*pgxPvzOrder
+
+	if err := pgxscan.Select(ctx, engine, &rows, query, orderIDs); err != nil {
+		return nil, err
+	}
+
+	orders := make([]domain.PVZOrder, 0, len(rows))
+	for _, row := range rows {
+		orders = append(orders, row.ToDomain())
+	}
+
+	return orders, nil
+}
+
+func (p *PostgresRepository) SetOrderReturned(ctx context.Context, orderID string, details domain.ReturnDetails, expectedVersion int64) error {
+	const query = `
+		UPDATE pvz_orders
+		SET returned_at = NOW(), status = 'returned_by_client', return_reason = $3, return_inspection = $4, return_comment = $5, version = version + 1
+		WHERE order_id = $1 AND ($2::bigint = 0 OR version = $2) AND status = 'issued' AND returned_at IS NULL AND deleted_at IS NULL
+	`
+
+	return p.execTransition(ctx, query, orderID, expectedVersion, "returned",
+		details.Reason.String(), details.Inspection.String(), newText(details.Comment),
+	)
+}
+
+func (p *PostgresRepository) SetOrderRefused(ctx context.Context, orderID string, expectedVersion int64) error {
 	const query = `
 		UPDATE pvz_orders
-		SET returned_at = NOW()
-		WHERE order_id = $1
+		SET returned_at = NOW(), status = 'refused', version = version + 1
+		WHERE order_id = $1 AND ($2::bigint = 0 OR version = $2) AND status = 'accepted' AND issued_at IS NULL AND deleted_at IS NULL
+		  AND (pickup_locked_until IS NULL OR pickup_locked_until <= NOW())
 	`
 
+	return p.execTransition(ctx, query, orderID, expectedVersion, "refused")
+}
+
+func (p *PostgresRepository) ExtendStorage(ctx context.Context, orderID string, extension time.Duration, expiresAt time.Time, extendedBy string, expectedVersion int64) error {
+	const query = `
+		UPDATE pvz_orders
+		SET storage_time = storage_time + $3, expires_at = $4, storage_extensions = storage_extensions + 1, extended_by = $5, expiry_reminded_at = NULL, version = version + 1
+		WHERE order_id = $1 AND ($2::bigint = 0 OR version = $2) AND status = 'accepted' AND deleted_at IS NULL
+	`
+
+	return p.execTransition(ctx, query, orderID, expectedVersion, "extended", newInterval(extension), newTimestamptz(expiresAt), extendedBy)
+}
+
+// ExpireOrders marks up to limit accepted orders whose storage time and paid storage have passed by now as expired
+// and returns them.
+// The orders locked by the concurrent sweeps are skipped
+func (p *PostgresRepository) ExpireOrders(ctx context.Context, now time.Time, limit int) ([]domain.PVZOrder, error) {
+	const query = `
+		UPDATE pvz_orders
+		SET status = 'expired', version = version + 1
+		WHERE order_id IN (
+			SELECT order_id
+			FROM pvz_orders
+			WHERE status = 'accepted' AND deleted_at IS NULL
+			  AND expires_at + make_interval(days => paid_storage_days) <= $1
+			ORDER BY expires_at
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING order_id, pvz_id, recipient_id, cost, currency, weight, measured_weight, weight_discrepancy, weight_override_reason, length, width, height, packaging, additional_film, status, version, received_at, storage_time, expires_at, storage_extensions, extended_by, paid_storage_days, daily_storage_fee, storage_fee_currency, storage_fee_paid, pickup_code_hash, pickup_attempts, pickup_locked_until, issued_at, returned_at, return_reason, return_inspection, return_comment, deleted_at
+	`
+
+	return p.selectOrders(ctx, query, newTimestamptz(now), limit)
+}
+
+// RemindExpiringOrders marks up to limit accepted orders which expire in (now, before] and have not been
+// reminded yet as reminded and returns them. The orders locked by the concurrent sweeps are skipped
+func (p *PostgresRepository) RemindExpiringOrders(ctx context.Context, now, before time.Time, limit int) ([]domain.PVZOrder, error) {
+	const query = `
+		UPDATE pvz_orders
+		SET expiry_reminded_at = $1
+		WHERE order_id IN (
+			SELECT order_id
+			FROM pvz_orders
+			WHERE status = 'accepted' AND deleted_at IS NULL AND expiry_reminded_at IS NULL
+			  AND expires_at > $1 AND expires_at <= $2
+			ORDER BY expires_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING order_id, pvz_id, recipient_id, cost, currency, weight, measured_weight, weight_discrepancy, weight_override_reason, length, width, height, packaging, additional_film, status, version, received_at, storage_time, expires_at, storage_extensions, extended_by, paid_storage_days, daily_storage_fee, storage_fee_currency, storage_fee_paid, pickup_code_hash, pickup_attempts, pickup_locked_until, issued_at, returned_at, return_reason, return_inspection, return_comment, deleted_at
+	`
+
+	return p.selectOrders(ctx, query, newTimestamptz(now), newTimestamptz(before), limit)
+}
+
+func (p *PostgresRepository) selectOrders(ctx context.Context, query string, args ...any) ([]domain.PVZOrder, error) {
 	engine := p.manager.GetQueryEngine(ctx)
 
-	_, err := engine.Exec(ctx, query, orderID)
-	if err != nil {
+	var rows []*pgxPvzOrder
+
+	if err := pgxscan.Select(ctx, engine, &rows, query, args...); err != nil {
+		return nil, err
+	}
+
+	orders := make([]domain.PVZOrder, 0, len(rows))
+	for _, row := range rows {
+		orders = append(orders, row.ToDomain())
+	}
+
+	return orders, nil
+}
+
+// RegisterFailedPickupAttempt counts the failed pickup attempt. After maxAttempts of them the counter is reset
+// and the pickup is locked for lockoutTime. Attempts made while the pickup is locked are not counted.
+// It returns the time until which the pickup is locked and whether the attempt has been counted
+func (p *PostgresRepository) RegisterFailedPickupAttempt(ctx context.Context, orderID string, maxAttempts int, lockoutTime time.Duration) (time.Time, bool, error) {
+	const query = `
+		UPDATE pvz_orders
+		SET pickup_attempts = CASE WHEN pickup_attempts + 1 >= $2 THEN 0 ELSE pickup_attempts + 1 END,
+		    pickup_locked_until = CASE WHEN pickup_attempts + 1 >= $2 THEN NOW() + $3 ELSE pickup_locked_until END
+		WHERE order_id = $1 AND (pickup_locked_until IS NULL OR pickup_locked_until <= NOW())
+		RETURNING pickup_locked_until
+	`
+
+	engine := p.manager.GetQueryEngine(ctx)
+
+	var lockedUntil pgtype.Timestamptz
+	err := engine.QueryRow(ctx, query, orderID, maxAttempts, newInterval(lockoutTime)).Scan(&lockedUntil)
+	if err == nil {
+		return lockedUntil.Time, true, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return time.Time{}, false, err
+	}
+
+	// The pickup is already locked or the order does not exist
+	const lockQuery = `SELECT pickup_locked_until FROM pvz_orders WHERE order_id = $1`
+
+	if err := engine.QueryRow(ctx, lockQuery, orderID).Scan(&lockedUntil); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return fmt.Errorf("%w: order not found", domain.ErrNotFound)
+			return time.Time{}, false, fmt.Errorf("%w: order not found", domain.ErrNotFound)
 		}
+		return time.Time{}, false, err
 	}
 
-	return nil
+	return lockedUntil.Time, false, nil
 }
 
 func (p *PostgresRepository) GetOrders(ctx context.Context, userID string, options ...abstractions.GetOrdersOptFunc) ([]domain.PVZOrder, error) {
@@ -184,18 +459,20 @@ func (p *PostgresRepository) GetOrders(ctx context.Context, userID string, optio
 
 	const query = `
 		WITH subquery AS (
-			SELECT order_id, pvz_id, recipient_id, cost, weight, packaging, additional_film, received_at, storage_time, issued_at, returned_at, deleted_at, 
+			SELECT order_id, pvz_id, recipient_id, cost, currency, weight, measured_weight, weight_discrepancy, weight_override_reason, length, width, height, packaging, additional_film, status, version, received_at, storage_time, expires_at, storage_extensions, extended_by, paid_storage_days, daily_storage_fee, storage_fee_currency, storage_fee_paid, pickup_code_hash, pickup_attempts, pickup_locked_until, issued_at, returned_at, return_reason, return_inspection, return_comment, deleted_at, 
 				   ROW_NUMBER() OVER (ORDER BY received_at DESC) AS rn
 			FROM pvz_orders
 			WHERE recipient_id = $1 
 			  AND (pvz_id = $2 OR $2 = '') 
 			  AND deleted_at IS NULL
+			  AND (COALESCE(array_length($6::text[], 1), 0) = 0 OR status = ANY($6::text[]))
+			  AND ($7::boolean = false OR weight_discrepancy)
 			ORDER BY received_at DESC
 			LIMIT CASE WHEN $3 = 0 THEN NULL ELSE $3 END
 		), row_boundary AS (
 			SELECT COALESCE((SELECT rn FROM subquery WHERE order_id = $4 OR $4 = '' LIMIT 1), 1) AS start_row
 		)
-		SELECT order_id, pvz_id, recipient_id, cost, weight, packaging, additional_film, received_at, storage_time, issued_at, returned_at, deleted_at
+		SELECT order_id, pvz_id, recipient_id, cost, currency, weight, measured_weight, weight_discrepancy, weight_override_reason, length, width, height, packaging, additional_film, status, version, received_at, storage_time, expires_at, storage_extensions, extended_by, paid_storage_days, daily_storage_fee, storage_fee_currency, storage_fee_paid, pickup_code_hash, pickup_attempts, pickup_locked_until, issued_at, returned_at, return_reason, return_inspection, return_comment, deleted_at
 		FROM subquery, row_boundary
 		WHERE subquery.rn >= row_boundary.start_row
 		LIMIT CASE WHEN $5 = 0 THEN NULL ELSE $5 END;
@@ -205,7 +482,12 @@ func (p *PostgresRepository) GetOrders(ctx context.Context, userID string, optio
 
 	var rows []*pgxPvzOrder
 
-	err = pgxscan.Select(ctx, engine, &rows, query, userID, opts.PVZID, opts.LastNOrders, opts.CursorID, opts.Limit)
+	statuses := make([]string, 0, len(opts.Statuses))
+	for _, status := range opts.Statuses {
+		statuses = append(statuses, status.String())
+	}
+
+	err = pgxscan.Select(ctx, engine, &rows, query, userID, opts.PVZID, opts.LastNOrders, opts.CursorID, opts.Limit, statuses, opts.WeightDiscrepancy)
 	if err != nil {
 		return nil, err
 	}
@@ -220,7 +502,7 @@ func (p *PostgresRepository) GetOrders(ctx context.Context, userID string, optio
 
 func (p *PostgresRepository) GetOrder(ctx context.Context, orderID string) (domain.PVZOrder, error) {
 	const query = `
-		SELECT order_id, pvz_id, recipient_id, cost, weight, packaging, additional_film, received_at, storage_time, issued_at, returned_at, deleted_at
+		SELECT order_id, pvz_id, recipient_id, cost, currency, weight, measured_weight, weight_discrepancy, weight_override_reason, length, width, height, packaging, additional_film, status, version, received_at, storage_time, expires_at, storage_extensions, extended_by, paid_storage_days, daily_storage_fee, storage_fee_currency, storage_fee_paid, pickup_code_hash, pickup_attempts, pickup_locked_until, issued_at, returned_at, return_reason, return_inspection, return_comment, deleted_at
 		FROM pvz_orders
 		WHERE order_id = $1 AND deleted_at IS NULL
 	`
@@ -240,25 +522,37 @@ func (p *PostgresRepository) GetOrder(ctx context.Context, orderID string) (doma
 	return row.ToDomain(), nil
 }
 
-func (p *PostgresRepository) GetReturns(ctx context.Context, options ...abstractions.PagePaginationOptFunc) ([]domain.PVZOrder, error) {
+func (p *PostgresRepository) GetReturns(ctx context.Context, pvzID string, options ...abstractions.PagePaginationOptFunc) ([]domain.PVZOrder, error) {
 	opts, err := abstractions.NewPaginationOptions(options...)
 	if err != nil {
 		return nil, err
 	}
 
 	const query = `
-		SELECT order_id, pvz_id, recipient_id, cost, weight, packaging, additional_film, received_at, storage_time, issued_at, returned_at, deleted_at
+		SELECT order_id, pvz_id, recipient_id, cost, currency, weight, measured_weight, weight_discrepancy, weight_override_reason, length, width, height, packaging, additional_film, status, version, received_at, storage_time, expires_at, storage_extensions, extended_by, paid_storage_days, daily_storage_fee, storage_fee_currency, storage_fee_paid, pickup_code_hash, pickup_attempts, pickup_locked_until, issued_at, returned_at, return_reason, return_inspection, return_comment, deleted_at
 		FROM pvz_orders
-		WHERE returned_at IS NOT NULL AND deleted_at IS NULL
+		WHERE returned_at IS NOT NULL AND deleted_at IS NULL AND pvz_id = $3 AND status <> 'dispatched_to_seller'
+		  AND (cardinality($4::text[]) = 0 OR return_reason = ANY($4))
+		  AND (cardinality($5::text[]) = 0 OR return_inspection = ANY($5))
 		ORDER BY returned_at DESC
 		LIMIT $1 OFFSET $2
 	`
 
+	reasons := make([]string, 0, len(opts.ReturnReasons))
+	for _, reason := range opts.ReturnReasons {
+		reasons = append(reasons, reason.String())
+	}
+
+	inspections := make([]string, 0, len(opts.InspectionOutcomes))
+	for _, inspection := range opts.InspectionOutcomes {
+		inspections = append(inspections, inspection.String())
+	}
+
 	engine := p.manager.GetQueryEngine(ctx)
 
 	var rows []*pgxPvzOrder
 
-	err = pgxscan.Select(ctx, engine, &rows, query, opts.PageSize, opts.Page*opts.PageSize)
+	err = pgxscan.Select(ctx, engine, &rows, query, opts.PageSize, opts.Page*opts.PageSize, pvzID, reasons, inspections)
 	if err != nil {
 		return nil, err
 	}
@@ -270,3 +564,165 @@ func (p *PostgresRepository) GetReturns(ctx context.Context, options ...abstract
 
 	return orders, nil
 }
+
+// GetWeightDiscrepancies returns the orders of the PVZ accepted with the weight discrepancy in [from, to)
+func (p *PostgresRepository) GetWeightDiscrepancies(ctx context.Context, pvzID string, from, to time.Time) ([]domain.PVZOrder, error) {
+	const query = `
+		SELECT order_id, pvz_id, recipient_id, cost, currency, weight, measured_weight, weight_discrepancy, weight_override_reason, length, width, height, packaging, additional_film, status, version, received_at, storage_time, expires_at, storage_extensions, extended_by, paid_storage_days, daily_storage_fee, storage_fee_currency, storage_fee_paid, pickup_code_hash, pickup_attempts, pickup_locked_until, issued_at, returned_at, return_reason, return_inspection, return_comment, deleted_at
+		FROM pvz_orders
+		WHERE pvz_id = $1 AND weight_discrepancy AND received_at >= $2 AND received_at < $3
+		ORDER BY received_at
+	`
+
+	engine := p.manager.GetQueryEngine(ctx)
+
+	var rows []*pgxPvzOrder
+
+	err := pgxscan.Select(ctx, engine, &rows, query, pvzID, newTimestamptz(from), newTimestamptz(to))
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]domain.PVZOrder, 0, len(rows))
+	for _, row := range rows {
+		orders = append(orders, row.ToDomain())
+	}
+
+	return orders, nil
+}
+
+// CreateReturnShipment stores the shipment and includes its orders in it.
+// domain.ErrConflict is returned if any of the orders is already included in another shipment
+func (p *PostgresRepository) CreateReturnShipment(ctx context.Context, shipment domain.ReturnShipment) error {
+	const shipmentQuery = `
+		INSERT INTO return_shipments (id, pvz_id, status, created_at)
+		VALUES ($1, $2, $3, $4)
+	`
+
+	const ordersQuery = `
+		INSERT INTO return_shipment_orders (order_id, shipment_id, position)
+		SELECT included.order_id, $1, included.position
+		FROM unnest($2::text[]) WITH ORDINALITY AS included(order_id, position)
+		ON CONFLICT (order_id) DO NOTHING
+	`
+
+	id, err := uuid.Parse(shipment.ID)
+	if err != nil {
+		return fmt.Errorf("%w: invalid shipment id: %v", domain.ErrInvalidArgument, err)
+	}
+
+	engine := p.manager.GetQueryEngine(ctx)
+
+	_, err = engine.Exec(ctx, shipmentQuery, id, shipment.PVZID, shipment.Status.String(), newTimestamptz(shipment.CreatedAt))
+	if err != nil {
+		return err
+	}
+
+	tag, err := engine.Exec(ctx, ordersQuery, id, shipment.OrderIDs)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() != int64(len(shipment.OrderIDs)) {
+		return fmt.Errorf("%w: %d of %d orders are already included in another return shipment",
+			domain.ErrConflict, int64(len(shipment.OrderIDs))-tag.RowsAffected(), len(shipment.OrderIDs))
+	}
+
+	return nil
+}
+
+// GetReturnShipment returns the shipment with its orders in the order they were included
+func (p *PostgresRepository) GetReturnShipment(ctx context.Context, shipmentID string) (domain.ReturnShipment, error) {
+	const shipmentQuery = `
+		SELECT id, pvz_id, status, courier_id, manifest, created_at, dispatched_at
+		FROM return_shipments
+		WHERE id = $1
+	`
+
+	const ordersQuery = `
+		SELECT order_id
+		FROM return_shipment_orders
+		WHERE shipment_id = $1
+		ORDER BY position
+	`
+
+	id, err := uuid.Parse(shipmentID)
+	if err != nil {
+		return domain.ReturnShipment{}, fmt.Errorf("%w: return shipment not found", domain.ErrNotFound)
+	}
+
+	engine := p.manager.GetQueryEngine(ctx)
+
+	var shipment pgxReturnShipment
+	if err := pgxscan.Get(ctx, engine, &shipment, shipmentQuery, id); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.ReturnShipment{}, fmt.Errorf("%w: return shipment not found", domain.ErrNotFound)
+		}
+		return domain.ReturnShipment{}, err
+	}
+
+	var orderIDs []string
+	if err := pgxscan.Select(ctx, engine, &orderIDs, ordersQuery, id); err != nil {
+		return domain.ReturnShipment{}, err
+	}
+
+	return shipment.ToDomain(orderIDs), nil
+}
+
+// DispatchReturnShipment marks the open shipment as dispatched with the manifest.
+// domain.ErrConflict is returned if the shipment has already been dispatched
+func (p *PostgresRepository) DispatchReturnShipment(ctx context.Context, manifest domain.ReturnManifest) error {
+	const query = `
+		UPDATE return_shipments
+		SET status = $2, courier_id = $3, manifest = $4, dispatched_at = $5
+		WHERE id = $1 AND status = $6
+	`
+
+	id, err := uuid.Parse(manifest.ShipmentID)
+	if err != nil {
+		return fmt.Errorf("%w: return shipment not found", domain.ErrNotFound)
+	}
+
+	engine := p.manager.GetQueryEngine(ctx)
+
+	tag, err := engine.Exec(ctx, query,
+		id,
+		domain.ReturnShipmentStatusDispatched.String(),
+		manifest.CourierID,
+		newReturnManifest(manifest),
+		newTimestamptz(manifest.DispatchedAt),
+		domain.ReturnShipmentStatusOpen.String(),
+	)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("%w: return shipment is already dispatched or does not exist", domain.ErrConflict)
+	}
+
+	return nil
+}
+
+// SetOrdersDispatched marks all the given returned or refused orders as dispatched to the seller.
+// The orders are expected to be locked by LockOrders
+func (p *PostgresRepository) SetOrdersDispatched(ctx context.Context, orderIDs []string) error {
+	const query = `
+		UPDATE pvz_orders
+		SET status = 'dispatched_to_seller', version = version + 1
+		WHERE order_id = ANY($1) AND status IN ('returned_by_client', 'refused') AND deleted_at IS NULL
+	`
+
+	engine := p.manager.GetQueryEngine(ctx)
+
+	tag, err := engine.Exec(ctx, query, orderIDs)
+	if err != nil {
+		return err
+	}
+
+	if tag.RowsAffected() != int64(len(orderIDs)) {
+		return fmt.Errorf("%w: %d of %d orders can not be dispatched", domain.ErrConflict, int64(len(orderIDs))-tag.RowsAffected(), len(orderIDs))
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package pgx
 
 import (
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	"homework/internal/domain"
 	"time"
@@ -11,18 +12,48 @@ type pgxPvzOrder struct {
 	PVZID       string `db:"pvz_id"`
 	RecipientID string `db:"recipient_id"`
 
-	Cost   int `db:"cost"`
-	Weight int `db:"weight"`
+	Cost     int64  `db:"cost"`
+	Currency string `db:"currency"`
+	Weight   int    `db:"weight"`
+
+	MeasuredWeight       int         `db:"measured_weight"`
+	WeightDiscrepancy    bool        `db:"weight_discrepancy"`
+	WeightOverrideReason pgtype.Text `db:"weight_override_reason"`
+
+	Length int `db:"length"`
+	Width  int `db:"width"`
+	Height int `db:"height"`
 
 	Packaging      string `db:"packaging"`
 	AdditionalFilm bool   `db:"additional_film"`
 
+	Status  string `db:"status"`
+	Version int64  `db:"version"`
+
 	ReceivedAt  pgtype.Timestamptz `db:"received_at"`
 	StorageTime pgtype.Interval    `db:"storage_time"`
+	// ExpiresAt is the end of the storage time, it is stored to find the expired orders by the index
+	ExpiresAt pgtype.Timestamptz `db:"expires_at"`
+
+	StorageExtensions int         `db:"storage_extensions"`
+	ExtendedBy        pgtype.Text `db:"extended_by"`
+
+	PaidStorageDays    int    `db:"paid_storage_days"`
+	DailyStorageFee    int64  `db:"daily_storage_fee"`
+	StorageFeeCurrency string `db:"storage_fee_currency"`
+	StorageFeePaid     int64  `db:"storage_fee_paid"`
+
+	PickupCodeHash    pgtype.Text        `db:"pickup_code_hash"`
+	PickupAttempts    int                `db:"pickup_attempts"`
+	PickupLockedUntil pgtype.Timestamptz `db:"pickup_locked_until"`
 
 	IssuedAt   pgtype.Timestamptz `db:"issued_at"`
 	ReturnedAt pgtype.Timestamptz `db:"returned_at"`
 
+	ReturnReason     pgtype.Text `db:"return_reason"`
+	ReturnInspection pgtype.Text `db:"return_inspection"`
+	ReturnComment    pgtype.Text `db:"return_comment"`
+
 	DeletedAt pgtype.Timestamptz `db:"deleted_at"`
 }
 
@@ -50,22 +81,63 @@ func newPgxPvzOrder(order domain.PVZOrder) pgxPvzOrder {
 		PVZID:       order.PVZID,
 		RecipientID: order.RecipientID,
 
-		Cost:   order.Cost,
-		Weight: order.Weight,
+		Cost:     order.Cost.Amount,
+		Currency: order.Cost.Currency.String(),
+		Weight:   order.Weight,
+
+		MeasuredWeight:       order.MeasuredWeight,
+		WeightDiscrepancy:    order.WeightDiscrepancy,
+		WeightOverrideReason: newText(order.WeightOverrideReason),
+
+		Length: order.Dimensions.Length,
+		Width:  order.Dimensions.Width,
+		Height: order.Dimensions.Height,
 
 		Packaging:      order.Packaging.String(),
 		AdditionalFilm: order.AdditionalFilm,
 
+		Status:  order.Status.String(),
+		Version: order.Version,
+
 		ReceivedAt:  newTimestamptz(order.ReceivedAt),
 		StorageTime: newInterval(order.StorageTime),
+		ExpiresAt:   newTimestamptz(order.ExpiresAt()),
+
+		StorageExtensions: order.StorageExtensions,
+		ExtendedBy:        newText(order.ExtendedBy),
+
+		PaidStorageDays:    order.PaidStorage.Days,
+		DailyStorageFee:    order.PaidStorage.DailyFee.Amount,
+		StorageFeeCurrency: storageFeeCurrency(order).String(),
+		StorageFeePaid:     order.StorageFeePaid.Amount,
+
+		PickupCodeHash:    newText(order.PickupCodeHash),
+		PickupAttempts:    order.PickupAttempts,
+		PickupLockedUntil: newTimestamptz(order.PickupLockedUntil),
 
 		IssuedAt:   newTimestamptz(order.IssuedAt),
 		ReturnedAt: newTimestamptz(order.ReturnedAt),
 
+		ReturnReason:     newText(order.ReturnDetails.Reason.String()),
+		ReturnInspection: newText(order.ReturnDetails.Inspection.String()),
+		ReturnComment:    newText(order.ReturnDetails.Comment),
+
 		DeletedAt: newTimestamptz(time.Time{}),
 	}
 }
 
+// storageFeeCurrency is the currency of the daily storage fee, the orders without the paid storage use the default one
+func storageFeeCurrency(order domain.PVZOrder) domain.Currency {
+	if order.PaidStorage.DailyFee.Currency == "" {
+		return domain.DefaultCurrency
+	}
+	return order.PaidStorage.DailyFee.Currency
+}
+
+func newText(s string) pgtype.Text {
+	return pgtype.Text{String: s, Valid: s != ""}
+}
+
 func intervalToDuration(i pgtype.Interval) time.Duration {
 	const (
 		microsecondsPerSecond = 1000000
@@ -84,16 +156,144 @@ func (p *pgxPvzOrder) ToDomain() domain.PVZOrder {
 		PVZID:       p.PVZID,
 		RecipientID: p.RecipientID,
 
-		Cost:   p.Cost,
+		Cost:   domain.NewMoney(p.Cost, domain.Currency(p.Currency)),
 		Weight: p.Weight,
 
+		MeasuredWeight:       p.MeasuredWeight,
+		WeightDiscrepancy:    p.WeightDiscrepancy,
+		WeightOverrideReason: p.WeightOverrideReason.String,
+
+		Dimensions: domain.Dimensions{
+			Length: p.Length,
+			Width:  p.Width,
+			Height: p.Height,
+		},
+
 		Packaging:      domain.PackagingType(p.Packaging),
 		AdditionalFilm: p.AdditionalFilm,
 
+		Status:  domain.OrderStatus(p.Status),
+		Version: p.Version,
+
 		ReceivedAt:  p.ReceivedAt.Time,
 		StorageTime: intervalToDuration(p.StorageTime),
+		Expiry:      p.ExpiresAt.Time,
+
+		StorageExtensions: p.StorageExtensions,
+		ExtendedBy:        p.ExtendedBy.String,
+
+		PaidStorage: domain.PaidStorage{
+			Days:     p.PaidStorageDays,
+			DailyFee: domain.NewMoney(p.DailyStorageFee, domain.Currency(p.StorageFeeCurrency)),
+		},
+		StorageFeePaid: domain.NewMoney(p.StorageFeePaid, domain.Currency(p.StorageFeeCurrency)),
+
+		PickupCodeHash:    p.PickupCodeHash.String,
+		PickupAttempts:    p.PickupAttempts,
+		PickupLockedUntil: p.PickupLockedUntil.Time,
 
 		IssuedAt:   p.IssuedAt.Time,
 		ReturnedAt: p.ReturnedAt.Time,
+
+		ReturnDetails: domain.ReturnDetails{
+			Reason:     domain.ReturnReason(p.ReturnReason.String),
+			Inspection: domain.InspectionOutcome(p.ReturnInspection.String),
+			Comment:    p.ReturnComment.String,
+		},
+	}
+}
+
+type pgxReturnShipment struct {
+	ID           uuid.UUID          `db:"id"`
+	PVZID        string             `db:"pvz_id"`
+	Status       string             `db:"status"`
+	CourierID    pgtype.Text        `db:"courier_id"`
+	Manifest     *returnManifest    `db:"manifest"`
+	CreatedAt    pgtype.Timestamptz `db:"created_at"`
+	DispatchedAt pgtype.Timestamptz `db:"dispatched_at"`
+}
+
+// returnManifest is the manifest of the dispatched shipment as it is stored in the manifest column
+type returnManifest struct {
+	Items []returnManifestItem `json:"items"`
+}
+
+type returnManifestItem struct {
+	OrderID     string    `json:"order_id"`
+	RecipientID string    `json:"recipient_id"`
+	Status      string    `json:"status"`
+	Cost        int64     `json:"cost"`
+	Currency    string    `json:"currency"`
+	Weight      int       `json:"weight"`
+	Packaging   string    `json:"packaging"`
+	Reason      string    `json:"reason,omitempty"`
+	Inspection  string    `json:"inspection,omitempty"`
+	Comment     string    `json:"comment,omitempty"`
+	ReturnedAt  time.Time `json:"returned_at"`
+}
+
+func newReturnManifest(manifest domain.ReturnManifest) returnManifest {
+	result := returnManifest{Items: make([]returnManifestItem, len(manifest.Items))}
+	for i, item := range manifest.Items {
+		result.Items[i] = returnManifestItem{
+			OrderID:     item.OrderID,
+			RecipientID: item.RecipientID,
+			Status:      item.Status.String(),
+			Cost:        item.Cost.Amount,
+			Currency:    item.Cost.Currency.String(),
+			Weight:      item.Weight,
+			Packaging:   item.Packaging.String(),
+			Reason:      string(item.ReturnDetails.Reason),
+			Inspection:  string(item.ReturnDetails.Inspection),
+			Comment:     item.ReturnDetails.Comment,
+			ReturnedAt:  item.ReturnedAt,
+		}
+	}
+	return result
+}
+
+func (m returnManifest) ToDomain(shipment domain.ReturnShipment) domain.ReturnManifest {
+	result := domain.ReturnManifest{
+		ShipmentID:   shipment.ID,
+		PVZID:        shipment.PVZID,
+		CourierID:    shipment.CourierID,
+		Items:        make([]domain.ReturnManifestItem, len(m.Items)),
+		DispatchedAt: shipment.DispatchedAt,
+	}
+	for i, item := range m.Items {
+		result.Items[i] = domain.ReturnManifestItem{
+			OrderID:     item.OrderID,
+			RecipientID: item.RecipientID,
+			Status:      domain.OrderStatus(item.Status),
+			Cost:        domain.NewMoney(item.Cost, domain.Currency(item.Currency)),
+			Weight:      item.Weight,
+			Packaging:   domain.PackagingType(item.Packaging),
+			ReturnDetails: domain.ReturnDetails{
+				Reason:     domain.ReturnReason(item.Reason),
+				Inspection: domain.InspectionOutcome(item.Inspection),
+				Comment:    item.Comment,
+			},
+			ReturnedAt: item.ReturnedAt,
+		}
 	}
+	return result
+}
+
+func (s pgxReturnShipment) ToDomain(orderIDs []string) domain.ReturnShipment {
+	shipment := domain.ReturnShipment{
+		ID:           s.ID.String(),
+		PVZID:        s.PVZID,
+		Status:       domain.ReturnShipmentStatus(s.Status),
+		OrderIDs:     orderIDs,
+		CourierID:    s.CourierID.String,
+		CreatedAt:    s.CreatedAt.Time,
+		DispatchedAt: s.DispatchedAt.Time,
+	}
+
+	if s.Manifest != nil {
+		manifest := s.Manifest.ToDomain(shipment)
+		shipment.Manifest = &manifest
+	}
+
+	return shipment
 }
@@ -0,0 +1,121 @@
+package pgx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"github.com/opentracing/opentracing-go"
+	"homework/internal/domain"
+	events "homework/internal/infrastructure/repositories/events/pgx"
+	"homework/internal/infrastructure/repositories/utils/pgx/txmanager"
+	"homework/internal/usecases"
+)
+
+var _ usecases.PVZPolicyRepository = &PVZPolicyRepository{}
+
+const policyColumns = `pvz_id, return_window, max_storage_time, max_order_weight, allowed_packaging, version, updated_by, updated_at`
+
+// PVZPolicyRepository stores the policies of the PVZs and writes their events in the same transaction
+type PVZPolicyRepository struct {
+	manager    *txmanager.PGXTXManager
+	eventsRepo *events.EventsRepository
+}
+
+func NewPVZPolicyRepository(manager *txmanager.PGXTXManager) *PVZPolicyRepository {
+	return &PVZPolicyRepository{
+		manager:    manager,
+		eventsRepo: events.NewEventsRepository(manager),
+	}
+}
+
+func (r *PVZPolicyRepository) GetPolicy(ctx context.Context, pvzID string) (domain.PVZPolicy, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZPolicyRepository.GetPolicy")
+	defer span.Finish()
+
+	const query = `SELECT ` + policyColumns + ` FROM pvz_policies WHERE pvz_id = $1`
+
+	engine := r.manager.GetQueryEngine(ctx)
+
+	var policy pgxPVZPolicy
+	if err := pgxscan.Get(ctx, engine, &policy, query, pvzID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.PVZPolicy{}, fmt.Errorf("%w: policy not found", domain.ErrNotFound)
+		}
+		return domain.PVZPolicy{}, err
+	}
+
+	return policy.ToDomain(), nil
+}
+
+func (r *PVZPolicyRepository) SavePolicy(ctx context.Context, policy domain.PVZPolicy, expectedVersion int64) (domain.PVZPolicy, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZPolicyRepository.SavePolicy")
+	defer span.Finish()
+
+	const upsertQuery = `
+		INSERT INTO pvz_policies (` + policyColumns + `)
+		VALUES ($1, $2, $3, $4, $5, 1, $6, $7)
+		ON CONFLICT (pvz_id) DO UPDATE SET
+			return_window = EXCLUDED.return_window,
+			max_storage_time = EXCLUDED.max_storage_time,
+			max_order_weight = EXCLUDED.max_order_weight,
+			allowed_packaging = EXCLUDED.allowed_packaging,
+			version = pvz_policies.version + 1,
+			updated_by = EXCLUDED.updated_by,
+			updated_at = EXCLUDED.updated_at
+		RETURNING ` + policyColumns
+
+	const updateQuery = `
+		UPDATE pvz_policies
+		SET return_window = $2, max_storage_time = $3, max_order_weight = $4, allowed_packaging = $5,
+			version = version + 1, updated_by = $6, updated_at = $7
+		WHERE pvz_id = $1 AND version = $8
+		RETURNING ` + policyColumns
+
+	p := newPgxPVZPolicy(policy)
+
+	var saved pgxPVZPolicy
+	err := r.manager.RunReadCommittedTransaction(ctx, func(ctx context.Context) error {
+		engine := r.manager.GetQueryEngine(ctx)
+
+		args := []any{p.PVZID, p.ReturnWindow, p.MaxStorageTime, p.MaxOrderWeight, p.AllowedPackaging, p.UpdatedBy, p.UpdatedAt}
+
+		query := upsertQuery
+		if expectedVersion != 0 {
+			query = updateQuery
+			args = append(args, expectedVersion)
+		}
+
+		if err := pgxscan.Get(ctx, engine, &saved, query, args...); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return r.versionMismatch(ctx, policy.PVZID, expectedVersion)
+			}
+			return err
+		}
+
+		return r.eventsRepo.Create(ctx, domain.NewPVZPolicyUpdatedEvent(saved.ToDomain()))
+	})
+	if err != nil {
+		return domain.PVZPolicy{}, err
+	}
+
+	return saved.ToDomain(), nil
+}
+
+// versionMismatch explains why the policy of the expected version was not updated
+func (r *PVZPolicyRepository) versionMismatch(ctx context.Context, pvzID string, expectedVersion int64) error {
+	engine := r.manager.GetQueryEngine(ctx)
+
+	const versionQuery = `SELECT version FROM pvz_policies WHERE pvz_id = $1`
+
+	var version int64
+	if err := engine.QueryRow(ctx, versionQuery, pvzID).Scan(&version); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return fmt.Errorf("%w: policy not found", domain.ErrNotFound)
+		}
+		return err
+	}
+
+	return fmt.Errorf("%w: policy of %s has version %d, expected %d", domain.ErrVersionMismatch, pvzID, version, expectedVersion)
+}
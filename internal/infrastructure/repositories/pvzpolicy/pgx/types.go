@@ -0,0 +1,87 @@
+package pgx
+
+import (
+	"github.com/jackc/pgx/v5/pgtype"
+	"homework/internal/domain"
+	"time"
+)
+
+// pgxPVZPolicy is a row of the stored policy, the NULL rules are not set by the administrator.
+// The nil AllowedPackaging is NULL, the empty one is the empty array of the rule which is set
+type pgxPVZPolicy struct {
+	PVZID            string             `db:"pvz_id"`
+	ReturnWindow     pgtype.Interval    `db:"return_window"`
+	MaxStorageTime   pgtype.Interval    `db:"max_storage_time"`
+	MaxOrderWeight   pgtype.Int4        `db:"max_order_weight"`
+	AllowedPackaging []string           `db:"allowed_packaging"`
+	Version          int64              `db:"version"`
+	UpdatedBy        string             `db:"updated_by"`
+	UpdatedAt        pgtype.Timestamptz `db:"updated_at"`
+}
+
+func newPgxPVZPolicy(policy domain.PVZPolicy) pgxPVZPolicy {
+	var packaging []string
+	if policy.IsSet(domain.PVZPolicyRuleAllowedPackaging) {
+		packaging = make([]string, len(policy.AllowedPackaging))
+		for i, p := range policy.AllowedPackaging {
+			packaging[i] = p.String()
+		}
+	}
+
+	return pgxPVZPolicy{
+		PVZID:            policy.PVZID,
+		ReturnWindow:     newInterval(policy.ReturnWindow, policy.IsSet(domain.PVZPolicyRuleReturnWindow)),
+		MaxStorageTime:   newInterval(policy.MaxStorageTime, policy.IsSet(domain.PVZPolicyRuleMaxStorageTime)),
+		MaxOrderWeight:   pgtype.Int4{Int32: int32(policy.MaxOrderWeight), Valid: policy.IsSet(domain.PVZPolicyRuleMaxOrderWeight)},
+		AllowedPackaging: packaging,
+		Version:          policy.Version,
+		UpdatedBy:        policy.UpdatedBy,
+		UpdatedAt:        pgtype.Timestamptz{Time: policy.UpdatedAt, Valid: !policy.UpdatedAt.IsZero()},
+	}
+}
+
+func (p *pgxPVZPolicy) ToDomain() domain.PVZPolicy {
+	policy := domain.PVZPolicy{
+		PVZID:     p.PVZID,
+		Version:   p.Version,
+		UpdatedBy: p.UpdatedBy,
+		UpdatedAt: p.UpdatedAt.Time,
+	}
+
+	if p.ReturnWindow.Valid {
+		policy.ReturnWindow = intervalToDuration(p.ReturnWindow)
+		policy.SetRules = append(policy.SetRules, domain.PVZPolicyRuleReturnWindow)
+	}
+	if p.MaxStorageTime.Valid {
+		policy.MaxStorageTime = intervalToDuration(p.MaxStorageTime)
+		policy.SetRules = append(policy.SetRules, domain.PVZPolicyRuleMaxStorageTime)
+	}
+	if p.MaxOrderWeight.Valid {
+		policy.MaxOrderWeight = int(p.MaxOrderWeight.Int32)
+		policy.SetRules = append(policy.SetRules, domain.PVZPolicyRuleMaxOrderWeight)
+	}
+	if p.AllowedPackaging != nil {
+		for _, packaging := range p.AllowedPackaging {
+			policy.AllowedPackaging = append(policy.AllowedPackaging, domain.PackagingType(packaging))
+		}
+		policy.SetRules = append(policy.SetRules, domain.PVZPolicyRuleAllowedPackaging)
+	}
+
+	return policy
+}
+
+func newInterval(d time.Duration, valid bool) pgtype.Interval {
+	return pgtype.Interval{Microseconds: d.Microseconds(), Valid: valid}
+}
+
+func intervalToDuration(i pgtype.Interval) time.Duration {
+	const (
+		microsecondsPerSecond = 1000000
+		microsecondsPerMinute = 60 * microsecondsPerSecond
+		microsecondsPerHour   = 60 * microsecondsPerMinute
+		microsecondsPerDay    = 24 * microsecondsPerHour
+		microsecondsPerMonth  = 30 * microsecondsPerDay
+	)
+
+	return time.Duration((int64(i.Months)*microsecondsPerMonth + int64(i.Days)*microsecondsPerDay + i.Microseconds) * 1000)
+}
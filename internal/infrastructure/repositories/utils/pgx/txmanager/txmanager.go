@@ -2,6 +2,8 @@ package txmanager
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -9,10 +11,24 @@ import (
 
 type (
 	key   string
-	inner func(context.Context) error
+	inner = func(context.Context) error
 )
 
-const engineKey key = "engine"
+const (
+	engineKey key = "engine"
+	levelKey  key = "level"
+)
+
+// ErrIsolationLevel is returned if a nested transaction needs a stronger isolation level than the outer one has
+var ErrIsolationLevel = errors.New("isolation level of the outer transaction is too weak")
+
+// isolationStrength orders the isolation levels from the weakest to the strongest
+var isolationStrength = map[pgx.TxIsoLevel]int{
+	pgx.ReadUncommitted: 0,
+	pgx.ReadCommitted:   1,
+	pgx.RepeatableRead:  2,
+	pgx.Serializable:    3,
+}
 
 type QueryEngine interface {
 	Exec(ctx context.Context, sql string, arguments ...interface{}) (pgconn.CommandTag, error)
@@ -44,8 +60,24 @@ func (p *PGXTXManager) RunSerializableTransaction(ctx context.Context, f inner)
 	return p.runTransaction(ctx, pgx.Serializable, f)
 }
 
+// runTransaction runs f in a new transaction. If the context already has one, f runs in a savepoint of it,
+// so the writes join the outer transaction with its isolation level and are committed along with it.
+// ErrIsolationLevel is returned if the outer transaction is weaker than the requested level
 func (p *PGXTXManager) runTransaction(ctx context.Context, level pgx.TxIsoLevel, f inner) error {
-	tx, err := p.pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: level})
+	var (
+		tx  pgx.Tx
+		err error
+	)
+	if outer, ok := ctx.Value(engineKey).(pgx.Tx); ok {
+		outerLevel, _ := ctx.Value(levelKey).(pgx.TxIsoLevel)
+		if isolationStrength[level] > isolationStrength[outerLevel] {
+			return fmt.Errorf("%w: %s is requested inside %s", ErrIsolationLevel, level, outerLevel)
+		}
+		tx, err = outer.Begin(ctx)
+	} else {
+		tx, err = p.pool.BeginTx(ctx, pgx.TxOptions{IsoLevel: level})
+		ctx = context.WithValue(ctx, levelKey, level)
+	}
 	if err != nil {
 		return err
 	}
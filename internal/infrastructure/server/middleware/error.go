@@ -29,6 +29,15 @@ func NewErrorMiddleware() grpc.UnaryServerInterceptor {
 			if errors.Is(err, domain.ErrInvalidArgument) {
 				return nil, status.Errorf(codes.InvalidArgument, err.Error())
 			}
+			if errors.Is(err, domain.ErrConflict) {
+				return nil, status.Errorf(codes.FailedPrecondition, err.Error())
+			}
+			if errors.Is(err, domain.ErrVersionMismatch) {
+				return nil, status.Errorf(codes.Aborted, err.Error())
+			}
+			if errors.Is(err, domain.ErrTooManyAttempts) {
+				return nil, status.Errorf(codes.ResourceExhausted, err.Error())
+			}
 			log.Printf("[interceptor.Error] method: %s; error: %s", info.FullMethod, err.Error())
 			return nil, status.Error(codes.Internal, "internal server error")
 		}
@@ -0,0 +1,153 @@
+package middleware
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+
+	"homework/internal/abstractions"
+	"homework/internal/domain"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i IdempotencyStore -s _mock.go -o ./mocks
+
+// IdempotencyKeyMetadataKey is a metadata key (and, through the gateway, an HTTP header) with the idempotency key of the request
+const IdempotencyKeyMetadataKey = "idempotency-key"
+
+// MaxIdempotencyKeyLength limits the keys the clients generate, a UUID fits into it
+const MaxIdempotencyKeyLength = 255
+
+// IdempotencyStore keeps the responses of the requests by their idempotency keys
+type IdempotencyStore interface {
+	// Do runs f once per key in a transaction which f joins with its writes, and stores the response f returns.
+	// If the key is already used, the stored response is returned without running f,
+	// the fingerprint of the request must be the same then
+	Do(ctx context.Context, key, fingerprint string, f func(ctx context.Context) ([]byte, error)) ([]byte, error)
+}
+
+// NewIdempotencyMiddleware makes the given methods safe to retry: the requests with the same
+// idempotency key are handled once and the retries get the stored response.
+// The requests without the key and the other methods are handled as usual
+func NewIdempotencyMiddleware(store IdempotencyStore, methods ...string) grpc.UnaryServerInterceptor {
+	idempotent := make(map[string]struct{}, len(methods))
+	for _, method := range methods {
+		idempotent[method] = struct{}{}
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if _, ok := idempotent[info.FullMethod]; !ok {
+			return handler(ctx, req)
+		}
+
+		key, err := idempotencyKeyFromMetadata(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if key == "" {
+			return handler(ctx, req)
+		}
+
+		span, ctx := opentracing.StartSpanFromContext(ctx, "server.middleware.Idempotency")
+		defer span.Finish()
+
+		fingerprint, err := requestFingerprint(ctx, info.FullMethod, req)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp any
+		stored, err := store.Do(ctx, key, fingerprint, func(ctx context.Context) ([]byte, error) {
+			var err error
+			resp, err = handler(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+
+			return marshalResponse(resp)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		// The handler has not run, it is a retry
+		if resp == nil {
+			span.SetTag("replayed", true)
+			return unmarshalResponse(stored)
+		}
+
+		return resp, nil
+	}
+}
+
+func idempotencyKeyFromMetadata(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", nil
+	}
+
+	values := md.Get(IdempotencyKeyMetadataKey)
+	if len(values) == 0 {
+		return "", nil
+	}
+
+	if len(values[0]) > MaxIdempotencyKeyLength {
+		return "", fmt.Errorf("%w: %s is longer than %d characters", domain.ErrInvalidArgument, IdempotencyKeyMetadataKey, MaxIdempotencyKeyLength)
+	}
+
+	return values[0], nil
+}
+
+// requestFingerprint identifies the request, so the key can not be reused for another method, PVZ or body
+func requestFingerprint(ctx context.Context, method string, req any) (string, error) {
+	message, ok := req.(proto.Message)
+	if !ok {
+		return "", fmt.Errorf("unexpected request type %T", req)
+	}
+
+	body, err := proto.MarshalOptions{Deterministic: true}.Marshal(message)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	pvzID, _ := abstractions.PVZIDFromContext(ctx)
+
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	hash.Write([]byte{0})
+	hash.Write([]byte(pvzID))
+	hash.Write([]byte{0})
+	hash.Write(body)
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// marshalResponse keeps the type of the response along with it, so it can be replayed for any method
+func marshalResponse(resp any) ([]byte, error) {
+	message, ok := resp.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response type %T", resp)
+	}
+
+	packed, err := anypb.New(message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack response: %w", err)
+	}
+
+	return proto.Marshal(packed)
+}
+
+func unmarshalResponse(data []byte) (any, error) {
+	var packed anypb.Any
+	if err := proto.Unmarshal(data, &packed); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal stored response: %w", err)
+	}
+
+	return packed.UnmarshalNew()
+}
@@ -0,0 +1,175 @@
+package middleware
+
+import (
+	"context"
+	"homework/internal/abstractions"
+	"homework/internal/domain"
+	"homework/internal/infrastructure/server/middleware/mocks"
+	desc "homework/pkg/pvz-service/v1"
+	"strings"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	t.Parallel()
+
+	const method = desc.PvzService_AcceptOrderDelivery_FullMethodName
+
+	req := &desc.AcceptOrderDeliveryRequest{OrderId: "orderID", RecipientId: "recipientID"}
+	handled := &desc.AcceptOrderDeliveryResponse{Order: &desc.PVZOrder{OrderId: "orderID"}}
+	stored := &desc.AcceptOrderDeliveryResponse{Order: &desc.PVZOrder{OrderId: "storedOrderID"}}
+
+	storedBytes, err := marshalResponse(stored)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		method      string
+		key         string
+		setup       func(storeMock *mocks.IdempotencyStoreMock)
+		wantHandled bool
+		want        proto.Message
+		wantErr     assert.ErrorAssertionFunc
+	}{
+		{
+			name:        "Without key",
+			method:      method,
+			wantHandled: true,
+			want:        handled,
+			wantErr:     assert.NoError,
+		},
+		{
+			name:        "Not mutating method",
+			method:      desc.PvzService_GetOrders_FullMethodName,
+			key:         "key",
+			wantHandled: true,
+			want:        handled,
+			wantErr:     assert.NoError,
+		},
+		{
+			name:   "First request",
+			method: method,
+			key:    "key",
+			setup: func(storeMock *mocks.IdempotencyStoreMock) {
+				storeMock.DoMock.Set(func(ctx context.Context, key, fingerprint string, f func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+					return f(ctx)
+				})
+			},
+			wantHandled: true,
+			want:        handled,
+			wantErr:     assert.NoError,
+		},
+		{
+			name:   "Retry",
+			method: method,
+			key:    "key",
+			setup: func(storeMock *mocks.IdempotencyStoreMock) {
+				storeMock.DoMock.Return(storedBytes, nil)
+			},
+			want:    stored,
+			wantErr: assert.NoError,
+		},
+		{
+			name:   "Key is used for another request",
+			method: method,
+			key:    "key",
+			setup: func(storeMock *mocks.IdempotencyStoreMock) {
+				storeMock.DoMock.Return(nil, domain.ErrConflict)
+			},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, domain.ErrConflict, i...)
+			},
+		},
+		{
+			name:   "Too long key",
+			method: method,
+			key:    strings.Repeat("k", MaxIdempotencyKeyLength+1),
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, domain.ErrInvalidArgument, i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := minimock.NewController(t)
+			storeMock := mocks.NewIdempotencyStoreMock(ctrl)
+			if tt.setup != nil {
+				tt.setup(storeMock)
+			}
+
+			ctx := abstractions.ContextWithPVZID(context.Background(), "pvzID")
+			if tt.key != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(IdempotencyKeyMetadataKey, tt.key))
+			}
+
+			var gotHandled bool
+			handler := func(ctx context.Context, req any) (any, error) {
+				gotHandled = true
+				return handled, nil
+			}
+
+			interceptor := NewIdempotencyMiddleware(storeMock, method)
+			got, err := interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if !tt.wantErr(t, err) {
+				return
+			}
+
+			assert.Equal(t, tt.wantHandled, gotHandled)
+			if tt.want != nil {
+				assert.True(t, proto.Equal(tt.want, got.(proto.Message)), "got %v", got)
+			}
+		})
+	}
+}
+
+func TestIdempotencyMiddleware_HandlerError(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	storeMock := mocks.NewIdempotencyStoreMock(ctrl)
+	storeMock.DoMock.Set(func(ctx context.Context, key, fingerprint string, f func(ctx context.Context) ([]byte, error)) ([]byte, error) {
+		return f(ctx)
+	})
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(IdempotencyKeyMetadataKey, "key"))
+	handler := func(ctx context.Context, req any) (any, error) {
+		return nil, domain.ErrAlreadyExists
+	}
+
+	interceptor := NewIdempotencyMiddleware(storeMock, desc.PvzService_AcceptOrderDelivery_FullMethodName)
+	_, err := interceptor(ctx, &desc.AcceptOrderDeliveryRequest{}, &grpc.UnaryServerInfo{FullMethod: desc.PvzService_AcceptOrderDelivery_FullMethodName}, handler)
+	assert.ErrorIs(t, err, domain.ErrAlreadyExists)
+}
+
+func TestRequestFingerprint(t *testing.T) {
+	t.Parallel()
+
+	ctx := abstractions.ContextWithPVZID(context.Background(), "pvzID")
+	req := &desc.AcceptOrderDeliveryRequest{OrderId: "orderID"}
+
+	fingerprint, err := requestFingerprint(ctx, desc.PvzService_AcceptOrderDelivery_FullMethodName, req)
+	assert.NoError(t, err)
+
+	same, err := requestFingerprint(ctx, desc.PvzService_AcceptOrderDelivery_FullMethodName, &desc.AcceptOrderDeliveryRequest{OrderId: "orderID"})
+	assert.NoError(t, err)
+	assert.Equal(t, fingerprint, same)
+
+	otherBody, err := requestFingerprint(ctx, desc.PvzService_AcceptOrderDelivery_FullMethodName, &desc.AcceptOrderDeliveryRequest{OrderId: "otherOrderID"})
+	assert.NoError(t, err)
+	assert.NotEqual(t, fingerprint, otherBody)
+
+	otherPVZ, err := requestFingerprint(abstractions.ContextWithPVZID(context.Background(), "otherPVZID"), desc.PvzService_AcceptOrderDelivery_FullMethodName, req)
+	assert.NoError(t, err)
+	assert.NotEqual(t, fingerprint, otherPVZ)
+}
@@ -0,0 +1,69 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+
+	"homework/internal/abstractions"
+	"homework/internal/domain"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// PVZIDMetadataKey is a metadata key (and, through the gateway, an HTTP header) with the PVZ ID of the request
+const PVZIDMetadataKey = "x-pvz-id"
+
+// PVZRegistry is a registry of known PVZs
+type PVZRegistry interface {
+	Exists(ctx context.Context, pvzID string) (bool, error)
+}
+
+// NewPVZMiddleware resolves the PVZ of the request from the metadata, checks it
+// against the registry and puts it into the request context. The skipped methods,
+// like the admin ones which take the PVZ in the request, are handled without it
+func NewPVZMiddleware(registry PVZRegistry, skipped ...string) grpc.UnaryServerInterceptor {
+	skip := make(map[string]struct{}, len(skipped))
+	for _, method := range skipped {
+		skip[method] = struct{}{}
+	}
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		if _, ok := skip[info.FullMethod]; ok {
+			return handler(ctx, req)
+		}
+
+		span, ctx := opentracing.StartSpanFromContext(ctx, "server.middleware.PVZ")
+		defer span.Finish()
+
+		pvzID, err := pvzIDFromMetadata(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		ok, err := registry.Exists(ctx, pvzID)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown pvz %s", domain.ErrInvalidArgument, pvzID)
+		}
+
+		return handler(abstractions.ContextWithPVZID(ctx, pvzID), req)
+	}
+}
+
+func pvzIDFromMetadata(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", fmt.Errorf("%w: metadata is not provided", domain.ErrInvalidArgument)
+	}
+
+	values := md.Get(PVZIDMetadataKey)
+	if len(values) == 0 || values[0] == "" {
+		return "", fmt.Errorf("%w: %s is not provided", domain.ErrInvalidArgument, PVZIDMetadataKey)
+	}
+
+	return values[0], nil
+}
@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"context"
+	"homework/internal/abstractions"
+	"homework/internal/domain"
+	desc "homework/pkg/pvz-service/v1"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type pvzRegistry map[string]struct{}
+
+func (r pvzRegistry) Exists(_ context.Context, pvzID string) (bool, error) {
+	_, ok := r[pvzID]
+	return ok, nil
+}
+
+func TestPVZMiddleware(t *testing.T) {
+	t.Parallel()
+
+	middleware := NewPVZMiddleware(pvzRegistry{"pvzID": {}}, desc.PvzService_UpdatePVZPolicy_FullMethodName)
+
+	tests := []struct {
+		name      string
+		method    string
+		pvzID     string
+		wantPVZID string
+		wantErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name:      "Known PVZ",
+			method:    desc.PvzService_GetOrders_FullMethodName,
+			pvzID:     "pvzID",
+			wantPVZID: "pvzID",
+			wantErr:   assert.NoError,
+		},
+		{
+			name:   "Unknown PVZ",
+			method: desc.PvzService_GetOrders_FullMethodName,
+			pvzID:  "unknownPVZID",
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, domain.ErrInvalidArgument, i...)
+			},
+		},
+		{
+			name:   "PVZ is not provided",
+			method: desc.PvzService_GetOrders_FullMethodName,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, domain.ErrInvalidArgument, i...)
+			},
+		},
+		{
+			name:    "Skipped method",
+			method:  desc.PvzService_UpdatePVZPolicy_FullMethodName,
+			wantErr: assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs())
+			if tt.pvzID != "" {
+				ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(PVZIDMetadataKey, tt.pvzID))
+			}
+
+			_, err := middleware(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, func(ctx context.Context, _ any) (any, error) {
+				pvzID, _ := abstractions.PVZIDFromContext(ctx)
+				assert.Equal(t, tt.wantPVZID, pvzID)
+				return nil, nil
+			})
+			tt.wantErr(t, err)
+		})
+	}
+}
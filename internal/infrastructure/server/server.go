@@ -18,36 +18,92 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 )
 
 type GRPCServer struct {
-	useCase abstractions.IPVZOrderUseCase
+	useCase         abstractions.IPVZOrderUseCase
+	handoverUseCase abstractions.IHandoverUseCase
+	policyUseCase   abstractions.IPVZPolicyUseCase
+	returnsUseCase  abstractions.IReturnShipmentUseCase
+	registry        middleware.PVZRegistry
+	idempotency     middleware.IdempotencyStore
 }
 
-func NewGRPCServer(useCase abstractions.IPVZOrderUseCase) *GRPCServer {
+func NewGRPCServer(
+	useCase abstractions.IPVZOrderUseCase,
+	handoverUseCase abstractions.IHandoverUseCase,
+	policyUseCase abstractions.IPVZPolicyUseCase,
+	returnsUseCase abstractions.IReturnShipmentUseCase,
+	registry middleware.PVZRegistry,
+	idempotency middleware.IdempotencyStore,
+) *GRPCServer {
 	return &GRPCServer{
-		useCase: useCase,
+		useCase:         useCase,
+		handoverUseCase: handoverUseCase,
+		policyUseCase:   policyUseCase,
+		returnsUseCase:  returnsUseCase,
+		registry:        registry,
+		idempotency:     idempotency,
 	}
 }
 
+// mutatingMethods are retried by the clients with an idempotency key
+var mutatingMethods = []string{
+	desc.PvzService_AcceptOrderDelivery_FullMethodName,
+	desc.PvzService_BatchAcceptOrderDelivery_FullMethodName,
+	desc.PvzService_ReturnOrderDelivery_FullMethodName,
+	desc.PvzService_GiveOrderToClient_FullMethodName,
+	desc.PvzService_AcceptReturn_FullMethodName,
+	desc.PvzService_ExtendStorage_FullMethodName,
+	desc.PvzService_OpenHandoverSession_FullMethodName,
+	desc.PvzService_ScanHandoverParcel_FullMethodName,
+	desc.PvzService_CloseHandoverSession_FullMethodName,
+	desc.PvzService_UpdatePVZPolicy_FullMethodName,
+	desc.PvzService_CreateReturnShipment_FullMethodName,
+	desc.PvzService_DispatchReturnShipment_FullMethodName,
+}
+
+// adminMethods are served for any PVZ given in the request, so they do not need the PVZ header
+var adminMethods = []string{
+	desc.PvzService_GetPVZPolicy_FullMethodName,
+	desc.PvzService_UpdatePVZPolicy_FullMethodName,
+}
+
+// incomingHeaderMatcher passes the PVZ and the idempotency key headers through the gateway along with the default ones
+func incomingHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, middleware.PVZIDMetadataKey) {
+		return middleware.PVZIDMetadataKey, true
+	}
+	if strings.EqualFold(key, middleware.IdempotencyKeyMetadataKey) {
+		return middleware.IdempotencyKeyMetadataKey, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 func (s *GRPCServer) Run(ctx context.Context, host string, grpcPort, httpPort int) error {
 	// Create a new server instance
 	srv := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			middleware.StdLogging,
 			middleware.NewErrorMiddleware(),
+			middleware.NewPVZMiddleware(s.registry, adminMethods...),
+			middleware.NewIdempotencyMiddleware(s.idempotency, mutatingMethods...),
 		),
 	)
 
 	// Register the service
-	desc.RegisterPvzServiceServer(srv, pvzService.NewPVZService(s.useCase))
+	desc.RegisterPvzServiceServer(srv, pvzService.NewPVZService(s.useCase, s.handoverUseCase, s.policyUseCase, s.returnsUseCase))
 
 	// Reflect the service
 	reflection.Register(srv)
 
 	// Create gateway
-	gatewayMux := runtime.NewServeMux()
+	gatewayMux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(incomingHeaderMatcher),
+	)
 	err := desc.RegisterPvzServiceHandlerFromEndpoint(
 		ctx,
 		gatewayMux,
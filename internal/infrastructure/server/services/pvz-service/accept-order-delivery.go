@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/opentracing/opentracing-go"
-	"google.golang.org/protobuf/types/known/emptypb"
+	"homework/internal/abstractions"
 	"homework/internal/domain"
 	desc "homework/pkg/pvz-service/v1"
 )
@@ -22,27 +22,65 @@ func packagingTypeFromProto(packaging desc.PackagingType) domain.PackagingType {
 	}
 }
 
-func (p *PVZService) AcceptOrderDelivery(ctx context.Context, req *desc.AcceptOrderDeliveryRequest) (*emptypb.Empty, error) {
+// packagingFromRequest prefers the packaging code of the catalog and falls back to the legacy enum
+func packagingFromRequest(req *desc.AcceptOrderDeliveryRequest) (domain.PackagingType, error) {
+	if req.GetPackagingCode() != "" {
+		return domain.NewPackagingType(req.GetPackagingCode())
+	}
+
+	packaging := packagingTypeFromProto(req.GetPackaging())
+	if packaging == domain.PackagingTypeUnknown {
+		return domain.PackagingTypeUnknown, fmt.Errorf("%w: packaging is not provided", domain.ErrInvalidArgument)
+	}
+
+	return packaging, nil
+}
+
+// dimensionsFromProto converts the dimensions of the request, nil means the dimensions are unknown
+func dimensionsFromProto(dimensions *desc.Dimensions) (domain.Dimensions, error) {
+	if dimensions == nil {
+		return domain.Dimensions{}, nil
+	}
+
+	return domain.NewDimensions(int(dimensions.GetLength()), int(dimensions.GetWidth()), int(dimensions.GetHeight()))
+}
+
+// acceptOptions passes the measured weight and the override reason of the parcel if they are set
+func acceptOptions(item domain.DeliveryItem) []abstractions.AcceptOptFunc {
+	var options []abstractions.AcceptOptFunc
+	if item.MeasuredWeight != 0 {
+		options = append(options, abstractions.WithMeasuredWeight(item.MeasuredWeight))
+	}
+	if item.WeightOverrideReason != "" {
+		options = append(options, abstractions.WithWeightOverrideReason(item.WeightOverrideReason))
+	}
+	return options
+}
+
+func (p *PVZService) AcceptOrderDelivery(ctx context.Context, req *desc.AcceptOrderDeliveryRequest) (*desc.AcceptOrderDeliveryResponse, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZService.AcceptOrderDelivery")
 	defer span.Finish()
 
-	if err := req.ValidateAll(); err != nil {
-		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidArgument, err)
+	item, err := descToDomainDeliveryItem(req)
+	if err != nil {
+		return nil, err
 	}
 
-	err := p.useCase.AcceptOrderDelivery(
+	order, err := p.useCase.AcceptOrderDelivery(
 		ctx,
-		req.GetOrderId(),
-		req.GetRecipientId(),
-		req.GetStorageTime().AsDuration(),
-		int(req.GetCost()),
-		int(req.GetWeight()),
-		packagingTypeFromProto(req.Packaging),
-		req.GetAdditionalFilm(),
+		item.OrderID,
+		item.RecipientID,
+		item.StorageTime,
+		item.Cost,
+		item.Weight,
+		item.Dimensions,
+		item.Packaging,
+		item.AdditionalFilm,
+		acceptOptions(item)...,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return &emptypb.Empty{}, nil
+	return &desc.AcceptOrderDeliveryResponse{Order: domainToDescOrder(&order)}, nil
 }
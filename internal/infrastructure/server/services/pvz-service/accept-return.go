@@ -5,10 +5,71 @@ import (
 	"fmt"
 	"github.com/opentracing/opentracing-go"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"homework/internal/abstractions"
 	"homework/internal/domain"
 	desc "homework/pkg/pvz-service/v1"
 )
 
+func domainReturnReasonToDesc(reason domain.ReturnReason) desc.ReturnReason {
+	switch reason {
+	case domain.ReturnReasonDefect:
+		return desc.ReturnReason_RETURN_REASON_DEFECT
+	case domain.ReturnReasonWrongItem:
+		return desc.ReturnReason_RETURN_REASON_WRONG_ITEM
+	case domain.ReturnReasonNotAsDescribed:
+		return desc.ReturnReason_RETURN_REASON_NOT_AS_DESCRIBED
+	case domain.ReturnReasonChangedMind:
+		return desc.ReturnReason_RETURN_REASON_CHANGED_MIND
+	case domain.ReturnReasonOther:
+		return desc.ReturnReason_RETURN_REASON_OTHER
+	default:
+		return desc.ReturnReason_RETURN_REASON_UNKNOWN
+	}
+}
+
+func returnReasonFromProto(reason desc.ReturnReason) domain.ReturnReason {
+	switch reason {
+	case desc.ReturnReason_RETURN_REASON_DEFECT:
+		return domain.ReturnReasonDefect
+	case desc.ReturnReason_RETURN_REASON_WRONG_ITEM:
+		return domain.ReturnReasonWrongItem
+	case desc.ReturnReason_RETURN_REASON_NOT_AS_DESCRIBED:
+		return domain.ReturnReasonNotAsDescribed
+	case desc.ReturnReason_RETURN_REASON_CHANGED_MIND:
+		return domain.ReturnReasonChangedMind
+	case desc.ReturnReason_RETURN_REASON_OTHER:
+		return domain.ReturnReasonOther
+	default:
+		return domain.ReturnReasonUnknown
+	}
+}
+
+func domainInspectionOutcomeToDesc(outcome domain.InspectionOutcome) desc.InspectionOutcome {
+	switch outcome {
+	case domain.InspectionOutcomeResellable:
+		return desc.InspectionOutcome_INSPECTION_OUTCOME_RESELLABLE
+	case domain.InspectionOutcomeDamaged:
+		return desc.InspectionOutcome_INSPECTION_OUTCOME_DAMAGED
+	case domain.InspectionOutcomeIncomplete:
+		return desc.InspectionOutcome_INSPECTION_OUTCOME_INCOMPLETE
+	default:
+		return desc.InspectionOutcome_INSPECTION_OUTCOME_UNKNOWN
+	}
+}
+
+func inspectionOutcomeFromProto(outcome desc.InspectionOutcome) domain.InspectionOutcome {
+	switch outcome {
+	case desc.InspectionOutcome_INSPECTION_OUTCOME_RESELLABLE:
+		return domain.InspectionOutcomeResellable
+	case desc.InspectionOutcome_INSPECTION_OUTCOME_DAMAGED:
+		return domain.InspectionOutcomeDamaged
+	case desc.InspectionOutcome_INSPECTION_OUTCOME_INCOMPLETE:
+		return domain.InspectionOutcomeIncomplete
+	default:
+		return domain.InspectionOutcomeUnknown
+	}
+}
+
 func (p *PVZService) AcceptReturn(ctx context.Context, req *desc.AcceptReturnRequest) (*emptypb.Empty, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZService.AcceptReturn")
 	defer span.Finish()
@@ -17,10 +78,21 @@ func (p *PVZService) AcceptReturn(ctx context.Context, req *desc.AcceptReturnReq
 		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidArgument, err)
 	}
 
+	var options []abstractions.MutationOptFunc
+	if req.ExpectedVersion != nil {
+		options = append(options, abstractions.WithExpectedVersion(req.GetExpectedVersion()))
+	}
+
 	err := p.useCase.AcceptReturn(
 		ctx,
 		req.GetUserId(),
 		req.GetOrderId(),
+		domain.ReturnDetails{
+			Reason:     returnReasonFromProto(req.GetReason()),
+			Inspection: inspectionOutcomeFromProto(req.GetInspection()),
+			Comment:    req.GetComment(),
+		},
+		options...,
 	)
 	if err != nil {
 		return nil, err
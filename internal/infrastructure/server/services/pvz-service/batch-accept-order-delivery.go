@@ -0,0 +1,105 @@
+package pvz_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"homework/internal/domain"
+	desc "homework/pkg/pvz-service/v1"
+)
+
+func (p *PVZService) BatchAcceptOrderDelivery(ctx context.Context, req *desc.BatchAcceptOrderDeliveryRequest) (*desc.BatchAcceptOrderDeliveryResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZService.BatchAcceptOrderDelivery")
+	defer span.Finish()
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidArgument, err)
+	}
+
+	// The invalid items are reported right away, the rest are accepted by the use case
+	results := make([]domain.DeliveryResult, len(req.GetItems()))
+	items := make([]domain.DeliveryItem, 0, len(req.GetItems()))
+	indexes := make([]int, 0, len(req.GetItems()))
+
+	for i, reqItem := range req.GetItems() {
+		item, err := descToDomainDeliveryItem(reqItem)
+		if err != nil {
+			results[i] = domain.DeliveryResult{OrderID: reqItem.GetOrderId(), Err: err}
+			continue
+		}
+
+		items = append(items, item)
+		indexes = append(indexes, i)
+	}
+
+	if len(items) > 0 {
+		accepted, err := p.useCase.BatchAcceptOrderDelivery(ctx, items)
+		if err != nil {
+			return nil, err
+		}
+
+		for i, result := range accepted {
+			results[indexes[i]] = result
+		}
+	}
+
+	descResults := make([]*desc.AcceptOrderDeliveryResult, 0, len(results))
+	for _, result := range results {
+		descResults = append(descResults, domainToDescDeliveryResult(result))
+	}
+
+	return &desc.BatchAcceptOrderDeliveryResponse{
+		Results: descResults,
+	}, nil
+}
+
+func descToDomainDeliveryItem(req *desc.AcceptOrderDeliveryRequest) (domain.DeliveryItem, error) {
+	if err := req.ValidateAll(); err != nil {
+		return domain.DeliveryItem{}, fmt.Errorf("%w: %v", domain.ErrInvalidArgument, err)
+	}
+
+	packaging, err := packagingFromRequest(req)
+	if err != nil {
+		return domain.DeliveryItem{}, err
+	}
+
+	dimensions, err := dimensionsFromProto(req.GetDimensions())
+	if err != nil {
+		return domain.DeliveryItem{}, err
+	}
+
+	cost, err := costFromRequest(req.GetCostMoney(), req.GetCost())
+	if err != nil {
+		return domain.DeliveryItem{}, err
+	}
+
+	return domain.DeliveryItem{
+		OrderID:              req.GetOrderId(),
+		RecipientID:          req.GetRecipientId(),
+		StorageTime:          req.GetStorageTime().AsDuration(),
+		Cost:                 cost,
+		Weight:               int(req.GetWeight()),
+		Dimensions:           dimensions,
+		Packaging:            packaging,
+		AdditionalFilm:       req.GetAdditionalFilm(),
+		MeasuredWeight:       int(req.GetMeasuredWeight()),
+		WeightOverrideReason: req.GetWeightOverrideReason(),
+	}, nil
+}
+
+func domainToDescDeliveryResult(result domain.DeliveryResult) *desc.AcceptOrderDeliveryResult {
+	descResult := &desc.AcceptOrderDeliveryResult{
+		OrderId: result.OrderID,
+		Success: result.Err == nil,
+	}
+
+	if result.Err != nil {
+		errMsg := result.Err.Error()
+		descResult.Error = &errMsg
+		return descResult
+	}
+
+	descResult.Order = domainToDescOrder(&result.Order)
+
+	return descResult
+}
@@ -0,0 +1,27 @@
+package pvz_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"homework/internal/domain"
+	desc "homework/pkg/pvz-service/v1"
+)
+
+func (p *PVZService) CloseHandoverSession(ctx context.Context, req *desc.CloseHandoverSessionRequest) (*desc.CloseHandoverSessionResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZService.CloseHandoverSession")
+	defer span.Finish()
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidArgument, err)
+	}
+
+	report, err := p.handoverUseCase.CloseHandoverSession(ctx, req.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.CloseHandoverSessionResponse{
+		Report: domainToDescHandoverReport(report),
+	}, nil
+}
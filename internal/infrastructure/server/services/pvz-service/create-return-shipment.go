@@ -0,0 +1,96 @@
+package pvz_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"homework/internal/domain"
+	desc "homework/pkg/pvz-service/v1"
+)
+
+func (p *PVZService) CreateReturnShipment(ctx context.Context, req *desc.CreateReturnShipmentRequest) (*desc.CreateReturnShipmentResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZService.CreateReturnShipment")
+	defer span.Finish()
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidArgument, err)
+	}
+
+	shipment, err := p.returnsUseCase.CreateReturnShipment(ctx, req.GetOrderIds())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.CreateReturnShipmentResponse{
+		Shipment: domainToDescReturnShipment(shipment),
+	}, nil
+}
+
+func domainReturnShipmentStatusToDesc(status domain.ReturnShipmentStatus) desc.ReturnShipmentStatus {
+	switch status {
+	case domain.ReturnShipmentStatusOpen:
+		return desc.ReturnShipmentStatus_RETURN_SHIPMENT_STATUS_OPEN
+	case domain.ReturnShipmentStatusDispatched:
+		return desc.ReturnShipmentStatus_RETURN_SHIPMENT_STATUS_DISPATCHED
+	default:
+		return desc.ReturnShipmentStatus_RETURN_SHIPMENT_STATUS_UNKNOWN
+	}
+}
+
+func domainToDescReturnShipment(shipment domain.ReturnShipment) *desc.ReturnShipment {
+	descShipment := &desc.ReturnShipment{
+		Id:        shipment.ID,
+		PvzId:     shipment.PVZID,
+		Status:    domainReturnShipmentStatusToDesc(shipment.Status),
+		OrderIds:  shipment.OrderIDs,
+		CreatedAt: timestamppb.New(shipment.CreatedAt),
+	}
+
+	if shipment.CourierID != "" {
+		descShipment.CourierId = &shipment.CourierID
+	}
+
+	if !shipment.DispatchedAt.IsZero() {
+		descShipment.DispatchedAt = timestamppb.New(shipment.DispatchedAt)
+	}
+
+	if shipment.Manifest != nil {
+		descShipment.Manifest = domainToDescReturnManifest(*shipment.Manifest)
+	}
+
+	return descShipment
+}
+
+func domainToDescReturnManifest(manifest domain.ReturnManifest) *desc.ReturnManifest {
+	descManifest := &desc.ReturnManifest{
+		ShipmentId:   manifest.ShipmentID,
+		PvzId:        manifest.PVZID,
+		CourierId:    manifest.CourierID,
+		DispatchedAt: timestamppb.New(manifest.DispatchedAt),
+	}
+
+	for _, item := range manifest.Items {
+		descItem := &desc.ReturnManifestItem{
+			OrderId:       item.OrderID,
+			RecipientId:   item.RecipientID,
+			Status:        domainOrderStatusToDesc(item.Status),
+			Cost:          domainMoneyToDesc(item.Cost),
+			Weight:        int32(item.Weight),
+			PackagingCode: item.Packaging.String(),
+			ReturnedAt:    timestamppb.New(item.ReturnedAt),
+		}
+
+		if !item.ReturnDetails.IsZero() {
+			descItem.ReturnReason = domainReturnReasonToDesc(item.ReturnDetails.Reason)
+			descItem.Inspection = domainInspectionOutcomeToDesc(item.ReturnDetails.Inspection)
+			if item.ReturnDetails.Comment != "" {
+				descItem.ReturnComment = &item.ReturnDetails.Comment
+			}
+		}
+
+		descManifest.Items = append(descManifest.Items, descItem)
+	}
+
+	return descManifest
+}
@@ -0,0 +1,27 @@
+package pvz_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"homework/internal/domain"
+	desc "homework/pkg/pvz-service/v1"
+)
+
+func (p *PVZService) DispatchReturnShipment(ctx context.Context, req *desc.DispatchReturnShipmentRequest) (*desc.DispatchReturnShipmentResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZService.DispatchReturnShipment")
+	defer span.Finish()
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidArgument, err)
+	}
+
+	manifest, err := p.returnsUseCase.DispatchReturnShipment(ctx, req.GetShipmentId(), req.GetCourierId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.DispatchReturnShipmentResponse{
+		Manifest: domainToDescReturnManifest(manifest),
+	}, nil
+}
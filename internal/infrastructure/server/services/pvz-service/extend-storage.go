@@ -0,0 +1,38 @@
+package pvz_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"homework/internal/abstractions"
+	"homework/internal/domain"
+	desc "homework/pkg/pvz-service/v1"
+)
+
+func (p *PVZService) ExtendStorage(ctx context.Context, req *desc.ExtendStorageRequest) (*emptypb.Empty, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZService.ExtendStorage")
+	defer span.Finish()
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidArgument, err)
+	}
+
+	var options []abstractions.MutationOptFunc
+	if req.ExpectedVersion != nil {
+		options = append(options, abstractions.WithExpectedVersion(req.GetExpectedVersion()))
+	}
+
+	err := p.useCase.ExtendStorage(
+		ctx,
+		req.GetOrderId(),
+		req.GetExtension().AsDuration(),
+		req.GetExtendedBy(),
+		options...,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,27 @@
+package pvz_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"homework/internal/domain"
+	desc "homework/pkg/pvz-service/v1"
+)
+
+func (p *PVZService) GetHandoverSession(ctx context.Context, req *desc.GetHandoverSessionRequest) (*desc.GetHandoverSessionResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZService.GetHandoverSession")
+	defer span.Finish()
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidArgument, err)
+	}
+
+	session, err := p.handoverUseCase.GetHandoverSession(ctx, req.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.GetHandoverSessionResponse{
+		Session: domainToDescHandoverSession(session),
+	}, nil
+}
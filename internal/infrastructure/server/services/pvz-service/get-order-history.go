@@ -0,0 +1,58 @@
+package pvz_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"homework/internal/domain"
+	desc "homework/pkg/pvz-service/v1"
+)
+
+func (p *PVZService) GetOrderHistory(ctx context.Context, req *desc.GetOrderHistoryRequest) (*desc.GetOrderHistoryResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZService.GetOrderHistory")
+	defer span.Finish()
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidArgument, err)
+	}
+
+	events, err := p.useCase.GetOrderHistory(ctx, req.GetOrderId())
+	if err != nil {
+		return nil, err
+	}
+
+	descEvents := make([]*desc.OrderEvent, 0, len(events))
+	for _, event := range events {
+		descEvent, err := domainToDescEvent(&event)
+		if err != nil {
+			return nil, err
+		}
+		descEvents = append(descEvents, descEvent)
+	}
+
+	return &desc.GetOrderHistoryResponse{
+		Events: descEvents,
+	}, nil
+}
+
+func domainToDescEvent(event *domain.Event) (*desc.OrderEvent, error) {
+	payload, err := structpb.NewStruct(event.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("%w: can not convert payload of event %s: %v", domain.ErrInternal, event.ID, err)
+	}
+
+	descEvent := &desc.OrderEvent{
+		Id:        event.ID.String(),
+		EventType: event.EventType.String(),
+		Payload:   payload,
+		CreatedAt: timestamppb.New(event.CreatedAt),
+	}
+
+	if !event.SentAt.IsZero() {
+		descEvent.SentAt = timestamppb.New(event.SentAt)
+	}
+
+	return descEvent, nil
+}
@@ -9,6 +9,7 @@ import (
 	"homework/internal/abstractions"
 	"homework/internal/domain"
 	desc "homework/pkg/pvz-service/v1"
+	"time"
 )
 
 func domainPackagingTypeToDesc(packagingType domain.PackagingType) desc.PackagingType {
@@ -24,24 +25,119 @@ func domainPackagingTypeToDesc(packagingType domain.PackagingType) desc.Packagin
 	}
 }
 
+func domainOrderStatusToDesc(status domain.OrderStatus) desc.OrderStatus {
+	switch status {
+	case domain.OrderStatusAccepted:
+		return desc.OrderStatus_ORDER_STATUS_ACCEPTED
+	case domain.OrderStatusIssued:
+		return desc.OrderStatus_ORDER_STATUS_ISSUED
+	case domain.OrderStatusReturnedByClient:
+		return desc.OrderStatus_ORDER_STATUS_RETURNED_BY_CLIENT
+	case domain.OrderStatusReturnedToCourier:
+		return desc.OrderStatus_ORDER_STATUS_RETURNED_TO_COURIER
+	case domain.OrderStatusExpired:
+		return desc.OrderStatus_ORDER_STATUS_EXPIRED
+	case domain.OrderStatusRefused:
+		return desc.OrderStatus_ORDER_STATUS_REFUSED
+	case domain.OrderStatusDispatchedToSeller:
+		return desc.OrderStatus_ORDER_STATUS_DISPATCHED_TO_SELLER
+	default:
+		return desc.OrderStatus_ORDER_STATUS_UNKNOWN
+	}
+}
+
+func orderStatusFromProto(status desc.OrderStatus) domain.OrderStatus {
+	switch status {
+	case desc.OrderStatus_ORDER_STATUS_ACCEPTED:
+		return domain.OrderStatusAccepted
+	case desc.OrderStatus_ORDER_STATUS_ISSUED:
+		return domain.OrderStatusIssued
+	case desc.OrderStatus_ORDER_STATUS_RETURNED_BY_CLIENT:
+		return domain.OrderStatusReturnedByClient
+	case desc.OrderStatus_ORDER_STATUS_RETURNED_TO_COURIER:
+		return domain.OrderStatusReturnedToCourier
+	case desc.OrderStatus_ORDER_STATUS_EXPIRED:
+		return domain.OrderStatusExpired
+	case desc.OrderStatus_ORDER_STATUS_REFUSED:
+		return domain.OrderStatusRefused
+	case desc.OrderStatus_ORDER_STATUS_DISPATCHED_TO_SELLER:
+		return domain.OrderStatusDispatchedToSeller
+	default:
+		return domain.OrderStatusUnknown
+	}
+}
+
 func domainToDescOrder(order *domain.PVZOrder) *desc.PVZOrder {
-	return &desc.PVZOrder{
+	descOrder := &desc.PVZOrder{
 		OrderId:     order.OrderID,
 		PvzId:       order.PVZID,
 		RecipientId: order.RecipientID,
 
-		Cost:   int32(order.Cost),
-		Weight: int32(order.Weight),
+		Cost:      legacyCost(order.Cost),
+		CostMoney: domainMoneyToDesc(order.Cost),
+		Weight:    int32(order.Weight),
 
 		StorageTime: durationpb.New(order.StorageTime),
 		ReceivedAt:  timestamppb.New(order.ReceivedAt),
+		ExpiresAt:   timestamppb.New(order.ExpiresAt()),
 
 		Packaging:      domainPackagingTypeToDesc(order.Packaging),
+		PackagingCode:  order.Packaging.String(),
 		AdditionalFilm: order.AdditionalFilm,
 
+		Status:  domainOrderStatusToDesc(order.Status),
+		Version: order.Version,
+
 		IssuedAt:   timestamppb.New(order.IssuedAt),
 		ReturnedAt: timestamppb.New(order.ReturnedAt),
+
+		StorageExtensions: int32(order.StorageExtensions),
+
+		MeasuredWeight:    int32(order.MeasuredWeight),
+		WeightDiscrepancy: order.WeightDiscrepancy,
+
+		PaidStorageUntil: timestamppb.New(order.PaidStorageUntil()),
 	}
+
+	if order.PaidStorage.IsEnabled() {
+		descOrder.DailyStorageFee = domainMoneyToDesc(order.PaidStorage.DailyFee)
+		descOrder.StorageFee = domainMoneyToDesc(order.StorageFee(time.Now()))
+	}
+
+	if !order.StorageFeePaid.IsZero() {
+		descOrder.StorageFeePaid = domainMoneyToDesc(order.StorageFeePaid)
+	}
+
+	if !order.ReturnDeadline.IsZero() {
+		descOrder.ReturnDeadline = timestamppb.New(order.ReturnDeadline)
+	}
+
+	if order.ExtendedBy != "" {
+		descOrder.ExtendedBy = &order.ExtendedBy
+	}
+
+	if order.WeightOverrideReason != "" {
+		descOrder.WeightOverrideReason = &order.WeightOverrideReason
+	}
+
+	if !order.ReturnDetails.IsZero() {
+		descOrder.ReturnReason = domainReturnReasonToDesc(order.ReturnDetails.Reason)
+		descOrder.Inspection = domainInspectionOutcomeToDesc(order.ReturnDetails.Inspection)
+		if order.ReturnDetails.Comment != "" {
+			descOrder.ReturnComment = &order.ReturnDetails.Comment
+		}
+	}
+
+	if !order.Dimensions.IsZero() {
+		descOrder.Dimensions = &desc.Dimensions{
+			Length: int32(order.Dimensions.Length),
+			Width:  int32(order.Dimensions.Width),
+			Height: int32(order.Dimensions.Height),
+		}
+		descOrder.VolumetricWeight = int32(order.Dimensions.VolumetricWeight())
+	}
+
+	return descOrder
 }
 
 func (p *PVZService) GetOrders(ctx context.Context, req *desc.GetOrdersRequest) (*desc.GetOrdersResponse, error) {
@@ -65,6 +161,16 @@ func (p *PVZService) GetOrders(ctx context.Context, req *desc.GetOrdersRequest)
 	if req.Limit != nil {
 		options = append(options, abstractions.WithLimit(int(req.GetLimit())))
 	}
+	if len(req.GetStatuses()) > 0 {
+		statuses := make([]domain.OrderStatus, 0, len(req.GetStatuses()))
+		for _, status := range req.GetStatuses() {
+			statuses = append(statuses, orderStatusFromProto(status))
+		}
+		options = append(options, abstractions.WithStatuses(statuses...))
+	}
+	if req.GetWeightDiscrepancy() {
+		options = append(options, abstractions.WithWeightDiscrepancy())
+	}
 
 	orders, err := p.useCase.GetOrders(
 		ctx,
@@ -0,0 +1,54 @@
+package pvz_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"homework/internal/domain"
+	desc "homework/pkg/pvz-service/v1"
+)
+
+func (p *PVZService) GetPVZPolicy(ctx context.Context, req *desc.GetPVZPolicyRequest) (*desc.GetPVZPolicyResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZService.GetPVZPolicy")
+	defer span.Finish()
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidArgument, err)
+	}
+
+	policy, err := p.policyUseCase.GetPVZPolicy(ctx, req.GetPvzId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.GetPVZPolicyResponse{
+		Policy: domainToDescPVZPolicy(policy),
+	}, nil
+}
+
+func domainToDescPVZPolicy(policy domain.PVZPolicy) *desc.PVZPolicy {
+	descPolicy := &desc.PVZPolicy{
+		PvzId:          policy.PVZID,
+		ReturnWindow:   durationpb.New(policy.ReturnWindow),
+		MaxStorageTime: durationpb.New(policy.MaxStorageTime),
+		MaxOrderWeight: int32(policy.MaxOrderWeight),
+		Version:        policy.Version,
+		UpdatedBy:      policy.UpdatedBy,
+	}
+
+	for _, packaging := range policy.AllowedPackaging {
+		descPolicy.AllowedPackaging = append(descPolicy.AllowedPackaging, packaging.String())
+	}
+
+	for _, rule := range policy.SetRules {
+		descPolicy.SetRules = append(descPolicy.SetRules, rule.String())
+	}
+
+	if !policy.UpdatedAt.IsZero() {
+		descPolicy.UpdatedAt = timestamppb.New(policy.UpdatedAt)
+	}
+
+	return descPolicy
+}
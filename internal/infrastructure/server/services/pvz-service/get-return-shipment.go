@@ -0,0 +1,27 @@
+package pvz_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"homework/internal/domain"
+	desc "homework/pkg/pvz-service/v1"
+)
+
+func (p *PVZService) GetReturnShipment(ctx context.Context, req *desc.GetReturnShipmentRequest) (*desc.GetReturnShipmentResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZService.GetReturnShipment")
+	defer span.Finish()
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidArgument, err)
+	}
+
+	shipment, err := p.returnsUseCase.GetReturnShipment(ctx, req.GetShipmentId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.GetReturnShipmentResponse{
+		Shipment: domainToDescReturnShipment(shipment),
+	}, nil
+}
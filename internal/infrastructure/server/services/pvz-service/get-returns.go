@@ -25,6 +25,13 @@ func (p *PVZService) GetReturns(ctx context.Context, req *desc.GetReturnsRequest
 		options = append(options, abstractions.WithPageSize(int(req.GetPageSize())))
 	}
 
+	for _, reason := range req.GetReasons() {
+		options = append(options, abstractions.WithReturnReasons(returnReasonFromProto(reason)))
+	}
+	for _, inspection := range req.GetInspections() {
+		options = append(options, abstractions.WithInspectionOutcomes(inspectionOutcomeFromProto(inspection)))
+	}
+
 	returns, err := p.useCase.GetReturns(ctx, options...)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,43 @@
+package pvz_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"homework/internal/domain"
+	desc "homework/pkg/pvz-service/v1"
+	"time"
+)
+
+func (p *PVZService) GetWeightDiscrepancyReport(ctx context.Context, req *desc.GetWeightDiscrepancyReportRequest) (*desc.GetWeightDiscrepancyReportResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZService.GetWeightDiscrepancyReport")
+	defer span.Finish()
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidArgument, err)
+	}
+
+	var date time.Time
+	if req.GetDate() != "" {
+		var err error
+		date, err = time.Parse(time.DateOnly, req.GetDate())
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid date: %v", domain.ErrInvalidArgument, err)
+		}
+	}
+
+	report, err := p.useCase.GetWeightDiscrepancyReport(ctx, date)
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]*desc.PVZOrder, 0, len(report.Orders))
+	for _, order := range report.Orders {
+		orders = append(orders, domainToDescOrder(&order))
+	}
+
+	return &desc.GetWeightDiscrepancyReportResponse{
+		Date:   report.Date.Format(time.DateOnly),
+		Orders: orders,
+	}, nil
+}
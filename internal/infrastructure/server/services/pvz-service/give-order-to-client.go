@@ -4,12 +4,11 @@ import (
 	"context"
 	"fmt"
 	"github.com/opentracing/opentracing-go"
-	"google.golang.org/protobuf/types/known/emptypb"
 	"homework/internal/domain"
 	desc "homework/pkg/pvz-service/v1"
 )
 
-func (p *PVZService) GiveOrderToClient(ctx context.Context, req *desc.GiveOrderToClientRequest) (*emptypb.Empty, error) {
+func (p *PVZService) GiveOrderToClient(ctx context.Context, req *desc.GiveOrderToClientRequest) (*desc.GiveOrderToClientResponse, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZService.GiveOrderToClient")
 	defer span.Finish()
 
@@ -17,13 +16,84 @@ func (p *PVZService) GiveOrderToClient(ctx context.Context, req *desc.GiveOrderT
 		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidArgument, err)
 	}
 
-	err := p.useCase.GiveOrderToClient(
-		ctx,
-		req.GetOrderIds(),
-	)
+	decisions := make([]domain.IssueDecision, 0, len(req.GetOrderIds())+len(req.GetDecisions()))
+	for _, orderID := range req.GetOrderIds() {
+		decisions = append(decisions, domain.NewIssueDecision(orderID))
+	}
+	for _, descDecision := range req.GetDecisions() {
+		decision, err := descToDomainIssueDecision(descDecision)
+		if err != nil {
+			return nil, err
+		}
+		decisions = append(decisions, decision)
+	}
+
+	if len(decisions) == 0 {
+		return nil, fmt.Errorf("%w: either order_ids or decisions must be provided", domain.ErrInvalidArgument)
+	}
+
+	results, err := p.useCase.GiveOrderToClient(ctx, decisions)
 	if err != nil {
 		return nil, err
 	}
 
-	return nil, nil
+	descResults := make([]*desc.IssueResult, 0, len(results))
+	for _, result := range results {
+		descResults = append(descResults, domainToDescIssueResult(result))
+	}
+
+	return &desc.GiveOrderToClientResponse{
+		Results: descResults,
+	}, nil
+}
+
+func descToDomainIssueDecision(decision *desc.IssueDecision) (domain.IssueDecision, error) {
+	var result domain.IssueDecision
+	switch decision.GetAction() {
+	case desc.IssueAction_ISSUE_ACTION_ISSUE:
+		result = domain.NewIssueDecision(decision.GetOrderId())
+	case desc.IssueAction_ISSUE_ACTION_REFUSE:
+		result = domain.NewRefuseDecision(decision.GetOrderId(), decision.GetRefusalReason())
+	default:
+		result = domain.IssueDecision{OrderID: decision.GetOrderId(), Action: domain.IssueActionUnknown}
+	}
+
+	result.ExpectedVersion = decision.GetExpectedVersion()
+	result.PickupCode = decision.GetPickupCode()
+
+	if decision.GetStorageFeePaid() != nil {
+		storageFeePaid, err := moneyFromProto(decision.GetStorageFeePaid())
+		if err != nil {
+			return domain.IssueDecision{}, err
+		}
+		result.StorageFeePaid = storageFeePaid
+	}
+
+	return result, nil
+}
+
+func domainToDescIssueAction(action domain.IssueAction) desc.IssueAction {
+	switch action {
+	case domain.IssueActionIssue:
+		return desc.IssueAction_ISSUE_ACTION_ISSUE
+	case domain.IssueActionRefuse:
+		return desc.IssueAction_ISSUE_ACTION_REFUSE
+	default:
+		return desc.IssueAction_ISSUE_ACTION_UNKNOWN
+	}
+}
+
+func domainToDescIssueResult(result domain.IssueResult) *desc.IssueResult {
+	descResult := &desc.IssueResult{
+		OrderId: result.OrderID,
+		Action:  domainToDescIssueAction(result.Action),
+		Success: result.Err == nil,
+	}
+
+	if result.Err != nil {
+		errMsg := result.Err.Error()
+		descResult.Error = &errMsg
+	}
+
+	return descResult
 }
@@ -0,0 +1,64 @@
+package pvz_service
+
+import (
+	"fmt"
+	"google.golang.org/genproto/googleapis/type/money"
+	"homework/internal/domain"
+	"math"
+)
+
+const nanosExponent = 9
+
+// costFromRequest prefers the cost in google.type.Money and falls back to the legacy cost in kopecks
+func costFromRequest(costMoney *money.Money, kopecks int32) (domain.Money, error) {
+	if costMoney == nil {
+		return domain.RUB(int64(kopecks)), nil
+	}
+
+	return moneyFromProto(costMoney)
+}
+
+// moneyFromProto converts google.type.Money to the amount in minor units of the currency
+func moneyFromProto(m *money.Money) (domain.Money, error) {
+	currency, err := domain.NewCurrency(m.GetCurrencyCode())
+	if err != nil {
+		return domain.Money{}, err
+	}
+
+	units, nanos := m.GetUnits(), int64(m.GetNanos())
+	if (units > 0 && nanos < 0) || (units < 0 && nanos > 0) {
+		return domain.Money{}, fmt.Errorf("%w: units and nanos of the money must have the same sign", domain.ErrInvalidArgument)
+	}
+
+	minorUnitsPerUnit := int64(math.Pow10(currency.Exponent()))
+	nanosPerMinorUnit := int64(math.Pow10(nanosExponent - currency.Exponent()))
+	if nanos%nanosPerMinorUnit != 0 {
+		return domain.Money{}, fmt.Errorf("%w: money is more precise than the minor units of %s", domain.ErrInvalidArgument, currency)
+	}
+
+	if units > math.MaxInt64/minorUnitsPerUnit || units < math.MinInt64/minorUnitsPerUnit {
+		return domain.Money{}, fmt.Errorf("%w: money amount is too large", domain.ErrInvalidArgument)
+	}
+
+	return domain.NewMoney(units*minorUnitsPerUnit+nanos/nanosPerMinorUnit, currency), nil
+}
+
+func domainMoneyToDesc(m domain.Money) *money.Money {
+	minorUnitsPerUnit := int64(math.Pow10(m.Currency.Exponent()))
+	nanosPerMinorUnit := int64(math.Pow10(nanosExponent - m.Currency.Exponent()))
+
+	return &money.Money{
+		CurrencyCode: m.Currency.String(),
+		Units:        m.Amount / minorUnitsPerUnit,
+		Nanos:        int32((m.Amount % minorUnitsPerUnit) * nanosPerMinorUnit),
+	}
+}
+
+// legacyCost returns the cost in kopecks for the clients which do not know google.type.Money,
+// it is 0 if the cost is not in roubles or does not fit into int32
+func legacyCost(m domain.Money) int32 {
+	if m.Currency != domain.CurrencyRUB || m.Amount > math.MaxInt32 || m.Amount < math.MinInt32 {
+		return 0
+	}
+	return int32(m.Amount)
+}
@@ -0,0 +1,129 @@
+package pvz_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"homework/internal/domain"
+	desc "homework/pkg/pvz-service/v1"
+)
+
+func (p *PVZService) OpenHandoverSession(ctx context.Context, req *desc.OpenHandoverSessionRequest) (*desc.OpenHandoverSessionResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZService.OpenHandoverSession")
+	defer span.Finish()
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidArgument, err)
+	}
+
+	expected := make([]domain.DeliveryItem, 0, len(req.GetExpected()))
+	for i, reqItem := range req.GetExpected() {
+		item, err := descToDomainDeliveryItem(reqItem)
+		if err != nil {
+			return nil, fmt.Errorf("expected[%d]: %w", i, err)
+		}
+		expected = append(expected, item)
+	}
+
+	session, err := p.handoverUseCase.OpenHandoverSession(ctx, req.GetCourierId(), expected)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.OpenHandoverSessionResponse{
+		Session: domainToDescHandoverSession(session),
+	}, nil
+}
+
+func domainHandoverSessionStatusToDesc(status domain.HandoverSessionStatus) desc.HandoverSessionStatus {
+	switch status {
+	case domain.HandoverSessionStatusOpen:
+		return desc.HandoverSessionStatus_HANDOVER_SESSION_STATUS_OPEN
+	case domain.HandoverSessionStatusClosed:
+		return desc.HandoverSessionStatus_HANDOVER_SESSION_STATUS_CLOSED
+	default:
+		return desc.HandoverSessionStatus_HANDOVER_SESSION_STATUS_UNKNOWN
+	}
+}
+
+func domainHandoverScanResultToDesc(result domain.HandoverScanResult) desc.HandoverScanResult {
+	switch result {
+	case domain.HandoverScanResultMatched:
+		return desc.HandoverScanResult_HANDOVER_SCAN_RESULT_MATCHED
+	case domain.HandoverScanResultUnexpected:
+		return desc.HandoverScanResult_HANDOVER_SCAN_RESULT_UNEXPECTED
+	case domain.HandoverScanResultWeightMismatch:
+		return desc.HandoverScanResult_HANDOVER_SCAN_RESULT_WEIGHT_MISMATCH
+	case domain.HandoverScanResultDuplicate:
+		return desc.HandoverScanResult_HANDOVER_SCAN_RESULT_DUPLICATE
+	case domain.HandoverScanResultRejected:
+		return desc.HandoverScanResult_HANDOVER_SCAN_RESULT_REJECTED
+	default:
+		return desc.HandoverScanResult_HANDOVER_SCAN_RESULT_UNKNOWN
+	}
+}
+
+func domainToDescHandoverSession(session domain.HandoverSession) *desc.HandoverSession {
+	descSession := &desc.HandoverSession{
+		Id:        session.ID,
+		PvzId:     session.PVZID,
+		CourierId: session.CourierID,
+		Status:    domainHandoverSessionStatusToDesc(session.Status),
+		OpenedAt:  timestamppb.New(session.OpenedAt),
+	}
+
+	for _, item := range session.Expected {
+		descSession.ExpectedOrderIds = append(descSession.ExpectedOrderIds, item.OrderID)
+	}
+
+	for _, scan := range session.Scans {
+		descSession.Scans = append(descSession.Scans, domainToDescHandoverScan(scan))
+	}
+
+	if !session.ClosedAt.IsZero() {
+		descSession.ClosedAt = timestamppb.New(session.ClosedAt)
+	}
+
+	if session.Report != nil {
+		descSession.Report = domainToDescHandoverReport(*session.Report)
+	}
+
+	return descSession
+}
+
+func domainToDescHandoverScan(scan domain.HandoverScan) *desc.HandoverScan {
+	return &desc.HandoverScan{
+		OrderId:        scan.OrderID,
+		MeasuredWeight: int32(scan.MeasuredWeight),
+		Result:         domainHandoverScanResultToDesc(scan.Result),
+		Reason:         scan.Reason,
+		ScannedAt:      timestamppb.New(scan.ScannedAt),
+	}
+}
+
+func domainToDescHandoverReport(report domain.HandoverReport) *desc.HandoverReport {
+	descReport := &desc.HandoverReport{
+		SessionId:  report.SessionID,
+		Accepted:   report.Accepted,
+		Missing:    report.Missing,
+		Unexpected: report.Unexpected,
+	}
+
+	for _, mismatch := range report.WeightMismatches {
+		descReport.WeightMismatches = append(descReport.WeightMismatches, &desc.HandoverWeightMismatch{
+			OrderId:        mismatch.OrderID,
+			DeclaredWeight: int32(mismatch.DeclaredWeight),
+			MeasuredWeight: int32(mismatch.MeasuredWeight),
+		})
+	}
+
+	for _, rejection := range report.Rejected {
+		descReport.Rejected = append(descReport.Rejected, &desc.HandoverRejection{
+			OrderId: rejection.OrderID,
+			Reason:  rejection.Reason,
+		})
+	}
+
+	return descReport
+}
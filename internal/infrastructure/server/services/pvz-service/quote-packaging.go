@@ -0,0 +1,49 @@
+package pvz_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"homework/internal/domain"
+	desc "homework/pkg/pvz-service/v1"
+)
+
+func domainToDescPackagingQuote(quote domain.PackagingQuote) *desc.PackagingQuote {
+	return &desc.PackagingQuote{
+		PackagingCode: quote.Packaging.String(),
+		Price:         legacyCost(quote.Price),
+		PriceMoney:    domainMoneyToDesc(quote.Price),
+		Cheapest:      quote.Cheapest,
+	}
+}
+
+func (p *PVZService) QuotePackaging(ctx context.Context, req *desc.QuotePackagingRequest) (*desc.QuotePackagingResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZService.QuotePackaging")
+	defer span.Finish()
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidArgument, err)
+	}
+
+	dimensions, err := dimensionsFromProto(req.GetDimensions())
+	if err != nil {
+		return nil, err
+	}
+
+	cost, err := costFromRequest(req.GetCostMoney(), req.GetCost())
+	if err != nil {
+		return nil, err
+	}
+
+	quotes, err := p.useCase.QuotePackaging(ctx, cost, int(req.GetWeight()), dimensions)
+	if err != nil {
+		return nil, err
+	}
+
+	descQuotes := make([]*desc.PackagingQuote, len(quotes))
+	for i, quote := range quotes {
+		descQuotes[i] = domainToDescPackagingQuote(quote)
+	}
+
+	return &desc.QuotePackagingResponse{Quotes: descQuotes}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/opentracing/opentracing-go"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"homework/internal/abstractions"
 	"homework/internal/domain"
 	desc "homework/pkg/pvz-service/v1"
 )
@@ -17,9 +18,15 @@ func (p *PVZService) ReturnOrderDelivery(ctx context.Context, req *desc.ReturnOr
 		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidArgument, err)
 	}
 
+	var options []abstractions.MutationOptFunc
+	if req.ExpectedVersion != nil {
+		options = append(options, abstractions.WithExpectedVersion(req.GetExpectedVersion()))
+	}
+
 	err := p.useCase.ReturnOrderDelivery(
 		ctx,
 		req.GetOrderId(),
+		options...,
 	)
 	if err != nil {
 		return nil, err
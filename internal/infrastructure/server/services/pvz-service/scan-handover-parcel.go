@@ -0,0 +1,27 @@
+package pvz_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"homework/internal/domain"
+	desc "homework/pkg/pvz-service/v1"
+)
+
+func (p *PVZService) ScanHandoverParcel(ctx context.Context, req *desc.ScanHandoverParcelRequest) (*desc.ScanHandoverParcelResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZService.ScanHandoverParcel")
+	defer span.Finish()
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidArgument, err)
+	}
+
+	scan, err := p.handoverUseCase.ScanHandoverParcel(ctx, req.GetSessionId(), req.GetOrderId(), int(req.GetMeasuredWeight()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.ScanHandoverParcelResponse{
+		Scan: domainToDescHandoverScan(scan),
+	}, nil
+}
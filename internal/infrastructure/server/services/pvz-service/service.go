@@ -6,13 +6,24 @@ import (
 )
 
 type PVZService struct {
-	useCase abstractions.IPVZOrderUseCase
+	useCase         abstractions.IPVZOrderUseCase
+	handoverUseCase abstractions.IHandoverUseCase
+	policyUseCase   abstractions.IPVZPolicyUseCase
+	returnsUseCase  abstractions.IReturnShipmentUseCase
 
 	desc.UnimplementedPvzServiceServer
 }
 
-func NewPVZService(useCase abstractions.IPVZOrderUseCase) *PVZService {
+func NewPVZService(
+	useCase abstractions.IPVZOrderUseCase,
+	handoverUseCase abstractions.IHandoverUseCase,
+	policyUseCase abstractions.IPVZPolicyUseCase,
+	returnsUseCase abstractions.IReturnShipmentUseCase,
+) *PVZService {
 	return &PVZService{
-		useCase: useCase,
+		useCase:         useCase,
+		handoverUseCase: handoverUseCase,
+		policyUseCase:   policyUseCase,
+		returnsUseCase:  returnsUseCase,
 	}
 }
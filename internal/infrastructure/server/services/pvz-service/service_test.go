@@ -14,19 +14,27 @@ import (
 
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/type/money"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
 	buffer = 1024 * 1024
 )
 
-func setupSuite(useCase abstractions.IPVZOrderUseCase) (desc.PvzServiceClient, func()) {
+func setupSuite(
+	useCase abstractions.IPVZOrderUseCase,
+	handoverUseCase abstractions.IHandoverUseCase,
+	policyUseCase abstractions.IPVZPolicyUseCase,
+	returnsUseCase abstractions.IReturnShipmentUseCase,
+) (desc.PvzServiceClient, func()) {
 	lis := bufconn.Listen(buffer)
 
 	baseServer := grpc.NewServer(
@@ -35,7 +43,7 @@ func setupSuite(useCase abstractions.IPVZOrderUseCase) (desc.PvzServiceClient, f
 		),
 	)
 
-	desc.RegisterPvzServiceServer(baseServer, NewPVZService(useCase))
+	desc.RegisterPvzServiceServer(baseServer, NewPVZService(useCase, handoverUseCase, policyUseCase, returnsUseCase))
 
 	go func() {
 		if err := baseServer.Serve(lis); err != nil {
@@ -44,7 +52,7 @@ func setupSuite(useCase abstractions.IPVZOrderUseCase) (desc.PvzServiceClient, f
 	}()
 
 	conn, err := grpc.NewClient(
-		"bufnet",
+		"passthrough:///bufnet",
 		grpc.WithContextDialer(
 			func(context.Context, string) (net.Conn, error) {
 				return lis.Dial()
@@ -72,7 +80,7 @@ func TestPVZService_AcceptOrderDelivery(t *testing.T) {
 	ctrl := minimock.NewController(t)
 	useCase := mocks.NewIPVZOrderUseCaseMock(ctrl)
 
-	client, teardown := setupSuite(useCase)
+	client, teardown := setupSuite(useCase, nil, nil, nil)
 	defer teardown()
 
 	type args struct {
@@ -83,6 +91,7 @@ func TestPVZService_AcceptOrderDelivery(t *testing.T) {
 		name    string
 		args    args
 		setup   func()
+		want    *desc.PVZOrder
 		wantErr assert.ErrorAssertionFunc
 	}{
 		{
@@ -104,44 +113,1030 @@ func TestPVZService_AcceptOrderDelivery(t *testing.T) {
 					"orderID",
 					"recipientID",
 					time.Duration(2*24*60*60*1000000000),
-					10000,
+					domain.RUB(10000),
 					1000,
+					domain.Dimensions{},
 					domain.PackagingTypeBox,
 					false,
+				).Return(domain.PVZOrder{
+					OrderID:     "orderID",
+					Cost:        domain.RUB(12000),
+					ReceivedAt:  time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+					StorageTime: 48 * time.Hour,
+				}, nil)
+			},
+			want: &desc.PVZOrder{
+				OrderId:   "orderID",
+				Cost:      12000,
+				ExpiresAt: timestamppb.New(time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC)),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "order already exists",
+			args: args{
+				body: &desc.AcceptOrderDeliveryRequest{
+					OrderId:        "orderID",
+					RecipientId:    "recipientID",
+					StorageTime:    durationpb.New(2 * 24 * 60 * 60 * 1000000000),
+					Cost:           10000,
+					Weight:         1000,
+					Packaging:      desc.PackagingType_BOX,
+					AdditionalFilm: false,
+				},
+			},
+			setup: func() {
+				useCase.AcceptOrderDeliveryMock.Expect(
+					minimock.AnyContext,
+					"orderID",
+					"recipientID",
+					time.Duration(2*24*60*60*1000000000),
+					domain.RUB(10000),
+					1000,
+					domain.Dimensions{},
+					domain.PackagingTypeBox,
+					false,
+				).Return(domain.PVZOrder{}, domain.ErrAlreadyExists)
+			},
+			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				assert.Error(t, err)
+				code, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.AlreadyExists, code.Code())
+				return true
+			},
+		},
+		{
+			name: "packaging code",
+			args: args{
+				body: &desc.AcceptOrderDeliveryRequest{
+					OrderId:        "orderID",
+					RecipientId:    "recipientID",
+					StorageTime:    durationpb.New(2 * 24 * 60 * 60 * 1000000000),
+					Cost:           10000,
+					Weight:         1000,
+					Packaging:      desc.PackagingType_BOX,
+					PackagingCode:  "large_box",
+					AdditionalFilm: true,
+				},
+			},
+			setup: func() {
+				useCase.AcceptOrderDeliveryMock.Expect(
+					minimock.AnyContext,
+					"orderID",
+					"recipientID",
+					time.Duration(2*24*60*60*1000000000),
+					domain.RUB(10000),
+					1000,
+					domain.Dimensions{},
+					domain.PackagingType("large_box"),
+					true,
+				).Return(domain.PVZOrder{}, nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "cost in dollars",
+			args: args{
+				body: &desc.AcceptOrderDeliveryRequest{
+					OrderId:     "orderID",
+					RecipientId: "recipientID",
+					StorageTime: durationpb.New(2 * 24 * 60 * 60 * 1000000000),
+					Cost:        10000,
+					CostMoney:   &money.Money{CurrencyCode: "USD", Units: 12, Nanos: 340000000},
+					Weight:      1000,
+					Packaging:   desc.PackagingType_BOX,
+				},
+			},
+			setup: func() {
+				useCase.AcceptOrderDeliveryMock.Expect(
+					minimock.AnyContext,
+					"orderID",
+					"recipientID",
+					time.Duration(2*24*60*60*1000000000),
+					domain.NewMoney(1234, domain.CurrencyUSD),
+					1000,
+					domain.Dimensions{},
+					domain.PackagingTypeBox,
+					false,
+				).Return(domain.PVZOrder{
+					OrderID: "orderID",
+					Cost:    domain.NewMoney(1434, domain.CurrencyUSD),
+				}, nil)
+			},
+			want: &desc.PVZOrder{
+				OrderId:   "orderID",
+				Cost:      0,
+				CostMoney: &money.Money{CurrencyCode: "USD", Units: 14, Nanos: 340000000},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "cost is more precise than cents",
+			args: args{
+				body: &desc.AcceptOrderDeliveryRequest{
+					OrderId:     "orderID",
+					RecipientId: "recipientID",
+					StorageTime: durationpb.New(2 * 24 * 60 * 60 * 1000000000),
+					CostMoney:   &money.Money{CurrencyCode: "USD", Units: 12, Nanos: 345000000},
+					Weight:      1000,
+					Packaging:   desc.PackagingType_BOX,
+				},
+			},
+			setup: func() {},
+			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				assert.Error(t, err)
+				code, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, code.Code())
+				return true
+			},
+		},
+		{
+			name: "invalid dimensions",
+			args: args{
+				body: &desc.AcceptOrderDeliveryRequest{
+					OrderId:     "orderID",
+					RecipientId: "recipientID",
+					StorageTime: durationpb.New(2 * 24 * 60 * 60 * 1000000000),
+					Cost:        10000,
+					Weight:      1000,
+					Packaging:   desc.PackagingType_BOX,
+					Dimensions:  &desc.Dimensions{Length: 30, Width: 20},
+				},
+			},
+			setup: func() {},
+			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				assert.Error(t, err)
+				code, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, code.Code())
+				return true
+			},
+		},
+		{
+			name: "packaging is not provided",
+			args: args{
+				body: &desc.AcceptOrderDeliveryRequest{
+					OrderId:     "orderID",
+					RecipientId: "recipientID",
+					StorageTime: durationpb.New(2 * 24 * 60 * 60 * 1000000000),
+					Cost:        10000,
+					Weight:      1000,
+				},
+			},
+			setup: func() {},
+			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				assert.Error(t, err)
+				code, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, code.Code())
+				return true
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			resp, err := client.AcceptOrderDelivery(
+				ctx,
+				tt.args.body,
+			)
+			if !tt.wantErr(t, err) || tt.want == nil {
+				return
+			}
+			assert.Equal(t, tt.want.GetOrderId(), resp.GetOrder().GetOrderId())
+			assert.Equal(t, tt.want.GetCost(), resp.GetOrder().GetCost())
+			if tt.want.GetExpiresAt() != nil {
+				assert.True(t, proto.Equal(tt.want.GetExpiresAt(), resp.GetOrder().GetExpiresAt()))
+			}
+			if tt.want.GetCostMoney() != nil {
+				assert.True(t, proto.Equal(tt.want.GetCostMoney(), resp.GetOrder().GetCostMoney()))
+			}
+		})
+	}
+}
+
+func TestPVZService_BatchAcceptOrderDelivery(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ctrl := minimock.NewController(t)
+	useCase := mocks.NewIPVZOrderUseCaseMock(ctrl)
+
+	client, teardown := setupSuite(useCase, nil, nil, nil)
+	defer teardown()
+
+	item := func(orderID string) *desc.AcceptOrderDeliveryRequest {
+		return &desc.AcceptOrderDeliveryRequest{
+			OrderId:     orderID,
+			RecipientId: "recipientID",
+			StorageTime: durationpb.New(48 * time.Hour),
+			Cost:        10000,
+			Weight:      1000,
+			Packaging:   desc.PackagingType_BOX,
+		}
+	}
+
+	t.Run("per-item results", func(t *testing.T) {
+		invalid := item("invalid")
+		invalid.Packaging = desc.PackagingType_UNKNOWN
+
+		domainItem := func(orderID string) domain.DeliveryItem {
+			return domain.DeliveryItem{
+				OrderID:     orderID,
+				RecipientID: "recipientID",
+				StorageTime: 48 * time.Hour,
+				Cost:        domain.RUB(10000),
+				Weight:      1000,
+				Packaging:   domain.PackagingTypeBox,
+			}
+		}
+
+		useCase.BatchAcceptOrderDeliveryMock.Expect(
+			minimock.AnyContext,
+			[]domain.DeliveryItem{domainItem("accepted"), domainItem("exists")},
+		).Return([]domain.DeliveryResult{
+			{OrderID: "accepted", Order: domain.PVZOrder{OrderID: "accepted", Cost: domain.RUB(12000)}},
+			{OrderID: "exists", Err: domain.ErrAlreadyExists},
+		}, nil)
+
+		resp, err := client.BatchAcceptOrderDelivery(ctx, &desc.BatchAcceptOrderDeliveryRequest{
+			Items: []*desc.AcceptOrderDeliveryRequest{item("accepted"), invalid, item("exists")},
+		})
+		if !assert.NoError(t, err) || !assert.Len(t, resp.GetResults(), 3) {
+			return
+		}
+
+		assert.True(t, resp.GetResults()[0].GetSuccess())
+		assert.Equal(t, int32(12000), resp.GetResults()[0].GetOrder().GetCost())
+
+		assert.False(t, resp.GetResults()[1].GetSuccess())
+		assert.Equal(t, "invalid", resp.GetResults()[1].GetOrderId())
+		assert.NotEmpty(t, resp.GetResults()[1].GetError())
+
+		assert.False(t, resp.GetResults()[2].GetSuccess())
+		assert.Equal(t, "exists", resp.GetResults()[2].GetOrderId())
+		assert.Nil(t, resp.GetResults()[2].GetOrder())
+	})
+
+	t.Run("empty items", func(t *testing.T) {
+		_, err := client.BatchAcceptOrderDelivery(ctx, &desc.BatchAcceptOrderDeliveryRequest{})
+		assert.Error(t, err)
+		code, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, code.Code())
+	})
+}
+
+func TestPVZService_HandoverSession(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ctrl := minimock.NewController(t)
+	handoverUseCase := mocks.NewIHandoverUseCaseMock(ctrl)
+
+	client, teardown := setupSuite(mocks.NewIPVZOrderUseCaseMock(ctrl), handoverUseCase, nil, nil)
+	defer teardown()
+
+	const sessionID = "6f1c7a4e-3b2d-4c5e-8f9a-0b1c2d3e4f5a"
+
+	t.Run("open", func(t *testing.T) {
+		handoverUseCase.OpenHandoverSessionMock.Expect(
+			minimock.AnyContext,
+			"courierID",
+			[]domain.DeliveryItem{{
+				OrderID:     "orderID",
+				RecipientID: "recipientID",
+				StorageTime: 48 * time.Hour,
+				Cost:        domain.RUB(10000),
+				Weight:      1000,
+				Packaging:   domain.PackagingTypeBox,
+			}},
+		).Return(domain.HandoverSession{
+			ID:        sessionID,
+			PVZID:     "pvzID",
+			CourierID: "courierID",
+			Status:    domain.HandoverSessionStatusOpen,
+			Expected:  []domain.DeliveryItem{{OrderID: "orderID"}},
+		}, nil)
+
+		resp, err := client.OpenHandoverSession(ctx, &desc.OpenHandoverSessionRequest{
+			CourierId: "courierID",
+			Expected: []*desc.AcceptOrderDeliveryRequest{{
+				OrderId:     "orderID",
+				RecipientId: "recipientID",
+				StorageTime: durationpb.New(48 * time.Hour),
+				Cost:        10000,
+				Weight:      1000,
+				Packaging:   desc.PackagingType_BOX,
+			}},
+		})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, sessionID, resp.GetSession().GetId())
+		assert.Equal(t, desc.HandoverSessionStatus_HANDOVER_SESSION_STATUS_OPEN, resp.GetSession().GetStatus())
+		assert.Equal(t, []string{"orderID"}, resp.GetSession().GetExpectedOrderIds())
+	})
+
+	t.Run("open with invalid item", func(t *testing.T) {
+		_, err := client.OpenHandoverSession(ctx, &desc.OpenHandoverSessionRequest{
+			CourierId: "courierID",
+			Expected: []*desc.AcceptOrderDeliveryRequest{{
+				OrderId:     "orderID",
+				RecipientId: "recipientID",
+				StorageTime: durationpb.New(48 * time.Hour),
+				Weight:      1000,
+			}},
+		})
+		code, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, code.Code())
+	})
+
+	t.Run("scan", func(t *testing.T) {
+		handoverUseCase.ScanHandoverParcelMock.Expect(minimock.AnyContext, sessionID, "orderID", 1500).Return(domain.HandoverScan{
+			SessionID:      sessionID,
+			OrderID:        "orderID",
+			MeasuredWeight: 1500,
+			Result:         domain.HandoverScanResultWeightMismatch,
+			Reason:         "too heavy",
+		}, nil)
+
+		resp, err := client.ScanHandoverParcel(ctx, &desc.ScanHandoverParcelRequest{
+			SessionId:      sessionID,
+			OrderId:        "orderID",
+			MeasuredWeight: 1500,
+		})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, desc.HandoverScanResult_HANDOVER_SCAN_RESULT_WEIGHT_MISMATCH, resp.GetScan().GetResult())
+		assert.Equal(t, "too heavy", resp.GetScan().GetReason())
+	})
+
+	t.Run("scan with invalid session id", func(t *testing.T) {
+		_, err := client.ScanHandoverParcel(ctx, &desc.ScanHandoverParcelRequest{SessionId: "session", OrderId: "orderID"})
+		code, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, code.Code())
+	})
+
+	t.Run("close", func(t *testing.T) {
+		handoverUseCase.CloseHandoverSessionMock.Expect(minimock.AnyContext, sessionID).Return(domain.HandoverReport{
+			SessionID:        sessionID,
+			Missing:          []string{"missing"},
+			WeightMismatches: []domain.HandoverWeightMismatch{{OrderID: "orderID", DeclaredWeight: 1000, MeasuredWeight: 1500}},
+		}, nil)
+
+		resp, err := client.CloseHandoverSession(ctx, &desc.CloseHandoverSessionRequest{SessionId: sessionID})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []string{"missing"}, resp.GetReport().GetMissing())
+		if assert.Len(t, resp.GetReport().GetWeightMismatches(), 1) {
+			assert.Equal(t, int32(1500), resp.GetReport().GetWeightMismatches()[0].GetMeasuredWeight())
+		}
+	})
+
+	t.Run("get unknown session", func(t *testing.T) {
+		handoverUseCase.GetHandoverSessionMock.Expect(minimock.AnyContext, sessionID).Return(domain.HandoverSession{}, domain.ErrNotFound)
+
+		_, err := client.GetHandoverSession(ctx, &desc.GetHandoverSessionRequest{SessionId: sessionID})
+		code, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, code.Code())
+	})
+}
+
+func TestPVZService_ReturnShipment(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ctrl := minimock.NewController(t)
+	returnsUseCase := mocks.NewIReturnShipmentUseCaseMock(ctrl)
+
+	client, teardown := setupSuite(mocks.NewIPVZOrderUseCaseMock(ctrl), nil, nil, returnsUseCase)
+	defer teardown()
+
+	const shipmentID = "0b6c2f1e-7d4a-4e3b-9c8d-1a2b3c4d5e6f"
+
+	t.Run("create", func(t *testing.T) {
+		returnsUseCase.CreateReturnShipmentMock.Expect(minimock.AnyContext, []string{"returned", "refused"}).Return(domain.ReturnShipment{
+			ID:       shipmentID,
+			PVZID:    "pvzID",
+			Status:   domain.ReturnShipmentStatusOpen,
+			OrderIDs: []string{"returned", "refused"},
+		}, nil)
+
+		resp, err := client.CreateReturnShipment(ctx, &desc.CreateReturnShipmentRequest{OrderIds: []string{"returned", "refused"}})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, shipmentID, resp.GetShipment().GetId())
+		assert.Equal(t, desc.ReturnShipmentStatus_RETURN_SHIPMENT_STATUS_OPEN, resp.GetShipment().GetStatus())
+		assert.Equal(t, []string{"returned", "refused"}, resp.GetShipment().GetOrderIds())
+		assert.Nil(t, resp.GetShipment().CourierId)
+		assert.Nil(t, resp.GetShipment().GetManifest())
+	})
+
+	t.Run("create with repeated order", func(t *testing.T) {
+		_, err := client.CreateReturnShipment(ctx, &desc.CreateReturnShipmentRequest{OrderIds: []string{"returned", "returned"}})
+		code, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, code.Code())
+	})
+
+	t.Run("dispatch", func(t *testing.T) {
+		dispatchedAt := time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+		returnsUseCase.DispatchReturnShipmentMock.Expect(minimock.AnyContext, shipmentID, "courierID").Return(domain.ReturnManifest{
+			ShipmentID: shipmentID,
+			PVZID:      "pvzID",
+			CourierID:  "courierID",
+			Items: []domain.ReturnManifestItem{
+				{
+					OrderID:     "returned",
+					RecipientID: "recipientID",
+					Status:      domain.OrderStatusReturnedByClient,
+					Cost:        domain.RUB(10000),
+					Weight:      1000,
+					Packaging:   domain.PackagingTypeBox,
+					ReturnDetails: domain.ReturnDetails{
+						Reason:     domain.ReturnReasonDefect,
+						Inspection: domain.InspectionOutcomeDamaged,
+						Comment:    "broken screen",
+					},
+					ReturnedAt: dispatchedAt.Add(-time.Hour),
+				},
+				{
+					OrderID:     "refused",
+					RecipientID: "recipientID",
+					Status:      domain.OrderStatusRefused,
+					Cost:        domain.RUB(5000),
+					Weight:      500,
+				},
+			},
+			DispatchedAt: dispatchedAt,
+		}, nil)
+
+		resp, err := client.DispatchReturnShipment(ctx, &desc.DispatchReturnShipmentRequest{ShipmentId: shipmentID, CourierId: "courierID"})
+		if !assert.NoError(t, err) || !assert.Len(t, resp.GetManifest().GetItems(), 2) {
+			return
+		}
+		assert.Equal(t, "courierID", resp.GetManifest().GetCourierId())
+		assert.Equal(t, dispatchedAt, resp.GetManifest().GetDispatchedAt().AsTime())
+
+		returned := resp.GetManifest().GetItems()[0]
+		assert.Equal(t, desc.OrderStatus_ORDER_STATUS_RETURNED_BY_CLIENT, returned.GetStatus())
+		assert.Equal(t, int64(100), returned.GetCost().GetUnits())
+		assert.Equal(t, desc.ReturnReason_RETURN_REASON_DEFECT, returned.GetReturnReason())
+		assert.Equal(t, "broken screen", returned.GetReturnComment())
+
+		refused := resp.GetManifest().GetItems()[1]
+		assert.Equal(t, desc.OrderStatus_ORDER_STATUS_REFUSED, refused.GetStatus())
+		assert.Equal(t, desc.ReturnReason_RETURN_REASON_UNKNOWN, refused.GetReturnReason())
+		assert.Nil(t, refused.ReturnComment)
+	})
+
+	t.Run("dispatch twice", func(t *testing.T) {
+		returnsUseCase.DispatchReturnShipmentMock.Expect(minimock.AnyContext, shipmentID, "anotherCourierID").Return(domain.ReturnManifest{}, domain.ErrConflict)
+
+		_, err := client.DispatchReturnShipment(ctx, &desc.DispatchReturnShipmentRequest{ShipmentId: shipmentID, CourierId: "anotherCourierID"})
+		code, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, code.Code())
+	})
+
+	t.Run("get unknown shipment", func(t *testing.T) {
+		returnsUseCase.GetReturnShipmentMock.Expect(minimock.AnyContext, shipmentID).Return(domain.ReturnShipment{}, domain.ErrNotFound)
+
+		_, err := client.GetReturnShipment(ctx, &desc.GetReturnShipmentRequest{ShipmentId: shipmentID})
+		code, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.NotFound, code.Code())
+	})
+}
+
+func TestPVZService_PVZPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ctrl := minimock.NewController(t)
+	policyUseCase := mocks.NewIPVZPolicyUseCaseMock(ctrl)
+
+	client, teardown := setupSuite(mocks.NewIPVZOrderUseCaseMock(ctrl), nil, policyUseCase, nil)
+	defer teardown()
+
+	t.Run("get", func(t *testing.T) {
+		policyUseCase.GetPVZPolicyMock.Expect(minimock.AnyContext, "pvzID").Return(domain.PVZPolicy{
+			PVZID:          "pvzID",
+			ReturnWindow:   domain.DefaultReturnWindow,
+			MaxStorageTime: 7 * 24 * time.Hour,
+		}, nil)
+
+		resp, err := client.GetPVZPolicy(ctx, &desc.GetPVZPolicyRequest{PvzId: "pvzID"})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, domain.DefaultReturnWindow, resp.GetPolicy().GetReturnWindow().AsDuration())
+		assert.Equal(t, 7*24*time.Hour, resp.GetPolicy().GetMaxStorageTime().AsDuration())
+		assert.Zero(t, resp.GetPolicy().GetVersion())
+		assert.Nil(t, resp.GetPolicy().GetUpdatedAt())
+	})
+
+	t.Run("update", func(t *testing.T) {
+		updatedAt := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+		policyUseCase.UpdatePVZPolicyMock.Set(func(_ context.Context, policy domain.PVZPolicy, options ...abstractions.MutationOptFunc) (domain.PVZPolicy, error) {
+			opts, err := abstractions.NewMutationOptions(options...)
+			if err != nil {
+				return domain.PVZPolicy{}, err
+			}
+			assert.Equal(t, int64(1), opts.ExpectedVersion)
+			assert.Equal(t, domain.PVZPolicy{
+				PVZID:            "pvzID",
+				MaxOrderWeight:   20000,
+				AllowedPackaging: []domain.PackagingType{domain.PackagingTypeBox, "large_box"},
+				SetRules: []domain.PVZPolicyRule{
+					domain.PVZPolicyRuleMaxStorageTime,
+					domain.PVZPolicyRuleMaxOrderWeight,
+					domain.PVZPolicyRuleAllowedPackaging,
+				},
+				UpdatedBy: "admin",
+			}, policy)
+
+			policy.Version, policy.UpdatedAt = 2, updatedAt
+			return policy, nil
+		})
+
+		resp, err := client.UpdatePVZPolicy(ctx, &desc.UpdatePVZPolicyRequest{
+			PvzId:            "pvzID",
+			MaxStorageTime:   durationpb.New(0),
+			MaxOrderWeight:   proto.Int32(20000),
+			AllowedPackaging: []string{"box", "Large_Box"},
+			UpdatedBy:        "admin",
+			ExpectedVersion:  proto.Int64(1),
+		})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, []string{"box", "large_box"}, resp.GetPolicy().GetAllowedPackaging())
+		assert.Equal(t, []string{"max_storage_time", "max_order_weight", "allowed_packaging"}, resp.GetPolicy().GetSetRules())
+		assert.Equal(t, int64(2), resp.GetPolicy().GetVersion())
+		assert.True(t, updatedAt.Equal(resp.GetPolicy().GetUpdatedAt().AsTime()))
+	})
+
+	t.Run("update without administrator", func(t *testing.T) {
+		_, err := client.UpdatePVZPolicy(ctx, &desc.UpdatePVZPolicyRequest{PvzId: "pvzID"})
+		code, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, code.Code())
+	})
+
+	t.Run("update allowing all packaging", func(t *testing.T) {
+		policyUseCase.UpdatePVZPolicyMock.Set(func(_ context.Context, policy domain.PVZPolicy, _ ...abstractions.MutationOptFunc) (domain.PVZPolicy, error) {
+			assert.Empty(t, policy.AllowedPackaging)
+			assert.Equal(t, []domain.PVZPolicyRule{domain.PVZPolicyRuleAllowedPackaging}, policy.SetRules)
+			return policy, nil
+		})
+
+		_, err := client.UpdatePVZPolicy(ctx, &desc.UpdatePVZPolicyRequest{
+			PvzId:               "pvzID",
+			UpdatedBy:           "admin",
+			AllPackagingAllowed: true,
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("update allowing all and some packaging", func(t *testing.T) {
+		_, err := client.UpdatePVZPolicy(ctx, &desc.UpdatePVZPolicyRequest{
+			PvzId:               "pvzID",
+			UpdatedBy:           "admin",
+			AllowedPackaging:    []string{"box"},
+			AllPackagingAllowed: true,
+		})
+		code, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, code.Code())
+	})
+
+	t.Run("update with negative max storage time", func(t *testing.T) {
+		_, err := client.UpdatePVZPolicy(ctx, &desc.UpdatePVZPolicyRequest{
+			PvzId:          "pvzID",
+			MaxStorageTime: durationpb.New(-time.Hour),
+			UpdatedBy:      "admin",
+		})
+		code, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, code.Code())
+	})
+}
+
+func TestPVZService_AcceptReturn(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ctrl := minimock.NewController(t)
+	useCase := mocks.NewIPVZOrderUseCaseMock(ctrl)
+
+	client, teardown := setupSuite(useCase, nil, nil, nil)
+	defer teardown()
+
+	type args struct {
+		body *desc.AcceptReturnRequest
+	}
+
+	details := domain.ReturnDetails{
+		Reason:     domain.ReturnReasonOther,
+		Inspection: domain.InspectionOutcomeIncomplete,
+		Comment:    "charger is missing",
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func()
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				body: &desc.AcceptReturnRequest{
+					OrderId:    "orderID",
+					UserId:     "userID",
+					Reason:     desc.ReturnReason_RETURN_REASON_OTHER,
+					Inspection: desc.InspectionOutcome_INSPECTION_OUTCOME_INCOMPLETE,
+					Comment:    "charger is missing",
+				},
+			},
+			setup: func() {
+				useCase.AcceptReturnMock.Expect(
+					minimock.AnyContext,
+					"userID",
+					"orderID",
+					details,
 				).Return(nil)
 			},
 			wantErr: assert.NoError,
 		},
 		{
-			name: "order already exists",
+			name: "not found",
+			args: args{
+				body: &desc.AcceptReturnRequest{
+					OrderId:    "orderID",
+					UserId:     "userID",
+					Reason:     desc.ReturnReason_RETURN_REASON_OTHER,
+					Inspection: desc.InspectionOutcome_INSPECTION_OUTCOME_INCOMPLETE,
+					Comment:    "charger is missing",
+				},
+			},
+			setup: func() {
+				useCase.AcceptReturnMock.Expect(
+					minimock.AnyContext,
+					"userID",
+					"orderID",
+					details,
+				).Return(domain.ErrNotFound)
+			},
+			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				assert.Error(t, err)
+				code, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.NotFound, code.Code())
+				return true
+			},
+		},
+		{
+			name: "invalid argument",
+			args: args{
+				body: &desc.AcceptReturnRequest{
+					OrderId:    "orderID",
+					UserId:     "userID",
+					Reason:     desc.ReturnReason_RETURN_REASON_OTHER,
+					Inspection: desc.InspectionOutcome_INSPECTION_OUTCOME_INCOMPLETE,
+					Comment:    "charger is missing",
+				},
+			},
+			setup: func() {
+				useCase.AcceptReturnMock.Expect(
+					minimock.AnyContext,
+					"userID",
+					"orderID",
+					details,
+				).Return(domain.ErrInvalidArgument)
+			},
+			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				assert.Error(t, err)
+				code, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, code.Code())
+				return true
+			},
+		},
+		{
+			name: "reason is not set",
+			args: args{
+				body: &desc.AcceptReturnRequest{
+					OrderId:    "orderID",
+					UserId:     "userID",
+					Inspection: desc.InspectionOutcome_INSPECTION_OUTCOME_RESELLABLE,
+				},
+			},
+			setup: func() {},
+			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				assert.Error(t, err)
+				code, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, code.Code())
+				return true
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			_, err := client.AcceptReturn(
+				ctx,
+				tt.args.body,
+			)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestPVZService_GiveOrderToClient(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ctrl := minimock.NewController(t)
+	useCase := mocks.NewIPVZOrderUseCaseMock(ctrl)
+
+	client, teardown := setupSuite(useCase, nil, nil, nil)
+	defer teardown()
+
+	type args struct {
+		body *desc.GiveOrderToClientRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func()
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				body: &desc.GiveOrderToClientRequest{
+					OrderIds: []string{"orderID"},
+				},
+			},
+			setup: func() {
+				useCase.GiveOrderToClientMock.Expect(
+					minimock.AnyContext,
+					[]domain.IssueDecision{domain.NewIssueDecision("orderID")},
+				).Return([]domain.IssueResult{{OrderID: "orderID", Action: domain.IssueActionIssue}}, nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "refuse",
+			args: args{
+				body: &desc.GiveOrderToClientRequest{
+					Decisions: []*desc.IssueDecision{
+						{
+							OrderId:       "orderID",
+							Action:        desc.IssueAction_ISSUE_ACTION_REFUSE,
+							RefusalReason: proto.String("damaged"),
+						},
+					},
+				},
+			},
+			setup: func() {
+				useCase.GiveOrderToClientMock.Expect(
+					minimock.AnyContext,
+					[]domain.IssueDecision{domain.NewRefuseDecision("orderID", "damaged")},
+				).Return([]domain.IssueResult{{OrderID: "orderID", Action: domain.IssueActionRefuse}}, nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "pickup code",
+			args: args{
+				body: &desc.GiveOrderToClientRequest{
+					Decisions: []*desc.IssueDecision{
+						{
+							OrderId:    "orderID",
+							Action:     desc.IssueAction_ISSUE_ACTION_ISSUE,
+							PickupCode: proto.String("123456"),
+						},
+					},
+				},
+			},
+			setup: func() {
+				decision := domain.NewIssueDecision("orderID")
+				decision.PickupCode = "123456"
+				useCase.GiveOrderToClientMock.Expect(
+					minimock.AnyContext,
+					[]domain.IssueDecision{decision},
+				).Return([]domain.IssueResult{{OrderID: "orderID", Action: domain.IssueActionIssue}}, nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "storage fee paid",
+			args: args{
+				body: &desc.GiveOrderToClientRequest{
+					Decisions: []*desc.IssueDecision{
+						{
+							OrderId:        "orderID",
+							Action:         desc.IssueAction_ISSUE_ACTION_ISSUE,
+							PickupCode:     proto.String("123456"),
+							StorageFeePaid: &money.Money{CurrencyCode: "RUB", Units: 150},
+						},
+					},
+				},
+			},
+			setup: func() {
+				decision := domain.NewIssueDecision("orderID")
+				decision.PickupCode = "123456"
+				decision.StorageFeePaid = domain.RUB(15000)
+				useCase.GiveOrderToClientMock.Expect(
+					minimock.AnyContext,
+					[]domain.IssueDecision{decision},
+				).Return([]domain.IssueResult{{OrderID: "orderID", Action: domain.IssueActionIssue}}, nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "storage fee in unsupported currency",
+			args: args{
+				body: &desc.GiveOrderToClientRequest{
+					Decisions: []*desc.IssueDecision{
+						{
+							OrderId:        "orderID",
+							Action:         desc.IssueAction_ISSUE_ACTION_ISSUE,
+							StorageFeePaid: &money.Money{CurrencyCode: "XXX", Units: 150},
+						},
+					},
+				},
+			},
+			setup: func() {},
+			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				assert.Error(t, err)
+				code, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, code.Code())
+				return true
+			},
+		},
+		{
+			name: "malformed pickup code",
+			args: args{
+				body: &desc.GiveOrderToClientRequest{
+					Decisions: []*desc.IssueDecision{
+						{
+							OrderId:    "orderID",
+							Action:     desc.IssueAction_ISSUE_ACTION_ISSUE,
+							PickupCode: proto.String("12ab"),
+						},
+					},
+				},
+			},
+			setup: func() {},
+			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				assert.Error(t, err)
+				code, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, code.Code())
+				return true
+			},
+		},
+		{
+			name: "unknown action",
+			args: args{
+				body: &desc.GiveOrderToClientRequest{
+					Decisions: []*desc.IssueDecision{
+						{
+							OrderId: "orderID",
+							Action:  desc.IssueAction_ISSUE_ACTION_UNKNOWN,
+						},
+					},
+				},
+			},
+			setup: func() {},
+			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				assert.Error(t, err)
+				code, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, code.Code())
+				return true
+			},
+		},
+		{
+			name: "empty orderIDs",
+			args: args{
+				body: &desc.GiveOrderToClientRequest{
+					OrderIds: []string{},
+				},
+			},
+			setup: func() {},
+			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				assert.Error(t, err)
+				code, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, code.Code())
+				return true
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			_, err := client.GiveOrderToClient(
+				ctx,
+				tt.args.body,
+			)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestPVZService_GetOrders(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ctrl := minimock.NewController(t)
+	useCase := mocks.NewIPVZOrderUseCaseMock(ctrl)
+
+	client, teardown := setupSuite(useCase, nil, nil, nil)
+	defer teardown()
+
+	type args struct {
+		body *desc.GetOrdersRequest
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func()
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				body: &desc.GetOrdersRequest{
+					UserId: "userID",
+				},
+			},
+			setup: func() {
+				useCase.GetOrdersMock.Expect(
+					minimock.AnyContext,
+					"userID",
+				).Return([]domain.PVZOrder{}, nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "empty userID",
 			args: args{
-				body: &desc.AcceptOrderDeliveryRequest{
-					OrderId:        "orderID",
-					RecipientId:    "recipientID",
-					StorageTime:    durationpb.New(2 * 24 * 60 * 60 * 1000000000),
-					Cost:           10000,
-					Weight:         1000,
-					Packaging:      desc.PackagingType_BOX,
-					AdditionalFilm: false,
+				body: &desc.GetOrdersRequest{
+					UserId: "",
 				},
 			},
-			setup: func() {
-				useCase.AcceptOrderDeliveryMock.Expect(
-					minimock.AnyContext,
-					"orderID",
-					"recipientID",
-					time.Duration(2*24*60*60*1000000000),
-					10000,
-					1000,
-					domain.PackagingTypeBox,
-					false,
-				).Return(domain.ErrAlreadyExists)
-			},
+			setup: func() {},
 			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
 				assert.Error(t, err)
 				code, ok := status.FromError(err)
 				assert.True(t, ok)
-				assert.Equal(t, codes.AlreadyExists, code.Code())
+				assert.Equal(t, codes.InvalidArgument, code.Code())
 				return true
 			},
 		},
@@ -151,7 +1146,7 @@ func TestPVZService_AcceptOrderDelivery(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			_, err := client.AcceptOrderDelivery(
+			_, err := client.GetOrders(
 				ctx,
 				tt.args.body,
 			)
@@ -160,7 +1155,132 @@ func TestPVZService_AcceptOrderDelivery(t *testing.T) {
 	}
 }
 
-func TestPVZService_AcceptReturn(t *testing.T) {
+func TestPVZService_GetOrders_StorageFee(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := minimock.NewController(t)
+	useCase := mocks.NewIPVZOrderUseCaseMock(ctrl)
+
+	client, teardown := setupSuite(useCase, nil, nil, nil)
+	defer teardown()
+
+	order := domain.PVZOrder{
+		OrderID:     "orderID",
+		RecipientID: "userID",
+		Status:      domain.OrderStatusAccepted,
+		ReceivedAt:  time.Now().Add(-26 * time.Hour),
+		StorageTime: time.Hour,
+		PaidStorage: domain.PaidStorage{Days: 3, DailyFee: domain.RUB(5050)},
+	}
+	useCase.GetOrdersMock.Expect(minimock.AnyContext, "userID").Return([]domain.PVZOrder{order}, nil)
+
+	resp, err := client.GetOrders(ctx, &desc.GetOrdersRequest{UserId: "userID"})
+	if !assert.NoError(t, err) || !assert.Len(t, resp.GetOrders(), 1) {
+		return
+	}
+
+	got := resp.GetOrders()[0]
+	assert.Equal(t, &money.Money{CurrencyCode: "RUB", Units: 50, Nanos: 500000000}, got.GetDailyStorageFee())
+	assert.Equal(t, &money.Money{CurrencyCode: "RUB", Units: 101}, got.GetStorageFee())
+	assert.True(t, got.GetPaidStorageUntil().AsTime().Equal(order.PaidStorageUntil()))
+	assert.Nil(t, got.GetStorageFeePaid())
+}
+
+func TestPVZService_GetOrders_Deadlines(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := minimock.NewController(t)
+	useCase := mocks.NewIPVZOrderUseCaseMock(ctrl)
+
+	client, teardown := setupSuite(useCase, nil, nil, nil)
+	defer teardown()
+
+	issued := domain.PVZOrder{
+		OrderID:        "issued",
+		RecipientID:    "userID",
+		Status:         domain.OrderStatusIssued,
+		ReceivedAt:     time.Date(2026, 10, 12, 10, 0, 0, 0, time.UTC),
+		StorageTime:    24 * time.Hour,
+		Expiry:         time.Date(2026, 10, 13, 21, 0, 0, 0, time.UTC),
+		IssuedAt:       time.Date(2026, 10, 13, 12, 0, 0, 0, time.UTC),
+		ReturnDeadline: time.Date(2026, 10, 15, 21, 0, 0, 0, time.UTC),
+	}
+	accepted := domain.PVZOrder{OrderID: "accepted", RecipientID: "userID", Status: domain.OrderStatusAccepted}
+	useCase.GetOrdersMock.Expect(minimock.AnyContext, "userID").Return([]domain.PVZOrder{issued, accepted}, nil)
+
+	resp, err := client.GetOrders(ctx, &desc.GetOrdersRequest{UserId: "userID"})
+	if !assert.NoError(t, err) || !assert.Len(t, resp.GetOrders(), 2) {
+		return
+	}
+
+	assert.True(t, resp.GetOrders()[0].GetExpiresAt().AsTime().Equal(issued.Expiry))
+	assert.True(t, resp.GetOrders()[0].GetReturnDeadline().AsTime().Equal(issued.ReturnDeadline))
+	assert.Nil(t, resp.GetOrders()[1].GetReturnDeadline())
+}
+
+func TestPVZService_GetReturns(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := minimock.NewController(t)
+	useCase := mocks.NewIPVZOrderUseCaseMock(ctrl)
+
+	client, teardown := setupSuite(useCase, nil, nil, nil)
+	defer teardown()
+
+	returned := domain.PVZOrder{
+		OrderID:     "returned",
+		RecipientID: "userID",
+		Status:      domain.OrderStatusReturnedByClient,
+		ReturnDetails: domain.ReturnDetails{
+			Reason:     domain.ReturnReasonOther,
+			Inspection: domain.InspectionOutcomeIncomplete,
+			Comment:    "charger is missing",
+		},
+	}
+	legacy := domain.PVZOrder{OrderID: "legacy", RecipientID: "userID", Status: domain.OrderStatusReturnedByClient}
+
+	useCase.GetReturnsMock.Set(func(_ context.Context, options ...abstractions.PagePaginationOptFunc) ([]domain.PVZOrder, error) {
+		opts, err := abstractions.NewPaginationOptions(options...)
+		if err != nil {
+			return nil, err
+		}
+		assert.Equal(t, []domain.ReturnReason{domain.ReturnReasonDefect, domain.ReturnReasonOther}, opts.ReturnReasons)
+		assert.Equal(t, []domain.InspectionOutcome{domain.InspectionOutcomeIncomplete}, opts.InspectionOutcomes)
+		return []domain.PVZOrder{returned, legacy}, nil
+	})
+
+	resp, err := client.GetReturns(ctx, &desc.GetReturnsRequest{
+		Reasons:     []desc.ReturnReason{desc.ReturnReason_RETURN_REASON_DEFECT, desc.ReturnReason_RETURN_REASON_OTHER},
+		Inspections: []desc.InspectionOutcome{desc.InspectionOutcome_INSPECTION_OUTCOME_INCOMPLETE},
+	})
+	if !assert.NoError(t, err) || !assert.Len(t, resp.GetReturns(), 2) {
+		return
+	}
+
+	assert.Equal(t, desc.ReturnReason_RETURN_REASON_OTHER, resp.GetReturns()[0].GetReturnReason())
+	assert.Equal(t, desc.InspectionOutcome_INSPECTION_OUTCOME_INCOMPLETE, resp.GetReturns()[0].GetInspection())
+	assert.Equal(t, "charger is missing", resp.GetReturns()[0].GetReturnComment())
+	assert.Equal(t, desc.ReturnReason_RETURN_REASON_UNKNOWN, resp.GetReturns()[1].GetReturnReason())
+	assert.Nil(t, resp.GetReturns()[1].ReturnComment)
+
+	_, err = client.GetReturns(ctx, &desc.GetReturnsRequest{
+		Reasons: []desc.ReturnReason{desc.ReturnReason_RETURN_REASON_UNKNOWN},
+	})
+	code, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.InvalidArgument, code.Code())
+}
+
+func TestPVZService_ReturnOrderDelivery(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
@@ -170,11 +1290,11 @@ func TestPVZService_AcceptReturn(t *testing.T) {
 	ctrl := minimock.NewController(t)
 	useCase := mocks.NewIPVZOrderUseCaseMock(ctrl)
 
-	client, teardown := setupSuite(useCase)
+	client, teardown := setupSuite(useCase, nil, nil, nil)
 	defer teardown()
 
 	type args struct {
-		body *desc.AcceptReturnRequest
+		body *desc.ReturnOrderDeliveryRequest
 	}
 
 	tests := []struct {
@@ -186,15 +1306,13 @@ func TestPVZService_AcceptReturn(t *testing.T) {
 		{
 			name: "success",
 			args: args{
-				body: &desc.AcceptReturnRequest{
+				body: &desc.ReturnOrderDeliveryRequest{
 					OrderId: "orderID",
-					UserId:  "userID",
 				},
 			},
 			setup: func() {
-				useCase.AcceptReturnMock.Expect(
+				useCase.ReturnOrderDeliveryMock.Expect(
 					minimock.AnyContext,
-					"userID",
 					"orderID",
 				).Return(nil)
 			},
@@ -203,15 +1321,13 @@ func TestPVZService_AcceptReturn(t *testing.T) {
 		{
 			name: "not found",
 			args: args{
-				body: &desc.AcceptReturnRequest{
+				body: &desc.ReturnOrderDeliveryRequest{
 					OrderId: "orderID",
-					UserId:  "userID",
 				},
 			},
 			setup: func() {
-				useCase.AcceptReturnMock.Expect(
+				useCase.ReturnOrderDeliveryMock.Expect(
 					minimock.AnyContext,
-					"userID",
 					"orderID",
 				).Return(domain.ErrNotFound)
 			},
@@ -224,25 +1340,23 @@ func TestPVZService_AcceptReturn(t *testing.T) {
 			},
 		},
 		{
-			name: "invalid argument",
+			name: "conflict",
 			args: args{
-				body: &desc.AcceptReturnRequest{
+				body: &desc.ReturnOrderDeliveryRequest{
 					OrderId: "orderID",
-					UserId:  "userID",
 				},
 			},
 			setup: func() {
-				useCase.AcceptReturnMock.Expect(
+				useCase.ReturnOrderDeliveryMock.Expect(
 					minimock.AnyContext,
-					"userID",
 					"orderID",
-				).Return(domain.ErrInvalidArgument)
+				).Return(domain.ErrConflict)
 			},
 			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
 				assert.Error(t, err)
 				code, ok := status.FromError(err)
 				assert.True(t, ok)
-				assert.Equal(t, codes.InvalidArgument, code.Code())
+				assert.Equal(t, codes.FailedPrecondition, code.Code())
 				return true
 			},
 		},
@@ -252,7 +1366,7 @@ func TestPVZService_AcceptReturn(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			_, err := client.AcceptReturn(
+			_, err := client.ReturnOrderDelivery(
 				ctx,
 				tt.args.body,
 			)
@@ -261,7 +1375,7 @@ func TestPVZService_AcceptReturn(t *testing.T) {
 	}
 }
 
-func TestPVZService_GiveOrderToClient(t *testing.T) {
+func TestPVZService_GetOrderHistory(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
@@ -271,11 +1385,11 @@ func TestPVZService_GiveOrderToClient(t *testing.T) {
 	ctrl := minimock.NewController(t)
 	useCase := mocks.NewIPVZOrderUseCaseMock(ctrl)
 
-	client, teardown := setupSuite(useCase)
+	client, teardown := setupSuite(useCase, nil, nil, nil)
 	defer teardown()
 
 	type args struct {
-		body *desc.GiveOrderToClientRequest
+		body *desc.GetOrderHistoryRequest
 	}
 
 	tests := []struct {
@@ -287,23 +1401,50 @@ func TestPVZService_GiveOrderToClient(t *testing.T) {
 		{
 			name: "success",
 			args: args{
-				body: &desc.GiveOrderToClientRequest{
-					OrderIds: []string{"orderID"},
+				body: &desc.GetOrderHistoryRequest{
+					OrderId: "orderID",
 				},
 			},
 			setup: func() {
-				useCase.GiveOrderToClientMock.Expect(
+				useCase.GetOrderHistoryMock.Expect(
 					minimock.AnyContext,
-					[]string{"orderID"},
-				).Return(nil)
+					"orderID",
+				).Return([]domain.Event{
+					{
+						EventType: domain.EventTypeOrderIssued,
+						Payload:   map[string]interface{}{"order_id": "orderID"},
+						CreatedAt: time.Now(),
+					},
+				}, nil)
 			},
 			wantErr: assert.NoError,
 		},
 		{
-			name: "empty orderIDs",
+			name: "not found",
 			args: args{
-				body: &desc.GiveOrderToClientRequest{
-					OrderIds: []string{},
+				body: &desc.GetOrderHistoryRequest{
+					OrderId: "orderID",
+				},
+			},
+			setup: func() {
+				useCase.GetOrderHistoryMock.Expect(
+					minimock.AnyContext,
+					"orderID",
+				).Return(nil, domain.ErrNotFound)
+			},
+			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				assert.Error(t, err)
+				code, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.NotFound, code.Code())
+				return true
+			},
+		},
+		{
+			name: "empty orderID",
+			args: args{
+				body: &desc.GetOrderHistoryRequest{
+					OrderId: "",
 				},
 			},
 			setup: func() {},
@@ -321,7 +1462,7 @@ func TestPVZService_GiveOrderToClient(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			_, err := client.GiveOrderToClient(
+			_, err := client.GetOrderHistory(
 				ctx,
 				tt.args.body,
 			)
@@ -330,7 +1471,7 @@ func TestPVZService_GiveOrderToClient(t *testing.T) {
 	}
 }
 
-func TestPVZService_GetOrders(t *testing.T) {
+func TestPVZService_ExtendStorage(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
@@ -340,11 +1481,19 @@ func TestPVZService_GetOrders(t *testing.T) {
 	ctrl := minimock.NewController(t)
 	useCase := mocks.NewIPVZOrderUseCaseMock(ctrl)
 
-	client, teardown := setupSuite(useCase)
+	client, teardown := setupSuite(useCase, nil, nil, nil)
 	defer teardown()
 
+	isInvalidArgument := func(t assert.TestingT, err error, _ ...interface{}) bool {
+		assert.Error(t, err)
+		code, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, code.Code())
+		return true
+	}
+
 	type args struct {
-		body *desc.GetOrdersRequest
+		body *desc.ExtendStorageRequest
 	}
 
 	tests := []struct {
@@ -356,33 +1505,63 @@ func TestPVZService_GetOrders(t *testing.T) {
 		{
 			name: "success",
 			args: args{
-				body: &desc.GetOrdersRequest{
-					UserId: "userID",
+				body: &desc.ExtendStorageRequest{
+					OrderId:    "orderID",
+					Extension:  durationpb.New(24 * time.Hour),
+					ExtendedBy: "operatorID",
 				},
 			},
 			setup: func() {
-				useCase.GetOrdersMock.Expect(
+				useCase.ExtendStorageMock.Expect(
 					minimock.AnyContext,
-					"userID",
-				).Return([]domain.PVZOrder{}, nil)
+					"orderID",
+					24*time.Hour,
+					"operatorID",
+				).Return(nil)
 			},
 			wantErr: assert.NoError,
 		},
 		{
-			name: "empty userID",
+			name: "exceeds max storage time",
 			args: args{
-				body: &desc.GetOrdersRequest{
-					UserId: "",
+				body: &desc.ExtendStorageRequest{
+					OrderId:    "orderID",
+					Extension:  durationpb.New(1000 * time.Hour),
+					ExtendedBy: "operatorID",
 				},
 			},
-			setup: func() {},
-			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
-				assert.Error(t, err)
-				code, ok := status.FromError(err)
-				assert.True(t, ok)
-				assert.Equal(t, codes.InvalidArgument, code.Code())
-				return true
+			setup: func() {
+				useCase.ExtendStorageMock.Expect(
+					minimock.AnyContext,
+					"orderID",
+					1000*time.Hour,
+					"operatorID",
+				).Return(domain.ErrInvalidArgument)
+			},
+			wantErr: isInvalidArgument,
+		},
+		{
+			name: "zero extension",
+			args: args{
+				body: &desc.ExtendStorageRequest{
+					OrderId:    "orderID",
+					Extension:  durationpb.New(0),
+					ExtendedBy: "operatorID",
+				},
+			},
+			setup:   func() {},
+			wantErr: isInvalidArgument,
+		},
+		{
+			name: "empty operator",
+			args: args{
+				body: &desc.ExtendStorageRequest{
+					OrderId:   "orderID",
+					Extension: durationpb.New(time.Hour),
+				},
 			},
+			setup:   func() {},
+			wantErr: isInvalidArgument,
 		},
 	}
 
@@ -390,7 +1569,7 @@ func TestPVZService_GetOrders(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			_, err := client.GetOrders(
+			_, err := client.ExtendStorage(
 				ctx,
 				tt.args.body,
 			)
@@ -399,7 +1578,44 @@ func TestPVZService_GetOrders(t *testing.T) {
 	}
 }
 
-func TestPVZService_ReturnOrderDelivery(t *testing.T) {
+func TestPVZService_ExpectedVersion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ctrl := minimock.NewController(t)
+	useCase := mocks.NewIPVZOrderUseCaseMock(ctrl)
+
+	client, teardown := setupSuite(useCase, nil, nil, nil)
+	defer teardown()
+
+	useCase.AcceptReturnMock.Set(func(_ context.Context, userID, orderID string, _ domain.ReturnDetails, options ...abstractions.MutationOptFunc) error {
+		opts, err := abstractions.NewMutationOptions(options...)
+		if err != nil {
+			return err
+		}
+		if opts.ExpectedVersion != 1 {
+			return domain.ErrInvalidArgument
+		}
+		return domain.ErrVersionMismatch
+	})
+
+	_, err := client.AcceptReturn(ctx, &desc.AcceptReturnRequest{
+		OrderId:         "orderID",
+		UserId:          "userID",
+		Reason:          desc.ReturnReason_RETURN_REASON_DEFECT,
+		Inspection:      desc.InspectionOutcome_INSPECTION_OUTCOME_DAMAGED,
+		ExpectedVersion: proto.Int64(1),
+	})
+	assert.Error(t, err)
+	code, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.Aborted, code.Code())
+}
+
+func TestPVZService_QuotePackaging(t *testing.T) {
 	t.Parallel()
 
 	ctx := context.Background()
@@ -409,52 +1625,55 @@ func TestPVZService_ReturnOrderDelivery(t *testing.T) {
 	ctrl := minimock.NewController(t)
 	useCase := mocks.NewIPVZOrderUseCaseMock(ctrl)
 
-	client, teardown := setupSuite(useCase)
+	client, teardown := setupSuite(useCase, nil, nil, nil)
 	defer teardown()
 
 	type args struct {
-		body *desc.ReturnOrderDeliveryRequest
+		body *desc.QuotePackagingRequest
 	}
 
 	tests := []struct {
 		name    string
 		args    args
 		setup   func()
+		want    []*desc.PackagingQuote
 		wantErr assert.ErrorAssertionFunc
 	}{
 		{
 			name: "success",
 			args: args{
-				body: &desc.ReturnOrderDeliveryRequest{
-					OrderId: "orderID",
+				body: &desc.QuotePackagingRequest{
+					Cost:       1000,
+					Weight:     5000,
+					Dimensions: &desc.Dimensions{Length: 30, Width: 20, Height: 10},
 				},
 			},
 			setup: func() {
-				useCase.ReturnOrderDeliveryMock.Expect(
-					minimock.AnyContext,
-					"orderID",
-				).Return(nil)
+				useCase.QuotePackagingMock.Expect(minimock.AnyContext, domain.RUB(1000), 5000, domain.Dimensions{Length: 30, Width: 20, Height: 10}).Return([]domain.PackagingQuote{
+					{Packaging: domain.PackagingTypeBox, Price: domain.RUB(3000)},
+					{Packaging: domain.PackagingTypeBag, Price: domain.RUB(1500), Cheapest: true},
+				}, nil)
+			},
+			want: []*desc.PackagingQuote{
+				{PackagingCode: "box", Price: 3000, PriceMoney: &money.Money{CurrencyCode: "RUB", Units: 30}},
+				{PackagingCode: "bag", Price: 1500, PriceMoney: &money.Money{CurrencyCode: "RUB", Units: 15}, Cheapest: true},
 			},
 			wantErr: assert.NoError,
 		},
 		{
-			name: "not found",
+			name: "negative weight",
 			args: args{
-				body: &desc.ReturnOrderDeliveryRequest{
-					OrderId: "orderID",
+				body: &desc.QuotePackagingRequest{
+					Cost:   1000,
+					Weight: -1,
 				},
 			},
-			setup: func() {
-				useCase.ReturnOrderDeliveryMock.Expect(
-					minimock.AnyContext,
-					"orderID",
-				).Return(domain.ErrNotFound)
-			},
+			setup: func() {},
 			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
 				assert.Error(t, err)
 				code, ok := status.FromError(err)
 				assert.True(t, ok)
-				assert.Equal(t, codes.NotFound, code.Code())
+				assert.Equal(t, codes.InvalidArgument, code.Code())
 				return true
 			},
 		},
@@ -464,11 +1683,102 @@ func TestPVZService_ReturnOrderDelivery(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setup()
 
-			_, err := client.ReturnOrderDelivery(
+			resp, err := client.QuotePackaging(
 				ctx,
 				tt.args.body,
 			)
-			tt.wantErr(t, err)
+			if !tt.wantErr(t, err) || err != nil {
+				return
+			}
+			assert.Len(t, resp.GetQuotes(), len(tt.want))
+			for i := range tt.want {
+				assert.True(t, proto.Equal(tt.want[i], resp.GetQuotes()[i]))
+			}
+		})
+	}
+}
+
+func TestPVZService_GetWeightDiscrepancyReport(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ctrl := minimock.NewController(t)
+	useCase := mocks.NewIPVZOrderUseCaseMock(ctrl)
+
+	client, teardown := setupSuite(useCase, nil, nil, nil)
+	defer teardown()
+
+	day := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		body    *desc.GetWeightDiscrepancyReportRequest
+		setup   func()
+		want    *desc.GetWeightDiscrepancyReportResponse
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			body: &desc.GetWeightDiscrepancyReportRequest{Date: "2024-10-01"},
+			setup: func() {
+				useCase.GetWeightDiscrepancyReportMock.Expect(minimock.AnyContext, day).Return(domain.WeightDiscrepancyReport{
+					PVZID: "pvzID",
+					Date:  day,
+					Orders: []domain.PVZOrder{{
+						OrderID:              "orderID",
+						Weight:               1000,
+						MeasuredWeight:       1500,
+						WeightDiscrepancy:    true,
+						WeightOverrideReason: "scales checked twice",
+					}},
+				}, nil)
+			},
+			want: &desc.GetWeightDiscrepancyReportResponse{
+				Date: "2024-10-01",
+				Orders: []*desc.PVZOrder{{
+					OrderId:              "orderID",
+					Weight:               1000,
+					MeasuredWeight:       1500,
+					WeightDiscrepancy:    true,
+					WeightOverrideReason: proto.String("scales checked twice"),
+				}},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:  "invalid date",
+			body:  &desc.GetWeightDiscrepancyReportRequest{Date: "01.10.2024"},
+			setup: func() {},
+			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				assert.Error(t, err)
+				code, ok := status.FromError(err)
+				assert.True(t, ok)
+				assert.Equal(t, codes.InvalidArgument, code.Code())
+				return true
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+
+			resp, err := client.GetWeightDiscrepancyReport(ctx, tt.body)
+			if !tt.wantErr(t, err) || err != nil {
+				return
+			}
+			assert.Equal(t, tt.want.GetDate(), resp.GetDate())
+			assert.Len(t, resp.GetOrders(), len(tt.want.GetOrders()))
+			for i, want := range tt.want.GetOrders() {
+				got := resp.GetOrders()[i]
+				assert.Equal(t, want.GetOrderId(), got.GetOrderId())
+				assert.Equal(t, want.GetMeasuredWeight(), got.GetMeasuredWeight())
+				assert.Equal(t, want.GetWeightDiscrepancy(), got.GetWeightDiscrepancy())
+				assert.Equal(t, want.GetWeightOverrideReason(), got.GetWeightOverrideReason())
+			}
 		})
 	}
 }
@@ -0,0 +1,66 @@
+package pvz_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/opentracing/opentracing-go"
+	"homework/internal/abstractions"
+	"homework/internal/domain"
+	desc "homework/pkg/pvz-service/v1"
+)
+
+func (p *PVZService) UpdatePVZPolicy(ctx context.Context, req *desc.UpdatePVZPolicyRequest) (*desc.UpdatePVZPolicyResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZService.UpdatePVZPolicy")
+	defer span.Finish()
+
+	if err := req.ValidateAll(); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidArgument, err)
+	}
+
+	var options []abstractions.MutationOptFunc
+	if req.ExpectedVersion != nil {
+		options = append(options, abstractions.WithExpectedVersion(req.GetExpectedVersion()))
+	}
+
+	policy := domain.PVZPolicy{
+		PVZID:     req.GetPvzId(),
+		UpdatedBy: req.GetUpdatedBy(),
+	}
+
+	if req.ReturnWindow != nil {
+		policy.ReturnWindow = req.GetReturnWindow().AsDuration()
+		policy.SetRules = append(policy.SetRules, domain.PVZPolicyRuleReturnWindow)
+	}
+	if req.MaxStorageTime != nil {
+		policy.MaxStorageTime = req.GetMaxStorageTime().AsDuration()
+		policy.SetRules = append(policy.SetRules, domain.PVZPolicyRuleMaxStorageTime)
+	}
+	if req.MaxOrderWeight != nil {
+		policy.MaxOrderWeight = int(req.GetMaxOrderWeight())
+		policy.SetRules = append(policy.SetRules, domain.PVZPolicyRuleMaxOrderWeight)
+	}
+
+	if req.GetAllPackagingAllowed() && len(req.GetAllowedPackaging()) > 0 {
+		return nil, fmt.Errorf("%w: allowed packaging must be empty if all packaging is allowed", domain.ErrInvalidArgument)
+	}
+	if req.GetAllPackagingAllowed() || len(req.GetAllowedPackaging()) > 0 {
+		policy.AllowedPackaging = []domain.PackagingType{}
+		policy.SetRules = append(policy.SetRules, domain.PVZPolicyRuleAllowedPackaging)
+	}
+	for _, code := range req.GetAllowedPackaging() {
+		packaging, err := domain.NewPackagingType(code)
+		if err != nil {
+			return nil, err
+		}
+		policy.AllowedPackaging = append(policy.AllowedPackaging, packaging)
+	}
+
+	policy, err := p.policyUseCase.UpdatePVZPolicy(ctx, policy, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &desc.UpdatePVZPolicyResponse{
+		Policy: domainToDescPVZPolicy(policy),
+	}, nil
+}
@@ -19,7 +19,7 @@ import (
 type EventsRepository interface {
 	// GetPendingEvents returns a list of events that have not been sent yet.
 	GetPendingEvents(ctx context.Context, limit int) ([]domain.Event, error)
-	// MarkAsSent marks the event as sent.
+	// MarkAsSent marks the event as sent. The pickup code is dropped from the payload of the sent event.
 	MarkAsSent(ctx context.Context, id uuid.UUID) error
 }
 
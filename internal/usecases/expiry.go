@@ -0,0 +1,98 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"homework/internal/domain"
+)
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i ExpiryRepository -s _mock.go -o ./mocks
+
+// ExpiryRepository finds the orders whose storage time is running out and writes their events in the same transaction
+type ExpiryRepository interface {
+	// ExpireOrders marks up to limit accepted orders whose storage time has passed by now as expired.
+	// If courierReturnLists is set, the expired orders are also grouped into the courier return lists.
+	// It returns the expired orders and the created lists
+	ExpireOrders(ctx context.Context, now time.Time, limit int, courierReturnLists bool) ([]domain.PVZOrder, []domain.CourierReturnList, error)
+	// RemindExpiringOrders marks up to limit accepted orders which expire in (now, before] as reminded
+	// and returns them. The order is reminded once unless its storage is extended
+	RemindExpiringOrders(ctx context.Context, now, before time.Time, limit int) ([]domain.PVZOrder, error)
+}
+
+// ExpirySweeper periodically expires the overdue orders and reminds the recipients of the orders which expire soon
+type ExpirySweeper struct {
+	repo ExpiryRepository
+
+	limit int
+	// remindBefore is how long before the expiry the recipient is reminded, 0 disables the reminders
+	remindBefore time.Duration
+	// courierReturnLists groups the expired orders into the lists for the courier
+	courierReturnLists bool
+
+	done chan struct{}
+}
+
+func NewExpirySweeper(repo ExpiryRepository, limit int, remindBefore time.Duration, courierReturnLists bool) *ExpirySweeper {
+	return &ExpirySweeper{
+		repo:               repo,
+		limit:              limit,
+		remindBefore:       remindBefore,
+		courierReturnLists: courierReturnLists,
+		done:               make(chan struct{}),
+	}
+}
+
+// Run sweeps the orders every interval until the context is done or the sweeper is stopped.
+// A failed sweep is logged and retried on the next tick
+func (e *ExpirySweeper) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-e.done:
+			return nil
+		case <-ticker.C:
+			if err := e.RunOnce(ctx); err != nil {
+				log.Printf("expiry sweep failed: %v\n", err)
+			}
+		}
+	}
+}
+
+func (e *ExpirySweeper) Stop() {
+	close(e.done)
+}
+
+// RunOnce expires the overdue orders and sends the reminders, at most limit orders of each
+func (e *ExpirySweeper) RunOnce(ctx context.Context) error {
+	now := time.Now()
+
+	expired, lists, err := e.repo.ExpireOrders(ctx, now, e.limit, e.courierReturnLists)
+	if err != nil {
+		return fmt.Errorf("error expiring orders: %w", err)
+	}
+
+	for _, list := range lists {
+		log.Printf("courier return list %s of pvz %s created with %d orders\n", list.ID, list.PVZID, len(list.OrderIDs))
+	}
+
+	var reminded []domain.PVZOrder
+	if e.remindBefore > 0 {
+		reminded, err = e.repo.RemindExpiringOrders(ctx, now, now.Add(e.remindBefore), e.limit)
+		if err != nil {
+			return fmt.Errorf("error reminding of expiring orders: %w", err)
+		}
+	}
+
+	if len(expired) > 0 || len(reminded) > 0 {
+		log.Printf("%d orders expired, %d recipients reminded\n", len(expired), len(reminded))
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"homework/internal/domain"
+	"homework/internal/usecases/mocks"
+	"testing"
+	"time"
+)
+
+func TestExpirySweeper_RunOnce(t *testing.T) {
+	t.Parallel()
+
+	const limit = 10
+
+	ctrl := minimock.NewController(t)
+	repo := mocks.NewExpiryRepositoryMock(ctrl)
+
+	sweeper := NewExpirySweeper(repo, limit, 24*time.Hour, true)
+
+	expired := []domain.PVZOrder{{OrderID: "1", PVZID: "1", Status: domain.OrderStatusExpired}}
+	repo.ExpireOrdersMock.Set(func(_ context.Context, _ time.Time, gotLimit int, courierReturnLists bool) ([]domain.PVZOrder, []domain.CourierReturnList, error) {
+		assert.Equal(t, limit, gotLimit)
+		assert.True(t, courierReturnLists)
+		return expired, domain.NewCourierReturnLists(expired), nil
+	})
+	repo.RemindExpiringOrdersMock.Set(func(_ context.Context, now, before time.Time, gotLimit int) ([]domain.PVZOrder, error) {
+		assert.Equal(t, limit, gotLimit)
+		assert.Equal(t, 24*time.Hour, before.Sub(now))
+		return []domain.PVZOrder{{OrderID: "2", PVZID: "1"}}, nil
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	assert.NoError(t, sweeper.RunOnce(ctx))
+}
+
+func TestExpirySweeper_RunOnce_NoReminders(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	repo := mocks.NewExpiryRepositoryMock(ctrl)
+
+	sweeper := NewExpirySweeper(repo, 10, 0, false)
+
+	repo.ExpireOrdersMock.Return(nil, nil, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	assert.NoError(t, sweeper.RunOnce(ctx))
+}
+
+func TestExpirySweeper_RunOnce_Error(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	repo := mocks.NewExpiryRepositoryMock(ctrl)
+
+	sweeper := NewExpirySweeper(repo, 10, 24*time.Hour, false)
+
+	repo.ExpireOrdersMock.Return(nil, nil, errors.New("connection lost"))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	assert.Error(t, sweeper.RunOnce(ctx))
+}
@@ -0,0 +1,254 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/opentracing/opentracing-go"
+
+	"homework/internal/abstractions"
+	"homework/internal/domain"
+)
+
+var _ abstractions.IHandoverUseCase = &HandoverUseCase{}
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i HandoverRepository -s _mock.go -o ./mocks
+
+// HandoverRepository is an interface for handover session repository
+type HandoverRepository interface {
+	CreateSession(ctx context.Context, session domain.HandoverSession) error
+	// GetSession returns the session along with its scans in the order they were made
+	GetSession(ctx context.Context, sessionID string) (domain.HandoverSession, error)
+	// LockSession returns the session like GetSession and locks it until the end of the transaction,
+	// so the scans of the session are classified one after another
+	LockSession(ctx context.Context, sessionID string) (domain.HandoverSession, error)
+	// AddScan stores the scan if the session is still open, domain.ErrConflict is returned otherwise
+	AddScan(ctx context.Context, scan domain.HandoverScan) error
+	// CloseSession closes the open session with the report, domain.ErrConflict is returned if it is already closed
+	CloseSession(ctx context.Context, report domain.HandoverReport) error
+}
+
+// HandoverUseCase reconciles the parcels the courier hands over with the manifest
+// and accepts the matching ones through the order use case
+type HandoverUseCase struct {
+	txManager TxManager
+	repo      HandoverRepository
+	orders    abstractions.IPVZOrderUseCase
+	policies  PVZPolicies
+}
+
+// NewHandoverUseCase creates a new handover use case
+func NewHandoverUseCase(txManager TxManager, repo HandoverRepository, orders abstractions.IPVZOrderUseCase, policies PVZPolicies) *HandoverUseCase {
+	return &HandoverUseCase{
+		txManager: txManager,
+		repo:      repo,
+		orders:    orders,
+		policies:  policies,
+	}
+}
+
+// OpenHandoverSession opens a session for the manifest of the courier
+func (h *HandoverUseCase) OpenHandoverSession(ctx context.Context, courierID string, expected []domain.DeliveryItem) (domain.HandoverSession, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "HandoverUseCase.OpenHandoverSession")
+	defer span.Finish()
+
+	pvzID, err := currentPVZID(ctx)
+	if err != nil {
+		return domain.HandoverSession{}, err
+	}
+
+	if err := validateHandoverManifest(courierID, expected); err != nil {
+		return domain.HandoverSession{}, err
+	}
+
+	session := domain.NewHandoverSession(pvzID, courierID, expected)
+	if err := h.repo.CreateSession(ctx, session); err != nil {
+		return domain.HandoverSession{}, err
+	}
+
+	return session, nil
+}
+
+func validateHandoverManifest(courierID string, expected []domain.DeliveryItem) error {
+	if courierID == "" {
+		return fmt.Errorf("%w: courier id is empty", domain.ErrInvalidArgument)
+	}
+
+	if len(expected) == 0 {
+		return fmt.Errorf("%w: manifest is empty", domain.ErrInvalidArgument)
+	}
+
+	if len(expected) > MaxDeliveryBatchSize {
+		return fmt.Errorf("%w: manifest has more than %d parcels", domain.ErrInvalidArgument, MaxDeliveryBatchSize)
+	}
+
+	seen := make(map[string]struct{}, len(expected))
+	for _, item := range expected {
+		if item.OrderID == "" {
+			return fmt.Errorf("%w: order id is empty", domain.ErrInvalidArgument)
+		}
+		if _, ok := seen[item.OrderID]; ok {
+			return fmt.Errorf("%w: order %s is repeated in the manifest", domain.ErrInvalidArgument, item.OrderID)
+		}
+		seen[item.OrderID] = struct{}{}
+	}
+
+	return nil
+}
+
+// ScanHandoverParcel checks the scanned parcel against the manifest and accepts the order if it matches.
+// measuredWeight is 0 if the parcel was not weighed. The scan is stored whatever the result is
+func (h *HandoverUseCase) ScanHandoverParcel(ctx context.Context, sessionID, orderID string, measuredWeight int) (domain.HandoverScan, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "HandoverUseCase.ScanHandoverParcel")
+	defer span.Finish()
+
+	if orderID == "" {
+		return domain.HandoverScan{}, fmt.Errorf("%w: order id is empty", domain.ErrInvalidArgument)
+	}
+
+	if measuredWeight < 0 {
+		return domain.HandoverScan{}, fmt.Errorf("%w: measured weight is negative", domain.ErrInvalidArgument)
+	}
+
+	// The scan is classified against the locked session, so the concurrent scans of the same parcel
+	// are not both matched. The order is accepted only along with its scan, so a failed scan can be retried
+	// and the parcel is never accepted without being reported as scanned
+	var scan domain.HandoverScan
+	err := h.txManager.RunReadCommittedTransaction(ctx, func(ctx context.Context) error {
+		session, err := h.lockOpenSession(ctx, sessionID)
+		if err != nil {
+			return err
+		}
+
+		var tolerance int
+		if measuredWeight > 0 {
+			tolerance, err = h.policies.WeightTolerancePercent(ctx, session.PVZID)
+			if err != nil {
+				return err
+			}
+		}
+
+		scan = session.NewScan(orderID, measuredWeight, tolerance)
+
+		if scan.Result == domain.HandoverScanResultMatched {
+			item, _ := session.ExpectedItem(orderID)
+			if err := h.acceptParcel(ctx, item, measuredWeight); err != nil {
+				if !isRejection(err) {
+					return err
+				}
+				scan.Result = domain.HandoverScanResultRejected
+				scan.Reason = err.Error()
+			}
+		}
+
+		return h.repo.AddScan(ctx, scan)
+	})
+	if err != nil {
+		return domain.HandoverScan{}, err
+	}
+
+	return scan, nil
+}
+
+// acceptParcel accepts the order of the matched parcel, the measured weight is recorded if the parcel was weighed
+func (h *HandoverUseCase) acceptParcel(ctx context.Context, item domain.DeliveryItem, measuredWeight int) error {
+	var options []abstractions.AcceptOptFunc
+	if measuredWeight > 0 {
+		options = append(options, abstractions.WithMeasuredWeight(measuredWeight))
+	}
+
+	_, err := h.orders.AcceptOrderDelivery(
+		ctx,
+		item.OrderID,
+		item.RecipientID,
+		item.StorageTime,
+		item.Cost,
+		item.Weight,
+		item.Dimensions,
+		item.Packaging,
+		item.AdditionalFilm,
+		options...,
+	)
+	return err
+}
+
+// isRejection checks if the order can not be accepted because of the parcel itself,
+// the other errors fail the scan so it can be retried
+func isRejection(err error) bool {
+	return errors.Is(err, domain.ErrInvalidArgument) ||
+		errors.Is(err, domain.ErrAlreadyExists) ||
+		errors.Is(err, domain.ErrConflict)
+}
+
+// CloseHandoverSession closes the session and returns the discrepancy report
+func (h *HandoverUseCase) CloseHandoverSession(ctx context.Context, sessionID string) (domain.HandoverReport, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "HandoverUseCase.CloseHandoverSession")
+	defer span.Finish()
+
+	// The report is made of the locked session, so no scan is stored after it is made
+	var report domain.HandoverReport
+	err := h.txManager.RunReadCommittedTransaction(ctx, func(ctx context.Context) error {
+		session, err := h.lockOpenSession(ctx, sessionID)
+		if err != nil {
+			return err
+		}
+
+		report = session.NewReport()
+		return h.repo.CloseSession(ctx, report)
+	})
+	if err != nil {
+		return domain.HandoverReport{}, err
+	}
+
+	return report, nil
+}
+
+// GetHandoverSession returns the session with its scans and, if it is closed, the report
+func (h *HandoverUseCase) GetHandoverSession(ctx context.Context, sessionID string) (domain.HandoverSession, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "HandoverUseCase.GetHandoverSession")
+	defer span.Finish()
+
+	return h.getSession(ctx, sessionID, h.repo.GetSession)
+}
+
+// getSession returns the session loaded by load if it belongs to the current PVZ
+func (h *HandoverUseCase) getSession(
+	ctx context.Context,
+	sessionID string,
+	load func(ctx context.Context, sessionID string) (domain.HandoverSession, error),
+) (domain.HandoverSession, error) {
+	pvzID, err := currentPVZID(ctx)
+	if err != nil {
+		return domain.HandoverSession{}, err
+	}
+
+	if sessionID == "" {
+		return domain.HandoverSession{}, fmt.Errorf("%w: session id is empty", domain.ErrInvalidArgument)
+	}
+
+	session, err := load(ctx, sessionID)
+	if err != nil {
+		return domain.HandoverSession{}, err
+	}
+
+	if session.PVZID != pvzID {
+		return domain.HandoverSession{}, fmt.Errorf("%w: session is opened in another pvz", domain.ErrNotFound)
+	}
+
+	return session, nil
+}
+
+// lockOpenSession locks the open session until the end of the transaction it is called in
+func (h *HandoverUseCase) lockOpenSession(ctx context.Context, sessionID string) (domain.HandoverSession, error) {
+	session, err := h.getSession(ctx, sessionID, h.repo.LockSession)
+	if err != nil {
+		return domain.HandoverSession{}, err
+	}
+
+	if !session.IsOpen() {
+		return domain.HandoverSession{}, fmt.Errorf("%w: session is closed", domain.ErrConflict)
+	}
+
+	return session, nil
+}
@@ -0,0 +1,338 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"homework/internal/abstractions"
+	abstractionsMocks "homework/internal/abstractions/mocks"
+	"homework/internal/domain"
+	"homework/internal/usecases/mocks"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func handoverItem(orderID string, weight int) domain.DeliveryItem {
+	return domain.DeliveryItem{
+		OrderID:     orderID,
+		RecipientID: "recipientID",
+		StorageTime: time.Hour,
+		Cost:        domain.RUB(100),
+		Weight:      weight,
+		Packaging:   domain.PackagingTypeBox,
+	}
+}
+
+// inTx marks the context of the transaction run by txManagerMock
+type inTx struct{}
+
+// txManagerMock runs the writes in a transaction the repositories see in the context
+func txManagerMock(ctrl *minimock.Controller) *mocks.TxManagerMock {
+	return mocks.NewTxManagerMock(ctrl).RunReadCommittedTransactionMock.Optional().
+		Set(func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(context.WithValue(ctx, inTx{}, true))
+		})
+}
+
+func TestHandoverUseCase_OpenHandoverSession(t *testing.T) {
+	t.Parallel()
+
+	ctx := abstractions.ContextWithPVZID(context.Background(), "currentPVZID")
+
+	tests := []struct {
+		name      string
+		courierID string
+		expected  []domain.DeliveryItem
+		setup     func(repoMock *mocks.HandoverRepositoryMock)
+		wantErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name:      "Success",
+			courierID: "courierID",
+			expected:  []domain.DeliveryItem{handoverItem("1", 1000), handoverItem("2", 1000)},
+			setup: func(repoMock *mocks.HandoverRepositoryMock) {
+				repoMock.CreateSessionMock.Set(func(_ context.Context, session domain.HandoverSession) error {
+					assert.Equal(t, "currentPVZID", session.PVZID)
+					assert.Equal(t, "courierID", session.CourierID)
+					assert.Equal(t, domain.HandoverSessionStatusOpen, session.Status)
+					assert.Len(t, session.Expected, 2)
+					assert.NotEmpty(t, session.ID)
+					return nil
+				})
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:      "Empty manifest",
+			courierID: "courierID",
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, domain.ErrInvalidArgument, i...)
+			},
+		},
+		{
+			name:      "Repeated order",
+			courierID: "courierID",
+			expected:  []domain.DeliveryItem{handoverItem("1", 1000), handoverItem("1", 1000)},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, domain.ErrInvalidArgument, i...)
+			},
+		},
+		{
+			name:     "Empty courier",
+			expected: []domain.DeliveryItem{handoverItem("1", 1000)},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, domain.ErrInvalidArgument, i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := minimock.NewController(t)
+			repoMock := mocks.NewHandoverRepositoryMock(ctrl)
+			if tt.setup != nil {
+				tt.setup(repoMock)
+			}
+
+			uc := NewHandoverUseCase(txManagerMock(ctrl), repoMock, abstractionsMocks.NewIPVZOrderUseCaseMock(ctrl), mocks.NewPVZPoliciesMock(ctrl))
+
+			_, err := uc.OpenHandoverSession(ctx, tt.courierID, tt.expected)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestHandoverUseCase_ScanHandoverParcel(t *testing.T) {
+	t.Parallel()
+
+	ctx := abstractions.ContextWithPVZID(context.Background(), "currentPVZID")
+
+	session := func(scans ...domain.HandoverScan) domain.HandoverSession {
+		s := domain.NewHandoverSession("currentPVZID", "courierID", []domain.DeliveryItem{handoverItem("1", 1000)})
+		s.Scans = scans
+		return s
+	}
+
+	tests := []struct {
+		name           string
+		session        domain.HandoverSession
+		orderID        string
+		measuredWeight int
+		acceptErr      error
+		addScanErr     error
+		wantAccept     bool
+		wantResult     domain.HandoverScanResult
+		wantErr        assert.ErrorAssertionFunc
+	}{
+		{
+			name:       "Matched",
+			session:    session(),
+			orderID:    "1",
+			wantAccept: true,
+			wantResult: domain.HandoverScanResultMatched,
+			wantErr:    assert.NoError,
+		},
+		{
+			name:           "Matched within tolerance",
+			session:        session(),
+			orderID:        "1",
+			measuredWeight: 1100,
+			wantAccept:     true,
+			wantResult:     domain.HandoverScanResultMatched,
+			wantErr:        assert.NoError,
+		},
+		{
+			name:           "Weight mismatch",
+			session:        session(),
+			orderID:        "1",
+			measuredWeight: 1200,
+			wantResult:     domain.HandoverScanResultWeightMismatch,
+			wantErr:        assert.NoError,
+		},
+		{
+			name:       "Unexpected",
+			session:    session(),
+			orderID:    "2",
+			wantResult: domain.HandoverScanResultUnexpected,
+			wantErr:    assert.NoError,
+		},
+		{
+			name:       "Duplicate",
+			session:    session(domain.HandoverScan{OrderID: "1", Result: domain.HandoverScanResultMatched}),
+			orderID:    "1",
+			wantResult: domain.HandoverScanResultDuplicate,
+			wantErr:    assert.NoError,
+		},
+		{
+			name:       "Rejected",
+			session:    session(),
+			orderID:    "1",
+			acceptErr:  domain.ErrAlreadyExists,
+			wantAccept: true,
+			wantResult: domain.HandoverScanResultRejected,
+			wantErr:    assert.NoError,
+		},
+		{
+			name:       "Accept failure",
+			session:    session(),
+			orderID:    "1",
+			acceptErr:  errors.New("connection lost"),
+			wantAccept: true,
+			wantErr:    assert.Error,
+		},
+		{
+			name:       "Session closed while scanning",
+			session:    session(),
+			orderID:    "1",
+			addScanErr: domain.ErrConflict,
+			wantAccept: true,
+			wantResult: domain.HandoverScanResultMatched,
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, domain.ErrConflict, i...)
+			},
+		},
+		{
+			name:       "Scan failure",
+			session:    session(),
+			orderID:    "1",
+			addScanErr: errors.New("connection lost"),
+			wantAccept: true,
+			wantResult: domain.HandoverScanResultMatched,
+			wantErr:    assert.Error,
+		},
+		{
+			name: "Closed session",
+			session: func() domain.HandoverSession {
+				s := session()
+				s.Status = domain.HandoverSessionStatusClosed
+				return s
+			}(),
+			orderID: "1",
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, domain.ErrConflict, i...)
+			},
+		},
+		{
+			name: "Another PVZ",
+			session: func() domain.HandoverSession {
+				s := session()
+				s.PVZID = "anotherPVZID"
+				return s
+			}(),
+			orderID: "1",
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, domain.ErrNotFound, i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := minimock.NewController(t)
+			repoMock := mocks.NewHandoverRepositoryMock(ctrl)
+			ordersMock := abstractionsMocks.NewIPVZOrderUseCaseMock(ctrl)
+			policiesMock := mocks.NewPVZPoliciesMock(ctrl)
+
+			// The scan is classified against the session locked in the transaction
+			repoMock.LockSessionMock.Set(func(ctx context.Context, sessionID string) (domain.HandoverSession, error) {
+				assert.Equal(t, true, ctx.Value(inTx{}))
+				assert.Equal(t, tt.session.ID, sessionID)
+				return tt.session, nil
+			})
+			if tt.measuredWeight > 0 {
+				policiesMock.WeightTolerancePercentMock.Expect(minimock.AnyContext, "currentPVZID").Return(10, nil)
+			}
+			// The order is accepted in the same transaction the scan is stored in,
+			// so it is rolled back along with the scan
+			if tt.wantAccept {
+				ordersMock.AcceptOrderDeliveryMock.Set(func(ctx context.Context, _, _ string, _ time.Duration, _ domain.Money, _ int, _ domain.Dimensions, _ domain.PackagingType, _ bool, _ ...abstractions.AcceptOptFunc) (domain.PVZOrder, error) {
+					assert.Equal(t, true, ctx.Value(inTx{}))
+					return domain.PVZOrder{}, tt.acceptErr
+				})
+			}
+			if tt.wantResult != "" {
+				repoMock.AddScanMock.Set(func(ctx context.Context, scan domain.HandoverScan) error {
+					assert.Equal(t, true, ctx.Value(inTx{}))
+					assert.Equal(t, tt.wantResult, scan.Result)
+					assert.Equal(t, tt.session.ID, scan.SessionID)
+					return tt.addScanErr
+				})
+			}
+
+			uc := NewHandoverUseCase(txManagerMock(ctrl), repoMock, ordersMock, policiesMock)
+
+			scan, err := uc.ScanHandoverParcel(ctx, tt.session.ID, tt.orderID, tt.measuredWeight)
+			if !tt.wantErr(t, err) {
+				return
+			}
+
+			if err == nil {
+				assert.Equal(t, tt.wantResult, scan.Result)
+			}
+		})
+	}
+}
+
+func TestHandoverUseCase_CloseHandoverSession(t *testing.T) {
+	t.Parallel()
+
+	ctx := abstractions.ContextWithPVZID(context.Background(), "currentPVZID")
+
+	ctrl := minimock.NewController(t)
+	repoMock := mocks.NewHandoverRepositoryMock(ctrl)
+
+	session := domain.NewHandoverSession("currentPVZID", "courierID", []domain.DeliveryItem{
+		handoverItem("accepted", 1000),
+		handoverItem("missing", 1000),
+		handoverItem("heavy", 1000),
+		handoverItem("rejected", 1000),
+		handoverItem("reweighed", 1000),
+	})
+	session.Scans = []domain.HandoverScan{
+		{OrderID: "accepted", Result: domain.HandoverScanResultMatched},
+		{OrderID: "accepted", Result: domain.HandoverScanResultDuplicate},
+		{OrderID: "heavy", MeasuredWeight: 1500, Result: domain.HandoverScanResultWeightMismatch},
+		{OrderID: "rejected", Result: domain.HandoverScanResultRejected, Reason: "order already exists"},
+		{OrderID: "unexpected", Result: domain.HandoverScanResultUnexpected},
+		{OrderID: "unexpected", Result: domain.HandoverScanResultUnexpected},
+		{OrderID: "reweighed", MeasuredWeight: 1500, Result: domain.HandoverScanResultWeightMismatch},
+		{OrderID: "reweighed", MeasuredWeight: 1000, Result: domain.HandoverScanResultMatched},
+	}
+
+	want := domain.HandoverReport{
+		SessionID:  session.ID,
+		Accepted:   []string{"accepted", "reweighed"},
+		Missing:    []string{"missing"},
+		Unexpected: []string{"unexpected"},
+		WeightMismatches: []domain.HandoverWeightMismatch{
+			{OrderID: "heavy", DeclaredWeight: 1000, MeasuredWeight: 1500},
+		},
+		Rejected: []domain.HandoverRejection{
+			{OrderID: "rejected", Reason: "order already exists"},
+		},
+	}
+
+	repoMock.LockSessionMock.Set(func(ctx context.Context, sessionID string) (domain.HandoverSession, error) {
+		assert.Equal(t, true, ctx.Value(inTx{}))
+		assert.Equal(t, session.ID, sessionID)
+		return session, nil
+	})
+	repoMock.CloseSessionMock.Set(func(ctx context.Context, report domain.HandoverReport) error {
+		assert.Equal(t, true, ctx.Value(inTx{}))
+		assert.Equal(t, want, report)
+		return nil
+	})
+
+	uc := NewHandoverUseCase(txManagerMock(ctrl), repoMock, abstractionsMocks.NewIPVZOrderUseCaseMock(ctrl), mocks.NewPVZPoliciesMock(ctrl))
+
+	report, err := uc.CloseHandoverSession(ctx, session.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, want, report)
+}
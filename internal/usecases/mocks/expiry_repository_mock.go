@@ -0,0 +1,898 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"homework/internal/domain"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// ExpiryRepositoryMock implements mm_usecases.ExpiryRepository
+type ExpiryRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcExpireOrders          func(ctx context.Context, now time.Time, limit int, courierReturnLists bool) (pa1 []domain.PVZOrder, ca1 []domain.CourierReturnList, err error)
+	funcExpireOrdersOrigin    string
+	inspectFuncExpireOrders   func(ctx context.Context, now time.Time, limit int, courierReturnLists bool)
+	afterExpireOrdersCounter  uint64
+	beforeExpireOrdersCounter uint64
+	ExpireOrdersMock          mExpiryRepositoryMockExpireOrders
+
+	funcRemindExpiringOrders          func(ctx context.Context, now time.Time, before time.Time, limit int) (pa1 []domain.PVZOrder, err error)
+	funcRemindExpiringOrdersOrigin    string
+	inspectFuncRemindExpiringOrders   func(ctx context.Context, now time.Time, before time.Time, limit int)
+	afterRemindExpiringOrdersCounter  uint64
+	beforeRemindExpiringOrdersCounter uint64
+	RemindExpiringOrdersMock          mExpiryRepositoryMockRemindExpiringOrders
+}
+
+// NewExpiryRepositoryMock returns a mock for mm_usecases.ExpiryRepository
+func NewExpiryRepositoryMock(t minimock.Tester) *ExpiryRepositoryMock {
+	m := &ExpiryRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ExpireOrdersMock = mExpiryRepositoryMockExpireOrders{mock: m}
+	m.ExpireOrdersMock.callArgs = []*ExpiryRepositoryMockExpireOrdersParams{}
+
+	m.RemindExpiringOrdersMock = mExpiryRepositoryMockRemindExpiringOrders{mock: m}
+	m.RemindExpiringOrdersMock.callArgs = []*ExpiryRepositoryMockRemindExpiringOrdersParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mExpiryRepositoryMockExpireOrders struct {
+	optional           bool
+	mock               *ExpiryRepositoryMock
+	defaultExpectation *ExpiryRepositoryMockExpireOrdersExpectation
+	expectations       []*ExpiryRepositoryMockExpireOrdersExpectation
+
+	callArgs []*ExpiryRepositoryMockExpireOrdersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ExpiryRepositoryMockExpireOrdersExpectation specifies expectation struct of the ExpiryRepository.ExpireOrders
+type ExpiryRepositoryMockExpireOrdersExpectation struct {
+	mock               *ExpiryRepositoryMock
+	params             *ExpiryRepositoryMockExpireOrdersParams
+	paramPtrs          *ExpiryRepositoryMockExpireOrdersParamPtrs
+	expectationOrigins ExpiryRepositoryMockExpireOrdersExpectationOrigins
+	results            *ExpiryRepositoryMockExpireOrdersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ExpiryRepositoryMockExpireOrdersParams contains parameters of the ExpiryRepository.ExpireOrders
+type ExpiryRepositoryMockExpireOrdersParams struct {
+	ctx                context.Context
+	now                time.Time
+	limit              int
+	courierReturnLists bool
+}
+
+// ExpiryRepositoryMockExpireOrdersParamPtrs contains pointers to parameters of the ExpiryRepository.ExpireOrders
+type ExpiryRepositoryMockExpireOrdersParamPtrs struct {
+	ctx                *context.Context
+	now                *time.Time
+	limit              *int
+	courierReturnLists *bool
+}
+
+// ExpiryRepositoryMockExpireOrdersResults contains results of the ExpiryRepository.ExpireOrders
+type ExpiryRepositoryMockExpireOrdersResults struct {
+	pa1 []domain.PVZOrder
+	ca1 []domain.CourierReturnList
+	err error
+}
+
+// ExpiryRepositoryMockExpireOrdersOrigins contains origins of expectations of the ExpiryRepository.ExpireOrders
+type ExpiryRepositoryMockExpireOrdersExpectationOrigins struct {
+	origin                   string
+	originCtx                string
+	originNow                string
+	originLimit              string
+	originCourierReturnLists string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExpireOrders *mExpiryRepositoryMockExpireOrders) Optional() *mExpiryRepositoryMockExpireOrders {
+	mmExpireOrders.optional = true
+	return mmExpireOrders
+}
+
+// Expect sets up expected params for ExpiryRepository.ExpireOrders
+func (mmExpireOrders *mExpiryRepositoryMockExpireOrders) Expect(ctx context.Context, now time.Time, limit int, courierReturnLists bool) *mExpiryRepositoryMockExpireOrders {
+	if mmExpireOrders.mock.funcExpireOrders != nil {
+		mmExpireOrders.mock.t.Fatalf("ExpiryRepositoryMock.ExpireOrders mock is already set by Set")
+	}
+
+	if mmExpireOrders.defaultExpectation == nil {
+		mmExpireOrders.defaultExpectation = &ExpiryRepositoryMockExpireOrdersExpectation{}
+	}
+
+	if mmExpireOrders.defaultExpectation.paramPtrs != nil {
+		mmExpireOrders.mock.t.Fatalf("ExpiryRepositoryMock.ExpireOrders mock is already set by ExpectParams functions")
+	}
+
+	mmExpireOrders.defaultExpectation.params = &ExpiryRepositoryMockExpireOrdersParams{ctx, now, limit, courierReturnLists}
+	mmExpireOrders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExpireOrders.expectations {
+		if minimock.Equal(e.params, mmExpireOrders.defaultExpectation.params) {
+			mmExpireOrders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExpireOrders.defaultExpectation.params)
+		}
+	}
+
+	return mmExpireOrders
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ExpiryRepository.ExpireOrders
+func (mmExpireOrders *mExpiryRepositoryMockExpireOrders) ExpectCtxParam1(ctx context.Context) *mExpiryRepositoryMockExpireOrders {
+	if mmExpireOrders.mock.funcExpireOrders != nil {
+		mmExpireOrders.mock.t.Fatalf("ExpiryRepositoryMock.ExpireOrders mock is already set by Set")
+	}
+
+	if mmExpireOrders.defaultExpectation == nil {
+		mmExpireOrders.defaultExpectation = &ExpiryRepositoryMockExpireOrdersExpectation{}
+	}
+
+	if mmExpireOrders.defaultExpectation.params != nil {
+		mmExpireOrders.mock.t.Fatalf("ExpiryRepositoryMock.ExpireOrders mock is already set by Expect")
+	}
+
+	if mmExpireOrders.defaultExpectation.paramPtrs == nil {
+		mmExpireOrders.defaultExpectation.paramPtrs = &ExpiryRepositoryMockExpireOrdersParamPtrs{}
+	}
+	mmExpireOrders.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExpireOrders.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExpireOrders
+}
+
+// ExpectNowParam2 sets up expected param now for ExpiryRepository.ExpireOrders
+func (mmExpireOrders *mExpiryRepositoryMockExpireOrders) ExpectNowParam2(now time.Time) *mExpiryRepositoryMockExpireOrders {
+	if mmExpireOrders.mock.funcExpireOrders != nil {
+		mmExpireOrders.mock.t.Fatalf("ExpiryRepositoryMock.ExpireOrders mock is already set by Set")
+	}
+
+	if mmExpireOrders.defaultExpectation == nil {
+		mmExpireOrders.defaultExpectation = &ExpiryRepositoryMockExpireOrdersExpectation{}
+	}
+
+	if mmExpireOrders.defaultExpectation.params != nil {
+		mmExpireOrders.mock.t.Fatalf("ExpiryRepositoryMock.ExpireOrders mock is already set by Expect")
+	}
+
+	if mmExpireOrders.defaultExpectation.paramPtrs == nil {
+		mmExpireOrders.defaultExpectation.paramPtrs = &ExpiryRepositoryMockExpireOrdersParamPtrs{}
+	}
+	mmExpireOrders.defaultExpectation.paramPtrs.now = &now
+	mmExpireOrders.defaultExpectation.expectationOrigins.originNow = minimock.CallerInfo(1)
+
+	return mmExpireOrders
+}
+
+// ExpectLimitParam3 sets up expected param limit for ExpiryRepository.ExpireOrders
+func (mmExpireOrders *mExpiryRepositoryMockExpireOrders) ExpectLimitParam3(limit int) *mExpiryRepositoryMockExpireOrders {
+	if mmExpireOrders.mock.funcExpireOrders != nil {
+		mmExpireOrders.mock.t.Fatalf("ExpiryRepositoryMock.ExpireOrders mock is already set by Set")
+	}
+
+	if mmExpireOrders.defaultExpectation == nil {
+		mmExpireOrders.defaultExpectation = &ExpiryRepositoryMockExpireOrdersExpectation{}
+	}
+
+	if mmExpireOrders.defaultExpectation.params != nil {
+		mmExpireOrders.mock.t.Fatalf("ExpiryRepositoryMock.ExpireOrders mock is already set by Expect")
+	}
+
+	if mmExpireOrders.defaultExpectation.paramPtrs == nil {
+		mmExpireOrders.defaultExpectation.paramPtrs = &ExpiryRepositoryMockExpireOrdersParamPtrs{}
+	}
+	mmExpireOrders.defaultExpectation.paramPtrs.limit = &limit
+	mmExpireOrders.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmExpireOrders
+}
+
+// ExpectCourierReturnListsParam4 sets up expected param courierReturnLists for ExpiryRepository.ExpireOrders
+func (mmExpireOrders *mExpiryRepositoryMockExpireOrders) ExpectCourierReturnListsParam4(courierReturnLists bool) *mExpiryRepositoryMockExpireOrders {
+	if mmExpireOrders.mock.funcExpireOrders != nil {
+		mmExpireOrders.mock.t.Fatalf("ExpiryRepositoryMock.ExpireOrders mock is already set by Set")
+	}
+
+	if mmExpireOrders.defaultExpectation == nil {
+		mmExpireOrders.defaultExpectation = &ExpiryRepositoryMockExpireOrdersExpectation{}
+	}
+
+	if mmExpireOrders.defaultExpectation.params != nil {
+		mmExpireOrders.mock.t.Fatalf("ExpiryRepositoryMock.ExpireOrders mock is already set by Expect")
+	}
+
+	if mmExpireOrders.defaultExpectation.paramPtrs == nil {
+		mmExpireOrders.defaultExpectation.paramPtrs = &ExpiryRepositoryMockExpireOrdersParamPtrs{}
+	}
+	mmExpireOrders.defaultExpectation.paramPtrs.courierReturnLists = &courierReturnLists
+	mmExpireOrders.defaultExpectation.expectationOrigins.originCourierReturnLists = minimock.CallerInfo(1)
+
+	return mmExpireOrders
+}
+
+// Inspect accepts an inspector function that has same arguments as the ExpiryRepository.ExpireOrders
+func (mmExpireOrders *mExpiryRepositoryMockExpireOrders) Inspect(f func(ctx context.Context, now time.Time, limit int, courierReturnLists bool)) *mExpiryRepositoryMockExpireOrders {
+	if mmExpireOrders.mock.inspectFuncExpireOrders != nil {
+		mmExpireOrders.mock.t.Fatalf("Inspect function is already set for ExpiryRepositoryMock.ExpireOrders")
+	}
+
+	mmExpireOrders.mock.inspectFuncExpireOrders = f
+
+	return mmExpireOrders
+}
+
+// Return sets up results that will be returned by ExpiryRepository.ExpireOrders
+func (mmExpireOrders *mExpiryRepositoryMockExpireOrders) Return(pa1 []domain.PVZOrder, ca1 []domain.CourierReturnList, err error) *ExpiryRepositoryMock {
+	if mmExpireOrders.mock.funcExpireOrders != nil {
+		mmExpireOrders.mock.t.Fatalf("ExpiryRepositoryMock.ExpireOrders mock is already set by Set")
+	}
+
+	if mmExpireOrders.defaultExpectation == nil {
+		mmExpireOrders.defaultExpectation = &ExpiryRepositoryMockExpireOrdersExpectation{mock: mmExpireOrders.mock}
+	}
+	mmExpireOrders.defaultExpectation.results = &ExpiryRepositoryMockExpireOrdersResults{pa1, ca1, err}
+	mmExpireOrders.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExpireOrders.mock
+}
+
+// Set uses given function f to mock the ExpiryRepository.ExpireOrders method
+func (mmExpireOrders *mExpiryRepositoryMockExpireOrders) Set(f func(ctx context.Context, now time.Time, limit int, courierReturnLists bool) (pa1 []domain.PVZOrder, ca1 []domain.CourierReturnList, err error)) *ExpiryRepositoryMock {
+	if mmExpireOrders.defaultExpectation != nil {
+		mmExpireOrders.mock.t.Fatalf("Default expectation is already set for the ExpiryRepository.ExpireOrders method")
+	}
+
+	if len(mmExpireOrders.expectations) > 0 {
+		mmExpireOrders.mock.t.Fatalf("Some expectations are already set for the ExpiryRepository.ExpireOrders method")
+	}
+
+	mmExpireOrders.mock.funcExpireOrders = f
+	mmExpireOrders.mock.funcExpireOrdersOrigin = minimock.CallerInfo(1)
+	return mmExpireOrders.mock
+}
+
+// When sets expectation for the ExpiryRepository.ExpireOrders which will trigger the result defined by the following
+// Then helper
+func (mmExpireOrders *mExpiryRepositoryMockExpireOrders) When(ctx context.Context, now time.Time, limit int, courierReturnLists bool) *ExpiryRepositoryMockExpireOrdersExpectation {
+	if mmExpireOrders.mock.funcExpireOrders != nil {
+		mmExpireOrders.mock.t.Fatalf("ExpiryRepositoryMock.ExpireOrders mock is already set by Set")
+	}
+
+	expectation := &ExpiryRepositoryMockExpireOrdersExpectation{
+		mock:               mmExpireOrders.mock,
+		params:             &ExpiryRepositoryMockExpireOrdersParams{ctx, now, limit, courierReturnLists},
+		expectationOrigins: ExpiryRepositoryMockExpireOrdersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExpireOrders.expectations = append(mmExpireOrders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ExpiryRepository.ExpireOrders return parameters for the expectation previously defined by the When method
+func (e *ExpiryRepositoryMockExpireOrdersExpectation) Then(pa1 []domain.PVZOrder, ca1 []domain.CourierReturnList, err error) *ExpiryRepositoryMock {
+	e.results = &ExpiryRepositoryMockExpireOrdersResults{pa1, ca1, err}
+	return e.mock
+}
+
+// Times sets number of times ExpiryRepository.ExpireOrders should be invoked
+func (mmExpireOrders *mExpiryRepositoryMockExpireOrders) Times(n uint64) *mExpiryRepositoryMockExpireOrders {
+	if n == 0 {
+		mmExpireOrders.mock.t.Fatalf("Times of ExpiryRepositoryMock.ExpireOrders mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExpireOrders.expectedInvocations, n)
+	mmExpireOrders.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExpireOrders
+}
+
+func (mmExpireOrders *mExpiryRepositoryMockExpireOrders) invocationsDone() bool {
+	if len(mmExpireOrders.expectations) == 0 && mmExpireOrders.defaultExpectation == nil && mmExpireOrders.mock.funcExpireOrders == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExpireOrders.mock.afterExpireOrdersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExpireOrders.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ExpireOrders implements mm_usecases.ExpiryRepository
+func (mmExpireOrders *ExpiryRepositoryMock) ExpireOrders(ctx context.Context, now time.Time, limit int, courierReturnLists bool) (pa1 []domain.PVZOrder, ca1 []domain.CourierReturnList, err error) {
+	mm_atomic.AddUint64(&mmExpireOrders.beforeExpireOrdersCounter, 1)
+	defer mm_atomic.AddUint64(&mmExpireOrders.afterExpireOrdersCounter, 1)
+
+	mmExpireOrders.t.Helper()
+
+	if mmExpireOrders.inspectFuncExpireOrders != nil {
+		mmExpireOrders.inspectFuncExpireOrders(ctx, now, limit, courierReturnLists)
+	}
+
+	mm_params := ExpiryRepositoryMockExpireOrdersParams{ctx, now, limit, courierReturnLists}
+
+	// Record call args
+	mmExpireOrders.ExpireOrdersMock.mutex.Lock()
+	mmExpireOrders.ExpireOrdersMock.callArgs = append(mmExpireOrders.ExpireOrdersMock.callArgs, &mm_params)
+	mmExpireOrders.ExpireOrdersMock.mutex.Unlock()
+
+	for _, e := range mmExpireOrders.ExpireOrdersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.ca1, e.results.err
+		}
+	}
+
+	if mmExpireOrders.ExpireOrdersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExpireOrders.ExpireOrdersMock.defaultExpectation.Counter, 1)
+		mm_want := mmExpireOrders.ExpireOrdersMock.defaultExpectation.params
+		mm_want_ptrs := mmExpireOrders.ExpireOrdersMock.defaultExpectation.paramPtrs
+
+		mm_got := ExpiryRepositoryMockExpireOrdersParams{ctx, now, limit, courierReturnLists}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExpireOrders.t.Errorf("ExpiryRepositoryMock.ExpireOrders got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExpireOrders.ExpireOrdersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmExpireOrders.t.Errorf("ExpiryRepositoryMock.ExpireOrders got unexpected parameter now, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExpireOrders.ExpireOrdersMock.defaultExpectation.expectationOrigins.originNow, *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmExpireOrders.t.Errorf("ExpiryRepositoryMock.ExpireOrders got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExpireOrders.ExpireOrdersMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+			if mm_want_ptrs.courierReturnLists != nil && !minimock.Equal(*mm_want_ptrs.courierReturnLists, mm_got.courierReturnLists) {
+				mmExpireOrders.t.Errorf("ExpiryRepositoryMock.ExpireOrders got unexpected parameter courierReturnLists, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExpireOrders.ExpireOrdersMock.defaultExpectation.expectationOrigins.originCourierReturnLists, *mm_want_ptrs.courierReturnLists, mm_got.courierReturnLists, minimock.Diff(*mm_want_ptrs.courierReturnLists, mm_got.courierReturnLists))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExpireOrders.t.Errorf("ExpiryRepositoryMock.ExpireOrders got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExpireOrders.ExpireOrdersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExpireOrders.ExpireOrdersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExpireOrders.t.Fatal("No results are set for the ExpiryRepositoryMock.ExpireOrders")
+		}
+		return (*mm_results).pa1, (*mm_results).ca1, (*mm_results).err
+	}
+	if mmExpireOrders.funcExpireOrders != nil {
+		return mmExpireOrders.funcExpireOrders(ctx, now, limit, courierReturnLists)
+	}
+	mmExpireOrders.t.Fatalf("Unexpected call to ExpiryRepositoryMock.ExpireOrders. %v %v %v %v", ctx, now, limit, courierReturnLists)
+	return
+}
+
+// ExpireOrdersAfterCounter returns a count of finished ExpiryRepositoryMock.ExpireOrders invocations
+func (mmExpireOrders *ExpiryRepositoryMock) ExpireOrdersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpireOrders.afterExpireOrdersCounter)
+}
+
+// ExpireOrdersBeforeCounter returns a count of ExpiryRepositoryMock.ExpireOrders invocations
+func (mmExpireOrders *ExpiryRepositoryMock) ExpireOrdersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpireOrders.beforeExpireOrdersCounter)
+}
+
+// Calls returns a list of arguments used in each call to ExpiryRepositoryMock.ExpireOrders.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExpireOrders *mExpiryRepositoryMockExpireOrders) Calls() []*ExpiryRepositoryMockExpireOrdersParams {
+	mmExpireOrders.mutex.RLock()
+
+	argCopy := make([]*ExpiryRepositoryMockExpireOrdersParams, len(mmExpireOrders.callArgs))
+	copy(argCopy, mmExpireOrders.callArgs)
+
+	mmExpireOrders.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExpireOrdersDone returns true if the count of the ExpireOrders invocations corresponds
+// the number of defined expectations
+func (m *ExpiryRepositoryMock) MinimockExpireOrdersDone() bool {
+	if m.ExpireOrdersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExpireOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExpireOrdersMock.invocationsDone()
+}
+
+// MinimockExpireOrdersInspect logs each unmet expectation
+func (m *ExpiryRepositoryMock) MinimockExpireOrdersInspect() {
+	for _, e := range m.ExpireOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ExpiryRepositoryMock.ExpireOrders at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExpireOrdersCounter := mm_atomic.LoadUint64(&m.afterExpireOrdersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExpireOrdersMock.defaultExpectation != nil && afterExpireOrdersCounter < 1 {
+		if m.ExpireOrdersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ExpiryRepositoryMock.ExpireOrders at\n%s", m.ExpireOrdersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ExpiryRepositoryMock.ExpireOrders at\n%s with params: %#v", m.ExpireOrdersMock.defaultExpectation.expectationOrigins.origin, *m.ExpireOrdersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExpireOrders != nil && afterExpireOrdersCounter < 1 {
+		m.t.Errorf("Expected call to ExpiryRepositoryMock.ExpireOrders at\n%s", m.funcExpireOrdersOrigin)
+	}
+
+	if !m.ExpireOrdersMock.invocationsDone() && afterExpireOrdersCounter > 0 {
+		m.t.Errorf("Expected %d calls to ExpiryRepositoryMock.ExpireOrders at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExpireOrdersMock.expectedInvocations), m.ExpireOrdersMock.expectedInvocationsOrigin, afterExpireOrdersCounter)
+	}
+}
+
+type mExpiryRepositoryMockRemindExpiringOrders struct {
+	optional           bool
+	mock               *ExpiryRepositoryMock
+	defaultExpectation *ExpiryRepositoryMockRemindExpiringOrdersExpectation
+	expectations       []*ExpiryRepositoryMockRemindExpiringOrdersExpectation
+
+	callArgs []*ExpiryRepositoryMockRemindExpiringOrdersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ExpiryRepositoryMockRemindExpiringOrdersExpectation specifies expectation struct of the ExpiryRepository.RemindExpiringOrders
+type ExpiryRepositoryMockRemindExpiringOrdersExpectation struct {
+	mock               *ExpiryRepositoryMock
+	params             *ExpiryRepositoryMockRemindExpiringOrdersParams
+	paramPtrs          *ExpiryRepositoryMockRemindExpiringOrdersParamPtrs
+	expectationOrigins ExpiryRepositoryMockRemindExpiringOrdersExpectationOrigins
+	results            *ExpiryRepositoryMockRemindExpiringOrdersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ExpiryRepositoryMockRemindExpiringOrdersParams contains parameters of the ExpiryRepository.RemindExpiringOrders
+type ExpiryRepositoryMockRemindExpiringOrdersParams struct {
+	ctx    context.Context
+	now    time.Time
+	before time.Time
+	limit  int
+}
+
+// ExpiryRepositoryMockRemindExpiringOrdersParamPtrs contains pointers to parameters of the ExpiryRepository.RemindExpiringOrders
+type ExpiryRepositoryMockRemindExpiringOrdersParamPtrs struct {
+	ctx    *context.Context
+	now    *time.Time
+	before *time.Time
+	limit  *int
+}
+
+// ExpiryRepositoryMockRemindExpiringOrdersResults contains results of the ExpiryRepository.RemindExpiringOrders
+type ExpiryRepositoryMockRemindExpiringOrdersResults struct {
+	pa1 []domain.PVZOrder
+	err error
+}
+
+// ExpiryRepositoryMockRemindExpiringOrdersOrigins contains origins of expectations of the ExpiryRepository.RemindExpiringOrders
+type ExpiryRepositoryMockRemindExpiringOrdersExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originNow    string
+	originBefore string
+	originLimit  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemindExpiringOrders *mExpiryRepositoryMockRemindExpiringOrders) Optional() *mExpiryRepositoryMockRemindExpiringOrders {
+	mmRemindExpiringOrders.optional = true
+	return mmRemindExpiringOrders
+}
+
+// Expect sets up expected params for ExpiryRepository.RemindExpiringOrders
+func (mmRemindExpiringOrders *mExpiryRepositoryMockRemindExpiringOrders) Expect(ctx context.Context, now time.Time, before time.Time, limit int) *mExpiryRepositoryMockRemindExpiringOrders {
+	if mmRemindExpiringOrders.mock.funcRemindExpiringOrders != nil {
+		mmRemindExpiringOrders.mock.t.Fatalf("ExpiryRepositoryMock.RemindExpiringOrders mock is already set by Set")
+	}
+
+	if mmRemindExpiringOrders.defaultExpectation == nil {
+		mmRemindExpiringOrders.defaultExpectation = &ExpiryRepositoryMockRemindExpiringOrdersExpectation{}
+	}
+
+	if mmRemindExpiringOrders.defaultExpectation.paramPtrs != nil {
+		mmRemindExpiringOrders.mock.t.Fatalf("ExpiryRepositoryMock.RemindExpiringOrders mock is already set by ExpectParams functions")
+	}
+
+	mmRemindExpiringOrders.defaultExpectation.params = &ExpiryRepositoryMockRemindExpiringOrdersParams{ctx, now, before, limit}
+	mmRemindExpiringOrders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemindExpiringOrders.expectations {
+		if minimock.Equal(e.params, mmRemindExpiringOrders.defaultExpectation.params) {
+			mmRemindExpiringOrders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemindExpiringOrders.defaultExpectation.params)
+		}
+	}
+
+	return mmRemindExpiringOrders
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ExpiryRepository.RemindExpiringOrders
+func (mmRemindExpiringOrders *mExpiryRepositoryMockRemindExpiringOrders) ExpectCtxParam1(ctx context.Context) *mExpiryRepositoryMockRemindExpiringOrders {
+	if mmRemindExpiringOrders.mock.funcRemindExpiringOrders != nil {
+		mmRemindExpiringOrders.mock.t.Fatalf("ExpiryRepositoryMock.RemindExpiringOrders mock is already set by Set")
+	}
+
+	if mmRemindExpiringOrders.defaultExpectation == nil {
+		mmRemindExpiringOrders.defaultExpectation = &ExpiryRepositoryMockRemindExpiringOrdersExpectation{}
+	}
+
+	if mmRemindExpiringOrders.defaultExpectation.params != nil {
+		mmRemindExpiringOrders.mock.t.Fatalf("ExpiryRepositoryMock.RemindExpiringOrders mock is already set by Expect")
+	}
+
+	if mmRemindExpiringOrders.defaultExpectation.paramPtrs == nil {
+		mmRemindExpiringOrders.defaultExpectation.paramPtrs = &ExpiryRepositoryMockRemindExpiringOrdersParamPtrs{}
+	}
+	mmRemindExpiringOrders.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemindExpiringOrders.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemindExpiringOrders
+}
+
+// ExpectNowParam2 sets up expected param now for ExpiryRepository.RemindExpiringOrders
+func (mmRemindExpiringOrders *mExpiryRepositoryMockRemindExpiringOrders) ExpectNowParam2(now time.Time) *mExpiryRepositoryMockRemindExpiringOrders {
+	if mmRemindExpiringOrders.mock.funcRemindExpiringOrders != nil {
+		mmRemindExpiringOrders.mock.t.Fatalf("ExpiryRepositoryMock.RemindExpiringOrders mock is already set by Set")
+	}
+
+	if mmRemindExpiringOrders.defaultExpectation == nil {
+		mmRemindExpiringOrders.defaultExpectation = &ExpiryRepositoryMockRemindExpiringOrdersExpectation{}
+	}
+
+	if mmRemindExpiringOrders.defaultExpectation.params != nil {
+		mmRemindExpiringOrders.mock.t.Fatalf("ExpiryRepositoryMock.RemindExpiringOrders mock is already set by Expect")
+	}
+
+	if mmRemindExpiringOrders.defaultExpectation.paramPtrs == nil {
+		mmRemindExpiringOrders.defaultExpectation.paramPtrs = &ExpiryRepositoryMockRemindExpiringOrdersParamPtrs{}
+	}
+	mmRemindExpiringOrders.defaultExpectation.paramPtrs.now = &now
+	mmRemindExpiringOrders.defaultExpectation.expectationOrigins.originNow = minimock.CallerInfo(1)
+
+	return mmRemindExpiringOrders
+}
+
+// ExpectBeforeParam3 sets up expected param before for ExpiryRepository.RemindExpiringOrders
+func (mmRemindExpiringOrders *mExpiryRepositoryMockRemindExpiringOrders) ExpectBeforeParam3(before time.Time) *mExpiryRepositoryMockRemindExpiringOrders {
+	if mmRemindExpiringOrders.mock.funcRemindExpiringOrders != nil {
+		mmRemindExpiringOrders.mock.t.Fatalf("ExpiryRepositoryMock.RemindExpiringOrders mock is already set by Set")
+	}
+
+	if mmRemindExpiringOrders.defaultExpectation == nil {
+		mmRemindExpiringOrders.defaultExpectation = &ExpiryRepositoryMockRemindExpiringOrdersExpectation{}
+	}
+
+	if mmRemindExpiringOrders.defaultExpectation.params != nil {
+		mmRemindExpiringOrders.mock.t.Fatalf("ExpiryRepositoryMock.RemindExpiringOrders mock is already set by Expect")
+	}
+
+	if mmRemindExpiringOrders.defaultExpectation.paramPtrs == nil {
+		mmRemindExpiringOrders.defaultExpectation.paramPtrs = &ExpiryRepositoryMockRemindExpiringOrdersParamPtrs{}
+	}
+	mmRemindExpiringOrders.defaultExpectation.paramPtrs.before = &before
+	mmRemindExpiringOrders.defaultExpectation.expectationOrigins.originBefore = minimock.CallerInfo(1)
+
+	return mmRemindExpiringOrders
+}
+
+// ExpectLimitParam4 sets up expected param limit for ExpiryRepository.RemindExpiringOrders
+func (mmRemindExpiringOrders *mExpiryRepositoryMockRemindExpiringOrders) ExpectLimitParam4(limit int) *mExpiryRepositoryMockRemindExpiringOrders {
+	if mmRemindExpiringOrders.mock.funcRemindExpiringOrders != nil {
+		mmRemindExpiringOrders.mock.t.Fatalf("ExpiryRepositoryMock.RemindExpiringOrders mock is already set by Set")
+	}
+
+	if mmRemindExpiringOrders.defaultExpectation == nil {
+		mmRemindExpiringOrders.defaultExpectation = &ExpiryRepositoryMockRemindExpiringOrdersExpectation{}
+	}
+
+	if mmRemindExpiringOrders.defaultExpectation.params != nil {
+		mmRemindExpiringOrders.mock.t.Fatalf("ExpiryRepositoryMock.RemindExpiringOrders mock is already set by Expect")
+	}
+
+	if mmRemindExpiringOrders.defaultExpectation.paramPtrs == nil {
+		mmRemindExpiringOrders.defaultExpectation.paramPtrs = &ExpiryRepositoryMockRemindExpiringOrdersParamPtrs{}
+	}
+	mmRemindExpiringOrders.defaultExpectation.paramPtrs.limit = &limit
+	mmRemindExpiringOrders.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmRemindExpiringOrders
+}
+
+// Inspect accepts an inspector function that has same arguments as the ExpiryRepository.RemindExpiringOrders
+func (mmRemindExpiringOrders *mExpiryRepositoryMockRemindExpiringOrders) Inspect(f func(ctx context.Context, now time.Time, before time.Time, limit int)) *mExpiryRepositoryMockRemindExpiringOrders {
+	if mmRemindExpiringOrders.mock.inspectFuncRemindExpiringOrders != nil {
+		mmRemindExpiringOrders.mock.t.Fatalf("Inspect function is already set for ExpiryRepositoryMock.RemindExpiringOrders")
+	}
+
+	mmRemindExpiringOrders.mock.inspectFuncRemindExpiringOrders = f
+
+	return mmRemindExpiringOrders
+}
+
+// Return sets up results that will be returned by ExpiryRepository.RemindExpiringOrders
+func (mmRemindExpiringOrders *mExpiryRepositoryMockRemindExpiringOrders) Return(pa1 []domain.PVZOrder, err error) *ExpiryRepositoryMock {
+	if mmRemindExpiringOrders.mock.funcRemindExpiringOrders != nil {
+		mmRemindExpiringOrders.mock.t.Fatalf("ExpiryRepositoryMock.RemindExpiringOrders mock is already set by Set")
+	}
+
+	if mmRemindExpiringOrders.defaultExpectation == nil {
+		mmRemindExpiringOrders.defaultExpectation = &ExpiryRepositoryMockRemindExpiringOrdersExpectation{mock: mmRemindExpiringOrders.mock}
+	}
+	mmRemindExpiringOrders.defaultExpectation.results = &ExpiryRepositoryMockRemindExpiringOrdersResults{pa1, err}
+	mmRemindExpiringOrders.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemindExpiringOrders.mock
+}
+
+// Set uses given function f to mock the ExpiryRepository.RemindExpiringOrders method
+func (mmRemindExpiringOrders *mExpiryRepositoryMockRemindExpiringOrders) Set(f func(ctx context.Context, now time.Time, before time.Time, limit int) (pa1 []domain.PVZOrder, err error)) *ExpiryRepositoryMock {
+	if mmRemindExpiringOrders.defaultExpectation != nil {
+		mmRemindExpiringOrders.mock.t.Fatalf("Default expectation is already set for the ExpiryRepository.RemindExpiringOrders method")
+	}
+
+	if len(mmRemindExpiringOrders.expectations) > 0 {
+		mmRemindExpiringOrders.mock.t.Fatalf("Some expectations are already set for the ExpiryRepository.RemindExpiringOrders method")
+	}
+
+	mmRemindExpiringOrders.mock.funcRemindExpiringOrders = f
+	mmRemindExpiringOrders.mock.funcRemindExpiringOrdersOrigin = minimock.CallerInfo(1)
+	return mmRemindExpiringOrders.mock
+}
+
+// When sets expectation for the ExpiryRepository.RemindExpiringOrders which will trigger the result defined by the following
+// Then helper
+func (mmRemindExpiringOrders *mExpiryRepositoryMockRemindExpiringOrders) When(ctx context.Context, now time.Time, before time.Time, limit int) *ExpiryRepositoryMockRemindExpiringOrdersExpectation {
+	if mmRemindExpiringOrders.mock.funcRemindExpiringOrders != nil {
+		mmRemindExpiringOrders.mock.t.Fatalf("ExpiryRepositoryMock.RemindExpiringOrders mock is already set by Set")
+	}
+
+	expectation := &ExpiryRepositoryMockRemindExpiringOrdersExpectation{
+		mock:               mmRemindExpiringOrders.mock,
+		params:             &ExpiryRepositoryMockRemindExpiringOrdersParams{ctx, now, before, limit},
+		expectationOrigins: ExpiryRepositoryMockRemindExpiringOrdersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemindExpiringOrders.expectations = append(mmRemindExpiringOrders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ExpiryRepository.RemindExpiringOrders return parameters for the expectation previously defined by the When method
+func (e *ExpiryRepositoryMockRemindExpiringOrdersExpectation) Then(pa1 []domain.PVZOrder, err error) *ExpiryRepositoryMock {
+	e.results = &ExpiryRepositoryMockRemindExpiringOrdersResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times ExpiryRepository.RemindExpiringOrders should be invoked
+func (mmRemindExpiringOrders *mExpiryRepositoryMockRemindExpiringOrders) Times(n uint64) *mExpiryRepositoryMockRemindExpiringOrders {
+	if n == 0 {
+		mmRemindExpiringOrders.mock.t.Fatalf("Times of ExpiryRepositoryMock.RemindExpiringOrders mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemindExpiringOrders.expectedInvocations, n)
+	mmRemindExpiringOrders.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemindExpiringOrders
+}
+
+func (mmRemindExpiringOrders *mExpiryRepositoryMockRemindExpiringOrders) invocationsDone() bool {
+	if len(mmRemindExpiringOrders.expectations) == 0 && mmRemindExpiringOrders.defaultExpectation == nil && mmRemindExpiringOrders.mock.funcRemindExpiringOrders == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemindExpiringOrders.mock.afterRemindExpiringOrdersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemindExpiringOrders.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemindExpiringOrders implements mm_usecases.ExpiryRepository
+func (mmRemindExpiringOrders *ExpiryRepositoryMock) RemindExpiringOrders(ctx context.Context, now time.Time, before time.Time, limit int) (pa1 []domain.PVZOrder, err error) {
+	mm_atomic.AddUint64(&mmRemindExpiringOrders.beforeRemindExpiringOrdersCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemindExpiringOrders.afterRemindExpiringOrdersCounter, 1)
+
+	mmRemindExpiringOrders.t.Helper()
+
+	if mmRemindExpiringOrders.inspectFuncRemindExpiringOrders != nil {
+		mmRemindExpiringOrders.inspectFuncRemindExpiringOrders(ctx, now, before, limit)
+	}
+
+	mm_params := ExpiryRepositoryMockRemindExpiringOrdersParams{ctx, now, before, limit}
+
+	// Record call args
+	mmRemindExpiringOrders.RemindExpiringOrdersMock.mutex.Lock()
+	mmRemindExpiringOrders.RemindExpiringOrdersMock.callArgs = append(mmRemindExpiringOrders.RemindExpiringOrdersMock.callArgs, &mm_params)
+	mmRemindExpiringOrders.RemindExpiringOrdersMock.mutex.Unlock()
+
+	for _, e := range mmRemindExpiringOrders.RemindExpiringOrdersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmRemindExpiringOrders.RemindExpiringOrdersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemindExpiringOrders.RemindExpiringOrdersMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemindExpiringOrders.RemindExpiringOrdersMock.defaultExpectation.params
+		mm_want_ptrs := mmRemindExpiringOrders.RemindExpiringOrdersMock.defaultExpectation.paramPtrs
+
+		mm_got := ExpiryRepositoryMockRemindExpiringOrdersParams{ctx, now, before, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemindExpiringOrders.t.Errorf("ExpiryRepositoryMock.RemindExpiringOrders got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemindExpiringOrders.RemindExpiringOrdersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.now != nil && !minimock.Equal(*mm_want_ptrs.now, mm_got.now) {
+				mmRemindExpiringOrders.t.Errorf("ExpiryRepositoryMock.RemindExpiringOrders got unexpected parameter now, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemindExpiringOrders.RemindExpiringOrdersMock.defaultExpectation.expectationOrigins.originNow, *mm_want_ptrs.now, mm_got.now, minimock.Diff(*mm_want_ptrs.now, mm_got.now))
+			}
+
+			if mm_want_ptrs.before != nil && !minimock.Equal(*mm_want_ptrs.before, mm_got.before) {
+				mmRemindExpiringOrders.t.Errorf("ExpiryRepositoryMock.RemindExpiringOrders got unexpected parameter before, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemindExpiringOrders.RemindExpiringOrdersMock.defaultExpectation.expectationOrigins.originBefore, *mm_want_ptrs.before, mm_got.before, minimock.Diff(*mm_want_ptrs.before, mm_got.before))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmRemindExpiringOrders.t.Errorf("ExpiryRepositoryMock.RemindExpiringOrders got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemindExpiringOrders.RemindExpiringOrdersMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemindExpiringOrders.t.Errorf("ExpiryRepositoryMock.RemindExpiringOrders got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemindExpiringOrders.RemindExpiringOrdersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemindExpiringOrders.RemindExpiringOrdersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemindExpiringOrders.t.Fatal("No results are set for the ExpiryRepositoryMock.RemindExpiringOrders")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmRemindExpiringOrders.funcRemindExpiringOrders != nil {
+		return mmRemindExpiringOrders.funcRemindExpiringOrders(ctx, now, before, limit)
+	}
+	mmRemindExpiringOrders.t.Fatalf("Unexpected call to ExpiryRepositoryMock.RemindExpiringOrders. %v %v %v %v", ctx, now, before, limit)
+	return
+}
+
+// RemindExpiringOrdersAfterCounter returns a count of finished ExpiryRepositoryMock.RemindExpiringOrders invocations
+func (mmRemindExpiringOrders *ExpiryRepositoryMock) RemindExpiringOrdersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemindExpiringOrders.afterRemindExpiringOrdersCounter)
+}
+
+// RemindExpiringOrdersBeforeCounter returns a count of ExpiryRepositoryMock.RemindExpiringOrders invocations
+func (mmRemindExpiringOrders *ExpiryRepositoryMock) RemindExpiringOrdersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemindExpiringOrders.beforeRemindExpiringOrdersCounter)
+}
+
+// Calls returns a list of arguments used in each call to ExpiryRepositoryMock.RemindExpiringOrders.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemindExpiringOrders *mExpiryRepositoryMockRemindExpiringOrders) Calls() []*ExpiryRepositoryMockRemindExpiringOrdersParams {
+	mmRemindExpiringOrders.mutex.RLock()
+
+	argCopy := make([]*ExpiryRepositoryMockRemindExpiringOrdersParams, len(mmRemindExpiringOrders.callArgs))
+	copy(argCopy, mmRemindExpiringOrders.callArgs)
+
+	mmRemindExpiringOrders.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemindExpiringOrdersDone returns true if the count of the RemindExpiringOrders invocations corresponds
+// the number of defined expectations
+func (m *ExpiryRepositoryMock) MinimockRemindExpiringOrdersDone() bool {
+	if m.RemindExpiringOrdersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemindExpiringOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemindExpiringOrdersMock.invocationsDone()
+}
+
+// MinimockRemindExpiringOrdersInspect logs each unmet expectation
+func (m *ExpiryRepositoryMock) MinimockRemindExpiringOrdersInspect() {
+	for _, e := range m.RemindExpiringOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ExpiryRepositoryMock.RemindExpiringOrders at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemindExpiringOrdersCounter := mm_atomic.LoadUint64(&m.afterRemindExpiringOrdersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemindExpiringOrdersMock.defaultExpectation != nil && afterRemindExpiringOrdersCounter < 1 {
+		if m.RemindExpiringOrdersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ExpiryRepositoryMock.RemindExpiringOrders at\n%s", m.RemindExpiringOrdersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ExpiryRepositoryMock.RemindExpiringOrders at\n%s with params: %#v", m.RemindExpiringOrdersMock.defaultExpectation.expectationOrigins.origin, *m.RemindExpiringOrdersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemindExpiringOrders != nil && afterRemindExpiringOrdersCounter < 1 {
+		m.t.Errorf("Expected call to ExpiryRepositoryMock.RemindExpiringOrders at\n%s", m.funcRemindExpiringOrdersOrigin)
+	}
+
+	if !m.RemindExpiringOrdersMock.invocationsDone() && afterRemindExpiringOrdersCounter > 0 {
+		m.t.Errorf("Expected %d calls to ExpiryRepositoryMock.RemindExpiringOrders at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemindExpiringOrdersMock.expectedInvocations), m.RemindExpiringOrdersMock.expectedInvocationsOrigin, afterRemindExpiringOrdersCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ExpiryRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockExpireOrdersInspect()
+
+			m.MinimockRemindExpiringOrdersInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ExpiryRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ExpiryRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockExpireOrdersDone() &&
+		m.MinimockRemindExpiringOrdersDone()
+}
@@ -0,0 +1,1837 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"homework/internal/domain"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// HandoverRepositoryMock implements mm_usecases.HandoverRepository
+type HandoverRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddScan          func(ctx context.Context, scan domain.HandoverScan) (err error)
+	funcAddScanOrigin    string
+	inspectFuncAddScan   func(ctx context.Context, scan domain.HandoverScan)
+	afterAddScanCounter  uint64
+	beforeAddScanCounter uint64
+	AddScanMock          mHandoverRepositoryMockAddScan
+
+	funcCloseSession          func(ctx context.Context, report domain.HandoverReport) (err error)
+	funcCloseSessionOrigin    string
+	inspectFuncCloseSession   func(ctx context.Context, report domain.HandoverReport)
+	afterCloseSessionCounter  uint64
+	beforeCloseSessionCounter uint64
+	CloseSessionMock          mHandoverRepositoryMockCloseSession
+
+	funcCreateSession          func(ctx context.Context, session domain.HandoverSession) (err error)
+	funcCreateSessionOrigin    string
+	inspectFuncCreateSession   func(ctx context.Context, session domain.HandoverSession)
+	afterCreateSessionCounter  uint64
+	beforeCreateSessionCounter uint64
+	CreateSessionMock          mHandoverRepositoryMockCreateSession
+
+	funcGetSession          func(ctx context.Context, sessionID string) (h1 domain.HandoverSession, err error)
+	funcGetSessionOrigin    string
+	inspectFuncGetSession   func(ctx context.Context, sessionID string)
+	afterGetSessionCounter  uint64
+	beforeGetSessionCounter uint64
+	GetSessionMock          mHandoverRepositoryMockGetSession
+
+	funcLockSession          func(ctx context.Context, sessionID string) (h1 domain.HandoverSession, err error)
+	funcLockSessionOrigin    string
+	inspectFuncLockSession   func(ctx context.Context, sessionID string)
+	afterLockSessionCounter  uint64
+	beforeLockSessionCounter uint64
+	LockSessionMock          mHandoverRepositoryMockLockSession
+}
+
+// NewHandoverRepositoryMock returns a mock for mm_usecases.HandoverRepository
+func NewHandoverRepositoryMock(t minimock.Tester) *HandoverRepositoryMock {
+	m := &HandoverRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddScanMock = mHandoverRepositoryMockAddScan{mock: m}
+	m.AddScanMock.callArgs = []*HandoverRepositoryMockAddScanParams{}
+
+	m.CloseSessionMock = mHandoverRepositoryMockCloseSession{mock: m}
+	m.CloseSessionMock.callArgs = []*HandoverRepositoryMockCloseSessionParams{}
+
+	m.CreateSessionMock = mHandoverRepositoryMockCreateSession{mock: m}
+	m.CreateSessionMock.callArgs = []*HandoverRepositoryMockCreateSessionParams{}
+
+	m.GetSessionMock = mHandoverRepositoryMockGetSession{mock: m}
+	m.GetSessionMock.callArgs = []*HandoverRepositoryMockGetSessionParams{}
+
+	m.LockSessionMock = mHandoverRepositoryMockLockSession{mock: m}
+	m.LockSessionMock.callArgs = []*HandoverRepositoryMockLockSessionParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mHandoverRepositoryMockAddScan struct {
+	optional           bool
+	mock               *HandoverRepositoryMock
+	defaultExpectation *HandoverRepositoryMockAddScanExpectation
+	expectations       []*HandoverRepositoryMockAddScanExpectation
+
+	callArgs []*HandoverRepositoryMockAddScanParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// HandoverRepositoryMockAddScanExpectation specifies expectation struct of the HandoverRepository.AddScan
+type HandoverRepositoryMockAddScanExpectation struct {
+	mock               *HandoverRepositoryMock
+	params             *HandoverRepositoryMockAddScanParams
+	paramPtrs          *HandoverRepositoryMockAddScanParamPtrs
+	expectationOrigins HandoverRepositoryMockAddScanExpectationOrigins
+	results            *HandoverRepositoryMockAddScanResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// HandoverRepositoryMockAddScanParams contains parameters of the HandoverRepository.AddScan
+type HandoverRepositoryMockAddScanParams struct {
+	ctx  context.Context
+	scan domain.HandoverScan
+}
+
+// HandoverRepositoryMockAddScanParamPtrs contains pointers to parameters of the HandoverRepository.AddScan
+type HandoverRepositoryMockAddScanParamPtrs struct {
+	ctx  *context.Context
+	scan *domain.HandoverScan
+}
+
+// HandoverRepositoryMockAddScanResults contains results of the HandoverRepository.AddScan
+type HandoverRepositoryMockAddScanResults struct {
+	err error
+}
+
+// HandoverRepositoryMockAddScanOrigins contains origins of expectations of the HandoverRepository.AddScan
+type HandoverRepositoryMockAddScanExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originScan string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddScan *mHandoverRepositoryMockAddScan) Optional() *mHandoverRepositoryMockAddScan {
+	mmAddScan.optional = true
+	return mmAddScan
+}
+
+// Expect sets up expected params for HandoverRepository.AddScan
+func (mmAddScan *mHandoverRepositoryMockAddScan) Expect(ctx context.Context, scan domain.HandoverScan) *mHandoverRepositoryMockAddScan {
+	if mmAddScan.mock.funcAddScan != nil {
+		mmAddScan.mock.t.Fatalf("HandoverRepositoryMock.AddScan mock is already set by Set")
+	}
+
+	if mmAddScan.defaultExpectation == nil {
+		mmAddScan.defaultExpectation = &HandoverRepositoryMockAddScanExpectation{}
+	}
+
+	if mmAddScan.defaultExpectation.paramPtrs != nil {
+		mmAddScan.mock.t.Fatalf("HandoverRepositoryMock.AddScan mock is already set by ExpectParams functions")
+	}
+
+	mmAddScan.defaultExpectation.params = &HandoverRepositoryMockAddScanParams{ctx, scan}
+	mmAddScan.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddScan.expectations {
+		if minimock.Equal(e.params, mmAddScan.defaultExpectation.params) {
+			mmAddScan.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddScan.defaultExpectation.params)
+		}
+	}
+
+	return mmAddScan
+}
+
+// ExpectCtxParam1 sets up expected param ctx for HandoverRepository.AddScan
+func (mmAddScan *mHandoverRepositoryMockAddScan) ExpectCtxParam1(ctx context.Context) *mHandoverRepositoryMockAddScan {
+	if mmAddScan.mock.funcAddScan != nil {
+		mmAddScan.mock.t.Fatalf("HandoverRepositoryMock.AddScan mock is already set by Set")
+	}
+
+	if mmAddScan.defaultExpectation == nil {
+		mmAddScan.defaultExpectation = &HandoverRepositoryMockAddScanExpectation{}
+	}
+
+	if mmAddScan.defaultExpectation.params != nil {
+		mmAddScan.mock.t.Fatalf("HandoverRepositoryMock.AddScan mock is already set by Expect")
+	}
+
+	if mmAddScan.defaultExpectation.paramPtrs == nil {
+		mmAddScan.defaultExpectation.paramPtrs = &HandoverRepositoryMockAddScanParamPtrs{}
+	}
+	mmAddScan.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddScan.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddScan
+}
+
+// ExpectScanParam2 sets up expected param scan for HandoverRepository.AddScan
+func (mmAddScan *mHandoverRepositoryMockAddScan) ExpectScanParam2(scan domain.HandoverScan) *mHandoverRepositoryMockAddScan {
+	if mmAddScan.mock.funcAddScan != nil {
+		mmAddScan.mock.t.Fatalf("HandoverRepositoryMock.AddScan mock is already set by Set")
+	}
+
+	if mmAddScan.defaultExpectation == nil {
+		mmAddScan.defaultExpectation = &HandoverRepositoryMockAddScanExpectation{}
+	}
+
+	if mmAddScan.defaultExpectation.params != nil {
+		mmAddScan.mock.t.Fatalf("HandoverRepositoryMock.AddScan mock is already set by Expect")
+	}
+
+	if mmAddScan.defaultExpectation.paramPtrs == nil {
+		mmAddScan.defaultExpectation.paramPtrs = &HandoverRepositoryMockAddScanParamPtrs{}
+	}
+	mmAddScan.defaultExpectation.paramPtrs.scan = &scan
+	mmAddScan.defaultExpectation.expectationOrigins.originScan = minimock.CallerInfo(1)
+
+	return mmAddScan
+}
+
+// Inspect accepts an inspector function that has same arguments as the HandoverRepository.AddScan
+func (mmAddScan *mHandoverRepositoryMockAddScan) Inspect(f func(ctx context.Context, scan domain.HandoverScan)) *mHandoverRepositoryMockAddScan {
+	if mmAddScan.mock.inspectFuncAddScan != nil {
+		mmAddScan.mock.t.Fatalf("Inspect function is already set for HandoverRepositoryMock.AddScan")
+	}
+
+	mmAddScan.mock.inspectFuncAddScan = f
+
+	return mmAddScan
+}
+
+// Return sets up results that will be returned by HandoverRepository.AddScan
+func (mmAddScan *mHandoverRepositoryMockAddScan) Return(err error) *HandoverRepositoryMock {
+	if mmAddScan.mock.funcAddScan != nil {
+		mmAddScan.mock.t.Fatalf("HandoverRepositoryMock.AddScan mock is already set by Set")
+	}
+
+	if mmAddScan.defaultExpectation == nil {
+		mmAddScan.defaultExpectation = &HandoverRepositoryMockAddScanExpectation{mock: mmAddScan.mock}
+	}
+	mmAddScan.defaultExpectation.results = &HandoverRepositoryMockAddScanResults{err}
+	mmAddScan.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddScan.mock
+}
+
+// Set uses given function f to mock the HandoverRepository.AddScan method
+func (mmAddScan *mHandoverRepositoryMockAddScan) Set(f func(ctx context.Context, scan domain.HandoverScan) (err error)) *HandoverRepositoryMock {
+	if mmAddScan.defaultExpectation != nil {
+		mmAddScan.mock.t.Fatalf("Default expectation is already set for the HandoverRepository.AddScan method")
+	}
+
+	if len(mmAddScan.expectations) > 0 {
+		mmAddScan.mock.t.Fatalf("Some expectations are already set for the HandoverRepository.AddScan method")
+	}
+
+	mmAddScan.mock.funcAddScan = f
+	mmAddScan.mock.funcAddScanOrigin = minimock.CallerInfo(1)
+	return mmAddScan.mock
+}
+
+// When sets expectation for the HandoverRepository.AddScan which will trigger the result defined by the following
+// Then helper
+func (mmAddScan *mHandoverRepositoryMockAddScan) When(ctx context.Context, scan domain.HandoverScan) *HandoverRepositoryMockAddScanExpectation {
+	if mmAddScan.mock.funcAddScan != nil {
+		mmAddScan.mock.t.Fatalf("HandoverRepositoryMock.AddScan mock is already set by Set")
+	}
+
+	expectation := &HandoverRepositoryMockAddScanExpectation{
+		mock:               mmAddScan.mock,
+		params:             &HandoverRepositoryMockAddScanParams{ctx, scan},
+		expectationOrigins: HandoverRepositoryMockAddScanExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddScan.expectations = append(mmAddScan.expectations, expectation)
+	return expectation
+}
+
+// Then sets up HandoverRepository.AddScan return parameters for the expectation previously defined by the When method
+func (e *HandoverRepositoryMockAddScanExpectation) Then(err error) *HandoverRepositoryMock {
+	e.results = &HandoverRepositoryMockAddScanResults{err}
+	return e.mock
+}
+
+// Times sets number of times HandoverRepository.AddScan should be invoked
+func (mmAddScan *mHandoverRepositoryMockAddScan) Times(n uint64) *mHandoverRepositoryMockAddScan {
+	if n == 0 {
+		mmAddScan.mock.t.Fatalf("Times of HandoverRepositoryMock.AddScan mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddScan.expectedInvocations, n)
+	mmAddScan.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddScan
+}
+
+func (mmAddScan *mHandoverRepositoryMockAddScan) invocationsDone() bool {
+	if len(mmAddScan.expectations) == 0 && mmAddScan.defaultExpectation == nil && mmAddScan.mock.funcAddScan == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddScan.mock.afterAddScanCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddScan.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddScan implements mm_usecases.HandoverRepository
+func (mmAddScan *HandoverRepositoryMock) AddScan(ctx context.Context, scan domain.HandoverScan) (err error) {
+	mm_atomic.AddUint64(&mmAddScan.beforeAddScanCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddScan.afterAddScanCounter, 1)
+
+	mmAddScan.t.Helper()
+
+	if mmAddScan.inspectFuncAddScan != nil {
+		mmAddScan.inspectFuncAddScan(ctx, scan)
+	}
+
+	mm_params := HandoverRepositoryMockAddScanParams{ctx, scan}
+
+	// Record call args
+	mmAddScan.AddScanMock.mutex.Lock()
+	mmAddScan.AddScanMock.callArgs = append(mmAddScan.AddScanMock.callArgs, &mm_params)
+	mmAddScan.AddScanMock.mutex.Unlock()
+
+	for _, e := range mmAddScan.AddScanMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddScan.AddScanMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddScan.AddScanMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddScan.AddScanMock.defaultExpectation.params
+		mm_want_ptrs := mmAddScan.AddScanMock.defaultExpectation.paramPtrs
+
+		mm_got := HandoverRepositoryMockAddScanParams{ctx, scan}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddScan.t.Errorf("HandoverRepositoryMock.AddScan got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddScan.AddScanMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.scan != nil && !minimock.Equal(*mm_want_ptrs.scan, mm_got.scan) {
+				mmAddScan.t.Errorf("HandoverRepositoryMock.AddScan got unexpected parameter scan, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddScan.AddScanMock.defaultExpectation.expectationOrigins.originScan, *mm_want_ptrs.scan, mm_got.scan, minimock.Diff(*mm_want_ptrs.scan, mm_got.scan))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddScan.t.Errorf("HandoverRepositoryMock.AddScan got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddScan.AddScanMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddScan.AddScanMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddScan.t.Fatal("No results are set for the HandoverRepositoryMock.AddScan")
+		}
+		return (*mm_results).err
+	}
+	if mmAddScan.funcAddScan != nil {
+		return mmAddScan.funcAddScan(ctx, scan)
+	}
+	mmAddScan.t.Fatalf("Unexpected call to HandoverRepositoryMock.AddScan. %v %v", ctx, scan)
+	return
+}
+
+// AddScanAfterCounter returns a count of finished HandoverRepositoryMock.AddScan invocations
+func (mmAddScan *HandoverRepositoryMock) AddScanAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddScan.afterAddScanCounter)
+}
+
+// AddScanBeforeCounter returns a count of HandoverRepositoryMock.AddScan invocations
+func (mmAddScan *HandoverRepositoryMock) AddScanBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddScan.beforeAddScanCounter)
+}
+
+// Calls returns a list of arguments used in each call to HandoverRepositoryMock.AddScan.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddScan *mHandoverRepositoryMockAddScan) Calls() []*HandoverRepositoryMockAddScanParams {
+	mmAddScan.mutex.RLock()
+
+	argCopy := make([]*HandoverRepositoryMockAddScanParams, len(mmAddScan.callArgs))
+	copy(argCopy, mmAddScan.callArgs)
+
+	mmAddScan.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddScanDone returns true if the count of the AddScan invocations corresponds
+// the number of defined expectations
+func (m *HandoverRepositoryMock) MinimockAddScanDone() bool {
+	if m.AddScanMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddScanMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddScanMock.invocationsDone()
+}
+
+// MinimockAddScanInspect logs each unmet expectation
+func (m *HandoverRepositoryMock) MinimockAddScanInspect() {
+	for _, e := range m.AddScanMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to HandoverRepositoryMock.AddScan at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddScanCounter := mm_atomic.LoadUint64(&m.afterAddScanCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddScanMock.defaultExpectation != nil && afterAddScanCounter < 1 {
+		if m.AddScanMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to HandoverRepositoryMock.AddScan at\n%s", m.AddScanMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to HandoverRepositoryMock.AddScan at\n%s with params: %#v", m.AddScanMock.defaultExpectation.expectationOrigins.origin, *m.AddScanMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddScan != nil && afterAddScanCounter < 1 {
+		m.t.Errorf("Expected call to HandoverRepositoryMock.AddScan at\n%s", m.funcAddScanOrigin)
+	}
+
+	if !m.AddScanMock.invocationsDone() && afterAddScanCounter > 0 {
+		m.t.Errorf("Expected %d calls to HandoverRepositoryMock.AddScan at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddScanMock.expectedInvocations), m.AddScanMock.expectedInvocationsOrigin, afterAddScanCounter)
+	}
+}
+
+type mHandoverRepositoryMockCloseSession struct {
+	optional           bool
+	mock               *HandoverRepositoryMock
+	defaultExpectation *HandoverRepositoryMockCloseSessionExpectation
+	expectations       []*HandoverRepositoryMockCloseSessionExpectation
+
+	callArgs []*HandoverRepositoryMockCloseSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// HandoverRepositoryMockCloseSessionExpectation specifies expectation struct of the HandoverRepository.CloseSession
+type HandoverRepositoryMockCloseSessionExpectation struct {
+	mock               *HandoverRepositoryMock
+	params             *HandoverRepositoryMockCloseSessionParams
+	paramPtrs          *HandoverRepositoryMockCloseSessionParamPtrs
+	expectationOrigins HandoverRepositoryMockCloseSessionExpectationOrigins
+	results            *HandoverRepositoryMockCloseSessionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// HandoverRepositoryMockCloseSessionParams contains parameters of the HandoverRepository.CloseSession
+type HandoverRepositoryMockCloseSessionParams struct {
+	ctx    context.Context
+	report domain.HandoverReport
+}
+
+// HandoverRepositoryMockCloseSessionParamPtrs contains pointers to parameters of the HandoverRepository.CloseSession
+type HandoverRepositoryMockCloseSessionParamPtrs struct {
+	ctx    *context.Context
+	report *domain.HandoverReport
+}
+
+// HandoverRepositoryMockCloseSessionResults contains results of the HandoverRepository.CloseSession
+type HandoverRepositoryMockCloseSessionResults struct {
+	err error
+}
+
+// HandoverRepositoryMockCloseSessionOrigins contains origins of expectations of the HandoverRepository.CloseSession
+type HandoverRepositoryMockCloseSessionExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originReport string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCloseSession *mHandoverRepositoryMockCloseSession) Optional() *mHandoverRepositoryMockCloseSession {
+	mmCloseSession.optional = true
+	return mmCloseSession
+}
+
+// Expect sets up expected params for HandoverRepository.CloseSession
+func (mmCloseSession *mHandoverRepositoryMockCloseSession) Expect(ctx context.Context, report domain.HandoverReport) *mHandoverRepositoryMockCloseSession {
+	if mmCloseSession.mock.funcCloseSession != nil {
+		mmCloseSession.mock.t.Fatalf("HandoverRepositoryMock.CloseSession mock is already set by Set")
+	}
+
+	if mmCloseSession.defaultExpectation == nil {
+		mmCloseSession.defaultExpectation = &HandoverRepositoryMockCloseSessionExpectation{}
+	}
+
+	if mmCloseSession.defaultExpectation.paramPtrs != nil {
+		mmCloseSession.mock.t.Fatalf("HandoverRepositoryMock.CloseSession mock is already set by ExpectParams functions")
+	}
+
+	mmCloseSession.defaultExpectation.params = &HandoverRepositoryMockCloseSessionParams{ctx, report}
+	mmCloseSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCloseSession.expectations {
+		if minimock.Equal(e.params, mmCloseSession.defaultExpectation.params) {
+			mmCloseSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCloseSession.defaultExpectation.params)
+		}
+	}
+
+	return mmCloseSession
+}
+
+// ExpectCtxParam1 sets up expected param ctx for HandoverRepository.CloseSession
+func (mmCloseSession *mHandoverRepositoryMockCloseSession) ExpectCtxParam1(ctx context.Context) *mHandoverRepositoryMockCloseSession {
+	if mmCloseSession.mock.funcCloseSession != nil {
+		mmCloseSession.mock.t.Fatalf("HandoverRepositoryMock.CloseSession mock is already set by Set")
+	}
+
+	if mmCloseSession.defaultExpectation == nil {
+		mmCloseSession.defaultExpectation = &HandoverRepositoryMockCloseSessionExpectation{}
+	}
+
+	if mmCloseSession.defaultExpectation.params != nil {
+		mmCloseSession.mock.t.Fatalf("HandoverRepositoryMock.CloseSession mock is already set by Expect")
+	}
+
+	if mmCloseSession.defaultExpectation.paramPtrs == nil {
+		mmCloseSession.defaultExpectation.paramPtrs = &HandoverRepositoryMockCloseSessionParamPtrs{}
+	}
+	mmCloseSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCloseSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCloseSession
+}
+
+// ExpectReportParam2 sets up expected param report for HandoverRepository.CloseSession
+func (mmCloseSession *mHandoverRepositoryMockCloseSession) ExpectReportParam2(report domain.HandoverReport) *mHandoverRepositoryMockCloseSession {
+	if mmCloseSession.mock.funcCloseSession != nil {
+		mmCloseSession.mock.t.Fatalf("HandoverRepositoryMock.CloseSession mock is already set by Set")
+	}
+
+	if mmCloseSession.defaultExpectation == nil {
+		mmCloseSession.defaultExpectation = &HandoverRepositoryMockCloseSessionExpectation{}
+	}
+
+	if mmCloseSession.defaultExpectation.params != nil {
+		mmCloseSession.mock.t.Fatalf("HandoverRepositoryMock.CloseSession mock is already set by Expect")
+	}
+
+	if mmCloseSession.defaultExpectation.paramPtrs == nil {
+		mmCloseSession.defaultExpectation.paramPtrs = &HandoverRepositoryMockCloseSessionParamPtrs{}
+	}
+	mmCloseSession.defaultExpectation.paramPtrs.report = &report
+	mmCloseSession.defaultExpectation.expectationOrigins.originReport = minimock.CallerInfo(1)
+
+	return mmCloseSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the HandoverRepository.CloseSession
+func (mmCloseSession *mHandoverRepositoryMockCloseSession) Inspect(f func(ctx context.Context, report domain.HandoverReport)) *mHandoverRepositoryMockCloseSession {
+	if mmCloseSession.mock.inspectFuncCloseSession != nil {
+		mmCloseSession.mock.t.Fatalf("Inspect function is already set for HandoverRepositoryMock.CloseSession")
+	}
+
+	mmCloseSession.mock.inspectFuncCloseSession = f
+
+	return mmCloseSession
+}
+
+// Return sets up results that will be returned by HandoverRepository.CloseSession
+func (mmCloseSession *mHandoverRepositoryMockCloseSession) Return(err error) *HandoverRepositoryMock {
+	if mmCloseSession.mock.funcCloseSession != nil {
+		mmCloseSession.mock.t.Fatalf("HandoverRepositoryMock.CloseSession mock is already set by Set")
+	}
+
+	if mmCloseSession.defaultExpectation == nil {
+		mmCloseSession.defaultExpectation = &HandoverRepositoryMockCloseSessionExpectation{mock: mmCloseSession.mock}
+	}
+	mmCloseSession.defaultExpectation.results = &HandoverRepositoryMockCloseSessionResults{err}
+	mmCloseSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCloseSession.mock
+}
+
+// Set uses given function f to mock the HandoverRepository.CloseSession method
+func (mmCloseSession *mHandoverRepositoryMockCloseSession) Set(f func(ctx context.Context, report domain.HandoverReport) (err error)) *HandoverRepositoryMock {
+	if mmCloseSession.defaultExpectation != nil {
+		mmCloseSession.mock.t.Fatalf("Default expectation is already set for the HandoverRepository.CloseSession method")
+	}
+
+	if len(mmCloseSession.expectations) > 0 {
+		mmCloseSession.mock.t.Fatalf("Some expectations are already set for the HandoverRepository.CloseSession method")
+	}
+
+	mmCloseSession.mock.funcCloseSession = f
+	mmCloseSession.mock.funcCloseSessionOrigin = minimock.CallerInfo(1)
+	return mmCloseSession.mock
+}
+
+// When sets expectation for the HandoverRepository.CloseSession which will trigger the result defined by the following
+// Then helper
+func (mmCloseSession *mHandoverRepositoryMockCloseSession) When(ctx context.Context, report domain.HandoverReport) *HandoverRepositoryMockCloseSessionExpectation {
+	if mmCloseSession.mock.funcCloseSession != nil {
+		mmCloseSession.mock.t.Fatalf("HandoverRepositoryMock.CloseSession mock is already set by Set")
+	}
+
+	expectation := &HandoverRepositoryMockCloseSessionExpectation{
+		mock:               mmCloseSession.mock,
+		params:             &HandoverRepositoryMockCloseSessionParams{ctx, report},
+		expectationOrigins: HandoverRepositoryMockCloseSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCloseSession.expectations = append(mmCloseSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up HandoverRepository.CloseSession return parameters for the expectation previously defined by the When method
+func (e *HandoverRepositoryMockCloseSessionExpectation) Then(err error) *HandoverRepositoryMock {
+	e.results = &HandoverRepositoryMockCloseSessionResults{err}
+	return e.mock
+}
+
+// Times sets number of times HandoverRepository.CloseSession should be invoked
+func (mmCloseSession *mHandoverRepositoryMockCloseSession) Times(n uint64) *mHandoverRepositoryMockCloseSession {
+	if n == 0 {
+		mmCloseSession.mock.t.Fatalf("Times of HandoverRepositoryMock.CloseSession mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCloseSession.expectedInvocations, n)
+	mmCloseSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCloseSession
+}
+
+func (mmCloseSession *mHandoverRepositoryMockCloseSession) invocationsDone() bool {
+	if len(mmCloseSession.expectations) == 0 && mmCloseSession.defaultExpectation == nil && mmCloseSession.mock.funcCloseSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCloseSession.mock.afterCloseSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCloseSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CloseSession implements mm_usecases.HandoverRepository
+func (mmCloseSession *HandoverRepositoryMock) CloseSession(ctx context.Context, report domain.HandoverReport) (err error) {
+	mm_atomic.AddUint64(&mmCloseSession.beforeCloseSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmCloseSession.afterCloseSessionCounter, 1)
+
+	mmCloseSession.t.Helper()
+
+	if mmCloseSession.inspectFuncCloseSession != nil {
+		mmCloseSession.inspectFuncCloseSession(ctx, report)
+	}
+
+	mm_params := HandoverRepositoryMockCloseSessionParams{ctx, report}
+
+	// Record call args
+	mmCloseSession.CloseSessionMock.mutex.Lock()
+	mmCloseSession.CloseSessionMock.callArgs = append(mmCloseSession.CloseSessionMock.callArgs, &mm_params)
+	mmCloseSession.CloseSessionMock.mutex.Unlock()
+
+	for _, e := range mmCloseSession.CloseSessionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCloseSession.CloseSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCloseSession.CloseSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmCloseSession.CloseSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmCloseSession.CloseSessionMock.defaultExpectation.paramPtrs
+
+		mm_got := HandoverRepositoryMockCloseSessionParams{ctx, report}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCloseSession.t.Errorf("HandoverRepositoryMock.CloseSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCloseSession.CloseSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.report != nil && !minimock.Equal(*mm_want_ptrs.report, mm_got.report) {
+				mmCloseSession.t.Errorf("HandoverRepositoryMock.CloseSession got unexpected parameter report, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCloseSession.CloseSessionMock.defaultExpectation.expectationOrigins.originReport, *mm_want_ptrs.report, mm_got.report, minimock.Diff(*mm_want_ptrs.report, mm_got.report))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCloseSession.t.Errorf("HandoverRepositoryMock.CloseSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCloseSession.CloseSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCloseSession.CloseSessionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCloseSession.t.Fatal("No results are set for the HandoverRepositoryMock.CloseSession")
+		}
+		return (*mm_results).err
+	}
+	if mmCloseSession.funcCloseSession != nil {
+		return mmCloseSession.funcCloseSession(ctx, report)
+	}
+	mmCloseSession.t.Fatalf("Unexpected call to HandoverRepositoryMock.CloseSession. %v %v", ctx, report)
+	return
+}
+
+// CloseSessionAfterCounter returns a count of finished HandoverRepositoryMock.CloseSession invocations
+func (mmCloseSession *HandoverRepositoryMock) CloseSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCloseSession.afterCloseSessionCounter)
+}
+
+// CloseSessionBeforeCounter returns a count of HandoverRepositoryMock.CloseSession invocations
+func (mmCloseSession *HandoverRepositoryMock) CloseSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCloseSession.beforeCloseSessionCounter)
+}
+
+// Calls returns a list of arguments used in each call to HandoverRepositoryMock.CloseSession.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCloseSession *mHandoverRepositoryMockCloseSession) Calls() []*HandoverRepositoryMockCloseSessionParams {
+	mmCloseSession.mutex.RLock()
+
+	argCopy := make([]*HandoverRepositoryMockCloseSessionParams, len(mmCloseSession.callArgs))
+	copy(argCopy, mmCloseSession.callArgs)
+
+	mmCloseSession.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCloseSessionDone returns true if the count of the CloseSession invocations corresponds
+// the number of defined expectations
+func (m *HandoverRepositoryMock) MinimockCloseSessionDone() bool {
+	if m.CloseSessionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CloseSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CloseSessionMock.invocationsDone()
+}
+
+// MinimockCloseSessionInspect logs each unmet expectation
+func (m *HandoverRepositoryMock) MinimockCloseSessionInspect() {
+	for _, e := range m.CloseSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to HandoverRepositoryMock.CloseSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCloseSessionCounter := mm_atomic.LoadUint64(&m.afterCloseSessionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CloseSessionMock.defaultExpectation != nil && afterCloseSessionCounter < 1 {
+		if m.CloseSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to HandoverRepositoryMock.CloseSession at\n%s", m.CloseSessionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to HandoverRepositoryMock.CloseSession at\n%s with params: %#v", m.CloseSessionMock.defaultExpectation.expectationOrigins.origin, *m.CloseSessionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCloseSession != nil && afterCloseSessionCounter < 1 {
+		m.t.Errorf("Expected call to HandoverRepositoryMock.CloseSession at\n%s", m.funcCloseSessionOrigin)
+	}
+
+	if !m.CloseSessionMock.invocationsDone() && afterCloseSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to HandoverRepositoryMock.CloseSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CloseSessionMock.expectedInvocations), m.CloseSessionMock.expectedInvocationsOrigin, afterCloseSessionCounter)
+	}
+}
+
+type mHandoverRepositoryMockCreateSession struct {
+	optional           bool
+	mock               *HandoverRepositoryMock
+	defaultExpectation *HandoverRepositoryMockCreateSessionExpectation
+	expectations       []*HandoverRepositoryMockCreateSessionExpectation
+
+	callArgs []*HandoverRepositoryMockCreateSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// HandoverRepositoryMockCreateSessionExpectation specifies expectation struct of the HandoverRepository.CreateSession
+type HandoverRepositoryMockCreateSessionExpectation struct {
+	mock               *HandoverRepositoryMock
+	params             *HandoverRepositoryMockCreateSessionParams
+	paramPtrs          *HandoverRepositoryMockCreateSessionParamPtrs
+	expectationOrigins HandoverRepositoryMockCreateSessionExpectationOrigins
+	results            *HandoverRepositoryMockCreateSessionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// HandoverRepositoryMockCreateSessionParams contains parameters of the HandoverRepository.CreateSession
+type HandoverRepositoryMockCreateSessionParams struct {
+	ctx     context.Context
+	session domain.HandoverSession
+}
+
+// HandoverRepositoryMockCreateSessionParamPtrs contains pointers to parameters of the HandoverRepository.CreateSession
+type HandoverRepositoryMockCreateSessionParamPtrs struct {
+	ctx     *context.Context
+	session *domain.HandoverSession
+}
+
+// HandoverRepositoryMockCreateSessionResults contains results of the HandoverRepository.CreateSession
+type HandoverRepositoryMockCreateSessionResults struct {
+	err error
+}
+
+// HandoverRepositoryMockCreateSessionOrigins contains origins of expectations of the HandoverRepository.CreateSession
+type HandoverRepositoryMockCreateSessionExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originSession string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateSession *mHandoverRepositoryMockCreateSession) Optional() *mHandoverRepositoryMockCreateSession {
+	mmCreateSession.optional = true
+	return mmCreateSession
+}
+
+// Expect sets up expected params for HandoverRepository.CreateSession
+func (mmCreateSession *mHandoverRepositoryMockCreateSession) Expect(ctx context.Context, session domain.HandoverSession) *mHandoverRepositoryMockCreateSession {
+	if mmCreateSession.mock.funcCreateSession != nil {
+		mmCreateSession.mock.t.Fatalf("HandoverRepositoryMock.CreateSession mock is already set by Set")
+	}
+
+	if mmCreateSession.defaultExpectation == nil {
+		mmCreateSession.defaultExpectation = &HandoverRepositoryMockCreateSessionExpectation{}
+	}
+
+	if mmCreateSession.defaultExpectation.paramPtrs != nil {
+		mmCreateSession.mock.t.Fatalf("HandoverRepositoryMock.CreateSession mock is already set by ExpectParams functions")
+	}
+
+	mmCreateSession.defaultExpectation.params = &HandoverRepositoryMockCreateSessionParams{ctx, session}
+	mmCreateSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateSession.expectations {
+		if minimock.Equal(e.params, mmCreateSession.defaultExpectation.params) {
+			mmCreateSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateSession.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateSession
+}
+
+// ExpectCtxParam1 sets up expected param ctx for HandoverRepository.CreateSession
+func (mmCreateSession *mHandoverRepositoryMockCreateSession) ExpectCtxParam1(ctx context.Context) *mHandoverRepositoryMockCreateSession {
+	if mmCreateSession.mock.funcCreateSession != nil {
+		mmCreateSession.mock.t.Fatalf("HandoverRepositoryMock.CreateSession mock is already set by Set")
+	}
+
+	if mmCreateSession.defaultExpectation == nil {
+		mmCreateSession.defaultExpectation = &HandoverRepositoryMockCreateSessionExpectation{}
+	}
+
+	if mmCreateSession.defaultExpectation.params != nil {
+		mmCreateSession.mock.t.Fatalf("HandoverRepositoryMock.CreateSession mock is already set by Expect")
+	}
+
+	if mmCreateSession.defaultExpectation.paramPtrs == nil {
+		mmCreateSession.defaultExpectation.paramPtrs = &HandoverRepositoryMockCreateSessionParamPtrs{}
+	}
+	mmCreateSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateSession
+}
+
+// ExpectSessionParam2 sets up expected param session for HandoverRepository.CreateSession
+func (mmCreateSession *mHandoverRepositoryMockCreateSession) ExpectSessionParam2(session domain.HandoverSession) *mHandoverRepositoryMockCreateSession {
+	if mmCreateSession.mock.funcCreateSession != nil {
+		mmCreateSession.mock.t.Fatalf("HandoverRepositoryMock.CreateSession mock is already set by Set")
+	}
+
+	if mmCreateSession.defaultExpectation == nil {
+		mmCreateSession.defaultExpectation = &HandoverRepositoryMockCreateSessionExpectation{}
+	}
+
+	if mmCreateSession.defaultExpectation.params != nil {
+		mmCreateSession.mock.t.Fatalf("HandoverRepositoryMock.CreateSession mock is already set by Expect")
+	}
+
+	if mmCreateSession.defaultExpectation.paramPtrs == nil {
+		mmCreateSession.defaultExpectation.paramPtrs = &HandoverRepositoryMockCreateSessionParamPtrs{}
+	}
+	mmCreateSession.defaultExpectation.paramPtrs.session = &session
+	mmCreateSession.defaultExpectation.expectationOrigins.originSession = minimock.CallerInfo(1)
+
+	return mmCreateSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the HandoverRepository.CreateSession
+func (mmCreateSession *mHandoverRepositoryMockCreateSession) Inspect(f func(ctx context.Context, session domain.HandoverSession)) *mHandoverRepositoryMockCreateSession {
+	if mmCreateSession.mock.inspectFuncCreateSession != nil {
+		mmCreateSession.mock.t.Fatalf("Inspect function is already set for HandoverRepositoryMock.CreateSession")
+	}
+
+	mmCreateSession.mock.inspectFuncCreateSession = f
+
+	return mmCreateSession
+}
+
+// Return sets up results that will be returned by HandoverRepository.CreateSession
+func (mmCreateSession *mHandoverRepositoryMockCreateSession) Return(err error) *HandoverRepositoryMock {
+	if mmCreateSession.mock.funcCreateSession != nil {
+		mmCreateSession.mock.t.Fatalf("HandoverRepositoryMock.CreateSession mock is already set by Set")
+	}
+
+	if mmCreateSession.defaultExpectation == nil {
+		mmCreateSession.defaultExpectation = &HandoverRepositoryMockCreateSessionExpectation{mock: mmCreateSession.mock}
+	}
+	mmCreateSession.defaultExpectation.results = &HandoverRepositoryMockCreateSessionResults{err}
+	mmCreateSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateSession.mock
+}
+
+// Set uses given function f to mock the HandoverRepository.CreateSession method
+func (mmCreateSession *mHandoverRepositoryMockCreateSession) Set(f func(ctx context.Context, session domain.HandoverSession) (err error)) *HandoverRepositoryMock {
+	if mmCreateSession.defaultExpectation != nil {
+		mmCreateSession.mock.t.Fatalf("Default expectation is already set for the HandoverRepository.CreateSession method")
+	}
+
+	if len(mmCreateSession.expectations) > 0 {
+		mmCreateSession.mock.t.Fatalf("Some expectations are already set for the HandoverRepository.CreateSession method")
+	}
+
+	mmCreateSession.mock.funcCreateSession = f
+	mmCreateSession.mock.funcCreateSessionOrigin = minimock.CallerInfo(1)
+	return mmCreateSession.mock
+}
+
+// When sets expectation for the HandoverRepository.CreateSession which will trigger the result defined by the following
+// Then helper
+func (mmCreateSession *mHandoverRepositoryMockCreateSession) When(ctx context.Context, session domain.HandoverSession) *HandoverRepositoryMockCreateSessionExpectation {
+	if mmCreateSession.mock.funcCreateSession != nil {
+		mmCreateSession.mock.t.Fatalf("HandoverRepositoryMock.CreateSession mock is already set by Set")
+	}
+
+	expectation := &HandoverRepositoryMockCreateSessionExpectation{
+		mock:               mmCreateSession.mock,
+		params:             &HandoverRepositoryMockCreateSessionParams{ctx, session},
+		expectationOrigins: HandoverRepositoryMockCreateSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateSession.expectations = append(mmCreateSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up HandoverRepository.CreateSession return parameters for the expectation previously defined by the When method
+func (e *HandoverRepositoryMockCreateSessionExpectation) Then(err error) *HandoverRepositoryMock {
+	e.results = &HandoverRepositoryMockCreateSessionResults{err}
+	return e.mock
+}
+
+// Times sets number of times HandoverRepository.CreateSession should be invoked
+func (mmCreateSession *mHandoverRepositoryMockCreateSession) Times(n uint64) *mHandoverRepositoryMockCreateSession {
+	if n == 0 {
+		mmCreateSession.mock.t.Fatalf("Times of HandoverRepositoryMock.CreateSession mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateSession.expectedInvocations, n)
+	mmCreateSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateSession
+}
+
+func (mmCreateSession *mHandoverRepositoryMockCreateSession) invocationsDone() bool {
+	if len(mmCreateSession.expectations) == 0 && mmCreateSession.defaultExpectation == nil && mmCreateSession.mock.funcCreateSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateSession.mock.afterCreateSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateSession implements mm_usecases.HandoverRepository
+func (mmCreateSession *HandoverRepositoryMock) CreateSession(ctx context.Context, session domain.HandoverSession) (err error) {
+	mm_atomic.AddUint64(&mmCreateSession.beforeCreateSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateSession.afterCreateSessionCounter, 1)
+
+	mmCreateSession.t.Helper()
+
+	if mmCreateSession.inspectFuncCreateSession != nil {
+		mmCreateSession.inspectFuncCreateSession(ctx, session)
+	}
+
+	mm_params := HandoverRepositoryMockCreateSessionParams{ctx, session}
+
+	// Record call args
+	mmCreateSession.CreateSessionMock.mutex.Lock()
+	mmCreateSession.CreateSessionMock.callArgs = append(mmCreateSession.CreateSessionMock.callArgs, &mm_params)
+	mmCreateSession.CreateSessionMock.mutex.Unlock()
+
+	for _, e := range mmCreateSession.CreateSessionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateSession.CreateSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateSession.CreateSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateSession.CreateSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateSession.CreateSessionMock.defaultExpectation.paramPtrs
+
+		mm_got := HandoverRepositoryMockCreateSessionParams{ctx, session}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateSession.t.Errorf("HandoverRepositoryMock.CreateSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateSession.CreateSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.session != nil && !minimock.Equal(*mm_want_ptrs.session, mm_got.session) {
+				mmCreateSession.t.Errorf("HandoverRepositoryMock.CreateSession got unexpected parameter session, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateSession.CreateSessionMock.defaultExpectation.expectationOrigins.originSession, *mm_want_ptrs.session, mm_got.session, minimock.Diff(*mm_want_ptrs.session, mm_got.session))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateSession.t.Errorf("HandoverRepositoryMock.CreateSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateSession.CreateSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateSession.CreateSessionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateSession.t.Fatal("No results are set for the HandoverRepositoryMock.CreateSession")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateSession.funcCreateSession != nil {
+		return mmCreateSession.funcCreateSession(ctx, session)
+	}
+	mmCreateSession.t.Fatalf("Unexpected call to HandoverRepositoryMock.CreateSession. %v %v", ctx, session)
+	return
+}
+
+// CreateSessionAfterCounter returns a count of finished HandoverRepositoryMock.CreateSession invocations
+func (mmCreateSession *HandoverRepositoryMock) CreateSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateSession.afterCreateSessionCounter)
+}
+
+// CreateSessionBeforeCounter returns a count of HandoverRepositoryMock.CreateSession invocations
+func (mmCreateSession *HandoverRepositoryMock) CreateSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateSession.beforeCreateSessionCounter)
+}
+
+// Calls returns a list of arguments used in each call to HandoverRepositoryMock.CreateSession.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateSession *mHandoverRepositoryMockCreateSession) Calls() []*HandoverRepositoryMockCreateSessionParams {
+	mmCreateSession.mutex.RLock()
+
+	argCopy := make([]*HandoverRepositoryMockCreateSessionParams, len(mmCreateSession.callArgs))
+	copy(argCopy, mmCreateSession.callArgs)
+
+	mmCreateSession.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateSessionDone returns true if the count of the CreateSession invocations corresponds
+// the number of defined expectations
+func (m *HandoverRepositoryMock) MinimockCreateSessionDone() bool {
+	if m.CreateSessionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateSessionMock.invocationsDone()
+}
+
+// MinimockCreateSessionInspect logs each unmet expectation
+func (m *HandoverRepositoryMock) MinimockCreateSessionInspect() {
+	for _, e := range m.CreateSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to HandoverRepositoryMock.CreateSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateSessionCounter := mm_atomic.LoadUint64(&m.afterCreateSessionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateSessionMock.defaultExpectation != nil && afterCreateSessionCounter < 1 {
+		if m.CreateSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to HandoverRepositoryMock.CreateSession at\n%s", m.CreateSessionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to HandoverRepositoryMock.CreateSession at\n%s with params: %#v", m.CreateSessionMock.defaultExpectation.expectationOrigins.origin, *m.CreateSessionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateSession != nil && afterCreateSessionCounter < 1 {
+		m.t.Errorf("Expected call to HandoverRepositoryMock.CreateSession at\n%s", m.funcCreateSessionOrigin)
+	}
+
+	if !m.CreateSessionMock.invocationsDone() && afterCreateSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to HandoverRepositoryMock.CreateSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateSessionMock.expectedInvocations), m.CreateSessionMock.expectedInvocationsOrigin, afterCreateSessionCounter)
+	}
+}
+
+type mHandoverRepositoryMockGetSession struct {
+	optional           bool
+	mock               *HandoverRepositoryMock
+	defaultExpectation *HandoverRepositoryMockGetSessionExpectation
+	expectations       []*HandoverRepositoryMockGetSessionExpectation
+
+	callArgs []*HandoverRepositoryMockGetSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// HandoverRepositoryMockGetSessionExpectation specifies expectation struct of the HandoverRepository.GetSession
+type HandoverRepositoryMockGetSessionExpectation struct {
+	mock               *HandoverRepositoryMock
+	params             *HandoverRepositoryMockGetSessionParams
+	paramPtrs          *HandoverRepositoryMockGetSessionParamPtrs
+	expectationOrigins HandoverRepositoryMockGetSessionExpectationOrigins
+	results            *HandoverRepositoryMockGetSessionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// HandoverRepositoryMockGetSessionParams contains parameters of the HandoverRepository.GetSession
+type HandoverRepositoryMockGetSessionParams struct {
+	ctx       context.Context
+	sessionID string
+}
+
+// HandoverRepositoryMockGetSessionParamPtrs contains pointers to parameters of the HandoverRepository.GetSession
+type HandoverRepositoryMockGetSessionParamPtrs struct {
+	ctx       *context.Context
+	sessionID *string
+}
+
+// HandoverRepositoryMockGetSessionResults contains results of the HandoverRepository.GetSession
+type HandoverRepositoryMockGetSessionResults struct {
+	h1  domain.HandoverSession
+	err error
+}
+
+// HandoverRepositoryMockGetSessionOrigins contains origins of expectations of the HandoverRepository.GetSession
+type HandoverRepositoryMockGetSessionExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originSessionID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetSession *mHandoverRepositoryMockGetSession) Optional() *mHandoverRepositoryMockGetSession {
+	mmGetSession.optional = true
+	return mmGetSession
+}
+
+// Expect sets up expected params for HandoverRepository.GetSession
+func (mmGetSession *mHandoverRepositoryMockGetSession) Expect(ctx context.Context, sessionID string) *mHandoverRepositoryMockGetSession {
+	if mmGetSession.mock.funcGetSession != nil {
+		mmGetSession.mock.t.Fatalf("HandoverRepositoryMock.GetSession mock is already set by Set")
+	}
+
+	if mmGetSession.defaultExpectation == nil {
+		mmGetSession.defaultExpectation = &HandoverRepositoryMockGetSessionExpectation{}
+	}
+
+	if mmGetSession.defaultExpectation.paramPtrs != nil {
+		mmGetSession.mock.t.Fatalf("HandoverRepositoryMock.GetSession mock is already set by ExpectParams functions")
+	}
+
+	mmGetSession.defaultExpectation.params = &HandoverRepositoryMockGetSessionParams{ctx, sessionID}
+	mmGetSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetSession.expectations {
+		if minimock.Equal(e.params, mmGetSession.defaultExpectation.params) {
+			mmGetSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetSession.defaultExpectation.params)
+		}
+	}
+
+	return mmGetSession
+}
+
+// ExpectCtxParam1 sets up expected param ctx for HandoverRepository.GetSession
+func (mmGetSession *mHandoverRepositoryMockGetSession) ExpectCtxParam1(ctx context.Context) *mHandoverRepositoryMockGetSession {
+	if mmGetSession.mock.funcGetSession != nil {
+		mmGetSession.mock.t.Fatalf("HandoverRepositoryMock.GetSession mock is already set by Set")
+	}
+
+	if mmGetSession.defaultExpectation == nil {
+		mmGetSession.defaultExpectation = &HandoverRepositoryMockGetSessionExpectation{}
+	}
+
+	if mmGetSession.defaultExpectation.params != nil {
+		mmGetSession.mock.t.Fatalf("HandoverRepositoryMock.GetSession mock is already set by Expect")
+	}
+
+	if mmGetSession.defaultExpectation.paramPtrs == nil {
+		mmGetSession.defaultExpectation.paramPtrs = &HandoverRepositoryMockGetSessionParamPtrs{}
+	}
+	mmGetSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetSession
+}
+
+// ExpectSessionIDParam2 sets up expected param sessionID for HandoverRepository.GetSession
+func (mmGetSession *mHandoverRepositoryMockGetSession) ExpectSessionIDParam2(sessionID string) *mHandoverRepositoryMockGetSession {
+	if mmGetSession.mock.funcGetSession != nil {
+		mmGetSession.mock.t.Fatalf("HandoverRepositoryMock.GetSession mock is already set by Set")
+	}
+
+	if mmGetSession.defaultExpectation == nil {
+		mmGetSession.defaultExpectation = &HandoverRepositoryMockGetSessionExpectation{}
+	}
+
+	if mmGetSession.defaultExpectation.params != nil {
+		mmGetSession.mock.t.Fatalf("HandoverRepositoryMock.GetSession mock is already set by Expect")
+	}
+
+	if mmGetSession.defaultExpectation.paramPtrs == nil {
+		mmGetSession.defaultExpectation.paramPtrs = &HandoverRepositoryMockGetSessionParamPtrs{}
+	}
+	mmGetSession.defaultExpectation.paramPtrs.sessionID = &sessionID
+	mmGetSession.defaultExpectation.expectationOrigins.originSessionID = minimock.CallerInfo(1)
+
+	return mmGetSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the HandoverRepository.GetSession
+func (mmGetSession *mHandoverRepositoryMockGetSession) Inspect(f func(ctx context.Context, sessionID string)) *mHandoverRepositoryMockGetSession {
+	if mmGetSession.mock.inspectFuncGetSession != nil {
+		mmGetSession.mock.t.Fatalf("Inspect function is already set for HandoverRepositoryMock.GetSession")
+	}
+
+	mmGetSession.mock.inspectFuncGetSession = f
+
+	return mmGetSession
+}
+
+// Return sets up results that will be returned by HandoverRepository.GetSession
+func (mmGetSession *mHandoverRepositoryMockGetSession) Return(h1 domain.HandoverSession, err error) *HandoverRepositoryMock {
+	if mmGetSession.mock.funcGetSession != nil {
+		mmGetSession.mock.t.Fatalf("HandoverRepositoryMock.GetSession mock is already set by Set")
+	}
+
+	if mmGetSession.defaultExpectation == nil {
+		mmGetSession.defaultExpectation = &HandoverRepositoryMockGetSessionExpectation{mock: mmGetSession.mock}
+	}
+	mmGetSession.defaultExpectation.results = &HandoverRepositoryMockGetSessionResults{h1, err}
+	mmGetSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetSession.mock
+}
+
+// Set uses given function f to mock the HandoverRepository.GetSession method
+func (mmGetSession *mHandoverRepositoryMockGetSession) Set(f func(ctx context.Context, sessionID string) (h1 domain.HandoverSession, err error)) *HandoverRepositoryMock {
+	if mmGetSession.defaultExpectation != nil {
+		mmGetSession.mock.t.Fatalf("Default expectation is already set for the HandoverRepository.GetSession method")
+	}
+
+	if len(mmGetSession.expectations) > 0 {
+		mmGetSession.mock.t.Fatalf("Some expectations are already set for the HandoverRepository.GetSession method")
+	}
+
+	mmGetSession.mock.funcGetSession = f
+	mmGetSession.mock.funcGetSessionOrigin = minimock.CallerInfo(1)
+	return mmGetSession.mock
+}
+
+// When sets expectation for the HandoverRepository.GetSession which will trigger the result defined by the following
+// Then helper
+func (mmGetSession *mHandoverRepositoryMockGetSession) When(ctx context.Context, sessionID string) *HandoverRepositoryMockGetSessionExpectation {
+	if mmGetSession.mock.funcGetSession != nil {
+		mmGetSession.mock.t.Fatalf("HandoverRepositoryMock.GetSession mock is already set by Set")
+	}
+
+	expectation := &HandoverRepositoryMockGetSessionExpectation{
+		mock:               mmGetSession.mock,
+		params:             &HandoverRepositoryMockGetSessionParams{ctx, sessionID},
+		expectationOrigins: HandoverRepositoryMockGetSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetSession.expectations = append(mmGetSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up HandoverRepository.GetSession return parameters for the expectation previously defined by the When method
+func (e *HandoverRepositoryMockGetSessionExpectation) Then(h1 domain.HandoverSession, err error) *HandoverRepositoryMock {
+	e.results = &HandoverRepositoryMockGetSessionResults{h1, err}
+	return e.mock
+}
+
+// Times sets number of times HandoverRepository.GetSession should be invoked
+func (mmGetSession *mHandoverRepositoryMockGetSession) Times(n uint64) *mHandoverRepositoryMockGetSession {
+	if n == 0 {
+		mmGetSession.mock.t.Fatalf("Times of HandoverRepositoryMock.GetSession mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetSession.expectedInvocations, n)
+	mmGetSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetSession
+}
+
+func (mmGetSession *mHandoverRepositoryMockGetSession) invocationsDone() bool {
+	if len(mmGetSession.expectations) == 0 && mmGetSession.defaultExpectation == nil && mmGetSession.mock.funcGetSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetSession.mock.afterGetSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetSession implements mm_usecases.HandoverRepository
+func (mmGetSession *HandoverRepositoryMock) GetSession(ctx context.Context, sessionID string) (h1 domain.HandoverSession, err error) {
+	mm_atomic.AddUint64(&mmGetSession.beforeGetSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetSession.afterGetSessionCounter, 1)
+
+	mmGetSession.t.Helper()
+
+	if mmGetSession.inspectFuncGetSession != nil {
+		mmGetSession.inspectFuncGetSession(ctx, sessionID)
+	}
+
+	mm_params := HandoverRepositoryMockGetSessionParams{ctx, sessionID}
+
+	// Record call args
+	mmGetSession.GetSessionMock.mutex.Lock()
+	mmGetSession.GetSessionMock.callArgs = append(mmGetSession.GetSessionMock.callArgs, &mm_params)
+	mmGetSession.GetSessionMock.mutex.Unlock()
+
+	for _, e := range mmGetSession.GetSessionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.h1, e.results.err
+		}
+	}
+
+	if mmGetSession.GetSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetSession.GetSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetSession.GetSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmGetSession.GetSessionMock.defaultExpectation.paramPtrs
+
+		mm_got := HandoverRepositoryMockGetSessionParams{ctx, sessionID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetSession.t.Errorf("HandoverRepositoryMock.GetSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSession.GetSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.sessionID != nil && !minimock.Equal(*mm_want_ptrs.sessionID, mm_got.sessionID) {
+				mmGetSession.t.Errorf("HandoverRepositoryMock.GetSession got unexpected parameter sessionID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSession.GetSessionMock.defaultExpectation.expectationOrigins.originSessionID, *mm_want_ptrs.sessionID, mm_got.sessionID, minimock.Diff(*mm_want_ptrs.sessionID, mm_got.sessionID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetSession.t.Errorf("HandoverRepositoryMock.GetSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetSession.GetSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetSession.GetSessionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetSession.t.Fatal("No results are set for the HandoverRepositoryMock.GetSession")
+		}
+		return (*mm_results).h1, (*mm_results).err
+	}
+	if mmGetSession.funcGetSession != nil {
+		return mmGetSession.funcGetSession(ctx, sessionID)
+	}
+	mmGetSession.t.Fatalf("Unexpected call to HandoverRepositoryMock.GetSession. %v %v", ctx, sessionID)
+	return
+}
+
+// GetSessionAfterCounter returns a count of finished HandoverRepositoryMock.GetSession invocations
+func (mmGetSession *HandoverRepositoryMock) GetSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSession.afterGetSessionCounter)
+}
+
+// GetSessionBeforeCounter returns a count of HandoverRepositoryMock.GetSession invocations
+func (mmGetSession *HandoverRepositoryMock) GetSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSession.beforeGetSessionCounter)
+}
+
+// Calls returns a list of arguments used in each call to HandoverRepositoryMock.GetSession.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetSession *mHandoverRepositoryMockGetSession) Calls() []*HandoverRepositoryMockGetSessionParams {
+	mmGetSession.mutex.RLock()
+
+	argCopy := make([]*HandoverRepositoryMockGetSessionParams, len(mmGetSession.callArgs))
+	copy(argCopy, mmGetSession.callArgs)
+
+	mmGetSession.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetSessionDone returns true if the count of the GetSession invocations corresponds
+// the number of defined expectations
+func (m *HandoverRepositoryMock) MinimockGetSessionDone() bool {
+	if m.GetSessionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetSessionMock.invocationsDone()
+}
+
+// MinimockGetSessionInspect logs each unmet expectation
+func (m *HandoverRepositoryMock) MinimockGetSessionInspect() {
+	for _, e := range m.GetSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to HandoverRepositoryMock.GetSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetSessionCounter := mm_atomic.LoadUint64(&m.afterGetSessionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSessionMock.defaultExpectation != nil && afterGetSessionCounter < 1 {
+		if m.GetSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to HandoverRepositoryMock.GetSession at\n%s", m.GetSessionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to HandoverRepositoryMock.GetSession at\n%s with params: %#v", m.GetSessionMock.defaultExpectation.expectationOrigins.origin, *m.GetSessionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSession != nil && afterGetSessionCounter < 1 {
+		m.t.Errorf("Expected call to HandoverRepositoryMock.GetSession at\n%s", m.funcGetSessionOrigin)
+	}
+
+	if !m.GetSessionMock.invocationsDone() && afterGetSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to HandoverRepositoryMock.GetSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetSessionMock.expectedInvocations), m.GetSessionMock.expectedInvocationsOrigin, afterGetSessionCounter)
+	}
+}
+
+type mHandoverRepositoryMockLockSession struct {
+	optional           bool
+	mock               *HandoverRepositoryMock
+	defaultExpectation *HandoverRepositoryMockLockSessionExpectation
+	expectations       []*HandoverRepositoryMockLockSessionExpectation
+
+	callArgs []*HandoverRepositoryMockLockSessionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// HandoverRepositoryMockLockSessionExpectation specifies expectation struct of the HandoverRepository.LockSession
+type HandoverRepositoryMockLockSessionExpectation struct {
+	mock               *HandoverRepositoryMock
+	params             *HandoverRepositoryMockLockSessionParams
+	paramPtrs          *HandoverRepositoryMockLockSessionParamPtrs
+	expectationOrigins HandoverRepositoryMockLockSessionExpectationOrigins
+	results            *HandoverRepositoryMockLockSessionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// HandoverRepositoryMockLockSessionParams contains parameters of the HandoverRepository.LockSession
+type HandoverRepositoryMockLockSessionParams struct {
+	ctx       context.Context
+	sessionID string
+}
+
+// HandoverRepositoryMockLockSessionParamPtrs contains pointers to parameters of the HandoverRepository.LockSession
+type HandoverRepositoryMockLockSessionParamPtrs struct {
+	ctx       *context.Context
+	sessionID *string
+}
+
+// HandoverRepositoryMockLockSessionResults contains results of the HandoverRepository.LockSession
+type HandoverRepositoryMockLockSessionResults struct {
+	h1  domain.HandoverSession
+	err error
+}
+
+// HandoverRepositoryMockLockSessionOrigins contains origins of expectations of the HandoverRepository.LockSession
+type HandoverRepositoryMockLockSessionExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originSessionID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmLockSession *mHandoverRepositoryMockLockSession) Optional() *mHandoverRepositoryMockLockSession {
+	mmLockSession.optional = true
+	return mmLockSession
+}
+
+// Expect sets up expected params for HandoverRepository.LockSession
+func (mmLockSession *mHandoverRepositoryMockLockSession) Expect(ctx context.Context, sessionID string) *mHandoverRepositoryMockLockSession {
+	if mmLockSession.mock.funcLockSession != nil {
+		mmLockSession.mock.t.Fatalf("HandoverRepositoryMock.LockSession mock is already set by Set")
+	}
+
+	if mmLockSession.defaultExpectation == nil {
+		mmLockSession.defaultExpectation = &HandoverRepositoryMockLockSessionExpectation{}
+	}
+
+	if mmLockSession.defaultExpectation.paramPtrs != nil {
+		mmLockSession.mock.t.Fatalf("HandoverRepositoryMock.LockSession mock is already set by ExpectParams functions")
+	}
+
+	mmLockSession.defaultExpectation.params = &HandoverRepositoryMockLockSessionParams{ctx, sessionID}
+	mmLockSession.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmLockSession.expectations {
+		if minimock.Equal(e.params, mmLockSession.defaultExpectation.params) {
+			mmLockSession.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmLockSession.defaultExpectation.params)
+		}
+	}
+
+	return mmLockSession
+}
+
+// ExpectCtxParam1 sets up expected param ctx for HandoverRepository.LockSession
+func (mmLockSession *mHandoverRepositoryMockLockSession) ExpectCtxParam1(ctx context.Context) *mHandoverRepositoryMockLockSession {
+	if mmLockSession.mock.funcLockSession != nil {
+		mmLockSession.mock.t.Fatalf("HandoverRepositoryMock.LockSession mock is already set by Set")
+	}
+
+	if mmLockSession.defaultExpectation == nil {
+		mmLockSession.defaultExpectation = &HandoverRepositoryMockLockSessionExpectation{}
+	}
+
+	if mmLockSession.defaultExpectation.params != nil {
+		mmLockSession.mock.t.Fatalf("HandoverRepositoryMock.LockSession mock is already set by Expect")
+	}
+
+	if mmLockSession.defaultExpectation.paramPtrs == nil {
+		mmLockSession.defaultExpectation.paramPtrs = &HandoverRepositoryMockLockSessionParamPtrs{}
+	}
+	mmLockSession.defaultExpectation.paramPtrs.ctx = &ctx
+	mmLockSession.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmLockSession
+}
+
+// ExpectSessionIDParam2 sets up expected param sessionID for HandoverRepository.LockSession
+func (mmLockSession *mHandoverRepositoryMockLockSession) ExpectSessionIDParam2(sessionID string) *mHandoverRepositoryMockLockSession {
+	if mmLockSession.mock.funcLockSession != nil {
+		mmLockSession.mock.t.Fatalf("HandoverRepositoryMock.LockSession mock is already set by Set")
+	}
+
+	if mmLockSession.defaultExpectation == nil {
+		mmLockSession.defaultExpectation = &HandoverRepositoryMockLockSessionExpectation{}
+	}
+
+	if mmLockSession.defaultExpectation.params != nil {
+		mmLockSession.mock.t.Fatalf("HandoverRepositoryMock.LockSession mock is already set by Expect")
+	}
+
+	if mmLockSession.defaultExpectation.paramPtrs == nil {
+		mmLockSession.defaultExpectation.paramPtrs = &HandoverRepositoryMockLockSessionParamPtrs{}
+	}
+	mmLockSession.defaultExpectation.paramPtrs.sessionID = &sessionID
+	mmLockSession.defaultExpectation.expectationOrigins.originSessionID = minimock.CallerInfo(1)
+
+	return mmLockSession
+}
+
+// Inspect accepts an inspector function that has same arguments as the HandoverRepository.LockSession
+func (mmLockSession *mHandoverRepositoryMockLockSession) Inspect(f func(ctx context.Context, sessionID string)) *mHandoverRepositoryMockLockSession {
+	if mmLockSession.mock.inspectFuncLockSession != nil {
+		mmLockSession.mock.t.Fatalf("Inspect function is already set for HandoverRepositoryMock.LockSession")
+	}
+
+	mmLockSession.mock.inspectFuncLockSession = f
+
+	return mmLockSession
+}
+
+// Return sets up results that will be returned by HandoverRepository.LockSession
+func (mmLockSession *mHandoverRepositoryMockLockSession) Return(h1 domain.HandoverSession, err error) *HandoverRepositoryMock {
+	if mmLockSession.mock.funcLockSession != nil {
+		mmLockSession.mock.t.Fatalf("HandoverRepositoryMock.LockSession mock is already set by Set")
+	}
+
+	if mmLockSession.defaultExpectation == nil {
+		mmLockSession.defaultExpectation = &HandoverRepositoryMockLockSessionExpectation{mock: mmLockSession.mock}
+	}
+	mmLockSession.defaultExpectation.results = &HandoverRepositoryMockLockSessionResults{h1, err}
+	mmLockSession.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmLockSession.mock
+}
+
+// Set uses given function f to mock the HandoverRepository.LockSession method
+func (mmLockSession *mHandoverRepositoryMockLockSession) Set(f func(ctx context.Context, sessionID string) (h1 domain.HandoverSession, err error)) *HandoverRepositoryMock {
+	if mmLockSession.defaultExpectation != nil {
+		mmLockSession.mock.t.Fatalf("Default expectation is already set for the HandoverRepository.LockSession method")
+	}
+
+	if len(mmLockSession.expectations) > 0 {
+		mmLockSession.mock.t.Fatalf("Some expectations are already set for the HandoverRepository.LockSession method")
+	}
+
+	mmLockSession.mock.funcLockSession = f
+	mmLockSession.mock.funcLockSessionOrigin = minimock.CallerInfo(1)
+	return mmLockSession.mock
+}
+
+// When sets expectation for the HandoverRepository.LockSession which will trigger the result defined by the following
+// Then helper
+func (mmLockSession *mHandoverRepositoryMockLockSession) When(ctx context.Context, sessionID string) *HandoverRepositoryMockLockSessionExpectation {
+	if mmLockSession.mock.funcLockSession != nil {
+		mmLockSession.mock.t.Fatalf("HandoverRepositoryMock.LockSession mock is already set by Set")
+	}
+
+	expectation := &HandoverRepositoryMockLockSessionExpectation{
+		mock:               mmLockSession.mock,
+		params:             &HandoverRepositoryMockLockSessionParams{ctx, sessionID},
+		expectationOrigins: HandoverRepositoryMockLockSessionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmLockSession.expectations = append(mmLockSession.expectations, expectation)
+	return expectation
+}
+
+// Then sets up HandoverRepository.LockSession return parameters for the expectation previously defined by the When method
+func (e *HandoverRepositoryMockLockSessionExpectation) Then(h1 domain.HandoverSession, err error) *HandoverRepositoryMock {
+	e.results = &HandoverRepositoryMockLockSessionResults{h1, err}
+	return e.mock
+}
+
+// Times sets number of times HandoverRepository.LockSession should be invoked
+func (mmLockSession *mHandoverRepositoryMockLockSession) Times(n uint64) *mHandoverRepositoryMockLockSession {
+	if n == 0 {
+		mmLockSession.mock.t.Fatalf("Times of HandoverRepositoryMock.LockSession mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmLockSession.expectedInvocations, n)
+	mmLockSession.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmLockSession
+}
+
+func (mmLockSession *mHandoverRepositoryMockLockSession) invocationsDone() bool {
+	if len(mmLockSession.expectations) == 0 && mmLockSession.defaultExpectation == nil && mmLockSession.mock.funcLockSession == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmLockSession.mock.afterLockSessionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmLockSession.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// LockSession implements mm_usecases.HandoverRepository
+func (mmLockSession *HandoverRepositoryMock) LockSession(ctx context.Context, sessionID string) (h1 domain.HandoverSession, err error) {
+	mm_atomic.AddUint64(&mmLockSession.beforeLockSessionCounter, 1)
+	defer mm_atomic.AddUint64(&mmLockSession.afterLockSessionCounter, 1)
+
+	mmLockSession.t.Helper()
+
+	if mmLockSession.inspectFuncLockSession != nil {
+		mmLockSession.inspectFuncLockSession(ctx, sessionID)
+	}
+
+	mm_params := HandoverRepositoryMockLockSessionParams{ctx, sessionID}
+
+	// Record call args
+	mmLockSession.LockSessionMock.mutex.Lock()
+	mmLockSession.LockSessionMock.callArgs = append(mmLockSession.LockSessionMock.callArgs, &mm_params)
+	mmLockSession.LockSessionMock.mutex.Unlock()
+
+	for _, e := range mmLockSession.LockSessionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.h1, e.results.err
+		}
+	}
+
+	if mmLockSession.LockSessionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmLockSession.LockSessionMock.defaultExpectation.Counter, 1)
+		mm_want := mmLockSession.LockSessionMock.defaultExpectation.params
+		mm_want_ptrs := mmLockSession.LockSessionMock.defaultExpectation.paramPtrs
+
+		mm_got := HandoverRepositoryMockLockSessionParams{ctx, sessionID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmLockSession.t.Errorf("HandoverRepositoryMock.LockSession got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLockSession.LockSessionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.sessionID != nil && !minimock.Equal(*mm_want_ptrs.sessionID, mm_got.sessionID) {
+				mmLockSession.t.Errorf("HandoverRepositoryMock.LockSession got unexpected parameter sessionID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmLockSession.LockSessionMock.defaultExpectation.expectationOrigins.originSessionID, *mm_want_ptrs.sessionID, mm_got.sessionID, minimock.Diff(*mm_want_ptrs.sessionID, mm_got.sessionID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmLockSession.t.Errorf("HandoverRepositoryMock.LockSession got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmLockSession.LockSessionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmLockSession.LockSessionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmLockSession.t.Fatal("No results are set for the HandoverRepositoryMock.LockSession")
+		}
+		return (*mm_results).h1, (*mm_results).err
+	}
+	if mmLockSession.funcLockSession != nil {
+		return mmLockSession.funcLockSession(ctx, sessionID)
+	}
+	mmLockSession.t.Fatalf("Unexpected call to HandoverRepositoryMock.LockSession. %v %v", ctx, sessionID)
+	return
+}
+
+// LockSessionAfterCounter returns a count of finished HandoverRepositoryMock.LockSession invocations
+func (mmLockSession *HandoverRepositoryMock) LockSessionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockSession.afterLockSessionCounter)
+}
+
+// LockSessionBeforeCounter returns a count of HandoverRepositoryMock.LockSession invocations
+func (mmLockSession *HandoverRepositoryMock) LockSessionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmLockSession.beforeLockSessionCounter)
+}
+
+// Calls returns a list of arguments used in each call to HandoverRepositoryMock.LockSession.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmLockSession *mHandoverRepositoryMockLockSession) Calls() []*HandoverRepositoryMockLockSessionParams {
+	mmLockSession.mutex.RLock()
+
+	argCopy := make([]*HandoverRepositoryMockLockSessionParams, len(mmLockSession.callArgs))
+	copy(argCopy, mmLockSession.callArgs)
+
+	mmLockSession.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockLockSessionDone returns true if the count of the LockSession invocations corresponds
+// the number of defined expectations
+func (m *HandoverRepositoryMock) MinimockLockSessionDone() bool {
+	if m.LockSessionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.LockSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.LockSessionMock.invocationsDone()
+}
+
+// MinimockLockSessionInspect logs each unmet expectation
+func (m *HandoverRepositoryMock) MinimockLockSessionInspect() {
+	for _, e := range m.LockSessionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to HandoverRepositoryMock.LockSession at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterLockSessionCounter := mm_atomic.LoadUint64(&m.afterLockSessionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.LockSessionMock.defaultExpectation != nil && afterLockSessionCounter < 1 {
+		if m.LockSessionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to HandoverRepositoryMock.LockSession at\n%s", m.LockSessionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to HandoverRepositoryMock.LockSession at\n%s with params: %#v", m.LockSessionMock.defaultExpectation.expectationOrigins.origin, *m.LockSessionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcLockSession != nil && afterLockSessionCounter < 1 {
+		m.t.Errorf("Expected call to HandoverRepositoryMock.LockSession at\n%s", m.funcLockSessionOrigin)
+	}
+
+	if !m.LockSessionMock.invocationsDone() && afterLockSessionCounter > 0 {
+		m.t.Errorf("Expected %d calls to HandoverRepositoryMock.LockSession at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.LockSessionMock.expectedInvocations), m.LockSessionMock.expectedInvocationsOrigin, afterLockSessionCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *HandoverRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddScanInspect()
+
+			m.MinimockCloseSessionInspect()
+
+			m.MinimockCreateSessionInspect()
+
+			m.MinimockGetSessionInspect()
+
+			m.MinimockLockSessionInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *HandoverRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *HandoverRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddScanDone() &&
+		m.MinimockCloseSessionDone() &&
+		m.MinimockCreateSessionDone() &&
+		m.MinimockGetSessionDone() &&
+		m.MinimockLockSessionDone()
+}
@@ -23,6 +23,20 @@ type OrderPackagerInterfaceMock struct {
 	afterPackageOrderCounter  uint64
 	beforePackageOrderCounter uint64
 	PackageOrderMock          mOrderPackagerInterfaceMockPackageOrder
+
+	funcQuotePackaging          func(order domain.PVZOrder) (pa1 []domain.PackagingQuote, err error)
+	funcQuotePackagingOrigin    string
+	inspectFuncQuotePackaging   func(order domain.PVZOrder)
+	afterQuotePackagingCounter  uint64
+	beforeQuotePackagingCounter uint64
+	QuotePackagingMock          mOrderPackagerInterfaceMockQuotePackaging
+
+	funcValidateCombination          func(base domain.PackagingType, additional domain.PackagingType) (err error)
+	funcValidateCombinationOrigin    string
+	inspectFuncValidateCombination   func(base domain.PackagingType, additional domain.PackagingType)
+	afterValidateCombinationCounter  uint64
+	beforeValidateCombinationCounter uint64
+	ValidateCombinationMock          mOrderPackagerInterfaceMockValidateCombination
 }
 
 // NewOrderPackagerInterfaceMock returns a mock for mm_usecases.OrderPackagerInterface
@@ -36,6 +50,12 @@ func NewOrderPackagerInterfaceMock(t minimock.Tester) *OrderPackagerInterfaceMoc
 	m.PackageOrderMock = mOrderPackagerInterfaceMockPackageOrder{mock: m}
 	m.PackageOrderMock.callArgs = []*OrderPackagerInterfaceMockPackageOrderParams{}
 
+	m.QuotePackagingMock = mOrderPackagerInterfaceMockQuotePackaging{mock: m}
+	m.QuotePackagingMock.callArgs = []*OrderPackagerInterfaceMockQuotePackagingParams{}
+
+	m.ValidateCombinationMock = mOrderPackagerInterfaceMockValidateCombination{mock: m}
+	m.ValidateCombinationMock.callArgs = []*OrderPackagerInterfaceMockValidateCombinationParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -384,11 +404,669 @@ func (m *OrderPackagerInterfaceMock) MinimockPackageOrderInspect() {
 	}
 }
 
+type mOrderPackagerInterfaceMockQuotePackaging struct {
+	optional           bool
+	mock               *OrderPackagerInterfaceMock
+	defaultExpectation *OrderPackagerInterfaceMockQuotePackagingExpectation
+	expectations       []*OrderPackagerInterfaceMockQuotePackagingExpectation
+
+	callArgs []*OrderPackagerInterfaceMockQuotePackagingParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderPackagerInterfaceMockQuotePackagingExpectation specifies expectation struct of the OrderPackagerInterface.QuotePackaging
+type OrderPackagerInterfaceMockQuotePackagingExpectation struct {
+	mock               *OrderPackagerInterfaceMock
+	params             *OrderPackagerInterfaceMockQuotePackagingParams
+	paramPtrs          *OrderPackagerInterfaceMockQuotePackagingParamPtrs
+	expectationOrigins OrderPackagerInterfaceMockQuotePackagingExpectationOrigins
+	results            *OrderPackagerInterfaceMockQuotePackagingResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderPackagerInterfaceMockQuotePackagingParams contains parameters of the OrderPackagerInterface.QuotePackaging
+type OrderPackagerInterfaceMockQuotePackagingParams struct {
+	order domain.PVZOrder
+}
+
+// OrderPackagerInterfaceMockQuotePackagingParamPtrs contains pointers to parameters of the OrderPackagerInterface.QuotePackaging
+type OrderPackagerInterfaceMockQuotePackagingParamPtrs struct {
+	order *domain.PVZOrder
+}
+
+// OrderPackagerInterfaceMockQuotePackagingResults contains results of the OrderPackagerInterface.QuotePackaging
+type OrderPackagerInterfaceMockQuotePackagingResults struct {
+	pa1 []domain.PackagingQuote
+	err error
+}
+
+// OrderPackagerInterfaceMockQuotePackagingOrigins contains origins of expectations of the OrderPackagerInterface.QuotePackaging
+type OrderPackagerInterfaceMockQuotePackagingExpectationOrigins struct {
+	origin      string
+	originOrder string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmQuotePackaging *mOrderPackagerInterfaceMockQuotePackaging) Optional() *mOrderPackagerInterfaceMockQuotePackaging {
+	mmQuotePackaging.optional = true
+	return mmQuotePackaging
+}
+
+// Expect sets up expected params for OrderPackagerInterface.QuotePackaging
+func (mmQuotePackaging *mOrderPackagerInterfaceMockQuotePackaging) Expect(order domain.PVZOrder) *mOrderPackagerInterfaceMockQuotePackaging {
+	if mmQuotePackaging.mock.funcQuotePackaging != nil {
+		mmQuotePackaging.mock.t.Fatalf("OrderPackagerInterfaceMock.QuotePackaging mock is already set by Set")
+	}
+
+	if mmQuotePackaging.defaultExpectation == nil {
+		mmQuotePackaging.defaultExpectation = &OrderPackagerInterfaceMockQuotePackagingExpectation{}
+	}
+
+	if mmQuotePackaging.defaultExpectation.paramPtrs != nil {
+		mmQuotePackaging.mock.t.Fatalf("OrderPackagerInterfaceMock.QuotePackaging mock is already set by ExpectParams functions")
+	}
+
+	mmQuotePackaging.defaultExpectation.params = &OrderPackagerInterfaceMockQuotePackagingParams{order}
+	mmQuotePackaging.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmQuotePackaging.expectations {
+		if minimock.Equal(e.params, mmQuotePackaging.defaultExpectation.params) {
+			mmQuotePackaging.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmQuotePackaging.defaultExpectation.params)
+		}
+	}
+
+	return mmQuotePackaging
+}
+
+// ExpectOrderParam1 sets up expected param order for OrderPackagerInterface.QuotePackaging
+func (mmQuotePackaging *mOrderPackagerInterfaceMockQuotePackaging) ExpectOrderParam1(order domain.PVZOrder) *mOrderPackagerInterfaceMockQuotePackaging {
+	if mmQuotePackaging.mock.funcQuotePackaging != nil {
+		mmQuotePackaging.mock.t.Fatalf("OrderPackagerInterfaceMock.QuotePackaging mock is already set by Set")
+	}
+
+	if mmQuotePackaging.defaultExpectation == nil {
+		mmQuotePackaging.defaultExpectation = &OrderPackagerInterfaceMockQuotePackagingExpectation{}
+	}
+
+	if mmQuotePackaging.defaultExpectation.params != nil {
+		mmQuotePackaging.mock.t.Fatalf("OrderPackagerInterfaceMock.QuotePackaging mock is already set by Expect")
+	}
+
+	if mmQuotePackaging.defaultExpectation.paramPtrs == nil {
+		mmQuotePackaging.defaultExpectation.paramPtrs = &OrderPackagerInterfaceMockQuotePackagingParamPtrs{}
+	}
+	mmQuotePackaging.defaultExpectation.paramPtrs.order = &order
+	mmQuotePackaging.defaultExpectation.expectationOrigins.originOrder = minimock.CallerInfo(1)
+
+	return mmQuotePackaging
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrderPackagerInterface.QuotePackaging
+func (mmQuotePackaging *mOrderPackagerInterfaceMockQuotePackaging) Inspect(f func(order domain.PVZOrder)) *mOrderPackagerInterfaceMockQuotePackaging {
+	if mmQuotePackaging.mock.inspectFuncQuotePackaging != nil {
+		mmQuotePackaging.mock.t.Fatalf("Inspect function is already set for OrderPackagerInterfaceMock.QuotePackaging")
+	}
+
+	mmQuotePackaging.mock.inspectFuncQuotePackaging = f
+
+	return mmQuotePackaging
+}
+
+// Return sets up results that will be returned by OrderPackagerInterface.QuotePackaging
+func (mmQuotePackaging *mOrderPackagerInterfaceMockQuotePackaging) Return(pa1 []domain.PackagingQuote, err error) *OrderPackagerInterfaceMock {
+	if mmQuotePackaging.mock.funcQuotePackaging != nil {
+		mmQuotePackaging.mock.t.Fatalf("OrderPackagerInterfaceMock.QuotePackaging mock is already set by Set")
+	}
+
+	if mmQuotePackaging.defaultExpectation == nil {
+		mmQuotePackaging.defaultExpectation = &OrderPackagerInterfaceMockQuotePackagingExpectation{mock: mmQuotePackaging.mock}
+	}
+	mmQuotePackaging.defaultExpectation.results = &OrderPackagerInterfaceMockQuotePackagingResults{pa1, err}
+	mmQuotePackaging.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmQuotePackaging.mock
+}
+
+// Set uses given function f to mock the OrderPackagerInterface.QuotePackaging method
+func (mmQuotePackaging *mOrderPackagerInterfaceMockQuotePackaging) Set(f func(order domain.PVZOrder) (pa1 []domain.PackagingQuote, err error)) *OrderPackagerInterfaceMock {
+	if mmQuotePackaging.defaultExpectation != nil {
+		mmQuotePackaging.mock.t.Fatalf("Default expectation is already set for the OrderPackagerInterface.QuotePackaging method")
+	}
+
+	if len(mmQuotePackaging.expectations) > 0 {
+		mmQuotePackaging.mock.t.Fatalf("Some expectations are already set for the OrderPackagerInterface.QuotePackaging method")
+	}
+
+	mmQuotePackaging.mock.funcQuotePackaging = f
+	mmQuotePackaging.mock.funcQuotePackagingOrigin = minimock.CallerInfo(1)
+	return mmQuotePackaging.mock
+}
+
+// When sets expectation for the OrderPackagerInterface.QuotePackaging which will trigger the result defined by the following
+// Then helper
+func (mmQuotePackaging *mOrderPackagerInterfaceMockQuotePackaging) When(order domain.PVZOrder) *OrderPackagerInterfaceMockQuotePackagingExpectation {
+	if mmQuotePackaging.mock.funcQuotePackaging != nil {
+		mmQuotePackaging.mock.t.Fatalf("OrderPackagerInterfaceMock.QuotePackaging mock is already set by Set")
+	}
+
+	expectation := &OrderPackagerInterfaceMockQuotePackagingExpectation{
+		mock:               mmQuotePackaging.mock,
+		params:             &OrderPackagerInterfaceMockQuotePackagingParams{order},
+		expectationOrigins: OrderPackagerInterfaceMockQuotePackagingExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmQuotePackaging.expectations = append(mmQuotePackaging.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrderPackagerInterface.QuotePackaging return parameters for the expectation previously defined by the When method
+func (e *OrderPackagerInterfaceMockQuotePackagingExpectation) Then(pa1 []domain.PackagingQuote, err error) *OrderPackagerInterfaceMock {
+	e.results = &OrderPackagerInterfaceMockQuotePackagingResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times OrderPackagerInterface.QuotePackaging should be invoked
+func (mmQuotePackaging *mOrderPackagerInterfaceMockQuotePackaging) Times(n uint64) *mOrderPackagerInterfaceMockQuotePackaging {
+	if n == 0 {
+		mmQuotePackaging.mock.t.Fatalf("Times of OrderPackagerInterfaceMock.QuotePackaging mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmQuotePackaging.expectedInvocations, n)
+	mmQuotePackaging.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmQuotePackaging
+}
+
+func (mmQuotePackaging *mOrderPackagerInterfaceMockQuotePackaging) invocationsDone() bool {
+	if len(mmQuotePackaging.expectations) == 0 && mmQuotePackaging.defaultExpectation == nil && mmQuotePackaging.mock.funcQuotePackaging == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmQuotePackaging.mock.afterQuotePackagingCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmQuotePackaging.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// QuotePackaging implements mm_usecases.OrderPackagerInterface
+func (mmQuotePackaging *OrderPackagerInterfaceMock) QuotePackaging(order domain.PVZOrder) (pa1 []domain.PackagingQuote, err error) {
+	mm_atomic.AddUint64(&mmQuotePackaging.beforeQuotePackagingCounter, 1)
+	defer mm_atomic.AddUint64(&mmQuotePackaging.afterQuotePackagingCounter, 1)
+
+	mmQuotePackaging.t.Helper()
+
+	if mmQuotePackaging.inspectFuncQuotePackaging != nil {
+		mmQuotePackaging.inspectFuncQuotePackaging(order)
+	}
+
+	mm_params := OrderPackagerInterfaceMockQuotePackagingParams{order}
+
+	// Record call args
+	mmQuotePackaging.QuotePackagingMock.mutex.Lock()
+	mmQuotePackaging.QuotePackagingMock.callArgs = append(mmQuotePackaging.QuotePackagingMock.callArgs, &mm_params)
+	mmQuotePackaging.QuotePackagingMock.mutex.Unlock()
+
+	for _, e := range mmQuotePackaging.QuotePackagingMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmQuotePackaging.QuotePackagingMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmQuotePackaging.QuotePackagingMock.defaultExpectation.Counter, 1)
+		mm_want := mmQuotePackaging.QuotePackagingMock.defaultExpectation.params
+		mm_want_ptrs := mmQuotePackaging.QuotePackagingMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderPackagerInterfaceMockQuotePackagingParams{order}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.order != nil && !minimock.Equal(*mm_want_ptrs.order, mm_got.order) {
+				mmQuotePackaging.t.Errorf("OrderPackagerInterfaceMock.QuotePackaging got unexpected parameter order, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmQuotePackaging.QuotePackagingMock.defaultExpectation.expectationOrigins.originOrder, *mm_want_ptrs.order, mm_got.order, minimock.Diff(*mm_want_ptrs.order, mm_got.order))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmQuotePackaging.t.Errorf("OrderPackagerInterfaceMock.QuotePackaging got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmQuotePackaging.QuotePackagingMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmQuotePackaging.QuotePackagingMock.defaultExpectation.results
+		if mm_results == nil {
+			mmQuotePackaging.t.Fatal("No results are set for the OrderPackagerInterfaceMock.QuotePackaging")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmQuotePackaging.funcQuotePackaging != nil {
+		return mmQuotePackaging.funcQuotePackaging(order)
+	}
+	mmQuotePackaging.t.Fatalf("Unexpected call to OrderPackagerInterfaceMock.QuotePackaging. %v", order)
+	return
+}
+
+// QuotePackagingAfterCounter returns a count of finished OrderPackagerInterfaceMock.QuotePackaging invocations
+func (mmQuotePackaging *OrderPackagerInterfaceMock) QuotePackagingAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmQuotePackaging.afterQuotePackagingCounter)
+}
+
+// QuotePackagingBeforeCounter returns a count of OrderPackagerInterfaceMock.QuotePackaging invocations
+func (mmQuotePackaging *OrderPackagerInterfaceMock) QuotePackagingBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmQuotePackaging.beforeQuotePackagingCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderPackagerInterfaceMock.QuotePackaging.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmQuotePackaging *mOrderPackagerInterfaceMockQuotePackaging) Calls() []*OrderPackagerInterfaceMockQuotePackagingParams {
+	mmQuotePackaging.mutex.RLock()
+
+	argCopy := make([]*OrderPackagerInterfaceMockQuotePackagingParams, len(mmQuotePackaging.callArgs))
+	copy(argCopy, mmQuotePackaging.callArgs)
+
+	mmQuotePackaging.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockQuotePackagingDone returns true if the count of the QuotePackaging invocations corresponds
+// the number of defined expectations
+func (m *OrderPackagerInterfaceMock) MinimockQuotePackagingDone() bool {
+	if m.QuotePackagingMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.QuotePackagingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.QuotePackagingMock.invocationsDone()
+}
+
+// MinimockQuotePackagingInspect logs each unmet expectation
+func (m *OrderPackagerInterfaceMock) MinimockQuotePackagingInspect() {
+	for _, e := range m.QuotePackagingMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderPackagerInterfaceMock.QuotePackaging at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterQuotePackagingCounter := mm_atomic.LoadUint64(&m.afterQuotePackagingCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.QuotePackagingMock.defaultExpectation != nil && afterQuotePackagingCounter < 1 {
+		if m.QuotePackagingMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderPackagerInterfaceMock.QuotePackaging at\n%s", m.QuotePackagingMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderPackagerInterfaceMock.QuotePackaging at\n%s with params: %#v", m.QuotePackagingMock.defaultExpectation.expectationOrigins.origin, *m.QuotePackagingMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcQuotePackaging != nil && afterQuotePackagingCounter < 1 {
+		m.t.Errorf("Expected call to OrderPackagerInterfaceMock.QuotePackaging at\n%s", m.funcQuotePackagingOrigin)
+	}
+
+	if !m.QuotePackagingMock.invocationsDone() && afterQuotePackagingCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderPackagerInterfaceMock.QuotePackaging at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.QuotePackagingMock.expectedInvocations), m.QuotePackagingMock.expectedInvocationsOrigin, afterQuotePackagingCounter)
+	}
+}
+
+type mOrderPackagerInterfaceMockValidateCombination struct {
+	optional           bool
+	mock               *OrderPackagerInterfaceMock
+	defaultExpectation *OrderPackagerInterfaceMockValidateCombinationExpectation
+	expectations       []*OrderPackagerInterfaceMockValidateCombinationExpectation
+
+	callArgs []*OrderPackagerInterfaceMockValidateCombinationParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrderPackagerInterfaceMockValidateCombinationExpectation specifies expectation struct of the OrderPackagerInterface.ValidateCombination
+type OrderPackagerInterfaceMockValidateCombinationExpectation struct {
+	mock               *OrderPackagerInterfaceMock
+	params             *OrderPackagerInterfaceMockValidateCombinationParams
+	paramPtrs          *OrderPackagerInterfaceMockValidateCombinationParamPtrs
+	expectationOrigins OrderPackagerInterfaceMockValidateCombinationExpectationOrigins
+	results            *OrderPackagerInterfaceMockValidateCombinationResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrderPackagerInterfaceMockValidateCombinationParams contains parameters of the OrderPackagerInterface.ValidateCombination
+type OrderPackagerInterfaceMockValidateCombinationParams struct {
+	base       domain.PackagingType
+	additional domain.PackagingType
+}
+
+// OrderPackagerInterfaceMockValidateCombinationParamPtrs contains pointers to parameters of the OrderPackagerInterface.ValidateCombination
+type OrderPackagerInterfaceMockValidateCombinationParamPtrs struct {
+	base       *domain.PackagingType
+	additional *domain.PackagingType
+}
+
+// OrderPackagerInterfaceMockValidateCombinationResults contains results of the OrderPackagerInterface.ValidateCombination
+type OrderPackagerInterfaceMockValidateCombinationResults struct {
+	err error
+}
+
+// OrderPackagerInterfaceMockValidateCombinationOrigins contains origins of expectations of the OrderPackagerInterface.ValidateCombination
+type OrderPackagerInterfaceMockValidateCombinationExpectationOrigins struct {
+	origin           string
+	originBase       string
+	originAdditional string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmValidateCombination *mOrderPackagerInterfaceMockValidateCombination) Optional() *mOrderPackagerInterfaceMockValidateCombination {
+	mmValidateCombination.optional = true
+	return mmValidateCombination
+}
+
+// Expect sets up expected params for OrderPackagerInterface.ValidateCombination
+func (mmValidateCombination *mOrderPackagerInterfaceMockValidateCombination) Expect(base domain.PackagingType, additional domain.PackagingType) *mOrderPackagerInterfaceMockValidateCombination {
+	if mmValidateCombination.mock.funcValidateCombination != nil {
+		mmValidateCombination.mock.t.Fatalf("OrderPackagerInterfaceMock.ValidateCombination mock is already set by Set")
+	}
+
+	if mmValidateCombination.defaultExpectation == nil {
+		mmValidateCombination.defaultExpectation = &OrderPackagerInterfaceMockValidateCombinationExpectation{}
+	}
+
+	if mmValidateCombination.defaultExpectation.paramPtrs != nil {
+		mmValidateCombination.mock.t.Fatalf("OrderPackagerInterfaceMock.ValidateCombination mock is already set by ExpectParams functions")
+	}
+
+	mmValidateCombination.defaultExpectation.params = &OrderPackagerInterfaceMockValidateCombinationParams{base, additional}
+	mmValidateCombination.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmValidateCombination.expectations {
+		if minimock.Equal(e.params, mmValidateCombination.defaultExpectation.params) {
+			mmValidateCombination.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmValidateCombination.defaultExpectation.params)
+		}
+	}
+
+	return mmValidateCombination
+}
+
+// ExpectBaseParam1 sets up expected param base for OrderPackagerInterface.ValidateCombination
+func (mmValidateCombination *mOrderPackagerInterfaceMockValidateCombination) ExpectBaseParam1(base domain.PackagingType) *mOrderPackagerInterfaceMockValidateCombination {
+	if mmValidateCombination.mock.funcValidateCombination != nil {
+		mmValidateCombination.mock.t.Fatalf("OrderPackagerInterfaceMock.ValidateCombination mock is already set by Set")
+	}
+
+	if mmValidateCombination.defaultExpectation == nil {
+		mmValidateCombination.defaultExpectation = &OrderPackagerInterfaceMockValidateCombinationExpectation{}
+	}
+
+	if mmValidateCombination.defaultExpectation.params != nil {
+		mmValidateCombination.mock.t.Fatalf("OrderPackagerInterfaceMock.ValidateCombination mock is already set by Expect")
+	}
+
+	if mmValidateCombination.defaultExpectation.paramPtrs == nil {
+		mmValidateCombination.defaultExpectation.paramPtrs = &OrderPackagerInterfaceMockValidateCombinationParamPtrs{}
+	}
+	mmValidateCombination.defaultExpectation.paramPtrs.base = &base
+	mmValidateCombination.defaultExpectation.expectationOrigins.originBase = minimock.CallerInfo(1)
+
+	return mmValidateCombination
+}
+
+// ExpectAdditionalParam2 sets up expected param additional for OrderPackagerInterface.ValidateCombination
+func (mmValidateCombination *mOrderPackagerInterfaceMockValidateCombination) ExpectAdditionalParam2(additional domain.PackagingType) *mOrderPackagerInterfaceMockValidateCombination {
+	if mmValidateCombination.mock.funcValidateCombination != nil {
+		mmValidateCombination.mock.t.Fatalf("OrderPackagerInterfaceMock.ValidateCombination mock is already set by Set")
+	}
+
+	if mmValidateCombination.defaultExpectation == nil {
+		mmValidateCombination.defaultExpectation = &OrderPackagerInterfaceMockValidateCombinationExpectation{}
+	}
+
+	if mmValidateCombination.defaultExpectation.params != nil {
+		mmValidateCombination.mock.t.Fatalf("OrderPackagerInterfaceMock.ValidateCombination mock is already set by Expect")
+	}
+
+	if mmValidateCombination.defaultExpectation.paramPtrs == nil {
+		mmValidateCombination.defaultExpectation.paramPtrs = &OrderPackagerInterfaceMockValidateCombinationParamPtrs{}
+	}
+	mmValidateCombination.defaultExpectation.paramPtrs.additional = &additional
+	mmValidateCombination.defaultExpectation.expectationOrigins.originAdditional = minimock.CallerInfo(1)
+
+	return mmValidateCombination
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrderPackagerInterface.ValidateCombination
+func (mmValidateCombination *mOrderPackagerInterfaceMockValidateCombination) Inspect(f func(base domain.PackagingType, additional domain.PackagingType)) *mOrderPackagerInterfaceMockValidateCombination {
+	if mmValidateCombination.mock.inspectFuncValidateCombination != nil {
+		mmValidateCombination.mock.t.Fatalf("Inspect function is already set for OrderPackagerInterfaceMock.ValidateCombination")
+	}
+
+	mmValidateCombination.mock.inspectFuncValidateCombination = f
+
+	return mmValidateCombination
+}
+
+// Return sets up results that will be returned by OrderPackagerInterface.ValidateCombination
+func (mmValidateCombination *mOrderPackagerInterfaceMockValidateCombination) Return(err error) *OrderPackagerInterfaceMock {
+	if mmValidateCombination.mock.funcValidateCombination != nil {
+		mmValidateCombination.mock.t.Fatalf("OrderPackagerInterfaceMock.ValidateCombination mock is already set by Set")
+	}
+
+	if mmValidateCombination.defaultExpectation == nil {
+		mmValidateCombination.defaultExpectation = &OrderPackagerInterfaceMockValidateCombinationExpectation{mock: mmValidateCombination.mock}
+	}
+	mmValidateCombination.defaultExpectation.results = &OrderPackagerInterfaceMockValidateCombinationResults{err}
+	mmValidateCombination.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmValidateCombination.mock
+}
+
+// Set uses given function f to mock the OrderPackagerInterface.ValidateCombination method
+func (mmValidateCombination *mOrderPackagerInterfaceMockValidateCombination) Set(f func(base domain.PackagingType, additional domain.PackagingType) (err error)) *OrderPackagerInterfaceMock {
+	if mmValidateCombination.defaultExpectation != nil {
+		mmValidateCombination.mock.t.Fatalf("Default expectation is already set for the OrderPackagerInterface.ValidateCombination method")
+	}
+
+	if len(mmValidateCombination.expectations) > 0 {
+		mmValidateCombination.mock.t.Fatalf("Some expectations are already set for the OrderPackagerInterface.ValidateCombination method")
+	}
+
+	mmValidateCombination.mock.funcValidateCombination = f
+	mmValidateCombination.mock.funcValidateCombinationOrigin = minimock.CallerInfo(1)
+	return mmValidateCombination.mock
+}
+
+// When sets expectation for the OrderPackagerInterface.ValidateCombination which will trigger the result defined by the following
+// Then helper
+func (mmValidateCombination *mOrderPackagerInterfaceMockValidateCombination) When(base domain.PackagingType, additional domain.PackagingType) *OrderPackagerInterfaceMockValidateCombinationExpectation {
+	if mmValidateCombination.mock.funcValidateCombination != nil {
+		mmValidateCombination.mock.t.Fatalf("OrderPackagerInterfaceMock.ValidateCombination mock is already set by Set")
+	}
+
+	expectation := &OrderPackagerInterfaceMockValidateCombinationExpectation{
+		mock:               mmValidateCombination.mock,
+		params:             &OrderPackagerInterfaceMockValidateCombinationParams{base, additional},
+		expectationOrigins: OrderPackagerInterfaceMockValidateCombinationExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmValidateCombination.expectations = append(mmValidateCombination.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrderPackagerInterface.ValidateCombination return parameters for the expectation previously defined by the When method
+func (e *OrderPackagerInterfaceMockValidateCombinationExpectation) Then(err error) *OrderPackagerInterfaceMock {
+	e.results = &OrderPackagerInterfaceMockValidateCombinationResults{err}
+	return e.mock
+}
+
+// Times sets number of times OrderPackagerInterface.ValidateCombination should be invoked
+func (mmValidateCombination *mOrderPackagerInterfaceMockValidateCombination) Times(n uint64) *mOrderPackagerInterfaceMockValidateCombination {
+	if n == 0 {
+		mmValidateCombination.mock.t.Fatalf("Times of OrderPackagerInterfaceMock.ValidateCombination mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmValidateCombination.expectedInvocations, n)
+	mmValidateCombination.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmValidateCombination
+}
+
+func (mmValidateCombination *mOrderPackagerInterfaceMockValidateCombination) invocationsDone() bool {
+	if len(mmValidateCombination.expectations) == 0 && mmValidateCombination.defaultExpectation == nil && mmValidateCombination.mock.funcValidateCombination == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmValidateCombination.mock.afterValidateCombinationCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmValidateCombination.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ValidateCombination implements mm_usecases.OrderPackagerInterface
+func (mmValidateCombination *OrderPackagerInterfaceMock) ValidateCombination(base domain.PackagingType, additional domain.PackagingType) (err error) {
+	mm_atomic.AddUint64(&mmValidateCombination.beforeValidateCombinationCounter, 1)
+	defer mm_atomic.AddUint64(&mmValidateCombination.afterValidateCombinationCounter, 1)
+
+	mmValidateCombination.t.Helper()
+
+	if mmValidateCombination.inspectFuncValidateCombination != nil {
+		mmValidateCombination.inspectFuncValidateCombination(base, additional)
+	}
+
+	mm_params := OrderPackagerInterfaceMockValidateCombinationParams{base, additional}
+
+	// Record call args
+	mmValidateCombination.ValidateCombinationMock.mutex.Lock()
+	mmValidateCombination.ValidateCombinationMock.callArgs = append(mmValidateCombination.ValidateCombinationMock.callArgs, &mm_params)
+	mmValidateCombination.ValidateCombinationMock.mutex.Unlock()
+
+	for _, e := range mmValidateCombination.ValidateCombinationMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmValidateCombination.ValidateCombinationMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmValidateCombination.ValidateCombinationMock.defaultExpectation.Counter, 1)
+		mm_want := mmValidateCombination.ValidateCombinationMock.defaultExpectation.params
+		mm_want_ptrs := mmValidateCombination.ValidateCombinationMock.defaultExpectation.paramPtrs
+
+		mm_got := OrderPackagerInterfaceMockValidateCombinationParams{base, additional}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.base != nil && !minimock.Equal(*mm_want_ptrs.base, mm_got.base) {
+				mmValidateCombination.t.Errorf("OrderPackagerInterfaceMock.ValidateCombination got unexpected parameter base, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateCombination.ValidateCombinationMock.defaultExpectation.expectationOrigins.originBase, *mm_want_ptrs.base, mm_got.base, minimock.Diff(*mm_want_ptrs.base, mm_got.base))
+			}
+
+			if mm_want_ptrs.additional != nil && !minimock.Equal(*mm_want_ptrs.additional, mm_got.additional) {
+				mmValidateCombination.t.Errorf("OrderPackagerInterfaceMock.ValidateCombination got unexpected parameter additional, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmValidateCombination.ValidateCombinationMock.defaultExpectation.expectationOrigins.originAdditional, *mm_want_ptrs.additional, mm_got.additional, minimock.Diff(*mm_want_ptrs.additional, mm_got.additional))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmValidateCombination.t.Errorf("OrderPackagerInterfaceMock.ValidateCombination got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmValidateCombination.ValidateCombinationMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmValidateCombination.ValidateCombinationMock.defaultExpectation.results
+		if mm_results == nil {
+			mmValidateCombination.t.Fatal("No results are set for the OrderPackagerInterfaceMock.ValidateCombination")
+		}
+		return (*mm_results).err
+	}
+	if mmValidateCombination.funcValidateCombination != nil {
+		return mmValidateCombination.funcValidateCombination(base, additional)
+	}
+	mmValidateCombination.t.Fatalf("Unexpected call to OrderPackagerInterfaceMock.ValidateCombination. %v %v", base, additional)
+	return
+}
+
+// ValidateCombinationAfterCounter returns a count of finished OrderPackagerInterfaceMock.ValidateCombination invocations
+func (mmValidateCombination *OrderPackagerInterfaceMock) ValidateCombinationAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateCombination.afterValidateCombinationCounter)
+}
+
+// ValidateCombinationBeforeCounter returns a count of OrderPackagerInterfaceMock.ValidateCombination invocations
+func (mmValidateCombination *OrderPackagerInterfaceMock) ValidateCombinationBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmValidateCombination.beforeValidateCombinationCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrderPackagerInterfaceMock.ValidateCombination.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmValidateCombination *mOrderPackagerInterfaceMockValidateCombination) Calls() []*OrderPackagerInterfaceMockValidateCombinationParams {
+	mmValidateCombination.mutex.RLock()
+
+	argCopy := make([]*OrderPackagerInterfaceMockValidateCombinationParams, len(mmValidateCombination.callArgs))
+	copy(argCopy, mmValidateCombination.callArgs)
+
+	mmValidateCombination.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockValidateCombinationDone returns true if the count of the ValidateCombination invocations corresponds
+// the number of defined expectations
+func (m *OrderPackagerInterfaceMock) MinimockValidateCombinationDone() bool {
+	if m.ValidateCombinationMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ValidateCombinationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ValidateCombinationMock.invocationsDone()
+}
+
+// MinimockValidateCombinationInspect logs each unmet expectation
+func (m *OrderPackagerInterfaceMock) MinimockValidateCombinationInspect() {
+	for _, e := range m.ValidateCombinationMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrderPackagerInterfaceMock.ValidateCombination at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterValidateCombinationCounter := mm_atomic.LoadUint64(&m.afterValidateCombinationCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ValidateCombinationMock.defaultExpectation != nil && afterValidateCombinationCounter < 1 {
+		if m.ValidateCombinationMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrderPackagerInterfaceMock.ValidateCombination at\n%s", m.ValidateCombinationMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrderPackagerInterfaceMock.ValidateCombination at\n%s with params: %#v", m.ValidateCombinationMock.defaultExpectation.expectationOrigins.origin, *m.ValidateCombinationMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcValidateCombination != nil && afterValidateCombinationCounter < 1 {
+		m.t.Errorf("Expected call to OrderPackagerInterfaceMock.ValidateCombination at\n%s", m.funcValidateCombinationOrigin)
+	}
+
+	if !m.ValidateCombinationMock.invocationsDone() && afterValidateCombinationCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrderPackagerInterfaceMock.ValidateCombination at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ValidateCombinationMock.expectedInvocations), m.ValidateCombinationMock.expectedInvocationsOrigin, afterValidateCombinationCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *OrderPackagerInterfaceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
 			m.MinimockPackageOrderInspect()
+
+			m.MinimockQuotePackagingInspect()
+
+			m.MinimockValidateCombinationInspect()
 		}
 	})
 }
@@ -412,5 +1090,7 @@ func (m *OrderPackagerInterfaceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *OrderPackagerInterfaceMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockPackageOrderDone()
+		m.MinimockPackageOrderDone() &&
+		m.MinimockQuotePackagingDone() &&
+		m.MinimockValidateCombinationDone()
 }
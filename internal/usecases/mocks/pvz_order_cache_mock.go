@@ -33,9 +33,9 @@ type PVZOrderCacheMock struct {
 	beforeGetOrdersCounter uint64
 	GetOrdersMock          mPVZOrderCacheMockGetOrders
 
-	funcGetReturns          func(ctx context.Context, options ...abstractions.PagePaginationOptFunc) (pa1 []domain.PVZOrder, e1 error, b1 bool)
+	funcGetReturns          func(ctx context.Context, pvzID string, options ...abstractions.PagePaginationOptFunc) (pa1 []domain.PVZOrder, e1 error, b1 bool)
 	funcGetReturnsOrigin    string
-	inspectFuncGetReturns   func(ctx context.Context, options ...abstractions.PagePaginationOptFunc)
+	inspectFuncGetReturns   func(ctx context.Context, pvzID string, options ...abstractions.PagePaginationOptFunc)
 	afterGetReturnsCounter  uint64
 	beforeGetReturnsCounter uint64
 	GetReturnsMock          mPVZOrderCacheMockGetReturns
@@ -47,9 +47,9 @@ type PVZOrderCacheMock struct {
 	beforeSetGetOrdersCounter uint64
 	SetGetOrdersMock          mPVZOrderCacheMockSetGetOrders
 
-	funcSetGetReturns          func(ctx context.Context, orders []domain.PVZOrder, options ...abstractions.PagePaginationOptFunc) (err error)
+	funcSetGetReturns          func(ctx context.Context, pvzID string, orders []domain.PVZOrder, options ...abstractions.PagePaginationOptFunc) (err error)
 	funcSetGetReturnsOrigin    string
-	inspectFuncSetGetReturns   func(ctx context.Context, orders []domain.PVZOrder, options ...abstractions.PagePaginationOptFunc)
+	inspectFuncSetGetReturns   func(ctx context.Context, pvzID string, orders []domain.PVZOrder, options ...abstractions.PagePaginationOptFunc)
 	afterSetGetReturnsCounter  uint64
 	beforeSetGetReturnsCounter uint64
 	SetGetReturnsMock          mPVZOrderCacheMockSetGetReturns
@@ -839,12 +839,14 @@ type PVZOrderCacheMockGetReturnsExpectation struct {
 // PVZOrderCacheMockGetReturnsParams contains parameters of the PVZOrderCache.GetReturns
 type PVZOrderCacheMockGetReturnsParams struct {
 	ctx     context.Context
+	pvzID   string
 	options []abstractions.PagePaginationOptFunc
 }
 
 // PVZOrderCacheMockGetReturnsParamPtrs contains pointers to parameters of the PVZOrderCache.GetReturns
 type PVZOrderCacheMockGetReturnsParamPtrs struct {
 	ctx     *context.Context
+	pvzID   *string
 	options *[]abstractions.PagePaginationOptFunc
 }
 
@@ -859,6 +861,7 @@ type PVZOrderCacheMockGetReturnsResults struct {
 type PVZOrderCacheMockGetReturnsExpectationOrigins struct {
 	origin        string
 	originCtx     string
+	originPvzID   string
 	originOptions string
 }
 
@@ -873,7 +876,7 @@ func (mmGetReturns *mPVZOrderCacheMockGetReturns) Optional() *mPVZOrderCacheMock
 }
 
 // Expect sets up expected params for PVZOrderCache.GetReturns
-func (mmGetReturns *mPVZOrderCacheMockGetReturns) Expect(ctx context.Context, options ...abstractions.PagePaginationOptFunc) *mPVZOrderCacheMockGetReturns {
+func (mmGetReturns *mPVZOrderCacheMockGetReturns) Expect(ctx context.Context, pvzID string, options ...abstractions.PagePaginationOptFunc) *mPVZOrderCacheMockGetReturns {
 	if mmGetReturns.mock.funcGetReturns != nil {
 		mmGetReturns.mock.t.Fatalf("PVZOrderCacheMock.GetReturns mock is already set by Set")
 	}
@@ -886,7 +889,7 @@ func (mmGetReturns *mPVZOrderCacheMockGetReturns) Expect(ctx context.Context, op
 		mmGetReturns.mock.t.Fatalf("PVZOrderCacheMock.GetReturns mock is already set by ExpectParams functions")
 	}
 
-	mmGetReturns.defaultExpectation.params = &PVZOrderCacheMockGetReturnsParams{ctx, options}
+	mmGetReturns.defaultExpectation.params = &PVZOrderCacheMockGetReturnsParams{ctx, pvzID, options}
 	mmGetReturns.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmGetReturns.expectations {
 		if minimock.Equal(e.params, mmGetReturns.defaultExpectation.params) {
@@ -920,8 +923,31 @@ func (mmGetReturns *mPVZOrderCacheMockGetReturns) ExpectCtxParam1(ctx context.Co
 	return mmGetReturns
 }
 
-// ExpectOptionsParam2 sets up expected param options for PVZOrderCache.GetReturns
-func (mmGetReturns *mPVZOrderCacheMockGetReturns) ExpectOptionsParam2(options ...abstractions.PagePaginationOptFunc) *mPVZOrderCacheMockGetReturns {
+// ExpectPvzIDParam2 sets up expected param pvzID for PVZOrderCache.GetReturns
+func (mmGetReturns *mPVZOrderCacheMockGetReturns) ExpectPvzIDParam2(pvzID string) *mPVZOrderCacheMockGetReturns {
+	if mmGetReturns.mock.funcGetReturns != nil {
+		mmGetReturns.mock.t.Fatalf("PVZOrderCacheMock.GetReturns mock is already set by Set")
+	}
+
+	if mmGetReturns.defaultExpectation == nil {
+		mmGetReturns.defaultExpectation = &PVZOrderCacheMockGetReturnsExpectation{}
+	}
+
+	if mmGetReturns.defaultExpectation.params != nil {
+		mmGetReturns.mock.t.Fatalf("PVZOrderCacheMock.GetReturns mock is already set by Expect")
+	}
+
+	if mmGetReturns.defaultExpectation.paramPtrs == nil {
+		mmGetReturns.defaultExpectation.paramPtrs = &PVZOrderCacheMockGetReturnsParamPtrs{}
+	}
+	mmGetReturns.defaultExpectation.paramPtrs.pvzID = &pvzID
+	mmGetReturns.defaultExpectation.expectationOrigins.originPvzID = minimock.CallerInfo(1)
+
+	return mmGetReturns
+}
+
+// ExpectOptionsParam3 sets up expected param options for PVZOrderCache.GetReturns
+func (mmGetReturns *mPVZOrderCacheMockGetReturns) ExpectOptionsParam3(options ...abstractions.PagePaginationOptFunc) *mPVZOrderCacheMockGetReturns {
 	if mmGetReturns.mock.funcGetReturns != nil {
 		mmGetReturns.mock.t.Fatalf("PVZOrderCacheMock.GetReturns mock is already set by Set")
 	}
@@ -944,7 +970,7 @@ func (mmGetReturns *mPVZOrderCacheMockGetReturns) ExpectOptionsParam2(options ..
 }
 
 // Inspect accepts an inspector function that has same arguments as the PVZOrderCache.GetReturns
-func (mmGetReturns *mPVZOrderCacheMockGetReturns) Inspect(f func(ctx context.Context, options ...abstractions.PagePaginationOptFunc)) *mPVZOrderCacheMockGetReturns {
+func (mmGetReturns *mPVZOrderCacheMockGetReturns) Inspect(f func(ctx context.Context, pvzID string, options ...abstractions.PagePaginationOptFunc)) *mPVZOrderCacheMockGetReturns {
 	if mmGetReturns.mock.inspectFuncGetReturns != nil {
 		mmGetReturns.mock.t.Fatalf("Inspect function is already set for PVZOrderCacheMock.GetReturns")
 	}
@@ -969,7 +995,7 @@ func (mmGetReturns *mPVZOrderCacheMockGetReturns) Return(pa1 []domain.PVZOrder,
 }
 
 // Set uses given function f to mock the PVZOrderCache.GetReturns method
-func (mmGetReturns *mPVZOrderCacheMockGetReturns) Set(f func(ctx context.Context, options ...abstractions.PagePaginationOptFunc) (pa1 []domain.PVZOrder, e1 error, b1 bool)) *PVZOrderCacheMock {
+func (mmGetReturns *mPVZOrderCacheMockGetReturns) Set(f func(ctx context.Context, pvzID string, options ...abstractions.PagePaginationOptFunc) (pa1 []domain.PVZOrder, e1 error, b1 bool)) *PVZOrderCacheMock {
 	if mmGetReturns.defaultExpectation != nil {
 		mmGetReturns.mock.t.Fatalf("Default expectation is already set for the PVZOrderCache.GetReturns method")
 	}
@@ -985,14 +1011,14 @@ func (mmGetReturns *mPVZOrderCacheMockGetReturns) Set(f func(ctx context.Context
 
 // When sets expectation for the PVZOrderCache.GetReturns which will trigger the result defined by the following
 // Then helper
-func (mmGetReturns *mPVZOrderCacheMockGetReturns) When(ctx context.Context, options ...abstractions.PagePaginationOptFunc) *PVZOrderCacheMockGetReturnsExpectation {
+func (mmGetReturns *mPVZOrderCacheMockGetReturns) When(ctx context.Context, pvzID string, options ...abstractions.PagePaginationOptFunc) *PVZOrderCacheMockGetReturnsExpectation {
 	if mmGetReturns.mock.funcGetReturns != nil {
 		mmGetReturns.mock.t.Fatalf("PVZOrderCacheMock.GetReturns mock is already set by Set")
 	}
 
 	expectation := &PVZOrderCacheMockGetReturnsExpectation{
 		mock:               mmGetReturns.mock,
-		params:             &PVZOrderCacheMockGetReturnsParams{ctx, options},
+		params:             &PVZOrderCacheMockGetReturnsParams{ctx, pvzID, options},
 		expectationOrigins: PVZOrderCacheMockGetReturnsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmGetReturns.expectations = append(mmGetReturns.expectations, expectation)
@@ -1027,17 +1053,17 @@ func (mmGetReturns *mPVZOrderCacheMockGetReturns) invocationsDone() bool {
 }
 
 // GetReturns implements mm_usecases.PVZOrderCache
-func (mmGetReturns *PVZOrderCacheMock) GetReturns(ctx context.Context, options ...abstractions.PagePaginationOptFunc) (pa1 []domain.PVZOrder, e1 error, b1 bool) {
+func (mmGetReturns *PVZOrderCacheMock) GetReturns(ctx context.Context, pvzID string, options ...abstractions.PagePaginationOptFunc) (pa1 []domain.PVZOrder, e1 error, b1 bool) {
 	mm_atomic.AddUint64(&mmGetReturns.beforeGetReturnsCounter, 1)
 	defer mm_atomic.AddUint64(&mmGetReturns.afterGetReturnsCounter, 1)
 
 	mmGetReturns.t.Helper()
 
 	if mmGetReturns.inspectFuncGetReturns != nil {
-		mmGetReturns.inspectFuncGetReturns(ctx, options...)
+		mmGetReturns.inspectFuncGetReturns(ctx, pvzID, options...)
 	}
 
-	mm_params := PVZOrderCacheMockGetReturnsParams{ctx, options}
+	mm_params := PVZOrderCacheMockGetReturnsParams{ctx, pvzID, options}
 
 	// Record call args
 	mmGetReturns.GetReturnsMock.mutex.Lock()
@@ -1056,7 +1082,7 @@ func (mmGetReturns *PVZOrderCacheMock) GetReturns(ctx context.Context, options .
 		mm_want := mmGetReturns.GetReturnsMock.defaultExpectation.params
 		mm_want_ptrs := mmGetReturns.GetReturnsMock.defaultExpectation.paramPtrs
 
-		mm_got := PVZOrderCacheMockGetReturnsParams{ctx, options}
+		mm_got := PVZOrderCacheMockGetReturnsParams{ctx, pvzID, options}
 
 		if mm_want_ptrs != nil {
 
@@ -1065,6 +1091,11 @@ func (mmGetReturns *PVZOrderCacheMock) GetReturns(ctx context.Context, options .
 					mmGetReturns.GetReturnsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
+			if mm_want_ptrs.pvzID != nil && !minimock.Equal(*mm_want_ptrs.pvzID, mm_got.pvzID) {
+				mmGetReturns.t.Errorf("PVZOrderCacheMock.GetReturns got unexpected parameter pvzID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReturns.GetReturnsMock.defaultExpectation.expectationOrigins.originPvzID, *mm_want_ptrs.pvzID, mm_got.pvzID, minimock.Diff(*mm_want_ptrs.pvzID, mm_got.pvzID))
+			}
+
 			if mm_want_ptrs.options != nil && !minimock.Equal(*mm_want_ptrs.options, mm_got.options) {
 				mmGetReturns.t.Errorf("PVZOrderCacheMock.GetReturns got unexpected parameter options, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 					mmGetReturns.GetReturnsMock.defaultExpectation.expectationOrigins.originOptions, *mm_want_ptrs.options, mm_got.options, minimock.Diff(*mm_want_ptrs.options, mm_got.options))
@@ -1082,9 +1113,9 @@ func (mmGetReturns *PVZOrderCacheMock) GetReturns(ctx context.Context, options .
 		return (*mm_results).pa1, (*mm_results).e1, (*mm_results).b1
 	}
 	if mmGetReturns.funcGetReturns != nil {
-		return mmGetReturns.funcGetReturns(ctx, options...)
+		return mmGetReturns.funcGetReturns(ctx, pvzID, options...)
 	}
-	mmGetReturns.t.Fatalf("Unexpected call to PVZOrderCacheMock.GetReturns. %v %v", ctx, options)
+	mmGetReturns.t.Fatalf("Unexpected call to PVZOrderCacheMock.GetReturns. %v %v %v", ctx, pvzID, options)
 	return
 }
 
@@ -1587,6 +1618,7 @@ type PVZOrderCacheMockSetGetReturnsExpectation struct {
 // PVZOrderCacheMockSetGetReturnsParams contains parameters of the PVZOrderCache.SetGetReturns
 type PVZOrderCacheMockSetGetReturnsParams struct {
 	ctx     context.Context
+	pvzID   string
 	orders  []domain.PVZOrder
 	options []abstractions.PagePaginationOptFunc
 }
@@ -1594,6 +1626,7 @@ type PVZOrderCacheMockSetGetReturnsParams struct {
 // PVZOrderCacheMockSetGetReturnsParamPtrs contains pointers to parameters of the PVZOrderCache.SetGetReturns
 type PVZOrderCacheMockSetGetReturnsParamPtrs struct {
 	ctx     *context.Context
+	pvzID   *string
 	orders  *[]domain.PVZOrder
 	options *[]abstractions.PagePaginationOptFunc
 }
@@ -1607,6 +1640,7 @@ type PVZOrderCacheMockSetGetReturnsResults struct {
 type PVZOrderCacheMockSetGetReturnsExpectationOrigins struct {
 	origin        string
 	originCtx     string
+	originPvzID   string
 	originOrders  string
 	originOptions string
 }
@@ -1622,7 +1656,7 @@ func (mmSetGetReturns *mPVZOrderCacheMockSetGetReturns) Optional() *mPVZOrderCac
 }
 
 // Expect sets up expected params for PVZOrderCache.SetGetReturns
-func (mmSetGetReturns *mPVZOrderCacheMockSetGetReturns) Expect(ctx context.Context, orders []domain.PVZOrder, options ...abstractions.PagePaginationOptFunc) *mPVZOrderCacheMockSetGetReturns {
+func (mmSetGetReturns *mPVZOrderCacheMockSetGetReturns) Expect(ctx context.Context, pvzID string, orders []domain.PVZOrder, options ...abstractions.PagePaginationOptFunc) *mPVZOrderCacheMockSetGetReturns {
 	if mmSetGetReturns.mock.funcSetGetReturns != nil {
 		mmSetGetReturns.mock.t.Fatalf("PVZOrderCacheMock.SetGetReturns mock is already set by Set")
 	}
@@ -1635,7 +1669,7 @@ func (mmSetGetReturns *mPVZOrderCacheMockSetGetReturns) Expect(ctx context.Conte
 		mmSetGetReturns.mock.t.Fatalf("PVZOrderCacheMock.SetGetReturns mock is already set by ExpectParams functions")
 	}
 
-	mmSetGetReturns.defaultExpectation.params = &PVZOrderCacheMockSetGetReturnsParams{ctx, orders, options}
+	mmSetGetReturns.defaultExpectation.params = &PVZOrderCacheMockSetGetReturnsParams{ctx, pvzID, orders, options}
 	mmSetGetReturns.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmSetGetReturns.expectations {
 		if minimock.Equal(e.params, mmSetGetReturns.defaultExpectation.params) {
@@ -1669,8 +1703,31 @@ func (mmSetGetReturns *mPVZOrderCacheMockSetGetReturns) ExpectCtxParam1(ctx cont
 	return mmSetGetReturns
 }
 
-// ExpectOrdersParam2 sets up expected param orders for PVZOrderCache.SetGetReturns
-func (mmSetGetReturns *mPVZOrderCacheMockSetGetReturns) ExpectOrdersParam2(orders []domain.PVZOrder) *mPVZOrderCacheMockSetGetReturns {
+// ExpectPvzIDParam2 sets up expected param pvzID for PVZOrderCache.SetGetReturns
+func (mmSetGetReturns *mPVZOrderCacheMockSetGetReturns) ExpectPvzIDParam2(pvzID string) *mPVZOrderCacheMockSetGetReturns {
+	if mmSetGetReturns.mock.funcSetGetReturns != nil {
+		mmSetGetReturns.mock.t.Fatalf("PVZOrderCacheMock.SetGetReturns mock is already set by Set")
+	}
+
+	if mmSetGetReturns.defaultExpectation == nil {
+		mmSetGetReturns.defaultExpectation = &PVZOrderCacheMockSetGetReturnsExpectation{}
+	}
+
+	if mmSetGetReturns.defaultExpectation.params != nil {
+		mmSetGetReturns.mock.t.Fatalf("PVZOrderCacheMock.SetGetReturns mock is already set by Expect")
+	}
+
+	if mmSetGetReturns.defaultExpectation.paramPtrs == nil {
+		mmSetGetReturns.defaultExpectation.paramPtrs = &PVZOrderCacheMockSetGetReturnsParamPtrs{}
+	}
+	mmSetGetReturns.defaultExpectation.paramPtrs.pvzID = &pvzID
+	mmSetGetReturns.defaultExpectation.expectationOrigins.originPvzID = minimock.CallerInfo(1)
+
+	return mmSetGetReturns
+}
+
+// ExpectOrdersParam3 sets up expected param orders for PVZOrderCache.SetGetReturns
+func (mmSetGetReturns *mPVZOrderCacheMockSetGetReturns) ExpectOrdersParam3(orders []domain.PVZOrder) *mPVZOrderCacheMockSetGetReturns {
 	if mmSetGetReturns.mock.funcSetGetReturns != nil {
 		mmSetGetReturns.mock.t.Fatalf("PVZOrderCacheMock.SetGetReturns mock is already set by Set")
 	}
@@ -1692,8 +1749,8 @@ func (mmSetGetReturns *mPVZOrderCacheMockSetGetReturns) ExpectOrdersParam2(order
 	return mmSetGetReturns
 }
 
-// ExpectOptionsParam3 sets up expected param options for PVZOrderCache.SetGetReturns
-func (mmSetGetReturns *mPVZOrderCacheMockSetGetReturns) ExpectOptionsParam3(options ...abstractions.PagePaginationOptFunc) *mPVZOrderCacheMockSetGetReturns {
+// ExpectOptionsParam4 sets up expected param options for PVZOrderCache.SetGetReturns
+func (mmSetGetReturns *mPVZOrderCacheMockSetGetReturns) ExpectOptionsParam4(options ...abstractions.PagePaginationOptFunc) *mPVZOrderCacheMockSetGetReturns {
 	if mmSetGetReturns.mock.funcSetGetReturns != nil {
 		mmSetGetReturns.mock.t.Fatalf("PVZOrderCacheMock.SetGetReturns mock is already set by Set")
 	}
@@ -1716,7 +1773,7 @@ func (mmSetGetReturns *mPVZOrderCacheMockSetGetReturns) ExpectOptionsParam3(opti
 }
 
 // Inspect accepts an inspector function that has same arguments as the PVZOrderCache.SetGetReturns
-func (mmSetGetReturns *mPVZOrderCacheMockSetGetReturns) Inspect(f func(ctx context.Context, orders []domain.PVZOrder, options ...abstractions.PagePaginationOptFunc)) *mPVZOrderCacheMockSetGetReturns {
+func (mmSetGetReturns *mPVZOrderCacheMockSetGetReturns) Inspect(f func(ctx context.Context, pvzID string, orders []domain.PVZOrder, options ...abstractions.PagePaginationOptFunc)) *mPVZOrderCacheMockSetGetReturns {
 	if mmSetGetReturns.mock.inspectFuncSetGetReturns != nil {
 		mmSetGetReturns.mock.t.Fatalf("Inspect function is already set for PVZOrderCacheMock.SetGetReturns")
 	}
@@ -1741,7 +1798,7 @@ func (mmSetGetReturns *mPVZOrderCacheMockSetGetReturns) Return(err error) *PVZOr
 }
 
 // Set uses given function f to mock the PVZOrderCache.SetGetReturns method
-func (mmSetGetReturns *mPVZOrderCacheMockSetGetReturns) Set(f func(ctx context.Context, orders []domain.PVZOrder, options ...abstractions.PagePaginationOptFunc) (err error)) *PVZOrderCacheMock {
+func (mmSetGetReturns *mPVZOrderCacheMockSetGetReturns) Set(f func(ctx context.Context, pvzID string, orders []domain.PVZOrder, options ...abstractions.PagePaginationOptFunc) (err error)) *PVZOrderCacheMock {
 	if mmSetGetReturns.defaultExpectation != nil {
 		mmSetGetReturns.mock.t.Fatalf("Default expectation is already set for the PVZOrderCache.SetGetReturns method")
 	}
@@ -1757,14 +1814,14 @@ func (mmSetGetReturns *mPVZOrderCacheMockSetGetReturns) Set(f func(ctx context.C
 
 // When sets expectation for the PVZOrderCache.SetGetReturns which will trigger the result defined by the following
 // Then helper
-func (mmSetGetReturns *mPVZOrderCacheMockSetGetReturns) When(ctx context.Context, orders []domain.PVZOrder, options ...abstractions.PagePaginationOptFunc) *PVZOrderCacheMockSetGetReturnsExpectation {
+func (mmSetGetReturns *mPVZOrderCacheMockSetGetReturns) When(ctx context.Context, pvzID string, orders []domain.PVZOrder, options ...abstractions.PagePaginationOptFunc) *PVZOrderCacheMockSetGetReturnsExpectation {
 	if mmSetGetReturns.mock.funcSetGetReturns != nil {
 		mmSetGetReturns.mock.t.Fatalf("PVZOrderCacheMock.SetGetReturns mock is already set by Set")
 	}
 
 	expectation := &PVZOrderCacheMockSetGetReturnsExpectation{
 		mock:               mmSetGetReturns.mock,
-		params:             &PVZOrderCacheMockSetGetReturnsParams{ctx, orders, options},
+		params:             &PVZOrderCacheMockSetGetReturnsParams{ctx, pvzID, orders, options},
 		expectationOrigins: PVZOrderCacheMockSetGetReturnsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmSetGetReturns.expectations = append(mmSetGetReturns.expectations, expectation)
@@ -1799,17 +1856,17 @@ func (mmSetGetReturns *mPVZOrderCacheMockSetGetReturns) invocationsDone() bool {
 }
 
 // SetGetReturns implements mm_usecases.PVZOrderCache
-func (mmSetGetReturns *PVZOrderCacheMock) SetGetReturns(ctx context.Context, orders []domain.PVZOrder, options ...abstractions.PagePaginationOptFunc) (err error) {
+func (mmSetGetReturns *PVZOrderCacheMock) SetGetReturns(ctx context.Context, pvzID string, orders []domain.PVZOrder, options ...abstractions.PagePaginationOptFunc) (err error) {
 	mm_atomic.AddUint64(&mmSetGetReturns.beforeSetGetReturnsCounter, 1)
 	defer mm_atomic.AddUint64(&mmSetGetReturns.afterSetGetReturnsCounter, 1)
 
 	mmSetGetReturns.t.Helper()
 
 	if mmSetGetReturns.inspectFuncSetGetReturns != nil {
-		mmSetGetReturns.inspectFuncSetGetReturns(ctx, orders, options...)
+		mmSetGetReturns.inspectFuncSetGetReturns(ctx, pvzID, orders, options...)
 	}
 
-	mm_params := PVZOrderCacheMockSetGetReturnsParams{ctx, orders, options}
+	mm_params := PVZOrderCacheMockSetGetReturnsParams{ctx, pvzID, orders, options}
 
 	// Record call args
 	mmSetGetReturns.SetGetReturnsMock.mutex.Lock()
@@ -1828,7 +1885,7 @@ func (mmSetGetReturns *PVZOrderCacheMock) SetGetReturns(ctx context.Context, ord
 		mm_want := mmSetGetReturns.SetGetReturnsMock.defaultExpectation.params
 		mm_want_ptrs := mmSetGetReturns.SetGetReturnsMock.defaultExpectation.paramPtrs
 
-		mm_got := PVZOrderCacheMockSetGetReturnsParams{ctx, orders, options}
+		mm_got := PVZOrderCacheMockSetGetReturnsParams{ctx, pvzID, orders, options}
 
 		if mm_want_ptrs != nil {
 
@@ -1837,6 +1894,11 @@ func (mmSetGetReturns *PVZOrderCacheMock) SetGetReturns(ctx context.Context, ord
 					mmSetGetReturns.SetGetReturnsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
+			if mm_want_ptrs.pvzID != nil && !minimock.Equal(*mm_want_ptrs.pvzID, mm_got.pvzID) {
+				mmSetGetReturns.t.Errorf("PVZOrderCacheMock.SetGetReturns got unexpected parameter pvzID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetGetReturns.SetGetReturnsMock.defaultExpectation.expectationOrigins.originPvzID, *mm_want_ptrs.pvzID, mm_got.pvzID, minimock.Diff(*mm_want_ptrs.pvzID, mm_got.pvzID))
+			}
+
 			if mm_want_ptrs.orders != nil && !minimock.Equal(*mm_want_ptrs.orders, mm_got.orders) {
 				mmSetGetReturns.t.Errorf("PVZOrderCacheMock.SetGetReturns got unexpected parameter orders, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 					mmSetGetReturns.SetGetReturnsMock.defaultExpectation.expectationOrigins.originOrders, *mm_want_ptrs.orders, mm_got.orders, minimock.Diff(*mm_want_ptrs.orders, mm_got.orders))
@@ -1859,9 +1921,9 @@ func (mmSetGetReturns *PVZOrderCacheMock) SetGetReturns(ctx context.Context, ord
 		return (*mm_results).err
 	}
 	if mmSetGetReturns.funcSetGetReturns != nil {
-		return mmSetGetReturns.funcSetGetReturns(ctx, orders, options...)
+		return mmSetGetReturns.funcSetGetReturns(ctx, pvzID, orders, options...)
 	}
-	mmSetGetReturns.t.Fatalf("Unexpected call to PVZOrderCacheMock.SetGetReturns. %v %v %v", ctx, orders, options)
+	mmSetGetReturns.t.Fatalf("Unexpected call to PVZOrderCacheMock.SetGetReturns. %v %v %v %v", ctx, pvzID, orders, options)
 	return
 }
 
@@ -8,6 +8,7 @@ import (
 	"homework/internal/domain"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
@@ -19,20 +20,34 @@ type PVZOrderRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcCreateOrder          func(ctx context.Context, order domain.PVZOrder) (err error)
+	funcCreateOrder          func(ctx context.Context, order domain.PVZOrder, pickupCode string) (err error)
 	funcCreateOrderOrigin    string
-	inspectFuncCreateOrder   func(ctx context.Context, order domain.PVZOrder)
+	inspectFuncCreateOrder   func(ctx context.Context, order domain.PVZOrder, pickupCode string)
 	afterCreateOrderCounter  uint64
 	beforeCreateOrderCounter uint64
 	CreateOrderMock          mPVZOrderRepositoryMockCreateOrder
 
-	funcDeleteOrder          func(ctx context.Context, orderID string) (err error)
+	funcCreateOrders          func(ctx context.Context, orders []domain.PVZOrder, pickupCodes map[string]string) (sa1 []string, err error)
+	funcCreateOrdersOrigin    string
+	inspectFuncCreateOrders   func(ctx context.Context, orders []domain.PVZOrder, pickupCodes map[string]string)
+	afterCreateOrdersCounter  uint64
+	beforeCreateOrdersCounter uint64
+	CreateOrdersMock          mPVZOrderRepositoryMockCreateOrders
+
+	funcDeleteOrder          func(ctx context.Context, orderID string, expectedVersion int64) (err error)
 	funcDeleteOrderOrigin    string
-	inspectFuncDeleteOrder   func(ctx context.Context, orderID string)
+	inspectFuncDeleteOrder   func(ctx context.Context, orderID string, expectedVersion int64)
 	afterDeleteOrderCounter  uint64
 	beforeDeleteOrderCounter uint64
 	DeleteOrderMock          mPVZOrderRepositoryMockDeleteOrder
 
+	funcExtendStorage          func(ctx context.Context, orderID string, extension time.Duration, expiresAt time.Time, extendedBy string, expectedVersion int64) (err error)
+	funcExtendStorageOrigin    string
+	inspectFuncExtendStorage   func(ctx context.Context, orderID string, extension time.Duration, expiresAt time.Time, extendedBy string, expectedVersion int64)
+	afterExtendStorageCounter  uint64
+	beforeExtendStorageCounter uint64
+	ExtendStorageMock          mPVZOrderRepositoryMockExtendStorage
+
 	funcGetOrder          func(ctx context.Context, orderID string) (p1 domain.PVZOrder, err error)
 	funcGetOrderOrigin    string
 	inspectFuncGetOrder   func(ctx context.Context, orderID string)
@@ -40,6 +55,13 @@ type PVZOrderRepositoryMock struct {
 	beforeGetOrderCounter uint64
 	GetOrderMock          mPVZOrderRepositoryMockGetOrder
 
+	funcGetOrderHistory          func(ctx context.Context, orderID string) (ea1 []domain.Event, err error)
+	funcGetOrderHistoryOrigin    string
+	inspectFuncGetOrderHistory   func(ctx context.Context, orderID string)
+	afterGetOrderHistoryCounter  uint64
+	beforeGetOrderHistoryCounter uint64
+	GetOrderHistoryMock          mPVZOrderRepositoryMockGetOrderHistory
+
 	funcGetOrders          func(ctx context.Context, userID string, options ...abstractions.GetOrdersOptFunc) (pa1 []domain.PVZOrder, err error)
 	funcGetOrdersOrigin    string
 	inspectFuncGetOrders   func(ctx context.Context, userID string, options ...abstractions.GetOrdersOptFunc)
@@ -47,26 +69,47 @@ type PVZOrderRepositoryMock struct {
 	beforeGetOrdersCounter uint64
 	GetOrdersMock          mPVZOrderRepositoryMockGetOrders
 
-	funcGetReturns          func(ctx context.Context, options ...abstractions.PagePaginationOptFunc) (pa1 []domain.PVZOrder, err error)
+	funcGetReturns          func(ctx context.Context, pvzID string, options ...abstractions.PagePaginationOptFunc) (pa1 []domain.PVZOrder, err error)
 	funcGetReturnsOrigin    string
-	inspectFuncGetReturns   func(ctx context.Context, options ...abstractions.PagePaginationOptFunc)
+	inspectFuncGetReturns   func(ctx context.Context, pvzID string, options ...abstractions.PagePaginationOptFunc)
 	afterGetReturnsCounter  uint64
 	beforeGetReturnsCounter uint64
 	GetReturnsMock          mPVZOrderRepositoryMockGetReturns
 
-	funcSetOrderIssued          func(ctx context.Context, orderID string) (err error)
-	funcSetOrderIssuedOrigin    string
-	inspectFuncSetOrderIssued   func(ctx context.Context, orderID string)
-	afterSetOrderIssuedCounter  uint64
-	beforeSetOrderIssuedCounter uint64
-	SetOrderIssuedMock          mPVZOrderRepositoryMockSetOrderIssued
-
-	funcSetOrderReturned          func(ctx context.Context, orderID string) (err error)
+	funcGetWeightDiscrepancies          func(ctx context.Context, pvzID string, from time.Time, to time.Time) (pa1 []domain.PVZOrder, err error)
+	funcGetWeightDiscrepanciesOrigin    string
+	inspectFuncGetWeightDiscrepancies   func(ctx context.Context, pvzID string, from time.Time, to time.Time)
+	afterGetWeightDiscrepanciesCounter  uint64
+	beforeGetWeightDiscrepanciesCounter uint64
+	GetWeightDiscrepanciesMock          mPVZOrderRepositoryMockGetWeightDiscrepancies
+
+	funcRegisterFailedPickupAttempt          func(ctx context.Context, orderID string, maxAttempts int, lockoutTime time.Duration) (t1 time.Time, err error)
+	funcRegisterFailedPickupAttemptOrigin    string
+	inspectFuncRegisterFailedPickupAttempt   func(ctx context.Context, orderID string, maxAttempts int, lockoutTime time.Duration)
+	afterRegisterFailedPickupAttemptCounter  uint64
+	beforeRegisterFailedPickupAttemptCounter uint64
+	RegisterFailedPickupAttemptMock          mPVZOrderRepositoryMockRegisterFailedPickupAttempt
+
+	funcSetOrderRefused          func(ctx context.Context, orderID string, reason string, expectedVersion int64) (err error)
+	funcSetOrderRefusedOrigin    string
+	inspectFuncSetOrderRefused   func(ctx context.Context, orderID string, reason string, expectedVersion int64)
+	afterSetOrderRefusedCounter  uint64
+	beforeSetOrderRefusedCounter uint64
+	SetOrderRefusedMock          mPVZOrderRepositoryMockSetOrderRefused
+
+	funcSetOrderReturned          func(ctx context.Context, orderID string, details domain.ReturnDetails, expectedVersion int64) (err error)
 	funcSetOrderReturnedOrigin    string
-	inspectFuncSetOrderReturned   func(ctx context.Context, orderID string)
+	inspectFuncSetOrderReturned   func(ctx context.Context, orderID string, details domain.ReturnDetails, expectedVersion int64)
 	afterSetOrderReturnedCounter  uint64
 	beforeSetOrderReturnedCounter uint64
 	SetOrderReturnedMock          mPVZOrderRepositoryMockSetOrderReturned
+
+	funcSetOrdersIssued          func(ctx context.Context, decisions []domain.IssueDecision) (err error)
+	funcSetOrdersIssuedOrigin    string
+	inspectFuncSetOrdersIssued   func(ctx context.Context, decisions []domain.IssueDecision)
+	afterSetOrdersIssuedCounter  uint64
+	beforeSetOrdersIssuedCounter uint64
+	SetOrdersIssuedMock          mPVZOrderRepositoryMockSetOrdersIssued
 }
 
 // NewPVZOrderRepositoryMock returns a mock for mm_usecases.PVZOrderRepository
@@ -80,24 +123,42 @@ func NewPVZOrderRepositoryMock(t minimock.Tester) *PVZOrderRepositoryMock {
 	m.CreateOrderMock = mPVZOrderRepositoryMockCreateOrder{mock: m}
 	m.CreateOrderMock.callArgs = []*PVZOrderRepositoryMockCreateOrderParams{}
 
+	m.CreateOrdersMock = mPVZOrderRepositoryMockCreateOrders{mock: m}
+	m.CreateOrdersMock.callArgs = []*PVZOrderRepositoryMockCreateOrdersParams{}
+
 	m.DeleteOrderMock = mPVZOrderRepositoryMockDeleteOrder{mock: m}
 	m.DeleteOrderMock.callArgs = []*PVZOrderRepositoryMockDeleteOrderParams{}
 
+	m.ExtendStorageMock = mPVZOrderRepositoryMockExtendStorage{mock: m}
+	m.ExtendStorageMock.callArgs = []*PVZOrderRepositoryMockExtendStorageParams{}
+
 	m.GetOrderMock = mPVZOrderRepositoryMockGetOrder{mock: m}
 	m.GetOrderMock.callArgs = []*PVZOrderRepositoryMockGetOrderParams{}
 
+	m.GetOrderHistoryMock = mPVZOrderRepositoryMockGetOrderHistory{mock: m}
+	m.GetOrderHistoryMock.callArgs = []*PVZOrderRepositoryMockGetOrderHistoryParams{}
+
 	m.GetOrdersMock = mPVZOrderRepositoryMockGetOrders{mock: m}
 	m.GetOrdersMock.callArgs = []*PVZOrderRepositoryMockGetOrdersParams{}
 
 	m.GetReturnsMock = mPVZOrderRepositoryMockGetReturns{mock: m}
 	m.GetReturnsMock.callArgs = []*PVZOrderRepositoryMockGetReturnsParams{}
 
-	m.SetOrderIssuedMock = mPVZOrderRepositoryMockSetOrderIssued{mock: m}
-	m.SetOrderIssuedMock.callArgs = []*PVZOrderRepositoryMockSetOrderIssuedParams{}
+	m.GetWeightDiscrepanciesMock = mPVZOrderRepositoryMockGetWeightDiscrepancies{mock: m}
+	m.GetWeightDiscrepanciesMock.callArgs = []*PVZOrderRepositoryMockGetWeightDiscrepanciesParams{}
+
+	m.RegisterFailedPickupAttemptMock = mPVZOrderRepositoryMockRegisterFailedPickupAttempt{mock: m}
+	m.RegisterFailedPickupAttemptMock.callArgs = []*PVZOrderRepositoryMockRegisterFailedPickupAttemptParams{}
+
+	m.SetOrderRefusedMock = mPVZOrderRepositoryMockSetOrderRefused{mock: m}
+	m.SetOrderRefusedMock.callArgs = []*PVZOrderRepositoryMockSetOrderRefusedParams{}
 
 	m.SetOrderReturnedMock = mPVZOrderRepositoryMockSetOrderReturned{mock: m}
 	m.SetOrderReturnedMock.callArgs = []*PVZOrderRepositoryMockSetOrderReturnedParams{}
 
+	m.SetOrdersIssuedMock = mPVZOrderRepositoryMockSetOrdersIssued{mock: m}
+	m.SetOrdersIssuedMock.callArgs = []*PVZOrderRepositoryMockSetOrdersIssuedParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -129,14 +190,16 @@ type PVZOrderRepositoryMockCreateOrderExpectation struct {
 
 // PVZOrderRepositoryMockCreateOrderParams contains parameters of the PVZOrderRepository.CreateOrder
 type PVZOrderRepositoryMockCreateOrderParams struct {
-	ctx   context.Context
-	order domain.PVZOrder
+	ctx        context.Context
+	order      domain.PVZOrder
+	pickupCode string
 }
 
 // PVZOrderRepositoryMockCreateOrderParamPtrs contains pointers to parameters of the PVZOrderRepository.CreateOrder
 type PVZOrderRepositoryMockCreateOrderParamPtrs struct {
-	ctx   *context.Context
-	order *domain.PVZOrder
+	ctx        *context.Context
+	order      *domain.PVZOrder
+	pickupCode *string
 }
 
 // PVZOrderRepositoryMockCreateOrderResults contains results of the PVZOrderRepository.CreateOrder
@@ -146,9 +209,10 @@ type PVZOrderRepositoryMockCreateOrderResults struct {
 
 // PVZOrderRepositoryMockCreateOrderOrigins contains origins of expectations of the PVZOrderRepository.CreateOrder
 type PVZOrderRepositoryMockCreateOrderExpectationOrigins struct {
-	origin      string
-	originCtx   string
-	originOrder string
+	origin           string
+	originCtx        string
+	originOrder      string
+	originPickupCode string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -162,7 +226,7 @@ func (mmCreateOrder *mPVZOrderRepositoryMockCreateOrder) Optional() *mPVZOrderRe
 }
 
 // Expect sets up expected params for PVZOrderRepository.CreateOrder
-func (mmCreateOrder *mPVZOrderRepositoryMockCreateOrder) Expect(ctx context.Context, order domain.PVZOrder) *mPVZOrderRepositoryMockCreateOrder {
+func (mmCreateOrder *mPVZOrderRepositoryMockCreateOrder) Expect(ctx context.Context, order domain.PVZOrder, pickupCode string) *mPVZOrderRepositoryMockCreateOrder {
 	if mmCreateOrder.mock.funcCreateOrder != nil {
 		mmCreateOrder.mock.t.Fatalf("PVZOrderRepositoryMock.CreateOrder mock is already set by Set")
 	}
@@ -175,7 +239,7 @@ func (mmCreateOrder *mPVZOrderRepositoryMockCreateOrder) Expect(ctx context.Cont
 		mmCreateOrder.mock.t.Fatalf("PVZOrderRepositoryMock.CreateOrder mock is already set by ExpectParams functions")
 	}
 
-	mmCreateOrder.defaultExpectation.params = &PVZOrderRepositoryMockCreateOrderParams{ctx, order}
+	mmCreateOrder.defaultExpectation.params = &PVZOrderRepositoryMockCreateOrderParams{ctx, order, pickupCode}
 	mmCreateOrder.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmCreateOrder.expectations {
 		if minimock.Equal(e.params, mmCreateOrder.defaultExpectation.params) {
@@ -232,8 +296,31 @@ func (mmCreateOrder *mPVZOrderRepositoryMockCreateOrder) ExpectOrderParam2(order
 	return mmCreateOrder
 }
 
+// ExpectPickupCodeParam3 sets up expected param pickupCode for PVZOrderRepository.CreateOrder
+func (mmCreateOrder *mPVZOrderRepositoryMockCreateOrder) ExpectPickupCodeParam3(pickupCode string) *mPVZOrderRepositoryMockCreateOrder {
+	if mmCreateOrder.mock.funcCreateOrder != nil {
+		mmCreateOrder.mock.t.Fatalf("PVZOrderRepositoryMock.CreateOrder mock is already set by Set")
+	}
+
+	if mmCreateOrder.defaultExpectation == nil {
+		mmCreateOrder.defaultExpectation = &PVZOrderRepositoryMockCreateOrderExpectation{}
+	}
+
+	if mmCreateOrder.defaultExpectation.params != nil {
+		mmCreateOrder.mock.t.Fatalf("PVZOrderRepositoryMock.CreateOrder mock is already set by Expect")
+	}
+
+	if mmCreateOrder.defaultExpectation.paramPtrs == nil {
+		mmCreateOrder.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockCreateOrderParamPtrs{}
+	}
+	mmCreateOrder.defaultExpectation.paramPtrs.pickupCode = &pickupCode
+	mmCreateOrder.defaultExpectation.expectationOrigins.originPickupCode = minimock.CallerInfo(1)
+
+	return mmCreateOrder
+}
+
 // Inspect accepts an inspector function that has same arguments as the PVZOrderRepository.CreateOrder
-func (mmCreateOrder *mPVZOrderRepositoryMockCreateOrder) Inspect(f func(ctx context.Context, order domain.PVZOrder)) *mPVZOrderRepositoryMockCreateOrder {
+func (mmCreateOrder *mPVZOrderRepositoryMockCreateOrder) Inspect(f func(ctx context.Context, order domain.PVZOrder, pickupCode string)) *mPVZOrderRepositoryMockCreateOrder {
 	if mmCreateOrder.mock.inspectFuncCreateOrder != nil {
 		mmCreateOrder.mock.t.Fatalf("Inspect function is already set for PVZOrderRepositoryMock.CreateOrder")
 	}
@@ -258,7 +345,7 @@ func (mmCreateOrder *mPVZOrderRepositoryMockCreateOrder) Return(err error) *PVZO
 }
 
 // Set uses given function f to mock the PVZOrderRepository.CreateOrder method
-func (mmCreateOrder *mPVZOrderRepositoryMockCreateOrder) Set(f func(ctx context.Context, order domain.PVZOrder) (err error)) *PVZOrderRepositoryMock {
+func (mmCreateOrder *mPVZOrderRepositoryMockCreateOrder) Set(f func(ctx context.Context, order domain.PVZOrder, pickupCode string) (err error)) *PVZOrderRepositoryMock {
 	if mmCreateOrder.defaultExpectation != nil {
 		mmCreateOrder.mock.t.Fatalf("Default expectation is already set for the PVZOrderRepository.CreateOrder method")
 	}
@@ -274,14 +361,14 @@ func (mmCreateOrder *mPVZOrderRepositoryMockCreateOrder) Set(f func(ctx context.
 
 // When sets expectation for the PVZOrderRepository.CreateOrder which will trigger the result defined by the following
 // Then helper
-func (mmCreateOrder *mPVZOrderRepositoryMockCreateOrder) When(ctx context.Context, order domain.PVZOrder) *PVZOrderRepositoryMockCreateOrderExpectation {
+func (mmCreateOrder *mPVZOrderRepositoryMockCreateOrder) When(ctx context.Context, order domain.PVZOrder, pickupCode string) *PVZOrderRepositoryMockCreateOrderExpectation {
 	if mmCreateOrder.mock.funcCreateOrder != nil {
 		mmCreateOrder.mock.t.Fatalf("PVZOrderRepositoryMock.CreateOrder mock is already set by Set")
 	}
 
 	expectation := &PVZOrderRepositoryMockCreateOrderExpectation{
 		mock:               mmCreateOrder.mock,
-		params:             &PVZOrderRepositoryMockCreateOrderParams{ctx, order},
+		params:             &PVZOrderRepositoryMockCreateOrderParams{ctx, order, pickupCode},
 		expectationOrigins: PVZOrderRepositoryMockCreateOrderExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmCreateOrder.expectations = append(mmCreateOrder.expectations, expectation)
@@ -316,17 +403,17 @@ func (mmCreateOrder *mPVZOrderRepositoryMockCreateOrder) invocationsDone() bool
 }
 
 // CreateOrder implements mm_usecases.PVZOrderRepository
-func (mmCreateOrder *PVZOrderRepositoryMock) CreateOrder(ctx context.Context, order domain.PVZOrder) (err error) {
+func (mmCreateOrder *PVZOrderRepositoryMock) CreateOrder(ctx context.Context, order domain.PVZOrder, pickupCode string) (err error) {
 	mm_atomic.AddUint64(&mmCreateOrder.beforeCreateOrderCounter, 1)
 	defer mm_atomic.AddUint64(&mmCreateOrder.afterCreateOrderCounter, 1)
 
 	mmCreateOrder.t.Helper()
 
 	if mmCreateOrder.inspectFuncCreateOrder != nil {
-		mmCreateOrder.inspectFuncCreateOrder(ctx, order)
+		mmCreateOrder.inspectFuncCreateOrder(ctx, order, pickupCode)
 	}
 
-	mm_params := PVZOrderRepositoryMockCreateOrderParams{ctx, order}
+	mm_params := PVZOrderRepositoryMockCreateOrderParams{ctx, order, pickupCode}
 
 	// Record call args
 	mmCreateOrder.CreateOrderMock.mutex.Lock()
@@ -345,7 +432,7 @@ func (mmCreateOrder *PVZOrderRepositoryMock) CreateOrder(ctx context.Context, or
 		mm_want := mmCreateOrder.CreateOrderMock.defaultExpectation.params
 		mm_want_ptrs := mmCreateOrder.CreateOrderMock.defaultExpectation.paramPtrs
 
-		mm_got := PVZOrderRepositoryMockCreateOrderParams{ctx, order}
+		mm_got := PVZOrderRepositoryMockCreateOrderParams{ctx, order, pickupCode}
 
 		if mm_want_ptrs != nil {
 
@@ -359,6 +446,11 @@ func (mmCreateOrder *PVZOrderRepositoryMock) CreateOrder(ctx context.Context, or
 					mmCreateOrder.CreateOrderMock.defaultExpectation.expectationOrigins.originOrder, *mm_want_ptrs.order, mm_got.order, minimock.Diff(*mm_want_ptrs.order, mm_got.order))
 			}
 
+			if mm_want_ptrs.pickupCode != nil && !minimock.Equal(*mm_want_ptrs.pickupCode, mm_got.pickupCode) {
+				mmCreateOrder.t.Errorf("PVZOrderRepositoryMock.CreateOrder got unexpected parameter pickupCode, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOrder.CreateOrderMock.defaultExpectation.expectationOrigins.originPickupCode, *mm_want_ptrs.pickupCode, mm_got.pickupCode, minimock.Diff(*mm_want_ptrs.pickupCode, mm_got.pickupCode))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmCreateOrder.t.Errorf("PVZOrderRepositoryMock.CreateOrder got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmCreateOrder.CreateOrderMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -371,9 +463,9 @@ func (mmCreateOrder *PVZOrderRepositoryMock) CreateOrder(ctx context.Context, or
 		return (*mm_results).err
 	}
 	if mmCreateOrder.funcCreateOrder != nil {
-		return mmCreateOrder.funcCreateOrder(ctx, order)
+		return mmCreateOrder.funcCreateOrder(ctx, order, pickupCode)
 	}
-	mmCreateOrder.t.Fatalf("Unexpected call to PVZOrderRepositoryMock.CreateOrder. %v %v", ctx, order)
+	mmCreateOrder.t.Fatalf("Unexpected call to PVZOrderRepositoryMock.CreateOrder. %v %v %v", ctx, order, pickupCode)
 	return
 }
 
@@ -445,6 +537,380 @@ func (m *PVZOrderRepositoryMock) MinimockCreateOrderInspect() {
 	}
 }
 
+type mPVZOrderRepositoryMockCreateOrders struct {
+	optional           bool
+	mock               *PVZOrderRepositoryMock
+	defaultExpectation *PVZOrderRepositoryMockCreateOrdersExpectation
+	expectations       []*PVZOrderRepositoryMockCreateOrdersExpectation
+
+	callArgs []*PVZOrderRepositoryMockCreateOrdersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PVZOrderRepositoryMockCreateOrdersExpectation specifies expectation struct of the PVZOrderRepository.CreateOrders
+type PVZOrderRepositoryMockCreateOrdersExpectation struct {
+	mock               *PVZOrderRepositoryMock
+	params             *PVZOrderRepositoryMockCreateOrdersParams
+	paramPtrs          *PVZOrderRepositoryMockCreateOrdersParamPtrs
+	expectationOrigins PVZOrderRepositoryMockCreateOrdersExpectationOrigins
+	results            *PVZOrderRepositoryMockCreateOrdersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PVZOrderRepositoryMockCreateOrdersParams contains parameters of the PVZOrderRepository.CreateOrders
+type PVZOrderRepositoryMockCreateOrdersParams struct {
+	ctx         context.Context
+	orders      []domain.PVZOrder
+	pickupCodes map[string]string
+}
+
+// PVZOrderRepositoryMockCreateOrdersParamPtrs contains pointers to parameters of the PVZOrderRepository.CreateOrders
+type PVZOrderRepositoryMockCreateOrdersParamPtrs struct {
+	ctx         *context.Context
+	orders      *[]domain.PVZOrder
+	pickupCodes *map[string]string
+}
+
+// PVZOrderRepositoryMockCreateOrdersResults contains results of the PVZOrderRepository.CreateOrders
+type PVZOrderRepositoryMockCreateOrdersResults struct {
+	sa1 []string
+	err error
+}
+
+// PVZOrderRepositoryMockCreateOrdersOrigins contains origins of expectations of the PVZOrderRepository.CreateOrders
+type PVZOrderRepositoryMockCreateOrdersExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originOrders      string
+	originPickupCodes string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateOrders *mPVZOrderRepositoryMockCreateOrders) Optional() *mPVZOrderRepositoryMockCreateOrders {
+	mmCreateOrders.optional = true
+	return mmCreateOrders
+}
+
+// Expect sets up expected params for PVZOrderRepository.CreateOrders
+func (mmCreateOrders *mPVZOrderRepositoryMockCreateOrders) Expect(ctx context.Context, orders []domain.PVZOrder, pickupCodes map[string]string) *mPVZOrderRepositoryMockCreateOrders {
+	if mmCreateOrders.mock.funcCreateOrders != nil {
+		mmCreateOrders.mock.t.Fatalf("PVZOrderRepositoryMock.CreateOrders mock is already set by Set")
+	}
+
+	if mmCreateOrders.defaultExpectation == nil {
+		mmCreateOrders.defaultExpectation = &PVZOrderRepositoryMockCreateOrdersExpectation{}
+	}
+
+	if mmCreateOrders.defaultExpectation.paramPtrs != nil {
+		mmCreateOrders.mock.t.Fatalf("PVZOrderRepositoryMock.CreateOrders mock is already set by ExpectParams functions")
+	}
+
+	mmCreateOrders.defaultExpectation.params = &PVZOrderRepositoryMockCreateOrdersParams{ctx, orders, pickupCodes}
+	mmCreateOrders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateOrders.expectations {
+		if minimock.Equal(e.params, mmCreateOrders.defaultExpectation.params) {
+			mmCreateOrders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateOrders.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateOrders
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PVZOrderRepository.CreateOrders
+func (mmCreateOrders *mPVZOrderRepositoryMockCreateOrders) ExpectCtxParam1(ctx context.Context) *mPVZOrderRepositoryMockCreateOrders {
+	if mmCreateOrders.mock.funcCreateOrders != nil {
+		mmCreateOrders.mock.t.Fatalf("PVZOrderRepositoryMock.CreateOrders mock is already set by Set")
+	}
+
+	if mmCreateOrders.defaultExpectation == nil {
+		mmCreateOrders.defaultExpectation = &PVZOrderRepositoryMockCreateOrdersExpectation{}
+	}
+
+	if mmCreateOrders.defaultExpectation.params != nil {
+		mmCreateOrders.mock.t.Fatalf("PVZOrderRepositoryMock.CreateOrders mock is already set by Expect")
+	}
+
+	if mmCreateOrders.defaultExpectation.paramPtrs == nil {
+		mmCreateOrders.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockCreateOrdersParamPtrs{}
+	}
+	mmCreateOrders.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateOrders.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateOrders
+}
+
+// ExpectOrdersParam2 sets up expected param orders for PVZOrderRepository.CreateOrders
+func (mmCreateOrders *mPVZOrderRepositoryMockCreateOrders) ExpectOrdersParam2(orders []domain.PVZOrder) *mPVZOrderRepositoryMockCreateOrders {
+	if mmCreateOrders.mock.funcCreateOrders != nil {
+		mmCreateOrders.mock.t.Fatalf("PVZOrderRepositoryMock.CreateOrders mock is already set by Set")
+	}
+
+	if mmCreateOrders.defaultExpectation == nil {
+		mmCreateOrders.defaultExpectation = &PVZOrderRepositoryMockCreateOrdersExpectation{}
+	}
+
+	if mmCreateOrders.defaultExpectation.params != nil {
+		mmCreateOrders.mock.t.Fatalf("PVZOrderRepositoryMock.CreateOrders mock is already set by Expect")
+	}
+
+	if mmCreateOrders.defaultExpectation.paramPtrs == nil {
+		mmCreateOrders.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockCreateOrdersParamPtrs{}
+	}
+	mmCreateOrders.defaultExpectation.paramPtrs.orders = &orders
+	mmCreateOrders.defaultExpectation.expectationOrigins.originOrders = minimock.CallerInfo(1)
+
+	return mmCreateOrders
+}
+
+// ExpectPickupCodesParam3 sets up expected param pickupCodes for PVZOrderRepository.CreateOrders
+func (mmCreateOrders *mPVZOrderRepositoryMockCreateOrders) ExpectPickupCodesParam3(pickupCodes map[string]string) *mPVZOrderRepositoryMockCreateOrders {
+	if mmCreateOrders.mock.funcCreateOrders != nil {
+		mmCreateOrders.mock.t.Fatalf("PVZOrderRepositoryMock.CreateOrders mock is already set by Set")
+	}
+
+	if mmCreateOrders.defaultExpectation == nil {
+		mmCreateOrders.defaultExpectation = &PVZOrderRepositoryMockCreateOrdersExpectation{}
+	}
+
+	if mmCreateOrders.defaultExpectation.params != nil {
+		mmCreateOrders.mock.t.Fatalf("PVZOrderRepositoryMock.CreateOrders mock is already set by Expect")
+	}
+
+	if mmCreateOrders.defaultExpectation.paramPtrs == nil {
+		mmCreateOrders.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockCreateOrdersParamPtrs{}
+	}
+	mmCreateOrders.defaultExpectation.paramPtrs.pickupCodes = &pickupCodes
+	mmCreateOrders.defaultExpectation.expectationOrigins.originPickupCodes = minimock.CallerInfo(1)
+
+	return mmCreateOrders
+}
+
+// Inspect accepts an inspector function that has same arguments as the PVZOrderRepository.CreateOrders
+func (mmCreateOrders *mPVZOrderRepositoryMockCreateOrders) Inspect(f func(ctx context.Context, orders []domain.PVZOrder, pickupCodes map[string]string)) *mPVZOrderRepositoryMockCreateOrders {
+	if mmCreateOrders.mock.inspectFuncCreateOrders != nil {
+		mmCreateOrders.mock.t.Fatalf("Inspect function is already set for PVZOrderRepositoryMock.CreateOrders")
+	}
+
+	mmCreateOrders.mock.inspectFuncCreateOrders = f
+
+	return mmCreateOrders
+}
+
+// Return sets up results that will be returned by PVZOrderRepository.CreateOrders
+func (mmCreateOrders *mPVZOrderRepositoryMockCreateOrders) Return(sa1 []string, err error) *PVZOrderRepositoryMock {
+	if mmCreateOrders.mock.funcCreateOrders != nil {
+		mmCreateOrders.mock.t.Fatalf("PVZOrderRepositoryMock.CreateOrders mock is already set by Set")
+	}
+
+	if mmCreateOrders.defaultExpectation == nil {
+		mmCreateOrders.defaultExpectation = &PVZOrderRepositoryMockCreateOrdersExpectation{mock: mmCreateOrders.mock}
+	}
+	mmCreateOrders.defaultExpectation.results = &PVZOrderRepositoryMockCreateOrdersResults{sa1, err}
+	mmCreateOrders.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateOrders.mock
+}
+
+// Set uses given function f to mock the PVZOrderRepository.CreateOrders method
+func (mmCreateOrders *mPVZOrderRepositoryMockCreateOrders) Set(f func(ctx context.Context, orders []domain.PVZOrder, pickupCodes map[string]string) (sa1 []string, err error)) *PVZOrderRepositoryMock {
+	if mmCreateOrders.defaultExpectation != nil {
+		mmCreateOrders.mock.t.Fatalf("Default expectation is already set for the PVZOrderRepository.CreateOrders method")
+	}
+
+	if len(mmCreateOrders.expectations) > 0 {
+		mmCreateOrders.mock.t.Fatalf("Some expectations are already set for the PVZOrderRepository.CreateOrders method")
+	}
+
+	mmCreateOrders.mock.funcCreateOrders = f
+	mmCreateOrders.mock.funcCreateOrdersOrigin = minimock.CallerInfo(1)
+	return mmCreateOrders.mock
+}
+
+// When sets expectation for the PVZOrderRepository.CreateOrders which will trigger the result defined by the following
+// Then helper
+func (mmCreateOrders *mPVZOrderRepositoryMockCreateOrders) When(ctx context.Context, orders []domain.PVZOrder, pickupCodes map[string]string) *PVZOrderRepositoryMockCreateOrdersExpectation {
+	if mmCreateOrders.mock.funcCreateOrders != nil {
+		mmCreateOrders.mock.t.Fatalf("PVZOrderRepositoryMock.CreateOrders mock is already set by Set")
+	}
+
+	expectation := &PVZOrderRepositoryMockCreateOrdersExpectation{
+		mock:               mmCreateOrders.mock,
+		params:             &PVZOrderRepositoryMockCreateOrdersParams{ctx, orders, pickupCodes},
+		expectationOrigins: PVZOrderRepositoryMockCreateOrdersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateOrders.expectations = append(mmCreateOrders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PVZOrderRepository.CreateOrders return parameters for the expectation previously defined by the When method
+func (e *PVZOrderRepositoryMockCreateOrdersExpectation) Then(sa1 []string, err error) *PVZOrderRepositoryMock {
+	e.results = &PVZOrderRepositoryMockCreateOrdersResults{sa1, err}
+	return e.mock
+}
+
+// Times sets number of times PVZOrderRepository.CreateOrders should be invoked
+func (mmCreateOrders *mPVZOrderRepositoryMockCreateOrders) Times(n uint64) *mPVZOrderRepositoryMockCreateOrders {
+	if n == 0 {
+		mmCreateOrders.mock.t.Fatalf("Times of PVZOrderRepositoryMock.CreateOrders mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateOrders.expectedInvocations, n)
+	mmCreateOrders.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateOrders
+}
+
+func (mmCreateOrders *mPVZOrderRepositoryMockCreateOrders) invocationsDone() bool {
+	if len(mmCreateOrders.expectations) == 0 && mmCreateOrders.defaultExpectation == nil && mmCreateOrders.mock.funcCreateOrders == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateOrders.mock.afterCreateOrdersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateOrders.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateOrders implements mm_usecases.PVZOrderRepository
+func (mmCreateOrders *PVZOrderRepositoryMock) CreateOrders(ctx context.Context, orders []domain.PVZOrder, pickupCodes map[string]string) (sa1 []string, err error) {
+	mm_atomic.AddUint64(&mmCreateOrders.beforeCreateOrdersCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateOrders.afterCreateOrdersCounter, 1)
+
+	mmCreateOrders.t.Helper()
+
+	if mmCreateOrders.inspectFuncCreateOrders != nil {
+		mmCreateOrders.inspectFuncCreateOrders(ctx, orders, pickupCodes)
+	}
+
+	mm_params := PVZOrderRepositoryMockCreateOrdersParams{ctx, orders, pickupCodes}
+
+	// Record call args
+	mmCreateOrders.CreateOrdersMock.mutex.Lock()
+	mmCreateOrders.CreateOrdersMock.callArgs = append(mmCreateOrders.CreateOrdersMock.callArgs, &mm_params)
+	mmCreateOrders.CreateOrdersMock.mutex.Unlock()
+
+	for _, e := range mmCreateOrders.CreateOrdersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.sa1, e.results.err
+		}
+	}
+
+	if mmCreateOrders.CreateOrdersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateOrders.CreateOrdersMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateOrders.CreateOrdersMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateOrders.CreateOrdersMock.defaultExpectation.paramPtrs
+
+		mm_got := PVZOrderRepositoryMockCreateOrdersParams{ctx, orders, pickupCodes}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateOrders.t.Errorf("PVZOrderRepositoryMock.CreateOrders got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOrders.CreateOrdersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.orders != nil && !minimock.Equal(*mm_want_ptrs.orders, mm_got.orders) {
+				mmCreateOrders.t.Errorf("PVZOrderRepositoryMock.CreateOrders got unexpected parameter orders, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOrders.CreateOrdersMock.defaultExpectation.expectationOrigins.originOrders, *mm_want_ptrs.orders, mm_got.orders, minimock.Diff(*mm_want_ptrs.orders, mm_got.orders))
+			}
+
+			if mm_want_ptrs.pickupCodes != nil && !minimock.Equal(*mm_want_ptrs.pickupCodes, mm_got.pickupCodes) {
+				mmCreateOrders.t.Errorf("PVZOrderRepositoryMock.CreateOrders got unexpected parameter pickupCodes, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOrders.CreateOrdersMock.defaultExpectation.expectationOrigins.originPickupCodes, *mm_want_ptrs.pickupCodes, mm_got.pickupCodes, minimock.Diff(*mm_want_ptrs.pickupCodes, mm_got.pickupCodes))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateOrders.t.Errorf("PVZOrderRepositoryMock.CreateOrders got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateOrders.CreateOrdersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateOrders.CreateOrdersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateOrders.t.Fatal("No results are set for the PVZOrderRepositoryMock.CreateOrders")
+		}
+		return (*mm_results).sa1, (*mm_results).err
+	}
+	if mmCreateOrders.funcCreateOrders != nil {
+		return mmCreateOrders.funcCreateOrders(ctx, orders, pickupCodes)
+	}
+	mmCreateOrders.t.Fatalf("Unexpected call to PVZOrderRepositoryMock.CreateOrders. %v %v %v", ctx, orders, pickupCodes)
+	return
+}
+
+// CreateOrdersAfterCounter returns a count of finished PVZOrderRepositoryMock.CreateOrders invocations
+func (mmCreateOrders *PVZOrderRepositoryMock) CreateOrdersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOrders.afterCreateOrdersCounter)
+}
+
+// CreateOrdersBeforeCounter returns a count of PVZOrderRepositoryMock.CreateOrders invocations
+func (mmCreateOrders *PVZOrderRepositoryMock) CreateOrdersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOrders.beforeCreateOrdersCounter)
+}
+
+// Calls returns a list of arguments used in each call to PVZOrderRepositoryMock.CreateOrders.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateOrders *mPVZOrderRepositoryMockCreateOrders) Calls() []*PVZOrderRepositoryMockCreateOrdersParams {
+	mmCreateOrders.mutex.RLock()
+
+	argCopy := make([]*PVZOrderRepositoryMockCreateOrdersParams, len(mmCreateOrders.callArgs))
+	copy(argCopy, mmCreateOrders.callArgs)
+
+	mmCreateOrders.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateOrdersDone returns true if the count of the CreateOrders invocations corresponds
+// the number of defined expectations
+func (m *PVZOrderRepositoryMock) MinimockCreateOrdersDone() bool {
+	if m.CreateOrdersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateOrdersMock.invocationsDone()
+}
+
+// MinimockCreateOrdersInspect logs each unmet expectation
+func (m *PVZOrderRepositoryMock) MinimockCreateOrdersInspect() {
+	for _, e := range m.CreateOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PVZOrderRepositoryMock.CreateOrders at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateOrdersCounter := mm_atomic.LoadUint64(&m.afterCreateOrdersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateOrdersMock.defaultExpectation != nil && afterCreateOrdersCounter < 1 {
+		if m.CreateOrdersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PVZOrderRepositoryMock.CreateOrders at\n%s", m.CreateOrdersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PVZOrderRepositoryMock.CreateOrders at\n%s with params: %#v", m.CreateOrdersMock.defaultExpectation.expectationOrigins.origin, *m.CreateOrdersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateOrders != nil && afterCreateOrdersCounter < 1 {
+		m.t.Errorf("Expected call to PVZOrderRepositoryMock.CreateOrders at\n%s", m.funcCreateOrdersOrigin)
+	}
+
+	if !m.CreateOrdersMock.invocationsDone() && afterCreateOrdersCounter > 0 {
+		m.t.Errorf("Expected %d calls to PVZOrderRepositoryMock.CreateOrders at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateOrdersMock.expectedInvocations), m.CreateOrdersMock.expectedInvocationsOrigin, afterCreateOrdersCounter)
+	}
+}
+
 type mPVZOrderRepositoryMockDeleteOrder struct {
 	optional           bool
 	mock               *PVZOrderRepositoryMock
@@ -471,14 +937,16 @@ type PVZOrderRepositoryMockDeleteOrderExpectation struct {
 
 // PVZOrderRepositoryMockDeleteOrderParams contains parameters of the PVZOrderRepository.DeleteOrder
 type PVZOrderRepositoryMockDeleteOrderParams struct {
-	ctx     context.Context
-	orderID string
+	ctx             context.Context
+	orderID         string
+	expectedVersion int64
 }
 
 // PVZOrderRepositoryMockDeleteOrderParamPtrs contains pointers to parameters of the PVZOrderRepository.DeleteOrder
 type PVZOrderRepositoryMockDeleteOrderParamPtrs struct {
-	ctx     *context.Context
-	orderID *string
+	ctx             *context.Context
+	orderID         *string
+	expectedVersion *int64
 }
 
 // PVZOrderRepositoryMockDeleteOrderResults contains results of the PVZOrderRepository.DeleteOrder
@@ -488,9 +956,10 @@ type PVZOrderRepositoryMockDeleteOrderResults struct {
 
 // PVZOrderRepositoryMockDeleteOrderOrigins contains origins of expectations of the PVZOrderRepository.DeleteOrder
 type PVZOrderRepositoryMockDeleteOrderExpectationOrigins struct {
-	origin        string
-	originCtx     string
-	originOrderID string
+	origin                string
+	originCtx             string
+	originOrderID         string
+	originExpectedVersion string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -504,7 +973,7 @@ func (mmDeleteOrder *mPVZOrderRepositoryMockDeleteOrder) Optional() *mPVZOrderRe
 }
 
 // Expect sets up expected params for PVZOrderRepository.DeleteOrder
-func (mmDeleteOrder *mPVZOrderRepositoryMockDeleteOrder) Expect(ctx context.Context, orderID string) *mPVZOrderRepositoryMockDeleteOrder {
+func (mmDeleteOrder *mPVZOrderRepositoryMockDeleteOrder) Expect(ctx context.Context, orderID string, expectedVersion int64) *mPVZOrderRepositoryMockDeleteOrder {
 	if mmDeleteOrder.mock.funcDeleteOrder != nil {
 		mmDeleteOrder.mock.t.Fatalf("PVZOrderRepositoryMock.DeleteOrder mock is already set by Set")
 	}
@@ -517,7 +986,7 @@ func (mmDeleteOrder *mPVZOrderRepositoryMockDeleteOrder) Expect(ctx context.Cont
 		mmDeleteOrder.mock.t.Fatalf("PVZOrderRepositoryMock.DeleteOrder mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteOrder.defaultExpectation.params = &PVZOrderRepositoryMockDeleteOrderParams{ctx, orderID}
+	mmDeleteOrder.defaultExpectation.params = &PVZOrderRepositoryMockDeleteOrderParams{ctx, orderID, expectedVersion}
 	mmDeleteOrder.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmDeleteOrder.expectations {
 		if minimock.Equal(e.params, mmDeleteOrder.defaultExpectation.params) {
@@ -574,8 +1043,31 @@ func (mmDeleteOrder *mPVZOrderRepositoryMockDeleteOrder) ExpectOrderIDParam2(ord
 	return mmDeleteOrder
 }
 
+// ExpectExpectedVersionParam3 sets up expected param expectedVersion for PVZOrderRepository.DeleteOrder
+func (mmDeleteOrder *mPVZOrderRepositoryMockDeleteOrder) ExpectExpectedVersionParam3(expectedVersion int64) *mPVZOrderRepositoryMockDeleteOrder {
+	if mmDeleteOrder.mock.funcDeleteOrder != nil {
+		mmDeleteOrder.mock.t.Fatalf("PVZOrderRepositoryMock.DeleteOrder mock is already set by Set")
+	}
+
+	if mmDeleteOrder.defaultExpectation == nil {
+		mmDeleteOrder.defaultExpectation = &PVZOrderRepositoryMockDeleteOrderExpectation{}
+	}
+
+	if mmDeleteOrder.defaultExpectation.params != nil {
+		mmDeleteOrder.mock.t.Fatalf("PVZOrderRepositoryMock.DeleteOrder mock is already set by Expect")
+	}
+
+	if mmDeleteOrder.defaultExpectation.paramPtrs == nil {
+		mmDeleteOrder.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockDeleteOrderParamPtrs{}
+	}
+	mmDeleteOrder.defaultExpectation.paramPtrs.expectedVersion = &expectedVersion
+	mmDeleteOrder.defaultExpectation.expectationOrigins.originExpectedVersion = minimock.CallerInfo(1)
+
+	return mmDeleteOrder
+}
+
 // Inspect accepts an inspector function that has same arguments as the PVZOrderRepository.DeleteOrder
-func (mmDeleteOrder *mPVZOrderRepositoryMockDeleteOrder) Inspect(f func(ctx context.Context, orderID string)) *mPVZOrderRepositoryMockDeleteOrder {
+func (mmDeleteOrder *mPVZOrderRepositoryMockDeleteOrder) Inspect(f func(ctx context.Context, orderID string, expectedVersion int64)) *mPVZOrderRepositoryMockDeleteOrder {
 	if mmDeleteOrder.mock.inspectFuncDeleteOrder != nil {
 		mmDeleteOrder.mock.t.Fatalf("Inspect function is already set for PVZOrderRepositoryMock.DeleteOrder")
 	}
@@ -600,7 +1092,7 @@ func (mmDeleteOrder *mPVZOrderRepositoryMockDeleteOrder) Return(err error) *PVZO
 }
 
 // Set uses given function f to mock the PVZOrderRepository.DeleteOrder method
-func (mmDeleteOrder *mPVZOrderRepositoryMockDeleteOrder) Set(f func(ctx context.Context, orderID string) (err error)) *PVZOrderRepositoryMock {
+func (mmDeleteOrder *mPVZOrderRepositoryMockDeleteOrder) Set(f func(ctx context.Context, orderID string, expectedVersion int64) (err error)) *PVZOrderRepositoryMock {
 	if mmDeleteOrder.defaultExpectation != nil {
 		mmDeleteOrder.mock.t.Fatalf("Default expectation is already set for the PVZOrderRepository.DeleteOrder method")
 	}
@@ -616,14 +1108,14 @@ func (mmDeleteOrder *mPVZOrderRepositoryMockDeleteOrder) Set(f func(ctx context.
 
 // When sets expectation for the PVZOrderRepository.DeleteOrder which will trigger the result defined by the following
 // Then helper
-func (mmDeleteOrder *mPVZOrderRepositoryMockDeleteOrder) When(ctx context.Context, orderID string) *PVZOrderRepositoryMockDeleteOrderExpectation {
+func (mmDeleteOrder *mPVZOrderRepositoryMockDeleteOrder) When(ctx context.Context, orderID string, expectedVersion int64) *PVZOrderRepositoryMockDeleteOrderExpectation {
 	if mmDeleteOrder.mock.funcDeleteOrder != nil {
 		mmDeleteOrder.mock.t.Fatalf("PVZOrderRepositoryMock.DeleteOrder mock is already set by Set")
 	}
 
 	expectation := &PVZOrderRepositoryMockDeleteOrderExpectation{
 		mock:               mmDeleteOrder.mock,
-		params:             &PVZOrderRepositoryMockDeleteOrderParams{ctx, orderID},
+		params:             &PVZOrderRepositoryMockDeleteOrderParams{ctx, orderID, expectedVersion},
 		expectationOrigins: PVZOrderRepositoryMockDeleteOrderExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmDeleteOrder.expectations = append(mmDeleteOrder.expectations, expectation)
@@ -658,17 +1150,17 @@ func (mmDeleteOrder *mPVZOrderRepositoryMockDeleteOrder) invocationsDone() bool
 }
 
 // DeleteOrder implements mm_usecases.PVZOrderRepository
-func (mmDeleteOrder *PVZOrderRepositoryMock) DeleteOrder(ctx context.Context, orderID string) (err error) {
+func (mmDeleteOrder *PVZOrderRepositoryMock) DeleteOrder(ctx context.Context, orderID string, expectedVersion int64) (err error) {
 	mm_atomic.AddUint64(&mmDeleteOrder.beforeDeleteOrderCounter, 1)
 	defer mm_atomic.AddUint64(&mmDeleteOrder.afterDeleteOrderCounter, 1)
 
 	mmDeleteOrder.t.Helper()
 
 	if mmDeleteOrder.inspectFuncDeleteOrder != nil {
-		mmDeleteOrder.inspectFuncDeleteOrder(ctx, orderID)
+		mmDeleteOrder.inspectFuncDeleteOrder(ctx, orderID, expectedVersion)
 	}
 
-	mm_params := PVZOrderRepositoryMockDeleteOrderParams{ctx, orderID}
+	mm_params := PVZOrderRepositoryMockDeleteOrderParams{ctx, orderID, expectedVersion}
 
 	// Record call args
 	mmDeleteOrder.DeleteOrderMock.mutex.Lock()
@@ -687,7 +1179,7 @@ func (mmDeleteOrder *PVZOrderRepositoryMock) DeleteOrder(ctx context.Context, or
 		mm_want := mmDeleteOrder.DeleteOrderMock.defaultExpectation.params
 		mm_want_ptrs := mmDeleteOrder.DeleteOrderMock.defaultExpectation.paramPtrs
 
-		mm_got := PVZOrderRepositoryMockDeleteOrderParams{ctx, orderID}
+		mm_got := PVZOrderRepositoryMockDeleteOrderParams{ctx, orderID, expectedVersion}
 
 		if mm_want_ptrs != nil {
 
@@ -701,6 +1193,11 @@ func (mmDeleteOrder *PVZOrderRepositoryMock) DeleteOrder(ctx context.Context, or
 					mmDeleteOrder.DeleteOrderMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
 			}
 
+			if mm_want_ptrs.expectedVersion != nil && !minimock.Equal(*mm_want_ptrs.expectedVersion, mm_got.expectedVersion) {
+				mmDeleteOrder.t.Errorf("PVZOrderRepositoryMock.DeleteOrder got unexpected parameter expectedVersion, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteOrder.DeleteOrderMock.defaultExpectation.expectationOrigins.originExpectedVersion, *mm_want_ptrs.expectedVersion, mm_got.expectedVersion, minimock.Diff(*mm_want_ptrs.expectedVersion, mm_got.expectedVersion))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmDeleteOrder.t.Errorf("PVZOrderRepositoryMock.DeleteOrder got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmDeleteOrder.DeleteOrderMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -713,9 +1210,9 @@ func (mmDeleteOrder *PVZOrderRepositoryMock) DeleteOrder(ctx context.Context, or
 		return (*mm_results).err
 	}
 	if mmDeleteOrder.funcDeleteOrder != nil {
-		return mmDeleteOrder.funcDeleteOrder(ctx, orderID)
+		return mmDeleteOrder.funcDeleteOrder(ctx, orderID, expectedVersion)
 	}
-	mmDeleteOrder.t.Fatalf("Unexpected call to PVZOrderRepositoryMock.DeleteOrder. %v %v", ctx, orderID)
+	mmDeleteOrder.t.Fatalf("Unexpected call to PVZOrderRepositoryMock.DeleteOrder. %v %v %v", ctx, orderID, expectedVersion)
 	return
 }
 
@@ -787,34 +1284,500 @@ func (m *PVZOrderRepositoryMock) MinimockDeleteOrderInspect() {
 	}
 }
 
-type mPVZOrderRepositoryMockGetOrder struct {
+type mPVZOrderRepositoryMockExtendStorage struct {
 	optional           bool
 	mock               *PVZOrderRepositoryMock
-	defaultExpectation *PVZOrderRepositoryMockGetOrderExpectation
-	expectations       []*PVZOrderRepositoryMockGetOrderExpectation
+	defaultExpectation *PVZOrderRepositoryMockExtendStorageExpectation
+	expectations       []*PVZOrderRepositoryMockExtendStorageExpectation
 
-	callArgs []*PVZOrderRepositoryMockGetOrderParams
+	callArgs []*PVZOrderRepositoryMockExtendStorageParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// PVZOrderRepositoryMockGetOrderExpectation specifies expectation struct of the PVZOrderRepository.GetOrder
-type PVZOrderRepositoryMockGetOrderExpectation struct {
+// PVZOrderRepositoryMockExtendStorageExpectation specifies expectation struct of the PVZOrderRepository.ExtendStorage
+type PVZOrderRepositoryMockExtendStorageExpectation struct {
 	mock               *PVZOrderRepositoryMock
-	params             *PVZOrderRepositoryMockGetOrderParams
-	paramPtrs          *PVZOrderRepositoryMockGetOrderParamPtrs
-	expectationOrigins PVZOrderRepositoryMockGetOrderExpectationOrigins
-	results            *PVZOrderRepositoryMockGetOrderResults
+	params             *PVZOrderRepositoryMockExtendStorageParams
+	paramPtrs          *PVZOrderRepositoryMockExtendStorageParamPtrs
+	expectationOrigins PVZOrderRepositoryMockExtendStorageExpectationOrigins
+	results            *PVZOrderRepositoryMockExtendStorageResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// PVZOrderRepositoryMockGetOrderParams contains parameters of the PVZOrderRepository.GetOrder
-type PVZOrderRepositoryMockGetOrderParams struct {
-	ctx     context.Context
-	orderID string
+// PVZOrderRepositoryMockExtendStorageParams contains parameters of the PVZOrderRepository.ExtendStorage
+type PVZOrderRepositoryMockExtendStorageParams struct {
+	ctx             context.Context
+	orderID         string
+	extension       time.Duration
+	expiresAt       time.Time
+	extendedBy      string
+	expectedVersion int64
+}
+
+// PVZOrderRepositoryMockExtendStorageParamPtrs contains pointers to parameters of the PVZOrderRepository.ExtendStorage
+type PVZOrderRepositoryMockExtendStorageParamPtrs struct {
+	ctx             *context.Context
+	orderID         *string
+	extension       *time.Duration
+	expiresAt       *time.Time
+	extendedBy      *string
+	expectedVersion *int64
+}
+
+// PVZOrderRepositoryMockExtendStorageResults contains results of the PVZOrderRepository.ExtendStorage
+type PVZOrderRepositoryMockExtendStorageResults struct {
+	err error
+}
+
+// PVZOrderRepositoryMockExtendStorageOrigins contains origins of expectations of the PVZOrderRepository.ExtendStorage
+type PVZOrderRepositoryMockExtendStorageExpectationOrigins struct {
+	origin                string
+	originCtx             string
+	originOrderID         string
+	originExtension       string
+	originExpiresAt       string
+	originExtendedBy      string
+	originExpectedVersion string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExtendStorage *mPVZOrderRepositoryMockExtendStorage) Optional() *mPVZOrderRepositoryMockExtendStorage {
+	mmExtendStorage.optional = true
+	return mmExtendStorage
+}
+
+// Expect sets up expected params for PVZOrderRepository.ExtendStorage
+func (mmExtendStorage *mPVZOrderRepositoryMockExtendStorage) Expect(ctx context.Context, orderID string, extension time.Duration, expiresAt time.Time, extendedBy string, expectedVersion int64) *mPVZOrderRepositoryMockExtendStorage {
+	if mmExtendStorage.mock.funcExtendStorage != nil {
+		mmExtendStorage.mock.t.Fatalf("PVZOrderRepositoryMock.ExtendStorage mock is already set by Set")
+	}
+
+	if mmExtendStorage.defaultExpectation == nil {
+		mmExtendStorage.defaultExpectation = &PVZOrderRepositoryMockExtendStorageExpectation{}
+	}
+
+	if mmExtendStorage.defaultExpectation.paramPtrs != nil {
+		mmExtendStorage.mock.t.Fatalf("PVZOrderRepositoryMock.ExtendStorage mock is already set by ExpectParams functions")
+	}
+
+	mmExtendStorage.defaultExpectation.params = &PVZOrderRepositoryMockExtendStorageParams{ctx, orderID, extension, expiresAt, extendedBy, expectedVersion}
+	mmExtendStorage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExtendStorage.expectations {
+		if minimock.Equal(e.params, mmExtendStorage.defaultExpectation.params) {
+			mmExtendStorage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExtendStorage.defaultExpectation.params)
+		}
+	}
+
+	return mmExtendStorage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PVZOrderRepository.ExtendStorage
+func (mmExtendStorage *mPVZOrderRepositoryMockExtendStorage) ExpectCtxParam1(ctx context.Context) *mPVZOrderRepositoryMockExtendStorage {
+	if mmExtendStorage.mock.funcExtendStorage != nil {
+		mmExtendStorage.mock.t.Fatalf("PVZOrderRepositoryMock.ExtendStorage mock is already set by Set")
+	}
+
+	if mmExtendStorage.defaultExpectation == nil {
+		mmExtendStorage.defaultExpectation = &PVZOrderRepositoryMockExtendStorageExpectation{}
+	}
+
+	if mmExtendStorage.defaultExpectation.params != nil {
+		mmExtendStorage.mock.t.Fatalf("PVZOrderRepositoryMock.ExtendStorage mock is already set by Expect")
+	}
+
+	if mmExtendStorage.defaultExpectation.paramPtrs == nil {
+		mmExtendStorage.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockExtendStorageParamPtrs{}
+	}
+	mmExtendStorage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExtendStorage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExtendStorage
+}
+
+// ExpectOrderIDParam2 sets up expected param orderID for PVZOrderRepository.ExtendStorage
+func (mmExtendStorage *mPVZOrderRepositoryMockExtendStorage) ExpectOrderIDParam2(orderID string) *mPVZOrderRepositoryMockExtendStorage {
+	if mmExtendStorage.mock.funcExtendStorage != nil {
+		mmExtendStorage.mock.t.Fatalf("PVZOrderRepositoryMock.ExtendStorage mock is already set by Set")
+	}
+
+	if mmExtendStorage.defaultExpectation == nil {
+		mmExtendStorage.defaultExpectation = &PVZOrderRepositoryMockExtendStorageExpectation{}
+	}
+
+	if mmExtendStorage.defaultExpectation.params != nil {
+		mmExtendStorage.mock.t.Fatalf("PVZOrderRepositoryMock.ExtendStorage mock is already set by Expect")
+	}
+
+	if mmExtendStorage.defaultExpectation.paramPtrs == nil {
+		mmExtendStorage.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockExtendStorageParamPtrs{}
+	}
+	mmExtendStorage.defaultExpectation.paramPtrs.orderID = &orderID
+	mmExtendStorage.defaultExpectation.expectationOrigins.originOrderID = minimock.CallerInfo(1)
+
+	return mmExtendStorage
+}
+
+// ExpectExtensionParam3 sets up expected param extension for PVZOrderRepository.ExtendStorage
+func (mmExtendStorage *mPVZOrderRepositoryMockExtendStorage) ExpectExtensionParam3(extension time.Duration) *mPVZOrderRepositoryMockExtendStorage {
+	if mmExtendStorage.mock.funcExtendStorage != nil {
+		mmExtendStorage.mock.t.Fatalf("PVZOrderRepositoryMock.ExtendStorage mock is already set by Set")
+	}
+
+	if mmExtendStorage.defaultExpectation == nil {
+		mmExtendStorage.defaultExpectation = &PVZOrderRepositoryMockExtendStorageExpectation{}
+	}
+
+	if mmExtendStorage.defaultExpectation.params != nil {
+		mmExtendStorage.mock.t.Fatalf("PVZOrderRepositoryMock.ExtendStorage mock is already set by Expect")
+	}
+
+	if mmExtendStorage.defaultExpectation.paramPtrs == nil {
+		mmExtendStorage.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockExtendStorageParamPtrs{}
+	}
+	mmExtendStorage.defaultExpectation.paramPtrs.extension = &extension
+	mmExtendStorage.defaultExpectation.expectationOrigins.originExtension = minimock.CallerInfo(1)
+
+	return mmExtendStorage
+}
+
+// ExpectExpiresAtParam4 sets up expected param expiresAt for PVZOrderRepository.ExtendStorage
+func (mmExtendStorage *mPVZOrderRepositoryMockExtendStorage) ExpectExpiresAtParam4(expiresAt time.Time) *mPVZOrderRepositoryMockExtendStorage {
+	if mmExtendStorage.mock.funcExtendStorage != nil {
+		mmExtendStorage.mock.t.Fatalf("PVZOrderRepositoryMock.ExtendStorage mock is already set by Set")
+	}
+
+	if mmExtendStorage.defaultExpectation == nil {
+		mmExtendStorage.defaultExpectation = &PVZOrderRepositoryMockExtendStorageExpectation{}
+	}
+
+	if mmExtendStorage.defaultExpectation.params != nil {
+		mmExtendStorage.mock.t.Fatalf("PVZOrderRepositoryMock.ExtendStorage mock is already set by Expect")
+	}
+
+	if mmExtendStorage.defaultExpectation.paramPtrs == nil {
+		mmExtendStorage.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockExtendStorageParamPtrs{}
+	}
+	mmExtendStorage.defaultExpectation.paramPtrs.expiresAt = &expiresAt
+	mmExtendStorage.defaultExpectation.expectationOrigins.originExpiresAt = minimock.CallerInfo(1)
+
+	return mmExtendStorage
+}
+
+// ExpectExtendedByParam5 sets up expected param extendedBy for PVZOrderRepository.ExtendStorage
+func (mmExtendStorage *mPVZOrderRepositoryMockExtendStorage) ExpectExtendedByParam5(extendedBy string) *mPVZOrderRepositoryMockExtendStorage {
+	if mmExtendStorage.mock.funcExtendStorage != nil {
+		mmExtendStorage.mock.t.Fatalf("PVZOrderRepositoryMock.ExtendStorage mock is already set by Set")
+	}
+
+	if mmExtendStorage.defaultExpectation == nil {
+		mmExtendStorage.defaultExpectation = &PVZOrderRepositoryMockExtendStorageExpectation{}
+	}
+
+	if mmExtendStorage.defaultExpectation.params != nil {
+		mmExtendStorage.mock.t.Fatalf("PVZOrderRepositoryMock.ExtendStorage mock is already set by Expect")
+	}
+
+	if mmExtendStorage.defaultExpectation.paramPtrs == nil {
+		mmExtendStorage.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockExtendStorageParamPtrs{}
+	}
+	mmExtendStorage.defaultExpectation.paramPtrs.extendedBy = &extendedBy
+	mmExtendStorage.defaultExpectation.expectationOrigins.originExtendedBy = minimock.CallerInfo(1)
+
+	return mmExtendStorage
+}
+
+// ExpectExpectedVersionParam6 sets up expected param expectedVersion for PVZOrderRepository.ExtendStorage
+func (mmExtendStorage *mPVZOrderRepositoryMockExtendStorage) ExpectExpectedVersionParam6(expectedVersion int64) *mPVZOrderRepositoryMockExtendStorage {
+	if mmExtendStorage.mock.funcExtendStorage != nil {
+		mmExtendStorage.mock.t.Fatalf("PVZOrderRepositoryMock.ExtendStorage mock is already set by Set")
+	}
+
+	if mmExtendStorage.defaultExpectation == nil {
+		mmExtendStorage.defaultExpectation = &PVZOrderRepositoryMockExtendStorageExpectation{}
+	}
+
+	if mmExtendStorage.defaultExpectation.params != nil {
+		mmExtendStorage.mock.t.Fatalf("PVZOrderRepositoryMock.ExtendStorage mock is already set by Expect")
+	}
+
+	if mmExtendStorage.defaultExpectation.paramPtrs == nil {
+		mmExtendStorage.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockExtendStorageParamPtrs{}
+	}
+	mmExtendStorage.defaultExpectation.paramPtrs.expectedVersion = &expectedVersion
+	mmExtendStorage.defaultExpectation.expectationOrigins.originExpectedVersion = minimock.CallerInfo(1)
+
+	return mmExtendStorage
+}
+
+// Inspect accepts an inspector function that has same arguments as the PVZOrderRepository.ExtendStorage
+func (mmExtendStorage *mPVZOrderRepositoryMockExtendStorage) Inspect(f func(ctx context.Context, orderID string, extension time.Duration, expiresAt time.Time, extendedBy string, expectedVersion int64)) *mPVZOrderRepositoryMockExtendStorage {
+	if mmExtendStorage.mock.inspectFuncExtendStorage != nil {
+		mmExtendStorage.mock.t.Fatalf("Inspect function is already set for PVZOrderRepositoryMock.ExtendStorage")
+	}
+
+	mmExtendStorage.mock.inspectFuncExtendStorage = f
+
+	return mmExtendStorage
+}
+
+// Return sets up results that will be returned by PVZOrderRepository.ExtendStorage
+func (mmExtendStorage *mPVZOrderRepositoryMockExtendStorage) Return(err error) *PVZOrderRepositoryMock {
+	if mmExtendStorage.mock.funcExtendStorage != nil {
+		mmExtendStorage.mock.t.Fatalf("PVZOrderRepositoryMock.ExtendStorage mock is already set by Set")
+	}
+
+	if mmExtendStorage.defaultExpectation == nil {
+		mmExtendStorage.defaultExpectation = &PVZOrderRepositoryMockExtendStorageExpectation{mock: mmExtendStorage.mock}
+	}
+	mmExtendStorage.defaultExpectation.results = &PVZOrderRepositoryMockExtendStorageResults{err}
+	mmExtendStorage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExtendStorage.mock
+}
+
+// Set uses given function f to mock the PVZOrderRepository.ExtendStorage method
+func (mmExtendStorage *mPVZOrderRepositoryMockExtendStorage) Set(f func(ctx context.Context, orderID string, extension time.Duration, expiresAt time.Time, extendedBy string, expectedVersion int64) (err error)) *PVZOrderRepositoryMock {
+	if mmExtendStorage.defaultExpectation != nil {
+		mmExtendStorage.mock.t.Fatalf("Default expectation is already set for the PVZOrderRepository.ExtendStorage method")
+	}
+
+	if len(mmExtendStorage.expectations) > 0 {
+		mmExtendStorage.mock.t.Fatalf("Some expectations are already set for the PVZOrderRepository.ExtendStorage method")
+	}
+
+	mmExtendStorage.mock.funcExtendStorage = f
+	mmExtendStorage.mock.funcExtendStorageOrigin = minimock.CallerInfo(1)
+	return mmExtendStorage.mock
+}
+
+// When sets expectation for the PVZOrderRepository.ExtendStorage which will trigger the result defined by the following
+// Then helper
+func (mmExtendStorage *mPVZOrderRepositoryMockExtendStorage) When(ctx context.Context, orderID string, extension time.Duration, expiresAt time.Time, extendedBy string, expectedVersion int64) *PVZOrderRepositoryMockExtendStorageExpectation {
+	if mmExtendStorage.mock.funcExtendStorage != nil {
+		mmExtendStorage.mock.t.Fatalf("PVZOrderRepositoryMock.ExtendStorage mock is already set by Set")
+	}
+
+	expectation := &PVZOrderRepositoryMockExtendStorageExpectation{
+		mock:               mmExtendStorage.mock,
+		params:             &PVZOrderRepositoryMockExtendStorageParams{ctx, orderID, extension, expiresAt, extendedBy, expectedVersion},
+		expectationOrigins: PVZOrderRepositoryMockExtendStorageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExtendStorage.expectations = append(mmExtendStorage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PVZOrderRepository.ExtendStorage return parameters for the expectation previously defined by the When method
+func (e *PVZOrderRepositoryMockExtendStorageExpectation) Then(err error) *PVZOrderRepositoryMock {
+	e.results = &PVZOrderRepositoryMockExtendStorageResults{err}
+	return e.mock
+}
+
+// Times sets number of times PVZOrderRepository.ExtendStorage should be invoked
+func (mmExtendStorage *mPVZOrderRepositoryMockExtendStorage) Times(n uint64) *mPVZOrderRepositoryMockExtendStorage {
+	if n == 0 {
+		mmExtendStorage.mock.t.Fatalf("Times of PVZOrderRepositoryMock.ExtendStorage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExtendStorage.expectedInvocations, n)
+	mmExtendStorage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExtendStorage
+}
+
+func (mmExtendStorage *mPVZOrderRepositoryMockExtendStorage) invocationsDone() bool {
+	if len(mmExtendStorage.expectations) == 0 && mmExtendStorage.defaultExpectation == nil && mmExtendStorage.mock.funcExtendStorage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExtendStorage.mock.afterExtendStorageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExtendStorage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ExtendStorage implements mm_usecases.PVZOrderRepository
+func (mmExtendStorage *PVZOrderRepositoryMock) ExtendStorage(ctx context.Context, orderID string, extension time.Duration, expiresAt time.Time, extendedBy string, expectedVersion int64) (err error) {
+	mm_atomic.AddUint64(&mmExtendStorage.beforeExtendStorageCounter, 1)
+	defer mm_atomic.AddUint64(&mmExtendStorage.afterExtendStorageCounter, 1)
+
+	mmExtendStorage.t.Helper()
+
+	if mmExtendStorage.inspectFuncExtendStorage != nil {
+		mmExtendStorage.inspectFuncExtendStorage(ctx, orderID, extension, expiresAt, extendedBy, expectedVersion)
+	}
+
+	mm_params := PVZOrderRepositoryMockExtendStorageParams{ctx, orderID, extension, expiresAt, extendedBy, expectedVersion}
+
+	// Record call args
+	mmExtendStorage.ExtendStorageMock.mutex.Lock()
+	mmExtendStorage.ExtendStorageMock.callArgs = append(mmExtendStorage.ExtendStorageMock.callArgs, &mm_params)
+	mmExtendStorage.ExtendStorageMock.mutex.Unlock()
+
+	for _, e := range mmExtendStorage.ExtendStorageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmExtendStorage.ExtendStorageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExtendStorage.ExtendStorageMock.defaultExpectation.Counter, 1)
+		mm_want := mmExtendStorage.ExtendStorageMock.defaultExpectation.params
+		mm_want_ptrs := mmExtendStorage.ExtendStorageMock.defaultExpectation.paramPtrs
+
+		mm_got := PVZOrderRepositoryMockExtendStorageParams{ctx, orderID, extension, expiresAt, extendedBy, expectedVersion}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExtendStorage.t.Errorf("PVZOrderRepositoryMock.ExtendStorage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExtendStorage.ExtendStorageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmExtendStorage.t.Errorf("PVZOrderRepositoryMock.ExtendStorage got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExtendStorage.ExtendStorageMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			}
+
+			if mm_want_ptrs.extension != nil && !minimock.Equal(*mm_want_ptrs.extension, mm_got.extension) {
+				mmExtendStorage.t.Errorf("PVZOrderRepositoryMock.ExtendStorage got unexpected parameter extension, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExtendStorage.ExtendStorageMock.defaultExpectation.expectationOrigins.originExtension, *mm_want_ptrs.extension, mm_got.extension, minimock.Diff(*mm_want_ptrs.extension, mm_got.extension))
+			}
+
+			if mm_want_ptrs.expiresAt != nil && !minimock.Equal(*mm_want_ptrs.expiresAt, mm_got.expiresAt) {
+				mmExtendStorage.t.Errorf("PVZOrderRepositoryMock.ExtendStorage got unexpected parameter expiresAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExtendStorage.ExtendStorageMock.defaultExpectation.expectationOrigins.originExpiresAt, *mm_want_ptrs.expiresAt, mm_got.expiresAt, minimock.Diff(*mm_want_ptrs.expiresAt, mm_got.expiresAt))
+			}
+
+			if mm_want_ptrs.extendedBy != nil && !minimock.Equal(*mm_want_ptrs.extendedBy, mm_got.extendedBy) {
+				mmExtendStorage.t.Errorf("PVZOrderRepositoryMock.ExtendStorage got unexpected parameter extendedBy, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExtendStorage.ExtendStorageMock.defaultExpectation.expectationOrigins.originExtendedBy, *mm_want_ptrs.extendedBy, mm_got.extendedBy, minimock.Diff(*mm_want_ptrs.extendedBy, mm_got.extendedBy))
+			}
+
+			if mm_want_ptrs.expectedVersion != nil && !minimock.Equal(*mm_want_ptrs.expectedVersion, mm_got.expectedVersion) {
+				mmExtendStorage.t.Errorf("PVZOrderRepositoryMock.ExtendStorage got unexpected parameter expectedVersion, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExtendStorage.ExtendStorageMock.defaultExpectation.expectationOrigins.originExpectedVersion, *mm_want_ptrs.expectedVersion, mm_got.expectedVersion, minimock.Diff(*mm_want_ptrs.expectedVersion, mm_got.expectedVersion))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExtendStorage.t.Errorf("PVZOrderRepositoryMock.ExtendStorage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExtendStorage.ExtendStorageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExtendStorage.ExtendStorageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExtendStorage.t.Fatal("No results are set for the PVZOrderRepositoryMock.ExtendStorage")
+		}
+		return (*mm_results).err
+	}
+	if mmExtendStorage.funcExtendStorage != nil {
+		return mmExtendStorage.funcExtendStorage(ctx, orderID, extension, expiresAt, extendedBy, expectedVersion)
+	}
+	mmExtendStorage.t.Fatalf("Unexpected call to PVZOrderRepositoryMock.ExtendStorage. %v %v %v %v %v %v", ctx, orderID, extension, expiresAt, extendedBy, expectedVersion)
+	return
+}
+
+// ExtendStorageAfterCounter returns a count of finished PVZOrderRepositoryMock.ExtendStorage invocations
+func (mmExtendStorage *PVZOrderRepositoryMock) ExtendStorageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExtendStorage.afterExtendStorageCounter)
+}
+
+// ExtendStorageBeforeCounter returns a count of PVZOrderRepositoryMock.ExtendStorage invocations
+func (mmExtendStorage *PVZOrderRepositoryMock) ExtendStorageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExtendStorage.beforeExtendStorageCounter)
+}
+
+// Calls returns a list of arguments used in each call to PVZOrderRepositoryMock.ExtendStorage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExtendStorage *mPVZOrderRepositoryMockExtendStorage) Calls() []*PVZOrderRepositoryMockExtendStorageParams {
+	mmExtendStorage.mutex.RLock()
+
+	argCopy := make([]*PVZOrderRepositoryMockExtendStorageParams, len(mmExtendStorage.callArgs))
+	copy(argCopy, mmExtendStorage.callArgs)
+
+	mmExtendStorage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExtendStorageDone returns true if the count of the ExtendStorage invocations corresponds
+// the number of defined expectations
+func (m *PVZOrderRepositoryMock) MinimockExtendStorageDone() bool {
+	if m.ExtendStorageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExtendStorageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExtendStorageMock.invocationsDone()
+}
+
+// MinimockExtendStorageInspect logs each unmet expectation
+func (m *PVZOrderRepositoryMock) MinimockExtendStorageInspect() {
+	for _, e := range m.ExtendStorageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PVZOrderRepositoryMock.ExtendStorage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExtendStorageCounter := mm_atomic.LoadUint64(&m.afterExtendStorageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExtendStorageMock.defaultExpectation != nil && afterExtendStorageCounter < 1 {
+		if m.ExtendStorageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PVZOrderRepositoryMock.ExtendStorage at\n%s", m.ExtendStorageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PVZOrderRepositoryMock.ExtendStorage at\n%s with params: %#v", m.ExtendStorageMock.defaultExpectation.expectationOrigins.origin, *m.ExtendStorageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExtendStorage != nil && afterExtendStorageCounter < 1 {
+		m.t.Errorf("Expected call to PVZOrderRepositoryMock.ExtendStorage at\n%s", m.funcExtendStorageOrigin)
+	}
+
+	if !m.ExtendStorageMock.invocationsDone() && afterExtendStorageCounter > 0 {
+		m.t.Errorf("Expected %d calls to PVZOrderRepositoryMock.ExtendStorage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExtendStorageMock.expectedInvocations), m.ExtendStorageMock.expectedInvocationsOrigin, afterExtendStorageCounter)
+	}
+}
+
+type mPVZOrderRepositoryMockGetOrder struct {
+	optional           bool
+	mock               *PVZOrderRepositoryMock
+	defaultExpectation *PVZOrderRepositoryMockGetOrderExpectation
+	expectations       []*PVZOrderRepositoryMockGetOrderExpectation
+
+	callArgs []*PVZOrderRepositoryMockGetOrderParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PVZOrderRepositoryMockGetOrderExpectation specifies expectation struct of the PVZOrderRepository.GetOrder
+type PVZOrderRepositoryMockGetOrderExpectation struct {
+	mock               *PVZOrderRepositoryMock
+	params             *PVZOrderRepositoryMockGetOrderParams
+	paramPtrs          *PVZOrderRepositoryMockGetOrderParamPtrs
+	expectationOrigins PVZOrderRepositoryMockGetOrderExpectationOrigins
+	results            *PVZOrderRepositoryMockGetOrderResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PVZOrderRepositoryMockGetOrderParams contains parameters of the PVZOrderRepository.GetOrder
+type PVZOrderRepositoryMockGetOrderParams struct {
+	ctx     context.Context
+	orderID string
 }
 
 // PVZOrderRepositoryMockGetOrderParamPtrs contains pointers to parameters of the PVZOrderRepository.GetOrder
@@ -1130,52 +2093,395 @@ func (m *PVZOrderRepositoryMock) MinimockGetOrderInspect() {
 	}
 }
 
-type mPVZOrderRepositoryMockGetOrders struct {
+type mPVZOrderRepositoryMockGetOrderHistory struct {
 	optional           bool
 	mock               *PVZOrderRepositoryMock
-	defaultExpectation *PVZOrderRepositoryMockGetOrdersExpectation
-	expectations       []*PVZOrderRepositoryMockGetOrdersExpectation
+	defaultExpectation *PVZOrderRepositoryMockGetOrderHistoryExpectation
+	expectations       []*PVZOrderRepositoryMockGetOrderHistoryExpectation
 
-	callArgs []*PVZOrderRepositoryMockGetOrdersParams
+	callArgs []*PVZOrderRepositoryMockGetOrderHistoryParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// PVZOrderRepositoryMockGetOrdersExpectation specifies expectation struct of the PVZOrderRepository.GetOrders
-type PVZOrderRepositoryMockGetOrdersExpectation struct {
+// PVZOrderRepositoryMockGetOrderHistoryExpectation specifies expectation struct of the PVZOrderRepository.GetOrderHistory
+type PVZOrderRepositoryMockGetOrderHistoryExpectation struct {
 	mock               *PVZOrderRepositoryMock
-	params             *PVZOrderRepositoryMockGetOrdersParams
-	paramPtrs          *PVZOrderRepositoryMockGetOrdersParamPtrs
-	expectationOrigins PVZOrderRepositoryMockGetOrdersExpectationOrigins
-	results            *PVZOrderRepositoryMockGetOrdersResults
+	params             *PVZOrderRepositoryMockGetOrderHistoryParams
+	paramPtrs          *PVZOrderRepositoryMockGetOrderHistoryParamPtrs
+	expectationOrigins PVZOrderRepositoryMockGetOrderHistoryExpectationOrigins
+	results            *PVZOrderRepositoryMockGetOrderHistoryResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// PVZOrderRepositoryMockGetOrdersParams contains parameters of the PVZOrderRepository.GetOrders
-type PVZOrderRepositoryMockGetOrdersParams struct {
+// PVZOrderRepositoryMockGetOrderHistoryParams contains parameters of the PVZOrderRepository.GetOrderHistory
+type PVZOrderRepositoryMockGetOrderHistoryParams struct {
 	ctx     context.Context
-	userID  string
-	options []abstractions.GetOrdersOptFunc
+	orderID string
 }
 
-// PVZOrderRepositoryMockGetOrdersParamPtrs contains pointers to parameters of the PVZOrderRepository.GetOrders
-type PVZOrderRepositoryMockGetOrdersParamPtrs struct {
+// PVZOrderRepositoryMockGetOrderHistoryParamPtrs contains pointers to parameters of the PVZOrderRepository.GetOrderHistory
+type PVZOrderRepositoryMockGetOrderHistoryParamPtrs struct {
 	ctx     *context.Context
-	userID  *string
-	options *[]abstractions.GetOrdersOptFunc
+	orderID *string
 }
 
-// PVZOrderRepositoryMockGetOrdersResults contains results of the PVZOrderRepository.GetOrders
-type PVZOrderRepositoryMockGetOrdersResults struct {
-	pa1 []domain.PVZOrder
+// PVZOrderRepositoryMockGetOrderHistoryResults contains results of the PVZOrderRepository.GetOrderHistory
+type PVZOrderRepositoryMockGetOrderHistoryResults struct {
+	ea1 []domain.Event
 	err error
 }
 
-// PVZOrderRepositoryMockGetOrdersOrigins contains origins of expectations of the PVZOrderRepository.GetOrders
-type PVZOrderRepositoryMockGetOrdersExpectationOrigins struct {
+// PVZOrderRepositoryMockGetOrderHistoryOrigins contains origins of expectations of the PVZOrderRepository.GetOrderHistory
+type PVZOrderRepositoryMockGetOrderHistoryExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originOrderID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetOrderHistory *mPVZOrderRepositoryMockGetOrderHistory) Optional() *mPVZOrderRepositoryMockGetOrderHistory {
+	mmGetOrderHistory.optional = true
+	return mmGetOrderHistory
+}
+
+// Expect sets up expected params for PVZOrderRepository.GetOrderHistory
+func (mmGetOrderHistory *mPVZOrderRepositoryMockGetOrderHistory) Expect(ctx context.Context, orderID string) *mPVZOrderRepositoryMockGetOrderHistory {
+	if mmGetOrderHistory.mock.funcGetOrderHistory != nil {
+		mmGetOrderHistory.mock.t.Fatalf("PVZOrderRepositoryMock.GetOrderHistory mock is already set by Set")
+	}
+
+	if mmGetOrderHistory.defaultExpectation == nil {
+		mmGetOrderHistory.defaultExpectation = &PVZOrderRepositoryMockGetOrderHistoryExpectation{}
+	}
+
+	if mmGetOrderHistory.defaultExpectation.paramPtrs != nil {
+		mmGetOrderHistory.mock.t.Fatalf("PVZOrderRepositoryMock.GetOrderHistory mock is already set by ExpectParams functions")
+	}
+
+	mmGetOrderHistory.defaultExpectation.params = &PVZOrderRepositoryMockGetOrderHistoryParams{ctx, orderID}
+	mmGetOrderHistory.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOrderHistory.expectations {
+		if minimock.Equal(e.params, mmGetOrderHistory.defaultExpectation.params) {
+			mmGetOrderHistory.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrderHistory.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOrderHistory
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PVZOrderRepository.GetOrderHistory
+func (mmGetOrderHistory *mPVZOrderRepositoryMockGetOrderHistory) ExpectCtxParam1(ctx context.Context) *mPVZOrderRepositoryMockGetOrderHistory {
+	if mmGetOrderHistory.mock.funcGetOrderHistory != nil {
+		mmGetOrderHistory.mock.t.Fatalf("PVZOrderRepositoryMock.GetOrderHistory mock is already set by Set")
+	}
+
+	if mmGetOrderHistory.defaultExpectation == nil {
+		mmGetOrderHistory.defaultExpectation = &PVZOrderRepositoryMockGetOrderHistoryExpectation{}
+	}
+
+	if mmGetOrderHistory.defaultExpectation.params != nil {
+		mmGetOrderHistory.mock.t.Fatalf("PVZOrderRepositoryMock.GetOrderHistory mock is already set by Expect")
+	}
+
+	if mmGetOrderHistory.defaultExpectation.paramPtrs == nil {
+		mmGetOrderHistory.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockGetOrderHistoryParamPtrs{}
+	}
+	mmGetOrderHistory.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOrderHistory.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetOrderHistory
+}
+
+// ExpectOrderIDParam2 sets up expected param orderID for PVZOrderRepository.GetOrderHistory
+func (mmGetOrderHistory *mPVZOrderRepositoryMockGetOrderHistory) ExpectOrderIDParam2(orderID string) *mPVZOrderRepositoryMockGetOrderHistory {
+	if mmGetOrderHistory.mock.funcGetOrderHistory != nil {
+		mmGetOrderHistory.mock.t.Fatalf("PVZOrderRepositoryMock.GetOrderHistory mock is already set by Set")
+	}
+
+	if mmGetOrderHistory.defaultExpectation == nil {
+		mmGetOrderHistory.defaultExpectation = &PVZOrderRepositoryMockGetOrderHistoryExpectation{}
+	}
+
+	if mmGetOrderHistory.defaultExpectation.params != nil {
+		mmGetOrderHistory.mock.t.Fatalf("PVZOrderRepositoryMock.GetOrderHistory mock is already set by Expect")
+	}
+
+	if mmGetOrderHistory.defaultExpectation.paramPtrs == nil {
+		mmGetOrderHistory.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockGetOrderHistoryParamPtrs{}
+	}
+	mmGetOrderHistory.defaultExpectation.paramPtrs.orderID = &orderID
+	mmGetOrderHistory.defaultExpectation.expectationOrigins.originOrderID = minimock.CallerInfo(1)
+
+	return mmGetOrderHistory
+}
+
+// Inspect accepts an inspector function that has same arguments as the PVZOrderRepository.GetOrderHistory
+func (mmGetOrderHistory *mPVZOrderRepositoryMockGetOrderHistory) Inspect(f func(ctx context.Context, orderID string)) *mPVZOrderRepositoryMockGetOrderHistory {
+	if mmGetOrderHistory.mock.inspectFuncGetOrderHistory != nil {
+		mmGetOrderHistory.mock.t.Fatalf("Inspect function is already set for PVZOrderRepositoryMock.GetOrderHistory")
+	}
+
+	mmGetOrderHistory.mock.inspectFuncGetOrderHistory = f
+
+	return mmGetOrderHistory
+}
+
+// Return sets up results that will be returned by PVZOrderRepository.GetOrderHistory
+func (mmGetOrderHistory *mPVZOrderRepositoryMockGetOrderHistory) Return(ea1 []domain.Event, err error) *PVZOrderRepositoryMock {
+	if mmGetOrderHistory.mock.funcGetOrderHistory != nil {
+		mmGetOrderHistory.mock.t.Fatalf("PVZOrderRepositoryMock.GetOrderHistory mock is already set by Set")
+	}
+
+	if mmGetOrderHistory.defaultExpectation == nil {
+		mmGetOrderHistory.defaultExpectation = &PVZOrderRepositoryMockGetOrderHistoryExpectation{mock: mmGetOrderHistory.mock}
+	}
+	mmGetOrderHistory.defaultExpectation.results = &PVZOrderRepositoryMockGetOrderHistoryResults{ea1, err}
+	mmGetOrderHistory.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOrderHistory.mock
+}
+
+// Set uses given function f to mock the PVZOrderRepository.GetOrderHistory method
+func (mmGetOrderHistory *mPVZOrderRepositoryMockGetOrderHistory) Set(f func(ctx context.Context, orderID string) (ea1 []domain.Event, err error)) *PVZOrderRepositoryMock {
+	if mmGetOrderHistory.defaultExpectation != nil {
+		mmGetOrderHistory.mock.t.Fatalf("Default expectation is already set for the PVZOrderRepository.GetOrderHistory method")
+	}
+
+	if len(mmGetOrderHistory.expectations) > 0 {
+		mmGetOrderHistory.mock.t.Fatalf("Some expectations are already set for the PVZOrderRepository.GetOrderHistory method")
+	}
+
+	mmGetOrderHistory.mock.funcGetOrderHistory = f
+	mmGetOrderHistory.mock.funcGetOrderHistoryOrigin = minimock.CallerInfo(1)
+	return mmGetOrderHistory.mock
+}
+
+// When sets expectation for the PVZOrderRepository.GetOrderHistory which will trigger the result defined by the following
+// Then helper
+func (mmGetOrderHistory *mPVZOrderRepositoryMockGetOrderHistory) When(ctx context.Context, orderID string) *PVZOrderRepositoryMockGetOrderHistoryExpectation {
+	if mmGetOrderHistory.mock.funcGetOrderHistory != nil {
+		mmGetOrderHistory.mock.t.Fatalf("PVZOrderRepositoryMock.GetOrderHistory mock is already set by Set")
+	}
+
+	expectation := &PVZOrderRepositoryMockGetOrderHistoryExpectation{
+		mock:               mmGetOrderHistory.mock,
+		params:             &PVZOrderRepositoryMockGetOrderHistoryParams{ctx, orderID},
+		expectationOrigins: PVZOrderRepositoryMockGetOrderHistoryExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetOrderHistory.expectations = append(mmGetOrderHistory.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PVZOrderRepository.GetOrderHistory return parameters for the expectation previously defined by the When method
+func (e *PVZOrderRepositoryMockGetOrderHistoryExpectation) Then(ea1 []domain.Event, err error) *PVZOrderRepositoryMock {
+	e.results = &PVZOrderRepositoryMockGetOrderHistoryResults{ea1, err}
+	return e.mock
+}
+
+// Times sets number of times PVZOrderRepository.GetOrderHistory should be invoked
+func (mmGetOrderHistory *mPVZOrderRepositoryMockGetOrderHistory) Times(n uint64) *mPVZOrderRepositoryMockGetOrderHistory {
+	if n == 0 {
+		mmGetOrderHistory.mock.t.Fatalf("Times of PVZOrderRepositoryMock.GetOrderHistory mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetOrderHistory.expectedInvocations, n)
+	mmGetOrderHistory.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOrderHistory
+}
+
+func (mmGetOrderHistory *mPVZOrderRepositoryMockGetOrderHistory) invocationsDone() bool {
+	if len(mmGetOrderHistory.expectations) == 0 && mmGetOrderHistory.defaultExpectation == nil && mmGetOrderHistory.mock.funcGetOrderHistory == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOrderHistory.mock.afterGetOrderHistoryCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOrderHistory.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetOrderHistory implements mm_usecases.PVZOrderRepository
+func (mmGetOrderHistory *PVZOrderRepositoryMock) GetOrderHistory(ctx context.Context, orderID string) (ea1 []domain.Event, err error) {
+	mm_atomic.AddUint64(&mmGetOrderHistory.beforeGetOrderHistoryCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOrderHistory.afterGetOrderHistoryCounter, 1)
+
+	mmGetOrderHistory.t.Helper()
+
+	if mmGetOrderHistory.inspectFuncGetOrderHistory != nil {
+		mmGetOrderHistory.inspectFuncGetOrderHistory(ctx, orderID)
+	}
+
+	mm_params := PVZOrderRepositoryMockGetOrderHistoryParams{ctx, orderID}
+
+	// Record call args
+	mmGetOrderHistory.GetOrderHistoryMock.mutex.Lock()
+	mmGetOrderHistory.GetOrderHistoryMock.callArgs = append(mmGetOrderHistory.GetOrderHistoryMock.callArgs, &mm_params)
+	mmGetOrderHistory.GetOrderHistoryMock.mutex.Unlock()
+
+	for _, e := range mmGetOrderHistory.GetOrderHistoryMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ea1, e.results.err
+		}
+	}
+
+	if mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation.paramPtrs
+
+		mm_got := PVZOrderRepositoryMockGetOrderHistoryParams{ctx, orderID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetOrderHistory.t.Errorf("PVZOrderRepositoryMock.GetOrderHistory got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmGetOrderHistory.t.Errorf("PVZOrderRepositoryMock.GetOrderHistory got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOrderHistory.t.Errorf("PVZOrderRepositoryMock.GetOrderHistory got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOrderHistory.GetOrderHistoryMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOrderHistory.t.Fatal("No results are set for the PVZOrderRepositoryMock.GetOrderHistory")
+		}
+		return (*mm_results).ea1, (*mm_results).err
+	}
+	if mmGetOrderHistory.funcGetOrderHistory != nil {
+		return mmGetOrderHistory.funcGetOrderHistory(ctx, orderID)
+	}
+	mmGetOrderHistory.t.Fatalf("Unexpected call to PVZOrderRepositoryMock.GetOrderHistory. %v %v", ctx, orderID)
+	return
+}
+
+// GetOrderHistoryAfterCounter returns a count of finished PVZOrderRepositoryMock.GetOrderHistory invocations
+func (mmGetOrderHistory *PVZOrderRepositoryMock) GetOrderHistoryAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrderHistory.afterGetOrderHistoryCounter)
+}
+
+// GetOrderHistoryBeforeCounter returns a count of PVZOrderRepositoryMock.GetOrderHistory invocations
+func (mmGetOrderHistory *PVZOrderRepositoryMock) GetOrderHistoryBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrderHistory.beforeGetOrderHistoryCounter)
+}
+
+// Calls returns a list of arguments used in each call to PVZOrderRepositoryMock.GetOrderHistory.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOrderHistory *mPVZOrderRepositoryMockGetOrderHistory) Calls() []*PVZOrderRepositoryMockGetOrderHistoryParams {
+	mmGetOrderHistory.mutex.RLock()
+
+	argCopy := make([]*PVZOrderRepositoryMockGetOrderHistoryParams, len(mmGetOrderHistory.callArgs))
+	copy(argCopy, mmGetOrderHistory.callArgs)
+
+	mmGetOrderHistory.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOrderHistoryDone returns true if the count of the GetOrderHistory invocations corresponds
+// the number of defined expectations
+func (m *PVZOrderRepositoryMock) MinimockGetOrderHistoryDone() bool {
+	if m.GetOrderHistoryMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetOrderHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetOrderHistoryMock.invocationsDone()
+}
+
+// MinimockGetOrderHistoryInspect logs each unmet expectation
+func (m *PVZOrderRepositoryMock) MinimockGetOrderHistoryInspect() {
+	for _, e := range m.GetOrderHistoryMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PVZOrderRepositoryMock.GetOrderHistory at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetOrderHistoryCounter := mm_atomic.LoadUint64(&m.afterGetOrderHistoryCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrderHistoryMock.defaultExpectation != nil && afterGetOrderHistoryCounter < 1 {
+		if m.GetOrderHistoryMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PVZOrderRepositoryMock.GetOrderHistory at\n%s", m.GetOrderHistoryMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PVZOrderRepositoryMock.GetOrderHistory at\n%s with params: %#v", m.GetOrderHistoryMock.defaultExpectation.expectationOrigins.origin, *m.GetOrderHistoryMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrderHistory != nil && afterGetOrderHistoryCounter < 1 {
+		m.t.Errorf("Expected call to PVZOrderRepositoryMock.GetOrderHistory at\n%s", m.funcGetOrderHistoryOrigin)
+	}
+
+	if !m.GetOrderHistoryMock.invocationsDone() && afterGetOrderHistoryCounter > 0 {
+		m.t.Errorf("Expected %d calls to PVZOrderRepositoryMock.GetOrderHistory at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetOrderHistoryMock.expectedInvocations), m.GetOrderHistoryMock.expectedInvocationsOrigin, afterGetOrderHistoryCounter)
+	}
+}
+
+type mPVZOrderRepositoryMockGetOrders struct {
+	optional           bool
+	mock               *PVZOrderRepositoryMock
+	defaultExpectation *PVZOrderRepositoryMockGetOrdersExpectation
+	expectations       []*PVZOrderRepositoryMockGetOrdersExpectation
+
+	callArgs []*PVZOrderRepositoryMockGetOrdersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PVZOrderRepositoryMockGetOrdersExpectation specifies expectation struct of the PVZOrderRepository.GetOrders
+type PVZOrderRepositoryMockGetOrdersExpectation struct {
+	mock               *PVZOrderRepositoryMock
+	params             *PVZOrderRepositoryMockGetOrdersParams
+	paramPtrs          *PVZOrderRepositoryMockGetOrdersParamPtrs
+	expectationOrigins PVZOrderRepositoryMockGetOrdersExpectationOrigins
+	results            *PVZOrderRepositoryMockGetOrdersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PVZOrderRepositoryMockGetOrdersParams contains parameters of the PVZOrderRepository.GetOrders
+type PVZOrderRepositoryMockGetOrdersParams struct {
+	ctx     context.Context
+	userID  string
+	options []abstractions.GetOrdersOptFunc
+}
+
+// PVZOrderRepositoryMockGetOrdersParamPtrs contains pointers to parameters of the PVZOrderRepository.GetOrders
+type PVZOrderRepositoryMockGetOrdersParamPtrs struct {
+	ctx     *context.Context
+	userID  *string
+	options *[]abstractions.GetOrdersOptFunc
+}
+
+// PVZOrderRepositoryMockGetOrdersResults contains results of the PVZOrderRepository.GetOrders
+type PVZOrderRepositoryMockGetOrdersResults struct {
+	pa1 []domain.PVZOrder
+	err error
+}
+
+// PVZOrderRepositoryMockGetOrdersOrigins contains origins of expectations of the PVZOrderRepository.GetOrders
+type PVZOrderRepositoryMockGetOrdersExpectationOrigins struct {
 	origin        string
 	originCtx     string
 	originUserID  string
@@ -1531,12 +2837,14 @@ type PVZOrderRepositoryMockGetReturnsExpectation struct {
 // PVZOrderRepositoryMockGetReturnsParams contains parameters of the PVZOrderRepository.GetReturns
 type PVZOrderRepositoryMockGetReturnsParams struct {
 	ctx     context.Context
+	pvzID   string
 	options []abstractions.PagePaginationOptFunc
 }
 
 // PVZOrderRepositoryMockGetReturnsParamPtrs contains pointers to parameters of the PVZOrderRepository.GetReturns
 type PVZOrderRepositoryMockGetReturnsParamPtrs struct {
 	ctx     *context.Context
+	pvzID   *string
 	options *[]abstractions.PagePaginationOptFunc
 }
 
@@ -1550,6 +2858,7 @@ type PVZOrderRepositoryMockGetReturnsResults struct {
 type PVZOrderRepositoryMockGetReturnsExpectationOrigins struct {
 	origin        string
 	originCtx     string
+	originPvzID   string
 	originOptions string
 }
 
@@ -1564,7 +2873,7 @@ func (mmGetReturns *mPVZOrderRepositoryMockGetReturns) Optional() *mPVZOrderRepo
 }
 
 // Expect sets up expected params for PVZOrderRepository.GetReturns
-func (mmGetReturns *mPVZOrderRepositoryMockGetReturns) Expect(ctx context.Context, options ...abstractions.PagePaginationOptFunc) *mPVZOrderRepositoryMockGetReturns {
+func (mmGetReturns *mPVZOrderRepositoryMockGetReturns) Expect(ctx context.Context, pvzID string, options ...abstractions.PagePaginationOptFunc) *mPVZOrderRepositoryMockGetReturns {
 	if mmGetReturns.mock.funcGetReturns != nil {
 		mmGetReturns.mock.t.Fatalf("PVZOrderRepositoryMock.GetReturns mock is already set by Set")
 	}
@@ -1577,7 +2886,7 @@ func (mmGetReturns *mPVZOrderRepositoryMockGetReturns) Expect(ctx context.Contex
 		mmGetReturns.mock.t.Fatalf("PVZOrderRepositoryMock.GetReturns mock is already set by ExpectParams functions")
 	}
 
-	mmGetReturns.defaultExpectation.params = &PVZOrderRepositoryMockGetReturnsParams{ctx, options}
+	mmGetReturns.defaultExpectation.params = &PVZOrderRepositoryMockGetReturnsParams{ctx, pvzID, options}
 	mmGetReturns.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmGetReturns.expectations {
 		if minimock.Equal(e.params, mmGetReturns.defaultExpectation.params) {
@@ -1611,8 +2920,31 @@ func (mmGetReturns *mPVZOrderRepositoryMockGetReturns) ExpectCtxParam1(ctx conte
 	return mmGetReturns
 }
 
-// ExpectOptionsParam2 sets up expected param options for PVZOrderRepository.GetReturns
-func (mmGetReturns *mPVZOrderRepositoryMockGetReturns) ExpectOptionsParam2(options ...abstractions.PagePaginationOptFunc) *mPVZOrderRepositoryMockGetReturns {
+// ExpectPvzIDParam2 sets up expected param pvzID for PVZOrderRepository.GetReturns
+func (mmGetReturns *mPVZOrderRepositoryMockGetReturns) ExpectPvzIDParam2(pvzID string) *mPVZOrderRepositoryMockGetReturns {
+	if mmGetReturns.mock.funcGetReturns != nil {
+		mmGetReturns.mock.t.Fatalf("PVZOrderRepositoryMock.GetReturns mock is already set by Set")
+	}
+
+	if mmGetReturns.defaultExpectation == nil {
+		mmGetReturns.defaultExpectation = &PVZOrderRepositoryMockGetReturnsExpectation{}
+	}
+
+	if mmGetReturns.defaultExpectation.params != nil {
+		mmGetReturns.mock.t.Fatalf("PVZOrderRepositoryMock.GetReturns mock is already set by Expect")
+	}
+
+	if mmGetReturns.defaultExpectation.paramPtrs == nil {
+		mmGetReturns.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockGetReturnsParamPtrs{}
+	}
+	mmGetReturns.defaultExpectation.paramPtrs.pvzID = &pvzID
+	mmGetReturns.defaultExpectation.expectationOrigins.originPvzID = minimock.CallerInfo(1)
+
+	return mmGetReturns
+}
+
+// ExpectOptionsParam3 sets up expected param options for PVZOrderRepository.GetReturns
+func (mmGetReturns *mPVZOrderRepositoryMockGetReturns) ExpectOptionsParam3(options ...abstractions.PagePaginationOptFunc) *mPVZOrderRepositoryMockGetReturns {
 	if mmGetReturns.mock.funcGetReturns != nil {
 		mmGetReturns.mock.t.Fatalf("PVZOrderRepositoryMock.GetReturns mock is already set by Set")
 	}
@@ -1635,7 +2967,7 @@ func (mmGetReturns *mPVZOrderRepositoryMockGetReturns) ExpectOptionsParam2(optio
 }
 
 // Inspect accepts an inspector function that has same arguments as the PVZOrderRepository.GetReturns
-func (mmGetReturns *mPVZOrderRepositoryMockGetReturns) Inspect(f func(ctx context.Context, options ...abstractions.PagePaginationOptFunc)) *mPVZOrderRepositoryMockGetReturns {
+func (mmGetReturns *mPVZOrderRepositoryMockGetReturns) Inspect(f func(ctx context.Context, pvzID string, options ...abstractions.PagePaginationOptFunc)) *mPVZOrderRepositoryMockGetReturns {
 	if mmGetReturns.mock.inspectFuncGetReturns != nil {
 		mmGetReturns.mock.t.Fatalf("Inspect function is already set for PVZOrderRepositoryMock.GetReturns")
 	}
@@ -1660,7 +2992,7 @@ func (mmGetReturns *mPVZOrderRepositoryMockGetReturns) Return(pa1 []domain.PVZOr
 }
 
 // Set uses given function f to mock the PVZOrderRepository.GetReturns method
-func (mmGetReturns *mPVZOrderRepositoryMockGetReturns) Set(f func(ctx context.Context, options ...abstractions.PagePaginationOptFunc) (pa1 []domain.PVZOrder, err error)) *PVZOrderRepositoryMock {
+func (mmGetReturns *mPVZOrderRepositoryMockGetReturns) Set(f func(ctx context.Context, pvzID string, options ...abstractions.PagePaginationOptFunc) (pa1 []domain.PVZOrder, err error)) *PVZOrderRepositoryMock {
 	if mmGetReturns.defaultExpectation != nil {
 		mmGetReturns.mock.t.Fatalf("Default expectation is already set for the PVZOrderRepository.GetReturns method")
 	}
@@ -1676,14 +3008,14 @@ func (mmGetReturns *mPVZOrderRepositoryMockGetReturns) Set(f func(ctx context.Co
 
 // When sets expectation for the PVZOrderRepository.GetReturns which will trigger the result defined by the following
 // Then helper
-func (mmGetReturns *mPVZOrderRepositoryMockGetReturns) When(ctx context.Context, options ...abstractions.PagePaginationOptFunc) *PVZOrderRepositoryMockGetReturnsExpectation {
+func (mmGetReturns *mPVZOrderRepositoryMockGetReturns) When(ctx context.Context, pvzID string, options ...abstractions.PagePaginationOptFunc) *PVZOrderRepositoryMockGetReturnsExpectation {
 	if mmGetReturns.mock.funcGetReturns != nil {
 		mmGetReturns.mock.t.Fatalf("PVZOrderRepositoryMock.GetReturns mock is already set by Set")
 	}
 
 	expectation := &PVZOrderRepositoryMockGetReturnsExpectation{
 		mock:               mmGetReturns.mock,
-		params:             &PVZOrderRepositoryMockGetReturnsParams{ctx, options},
+		params:             &PVZOrderRepositoryMockGetReturnsParams{ctx, pvzID, options},
 		expectationOrigins: PVZOrderRepositoryMockGetReturnsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmGetReturns.expectations = append(mmGetReturns.expectations, expectation)
@@ -1718,17 +3050,17 @@ func (mmGetReturns *mPVZOrderRepositoryMockGetReturns) invocationsDone() bool {
 }
 
 // GetReturns implements mm_usecases.PVZOrderRepository
-func (mmGetReturns *PVZOrderRepositoryMock) GetReturns(ctx context.Context, options ...abstractions.PagePaginationOptFunc) (pa1 []domain.PVZOrder, err error) {
+func (mmGetReturns *PVZOrderRepositoryMock) GetReturns(ctx context.Context, pvzID string, options ...abstractions.PagePaginationOptFunc) (pa1 []domain.PVZOrder, err error) {
 	mm_atomic.AddUint64(&mmGetReturns.beforeGetReturnsCounter, 1)
 	defer mm_atomic.AddUint64(&mmGetReturns.afterGetReturnsCounter, 1)
 
 	mmGetReturns.t.Helper()
 
 	if mmGetReturns.inspectFuncGetReturns != nil {
-		mmGetReturns.inspectFuncGetReturns(ctx, options...)
+		mmGetReturns.inspectFuncGetReturns(ctx, pvzID, options...)
 	}
 
-	mm_params := PVZOrderRepositoryMockGetReturnsParams{ctx, options}
+	mm_params := PVZOrderRepositoryMockGetReturnsParams{ctx, pvzID, options}
 
 	// Record call args
 	mmGetReturns.GetReturnsMock.mutex.Lock()
@@ -1747,7 +3079,7 @@ func (mmGetReturns *PVZOrderRepositoryMock) GetReturns(ctx context.Context, opti
 		mm_want := mmGetReturns.GetReturnsMock.defaultExpectation.params
 		mm_want_ptrs := mmGetReturns.GetReturnsMock.defaultExpectation.paramPtrs
 
-		mm_got := PVZOrderRepositoryMockGetReturnsParams{ctx, options}
+		mm_got := PVZOrderRepositoryMockGetReturnsParams{ctx, pvzID, options}
 
 		if mm_want_ptrs != nil {
 
@@ -1756,6 +3088,11 @@ func (mmGetReturns *PVZOrderRepositoryMock) GetReturns(ctx context.Context, opti
 					mmGetReturns.GetReturnsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
+			if mm_want_ptrs.pvzID != nil && !minimock.Equal(*mm_want_ptrs.pvzID, mm_got.pvzID) {
+				mmGetReturns.t.Errorf("PVZOrderRepositoryMock.GetReturns got unexpected parameter pvzID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReturns.GetReturnsMock.defaultExpectation.expectationOrigins.originPvzID, *mm_want_ptrs.pvzID, mm_got.pvzID, minimock.Diff(*mm_want_ptrs.pvzID, mm_got.pvzID))
+			}
+
 			if mm_want_ptrs.options != nil && !minimock.Equal(*mm_want_ptrs.options, mm_got.options) {
 				mmGetReturns.t.Errorf("PVZOrderRepositoryMock.GetReturns got unexpected parameter options, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 					mmGetReturns.GetReturnsMock.defaultExpectation.expectationOrigins.originOptions, *mm_want_ptrs.options, mm_got.options, minimock.Diff(*mm_want_ptrs.options, mm_got.options))
@@ -1773,9 +3110,9 @@ func (mmGetReturns *PVZOrderRepositoryMock) GetReturns(ctx context.Context, opti
 		return (*mm_results).pa1, (*mm_results).err
 	}
 	if mmGetReturns.funcGetReturns != nil {
-		return mmGetReturns.funcGetReturns(ctx, options...)
+		return mmGetReturns.funcGetReturns(ctx, pvzID, options...)
 	}
-	mmGetReturns.t.Fatalf("Unexpected call to PVZOrderRepositoryMock.GetReturns. %v %v", ctx, options)
+	mmGetReturns.t.Fatalf("Unexpected call to PVZOrderRepositoryMock.GetReturns. %v %v %v", ctx, pvzID, options)
 	return
 }
 
@@ -1847,52 +3184,868 @@ func (m *PVZOrderRepositoryMock) MinimockGetReturnsInspect() {
 	}
 }
 
-type mPVZOrderRepositoryMockSetOrderIssued struct {
+type mPVZOrderRepositoryMockGetWeightDiscrepancies struct {
+	optional           bool
+	mock               *PVZOrderRepositoryMock
+	defaultExpectation *PVZOrderRepositoryMockGetWeightDiscrepanciesExpectation
+	expectations       []*PVZOrderRepositoryMockGetWeightDiscrepanciesExpectation
+
+	callArgs []*PVZOrderRepositoryMockGetWeightDiscrepanciesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PVZOrderRepositoryMockGetWeightDiscrepanciesExpectation specifies expectation struct of the PVZOrderRepository.GetWeightDiscrepancies
+type PVZOrderRepositoryMockGetWeightDiscrepanciesExpectation struct {
+	mock               *PVZOrderRepositoryMock
+	params             *PVZOrderRepositoryMockGetWeightDiscrepanciesParams
+	paramPtrs          *PVZOrderRepositoryMockGetWeightDiscrepanciesParamPtrs
+	expectationOrigins PVZOrderRepositoryMockGetWeightDiscrepanciesExpectationOrigins
+	results            *PVZOrderRepositoryMockGetWeightDiscrepanciesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PVZOrderRepositoryMockGetWeightDiscrepanciesParams contains parameters of the PVZOrderRepository.GetWeightDiscrepancies
+type PVZOrderRepositoryMockGetWeightDiscrepanciesParams struct {
+	ctx   context.Context
+	pvzID string
+	from  time.Time
+	to    time.Time
+}
+
+// PVZOrderRepositoryMockGetWeightDiscrepanciesParamPtrs contains pointers to parameters of the PVZOrderRepository.GetWeightDiscrepancies
+type PVZOrderRepositoryMockGetWeightDiscrepanciesParamPtrs struct {
+	ctx   *context.Context
+	pvzID *string
+	from  *time.Time
+	to    *time.Time
+}
+
+// PVZOrderRepositoryMockGetWeightDiscrepanciesResults contains results of the PVZOrderRepository.GetWeightDiscrepancies
+type PVZOrderRepositoryMockGetWeightDiscrepanciesResults struct {
+	pa1 []domain.PVZOrder
+	err error
+}
+
+// PVZOrderRepositoryMockGetWeightDiscrepanciesOrigins contains origins of expectations of the PVZOrderRepository.GetWeightDiscrepancies
+type PVZOrderRepositoryMockGetWeightDiscrepanciesExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originPvzID string
+	originFrom  string
+	originTo    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetWeightDiscrepancies *mPVZOrderRepositoryMockGetWeightDiscrepancies) Optional() *mPVZOrderRepositoryMockGetWeightDiscrepancies {
+	mmGetWeightDiscrepancies.optional = true
+	return mmGetWeightDiscrepancies
+}
+
+// Expect sets up expected params for PVZOrderRepository.GetWeightDiscrepancies
+func (mmGetWeightDiscrepancies *mPVZOrderRepositoryMockGetWeightDiscrepancies) Expect(ctx context.Context, pvzID string, from time.Time, to time.Time) *mPVZOrderRepositoryMockGetWeightDiscrepancies {
+	if mmGetWeightDiscrepancies.mock.funcGetWeightDiscrepancies != nil {
+		mmGetWeightDiscrepancies.mock.t.Fatalf("PVZOrderRepositoryMock.GetWeightDiscrepancies mock is already set by Set")
+	}
+
+	if mmGetWeightDiscrepancies.defaultExpectation == nil {
+		mmGetWeightDiscrepancies.defaultExpectation = &PVZOrderRepositoryMockGetWeightDiscrepanciesExpectation{}
+	}
+
+	if mmGetWeightDiscrepancies.defaultExpectation.paramPtrs != nil {
+		mmGetWeightDiscrepancies.mock.t.Fatalf("PVZOrderRepositoryMock.GetWeightDiscrepancies mock is already set by ExpectParams functions")
+	}
+
+	mmGetWeightDiscrepancies.defaultExpectation.params = &PVZOrderRepositoryMockGetWeightDiscrepanciesParams{ctx, pvzID, from, to}
+	mmGetWeightDiscrepancies.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetWeightDiscrepancies.expectations {
+		if minimock.Equal(e.params, mmGetWeightDiscrepancies.defaultExpectation.params) {
+			mmGetWeightDiscrepancies.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetWeightDiscrepancies.defaultExpectation.params)
+		}
+	}
+
+	return mmGetWeightDiscrepancies
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PVZOrderRepository.GetWeightDiscrepancies
+func (mmGetWeightDiscrepancies *mPVZOrderRepositoryMockGetWeightDiscrepancies) ExpectCtxParam1(ctx context.Context) *mPVZOrderRepositoryMockGetWeightDiscrepancies {
+	if mmGetWeightDiscrepancies.mock.funcGetWeightDiscrepancies != nil {
+		mmGetWeightDiscrepancies.mock.t.Fatalf("PVZOrderRepositoryMock.GetWeightDiscrepancies mock is already set by Set")
+	}
+
+	if mmGetWeightDiscrepancies.defaultExpectation == nil {
+		mmGetWeightDiscrepancies.defaultExpectation = &PVZOrderRepositoryMockGetWeightDiscrepanciesExpectation{}
+	}
+
+	if mmGetWeightDiscrepancies.defaultExpectation.params != nil {
+		mmGetWeightDiscrepancies.mock.t.Fatalf("PVZOrderRepositoryMock.GetWeightDiscrepancies mock is already set by Expect")
+	}
+
+	if mmGetWeightDiscrepancies.defaultExpectation.paramPtrs == nil {
+		mmGetWeightDiscrepancies.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockGetWeightDiscrepanciesParamPtrs{}
+	}
+	mmGetWeightDiscrepancies.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetWeightDiscrepancies.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetWeightDiscrepancies
+}
+
+// ExpectPvzIDParam2 sets up expected param pvzID for PVZOrderRepository.GetWeightDiscrepancies
+func (mmGetWeightDiscrepancies *mPVZOrderRepositoryMockGetWeightDiscrepancies) ExpectPvzIDParam2(pvzID string) *mPVZOrderRepositoryMockGetWeightDiscrepancies {
+	if mmGetWeightDiscrepancies.mock.funcGetWeightDiscrepancies != nil {
+		mmGetWeightDiscrepancies.mock.t.Fatalf("PVZOrderRepositoryMock.GetWeightDiscrepancies mock is already set by Set")
+	}
+
+	if mmGetWeightDiscrepancies.defaultExpectation == nil {
+		mmGetWeightDiscrepancies.defaultExpectation = &PVZOrderRepositoryMockGetWeightDiscrepanciesExpectation{}
+	}
+
+	if mmGetWeightDiscrepancies.defaultExpectation.params != nil {
+		mmGetWeightDiscrepancies.mock.t.Fatalf("PVZOrderRepositoryMock.GetWeightDiscrepancies mock is already set by Expect")
+	}
+
+	if mmGetWeightDiscrepancies.defaultExpectation.paramPtrs == nil {
+		mmGetWeightDiscrepancies.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockGetWeightDiscrepanciesParamPtrs{}
+	}
+	mmGetWeightDiscrepancies.defaultExpectation.paramPtrs.pvzID = &pvzID
+	mmGetWeightDiscrepancies.defaultExpectation.expectationOrigins.originPvzID = minimock.CallerInfo(1)
+
+	return mmGetWeightDiscrepancies
+}
+
+// ExpectFromParam3 sets up expected param from for PVZOrderRepository.GetWeightDiscrepancies
+func (mmGetWeightDiscrepancies *mPVZOrderRepositoryMockGetWeightDiscrepancies) ExpectFromParam3(from time.Time) *mPVZOrderRepositoryMockGetWeightDiscrepancies {
+	if mmGetWeightDiscrepancies.mock.funcGetWeightDiscrepancies != nil {
+		mmGetWeightDiscrepancies.mock.t.Fatalf("PVZOrderRepositoryMock.GetWeightDiscrepancies mock is already set by Set")
+	}
+
+	if mmGetWeightDiscrepancies.defaultExpectation == nil {
+		mmGetWeightDiscrepancies.defaultExpectation = &PVZOrderRepositoryMockGetWeightDiscrepanciesExpectation{}
+	}
+
+	if mmGetWeightDiscrepancies.defaultExpectation.params != nil {
+		mmGetWeightDiscrepancies.mock.t.Fatalf("PVZOrderRepositoryMock.GetWeightDiscrepancies mock is already set by Expect")
+	}
+
+	if mmGetWeightDiscrepancies.defaultExpectation.paramPtrs == nil {
+		mmGetWeightDiscrepancies.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockGetWeightDiscrepanciesParamPtrs{}
+	}
+	mmGetWeightDiscrepancies.defaultExpectation.paramPtrs.from = &from
+	mmGetWeightDiscrepancies.defaultExpectation.expectationOrigins.originFrom = minimock.CallerInfo(1)
+
+	return mmGetWeightDiscrepancies
+}
+
+// ExpectToParam4 sets up expected param to for PVZOrderRepository.GetWeightDiscrepancies
+func (mmGetWeightDiscrepancies *mPVZOrderRepositoryMockGetWeightDiscrepancies) ExpectToParam4(to time.Time) *mPVZOrderRepositoryMockGetWeightDiscrepancies {
+	if mmGetWeightDiscrepancies.mock.funcGetWeightDiscrepancies != nil {
+		mmGetWeightDiscrepancies.mock.t.Fatalf("PVZOrderRepositoryMock.GetWeightDiscrepancies mock is already set by Set")
+	}
+
+	if mmGetWeightDiscrepancies.defaultExpectation == nil {
+		mmGetWeightDiscrepancies.defaultExpectation = &PVZOrderRepositoryMockGetWeightDiscrepanciesExpectation{}
+	}
+
+	if mmGetWeightDiscrepancies.defaultExpectation.params != nil {
+		mmGetWeightDiscrepancies.mock.t.Fatalf("PVZOrderRepositoryMock.GetWeightDiscrepancies mock is already set by Expect")
+	}
+
+	if mmGetWeightDiscrepancies.defaultExpectation.paramPtrs == nil {
+		mmGetWeightDiscrepancies.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockGetWeightDiscrepanciesParamPtrs{}
+	}
+	mmGetWeightDiscrepancies.defaultExpectation.paramPtrs.to = &to
+	mmGetWeightDiscrepancies.defaultExpectation.expectationOrigins.originTo = minimock.CallerInfo(1)
+
+	return mmGetWeightDiscrepancies
+}
+
+// Inspect accepts an inspector function that has same arguments as the PVZOrderRepository.GetWeightDiscrepancies
+func (mmGetWeightDiscrepancies *mPVZOrderRepositoryMockGetWeightDiscrepancies) Inspect(f func(ctx context.Context, pvzID string, from time.Time, to time.Time)) *mPVZOrderRepositoryMockGetWeightDiscrepancies {
+	if mmGetWeightDiscrepancies.mock.inspectFuncGetWeightDiscrepancies != nil {
+		mmGetWeightDiscrepancies.mock.t.Fatalf("Inspect function is already set for PVZOrderRepositoryMock.GetWeightDiscrepancies")
+	}
+
+	mmGetWeightDiscrepancies.mock.inspectFuncGetWeightDiscrepancies = f
+
+	return mmGetWeightDiscrepancies
+}
+
+// Return sets up results that will be returned by PVZOrderRepository.GetWeightDiscrepancies
+func (mmGetWeightDiscrepancies *mPVZOrderRepositoryMockGetWeightDiscrepancies) Return(pa1 []domain.PVZOrder, err error) *PVZOrderRepositoryMock {
+	if mmGetWeightDiscrepancies.mock.funcGetWeightDiscrepancies != nil {
+		mmGetWeightDiscrepancies.mock.t.Fatalf("PVZOrderRepositoryMock.GetWeightDiscrepancies mock is already set by Set")
+	}
+
+	if mmGetWeightDiscrepancies.defaultExpectation == nil {
+		mmGetWeightDiscrepancies.defaultExpectation = &PVZOrderRepositoryMockGetWeightDiscrepanciesExpectation{mock: mmGetWeightDiscrepancies.mock}
+	}
+	mmGetWeightDiscrepancies.defaultExpectation.results = &PVZOrderRepositoryMockGetWeightDiscrepanciesResults{pa1, err}
+	mmGetWeightDiscrepancies.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetWeightDiscrepancies.mock
+}
+
+// Set uses given function f to mock the PVZOrderRepository.GetWeightDiscrepancies method
+func (mmGetWeightDiscrepancies *mPVZOrderRepositoryMockGetWeightDiscrepancies) Set(f func(ctx context.Context, pvzID string, from time.Time, to time.Time) (pa1 []domain.PVZOrder, err error)) *PVZOrderRepositoryMock {
+	if mmGetWeightDiscrepancies.defaultExpectation != nil {
+		mmGetWeightDiscrepancies.mock.t.Fatalf("Default expectation is already set for the PVZOrderRepository.GetWeightDiscrepancies method")
+	}
+
+	if len(mmGetWeightDiscrepancies.expectations) > 0 {
+		mmGetWeightDiscrepancies.mock.t.Fatalf("Some expectations are already set for the PVZOrderRepository.GetWeightDiscrepancies method")
+	}
+
+	mmGetWeightDiscrepancies.mock.funcGetWeightDiscrepancies = f
+	mmGetWeightDiscrepancies.mock.funcGetWeightDiscrepanciesOrigin = minimock.CallerInfo(1)
+	return mmGetWeightDiscrepancies.mock
+}
+
+// When sets expectation for the PVZOrderRepository.GetWeightDiscrepancies which will trigger the result defined by the following
+// Then helper
+func (mmGetWeightDiscrepancies *mPVZOrderRepositoryMockGetWeightDiscrepancies) When(ctx context.Context, pvzID string, from time.Time, to time.Time) *PVZOrderRepositoryMockGetWeightDiscrepanciesExpectation {
+	if mmGetWeightDiscrepancies.mock.funcGetWeightDiscrepancies != nil {
+		mmGetWeightDiscrepancies.mock.t.Fatalf("PVZOrderRepositoryMock.GetWeightDiscrepancies mock is already set by Set")
+	}
+
+	expectation := &PVZOrderRepositoryMockGetWeightDiscrepanciesExpectation{
+		mock:               mmGetWeightDiscrepancies.mock,
+		params:             &PVZOrderRepositoryMockGetWeightDiscrepanciesParams{ctx, pvzID, from, to},
+		expectationOrigins: PVZOrderRepositoryMockGetWeightDiscrepanciesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetWeightDiscrepancies.expectations = append(mmGetWeightDiscrepancies.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PVZOrderRepository.GetWeightDiscrepancies return parameters for the expectation previously defined by the When method
+func (e *PVZOrderRepositoryMockGetWeightDiscrepanciesExpectation) Then(pa1 []domain.PVZOrder, err error) *PVZOrderRepositoryMock {
+	e.results = &PVZOrderRepositoryMockGetWeightDiscrepanciesResults{pa1, err}
+	return e.mock
+}
+
+// Times sets number of times PVZOrderRepository.GetWeightDiscrepancies should be invoked
+func (mmGetWeightDiscrepancies *mPVZOrderRepositoryMockGetWeightDiscrepancies) Times(n uint64) *mPVZOrderRepositoryMockGetWeightDiscrepancies {
+	if n == 0 {
+		mmGetWeightDiscrepancies.mock.t.Fatalf("Times of PVZOrderRepositoryMock.GetWeightDiscrepancies mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetWeightDiscrepancies.expectedInvocations, n)
+	mmGetWeightDiscrepancies.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetWeightDiscrepancies
+}
+
+func (mmGetWeightDiscrepancies *mPVZOrderRepositoryMockGetWeightDiscrepancies) invocationsDone() bool {
+	if len(mmGetWeightDiscrepancies.expectations) == 0 && mmGetWeightDiscrepancies.defaultExpectation == nil && mmGetWeightDiscrepancies.mock.funcGetWeightDiscrepancies == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetWeightDiscrepancies.mock.afterGetWeightDiscrepanciesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetWeightDiscrepancies.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetWeightDiscrepancies implements mm_usecases.PVZOrderRepository
+func (mmGetWeightDiscrepancies *PVZOrderRepositoryMock) GetWeightDiscrepancies(ctx context.Context, pvzID string, from time.Time, to time.Time) (pa1 []domain.PVZOrder, err error) {
+	mm_atomic.AddUint64(&mmGetWeightDiscrepancies.beforeGetWeightDiscrepanciesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetWeightDiscrepancies.afterGetWeightDiscrepanciesCounter, 1)
+
+	mmGetWeightDiscrepancies.t.Helper()
+
+	if mmGetWeightDiscrepancies.inspectFuncGetWeightDiscrepancies != nil {
+		mmGetWeightDiscrepancies.inspectFuncGetWeightDiscrepancies(ctx, pvzID, from, to)
+	}
+
+	mm_params := PVZOrderRepositoryMockGetWeightDiscrepanciesParams{ctx, pvzID, from, to}
+
+	// Record call args
+	mmGetWeightDiscrepancies.GetWeightDiscrepanciesMock.mutex.Lock()
+	mmGetWeightDiscrepancies.GetWeightDiscrepanciesMock.callArgs = append(mmGetWeightDiscrepancies.GetWeightDiscrepanciesMock.callArgs, &mm_params)
+	mmGetWeightDiscrepancies.GetWeightDiscrepanciesMock.mutex.Unlock()
+
+	for _, e := range mmGetWeightDiscrepancies.GetWeightDiscrepanciesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.pa1, e.results.err
+		}
+	}
+
+	if mmGetWeightDiscrepancies.GetWeightDiscrepanciesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetWeightDiscrepancies.GetWeightDiscrepanciesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetWeightDiscrepancies.GetWeightDiscrepanciesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetWeightDiscrepancies.GetWeightDiscrepanciesMock.defaultExpectation.paramPtrs
+
+		mm_got := PVZOrderRepositoryMockGetWeightDiscrepanciesParams{ctx, pvzID, from, to}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetWeightDiscrepancies.t.Errorf("PVZOrderRepositoryMock.GetWeightDiscrepancies got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetWeightDiscrepancies.GetWeightDiscrepanciesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pvzID != nil && !minimock.Equal(*mm_want_ptrs.pvzID, mm_got.pvzID) {
+				mmGetWeightDiscrepancies.t.Errorf("PVZOrderRepositoryMock.GetWeightDiscrepancies got unexpected parameter pvzID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetWeightDiscrepancies.GetWeightDiscrepanciesMock.defaultExpectation.expectationOrigins.originPvzID, *mm_want_ptrs.pvzID, mm_got.pvzID, minimock.Diff(*mm_want_ptrs.pvzID, mm_got.pvzID))
+			}
+
+			if mm_want_ptrs.from != nil && !minimock.Equal(*mm_want_ptrs.from, mm_got.from) {
+				mmGetWeightDiscrepancies.t.Errorf("PVZOrderRepositoryMock.GetWeightDiscrepancies got unexpected parameter from, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetWeightDiscrepancies.GetWeightDiscrepanciesMock.defaultExpectation.expectationOrigins.originFrom, *mm_want_ptrs.from, mm_got.from, minimock.Diff(*mm_want_ptrs.from, mm_got.from))
+			}
+
+			if mm_want_ptrs.to != nil && !minimock.Equal(*mm_want_ptrs.to, mm_got.to) {
+				mmGetWeightDiscrepancies.t.Errorf("PVZOrderRepositoryMock.GetWeightDiscrepancies got unexpected parameter to, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetWeightDiscrepancies.GetWeightDiscrepanciesMock.defaultExpectation.expectationOrigins.originTo, *mm_want_ptrs.to, mm_got.to, minimock.Diff(*mm_want_ptrs.to, mm_got.to))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetWeightDiscrepancies.t.Errorf("PVZOrderRepositoryMock.GetWeightDiscrepancies got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetWeightDiscrepancies.GetWeightDiscrepanciesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetWeightDiscrepancies.GetWeightDiscrepanciesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetWeightDiscrepancies.t.Fatal("No results are set for the PVZOrderRepositoryMock.GetWeightDiscrepancies")
+		}
+		return (*mm_results).pa1, (*mm_results).err
+	}
+	if mmGetWeightDiscrepancies.funcGetWeightDiscrepancies != nil {
+		return mmGetWeightDiscrepancies.funcGetWeightDiscrepancies(ctx, pvzID, from, to)
+	}
+	mmGetWeightDiscrepancies.t.Fatalf("Unexpected call to PVZOrderRepositoryMock.GetWeightDiscrepancies. %v %v %v %v", ctx, pvzID, from, to)
+	return
+}
+
+// GetWeightDiscrepanciesAfterCounter returns a count of finished PVZOrderRepositoryMock.GetWeightDiscrepancies invocations
+func (mmGetWeightDiscrepancies *PVZOrderRepositoryMock) GetWeightDiscrepanciesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetWeightDiscrepancies.afterGetWeightDiscrepanciesCounter)
+}
+
+// GetWeightDiscrepanciesBeforeCounter returns a count of PVZOrderRepositoryMock.GetWeightDiscrepancies invocations
+func (mmGetWeightDiscrepancies *PVZOrderRepositoryMock) GetWeightDiscrepanciesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetWeightDiscrepancies.beforeGetWeightDiscrepanciesCounter)
+}
+
+// Calls returns a list of arguments used in each call to PVZOrderRepositoryMock.GetWeightDiscrepancies.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetWeightDiscrepancies *mPVZOrderRepositoryMockGetWeightDiscrepancies) Calls() []*PVZOrderRepositoryMockGetWeightDiscrepanciesParams {
+	mmGetWeightDiscrepancies.mutex.RLock()
+
+	argCopy := make([]*PVZOrderRepositoryMockGetWeightDiscrepanciesParams, len(mmGetWeightDiscrepancies.callArgs))
+	copy(argCopy, mmGetWeightDiscrepancies.callArgs)
+
+	mmGetWeightDiscrepancies.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetWeightDiscrepanciesDone returns true if the count of the GetWeightDiscrepancies invocations corresponds
+// the number of defined expectations
+func (m *PVZOrderRepositoryMock) MinimockGetWeightDiscrepanciesDone() bool {
+	if m.GetWeightDiscrepanciesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetWeightDiscrepanciesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetWeightDiscrepanciesMock.invocationsDone()
+}
+
+// MinimockGetWeightDiscrepanciesInspect logs each unmet expectation
+func (m *PVZOrderRepositoryMock) MinimockGetWeightDiscrepanciesInspect() {
+	for _, e := range m.GetWeightDiscrepanciesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PVZOrderRepositoryMock.GetWeightDiscrepancies at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetWeightDiscrepanciesCounter := mm_atomic.LoadUint64(&m.afterGetWeightDiscrepanciesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetWeightDiscrepanciesMock.defaultExpectation != nil && afterGetWeightDiscrepanciesCounter < 1 {
+		if m.GetWeightDiscrepanciesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PVZOrderRepositoryMock.GetWeightDiscrepancies at\n%s", m.GetWeightDiscrepanciesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PVZOrderRepositoryMock.GetWeightDiscrepancies at\n%s with params: %#v", m.GetWeightDiscrepanciesMock.defaultExpectation.expectationOrigins.origin, *m.GetWeightDiscrepanciesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetWeightDiscrepancies != nil && afterGetWeightDiscrepanciesCounter < 1 {
+		m.t.Errorf("Expected call to PVZOrderRepositoryMock.GetWeightDiscrepancies at\n%s", m.funcGetWeightDiscrepanciesOrigin)
+	}
+
+	if !m.GetWeightDiscrepanciesMock.invocationsDone() && afterGetWeightDiscrepanciesCounter > 0 {
+		m.t.Errorf("Expected %d calls to PVZOrderRepositoryMock.GetWeightDiscrepancies at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetWeightDiscrepanciesMock.expectedInvocations), m.GetWeightDiscrepanciesMock.expectedInvocationsOrigin, afterGetWeightDiscrepanciesCounter)
+	}
+}
+
+type mPVZOrderRepositoryMockRegisterFailedPickupAttempt struct {
+	optional           bool
+	mock               *PVZOrderRepositoryMock
+	defaultExpectation *PVZOrderRepositoryMockRegisterFailedPickupAttemptExpectation
+	expectations       []*PVZOrderRepositoryMockRegisterFailedPickupAttemptExpectation
+
+	callArgs []*PVZOrderRepositoryMockRegisterFailedPickupAttemptParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PVZOrderRepositoryMockRegisterFailedPickupAttemptExpectation specifies expectation struct of the PVZOrderRepository.RegisterFailedPickupAttempt
+type PVZOrderRepositoryMockRegisterFailedPickupAttemptExpectation struct {
+	mock               *PVZOrderRepositoryMock
+	params             *PVZOrderRepositoryMockRegisterFailedPickupAttemptParams
+	paramPtrs          *PVZOrderRepositoryMockRegisterFailedPickupAttemptParamPtrs
+	expectationOrigins PVZOrderRepositoryMockRegisterFailedPickupAttemptExpectationOrigins
+	results            *PVZOrderRepositoryMockRegisterFailedPickupAttemptResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PVZOrderRepositoryMockRegisterFailedPickupAttemptParams contains parameters of the PVZOrderRepository.RegisterFailedPickupAttempt
+type PVZOrderRepositoryMockRegisterFailedPickupAttemptParams struct {
+	ctx         context.Context
+	orderID     string
+	maxAttempts int
+	lockoutTime time.Duration
+}
+
+// PVZOrderRepositoryMockRegisterFailedPickupAttemptParamPtrs contains pointers to parameters of the PVZOrderRepository.RegisterFailedPickupAttempt
+type PVZOrderRepositoryMockRegisterFailedPickupAttemptParamPtrs struct {
+	ctx         *context.Context
+	orderID     *string
+	maxAttempts *int
+	lockoutTime *time.Duration
+}
+
+// PVZOrderRepositoryMockRegisterFailedPickupAttemptResults contains results of the PVZOrderRepository.RegisterFailedPickupAttempt
+type PVZOrderRepositoryMockRegisterFailedPickupAttemptResults struct {
+	t1  time.Time
+	err error
+}
+
+// PVZOrderRepositoryMockRegisterFailedPickupAttemptOrigins contains origins of expectations of the PVZOrderRepository.RegisterFailedPickupAttempt
+type PVZOrderRepositoryMockRegisterFailedPickupAttemptExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originOrderID     string
+	originMaxAttempts string
+	originLockoutTime string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRegisterFailedPickupAttempt *mPVZOrderRepositoryMockRegisterFailedPickupAttempt) Optional() *mPVZOrderRepositoryMockRegisterFailedPickupAttempt {
+	mmRegisterFailedPickupAttempt.optional = true
+	return mmRegisterFailedPickupAttempt
+}
+
+// Expect sets up expected params for PVZOrderRepository.RegisterFailedPickupAttempt
+func (mmRegisterFailedPickupAttempt *mPVZOrderRepositoryMockRegisterFailedPickupAttempt) Expect(ctx context.Context, orderID string, maxAttempts int, lockoutTime time.Duration) *mPVZOrderRepositoryMockRegisterFailedPickupAttempt {
+	if mmRegisterFailedPickupAttempt.mock.funcRegisterFailedPickupAttempt != nil {
+		mmRegisterFailedPickupAttempt.mock.t.Fatalf("PVZOrderRepositoryMock.RegisterFailedPickupAttempt mock is already set by Set")
+	}
+
+	if mmRegisterFailedPickupAttempt.defaultExpectation == nil {
+		mmRegisterFailedPickupAttempt.defaultExpectation = &PVZOrderRepositoryMockRegisterFailedPickupAttemptExpectation{}
+	}
+
+	if mmRegisterFailedPickupAttempt.defaultExpectation.paramPtrs != nil {
+		mmRegisterFailedPickupAttempt.mock.t.Fatalf("PVZOrderRepositoryMock.RegisterFailedPickupAttempt mock is already set by ExpectParams functions")
+	}
+
+	mmRegisterFailedPickupAttempt.defaultExpectation.params = &PVZOrderRepositoryMockRegisterFailedPickupAttemptParams{ctx, orderID, maxAttempts, lockoutTime}
+	mmRegisterFailedPickupAttempt.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRegisterFailedPickupAttempt.expectations {
+		if minimock.Equal(e.params, mmRegisterFailedPickupAttempt.defaultExpectation.params) {
+			mmRegisterFailedPickupAttempt.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRegisterFailedPickupAttempt.defaultExpectation.params)
+		}
+	}
+
+	return mmRegisterFailedPickupAttempt
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PVZOrderRepository.RegisterFailedPickupAttempt
+func (mmRegisterFailedPickupAttempt *mPVZOrderRepositoryMockRegisterFailedPickupAttempt) ExpectCtxParam1(ctx context.Context) *mPVZOrderRepositoryMockRegisterFailedPickupAttempt {
+	if mmRegisterFailedPickupAttempt.mock.funcRegisterFailedPickupAttempt != nil {
+		mmRegisterFailedPickupAttempt.mock.t.Fatalf("PVZOrderRepositoryMock.RegisterFailedPickupAttempt mock is already set by Set")
+	}
+
+	if mmRegisterFailedPickupAttempt.defaultExpectation == nil {
+		mmRegisterFailedPickupAttempt.defaultExpectation = &PVZOrderRepositoryMockRegisterFailedPickupAttemptExpectation{}
+	}
+
+	if mmRegisterFailedPickupAttempt.defaultExpectation.params != nil {
+		mmRegisterFailedPickupAttempt.mock.t.Fatalf("PVZOrderRepositoryMock.RegisterFailedPickupAttempt mock is already set by Expect")
+	}
+
+	if mmRegisterFailedPickupAttempt.defaultExpectation.paramPtrs == nil {
+		mmRegisterFailedPickupAttempt.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockRegisterFailedPickupAttemptParamPtrs{}
+	}
+	mmRegisterFailedPickupAttempt.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRegisterFailedPickupAttempt.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRegisterFailedPickupAttempt
+}
+
+// ExpectOrderIDParam2 sets up expected param orderID for PVZOrderRepository.RegisterFailedPickupAttempt
+func (mmRegisterFailedPickupAttempt *mPVZOrderRepositoryMockRegisterFailedPickupAttempt) ExpectOrderIDParam2(orderID string) *mPVZOrderRepositoryMockRegisterFailedPickupAttempt {
+	if mmRegisterFailedPickupAttempt.mock.funcRegisterFailedPickupAttempt != nil {
+		mmRegisterFailedPickupAttempt.mock.t.Fatalf("PVZOrderRepositoryMock.RegisterFailedPickupAttempt mock is already set by Set")
+	}
+
+	if mmRegisterFailedPickupAttempt.defaultExpectation == nil {
+		mmRegisterFailedPickupAttempt.defaultExpectation = &PVZOrderRepositoryMockRegisterFailedPickupAttemptExpectation{}
+	}
+
+	if mmRegisterFailedPickupAttempt.defaultExpectation.params != nil {
+		mmRegisterFailedPickupAttempt.mock.t.Fatalf("PVZOrderRepositoryMock.RegisterFailedPickupAttempt mock is already set by Expect")
+	}
+
+	if mmRegisterFailedPickupAttempt.defaultExpectation.paramPtrs == nil {
+		mmRegisterFailedPickupAttempt.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockRegisterFailedPickupAttemptParamPtrs{}
+	}
+	mmRegisterFailedPickupAttempt.defaultExpectation.paramPtrs.orderID = &orderID
+	mmRegisterFailedPickupAttempt.defaultExpectation.expectationOrigins.originOrderID = minimock.CallerInfo(1)
+
+	return mmRegisterFailedPickupAttempt
+}
+
+// ExpectMaxAttemptsParam3 sets up expected param maxAttempts for PVZOrderRepository.RegisterFailedPickupAttempt
+func (mmRegisterFailedPickupAttempt *mPVZOrderRepositoryMockRegisterFailedPickupAttempt) ExpectMaxAttemptsParam3(maxAttempts int) *mPVZOrderRepositoryMockRegisterFailedPickupAttempt {
+	if mmRegisterFailedPickupAttempt.mock.funcRegisterFailedPickupAttempt != nil {
+		mmRegisterFailedPickupAttempt.mock.t.Fatalf("PVZOrderRepositoryMock.RegisterFailedPickupAttempt mock is already set by Set")
+	}
+
+	if mmRegisterFailedPickupAttempt.defaultExpectation == nil {
+		mmRegisterFailedPickupAttempt.defaultExpectation = &PVZOrderRepositoryMockRegisterFailedPickupAttemptExpectation{}
+	}
+
+	if mmRegisterFailedPickupAttempt.defaultExpectation.params != nil {
+		mmRegisterFailedPickupAttempt.mock.t.Fatalf("PVZOrderRepositoryMock.RegisterFailedPickupAttempt mock is already set by Expect")
+	}
+
+	if mmRegisterFailedPickupAttempt.defaultExpectation.paramPtrs == nil {
+		mmRegisterFailedPickupAttempt.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockRegisterFailedPickupAttemptParamPtrs{}
+	}
+	mmRegisterFailedPickupAttempt.defaultExpectation.paramPtrs.maxAttempts = &maxAttempts
+	mmRegisterFailedPickupAttempt.defaultExpectation.expectationOrigins.originMaxAttempts = minimock.CallerInfo(1)
+
+	return mmRegisterFailedPickupAttempt
+}
+
+// ExpectLockoutTimeParam4 sets up expected param lockoutTime for PVZOrderRepository.RegisterFailedPickupAttempt
+func (mmRegisterFailedPickupAttempt *mPVZOrderRepositoryMockRegisterFailedPickupAttempt) ExpectLockoutTimeParam4(lockoutTime time.Duration) *mPVZOrderRepositoryMockRegisterFailedPickupAttempt {
+	if mmRegisterFailedPickupAttempt.mock.funcRegisterFailedPickupAttempt != nil {
+		mmRegisterFailedPickupAttempt.mock.t.Fatalf("PVZOrderRepositoryMock.RegisterFailedPickupAttempt mock is already set by Set")
+	}
+
+	if mmRegisterFailedPickupAttempt.defaultExpectation == nil {
+		mmRegisterFailedPickupAttempt.defaultExpectation = &PVZOrderRepositoryMockRegisterFailedPickupAttemptExpectation{}
+	}
+
+	if mmRegisterFailedPickupAttempt.defaultExpectation.params != nil {
+		mmRegisterFailedPickupAttempt.mock.t.Fatalf("PVZOrderRepositoryMock.RegisterFailedPickupAttempt mock is already set by Expect")
+	}
+
+	if mmRegisterFailedPickupAttempt.defaultExpectation.paramPtrs == nil {
+		mmRegisterFailedPickupAttempt.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockRegisterFailedPickupAttemptParamPtrs{}
+	}
+	mmRegisterFailedPickupAttempt.defaultExpectation.paramPtrs.lockoutTime = &lockoutTime
+	mmRegisterFailedPickupAttempt.defaultExpectation.expectationOrigins.originLockoutTime = minimock.CallerInfo(1)
+
+	return mmRegisterFailedPickupAttempt
+}
+
+// Inspect accepts an inspector function that has same arguments as the PVZOrderRepository.RegisterFailedPickupAttempt
+func (mmRegisterFailedPickupAttempt *mPVZOrderRepositoryMockRegisterFailedPickupAttempt) Inspect(f func(ctx context.Context, orderID string, maxAttempts int, lockoutTime time.Duration)) *mPVZOrderRepositoryMockRegisterFailedPickupAttempt {
+	if mmRegisterFailedPickupAttempt.mock.inspectFuncRegisterFailedPickupAttempt != nil {
+		mmRegisterFailedPickupAttempt.mock.t.Fatalf("Inspect function is already set for PVZOrderRepositoryMock.RegisterFailedPickupAttempt")
+	}
+
+	mmRegisterFailedPickupAttempt.mock.inspectFuncRegisterFailedPickupAttempt = f
+
+	return mmRegisterFailedPickupAttempt
+}
+
+// Return sets up results that will be returned by PVZOrderRepository.RegisterFailedPickupAttempt
+func (mmRegisterFailedPickupAttempt *mPVZOrderRepositoryMockRegisterFailedPickupAttempt) Return(t1 time.Time, err error) *PVZOrderRepositoryMock {
+	if mmRegisterFailedPickupAttempt.mock.funcRegisterFailedPickupAttempt != nil {
+		mmRegisterFailedPickupAttempt.mock.t.Fatalf("PVZOrderRepositoryMock.RegisterFailedPickupAttempt mock is already set by Set")
+	}
+
+	if mmRegisterFailedPickupAttempt.defaultExpectation == nil {
+		mmRegisterFailedPickupAttempt.defaultExpectation = &PVZOrderRepositoryMockRegisterFailedPickupAttemptExpectation{mock: mmRegisterFailedPickupAttempt.mock}
+	}
+	mmRegisterFailedPickupAttempt.defaultExpectation.results = &PVZOrderRepositoryMockRegisterFailedPickupAttemptResults{t1, err}
+	mmRegisterFailedPickupAttempt.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRegisterFailedPickupAttempt.mock
+}
+
+// Set uses given function f to mock the PVZOrderRepository.RegisterFailedPickupAttempt method
+func (mmRegisterFailedPickupAttempt *mPVZOrderRepositoryMockRegisterFailedPickupAttempt) Set(f func(ctx context.Context, orderID string, maxAttempts int, lockoutTime time.Duration) (t1 time.Time, err error)) *PVZOrderRepositoryMock {
+	if mmRegisterFailedPickupAttempt.defaultExpectation != nil {
+		mmRegisterFailedPickupAttempt.mock.t.Fatalf("Default expectation is already set for the PVZOrderRepository.RegisterFailedPickupAttempt method")
+	}
+
+	if len(mmRegisterFailedPickupAttempt.expectations) > 0 {
+		mmRegisterFailedPickupAttempt.mock.t.Fatalf("Some expectations are already set for the PVZOrderRepository.RegisterFailedPickupAttempt method")
+	}
+
+	mmRegisterFailedPickupAttempt.mock.funcRegisterFailedPickupAttempt = f
+	mmRegisterFailedPickupAttempt.mock.funcRegisterFailedPickupAttemptOrigin = minimock.CallerInfo(1)
+	return mmRegisterFailedPickupAttempt.mock
+}
+
+// When sets expectation for the PVZOrderRepository.RegisterFailedPickupAttempt which will trigger the result defined by the following
+// Then helper
+func (mmRegisterFailedPickupAttempt *mPVZOrderRepositoryMockRegisterFailedPickupAttempt) When(ctx context.Context, orderID string, maxAttempts int, lockoutTime time.Duration) *PVZOrderRepositoryMockRegisterFailedPickupAttemptExpectation {
+	if mmRegisterFailedPickupAttempt.mock.funcRegisterFailedPickupAttempt != nil {
+		mmRegisterFailedPickupAttempt.mock.t.Fatalf("PVZOrderRepositoryMock.RegisterFailedPickupAttempt mock is already set by Set")
+	}
+
+	expectation := &PVZOrderRepositoryMockRegisterFailedPickupAttemptExpectation{
+		mock:               mmRegisterFailedPickupAttempt.mock,
+		params:             &PVZOrderRepositoryMockRegisterFailedPickupAttemptParams{ctx, orderID, maxAttempts, lockoutTime},
+		expectationOrigins: PVZOrderRepositoryMockRegisterFailedPickupAttemptExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRegisterFailedPickupAttempt.expectations = append(mmRegisterFailedPickupAttempt.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PVZOrderRepository.RegisterFailedPickupAttempt return parameters for the expectation previously defined by the When method
+func (e *PVZOrderRepositoryMockRegisterFailedPickupAttemptExpectation) Then(t1 time.Time, err error) *PVZOrderRepositoryMock {
+	e.results = &PVZOrderRepositoryMockRegisterFailedPickupAttemptResults{t1, err}
+	return e.mock
+}
+
+// Times sets number of times PVZOrderRepository.RegisterFailedPickupAttempt should be invoked
+func (mmRegisterFailedPickupAttempt *mPVZOrderRepositoryMockRegisterFailedPickupAttempt) Times(n uint64) *mPVZOrderRepositoryMockRegisterFailedPickupAttempt {
+	if n == 0 {
+		mmRegisterFailedPickupAttempt.mock.t.Fatalf("Times of PVZOrderRepositoryMock.RegisterFailedPickupAttempt mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRegisterFailedPickupAttempt.expectedInvocations, n)
+	mmRegisterFailedPickupAttempt.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRegisterFailedPickupAttempt
+}
+
+func (mmRegisterFailedPickupAttempt *mPVZOrderRepositoryMockRegisterFailedPickupAttempt) invocationsDone() bool {
+	if len(mmRegisterFailedPickupAttempt.expectations) == 0 && mmRegisterFailedPickupAttempt.defaultExpectation == nil && mmRegisterFailedPickupAttempt.mock.funcRegisterFailedPickupAttempt == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRegisterFailedPickupAttempt.mock.afterRegisterFailedPickupAttemptCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRegisterFailedPickupAttempt.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RegisterFailedPickupAttempt implements mm_usecases.PVZOrderRepository
+func (mmRegisterFailedPickupAttempt *PVZOrderRepositoryMock) RegisterFailedPickupAttempt(ctx context.Context, orderID string, maxAttempts int, lockoutTime time.Duration) (t1 time.Time, err error) {
+	mm_atomic.AddUint64(&mmRegisterFailedPickupAttempt.beforeRegisterFailedPickupAttemptCounter, 1)
+	defer mm_atomic.AddUint64(&mmRegisterFailedPickupAttempt.afterRegisterFailedPickupAttemptCounter, 1)
+
+	mmRegisterFailedPickupAttempt.t.Helper()
+
+	if mmRegisterFailedPickupAttempt.inspectFuncRegisterFailedPickupAttempt != nil {
+		mmRegisterFailedPickupAttempt.inspectFuncRegisterFailedPickupAttempt(ctx, orderID, maxAttempts, lockoutTime)
+	}
+
+	mm_params := PVZOrderRepositoryMockRegisterFailedPickupAttemptParams{ctx, orderID, maxAttempts, lockoutTime}
+
+	// Record call args
+	mmRegisterFailedPickupAttempt.RegisterFailedPickupAttemptMock.mutex.Lock()
+	mmRegisterFailedPickupAttempt.RegisterFailedPickupAttemptMock.callArgs = append(mmRegisterFailedPickupAttempt.RegisterFailedPickupAttemptMock.callArgs, &mm_params)
+	mmRegisterFailedPickupAttempt.RegisterFailedPickupAttemptMock.mutex.Unlock()
+
+	for _, e := range mmRegisterFailedPickupAttempt.RegisterFailedPickupAttemptMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.t1, e.results.err
+		}
+	}
+
+	if mmRegisterFailedPickupAttempt.RegisterFailedPickupAttemptMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRegisterFailedPickupAttempt.RegisterFailedPickupAttemptMock.defaultExpectation.Counter, 1)
+		mm_want := mmRegisterFailedPickupAttempt.RegisterFailedPickupAttemptMock.defaultExpectation.params
+		mm_want_ptrs := mmRegisterFailedPickupAttempt.RegisterFailedPickupAttemptMock.defaultExpectation.paramPtrs
+
+		mm_got := PVZOrderRepositoryMockRegisterFailedPickupAttemptParams{ctx, orderID, maxAttempts, lockoutTime}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRegisterFailedPickupAttempt.t.Errorf("PVZOrderRepositoryMock.RegisterFailedPickupAttempt got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRegisterFailedPickupAttempt.RegisterFailedPickupAttemptMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmRegisterFailedPickupAttempt.t.Errorf("PVZOrderRepositoryMock.RegisterFailedPickupAttempt got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRegisterFailedPickupAttempt.RegisterFailedPickupAttemptMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			}
+
+			if mm_want_ptrs.maxAttempts != nil && !minimock.Equal(*mm_want_ptrs.maxAttempts, mm_got.maxAttempts) {
+				mmRegisterFailedPickupAttempt.t.Errorf("PVZOrderRepositoryMock.RegisterFailedPickupAttempt got unexpected parameter maxAttempts, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRegisterFailedPickupAttempt.RegisterFailedPickupAttemptMock.defaultExpectation.expectationOrigins.originMaxAttempts, *mm_want_ptrs.maxAttempts, mm_got.maxAttempts, minimock.Diff(*mm_want_ptrs.maxAttempts, mm_got.maxAttempts))
+			}
+
+			if mm_want_ptrs.lockoutTime != nil && !minimock.Equal(*mm_want_ptrs.lockoutTime, mm_got.lockoutTime) {
+				mmRegisterFailedPickupAttempt.t.Errorf("PVZOrderRepositoryMock.RegisterFailedPickupAttempt got unexpected parameter lockoutTime, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRegisterFailedPickupAttempt.RegisterFailedPickupAttemptMock.defaultExpectation.expectationOrigins.originLockoutTime, *mm_want_ptrs.lockoutTime, mm_got.lockoutTime, minimock.Diff(*mm_want_ptrs.lockoutTime, mm_got.lockoutTime))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRegisterFailedPickupAttempt.t.Errorf("PVZOrderRepositoryMock.RegisterFailedPickupAttempt got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRegisterFailedPickupAttempt.RegisterFailedPickupAttemptMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRegisterFailedPickupAttempt.RegisterFailedPickupAttemptMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRegisterFailedPickupAttempt.t.Fatal("No results are set for the PVZOrderRepositoryMock.RegisterFailedPickupAttempt")
+		}
+		return (*mm_results).t1, (*mm_results).err
+	}
+	if mmRegisterFailedPickupAttempt.funcRegisterFailedPickupAttempt != nil {
+		return mmRegisterFailedPickupAttempt.funcRegisterFailedPickupAttempt(ctx, orderID, maxAttempts, lockoutTime)
+	}
+	mmRegisterFailedPickupAttempt.t.Fatalf("Unexpected call to PVZOrderRepositoryMock.RegisterFailedPickupAttempt. %v %v %v %v", ctx, orderID, maxAttempts, lockoutTime)
+	return
+}
+
+// RegisterFailedPickupAttemptAfterCounter returns a count of finished PVZOrderRepositoryMock.RegisterFailedPickupAttempt invocations
+func (mmRegisterFailedPickupAttempt *PVZOrderRepositoryMock) RegisterFailedPickupAttemptAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRegisterFailedPickupAttempt.afterRegisterFailedPickupAttemptCounter)
+}
+
+// RegisterFailedPickupAttemptBeforeCounter returns a count of PVZOrderRepositoryMock.RegisterFailedPickupAttempt invocations
+func (mmRegisterFailedPickupAttempt *PVZOrderRepositoryMock) RegisterFailedPickupAttemptBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRegisterFailedPickupAttempt.beforeRegisterFailedPickupAttemptCounter)
+}
+
+// Calls returns a list of arguments used in each call to PVZOrderRepositoryMock.RegisterFailedPickupAttempt.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRegisterFailedPickupAttempt *mPVZOrderRepositoryMockRegisterFailedPickupAttempt) Calls() []*PVZOrderRepositoryMockRegisterFailedPickupAttemptParams {
+	mmRegisterFailedPickupAttempt.mutex.RLock()
+
+	argCopy := make([]*PVZOrderRepositoryMockRegisterFailedPickupAttemptParams, len(mmRegisterFailedPickupAttempt.callArgs))
+	copy(argCopy, mmRegisterFailedPickupAttempt.callArgs)
+
+	mmRegisterFailedPickupAttempt.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRegisterFailedPickupAttemptDone returns true if the count of the RegisterFailedPickupAttempt invocations corresponds
+// the number of defined expectations
+func (m *PVZOrderRepositoryMock) MinimockRegisterFailedPickupAttemptDone() bool {
+	if m.RegisterFailedPickupAttemptMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RegisterFailedPickupAttemptMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RegisterFailedPickupAttemptMock.invocationsDone()
+}
+
+// MinimockRegisterFailedPickupAttemptInspect logs each unmet expectation
+func (m *PVZOrderRepositoryMock) MinimockRegisterFailedPickupAttemptInspect() {
+	for _, e := range m.RegisterFailedPickupAttemptMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PVZOrderRepositoryMock.RegisterFailedPickupAttempt at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRegisterFailedPickupAttemptCounter := mm_atomic.LoadUint64(&m.afterRegisterFailedPickupAttemptCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RegisterFailedPickupAttemptMock.defaultExpectation != nil && afterRegisterFailedPickupAttemptCounter < 1 {
+		if m.RegisterFailedPickupAttemptMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PVZOrderRepositoryMock.RegisterFailedPickupAttempt at\n%s", m.RegisterFailedPickupAttemptMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PVZOrderRepositoryMock.RegisterFailedPickupAttempt at\n%s with params: %#v", m.RegisterFailedPickupAttemptMock.defaultExpectation.expectationOrigins.origin, *m.RegisterFailedPickupAttemptMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRegisterFailedPickupAttempt != nil && afterRegisterFailedPickupAttemptCounter < 1 {
+		m.t.Errorf("Expected call to PVZOrderRepositoryMock.RegisterFailedPickupAttempt at\n%s", m.funcRegisterFailedPickupAttemptOrigin)
+	}
+
+	if !m.RegisterFailedPickupAttemptMock.invocationsDone() && afterRegisterFailedPickupAttemptCounter > 0 {
+		m.t.Errorf("Expected %d calls to PVZOrderRepositoryMock.RegisterFailedPickupAttempt at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RegisterFailedPickupAttemptMock.expectedInvocations), m.RegisterFailedPickupAttemptMock.expectedInvocationsOrigin, afterRegisterFailedPickupAttemptCounter)
+	}
+}
+
+type mPVZOrderRepositoryMockSetOrderRefused struct {
 	optional           bool
 	mock               *PVZOrderRepositoryMock
-	defaultExpectation *PVZOrderRepositoryMockSetOrderIssuedExpectation
-	expectations       []*PVZOrderRepositoryMockSetOrderIssuedExpectation
+	defaultExpectation *PVZOrderRepositoryMockSetOrderRefusedExpectation
+	expectations       []*PVZOrderRepositoryMockSetOrderRefusedExpectation
 
-	callArgs []*PVZOrderRepositoryMockSetOrderIssuedParams
+	callArgs []*PVZOrderRepositoryMockSetOrderRefusedParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// PVZOrderRepositoryMockSetOrderIssuedExpectation specifies expectation struct of the PVZOrderRepository.SetOrderIssued
-type PVZOrderRepositoryMockSetOrderIssuedExpectation struct {
+// PVZOrderRepositoryMockSetOrderRefusedExpectation specifies expectation struct of the PVZOrderRepository.SetOrderRefused
+type PVZOrderRepositoryMockSetOrderRefusedExpectation struct {
 	mock               *PVZOrderRepositoryMock
-	params             *PVZOrderRepositoryMockSetOrderIssuedParams
-	paramPtrs          *PVZOrderRepositoryMockSetOrderIssuedParamPtrs
-	expectationOrigins PVZOrderRepositoryMockSetOrderIssuedExpectationOrigins
-	results            *PVZOrderRepositoryMockSetOrderIssuedResults
+	params             *PVZOrderRepositoryMockSetOrderRefusedParams
+	paramPtrs          *PVZOrderRepositoryMockSetOrderRefusedParamPtrs
+	expectationOrigins PVZOrderRepositoryMockSetOrderRefusedExpectationOrigins
+	results            *PVZOrderRepositoryMockSetOrderRefusedResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// PVZOrderRepositoryMockSetOrderIssuedParams contains parameters of the PVZOrderRepository.SetOrderIssued
-type PVZOrderRepositoryMockSetOrderIssuedParams struct {
-	ctx     context.Context
-	orderID string
+// PVZOrderRepositoryMockSetOrderRefusedParams contains parameters of the PVZOrderRepository.SetOrderRefused
+type PVZOrderRepositoryMockSetOrderRefusedParams struct {
+	ctx             context.Context
+	orderID         string
+	reason          string
+	expectedVersion int64
 }
 
-// PVZOrderRepositoryMockSetOrderIssuedParamPtrs contains pointers to parameters of the PVZOrderRepository.SetOrderIssued
-type PVZOrderRepositoryMockSetOrderIssuedParamPtrs struct {
-	ctx     *context.Context
-	orderID *string
+// PVZOrderRepositoryMockSetOrderRefusedParamPtrs contains pointers to parameters of the PVZOrderRepository.SetOrderRefused
+type PVZOrderRepositoryMockSetOrderRefusedParamPtrs struct {
+	ctx             *context.Context
+	orderID         *string
+	reason          *string
+	expectedVersion *int64
 }
 
-// PVZOrderRepositoryMockSetOrderIssuedResults contains results of the PVZOrderRepository.SetOrderIssued
-type PVZOrderRepositoryMockSetOrderIssuedResults struct {
+// PVZOrderRepositoryMockSetOrderRefusedResults contains results of the PVZOrderRepository.SetOrderRefused
+type PVZOrderRepositoryMockSetOrderRefusedResults struct {
 	err error
 }
 
-// PVZOrderRepositoryMockSetOrderIssuedOrigins contains origins of expectations of the PVZOrderRepository.SetOrderIssued
-type PVZOrderRepositoryMockSetOrderIssuedExpectationOrigins struct {
-	origin        string
-	originCtx     string
-	originOrderID string
+// PVZOrderRepositoryMockSetOrderRefusedOrigins contains origins of expectations of the PVZOrderRepository.SetOrderRefused
+type PVZOrderRepositoryMockSetOrderRefusedExpectationOrigins struct {
+	origin                string
+	originCtx             string
+	originOrderID         string
+	originReason          string
+	originExpectedVersion string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1900,292 +4053,348 @@ type PVZOrderRepositoryMockSetOrderIssuedExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmSetOrderIssued *mPVZOrderRepositoryMockSetOrderIssued) Optional() *mPVZOrderRepositoryMockSetOrderIssued {
-	mmSetOrderIssued.optional = true
-	return mmSetOrderIssued
+func (mmSetOrderRefused *mPVZOrderRepositoryMockSetOrderRefused) Optional() *mPVZOrderRepositoryMockSetOrderRefused {
+	mmSetOrderRefused.optional = true
+	return mmSetOrderRefused
 }
 
-// Expect sets up expected params for PVZOrderRepository.SetOrderIssued
-func (mmSetOrderIssued *mPVZOrderRepositoryMockSetOrderIssued) Expect(ctx context.Context, orderID string) *mPVZOrderRepositoryMockSetOrderIssued {
-	if mmSetOrderIssued.mock.funcSetOrderIssued != nil {
-		mmSetOrderIssued.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderIssued mock is already set by Set")
+// Expect sets up expected params for PVZOrderRepository.SetOrderRefused
+func (mmSetOrderRefused *mPVZOrderRepositoryMockSetOrderRefused) Expect(ctx context.Context, orderID string, reason string, expectedVersion int64) *mPVZOrderRepositoryMockSetOrderRefused {
+	if mmSetOrderRefused.mock.funcSetOrderRefused != nil {
+		mmSetOrderRefused.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderRefused mock is already set by Set")
 	}
 
-	if mmSetOrderIssued.defaultExpectation == nil {
-		mmSetOrderIssued.defaultExpectation = &PVZOrderRepositoryMockSetOrderIssuedExpectation{}
+	if mmSetOrderRefused.defaultExpectation == nil {
+		mmSetOrderRefused.defaultExpectation = &PVZOrderRepositoryMockSetOrderRefusedExpectation{}
 	}
 
-	if mmSetOrderIssued.defaultExpectation.paramPtrs != nil {
-		mmSetOrderIssued.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderIssued mock is already set by ExpectParams functions")
+	if mmSetOrderRefused.defaultExpectation.paramPtrs != nil {
+		mmSetOrderRefused.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderRefused mock is already set by ExpectParams functions")
 	}
 
-	mmSetOrderIssued.defaultExpectation.params = &PVZOrderRepositoryMockSetOrderIssuedParams{ctx, orderID}
-	mmSetOrderIssued.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmSetOrderIssued.expectations {
-		if minimock.Equal(e.params, mmSetOrderIssued.defaultExpectation.params) {
-			mmSetOrderIssued.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetOrderIssued.defaultExpectation.params)
+	mmSetOrderRefused.defaultExpectation.params = &PVZOrderRepositoryMockSetOrderRefusedParams{ctx, orderID, reason, expectedVersion}
+	mmSetOrderRefused.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetOrderRefused.expectations {
+		if minimock.Equal(e.params, mmSetOrderRefused.defaultExpectation.params) {
+			mmSetOrderRefused.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetOrderRefused.defaultExpectation.params)
 		}
 	}
 
-	return mmSetOrderIssued
+	return mmSetOrderRefused
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PVZOrderRepository.SetOrderRefused
+func (mmSetOrderRefused *mPVZOrderRepositoryMockSetOrderRefused) ExpectCtxParam1(ctx context.Context) *mPVZOrderRepositoryMockSetOrderRefused {
+	if mmSetOrderRefused.mock.funcSetOrderRefused != nil {
+		mmSetOrderRefused.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderRefused mock is already set by Set")
+	}
+
+	if mmSetOrderRefused.defaultExpectation == nil {
+		mmSetOrderRefused.defaultExpectation = &PVZOrderRepositoryMockSetOrderRefusedExpectation{}
+	}
+
+	if mmSetOrderRefused.defaultExpectation.params != nil {
+		mmSetOrderRefused.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderRefused mock is already set by Expect")
+	}
+
+	if mmSetOrderRefused.defaultExpectation.paramPtrs == nil {
+		mmSetOrderRefused.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockSetOrderRefusedParamPtrs{}
+	}
+	mmSetOrderRefused.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetOrderRefused.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetOrderRefused
+}
+
+// ExpectOrderIDParam2 sets up expected param orderID for PVZOrderRepository.SetOrderRefused
+func (mmSetOrderRefused *mPVZOrderRepositoryMockSetOrderRefused) ExpectOrderIDParam2(orderID string) *mPVZOrderRepositoryMockSetOrderRefused {
+	if mmSetOrderRefused.mock.funcSetOrderRefused != nil {
+		mmSetOrderRefused.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderRefused mock is already set by Set")
+	}
+
+	if mmSetOrderRefused.defaultExpectation == nil {
+		mmSetOrderRefused.defaultExpectation = &PVZOrderRepositoryMockSetOrderRefusedExpectation{}
+	}
+
+	if mmSetOrderRefused.defaultExpectation.params != nil {
+		mmSetOrderRefused.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderRefused mock is already set by Expect")
+	}
+
+	if mmSetOrderRefused.defaultExpectation.paramPtrs == nil {
+		mmSetOrderRefused.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockSetOrderRefusedParamPtrs{}
+	}
+	mmSetOrderRefused.defaultExpectation.paramPtrs.orderID = &orderID
+	mmSetOrderRefused.defaultExpectation.expectationOrigins.originOrderID = minimock.CallerInfo(1)
+
+	return mmSetOrderRefused
 }
 
-// ExpectCtxParam1 sets up expected param ctx for PVZOrderRepository.SetOrderIssued
-func (mmSetOrderIssued *mPVZOrderRepositoryMockSetOrderIssued) ExpectCtxParam1(ctx context.Context) *mPVZOrderRepositoryMockSetOrderIssued {
-	if mmSetOrderIssued.mock.funcSetOrderIssued != nil {
-		mmSetOrderIssued.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderIssued mock is already set by Set")
+// ExpectReasonParam3 sets up expected param reason for PVZOrderRepository.SetOrderRefused
+func (mmSetOrderRefused *mPVZOrderRepositoryMockSetOrderRefused) ExpectReasonParam3(reason string) *mPVZOrderRepositoryMockSetOrderRefused {
+	if mmSetOrderRefused.mock.funcSetOrderRefused != nil {
+		mmSetOrderRefused.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderRefused mock is already set by Set")
 	}
 
-	if mmSetOrderIssued.defaultExpectation == nil {
-		mmSetOrderIssued.defaultExpectation = &PVZOrderRepositoryMockSetOrderIssuedExpectation{}
+	if mmSetOrderRefused.defaultExpectation == nil {
+		mmSetOrderRefused.defaultExpectation = &PVZOrderRepositoryMockSetOrderRefusedExpectation{}
 	}
 
-	if mmSetOrderIssued.defaultExpectation.params != nil {
-		mmSetOrderIssued.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderIssued mock is already set by Expect")
+	if mmSetOrderRefused.defaultExpectation.params != nil {
+		mmSetOrderRefused.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderRefused mock is already set by Expect")
 	}
 
-	if mmSetOrderIssued.defaultExpectation.paramPtrs == nil {
-		mmSetOrderIssued.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockSetOrderIssuedParamPtrs{}
+	if mmSetOrderRefused.defaultExpectation.paramPtrs == nil {
+		mmSetOrderRefused.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockSetOrderRefusedParamPtrs{}
 	}
-	mmSetOrderIssued.defaultExpectation.paramPtrs.ctx = &ctx
-	mmSetOrderIssued.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmSetOrderRefused.defaultExpectation.paramPtrs.reason = &reason
+	mmSetOrderRefused.defaultExpectation.expectationOrigins.originReason = minimock.CallerInfo(1)
 
-	return mmSetOrderIssued
+	return mmSetOrderRefused
 }
 
-// ExpectOrderIDParam2 sets up expected param orderID for PVZOrderRepository.SetOrderIssued
-func (mmSetOrderIssued *mPVZOrderRepositoryMockSetOrderIssued) ExpectOrderIDParam2(orderID string) *mPVZOrderRepositoryMockSetOrderIssued {
-	if mmSetOrderIssued.mock.funcSetOrderIssued != nil {
-		mmSetOrderIssued.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderIssued mock is already set by Set")
+// ExpectExpectedVersionParam4 sets up expected param expectedVersion for PVZOrderRepository.SetOrderRefused
+func (mmSetOrderRefused *mPVZOrderRepositoryMockSetOrderRefused) ExpectExpectedVersionParam4(expectedVersion int64) *mPVZOrderRepositoryMockSetOrderRefused {
+	if mmSetOrderRefused.mock.funcSetOrderRefused != nil {
+		mmSetOrderRefused.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderRefused mock is already set by Set")
 	}
 
-	if mmSetOrderIssued.defaultExpectation == nil {
-		mmSetOrderIssued.defaultExpectation = &PVZOrderRepositoryMockSetOrderIssuedExpectation{}
+	if mmSetOrderRefused.defaultExpectation == nil {
+		mmSetOrderRefused.defaultExpectation = &PVZOrderRepositoryMockSetOrderRefusedExpectation{}
 	}
 
-	if mmSetOrderIssued.defaultExpectation.params != nil {
-		mmSetOrderIssued.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderIssued mock is already set by Expect")
+	if mmSetOrderRefused.defaultExpectation.params != nil {
+		mmSetOrderRefused.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderRefused mock is already set by Expect")
 	}
 
-	if mmSetOrderIssued.defaultExpectation.paramPtrs == nil {
-		mmSetOrderIssued.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockSetOrderIssuedParamPtrs{}
+	if mmSetOrderRefused.defaultExpectation.paramPtrs == nil {
+		mmSetOrderRefused.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockSetOrderRefusedParamPtrs{}
 	}
-	mmSetOrderIssued.defaultExpectation.paramPtrs.orderID = &orderID
-	mmSetOrderIssued.defaultExpectation.expectationOrigins.originOrderID = minimock.CallerInfo(1)
+	mmSetOrderRefused.defaultExpectation.paramPtrs.expectedVersion = &expectedVersion
+	mmSetOrderRefused.defaultExpectation.expectationOrigins.originExpectedVersion = minimock.CallerInfo(1)
 
-	return mmSetOrderIssued
+	return mmSetOrderRefused
 }
 
-// Inspect accepts an inspector function that has same arguments as the PVZOrderRepository.SetOrderIssued
-func (mmSetOrderIssued *mPVZOrderRepositoryMockSetOrderIssued) Inspect(f func(ctx context.Context, orderID string)) *mPVZOrderRepositoryMockSetOrderIssued {
-	if mmSetOrderIssued.mock.inspectFuncSetOrderIssued != nil {
-		mmSetOrderIssued.mock.t.Fatalf("Inspect function is already set for PVZOrderRepositoryMock.SetOrderIssued")
+// Inspect accepts an inspector function that has same arguments as the PVZOrderRepository.SetOrderRefused
+func (mmSetOrderRefused *mPVZOrderRepositoryMockSetOrderRefused) Inspect(f func(ctx context.Context, orderID string, reason string, expectedVersion int64)) *mPVZOrderRepositoryMockSetOrderRefused {
+	if mmSetOrderRefused.mock.inspectFuncSetOrderRefused != nil {
+		mmSetOrderRefused.mock.t.Fatalf("Inspect function is already set for PVZOrderRepositoryMock.SetOrderRefused")
 	}
 
-	mmSetOrderIssued.mock.inspectFuncSetOrderIssued = f
+	mmSetOrderRefused.mock.inspectFuncSetOrderRefused = f
 
-	return mmSetOrderIssued
+	return mmSetOrderRefused
 }
 
-// Return sets up results that will be returned by PVZOrderRepository.SetOrderIssued
-func (mmSetOrderIssued *mPVZOrderRepositoryMockSetOrderIssued) Return(err error) *PVZOrderRepositoryMock {
-	if mmSetOrderIssued.mock.funcSetOrderIssued != nil {
-		mmSetOrderIssued.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderIssued mock is already set by Set")
+// Return sets up results that will be returned by PVZOrderRepository.SetOrderRefused
+func (mmSetOrderRefused *mPVZOrderRepositoryMockSetOrderRefused) Return(err error) *PVZOrderRepositoryMock {
+	if mmSetOrderRefused.mock.funcSetOrderRefused != nil {
+		mmSetOrderRefused.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderRefused mock is already set by Set")
 	}
 
-	if mmSetOrderIssued.defaultExpectation == nil {
-		mmSetOrderIssued.defaultExpectation = &PVZOrderRepositoryMockSetOrderIssuedExpectation{mock: mmSetOrderIssued.mock}
+	if mmSetOrderRefused.defaultExpectation == nil {
+		mmSetOrderRefused.defaultExpectation = &PVZOrderRepositoryMockSetOrderRefusedExpectation{mock: mmSetOrderRefused.mock}
 	}
-	mmSetOrderIssued.defaultExpectation.results = &PVZOrderRepositoryMockSetOrderIssuedResults{err}
-	mmSetOrderIssued.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmSetOrderIssued.mock
+	mmSetOrderRefused.defaultExpectation.results = &PVZOrderRepositoryMockSetOrderRefusedResults{err}
+	mmSetOrderRefused.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetOrderRefused.mock
 }
 
-// Set uses given function f to mock the PVZOrderRepository.SetOrderIssued method
-func (mmSetOrderIssued *mPVZOrderRepositoryMockSetOrderIssued) Set(f func(ctx context.Context, orderID string) (err error)) *PVZOrderRepositoryMock {
-	if mmSetOrderIssued.defaultExpectation != nil {
-		mmSetOrderIssued.mock.t.Fatalf("Default expectation is already set for the PVZOrderRepository.SetOrderIssued method")
+// Set uses given function f to mock the PVZOrderRepository.SetOrderRefused method
+func (mmSetOrderRefused *mPVZOrderRepositoryMockSetOrderRefused) Set(f func(ctx context.Context, orderID string, reason string, expectedVersion int64) (err error)) *PVZOrderRepositoryMock {
+	if mmSetOrderRefused.defaultExpectation != nil {
+		mmSetOrderRefused.mock.t.Fatalf("Default expectation is already set for the PVZOrderRepository.SetOrderRefused method")
 	}
 
-	if len(mmSetOrderIssued.expectations) > 0 {
-		mmSetOrderIssued.mock.t.Fatalf("Some expectations are already set for the PVZOrderRepository.SetOrderIssued method")
+	if len(mmSetOrderRefused.expectations) > 0 {
+		mmSetOrderRefused.mock.t.Fatalf("Some expectations are already set for the PVZOrderRepository.SetOrderRefused method")
 	}
 
-	mmSetOrderIssued.mock.funcSetOrderIssued = f
-	mmSetOrderIssued.mock.funcSetOrderIssuedOrigin = minimock.CallerInfo(1)
-	return mmSetOrderIssued.mock
+	mmSetOrderRefused.mock.funcSetOrderRefused = f
+	mmSetOrderRefused.mock.funcSetOrderRefusedOrigin = minimock.CallerInfo(1)
+	return mmSetOrderRefused.mock
 }
 
-// When sets expectation for the PVZOrderRepository.SetOrderIssued which will trigger the result defined by the following
+// When sets expectation for the PVZOrderRepository.SetOrderRefused which will trigger the result defined by the following
 // Then helper
-func (mmSetOrderIssued *mPVZOrderRepositoryMockSetOrderIssued) When(ctx context.Context, orderID string) *PVZOrderRepositoryMockSetOrderIssuedExpectation {
-	if mmSetOrderIssued.mock.funcSetOrderIssued != nil {
-		mmSetOrderIssued.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderIssued mock is already set by Set")
+func (mmSetOrderRefused *mPVZOrderRepositoryMockSetOrderRefused) When(ctx context.Context, orderID string, reason string, expectedVersion int64) *PVZOrderRepositoryMockSetOrderRefusedExpectation {
+	if mmSetOrderRefused.mock.funcSetOrderRefused != nil {
+		mmSetOrderRefused.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderRefused mock is already set by Set")
 	}
 
-	expectation := &PVZOrderRepositoryMockSetOrderIssuedExpectation{
-		mock:               mmSetOrderIssued.mock,
-		params:             &PVZOrderRepositoryMockSetOrderIssuedParams{ctx, orderID},
-		expectationOrigins: PVZOrderRepositoryMockSetOrderIssuedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &PVZOrderRepositoryMockSetOrderRefusedExpectation{
+		mock:               mmSetOrderRefused.mock,
+		params:             &PVZOrderRepositoryMockSetOrderRefusedParams{ctx, orderID, reason, expectedVersion},
+		expectationOrigins: PVZOrderRepositoryMockSetOrderRefusedExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmSetOrderIssued.expectations = append(mmSetOrderIssued.expectations, expectation)
+	mmSetOrderRefused.expectations = append(mmSetOrderRefused.expectations, expectation)
 	return expectation
 }
 
-// Then sets up PVZOrderRepository.SetOrderIssued return parameters for the expectation previously defined by the When method
-func (e *PVZOrderRepositoryMockSetOrderIssuedExpectation) Then(err error) *PVZOrderRepositoryMock {
-	e.results = &PVZOrderRepositoryMockSetOrderIssuedResults{err}
+// Then sets up PVZOrderRepository.SetOrderRefused return parameters for the expectation previously defined by the When method
+func (e *PVZOrderRepositoryMockSetOrderRefusedExpectation) Then(err error) *PVZOrderRepositoryMock {
+	e.results = &PVZOrderRepositoryMockSetOrderRefusedResults{err}
 	return e.mock
 }
 
-// Times sets number of times PVZOrderRepository.SetOrderIssued should be invoked
-func (mmSetOrderIssued *mPVZOrderRepositoryMockSetOrderIssued) Times(n uint64) *mPVZOrderRepositoryMockSetOrderIssued {
+// Times sets number of times PVZOrderRepository.SetOrderRefused should be invoked
+func (mmSetOrderRefused *mPVZOrderRepositoryMockSetOrderRefused) Times(n uint64) *mPVZOrderRepositoryMockSetOrderRefused {
 	if n == 0 {
-		mmSetOrderIssued.mock.t.Fatalf("Times of PVZOrderRepositoryMock.SetOrderIssued mock can not be zero")
+		mmSetOrderRefused.mock.t.Fatalf("Times of PVZOrderRepositoryMock.SetOrderRefused mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmSetOrderIssued.expectedInvocations, n)
-	mmSetOrderIssued.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmSetOrderIssued
+	mm_atomic.StoreUint64(&mmSetOrderRefused.expectedInvocations, n)
+	mmSetOrderRefused.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetOrderRefused
 }
 
-func (mmSetOrderIssued *mPVZOrderRepositoryMockSetOrderIssued) invocationsDone() bool {
-	if len(mmSetOrderIssued.expectations) == 0 && mmSetOrderIssued.defaultExpectation == nil && mmSetOrderIssued.mock.funcSetOrderIssued == nil {
+func (mmSetOrderRefused *mPVZOrderRepositoryMockSetOrderRefused) invocationsDone() bool {
+	if len(mmSetOrderRefused.expectations) == 0 && mmSetOrderRefused.defaultExpectation == nil && mmSetOrderRefused.mock.funcSetOrderRefused == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmSetOrderIssued.mock.afterSetOrderIssuedCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmSetOrderIssued.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSetOrderRefused.mock.afterSetOrderRefusedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetOrderRefused.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// SetOrderIssued implements mm_usecases.PVZOrderRepository
-func (mmSetOrderIssued *PVZOrderRepositoryMock) SetOrderIssued(ctx context.Context, orderID string) (err error) {
-	mm_atomic.AddUint64(&mmSetOrderIssued.beforeSetOrderIssuedCounter, 1)
-	defer mm_atomic.AddUint64(&mmSetOrderIssued.afterSetOrderIssuedCounter, 1)
+// SetOrderRefused implements mm_usecases.PVZOrderRepository
+func (mmSetOrderRefused *PVZOrderRepositoryMock) SetOrderRefused(ctx context.Context, orderID string, reason string, expectedVersion int64) (err error) {
+	mm_atomic.AddUint64(&mmSetOrderRefused.beforeSetOrderRefusedCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetOrderRefused.afterSetOrderRefusedCounter, 1)
 
-	mmSetOrderIssued.t.Helper()
+	mmSetOrderRefused.t.Helper()
 
-	if mmSetOrderIssued.inspectFuncSetOrderIssued != nil {
-		mmSetOrderIssued.inspectFuncSetOrderIssued(ctx, orderID)
+	if mmSetOrderRefused.inspectFuncSetOrderRefused != nil {
+		mmSetOrderRefused.inspectFuncSetOrderRefused(ctx, orderID, reason, expectedVersion)
 	}
 
-	mm_params := PVZOrderRepositoryMockSetOrderIssuedParams{ctx, orderID}
+	mm_params := PVZOrderRepositoryMockSetOrderRefusedParams{ctx, orderID, reason, expectedVersion}
 
 	// Record call args
-	mmSetOrderIssued.SetOrderIssuedMock.mutex.Lock()
-	mmSetOrderIssued.SetOrderIssuedMock.callArgs = append(mmSetOrderIssued.SetOrderIssuedMock.callArgs, &mm_params)
-	mmSetOrderIssued.SetOrderIssuedMock.mutex.Unlock()
+	mmSetOrderRefused.SetOrderRefusedMock.mutex.Lock()
+	mmSetOrderRefused.SetOrderRefusedMock.callArgs = append(mmSetOrderRefused.SetOrderRefusedMock.callArgs, &mm_params)
+	mmSetOrderRefused.SetOrderRefusedMock.mutex.Unlock()
 
-	for _, e := range mmSetOrderIssued.SetOrderIssuedMock.expectations {
+	for _, e := range mmSetOrderRefused.SetOrderRefusedMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmSetOrderIssued.SetOrderIssuedMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmSetOrderIssued.SetOrderIssuedMock.defaultExpectation.Counter, 1)
-		mm_want := mmSetOrderIssued.SetOrderIssuedMock.defaultExpectation.params
-		mm_want_ptrs := mmSetOrderIssued.SetOrderIssuedMock.defaultExpectation.paramPtrs
+	if mmSetOrderRefused.SetOrderRefusedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetOrderRefused.SetOrderRefusedMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetOrderRefused.SetOrderRefusedMock.defaultExpectation.params
+		mm_want_ptrs := mmSetOrderRefused.SetOrderRefusedMock.defaultExpectation.paramPtrs
 
-		mm_got := PVZOrderRepositoryMockSetOrderIssuedParams{ctx, orderID}
+		mm_got := PVZOrderRepositoryMockSetOrderRefusedParams{ctx, orderID, reason, expectedVersion}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmSetOrderIssued.t.Errorf("PVZOrderRepositoryMock.SetOrderIssued got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSetOrderIssued.SetOrderIssuedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSetOrderRefused.t.Errorf("PVZOrderRepositoryMock.SetOrderRefused got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetOrderRefused.SetOrderRefusedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
-				mmSetOrderIssued.t.Errorf("PVZOrderRepositoryMock.SetOrderIssued got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmSetOrderIssued.SetOrderIssuedMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+				mmSetOrderRefused.t.Errorf("PVZOrderRepositoryMock.SetOrderRefused got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetOrderRefused.SetOrderRefusedMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			}
+
+			if mm_want_ptrs.reason != nil && !minimock.Equal(*mm_want_ptrs.reason, mm_got.reason) {
+				mmSetOrderRefused.t.Errorf("PVZOrderRepositoryMock.SetOrderRefused got unexpected parameter reason, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetOrderRefused.SetOrderRefusedMock.defaultExpectation.expectationOrigins.originReason, *mm_want_ptrs.reason, mm_got.reason, minimock.Diff(*mm_want_ptrs.reason, mm_got.reason))
+			}
+
+			if mm_want_ptrs.expectedVersion != nil && !minimock.Equal(*mm_want_ptrs.expectedVersion, mm_got.expectedVersion) {
+				mmSetOrderRefused.t.Errorf("PVZOrderRepositoryMock.SetOrderRefused got unexpected parameter expectedVersion, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetOrderRefused.SetOrderRefusedMock.defaultExpectation.expectationOrigins.originExpectedVersion, *mm_want_ptrs.expectedVersion, mm_got.expectedVersion, minimock.Diff(*mm_want_ptrs.expectedVersion, mm_got.expectedVersion))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmSetOrderIssued.t.Errorf("PVZOrderRepositoryMock.SetOrderIssued got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmSetOrderIssued.SetOrderIssuedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSetOrderRefused.t.Errorf("PVZOrderRepositoryMock.SetOrderRefused got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetOrderRefused.SetOrderRefusedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmSetOrderIssued.SetOrderIssuedMock.defaultExpectation.results
+		mm_results := mmSetOrderRefused.SetOrderRefusedMock.defaultExpectation.results
 		if mm_results == nil {
-			mmSetOrderIssued.t.Fatal("No results are set for the PVZOrderRepositoryMock.SetOrderIssued")
+			mmSetOrderRefused.t.Fatal("No results are set for the PVZOrderRepositoryMock.SetOrderRefused")
 		}
 		return (*mm_results).err
 	}
-	if mmSetOrderIssued.funcSetOrderIssued != nil {
-		return mmSetOrderIssued.funcSetOrderIssued(ctx, orderID)
+	if mmSetOrderRefused.funcSetOrderRefused != nil {
+		return mmSetOrderRefused.funcSetOrderRefused(ctx, orderID, reason, expectedVersion)
 	}
-	mmSetOrderIssued.t.Fatalf("Unexpected call to PVZOrderRepositoryMock.SetOrderIssued. %v %v", ctx, orderID)
+	mmSetOrderRefused.t.Fatalf("Unexpected call to PVZOrderRepositoryMock.SetOrderRefused. %v %v %v %v", ctx, orderID, reason, expectedVersion)
 	return
 }
 
-// SetOrderIssuedAfterCounter returns a count of finished PVZOrderRepositoryMock.SetOrderIssued invocations
-func (mmSetOrderIssued *PVZOrderRepositoryMock) SetOrderIssuedAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSetOrderIssued.afterSetOrderIssuedCounter)
+// SetOrderRefusedAfterCounter returns a count of finished PVZOrderRepositoryMock.SetOrderRefused invocations
+func (mmSetOrderRefused *PVZOrderRepositoryMock) SetOrderRefusedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetOrderRefused.afterSetOrderRefusedCounter)
 }
 
-// SetOrderIssuedBeforeCounter returns a count of PVZOrderRepositoryMock.SetOrderIssued invocations
-func (mmSetOrderIssued *PVZOrderRepositoryMock) SetOrderIssuedBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmSetOrderIssued.beforeSetOrderIssuedCounter)
+// SetOrderRefusedBeforeCounter returns a count of PVZOrderRepositoryMock.SetOrderRefused invocations
+func (mmSetOrderRefused *PVZOrderRepositoryMock) SetOrderRefusedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetOrderRefused.beforeSetOrderRefusedCounter)
 }
 
-// Calls returns a list of arguments used in each call to PVZOrderRepositoryMock.SetOrderIssued.
+// Calls returns a list of arguments used in each call to PVZOrderRepositoryMock.SetOrderRefused.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmSetOrderIssued *mPVZOrderRepositoryMockSetOrderIssued) Calls() []*PVZOrderRepositoryMockSetOrderIssuedParams {
-	mmSetOrderIssued.mutex.RLock()
+func (mmSetOrderRefused *mPVZOrderRepositoryMockSetOrderRefused) Calls() []*PVZOrderRepositoryMockSetOrderRefusedParams {
+	mmSetOrderRefused.mutex.RLock()
 
-	argCopy := make([]*PVZOrderRepositoryMockSetOrderIssuedParams, len(mmSetOrderIssued.callArgs))
-	copy(argCopy, mmSetOrderIssued.callArgs)
+	argCopy := make([]*PVZOrderRepositoryMockSetOrderRefusedParams, len(mmSetOrderRefused.callArgs))
+	copy(argCopy, mmSetOrderRefused.callArgs)
 
-	mmSetOrderIssued.mutex.RUnlock()
+	mmSetOrderRefused.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockSetOrderIssuedDone returns true if the count of the SetOrderIssued invocations corresponds
+// MinimockSetOrderRefusedDone returns true if the count of the SetOrderRefused invocations corresponds
 // the number of defined expectations
-func (m *PVZOrderRepositoryMock) MinimockSetOrderIssuedDone() bool {
-	if m.SetOrderIssuedMock.optional {
+func (m *PVZOrderRepositoryMock) MinimockSetOrderRefusedDone() bool {
+	if m.SetOrderRefusedMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.SetOrderIssuedMock.expectations {
+	for _, e := range m.SetOrderRefusedMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.SetOrderIssuedMock.invocationsDone()
+	return m.SetOrderRefusedMock.invocationsDone()
 }
 
-// MinimockSetOrderIssuedInspect logs each unmet expectation
-func (m *PVZOrderRepositoryMock) MinimockSetOrderIssuedInspect() {
-	for _, e := range m.SetOrderIssuedMock.expectations {
+// MinimockSetOrderRefusedInspect logs each unmet expectation
+func (m *PVZOrderRepositoryMock) MinimockSetOrderRefusedInspect() {
+	for _, e := range m.SetOrderRefusedMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to PVZOrderRepositoryMock.SetOrderIssued at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to PVZOrderRepositoryMock.SetOrderRefused at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterSetOrderIssuedCounter := mm_atomic.LoadUint64(&m.afterSetOrderIssuedCounter)
+	afterSetOrderRefusedCounter := mm_atomic.LoadUint64(&m.afterSetOrderRefusedCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.SetOrderIssuedMock.defaultExpectation != nil && afterSetOrderIssuedCounter < 1 {
-		if m.SetOrderIssuedMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to PVZOrderRepositoryMock.SetOrderIssued at\n%s", m.SetOrderIssuedMock.defaultExpectation.returnOrigin)
+	if m.SetOrderRefusedMock.defaultExpectation != nil && afterSetOrderRefusedCounter < 1 {
+		if m.SetOrderRefusedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PVZOrderRepositoryMock.SetOrderRefused at\n%s", m.SetOrderRefusedMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to PVZOrderRepositoryMock.SetOrderIssued at\n%s with params: %#v", m.SetOrderIssuedMock.defaultExpectation.expectationOrigins.origin, *m.SetOrderIssuedMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to PVZOrderRepositoryMock.SetOrderRefused at\n%s with params: %#v", m.SetOrderRefusedMock.defaultExpectation.expectationOrigins.origin, *m.SetOrderRefusedMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcSetOrderIssued != nil && afterSetOrderIssuedCounter < 1 {
-		m.t.Errorf("Expected call to PVZOrderRepositoryMock.SetOrderIssued at\n%s", m.funcSetOrderIssuedOrigin)
+	if m.funcSetOrderRefused != nil && afterSetOrderRefusedCounter < 1 {
+		m.t.Errorf("Expected call to PVZOrderRepositoryMock.SetOrderRefused at\n%s", m.funcSetOrderRefusedOrigin)
 	}
 
-	if !m.SetOrderIssuedMock.invocationsDone() && afterSetOrderIssuedCounter > 0 {
-		m.t.Errorf("Expected %d calls to PVZOrderRepositoryMock.SetOrderIssued at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.SetOrderIssuedMock.expectedInvocations), m.SetOrderIssuedMock.expectedInvocationsOrigin, afterSetOrderIssuedCounter)
+	if !m.SetOrderRefusedMock.invocationsDone() && afterSetOrderRefusedCounter > 0 {
+		m.t.Errorf("Expected %d calls to PVZOrderRepositoryMock.SetOrderRefused at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetOrderRefusedMock.expectedInvocations), m.SetOrderRefusedMock.expectedInvocationsOrigin, afterSetOrderRefusedCounter)
 	}
 }
 
@@ -2215,14 +4424,18 @@ type PVZOrderRepositoryMockSetOrderReturnedExpectation struct {
 
 // PVZOrderRepositoryMockSetOrderReturnedParams contains parameters of the PVZOrderRepository.SetOrderReturned
 type PVZOrderRepositoryMockSetOrderReturnedParams struct {
-	ctx     context.Context
-	orderID string
+	ctx             context.Context
+	orderID         string
+	details         domain.ReturnDetails
+	expectedVersion int64
 }
 
 // PVZOrderRepositoryMockSetOrderReturnedParamPtrs contains pointers to parameters of the PVZOrderRepository.SetOrderReturned
 type PVZOrderRepositoryMockSetOrderReturnedParamPtrs struct {
-	ctx     *context.Context
-	orderID *string
+	ctx             *context.Context
+	orderID         *string
+	details         *domain.ReturnDetails
+	expectedVersion *int64
 }
 
 // PVZOrderRepositoryMockSetOrderReturnedResults contains results of the PVZOrderRepository.SetOrderReturned
@@ -2232,9 +4445,11 @@ type PVZOrderRepositoryMockSetOrderReturnedResults struct {
 
 // PVZOrderRepositoryMockSetOrderReturnedOrigins contains origins of expectations of the PVZOrderRepository.SetOrderReturned
 type PVZOrderRepositoryMockSetOrderReturnedExpectationOrigins struct {
-	origin        string
-	originCtx     string
-	originOrderID string
+	origin                string
+	originCtx             string
+	originOrderID         string
+	originDetails         string
+	originExpectedVersion string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -2248,7 +4463,7 @@ func (mmSetOrderReturned *mPVZOrderRepositoryMockSetOrderReturned) Optional() *m
 }
 
 // Expect sets up expected params for PVZOrderRepository.SetOrderReturned
-func (mmSetOrderReturned *mPVZOrderRepositoryMockSetOrderReturned) Expect(ctx context.Context, orderID string) *mPVZOrderRepositoryMockSetOrderReturned {
+func (mmSetOrderReturned *mPVZOrderRepositoryMockSetOrderReturned) Expect(ctx context.Context, orderID string, details domain.ReturnDetails, expectedVersion int64) *mPVZOrderRepositoryMockSetOrderReturned {
 	if mmSetOrderReturned.mock.funcSetOrderReturned != nil {
 		mmSetOrderReturned.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderReturned mock is already set by Set")
 	}
@@ -2261,7 +4476,7 @@ func (mmSetOrderReturned *mPVZOrderRepositoryMockSetOrderReturned) Expect(ctx co
 		mmSetOrderReturned.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderReturned mock is already set by ExpectParams functions")
 	}
 
-	mmSetOrderReturned.defaultExpectation.params = &PVZOrderRepositoryMockSetOrderReturnedParams{ctx, orderID}
+	mmSetOrderReturned.defaultExpectation.params = &PVZOrderRepositoryMockSetOrderReturnedParams{ctx, orderID, details, expectedVersion}
 	mmSetOrderReturned.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmSetOrderReturned.expectations {
 		if minimock.Equal(e.params, mmSetOrderReturned.defaultExpectation.params) {
@@ -2318,8 +4533,54 @@ func (mmSetOrderReturned *mPVZOrderRepositoryMockSetOrderReturned) ExpectOrderID
 	return mmSetOrderReturned
 }
 
+// ExpectDetailsParam3 sets up expected param details for PVZOrderRepository.SetOrderReturned
+func (mmSetOrderReturned *mPVZOrderRepositoryMockSetOrderReturned) ExpectDetailsParam3(details domain.ReturnDetails) *mPVZOrderRepositoryMockSetOrderReturned {
+	if mmSetOrderReturned.mock.funcSetOrderReturned != nil {
+		mmSetOrderReturned.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderReturned mock is already set by Set")
+	}
+
+	if mmSetOrderReturned.defaultExpectation == nil {
+		mmSetOrderReturned.defaultExpectation = &PVZOrderRepositoryMockSetOrderReturnedExpectation{}
+	}
+
+	if mmSetOrderReturned.defaultExpectation.params != nil {
+		mmSetOrderReturned.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderReturned mock is already set by Expect")
+	}
+
+	if mmSetOrderReturned.defaultExpectation.paramPtrs == nil {
+		mmSetOrderReturned.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockSetOrderReturnedParamPtrs{}
+	}
+	mmSetOrderReturned.defaultExpectation.paramPtrs.details = &details
+	mmSetOrderReturned.defaultExpectation.expectationOrigins.originDetails = minimock.CallerInfo(1)
+
+	return mmSetOrderReturned
+}
+
+// ExpectExpectedVersionParam4 sets up expected param expectedVersion for PVZOrderRepository.SetOrderReturned
+func (mmSetOrderReturned *mPVZOrderRepositoryMockSetOrderReturned) ExpectExpectedVersionParam4(expectedVersion int64) *mPVZOrderRepositoryMockSetOrderReturned {
+	if mmSetOrderReturned.mock.funcSetOrderReturned != nil {
+		mmSetOrderReturned.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderReturned mock is already set by Set")
+	}
+
+	if mmSetOrderReturned.defaultExpectation == nil {
+		mmSetOrderReturned.defaultExpectation = &PVZOrderRepositoryMockSetOrderReturnedExpectation{}
+	}
+
+	if mmSetOrderReturned.defaultExpectation.params != nil {
+		mmSetOrderReturned.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderReturned mock is already set by Expect")
+	}
+
+	if mmSetOrderReturned.defaultExpectation.paramPtrs == nil {
+		mmSetOrderReturned.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockSetOrderReturnedParamPtrs{}
+	}
+	mmSetOrderReturned.defaultExpectation.paramPtrs.expectedVersion = &expectedVersion
+	mmSetOrderReturned.defaultExpectation.expectationOrigins.originExpectedVersion = minimock.CallerInfo(1)
+
+	return mmSetOrderReturned
+}
+
 // Inspect accepts an inspector function that has same arguments as the PVZOrderRepository.SetOrderReturned
-func (mmSetOrderReturned *mPVZOrderRepositoryMockSetOrderReturned) Inspect(f func(ctx context.Context, orderID string)) *mPVZOrderRepositoryMockSetOrderReturned {
+func (mmSetOrderReturned *mPVZOrderRepositoryMockSetOrderReturned) Inspect(f func(ctx context.Context, orderID string, details domain.ReturnDetails, expectedVersion int64)) *mPVZOrderRepositoryMockSetOrderReturned {
 	if mmSetOrderReturned.mock.inspectFuncSetOrderReturned != nil {
 		mmSetOrderReturned.mock.t.Fatalf("Inspect function is already set for PVZOrderRepositoryMock.SetOrderReturned")
 	}
@@ -2344,7 +4605,7 @@ func (mmSetOrderReturned *mPVZOrderRepositoryMockSetOrderReturned) Return(err er
 }
 
 // Set uses given function f to mock the PVZOrderRepository.SetOrderReturned method
-func (mmSetOrderReturned *mPVZOrderRepositoryMockSetOrderReturned) Set(f func(ctx context.Context, orderID string) (err error)) *PVZOrderRepositoryMock {
+func (mmSetOrderReturned *mPVZOrderRepositoryMockSetOrderReturned) Set(f func(ctx context.Context, orderID string, details domain.ReturnDetails, expectedVersion int64) (err error)) *PVZOrderRepositoryMock {
 	if mmSetOrderReturned.defaultExpectation != nil {
 		mmSetOrderReturned.mock.t.Fatalf("Default expectation is already set for the PVZOrderRepository.SetOrderReturned method")
 	}
@@ -2360,14 +4621,14 @@ func (mmSetOrderReturned *mPVZOrderRepositoryMockSetOrderReturned) Set(f func(ct
 
 // When sets expectation for the PVZOrderRepository.SetOrderReturned which will trigger the result defined by the following
 // Then helper
-func (mmSetOrderReturned *mPVZOrderRepositoryMockSetOrderReturned) When(ctx context.Context, orderID string) *PVZOrderRepositoryMockSetOrderReturnedExpectation {
+func (mmSetOrderReturned *mPVZOrderRepositoryMockSetOrderReturned) When(ctx context.Context, orderID string, details domain.ReturnDetails, expectedVersion int64) *PVZOrderRepositoryMockSetOrderReturnedExpectation {
 	if mmSetOrderReturned.mock.funcSetOrderReturned != nil {
 		mmSetOrderReturned.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrderReturned mock is already set by Set")
 	}
 
 	expectation := &PVZOrderRepositoryMockSetOrderReturnedExpectation{
 		mock:               mmSetOrderReturned.mock,
-		params:             &PVZOrderRepositoryMockSetOrderReturnedParams{ctx, orderID},
+		params:             &PVZOrderRepositoryMockSetOrderReturnedParams{ctx, orderID, details, expectedVersion},
 		expectationOrigins: PVZOrderRepositoryMockSetOrderReturnedExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmSetOrderReturned.expectations = append(mmSetOrderReturned.expectations, expectation)
@@ -2402,17 +4663,17 @@ func (mmSetOrderReturned *mPVZOrderRepositoryMockSetOrderReturned) invocationsDo
 }
 
 // SetOrderReturned implements mm_usecases.PVZOrderRepository
-func (mmSetOrderReturned *PVZOrderRepositoryMock) SetOrderReturned(ctx context.Context, orderID string) (err error) {
+func (mmSetOrderReturned *PVZOrderRepositoryMock) SetOrderReturned(ctx context.Context, orderID string, details domain.ReturnDetails, expectedVersion int64) (err error) {
 	mm_atomic.AddUint64(&mmSetOrderReturned.beforeSetOrderReturnedCounter, 1)
 	defer mm_atomic.AddUint64(&mmSetOrderReturned.afterSetOrderReturnedCounter, 1)
 
 	mmSetOrderReturned.t.Helper()
 
 	if mmSetOrderReturned.inspectFuncSetOrderReturned != nil {
-		mmSetOrderReturned.inspectFuncSetOrderReturned(ctx, orderID)
+		mmSetOrderReturned.inspectFuncSetOrderReturned(ctx, orderID, details, expectedVersion)
 	}
 
-	mm_params := PVZOrderRepositoryMockSetOrderReturnedParams{ctx, orderID}
+	mm_params := PVZOrderRepositoryMockSetOrderReturnedParams{ctx, orderID, details, expectedVersion}
 
 	// Record call args
 	mmSetOrderReturned.SetOrderReturnedMock.mutex.Lock()
@@ -2431,7 +4692,7 @@ func (mmSetOrderReturned *PVZOrderRepositoryMock) SetOrderReturned(ctx context.C
 		mm_want := mmSetOrderReturned.SetOrderReturnedMock.defaultExpectation.params
 		mm_want_ptrs := mmSetOrderReturned.SetOrderReturnedMock.defaultExpectation.paramPtrs
 
-		mm_got := PVZOrderRepositoryMockSetOrderReturnedParams{ctx, orderID}
+		mm_got := PVZOrderRepositoryMockSetOrderReturnedParams{ctx, orderID, details, expectedVersion}
 
 		if mm_want_ptrs != nil {
 
@@ -2445,6 +4706,16 @@ func (mmSetOrderReturned *PVZOrderRepositoryMock) SetOrderReturned(ctx context.C
 					mmSetOrderReturned.SetOrderReturnedMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
 			}
 
+			if mm_want_ptrs.details != nil && !minimock.Equal(*mm_want_ptrs.details, mm_got.details) {
+				mmSetOrderReturned.t.Errorf("PVZOrderRepositoryMock.SetOrderReturned got unexpected parameter details, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetOrderReturned.SetOrderReturnedMock.defaultExpectation.expectationOrigins.originDetails, *mm_want_ptrs.details, mm_got.details, minimock.Diff(*mm_want_ptrs.details, mm_got.details))
+			}
+
+			if mm_want_ptrs.expectedVersion != nil && !minimock.Equal(*mm_want_ptrs.expectedVersion, mm_got.expectedVersion) {
+				mmSetOrderReturned.t.Errorf("PVZOrderRepositoryMock.SetOrderReturned got unexpected parameter expectedVersion, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetOrderReturned.SetOrderReturnedMock.defaultExpectation.expectationOrigins.originExpectedVersion, *mm_want_ptrs.expectedVersion, mm_got.expectedVersion, minimock.Diff(*mm_want_ptrs.expectedVersion, mm_got.expectedVersion))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmSetOrderReturned.t.Errorf("PVZOrderRepositoryMock.SetOrderReturned got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmSetOrderReturned.SetOrderReturnedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -2457,9 +4728,9 @@ func (mmSetOrderReturned *PVZOrderRepositoryMock) SetOrderReturned(ctx context.C
 		return (*mm_results).err
 	}
 	if mmSetOrderReturned.funcSetOrderReturned != nil {
-		return mmSetOrderReturned.funcSetOrderReturned(ctx, orderID)
+		return mmSetOrderReturned.funcSetOrderReturned(ctx, orderID, details, expectedVersion)
 	}
-	mmSetOrderReturned.t.Fatalf("Unexpected call to PVZOrderRepositoryMock.SetOrderReturned. %v %v", ctx, orderID)
+	mmSetOrderReturned.t.Fatalf("Unexpected call to PVZOrderRepositoryMock.SetOrderReturned. %v %v %v %v", ctx, orderID, details, expectedVersion)
 	return
 }
 
@@ -2531,23 +4802,377 @@ func (m *PVZOrderRepositoryMock) MinimockSetOrderReturnedInspect() {
 	}
 }
 
+type mPVZOrderRepositoryMockSetOrdersIssued struct {
+	optional           bool
+	mock               *PVZOrderRepositoryMock
+	defaultExpectation *PVZOrderRepositoryMockSetOrdersIssuedExpectation
+	expectations       []*PVZOrderRepositoryMockSetOrdersIssuedExpectation
+
+	callArgs []*PVZOrderRepositoryMockSetOrdersIssuedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PVZOrderRepositoryMockSetOrdersIssuedExpectation specifies expectation struct of the PVZOrderRepository.SetOrdersIssued
+type PVZOrderRepositoryMockSetOrdersIssuedExpectation struct {
+	mock               *PVZOrderRepositoryMock
+	params             *PVZOrderRepositoryMockSetOrdersIssuedParams
+	paramPtrs          *PVZOrderRepositoryMockSetOrdersIssuedParamPtrs
+	expectationOrigins PVZOrderRepositoryMockSetOrdersIssuedExpectationOrigins
+	results            *PVZOrderRepositoryMockSetOrdersIssuedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PVZOrderRepositoryMockSetOrdersIssuedParams contains parameters of the PVZOrderRepository.SetOrdersIssued
+type PVZOrderRepositoryMockSetOrdersIssuedParams struct {
+	ctx       context.Context
+	decisions []domain.IssueDecision
+}
+
+// PVZOrderRepositoryMockSetOrdersIssuedParamPtrs contains pointers to parameters of the PVZOrderRepository.SetOrdersIssued
+type PVZOrderRepositoryMockSetOrdersIssuedParamPtrs struct {
+	ctx       *context.Context
+	decisions *[]domain.IssueDecision
+}
+
+// PVZOrderRepositoryMockSetOrdersIssuedResults contains results of the PVZOrderRepository.SetOrdersIssued
+type PVZOrderRepositoryMockSetOrdersIssuedResults struct {
+	err error
+}
+
+// PVZOrderRepositoryMockSetOrdersIssuedOrigins contains origins of expectations of the PVZOrderRepository.SetOrdersIssued
+type PVZOrderRepositoryMockSetOrdersIssuedExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originDecisions string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetOrdersIssued *mPVZOrderRepositoryMockSetOrdersIssued) Optional() *mPVZOrderRepositoryMockSetOrdersIssued {
+	mmSetOrdersIssued.optional = true
+	return mmSetOrdersIssued
+}
+
+// Expect sets up expected params for PVZOrderRepository.SetOrdersIssued
+func (mmSetOrdersIssued *mPVZOrderRepositoryMockSetOrdersIssued) Expect(ctx context.Context, decisions []domain.IssueDecision) *mPVZOrderRepositoryMockSetOrdersIssued {
+	if mmSetOrdersIssued.mock.funcSetOrdersIssued != nil {
+		mmSetOrdersIssued.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrdersIssued mock is already set by Set")
+	}
+
+	if mmSetOrdersIssued.defaultExpectation == nil {
+		mmSetOrdersIssued.defaultExpectation = &PVZOrderRepositoryMockSetOrdersIssuedExpectation{}
+	}
+
+	if mmSetOrdersIssued.defaultExpectation.paramPtrs != nil {
+		mmSetOrdersIssued.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrdersIssued mock is already set by ExpectParams functions")
+	}
+
+	mmSetOrdersIssued.defaultExpectation.params = &PVZOrderRepositoryMockSetOrdersIssuedParams{ctx, decisions}
+	mmSetOrdersIssued.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetOrdersIssued.expectations {
+		if minimock.Equal(e.params, mmSetOrdersIssued.defaultExpectation.params) {
+			mmSetOrdersIssued.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetOrdersIssued.defaultExpectation.params)
+		}
+	}
+
+	return mmSetOrdersIssued
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PVZOrderRepository.SetOrdersIssued
+func (mmSetOrdersIssued *mPVZOrderRepositoryMockSetOrdersIssued) ExpectCtxParam1(ctx context.Context) *mPVZOrderRepositoryMockSetOrdersIssued {
+	if mmSetOrdersIssued.mock.funcSetOrdersIssued != nil {
+		mmSetOrdersIssued.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrdersIssued mock is already set by Set")
+	}
+
+	if mmSetOrdersIssued.defaultExpectation == nil {
+		mmSetOrdersIssued.defaultExpectation = &PVZOrderRepositoryMockSetOrdersIssuedExpectation{}
+	}
+
+	if mmSetOrdersIssued.defaultExpectation.params != nil {
+		mmSetOrdersIssued.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrdersIssued mock is already set by Expect")
+	}
+
+	if mmSetOrdersIssued.defaultExpectation.paramPtrs == nil {
+		mmSetOrdersIssued.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockSetOrdersIssuedParamPtrs{}
+	}
+	mmSetOrdersIssued.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetOrdersIssued.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetOrdersIssued
+}
+
+// ExpectDecisionsParam2 sets up expected param decisions for PVZOrderRepository.SetOrdersIssued
+func (mmSetOrdersIssued *mPVZOrderRepositoryMockSetOrdersIssued) ExpectDecisionsParam2(decisions []domain.IssueDecision) *mPVZOrderRepositoryMockSetOrdersIssued {
+	if mmSetOrdersIssued.mock.funcSetOrdersIssued != nil {
+		mmSetOrdersIssued.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrdersIssued mock is already set by Set")
+	}
+
+	if mmSetOrdersIssued.defaultExpectation == nil {
+		mmSetOrdersIssued.defaultExpectation = &PVZOrderRepositoryMockSetOrdersIssuedExpectation{}
+	}
+
+	if mmSetOrdersIssued.defaultExpectation.params != nil {
+		mmSetOrdersIssued.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrdersIssued mock is already set by Expect")
+	}
+
+	if mmSetOrdersIssued.defaultExpectation.paramPtrs == nil {
+		mmSetOrdersIssued.defaultExpectation.paramPtrs = &PVZOrderRepositoryMockSetOrdersIssuedParamPtrs{}
+	}
+	mmSetOrdersIssued.defaultExpectation.paramPtrs.decisions = &decisions
+	mmSetOrdersIssued.defaultExpectation.expectationOrigins.originDecisions = minimock.CallerInfo(1)
+
+	return mmSetOrdersIssued
+}
+
+// Inspect accepts an inspector function that has same arguments as the PVZOrderRepository.SetOrdersIssued
+func (mmSetOrdersIssued *mPVZOrderRepositoryMockSetOrdersIssued) Inspect(f func(ctx context.Context, decisions []domain.IssueDecision)) *mPVZOrderRepositoryMockSetOrdersIssued {
+	if mmSetOrdersIssued.mock.inspectFuncSetOrdersIssued != nil {
+		mmSetOrdersIssued.mock.t.Fatalf("Inspect function is already set for PVZOrderRepositoryMock.SetOrdersIssued")
+	}
+
+	mmSetOrdersIssued.mock.inspectFuncSetOrdersIssued = f
+
+	return mmSetOrdersIssued
+}
+
+// Return sets up results that will be returned by PVZOrderRepository.SetOrdersIssued
+func (mmSetOrdersIssued *mPVZOrderRepositoryMockSetOrdersIssued) Return(err error) *PVZOrderRepositoryMock {
+	if mmSetOrdersIssued.mock.funcSetOrdersIssued != nil {
+		mmSetOrdersIssued.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrdersIssued mock is already set by Set")
+	}
+
+	if mmSetOrdersIssued.defaultExpectation == nil {
+		mmSetOrdersIssued.defaultExpectation = &PVZOrderRepositoryMockSetOrdersIssuedExpectation{mock: mmSetOrdersIssued.mock}
+	}
+	mmSetOrdersIssued.defaultExpectation.results = &PVZOrderRepositoryMockSetOrdersIssuedResults{err}
+	mmSetOrdersIssued.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetOrdersIssued.mock
+}
+
+// Set uses given function f to mock the PVZOrderRepository.SetOrdersIssued method
+func (mmSetOrdersIssued *mPVZOrderRepositoryMockSetOrdersIssued) Set(f func(ctx context.Context, decisions []domain.IssueDecision) (err error)) *PVZOrderRepositoryMock {
+	if mmSetOrdersIssued.defaultExpectation != nil {
+		mmSetOrdersIssued.mock.t.Fatalf("Default expectation is already set for the PVZOrderRepository.SetOrdersIssued method")
+	}
+
+	if len(mmSetOrdersIssued.expectations) > 0 {
+		mmSetOrdersIssued.mock.t.Fatalf("Some expectations are already set for the PVZOrderRepository.SetOrdersIssued method")
+	}
+
+	mmSetOrdersIssued.mock.funcSetOrdersIssued = f
+	mmSetOrdersIssued.mock.funcSetOrdersIssuedOrigin = minimock.CallerInfo(1)
+	return mmSetOrdersIssued.mock
+}
+
+// When sets expectation for the PVZOrderRepository.SetOrdersIssued which will trigger the result defined by the following
+// Then helper
+func (mmSetOrdersIssued *mPVZOrderRepositoryMockSetOrdersIssued) When(ctx context.Context, decisions []domain.IssueDecision) *PVZOrderRepositoryMockSetOrdersIssuedExpectation {
+	if mmSetOrdersIssued.mock.funcSetOrdersIssued != nil {
+		mmSetOrdersIssued.mock.t.Fatalf("PVZOrderRepositoryMock.SetOrdersIssued mock is already set by Set")
+	}
+
+	expectation := &PVZOrderRepositoryMockSetOrdersIssuedExpectation{
+		mock:               mmSetOrdersIssued.mock,
+		params:             &PVZOrderRepositoryMockSetOrdersIssuedParams{ctx, decisions},
+		expectationOrigins: PVZOrderRepositoryMockSetOrdersIssuedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetOrdersIssued.expectations = append(mmSetOrdersIssued.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PVZOrderRepository.SetOrdersIssued return parameters for the expectation previously defined by the When method
+func (e *PVZOrderRepositoryMockSetOrdersIssuedExpectation) Then(err error) *PVZOrderRepositoryMock {
+	e.results = &PVZOrderRepositoryMockSetOrdersIssuedResults{err}
+	return e.mock
+}
+
+// Times sets number of times PVZOrderRepository.SetOrdersIssued should be invoked
+func (mmSetOrdersIssued *mPVZOrderRepositoryMockSetOrdersIssued) Times(n uint64) *mPVZOrderRepositoryMockSetOrdersIssued {
+	if n == 0 {
+		mmSetOrdersIssued.mock.t.Fatalf("Times of PVZOrderRepositoryMock.SetOrdersIssued mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetOrdersIssued.expectedInvocations, n)
+	mmSetOrdersIssued.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetOrdersIssued
+}
+
+func (mmSetOrdersIssued *mPVZOrderRepositoryMockSetOrdersIssued) invocationsDone() bool {
+	if len(mmSetOrdersIssued.expectations) == 0 && mmSetOrdersIssued.defaultExpectation == nil && mmSetOrdersIssued.mock.funcSetOrdersIssued == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetOrdersIssued.mock.afterSetOrdersIssuedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetOrdersIssued.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetOrdersIssued implements mm_usecases.PVZOrderRepository
+func (mmSetOrdersIssued *PVZOrderRepositoryMock) SetOrdersIssued(ctx context.Context, decisions []domain.IssueDecision) (err error) {
+	mm_atomic.AddUint64(&mmSetOrdersIssued.beforeSetOrdersIssuedCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetOrdersIssued.afterSetOrdersIssuedCounter, 1)
+
+	mmSetOrdersIssued.t.Helper()
+
+	if mmSetOrdersIssued.inspectFuncSetOrdersIssued != nil {
+		mmSetOrdersIssued.inspectFuncSetOrdersIssued(ctx, decisions)
+	}
+
+	mm_params := PVZOrderRepositoryMockSetOrdersIssuedParams{ctx, decisions}
+
+	// Record call args
+	mmSetOrdersIssued.SetOrdersIssuedMock.mutex.Lock()
+	mmSetOrdersIssued.SetOrdersIssuedMock.callArgs = append(mmSetOrdersIssued.SetOrdersIssuedMock.callArgs, &mm_params)
+	mmSetOrdersIssued.SetOrdersIssuedMock.mutex.Unlock()
+
+	for _, e := range mmSetOrdersIssued.SetOrdersIssuedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetOrdersIssued.SetOrdersIssuedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetOrdersIssued.SetOrdersIssuedMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetOrdersIssued.SetOrdersIssuedMock.defaultExpectation.params
+		mm_want_ptrs := mmSetOrdersIssued.SetOrdersIssuedMock.defaultExpectation.paramPtrs
+
+		mm_got := PVZOrderRepositoryMockSetOrdersIssuedParams{ctx, decisions}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetOrdersIssued.t.Errorf("PVZOrderRepositoryMock.SetOrdersIssued got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetOrdersIssued.SetOrdersIssuedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.decisions != nil && !minimock.Equal(*mm_want_ptrs.decisions, mm_got.decisions) {
+				mmSetOrdersIssued.t.Errorf("PVZOrderRepositoryMock.SetOrdersIssued got unexpected parameter decisions, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetOrdersIssued.SetOrdersIssuedMock.defaultExpectation.expectationOrigins.originDecisions, *mm_want_ptrs.decisions, mm_got.decisions, minimock.Diff(*mm_want_ptrs.decisions, mm_got.decisions))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetOrdersIssued.t.Errorf("PVZOrderRepositoryMock.SetOrdersIssued got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetOrdersIssued.SetOrdersIssuedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetOrdersIssued.SetOrdersIssuedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetOrdersIssued.t.Fatal("No results are set for the PVZOrderRepositoryMock.SetOrdersIssued")
+		}
+		return (*mm_results).err
+	}
+	if mmSetOrdersIssued.funcSetOrdersIssued != nil {
+		return mmSetOrdersIssued.funcSetOrdersIssued(ctx, decisions)
+	}
+	mmSetOrdersIssued.t.Fatalf("Unexpected call to PVZOrderRepositoryMock.SetOrdersIssued. %v %v", ctx, decisions)
+	return
+}
+
+// SetOrdersIssuedAfterCounter returns a count of finished PVZOrderRepositoryMock.SetOrdersIssued invocations
+func (mmSetOrdersIssued *PVZOrderRepositoryMock) SetOrdersIssuedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetOrdersIssued.afterSetOrdersIssuedCounter)
+}
+
+// SetOrdersIssuedBeforeCounter returns a count of PVZOrderRepositoryMock.SetOrdersIssued invocations
+func (mmSetOrdersIssued *PVZOrderRepositoryMock) SetOrdersIssuedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetOrdersIssued.beforeSetOrdersIssuedCounter)
+}
+
+// Calls returns a list of arguments used in each call to PVZOrderRepositoryMock.SetOrdersIssued.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetOrdersIssued *mPVZOrderRepositoryMockSetOrdersIssued) Calls() []*PVZOrderRepositoryMockSetOrdersIssuedParams {
+	mmSetOrdersIssued.mutex.RLock()
+
+	argCopy := make([]*PVZOrderRepositoryMockSetOrdersIssuedParams, len(mmSetOrdersIssued.callArgs))
+	copy(argCopy, mmSetOrdersIssued.callArgs)
+
+	mmSetOrdersIssued.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetOrdersIssuedDone returns true if the count of the SetOrdersIssued invocations corresponds
+// the number of defined expectations
+func (m *PVZOrderRepositoryMock) MinimockSetOrdersIssuedDone() bool {
+	if m.SetOrdersIssuedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetOrdersIssuedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetOrdersIssuedMock.invocationsDone()
+}
+
+// MinimockSetOrdersIssuedInspect logs each unmet expectation
+func (m *PVZOrderRepositoryMock) MinimockSetOrdersIssuedInspect() {
+	for _, e := range m.SetOrdersIssuedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PVZOrderRepositoryMock.SetOrdersIssued at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetOrdersIssuedCounter := mm_atomic.LoadUint64(&m.afterSetOrdersIssuedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetOrdersIssuedMock.defaultExpectation != nil && afterSetOrdersIssuedCounter < 1 {
+		if m.SetOrdersIssuedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PVZOrderRepositoryMock.SetOrdersIssued at\n%s", m.SetOrdersIssuedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PVZOrderRepositoryMock.SetOrdersIssued at\n%s with params: %#v", m.SetOrdersIssuedMock.defaultExpectation.expectationOrigins.origin, *m.SetOrdersIssuedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetOrdersIssued != nil && afterSetOrdersIssuedCounter < 1 {
+		m.t.Errorf("Expected call to PVZOrderRepositoryMock.SetOrdersIssued at\n%s", m.funcSetOrdersIssuedOrigin)
+	}
+
+	if !m.SetOrdersIssuedMock.invocationsDone() && afterSetOrdersIssuedCounter > 0 {
+		m.t.Errorf("Expected %d calls to PVZOrderRepositoryMock.SetOrdersIssued at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetOrdersIssuedMock.expectedInvocations), m.SetOrdersIssuedMock.expectedInvocationsOrigin, afterSetOrdersIssuedCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *PVZOrderRepositoryMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
 			m.MinimockCreateOrderInspect()
 
+			m.MinimockCreateOrdersInspect()
+
 			m.MinimockDeleteOrderInspect()
 
+			m.MinimockExtendStorageInspect()
+
 			m.MinimockGetOrderInspect()
 
+			m.MinimockGetOrderHistoryInspect()
+
 			m.MinimockGetOrdersInspect()
 
 			m.MinimockGetReturnsInspect()
 
-			m.MinimockSetOrderIssuedInspect()
+			m.MinimockGetWeightDiscrepanciesInspect()
+
+			m.MinimockRegisterFailedPickupAttemptInspect()
+
+			m.MinimockSetOrderRefusedInspect()
 
 			m.MinimockSetOrderReturnedInspect()
+
+			m.MinimockSetOrdersIssuedInspect()
 		}
 	})
 }
@@ -2572,10 +5197,16 @@ func (m *PVZOrderRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockCreateOrderDone() &&
+		m.MinimockCreateOrdersDone() &&
 		m.MinimockDeleteOrderDone() &&
+		m.MinimockExtendStorageDone() &&
 		m.MinimockGetOrderDone() &&
+		m.MinimockGetOrderHistoryDone() &&
 		m.MinimockGetOrdersDone() &&
 		m.MinimockGetReturnsDone() &&
-		m.MinimockSetOrderIssuedDone() &&
-		m.MinimockSetOrderReturnedDone()
+		m.MinimockGetWeightDiscrepanciesDone() &&
+		m.MinimockRegisterFailedPickupAttemptDone() &&
+		m.MinimockSetOrderRefusedDone() &&
+		m.MinimockSetOrderReturnedDone() &&
+		m.MinimockSetOrdersIssuedDone()
 }
@@ -0,0 +1,1516 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"homework/internal/domain"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+	_ "github.com/gojuno/minimock/v3"
+)
+
+// PVZPoliciesMock implements mm_usecases.PVZPolicies
+type PVZPoliciesMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCalendar          func(ctx context.Context, pvzID string) (c2 domain.Calendar, err error)
+	funcCalendarOrigin    string
+	inspectFuncCalendar   func(ctx context.Context, pvzID string)
+	afterCalendarCounter  uint64
+	beforeCalendarCounter uint64
+	CalendarMock          mPVZPoliciesMockCalendar
+
+	funcPaidStorage          func(ctx context.Context, pvzID string, packaging domain.PackagingType) (p1 domain.PaidStorage, err error)
+	funcPaidStorageOrigin    string
+	inspectFuncPaidStorage   func(ctx context.Context, pvzID string, packaging domain.PackagingType)
+	afterPaidStorageCounter  uint64
+	beforePaidStorageCounter uint64
+	PaidStorageMock          mPVZPoliciesMockPaidStorage
+
+	funcPolicy          func(ctx context.Context, pvzID string) (p1 domain.PVZPolicy, err error)
+	funcPolicyOrigin    string
+	inspectFuncPolicy   func(ctx context.Context, pvzID string)
+	afterPolicyCounter  uint64
+	beforePolicyCounter uint64
+	PolicyMock          mPVZPoliciesMockPolicy
+
+	funcWeightTolerancePercent          func(ctx context.Context, pvzID string) (i1 int, err error)
+	funcWeightTolerancePercentOrigin    string
+	inspectFuncWeightTolerancePercent   func(ctx context.Context, pvzID string)
+	afterWeightTolerancePercentCounter  uint64
+	beforeWeightTolerancePercentCounter uint64
+	WeightTolerancePercentMock          mPVZPoliciesMockWeightTolerancePercent
+}
+
+// NewPVZPoliciesMock returns a mock for mm_usecases.PVZPolicies
+func NewPVZPoliciesMock(t minimock.Tester) *PVZPoliciesMock {
+	m := &PVZPoliciesMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CalendarMock = mPVZPoliciesMockCalendar{mock: m}
+	m.CalendarMock.callArgs = []*PVZPoliciesMockCalendarParams{}
+
+	m.PaidStorageMock = mPVZPoliciesMockPaidStorage{mock: m}
+	m.PaidStorageMock.callArgs = []*PVZPoliciesMockPaidStorageParams{}
+
+	m.PolicyMock = mPVZPoliciesMockPolicy{mock: m}
+	m.PolicyMock.callArgs = []*PVZPoliciesMockPolicyParams{}
+
+	m.WeightTolerancePercentMock = mPVZPoliciesMockWeightTolerancePercent{mock: m}
+	m.WeightTolerancePercentMock.callArgs = []*PVZPoliciesMockWeightTolerancePercentParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPVZPoliciesMockCalendar struct {
+	optional           bool
+	mock               *PVZPoliciesMock
+	defaultExpectation *PVZPoliciesMockCalendarExpectation
+	expectations       []*PVZPoliciesMockCalendarExpectation
+
+	callArgs []*PVZPoliciesMockCalendarParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PVZPoliciesMockCalendarExpectation specifies expectation struct of the PVZPolicies.Calendar
+type PVZPoliciesMockCalendarExpectation struct {
+	mock               *PVZPoliciesMock
+	params             *PVZPoliciesMockCalendarParams
+	paramPtrs          *PVZPoliciesMockCalendarParamPtrs
+	expectationOrigins PVZPoliciesMockCalendarExpectationOrigins
+	results            *PVZPoliciesMockCalendarResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PVZPoliciesMockCalendarParams contains parameters of the PVZPolicies.Calendar
+type PVZPoliciesMockCalendarParams struct {
+	ctx   context.Context
+	pvzID string
+}
+
+// PVZPoliciesMockCalendarParamPtrs contains pointers to parameters of the PVZPolicies.Calendar
+type PVZPoliciesMockCalendarParamPtrs struct {
+	ctx   *context.Context
+	pvzID *string
+}
+
+// PVZPoliciesMockCalendarResults contains results of the PVZPolicies.Calendar
+type PVZPoliciesMockCalendarResults struct {
+	c2  domain.Calendar
+	err error
+}
+
+// PVZPoliciesMockCalendarOrigins contains origins of expectations of the PVZPolicies.Calendar
+type PVZPoliciesMockCalendarExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originPvzID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCalendar *mPVZPoliciesMockCalendar) Optional() *mPVZPoliciesMockCalendar {
+	mmCalendar.optional = true
+	return mmCalendar
+}
+
+// Expect sets up expected params for PVZPolicies.Calendar
+func (mmCalendar *mPVZPoliciesMockCalendar) Expect(ctx context.Context, pvzID string) *mPVZPoliciesMockCalendar {
+	if mmCalendar.mock.funcCalendar != nil {
+		mmCalendar.mock.t.Fatalf("PVZPoliciesMock.Calendar mock is already set by Set")
+	}
+
+	if mmCalendar.defaultExpectation == nil {
+		mmCalendar.defaultExpectation = &PVZPoliciesMockCalendarExpectation{}
+	}
+
+	if mmCalendar.defaultExpectation.paramPtrs != nil {
+		mmCalendar.mock.t.Fatalf("PVZPoliciesMock.Calendar mock is already set by ExpectParams functions")
+	}
+
+	mmCalendar.defaultExpectation.params = &PVZPoliciesMockCalendarParams{ctx, pvzID}
+	mmCalendar.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCalendar.expectations {
+		if minimock.Equal(e.params, mmCalendar.defaultExpectation.params) {
+			mmCalendar.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCalendar.defaultExpectation.params)
+		}
+	}
+
+	return mmCalendar
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PVZPolicies.Calendar
+func (mmCalendar *mPVZPoliciesMockCalendar) ExpectCtxParam1(ctx context.Context) *mPVZPoliciesMockCalendar {
+	if mmCalendar.mock.funcCalendar != nil {
+		mmCalendar.mock.t.Fatalf("PVZPoliciesMock.Calendar mock is already set by Set")
+	}
+
+	if mmCalendar.defaultExpectation == nil {
+		mmCalendar.defaultExpectation = &PVZPoliciesMockCalendarExpectation{}
+	}
+
+	if mmCalendar.defaultExpectation.params != nil {
+		mmCalendar.mock.t.Fatalf("PVZPoliciesMock.Calendar mock is already set by Expect")
+	}
+
+	if mmCalendar.defaultExpectation.paramPtrs == nil {
+		mmCalendar.defaultExpectation.paramPtrs = &PVZPoliciesMockCalendarParamPtrs{}
+	}
+	mmCalendar.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCalendar.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCalendar
+}
+
+// ExpectPvzIDParam2 sets up expected param pvzID for PVZPolicies.Calendar
+func (mmCalendar *mPVZPoliciesMockCalendar) ExpectPvzIDParam2(pvzID string) *mPVZPoliciesMockCalendar {
+	if mmCalendar.mock.funcCalendar != nil {
+		mmCalendar.mock.t.Fatalf("PVZPoliciesMock.Calendar mock is already set by Set")
+	}
+
+	if mmCalendar.defaultExpectation == nil {
+		mmCalendar.defaultExpectation = &PVZPoliciesMockCalendarExpectation{}
+	}
+
+	if mmCalendar.defaultExpectation.params != nil {
+		mmCalendar.mock.t.Fatalf("PVZPoliciesMock.Calendar mock is already set by Expect")
+	}
+
+	if mmCalendar.defaultExpectation.paramPtrs == nil {
+		mmCalendar.defaultExpectation.paramPtrs = &PVZPoliciesMockCalendarParamPtrs{}
+	}
+	mmCalendar.defaultExpectation.paramPtrs.pvzID = &pvzID
+	mmCalendar.defaultExpectation.expectationOrigins.originPvzID = minimock.CallerInfo(1)
+
+	return mmCalendar
+}
+
+// Inspect accepts an inspector function that has same arguments as the PVZPolicies.Calendar
+func (mmCalendar *mPVZPoliciesMockCalendar) Inspect(f func(ctx context.Context, pvzID string)) *mPVZPoliciesMockCalendar {
+	if mmCalendar.mock.inspectFuncCalendar != nil {
+		mmCalendar.mock.t.Fatalf("Inspect function is already set for PVZPoliciesMock.Calendar")
+	}
+
+	mmCalendar.mock.inspectFuncCalendar = f
+
+	return mmCalendar
+}
+
+// Return sets up results that will be returned by PVZPolicies.Calendar
+func (mmCalendar *mPVZPoliciesMockCalendar) Return(c2 domain.Calendar, err error) *PVZPoliciesMock {
+	if mmCalendar.mock.funcCalendar != nil {
+		mmCalendar.mock.t.Fatalf("PVZPoliciesMock.Calendar mock is already set by Set")
+	}
+
+	if mmCalendar.defaultExpectation == nil {
+		mmCalendar.defaultExpectation = &PVZPoliciesMockCalendarExpectation{mock: mmCalendar.mock}
+	}
+	mmCalendar.defaultExpectation.results = &PVZPoliciesMockCalendarResults{c2, err}
+	mmCalendar.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCalendar.mock
+}
+
+// Set uses given function f to mock the PVZPolicies.Calendar method
+func (mmCalendar *mPVZPoliciesMockCalendar) Set(f func(ctx context.Context, pvzID string) (c2 domain.Calendar, err error)) *PVZPoliciesMock {
+	if mmCalendar.defaultExpectation != nil {
+		mmCalendar.mock.t.Fatalf("Default expectation is already set for the PVZPolicies.Calendar method")
+	}
+
+	if len(mmCalendar.expectations) > 0 {
+		mmCalendar.mock.t.Fatalf("Some expectations are already set for the PVZPolicies.Calendar method")
+	}
+
+	mmCalendar.mock.funcCalendar = f
+	mmCalendar.mock.funcCalendarOrigin = minimock.CallerInfo(1)
+	return mmCalendar.mock
+}
+
+// When sets expectation for the PVZPolicies.Calendar which will trigger the result defined by the following
+// Then helper
+func (mmCalendar *mPVZPoliciesMockCalendar) When(ctx context.Context, pvzID string) *PVZPoliciesMockCalendarExpectation {
+	if mmCalendar.mock.funcCalendar != nil {
+		mmCalendar.mock.t.Fatalf("PVZPoliciesMock.Calendar mock is already set by Set")
+	}
+
+	expectation := &PVZPoliciesMockCalendarExpectation{
+		mock:               mmCalendar.mock,
+		params:             &PVZPoliciesMockCalendarParams{ctx, pvzID},
+		expectationOrigins: PVZPoliciesMockCalendarExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCalendar.expectations = append(mmCalendar.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PVZPolicies.Calendar return parameters for the expectation previously defined by the When method
+func (e *PVZPoliciesMockCalendarExpectation) Then(c2 domain.Calendar, err error) *PVZPoliciesMock {
+	e.results = &PVZPoliciesMockCalendarResults{c2, err}
+	return e.mock
+}
+
+// Times sets number of times PVZPolicies.Calendar should be invoked
+func (mmCalendar *mPVZPoliciesMockCalendar) Times(n uint64) *mPVZPoliciesMockCalendar {
+	if n == 0 {
+		mmCalendar.mock.t.Fatalf("Times of PVZPoliciesMock.Calendar mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCalendar.expectedInvocations, n)
+	mmCalendar.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCalendar
+}
+
+func (mmCalendar *mPVZPoliciesMockCalendar) invocationsDone() bool {
+	if len(mmCalendar.expectations) == 0 && mmCalendar.defaultExpectation == nil && mmCalendar.mock.funcCalendar == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCalendar.mock.afterCalendarCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCalendar.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Calendar implements mm_usecases.PVZPolicies
+func (mmCalendar *PVZPoliciesMock) Calendar(ctx context.Context, pvzID string) (c2 domain.Calendar, err error) {
+	mm_atomic.AddUint64(&mmCalendar.beforeCalendarCounter, 1)
+	defer mm_atomic.AddUint64(&mmCalendar.afterCalendarCounter, 1)
+
+	mmCalendar.t.Helper()
+
+	if mmCalendar.inspectFuncCalendar != nil {
+		mmCalendar.inspectFuncCalendar(ctx, pvzID)
+	}
+
+	mm_params := PVZPoliciesMockCalendarParams{ctx, pvzID}
+
+	// Record call args
+	mmCalendar.CalendarMock.mutex.Lock()
+	mmCalendar.CalendarMock.callArgs = append(mmCalendar.CalendarMock.callArgs, &mm_params)
+	mmCalendar.CalendarMock.mutex.Unlock()
+
+	for _, e := range mmCalendar.CalendarMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.c2, e.results.err
+		}
+	}
+
+	if mmCalendar.CalendarMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCalendar.CalendarMock.defaultExpectation.Counter, 1)
+		mm_want := mmCalendar.CalendarMock.defaultExpectation.params
+		mm_want_ptrs := mmCalendar.CalendarMock.defaultExpectation.paramPtrs
+
+		mm_got := PVZPoliciesMockCalendarParams{ctx, pvzID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCalendar.t.Errorf("PVZPoliciesMock.Calendar got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCalendar.CalendarMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pvzID != nil && !minimock.Equal(*mm_want_ptrs.pvzID, mm_got.pvzID) {
+				mmCalendar.t.Errorf("PVZPoliciesMock.Calendar got unexpected parameter pvzID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCalendar.CalendarMock.defaultExpectation.expectationOrigins.originPvzID, *mm_want_ptrs.pvzID, mm_got.pvzID, minimock.Diff(*mm_want_ptrs.pvzID, mm_got.pvzID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCalendar.t.Errorf("PVZPoliciesMock.Calendar got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCalendar.CalendarMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCalendar.CalendarMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCalendar.t.Fatal("No results are set for the PVZPoliciesMock.Calendar")
+		}
+		return (*mm_results).c2, (*mm_results).err
+	}
+	if mmCalendar.funcCalendar != nil {
+		return mmCalendar.funcCalendar(ctx, pvzID)
+	}
+	mmCalendar.t.Fatalf("Unexpected call to PVZPoliciesMock.Calendar. %v %v", ctx, pvzID)
+	return
+}
+
+// CalendarAfterCounter returns a count of finished PVZPoliciesMock.Calendar invocations
+func (mmCalendar *PVZPoliciesMock) CalendarAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCalendar.afterCalendarCounter)
+}
+
+// CalendarBeforeCounter returns a count of PVZPoliciesMock.Calendar invocations
+func (mmCalendar *PVZPoliciesMock) CalendarBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCalendar.beforeCalendarCounter)
+}
+
+// Calls returns a list of arguments used in each call to PVZPoliciesMock.Calendar.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCalendar *mPVZPoliciesMockCalendar) Calls() []*PVZPoliciesMockCalendarParams {
+	mmCalendar.mutex.RLock()
+
+	argCopy := make([]*PVZPoliciesMockCalendarParams, len(mmCalendar.callArgs))
+	copy(argCopy, mmCalendar.callArgs)
+
+	mmCalendar.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCalendarDone returns true if the count of the Calendar invocations corresponds
+// the number of defined expectations
+func (m *PVZPoliciesMock) MinimockCalendarDone() bool {
+	if m.CalendarMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CalendarMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CalendarMock.invocationsDone()
+}
+
+// MinimockCalendarInspect logs each unmet expectation
+func (m *PVZPoliciesMock) MinimockCalendarInspect() {
+	for _, e := range m.CalendarMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PVZPoliciesMock.Calendar at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCalendarCounter := mm_atomic.LoadUint64(&m.afterCalendarCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CalendarMock.defaultExpectation != nil && afterCalendarCounter < 1 {
+		if m.CalendarMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PVZPoliciesMock.Calendar at\n%s", m.CalendarMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PVZPoliciesMock.Calendar at\n%s with params: %#v", m.CalendarMock.defaultExpectation.expectationOrigins.origin, *m.CalendarMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCalendar != nil && afterCalendarCounter < 1 {
+		m.t.Errorf("Expected call to PVZPoliciesMock.Calendar at\n%s", m.funcCalendarOrigin)
+	}
+
+	if !m.CalendarMock.invocationsDone() && afterCalendarCounter > 0 {
+		m.t.Errorf("Expected %d calls to PVZPoliciesMock.Calendar at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CalendarMock.expectedInvocations), m.CalendarMock.expectedInvocationsOrigin, afterCalendarCounter)
+	}
+}
+
+type mPVZPoliciesMockPaidStorage struct {
+	optional           bool
+	mock               *PVZPoliciesMock
+	defaultExpectation *PVZPoliciesMockPaidStorageExpectation
+	expectations       []*PVZPoliciesMockPaidStorageExpectation
+
+	callArgs []*PVZPoliciesMockPaidStorageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PVZPoliciesMockPaidStorageExpectation specifies expectation struct of the PVZPolicies.PaidStorage
+type PVZPoliciesMockPaidStorageExpectation struct {
+	mock               *PVZPoliciesMock
+	params             *PVZPoliciesMockPaidStorageParams
+	paramPtrs          *PVZPoliciesMockPaidStorageParamPtrs
+	expectationOrigins PVZPoliciesMockPaidStorageExpectationOrigins
+	results            *PVZPoliciesMockPaidStorageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PVZPoliciesMockPaidStorageParams contains parameters of the PVZPolicies.PaidStorage
+type PVZPoliciesMockPaidStorageParams struct {
+	ctx       context.Context
+	pvzID     string
+	packaging domain.PackagingType
+}
+
+// PVZPoliciesMockPaidStorageParamPtrs contains pointers to parameters of the PVZPolicies.PaidStorage
+type PVZPoliciesMockPaidStorageParamPtrs struct {
+	ctx       *context.Context
+	pvzID     *string
+	packaging *domain.PackagingType
+}
+
+// PVZPoliciesMockPaidStorageResults contains results of the PVZPolicies.PaidStorage
+type PVZPoliciesMockPaidStorageResults struct {
+	p1  domain.PaidStorage
+	err error
+}
+
+// PVZPoliciesMockPaidStorageOrigins contains origins of expectations of the PVZPolicies.PaidStorage
+type PVZPoliciesMockPaidStorageExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originPvzID     string
+	originPackaging string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPaidStorage *mPVZPoliciesMockPaidStorage) Optional() *mPVZPoliciesMockPaidStorage {
+	mmPaidStorage.optional = true
+	return mmPaidStorage
+}
+
+// Expect sets up expected params for PVZPolicies.PaidStorage
+func (mmPaidStorage *mPVZPoliciesMockPaidStorage) Expect(ctx context.Context, pvzID string, packaging domain.PackagingType) *mPVZPoliciesMockPaidStorage {
+	if mmPaidStorage.mock.funcPaidStorage != nil {
+		mmPaidStorage.mock.t.Fatalf("PVZPoliciesMock.PaidStorage mock is already set by Set")
+	}
+
+	if mmPaidStorage.defaultExpectation == nil {
+		mmPaidStorage.defaultExpectation = &PVZPoliciesMockPaidStorageExpectation{}
+	}
+
+	if mmPaidStorage.defaultExpectation.paramPtrs != nil {
+		mmPaidStorage.mock.t.Fatalf("PVZPoliciesMock.PaidStorage mock is already set by ExpectParams functions")
+	}
+
+	mmPaidStorage.defaultExpectation.params = &PVZPoliciesMockPaidStorageParams{ctx, pvzID, packaging}
+	mmPaidStorage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPaidStorage.expectations {
+		if minimock.Equal(e.params, mmPaidStorage.defaultExpectation.params) {
+			mmPaidStorage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPaidStorage.defaultExpectation.params)
+		}
+	}
+
+	return mmPaidStorage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PVZPolicies.PaidStorage
+func (mmPaidStorage *mPVZPoliciesMockPaidStorage) ExpectCtxParam1(ctx context.Context) *mPVZPoliciesMockPaidStorage {
+	if mmPaidStorage.mock.funcPaidStorage != nil {
+		mmPaidStorage.mock.t.Fatalf("PVZPoliciesMock.PaidStorage mock is already set by Set")
+	}
+
+	if mmPaidStorage.defaultExpectation == nil {
+		mmPaidStorage.defaultExpectation = &PVZPoliciesMockPaidStorageExpectation{}
+	}
+
+	if mmPaidStorage.defaultExpectation.params != nil {
+		mmPaidStorage.mock.t.Fatalf("PVZPoliciesMock.PaidStorage mock is already set by Expect")
+	}
+
+	if mmPaidStorage.defaultExpectation.paramPtrs == nil {
+		mmPaidStorage.defaultExpectation.paramPtrs = &PVZPoliciesMockPaidStorageParamPtrs{}
+	}
+	mmPaidStorage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPaidStorage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPaidStorage
+}
+
+// ExpectPvzIDParam2 sets up expected param pvzID for PVZPolicies.PaidStorage
+func (mmPaidStorage *mPVZPoliciesMockPaidStorage) ExpectPvzIDParam2(pvzID string) *mPVZPoliciesMockPaidStorage {
+	if mmPaidStorage.mock.funcPaidStorage != nil {
+		mmPaidStorage.mock.t.Fatalf("PVZPoliciesMock.PaidStorage mock is already set by Set")
+	}
+
+	if mmPaidStorage.defaultExpectation == nil {
+		mmPaidStorage.defaultExpectation = &PVZPoliciesMockPaidStorageExpectation{}
+	}
+
+	if mmPaidStorage.defaultExpectation.params != nil {
+		mmPaidStorage.mock.t.Fatalf("PVZPoliciesMock.PaidStorage mock is already set by Expect")
+	}
+
+	if mmPaidStorage.defaultExpectation.paramPtrs == nil {
+		mmPaidStorage.defaultExpectation.paramPtrs = &PVZPoliciesMockPaidStorageParamPtrs{}
+	}
+	mmPaidStorage.defaultExpectation.paramPtrs.pvzID = &pvzID
+	mmPaidStorage.defaultExpectation.expectationOrigins.originPvzID = minimock.CallerInfo(1)
+
+	return mmPaidStorage
+}
+
+// ExpectPackagingParam3 sets up expected param packaging for PVZPolicies.PaidStorage
+func (mmPaidStorage *mPVZPoliciesMockPaidStorage) ExpectPackagingParam3(packaging domain.PackagingType) *mPVZPoliciesMockPaidStorage {
+	if mmPaidStorage.mock.funcPaidStorage != nil {
+		mmPaidStorage.mock.t.Fatalf("PVZPoliciesMock.PaidStorage mock is already set by Set")
+	}
+
+	if mmPaidStorage.defaultExpectation == nil {
+		mmPaidStorage.defaultExpectation = &PVZPoliciesMockPaidStorageExpectation{}
+	}
+
+	if mmPaidStorage.defaultExpectation.params != nil {
+		mmPaidStorage.mock.t.Fatalf("PVZPoliciesMock.PaidStorage mock is already set by Expect")
+	}
+
+	if mmPaidStorage.defaultExpectation.paramPtrs == nil {
+		mmPaidStorage.defaultExpectation.paramPtrs = &PVZPoliciesMockPaidStorageParamPtrs{}
+	}
+	mmPaidStorage.defaultExpectation.paramPtrs.packaging = &packaging
+	mmPaidStorage.defaultExpectation.expectationOrigins.originPackaging = minimock.CallerInfo(1)
+
+	return mmPaidStorage
+}
+
+// Inspect accepts an inspector function that has same arguments as the PVZPolicies.PaidStorage
+func (mmPaidStorage *mPVZPoliciesMockPaidStorage) Inspect(f func(ctx context.Context, pvzID string, packaging domain.PackagingType)) *mPVZPoliciesMockPaidStorage {
+	if mmPaidStorage.mock.inspectFuncPaidStorage != nil {
+		mmPaidStorage.mock.t.Fatalf("Inspect function is already set for PVZPoliciesMock.PaidStorage")
+	}
+
+	mmPaidStorage.mock.inspectFuncPaidStorage = f
+
+	return mmPaidStorage
+}
+
+// Return sets up results that will be returned by PVZPolicies.PaidStorage
+func (mmPaidStorage *mPVZPoliciesMockPaidStorage) Return(p1 domain.PaidStorage, err error) *PVZPoliciesMock {
+	if mmPaidStorage.mock.funcPaidStorage != nil {
+		mmPaidStorage.mock.t.Fatalf("PVZPoliciesMock.PaidStorage mock is already set by Set")
+	}
+
+	if mmPaidStorage.defaultExpectation == nil {
+		mmPaidStorage.defaultExpectation = &PVZPoliciesMockPaidStorageExpectation{mock: mmPaidStorage.mock}
+	}
+	mmPaidStorage.defaultExpectation.results = &PVZPoliciesMockPaidStorageResults{p1, err}
+	mmPaidStorage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPaidStorage.mock
+}
+
+// Set uses given function f to mock the PVZPolicies.PaidStorage method
+func (mmPaidStorage *mPVZPoliciesMockPaidStorage) Set(f func(ctx context.Context, pvzID string, packaging domain.PackagingType) (p1 domain.PaidStorage, err error)) *PVZPoliciesMock {
+	if mmPaidStorage.defaultExpectation != nil {
+		mmPaidStorage.mock.t.Fatalf("Default expectation is already set for the PVZPolicies.PaidStorage method")
+	}
+
+	if len(mmPaidStorage.expectations) > 0 {
+		mmPaidStorage.mock.t.Fatalf("Some expectations are already set for the PVZPolicies.PaidStorage method")
+	}
+
+	mmPaidStorage.mock.funcPaidStorage = f
+	mmPaidStorage.mock.funcPaidStorageOrigin = minimock.CallerInfo(1)
+	return mmPaidStorage.mock
+}
+
+// When sets expectation for the PVZPolicies.PaidStorage which will trigger the result defined by the following
+// Then helper
+func (mmPaidStorage *mPVZPoliciesMockPaidStorage) When(ctx context.Context, pvzID string, packaging domain.PackagingType) *PVZPoliciesMockPaidStorageExpectation {
+	if mmPaidStorage.mock.funcPaidStorage != nil {
+		mmPaidStorage.mock.t.Fatalf("PVZPoliciesMock.PaidStorage mock is already set by Set")
+	}
+
+	expectation := &PVZPoliciesMockPaidStorageExpectation{
+		mock:               mmPaidStorage.mock,
+		params:             &PVZPoliciesMockPaidStorageParams{ctx, pvzID, packaging},
+		expectationOrigins: PVZPoliciesMockPaidStorageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPaidStorage.expectations = append(mmPaidStorage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PVZPolicies.PaidStorage return parameters for the expectation previously defined by the When method
+func (e *PVZPoliciesMockPaidStorageExpectation) Then(p1 domain.PaidStorage, err error) *PVZPoliciesMock {
+	e.results = &PVZPoliciesMockPaidStorageResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times PVZPolicies.PaidStorage should be invoked
+func (mmPaidStorage *mPVZPoliciesMockPaidStorage) Times(n uint64) *mPVZPoliciesMockPaidStorage {
+	if n == 0 {
+		mmPaidStorage.mock.t.Fatalf("Times of PVZPoliciesMock.PaidStorage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPaidStorage.expectedInvocations, n)
+	mmPaidStorage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPaidStorage
+}
+
+func (mmPaidStorage *mPVZPoliciesMockPaidStorage) invocationsDone() bool {
+	if len(mmPaidStorage.expectations) == 0 && mmPaidStorage.defaultExpectation == nil && mmPaidStorage.mock.funcPaidStorage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPaidStorage.mock.afterPaidStorageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPaidStorage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// PaidStorage implements mm_usecases.PVZPolicies
+func (mmPaidStorage *PVZPoliciesMock) PaidStorage(ctx context.Context, pvzID string, packaging domain.PackagingType) (p1 domain.PaidStorage, err error) {
+	mm_atomic.AddUint64(&mmPaidStorage.beforePaidStorageCounter, 1)
+	defer mm_atomic.AddUint64(&mmPaidStorage.afterPaidStorageCounter, 1)
+
+	mmPaidStorage.t.Helper()
+
+	if mmPaidStorage.inspectFuncPaidStorage != nil {
+		mmPaidStorage.inspectFuncPaidStorage(ctx, pvzID, packaging)
+	}
+
+	mm_params := PVZPoliciesMockPaidStorageParams{ctx, pvzID, packaging}
+
+	// Record call args
+	mmPaidStorage.PaidStorageMock.mutex.Lock()
+	mmPaidStorage.PaidStorageMock.callArgs = append(mmPaidStorage.PaidStorageMock.callArgs, &mm_params)
+	mmPaidStorage.PaidStorageMock.mutex.Unlock()
+
+	for _, e := range mmPaidStorage.PaidStorageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmPaidStorage.PaidStorageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPaidStorage.PaidStorageMock.defaultExpectation.Counter, 1)
+		mm_want := mmPaidStorage.PaidStorageMock.defaultExpectation.params
+		mm_want_ptrs := mmPaidStorage.PaidStorageMock.defaultExpectation.paramPtrs
+
+		mm_got := PVZPoliciesMockPaidStorageParams{ctx, pvzID, packaging}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPaidStorage.t.Errorf("PVZPoliciesMock.PaidStorage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPaidStorage.PaidStorageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pvzID != nil && !minimock.Equal(*mm_want_ptrs.pvzID, mm_got.pvzID) {
+				mmPaidStorage.t.Errorf("PVZPoliciesMock.PaidStorage got unexpected parameter pvzID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPaidStorage.PaidStorageMock.defaultExpectation.expectationOrigins.originPvzID, *mm_want_ptrs.pvzID, mm_got.pvzID, minimock.Diff(*mm_want_ptrs.pvzID, mm_got.pvzID))
+			}
+
+			if mm_want_ptrs.packaging != nil && !minimock.Equal(*mm_want_ptrs.packaging, mm_got.packaging) {
+				mmPaidStorage.t.Errorf("PVZPoliciesMock.PaidStorage got unexpected parameter packaging, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPaidStorage.PaidStorageMock.defaultExpectation.expectationOrigins.originPackaging, *mm_want_ptrs.packaging, mm_got.packaging, minimock.Diff(*mm_want_ptrs.packaging, mm_got.packaging))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPaidStorage.t.Errorf("PVZPoliciesMock.PaidStorage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPaidStorage.PaidStorageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPaidStorage.PaidStorageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPaidStorage.t.Fatal("No results are set for the PVZPoliciesMock.PaidStorage")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmPaidStorage.funcPaidStorage != nil {
+		return mmPaidStorage.funcPaidStorage(ctx, pvzID, packaging)
+	}
+	mmPaidStorage.t.Fatalf("Unexpected call to PVZPoliciesMock.PaidStorage. %v %v %v", ctx, pvzID, packaging)
+	return
+}
+
+// PaidStorageAfterCounter returns a count of finished PVZPoliciesMock.PaidStorage invocations
+func (mmPaidStorage *PVZPoliciesMock) PaidStorageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPaidStorage.afterPaidStorageCounter)
+}
+
+// PaidStorageBeforeCounter returns a count of PVZPoliciesMock.PaidStorage invocations
+func (mmPaidStorage *PVZPoliciesMock) PaidStorageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPaidStorage.beforePaidStorageCounter)
+}
+
+// Calls returns a list of arguments used in each call to PVZPoliciesMock.PaidStorage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPaidStorage *mPVZPoliciesMockPaidStorage) Calls() []*PVZPoliciesMockPaidStorageParams {
+	mmPaidStorage.mutex.RLock()
+
+	argCopy := make([]*PVZPoliciesMockPaidStorageParams, len(mmPaidStorage.callArgs))
+	copy(argCopy, mmPaidStorage.callArgs)
+
+	mmPaidStorage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPaidStorageDone returns true if the count of the PaidStorage invocations corresponds
+// the number of defined expectations
+func (m *PVZPoliciesMock) MinimockPaidStorageDone() bool {
+	if m.PaidStorageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PaidStorageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PaidStorageMock.invocationsDone()
+}
+
+// MinimockPaidStorageInspect logs each unmet expectation
+func (m *PVZPoliciesMock) MinimockPaidStorageInspect() {
+	for _, e := range m.PaidStorageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PVZPoliciesMock.PaidStorage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPaidStorageCounter := mm_atomic.LoadUint64(&m.afterPaidStorageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PaidStorageMock.defaultExpectation != nil && afterPaidStorageCounter < 1 {
+		if m.PaidStorageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PVZPoliciesMock.PaidStorage at\n%s", m.PaidStorageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PVZPoliciesMock.PaidStorage at\n%s with params: %#v", m.PaidStorageMock.defaultExpectation.expectationOrigins.origin, *m.PaidStorageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPaidStorage != nil && afterPaidStorageCounter < 1 {
+		m.t.Errorf("Expected call to PVZPoliciesMock.PaidStorage at\n%s", m.funcPaidStorageOrigin)
+	}
+
+	if !m.PaidStorageMock.invocationsDone() && afterPaidStorageCounter > 0 {
+		m.t.Errorf("Expected %d calls to PVZPoliciesMock.PaidStorage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PaidStorageMock.expectedInvocations), m.PaidStorageMock.expectedInvocationsOrigin, afterPaidStorageCounter)
+	}
+}
+
+type mPVZPoliciesMockPolicy struct {
+	optional           bool
+	mock               *PVZPoliciesMock
+	defaultExpectation *PVZPoliciesMockPolicyExpectation
+	expectations       []*PVZPoliciesMockPolicyExpectation
+
+	callArgs []*PVZPoliciesMockPolicyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PVZPoliciesMockPolicyExpectation specifies expectation struct of the PVZPolicies.Policy
+type PVZPoliciesMockPolicyExpectation struct {
+	mock               *PVZPoliciesMock
+	params             *PVZPoliciesMockPolicyParams
+	paramPtrs          *PVZPoliciesMockPolicyParamPtrs
+	expectationOrigins PVZPoliciesMockPolicyExpectationOrigins
+	results            *PVZPoliciesMockPolicyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PVZPoliciesMockPolicyParams contains parameters of the PVZPolicies.Policy
+type PVZPoliciesMockPolicyParams struct {
+	ctx   context.Context
+	pvzID string
+}
+
+// PVZPoliciesMockPolicyParamPtrs contains pointers to parameters of the PVZPolicies.Policy
+type PVZPoliciesMockPolicyParamPtrs struct {
+	ctx   *context.Context
+	pvzID *string
+}
+
+// PVZPoliciesMockPolicyResults contains results of the PVZPolicies.Policy
+type PVZPoliciesMockPolicyResults struct {
+	p1  domain.PVZPolicy
+	err error
+}
+
+// PVZPoliciesMockPolicyOrigins contains origins of expectations of the PVZPolicies.Policy
+type PVZPoliciesMockPolicyExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originPvzID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmPolicy *mPVZPoliciesMockPolicy) Optional() *mPVZPoliciesMockPolicy {
+	mmPolicy.optional = true
+	return mmPolicy
+}
+
+// Expect sets up expected params for PVZPolicies.Policy
+func (mmPolicy *mPVZPoliciesMockPolicy) Expect(ctx context.Context, pvzID string) *mPVZPoliciesMockPolicy {
+	if mmPolicy.mock.funcPolicy != nil {
+		mmPolicy.mock.t.Fatalf("PVZPoliciesMock.Policy mock is already set by Set")
+	}
+
+	if mmPolicy.defaultExpectation == nil {
+		mmPolicy.defaultExpectation = &PVZPoliciesMockPolicyExpectation{}
+	}
+
+	if mmPolicy.defaultExpectation.paramPtrs != nil {
+		mmPolicy.mock.t.Fatalf("PVZPoliciesMock.Policy mock is already set by ExpectParams functions")
+	}
+
+	mmPolicy.defaultExpectation.params = &PVZPoliciesMockPolicyParams{ctx, pvzID}
+	mmPolicy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmPolicy.expectations {
+		if minimock.Equal(e.params, mmPolicy.defaultExpectation.params) {
+			mmPolicy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmPolicy.defaultExpectation.params)
+		}
+	}
+
+	return mmPolicy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PVZPolicies.Policy
+func (mmPolicy *mPVZPoliciesMockPolicy) ExpectCtxParam1(ctx context.Context) *mPVZPoliciesMockPolicy {
+	if mmPolicy.mock.funcPolicy != nil {
+		mmPolicy.mock.t.Fatalf("PVZPoliciesMock.Policy mock is already set by Set")
+	}
+
+	if mmPolicy.defaultExpectation == nil {
+		mmPolicy.defaultExpectation = &PVZPoliciesMockPolicyExpectation{}
+	}
+
+	if mmPolicy.defaultExpectation.params != nil {
+		mmPolicy.mock.t.Fatalf("PVZPoliciesMock.Policy mock is already set by Expect")
+	}
+
+	if mmPolicy.defaultExpectation.paramPtrs == nil {
+		mmPolicy.defaultExpectation.paramPtrs = &PVZPoliciesMockPolicyParamPtrs{}
+	}
+	mmPolicy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmPolicy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmPolicy
+}
+
+// ExpectPvzIDParam2 sets up expected param pvzID for PVZPolicies.Policy
+func (mmPolicy *mPVZPoliciesMockPolicy) ExpectPvzIDParam2(pvzID string) *mPVZPoliciesMockPolicy {
+	if mmPolicy.mock.funcPolicy != nil {
+		mmPolicy.mock.t.Fatalf("PVZPoliciesMock.Policy mock is already set by Set")
+	}
+
+	if mmPolicy.defaultExpectation == nil {
+		mmPolicy.defaultExpectation = &PVZPoliciesMockPolicyExpectation{}
+	}
+
+	if mmPolicy.defaultExpectation.params != nil {
+		mmPolicy.mock.t.Fatalf("PVZPoliciesMock.Policy mock is already set by Expect")
+	}
+
+	if mmPolicy.defaultExpectation.paramPtrs == nil {
+		mmPolicy.defaultExpectation.paramPtrs = &PVZPoliciesMockPolicyParamPtrs{}
+	}
+	mmPolicy.defaultExpectation.paramPtrs.pvzID = &pvzID
+	mmPolicy.defaultExpectation.expectationOrigins.originPvzID = minimock.CallerInfo(1)
+
+	return mmPolicy
+}
+
+// Inspect accepts an inspector function that has same arguments as the PVZPolicies.Policy
+func (mmPolicy *mPVZPoliciesMockPolicy) Inspect(f func(ctx context.Context, pvzID string)) *mPVZPoliciesMockPolicy {
+	if mmPolicy.mock.inspectFuncPolicy != nil {
+		mmPolicy.mock.t.Fatalf("Inspect function is already set for PVZPoliciesMock.Policy")
+	}
+
+	mmPolicy.mock.inspectFuncPolicy = f
+
+	return mmPolicy
+}
+
+// Return sets up results that will be returned by PVZPolicies.Policy
+func (mmPolicy *mPVZPoliciesMockPolicy) Return(p1 domain.PVZPolicy, err error) *PVZPoliciesMock {
+	if mmPolicy.mock.funcPolicy != nil {
+		mmPolicy.mock.t.Fatalf("PVZPoliciesMock.Policy mock is already set by Set")
+	}
+
+	if mmPolicy.defaultExpectation == nil {
+		mmPolicy.defaultExpectation = &PVZPoliciesMockPolicyExpectation{mock: mmPolicy.mock}
+	}
+	mmPolicy.defaultExpectation.results = &PVZPoliciesMockPolicyResults{p1, err}
+	mmPolicy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmPolicy.mock
+}
+
+// Set uses given function f to mock the PVZPolicies.Policy method
+func (mmPolicy *mPVZPoliciesMockPolicy) Set(f func(ctx context.Context, pvzID string) (p1 domain.PVZPolicy, err error)) *PVZPoliciesMock {
+	if mmPolicy.defaultExpectation != nil {
+		mmPolicy.mock.t.Fatalf("Default expectation is already set for the PVZPolicies.Policy method")
+	}
+
+	if len(mmPolicy.expectations) > 0 {
+		mmPolicy.mock.t.Fatalf("Some expectations are already set for the PVZPolicies.Policy method")
+	}
+
+	mmPolicy.mock.funcPolicy = f
+	mmPolicy.mock.funcPolicyOrigin = minimock.CallerInfo(1)
+	return mmPolicy.mock
+}
+
+// When sets expectation for the PVZPolicies.Policy which will trigger the result defined by the following
+// Then helper
+func (mmPolicy *mPVZPoliciesMockPolicy) When(ctx context.Context, pvzID string) *PVZPoliciesMockPolicyExpectation {
+	if mmPolicy.mock.funcPolicy != nil {
+		mmPolicy.mock.t.Fatalf("PVZPoliciesMock.Policy mock is already set by Set")
+	}
+
+	expectation := &PVZPoliciesMockPolicyExpectation{
+		mock:               mmPolicy.mock,
+		params:             &PVZPoliciesMockPolicyParams{ctx, pvzID},
+		expectationOrigins: PVZPoliciesMockPolicyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmPolicy.expectations = append(mmPolicy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PVZPolicies.Policy return parameters for the expectation previously defined by the When method
+func (e *PVZPoliciesMockPolicyExpectation) Then(p1 domain.PVZPolicy, err error) *PVZPoliciesMock {
+	e.results = &PVZPoliciesMockPolicyResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times PVZPolicies.Policy should be invoked
+func (mmPolicy *mPVZPoliciesMockPolicy) Times(n uint64) *mPVZPoliciesMockPolicy {
+	if n == 0 {
+		mmPolicy.mock.t.Fatalf("Times of PVZPoliciesMock.Policy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmPolicy.expectedInvocations, n)
+	mmPolicy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmPolicy
+}
+
+func (mmPolicy *mPVZPoliciesMockPolicy) invocationsDone() bool {
+	if len(mmPolicy.expectations) == 0 && mmPolicy.defaultExpectation == nil && mmPolicy.mock.funcPolicy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmPolicy.mock.afterPolicyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmPolicy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Policy implements mm_usecases.PVZPolicies
+func (mmPolicy *PVZPoliciesMock) Policy(ctx context.Context, pvzID string) (p1 domain.PVZPolicy, err error) {
+	mm_atomic.AddUint64(&mmPolicy.beforePolicyCounter, 1)
+	defer mm_atomic.AddUint64(&mmPolicy.afterPolicyCounter, 1)
+
+	mmPolicy.t.Helper()
+
+	if mmPolicy.inspectFuncPolicy != nil {
+		mmPolicy.inspectFuncPolicy(ctx, pvzID)
+	}
+
+	mm_params := PVZPoliciesMockPolicyParams{ctx, pvzID}
+
+	// Record call args
+	mmPolicy.PolicyMock.mutex.Lock()
+	mmPolicy.PolicyMock.callArgs = append(mmPolicy.PolicyMock.callArgs, &mm_params)
+	mmPolicy.PolicyMock.mutex.Unlock()
+
+	for _, e := range mmPolicy.PolicyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmPolicy.PolicyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmPolicy.PolicyMock.defaultExpectation.Counter, 1)
+		mm_want := mmPolicy.PolicyMock.defaultExpectation.params
+		mm_want_ptrs := mmPolicy.PolicyMock.defaultExpectation.paramPtrs
+
+		mm_got := PVZPoliciesMockPolicyParams{ctx, pvzID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmPolicy.t.Errorf("PVZPoliciesMock.Policy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPolicy.PolicyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pvzID != nil && !minimock.Equal(*mm_want_ptrs.pvzID, mm_got.pvzID) {
+				mmPolicy.t.Errorf("PVZPoliciesMock.Policy got unexpected parameter pvzID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmPolicy.PolicyMock.defaultExpectation.expectationOrigins.originPvzID, *mm_want_ptrs.pvzID, mm_got.pvzID, minimock.Diff(*mm_want_ptrs.pvzID, mm_got.pvzID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmPolicy.t.Errorf("PVZPoliciesMock.Policy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmPolicy.PolicyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmPolicy.PolicyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmPolicy.t.Fatal("No results are set for the PVZPoliciesMock.Policy")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmPolicy.funcPolicy != nil {
+		return mmPolicy.funcPolicy(ctx, pvzID)
+	}
+	mmPolicy.t.Fatalf("Unexpected call to PVZPoliciesMock.Policy. %v %v", ctx, pvzID)
+	return
+}
+
+// PolicyAfterCounter returns a count of finished PVZPoliciesMock.Policy invocations
+func (mmPolicy *PVZPoliciesMock) PolicyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPolicy.afterPolicyCounter)
+}
+
+// PolicyBeforeCounter returns a count of PVZPoliciesMock.Policy invocations
+func (mmPolicy *PVZPoliciesMock) PolicyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmPolicy.beforePolicyCounter)
+}
+
+// Calls returns a list of arguments used in each call to PVZPoliciesMock.Policy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmPolicy *mPVZPoliciesMockPolicy) Calls() []*PVZPoliciesMockPolicyParams {
+	mmPolicy.mutex.RLock()
+
+	argCopy := make([]*PVZPoliciesMockPolicyParams, len(mmPolicy.callArgs))
+	copy(argCopy, mmPolicy.callArgs)
+
+	mmPolicy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockPolicyDone returns true if the count of the Policy invocations corresponds
+// the number of defined expectations
+func (m *PVZPoliciesMock) MinimockPolicyDone() bool {
+	if m.PolicyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.PolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.PolicyMock.invocationsDone()
+}
+
+// MinimockPolicyInspect logs each unmet expectation
+func (m *PVZPoliciesMock) MinimockPolicyInspect() {
+	for _, e := range m.PolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PVZPoliciesMock.Policy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterPolicyCounter := mm_atomic.LoadUint64(&m.afterPolicyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.PolicyMock.defaultExpectation != nil && afterPolicyCounter < 1 {
+		if m.PolicyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PVZPoliciesMock.Policy at\n%s", m.PolicyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PVZPoliciesMock.Policy at\n%s with params: %#v", m.PolicyMock.defaultExpectation.expectationOrigins.origin, *m.PolicyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcPolicy != nil && afterPolicyCounter < 1 {
+		m.t.Errorf("Expected call to PVZPoliciesMock.Policy at\n%s", m.funcPolicyOrigin)
+	}
+
+	if !m.PolicyMock.invocationsDone() && afterPolicyCounter > 0 {
+		m.t.Errorf("Expected %d calls to PVZPoliciesMock.Policy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.PolicyMock.expectedInvocations), m.PolicyMock.expectedInvocationsOrigin, afterPolicyCounter)
+	}
+}
+
+type mPVZPoliciesMockWeightTolerancePercent struct {
+	optional           bool
+	mock               *PVZPoliciesMock
+	defaultExpectation *PVZPoliciesMockWeightTolerancePercentExpectation
+	expectations       []*PVZPoliciesMockWeightTolerancePercentExpectation
+
+	callArgs []*PVZPoliciesMockWeightTolerancePercentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PVZPoliciesMockWeightTolerancePercentExpectation specifies expectation struct of the PVZPolicies.WeightTolerancePercent
+type PVZPoliciesMockWeightTolerancePercentExpectation struct {
+	mock               *PVZPoliciesMock
+	params             *PVZPoliciesMockWeightTolerancePercentParams
+	paramPtrs          *PVZPoliciesMockWeightTolerancePercentParamPtrs
+	expectationOrigins PVZPoliciesMockWeightTolerancePercentExpectationOrigins
+	results            *PVZPoliciesMockWeightTolerancePercentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PVZPoliciesMockWeightTolerancePercentParams contains parameters of the PVZPolicies.WeightTolerancePercent
+type PVZPoliciesMockWeightTolerancePercentParams struct {
+	ctx   context.Context
+	pvzID string
+}
+
+// PVZPoliciesMockWeightTolerancePercentParamPtrs contains pointers to parameters of the PVZPolicies.WeightTolerancePercent
+type PVZPoliciesMockWeightTolerancePercentParamPtrs struct {
+	ctx   *context.Context
+	pvzID *string
+}
+
+// PVZPoliciesMockWeightTolerancePercentResults contains results of the PVZPolicies.WeightTolerancePercent
+type PVZPoliciesMockWeightTolerancePercentResults struct {
+	i1  int
+	err error
+}
+
+// PVZPoliciesMockWeightTolerancePercentOrigins contains origins of expectations of the PVZPolicies.WeightTolerancePercent
+type PVZPoliciesMockWeightTolerancePercentExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originPvzID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmWeightTolerancePercent *mPVZPoliciesMockWeightTolerancePercent) Optional() *mPVZPoliciesMockWeightTolerancePercent {
+	mmWeightTolerancePercent.optional = true
+	return mmWeightTolerancePercent
+}
+
+// Expect sets up expected params for PVZPolicies.WeightTolerancePercent
+func (mmWeightTolerancePercent *mPVZPoliciesMockWeightTolerancePercent) Expect(ctx context.Context, pvzID string) *mPVZPoliciesMockWeightTolerancePercent {
+	if mmWeightTolerancePercent.mock.funcWeightTolerancePercent != nil {
+		mmWeightTolerancePercent.mock.t.Fatalf("PVZPoliciesMock.WeightTolerancePercent mock is already set by Set")
+	}
+
+	if mmWeightTolerancePercent.defaultExpectation == nil {
+		mmWeightTolerancePercent.defaultExpectation = &PVZPoliciesMockWeightTolerancePercentExpectation{}
+	}
+
+	if mmWeightTolerancePercent.defaultExpectation.paramPtrs != nil {
+		mmWeightTolerancePercent.mock.t.Fatalf("PVZPoliciesMock.WeightTolerancePercent mock is already set by ExpectParams functions")
+	}
+
+	mmWeightTolerancePercent.defaultExpectation.params = &PVZPoliciesMockWeightTolerancePercentParams{ctx, pvzID}
+	mmWeightTolerancePercent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmWeightTolerancePercent.expectations {
+		if minimock.Equal(e.params, mmWeightTolerancePercent.defaultExpectation.params) {
+			mmWeightTolerancePercent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWeightTolerancePercent.defaultExpectation.params)
+		}
+	}
+
+	return mmWeightTolerancePercent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PVZPolicies.WeightTolerancePercent
+func (mmWeightTolerancePercent *mPVZPoliciesMockWeightTolerancePercent) ExpectCtxParam1(ctx context.Context) *mPVZPoliciesMockWeightTolerancePercent {
+	if mmWeightTolerancePercent.mock.funcWeightTolerancePercent != nil {
+		mmWeightTolerancePercent.mock.t.Fatalf("PVZPoliciesMock.WeightTolerancePercent mock is already set by Set")
+	}
+
+	if mmWeightTolerancePercent.defaultExpectation == nil {
+		mmWeightTolerancePercent.defaultExpectation = &PVZPoliciesMockWeightTolerancePercentExpectation{}
+	}
+
+	if mmWeightTolerancePercent.defaultExpectation.params != nil {
+		mmWeightTolerancePercent.mock.t.Fatalf("PVZPoliciesMock.WeightTolerancePercent mock is already set by Expect")
+	}
+
+	if mmWeightTolerancePercent.defaultExpectation.paramPtrs == nil {
+		mmWeightTolerancePercent.defaultExpectation.paramPtrs = &PVZPoliciesMockWeightTolerancePercentParamPtrs{}
+	}
+	mmWeightTolerancePercent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmWeightTolerancePercent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmWeightTolerancePercent
+}
+
+// ExpectPvzIDParam2 sets up expected param pvzID for PVZPolicies.WeightTolerancePercent
+func (mmWeightTolerancePercent *mPVZPoliciesMockWeightTolerancePercent) ExpectPvzIDParam2(pvzID string) *mPVZPoliciesMockWeightTolerancePercent {
+	if mmWeightTolerancePercent.mock.funcWeightTolerancePercent != nil {
+		mmWeightTolerancePercent.mock.t.Fatalf("PVZPoliciesMock.WeightTolerancePercent mock is already set by Set")
+	}
+
+	if mmWeightTolerancePercent.defaultExpectation == nil {
+		mmWeightTolerancePercent.defaultExpectation = &PVZPoliciesMockWeightTolerancePercentExpectation{}
+	}
+
+	if mmWeightTolerancePercent.defaultExpectation.params != nil {
+		mmWeightTolerancePercent.mock.t.Fatalf("PVZPoliciesMock.WeightTolerancePercent mock is already set by Expect")
+	}
+
+	if mmWeightTolerancePercent.defaultExpectation.paramPtrs == nil {
+		mmWeightTolerancePercent.defaultExpectation.paramPtrs = &PVZPoliciesMockWeightTolerancePercentParamPtrs{}
+	}
+	mmWeightTolerancePercent.defaultExpectation.paramPtrs.pvzID = &pvzID
+	mmWeightTolerancePercent.defaultExpectation.expectationOrigins.originPvzID = minimock.CallerInfo(1)
+
+	return mmWeightTolerancePercent
+}
+
+// Inspect accepts an inspector function that has same arguments as the PVZPolicies.WeightTolerancePercent
+func (mmWeightTolerancePercent *mPVZPoliciesMockWeightTolerancePercent) Inspect(f func(ctx context.Context, pvzID string)) *mPVZPoliciesMockWeightTolerancePercent {
+	if mmWeightTolerancePercent.mock.inspectFuncWeightTolerancePercent != nil {
+		mmWeightTolerancePercent.mock.t.Fatalf("Inspect function is already set for PVZPoliciesMock.WeightTolerancePercent")
+	}
+
+	mmWeightTolerancePercent.mock.inspectFuncWeightTolerancePercent = f
+
+	return mmWeightTolerancePercent
+}
+
+// Return sets up results that will be returned by PVZPolicies.WeightTolerancePercent
+func (mmWeightTolerancePercent *mPVZPoliciesMockWeightTolerancePercent) Return(i1 int, err error) *PVZPoliciesMock {
+	if mmWeightTolerancePercent.mock.funcWeightTolerancePercent != nil {
+		mmWeightTolerancePercent.mock.t.Fatalf("PVZPoliciesMock.WeightTolerancePercent mock is already set by Set")
+	}
+
+	if mmWeightTolerancePercent.defaultExpectation == nil {
+		mmWeightTolerancePercent.defaultExpectation = &PVZPoliciesMockWeightTolerancePercentExpectation{mock: mmWeightTolerancePercent.mock}
+	}
+	mmWeightTolerancePercent.defaultExpectation.results = &PVZPoliciesMockWeightTolerancePercentResults{i1, err}
+	mmWeightTolerancePercent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmWeightTolerancePercent.mock
+}
+
+// Set uses given function f to mock the PVZPolicies.WeightTolerancePercent method
+func (mmWeightTolerancePercent *mPVZPoliciesMockWeightTolerancePercent) Set(f func(ctx context.Context, pvzID string) (i1 int, err error)) *PVZPoliciesMock {
+	if mmWeightTolerancePercent.defaultExpectation != nil {
+		mmWeightTolerancePercent.mock.t.Fatalf("Default expectation is already set for the PVZPolicies.WeightTolerancePercent method")
+	}
+
+	if len(mmWeightTolerancePercent.expectations) > 0 {
+		mmWeightTolerancePercent.mock.t.Fatalf("Some expectations are already set for the PVZPolicies.WeightTolerancePercent method")
+	}
+
+	mmWeightTolerancePercent.mock.funcWeightTolerancePercent = f
+	mmWeightTolerancePercent.mock.funcWeightTolerancePercentOrigin = minimock.CallerInfo(1)
+	return mmWeightTolerancePercent.mock
+}
+
+// When sets expectation for the PVZPolicies.WeightTolerancePercent which will trigger the result defined by the following
+// Then helper
+func (mmWeightTolerancePercent *mPVZPoliciesMockWeightTolerancePercent) When(ctx context.Context, pvzID string) *PVZPoliciesMockWeightTolerancePercentExpectation {
+	if mmWeightTolerancePercent.mock.funcWeightTolerancePercent != nil {
+		mmWeightTolerancePercent.mock.t.Fatalf("PVZPoliciesMock.WeightTolerancePercent mock is already set by Set")
+	}
+
+	expectation := &PVZPoliciesMockWeightTolerancePercentExpectation{
+		mock:               mmWeightTolerancePercent.mock,
+		params:             &PVZPoliciesMockWeightTolerancePercentParams{ctx, pvzID},
+		expectationOrigins: PVZPoliciesMockWeightTolerancePercentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmWeightTolerancePercent.expectations = append(mmWeightTolerancePercent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PVZPolicies.WeightTolerancePercent return parameters for the expectation previously defined by the When method
+func (e *PVZPoliciesMockWeightTolerancePercentExpectation) Then(i1 int, err error) *PVZPoliciesMock {
+	e.results = &PVZPoliciesMockWeightTolerancePercentResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times PVZPolicies.WeightTolerancePercent should be invoked
+func (mmWeightTolerancePercent *mPVZPoliciesMockWeightTolerancePercent) Times(n uint64) *mPVZPoliciesMockWeightTolerancePercent {
+	if n == 0 {
+		mmWeightTolerancePercent.mock.t.Fatalf("Times of PVZPoliciesMock.WeightTolerancePercent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmWeightTolerancePercent.expectedInvocations, n)
+	mmWeightTolerancePercent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmWeightTolerancePercent
+}
+
+func (mmWeightTolerancePercent *mPVZPoliciesMockWeightTolerancePercent) invocationsDone() bool {
+	if len(mmWeightTolerancePercent.expectations) == 0 && mmWeightTolerancePercent.defaultExpectation == nil && mmWeightTolerancePercent.mock.funcWeightTolerancePercent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmWeightTolerancePercent.mock.afterWeightTolerancePercentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmWeightTolerancePercent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// WeightTolerancePercent implements mm_usecases.PVZPolicies
+func (mmWeightTolerancePercent *PVZPoliciesMock) WeightTolerancePercent(ctx context.Context, pvzID string) (i1 int, err error) {
+	mm_atomic.AddUint64(&mmWeightTolerancePercent.beforeWeightTolerancePercentCounter, 1)
+	defer mm_atomic.AddUint64(&mmWeightTolerancePercent.afterWeightTolerancePercentCounter, 1)
+
+	mmWeightTolerancePercent.t.Helper()
+
+	if mmWeightTolerancePercent.inspectFuncWeightTolerancePercent != nil {
+		mmWeightTolerancePercent.inspectFuncWeightTolerancePercent(ctx, pvzID)
+	}
+
+	mm_params := PVZPoliciesMockWeightTolerancePercentParams{ctx, pvzID}
+
+	// Record call args
+	mmWeightTolerancePercent.WeightTolerancePercentMock.mutex.Lock()
+	mmWeightTolerancePercent.WeightTolerancePercentMock.callArgs = append(mmWeightTolerancePercent.WeightTolerancePercentMock.callArgs, &mm_params)
+	mmWeightTolerancePercent.WeightTolerancePercentMock.mutex.Unlock()
+
+	for _, e := range mmWeightTolerancePercent.WeightTolerancePercentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmWeightTolerancePercent.WeightTolerancePercentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWeightTolerancePercent.WeightTolerancePercentMock.defaultExpectation.Counter, 1)
+		mm_want := mmWeightTolerancePercent.WeightTolerancePercentMock.defaultExpectation.params
+		mm_want_ptrs := mmWeightTolerancePercent.WeightTolerancePercentMock.defaultExpectation.paramPtrs
+
+		mm_got := PVZPoliciesMockWeightTolerancePercentParams{ctx, pvzID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmWeightTolerancePercent.t.Errorf("PVZPoliciesMock.WeightTolerancePercent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWeightTolerancePercent.WeightTolerancePercentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pvzID != nil && !minimock.Equal(*mm_want_ptrs.pvzID, mm_got.pvzID) {
+				mmWeightTolerancePercent.t.Errorf("PVZPoliciesMock.WeightTolerancePercent got unexpected parameter pvzID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWeightTolerancePercent.WeightTolerancePercentMock.defaultExpectation.expectationOrigins.originPvzID, *mm_want_ptrs.pvzID, mm_got.pvzID, minimock.Diff(*mm_want_ptrs.pvzID, mm_got.pvzID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmWeightTolerancePercent.t.Errorf("PVZPoliciesMock.WeightTolerancePercent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmWeightTolerancePercent.WeightTolerancePercentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmWeightTolerancePercent.WeightTolerancePercentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmWeightTolerancePercent.t.Fatal("No results are set for the PVZPoliciesMock.WeightTolerancePercent")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmWeightTolerancePercent.funcWeightTolerancePercent != nil {
+		return mmWeightTolerancePercent.funcWeightTolerancePercent(ctx, pvzID)
+	}
+	mmWeightTolerancePercent.t.Fatalf("Unexpected call to PVZPoliciesMock.WeightTolerancePercent. %v %v", ctx, pvzID)
+	return
+}
+
+// WeightTolerancePercentAfterCounter returns a count of finished PVZPoliciesMock.WeightTolerancePercent invocations
+func (mmWeightTolerancePercent *PVZPoliciesMock) WeightTolerancePercentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWeightTolerancePercent.afterWeightTolerancePercentCounter)
+}
+
+// WeightTolerancePercentBeforeCounter returns a count of PVZPoliciesMock.WeightTolerancePercent invocations
+func (mmWeightTolerancePercent *PVZPoliciesMock) WeightTolerancePercentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWeightTolerancePercent.beforeWeightTolerancePercentCounter)
+}
+
+// Calls returns a list of arguments used in each call to PVZPoliciesMock.WeightTolerancePercent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmWeightTolerancePercent *mPVZPoliciesMockWeightTolerancePercent) Calls() []*PVZPoliciesMockWeightTolerancePercentParams {
+	mmWeightTolerancePercent.mutex.RLock()
+
+	argCopy := make([]*PVZPoliciesMockWeightTolerancePercentParams, len(mmWeightTolerancePercent.callArgs))
+	copy(argCopy, mmWeightTolerancePercent.callArgs)
+
+	mmWeightTolerancePercent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockWeightTolerancePercentDone returns true if the count of the WeightTolerancePercent invocations corresponds
+// the number of defined expectations
+func (m *PVZPoliciesMock) MinimockWeightTolerancePercentDone() bool {
+	if m.WeightTolerancePercentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.WeightTolerancePercentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.WeightTolerancePercentMock.invocationsDone()
+}
+
+// MinimockWeightTolerancePercentInspect logs each unmet expectation
+func (m *PVZPoliciesMock) MinimockWeightTolerancePercentInspect() {
+	for _, e := range m.WeightTolerancePercentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PVZPoliciesMock.WeightTolerancePercent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterWeightTolerancePercentCounter := mm_atomic.LoadUint64(&m.afterWeightTolerancePercentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.WeightTolerancePercentMock.defaultExpectation != nil && afterWeightTolerancePercentCounter < 1 {
+		if m.WeightTolerancePercentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PVZPoliciesMock.WeightTolerancePercent at\n%s", m.WeightTolerancePercentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PVZPoliciesMock.WeightTolerancePercent at\n%s with params: %#v", m.WeightTolerancePercentMock.defaultExpectation.expectationOrigins.origin, *m.WeightTolerancePercentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcWeightTolerancePercent != nil && afterWeightTolerancePercentCounter < 1 {
+		m.t.Errorf("Expected call to PVZPoliciesMock.WeightTolerancePercent at\n%s", m.funcWeightTolerancePercentOrigin)
+	}
+
+	if !m.WeightTolerancePercentMock.invocationsDone() && afterWeightTolerancePercentCounter > 0 {
+		m.t.Errorf("Expected %d calls to PVZPoliciesMock.WeightTolerancePercent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.WeightTolerancePercentMock.expectedInvocations), m.WeightTolerancePercentMock.expectedInvocationsOrigin, afterWeightTolerancePercentCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PVZPoliciesMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCalendarInspect()
+
+			m.MinimockPaidStorageInspect()
+
+			m.MinimockPolicyInspect()
+
+			m.MinimockWeightTolerancePercentInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PVZPoliciesMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PVZPoliciesMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCalendarDone() &&
+		m.MinimockPaidStorageDone() &&
+		m.MinimockPolicyDone() &&
+		m.MinimockWeightTolerancePercentDone()
+}
@@ -0,0 +1,772 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"homework/internal/domain"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// PVZPolicyCacheMock implements mm_usecases.PVZPolicyCache
+type PVZPolicyCacheMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetPolicy          func(ctx context.Context, pvzID string) (p1 domain.PVZPolicy, e1 error, b1 bool)
+	funcGetPolicyOrigin    string
+	inspectFuncGetPolicy   func(ctx context.Context, pvzID string)
+	afterGetPolicyCounter  uint64
+	beforeGetPolicyCounter uint64
+	GetPolicyMock          mPVZPolicyCacheMockGetPolicy
+
+	funcSetPolicy          func(ctx context.Context, policy domain.PVZPolicy) (err error)
+	funcSetPolicyOrigin    string
+	inspectFuncSetPolicy   func(ctx context.Context, policy domain.PVZPolicy)
+	afterSetPolicyCounter  uint64
+	beforeSetPolicyCounter uint64
+	SetPolicyMock          mPVZPolicyCacheMockSetPolicy
+}
+
+// NewPVZPolicyCacheMock returns a mock for mm_usecases.PVZPolicyCache
+func NewPVZPolicyCacheMock(t minimock.Tester) *PVZPolicyCacheMock {
+	m := &PVZPolicyCacheMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetPolicyMock = mPVZPolicyCacheMockGetPolicy{mock: m}
+	m.GetPolicyMock.callArgs = []*PVZPolicyCacheMockGetPolicyParams{}
+
+	m.SetPolicyMock = mPVZPolicyCacheMockSetPolicy{mock: m}
+	m.SetPolicyMock.callArgs = []*PVZPolicyCacheMockSetPolicyParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPVZPolicyCacheMockGetPolicy struct {
+	optional           bool
+	mock               *PVZPolicyCacheMock
+	defaultExpectation *PVZPolicyCacheMockGetPolicyExpectation
+	expectations       []*PVZPolicyCacheMockGetPolicyExpectation
+
+	callArgs []*PVZPolicyCacheMockGetPolicyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PVZPolicyCacheMockGetPolicyExpectation specifies expectation struct of the PVZPolicyCache.GetPolicy
+type PVZPolicyCacheMockGetPolicyExpectation struct {
+	mock               *PVZPolicyCacheMock
+	params             *PVZPolicyCacheMockGetPolicyParams
+	paramPtrs          *PVZPolicyCacheMockGetPolicyParamPtrs
+	expectationOrigins PVZPolicyCacheMockGetPolicyExpectationOrigins
+	results            *PVZPolicyCacheMockGetPolicyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PVZPolicyCacheMockGetPolicyParams contains parameters of the PVZPolicyCache.GetPolicy
+type PVZPolicyCacheMockGetPolicyParams struct {
+	ctx   context.Context
+	pvzID string
+}
+
+// PVZPolicyCacheMockGetPolicyParamPtrs contains pointers to parameters of the PVZPolicyCache.GetPolicy
+type PVZPolicyCacheMockGetPolicyParamPtrs struct {
+	ctx   *context.Context
+	pvzID *string
+}
+
+// PVZPolicyCacheMockGetPolicyResults contains results of the PVZPolicyCache.GetPolicy
+type PVZPolicyCacheMockGetPolicyResults struct {
+	p1 domain.PVZPolicy
+	e1 error
+	b1 bool
+}
+
+// PVZPolicyCacheMockGetPolicyOrigins contains origins of expectations of the PVZPolicyCache.GetPolicy
+type PVZPolicyCacheMockGetPolicyExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originPvzID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPolicy *mPVZPolicyCacheMockGetPolicy) Optional() *mPVZPolicyCacheMockGetPolicy {
+	mmGetPolicy.optional = true
+	return mmGetPolicy
+}
+
+// Expect sets up expected params for PVZPolicyCache.GetPolicy
+func (mmGetPolicy *mPVZPolicyCacheMockGetPolicy) Expect(ctx context.Context, pvzID string) *mPVZPolicyCacheMockGetPolicy {
+	if mmGetPolicy.mock.funcGetPolicy != nil {
+		mmGetPolicy.mock.t.Fatalf("PVZPolicyCacheMock.GetPolicy mock is already set by Set")
+	}
+
+	if mmGetPolicy.defaultExpectation == nil {
+		mmGetPolicy.defaultExpectation = &PVZPolicyCacheMockGetPolicyExpectation{}
+	}
+
+	if mmGetPolicy.defaultExpectation.paramPtrs != nil {
+		mmGetPolicy.mock.t.Fatalf("PVZPolicyCacheMock.GetPolicy mock is already set by ExpectParams functions")
+	}
+
+	mmGetPolicy.defaultExpectation.params = &PVZPolicyCacheMockGetPolicyParams{ctx, pvzID}
+	mmGetPolicy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPolicy.expectations {
+		if minimock.Equal(e.params, mmGetPolicy.defaultExpectation.params) {
+			mmGetPolicy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPolicy.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPolicy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PVZPolicyCache.GetPolicy
+func (mmGetPolicy *mPVZPolicyCacheMockGetPolicy) ExpectCtxParam1(ctx context.Context) *mPVZPolicyCacheMockGetPolicy {
+	if mmGetPolicy.mock.funcGetPolicy != nil {
+		mmGetPolicy.mock.t.Fatalf("PVZPolicyCacheMock.GetPolicy mock is already set by Set")
+	}
+
+	if mmGetPolicy.defaultExpectation == nil {
+		mmGetPolicy.defaultExpectation = &PVZPolicyCacheMockGetPolicyExpectation{}
+	}
+
+	if mmGetPolicy.defaultExpectation.params != nil {
+		mmGetPolicy.mock.t.Fatalf("PVZPolicyCacheMock.GetPolicy mock is already set by Expect")
+	}
+
+	if mmGetPolicy.defaultExpectation.paramPtrs == nil {
+		mmGetPolicy.defaultExpectation.paramPtrs = &PVZPolicyCacheMockGetPolicyParamPtrs{}
+	}
+	mmGetPolicy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPolicy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPolicy
+}
+
+// ExpectPvzIDParam2 sets up expected param pvzID for PVZPolicyCache.GetPolicy
+func (mmGetPolicy *mPVZPolicyCacheMockGetPolicy) ExpectPvzIDParam2(pvzID string) *mPVZPolicyCacheMockGetPolicy {
+	if mmGetPolicy.mock.funcGetPolicy != nil {
+		mmGetPolicy.mock.t.Fatalf("PVZPolicyCacheMock.GetPolicy mock is already set by Set")
+	}
+
+	if mmGetPolicy.defaultExpectation == nil {
+		mmGetPolicy.defaultExpectation = &PVZPolicyCacheMockGetPolicyExpectation{}
+	}
+
+	if mmGetPolicy.defaultExpectation.params != nil {
+		mmGetPolicy.mock.t.Fatalf("PVZPolicyCacheMock.GetPolicy mock is already set by Expect")
+	}
+
+	if mmGetPolicy.defaultExpectation.paramPtrs == nil {
+		mmGetPolicy.defaultExpectation.paramPtrs = &PVZPolicyCacheMockGetPolicyParamPtrs{}
+	}
+	mmGetPolicy.defaultExpectation.paramPtrs.pvzID = &pvzID
+	mmGetPolicy.defaultExpectation.expectationOrigins.originPvzID = minimock.CallerInfo(1)
+
+	return mmGetPolicy
+}
+
+// Inspect accepts an inspector function that has same arguments as the PVZPolicyCache.GetPolicy
+func (mmGetPolicy *mPVZPolicyCacheMockGetPolicy) Inspect(f func(ctx context.Context, pvzID string)) *mPVZPolicyCacheMockGetPolicy {
+	if mmGetPolicy.mock.inspectFuncGetPolicy != nil {
+		mmGetPolicy.mock.t.Fatalf("Inspect function is already set for PVZPolicyCacheMock.GetPolicy")
+	}
+
+	mmGetPolicy.mock.inspectFuncGetPolicy = f
+
+	return mmGetPolicy
+}
+
+// Return sets up results that will be returned by PVZPolicyCache.GetPolicy
+func (mmGetPolicy *mPVZPolicyCacheMockGetPolicy) Return(p1 domain.PVZPolicy, e1 error, b1 bool) *PVZPolicyCacheMock {
+	if mmGetPolicy.mock.funcGetPolicy != nil {
+		mmGetPolicy.mock.t.Fatalf("PVZPolicyCacheMock.GetPolicy mock is already set by Set")
+	}
+
+	if mmGetPolicy.defaultExpectation == nil {
+		mmGetPolicy.defaultExpectation = &PVZPolicyCacheMockGetPolicyExpectation{mock: mmGetPolicy.mock}
+	}
+	mmGetPolicy.defaultExpectation.results = &PVZPolicyCacheMockGetPolicyResults{p1, e1, b1}
+	mmGetPolicy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPolicy.mock
+}
+
+// Set uses given function f to mock the PVZPolicyCache.GetPolicy method
+func (mmGetPolicy *mPVZPolicyCacheMockGetPolicy) Set(f func(ctx context.Context, pvzID string) (p1 domain.PVZPolicy, e1 error, b1 bool)) *PVZPolicyCacheMock {
+	if mmGetPolicy.defaultExpectation != nil {
+		mmGetPolicy.mock.t.Fatalf("Default expectation is already set for the PVZPolicyCache.GetPolicy method")
+	}
+
+	if len(mmGetPolicy.expectations) > 0 {
+		mmGetPolicy.mock.t.Fatalf("Some expectations are already set for the PVZPolicyCache.GetPolicy method")
+	}
+
+	mmGetPolicy.mock.funcGetPolicy = f
+	mmGetPolicy.mock.funcGetPolicyOrigin = minimock.CallerInfo(1)
+	return mmGetPolicy.mock
+}
+
+// When sets expectation for the PVZPolicyCache.GetPolicy which will trigger the result defined by the following
+// Then helper
+func (mmGetPolicy *mPVZPolicyCacheMockGetPolicy) When(ctx context.Context, pvzID string) *PVZPolicyCacheMockGetPolicyExpectation {
+	if mmGetPolicy.mock.funcGetPolicy != nil {
+		mmGetPolicy.mock.t.Fatalf("PVZPolicyCacheMock.GetPolicy mock is already set by Set")
+	}
+
+	expectation := &PVZPolicyCacheMockGetPolicyExpectation{
+		mock:               mmGetPolicy.mock,
+		params:             &PVZPolicyCacheMockGetPolicyParams{ctx, pvzID},
+		expectationOrigins: PVZPolicyCacheMockGetPolicyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPolicy.expectations = append(mmGetPolicy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PVZPolicyCache.GetPolicy return parameters for the expectation previously defined by the When method
+func (e *PVZPolicyCacheMockGetPolicyExpectation) Then(p1 domain.PVZPolicy, e1 error, b1 bool) *PVZPolicyCacheMock {
+	e.results = &PVZPolicyCacheMockGetPolicyResults{p1, e1, b1}
+	return e.mock
+}
+
+// Times sets number of times PVZPolicyCache.GetPolicy should be invoked
+func (mmGetPolicy *mPVZPolicyCacheMockGetPolicy) Times(n uint64) *mPVZPolicyCacheMockGetPolicy {
+	if n == 0 {
+		mmGetPolicy.mock.t.Fatalf("Times of PVZPolicyCacheMock.GetPolicy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPolicy.expectedInvocations, n)
+	mmGetPolicy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPolicy
+}
+
+func (mmGetPolicy *mPVZPolicyCacheMockGetPolicy) invocationsDone() bool {
+	if len(mmGetPolicy.expectations) == 0 && mmGetPolicy.defaultExpectation == nil && mmGetPolicy.mock.funcGetPolicy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPolicy.mock.afterGetPolicyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPolicy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPolicy implements mm_usecases.PVZPolicyCache
+func (mmGetPolicy *PVZPolicyCacheMock) GetPolicy(ctx context.Context, pvzID string) (p1 domain.PVZPolicy, e1 error, b1 bool) {
+	mm_atomic.AddUint64(&mmGetPolicy.beforeGetPolicyCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPolicy.afterGetPolicyCounter, 1)
+
+	mmGetPolicy.t.Helper()
+
+	if mmGetPolicy.inspectFuncGetPolicy != nil {
+		mmGetPolicy.inspectFuncGetPolicy(ctx, pvzID)
+	}
+
+	mm_params := PVZPolicyCacheMockGetPolicyParams{ctx, pvzID}
+
+	// Record call args
+	mmGetPolicy.GetPolicyMock.mutex.Lock()
+	mmGetPolicy.GetPolicyMock.callArgs = append(mmGetPolicy.GetPolicyMock.callArgs, &mm_params)
+	mmGetPolicy.GetPolicyMock.mutex.Unlock()
+
+	for _, e := range mmGetPolicy.GetPolicyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.e1, e.results.b1
+		}
+	}
+
+	if mmGetPolicy.GetPolicyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPolicy.GetPolicyMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPolicy.GetPolicyMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPolicy.GetPolicyMock.defaultExpectation.paramPtrs
+
+		mm_got := PVZPolicyCacheMockGetPolicyParams{ctx, pvzID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPolicy.t.Errorf("PVZPolicyCacheMock.GetPolicy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPolicy.GetPolicyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pvzID != nil && !minimock.Equal(*mm_want_ptrs.pvzID, mm_got.pvzID) {
+				mmGetPolicy.t.Errorf("PVZPolicyCacheMock.GetPolicy got unexpected parameter pvzID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPolicy.GetPolicyMock.defaultExpectation.expectationOrigins.originPvzID, *mm_want_ptrs.pvzID, mm_got.pvzID, minimock.Diff(*mm_want_ptrs.pvzID, mm_got.pvzID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPolicy.t.Errorf("PVZPolicyCacheMock.GetPolicy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPolicy.GetPolicyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPolicy.GetPolicyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPolicy.t.Fatal("No results are set for the PVZPolicyCacheMock.GetPolicy")
+		}
+		return (*mm_results).p1, (*mm_results).e1, (*mm_results).b1
+	}
+	if mmGetPolicy.funcGetPolicy != nil {
+		return mmGetPolicy.funcGetPolicy(ctx, pvzID)
+	}
+	mmGetPolicy.t.Fatalf("Unexpected call to PVZPolicyCacheMock.GetPolicy. %v %v", ctx, pvzID)
+	return
+}
+
+// GetPolicyAfterCounter returns a count of finished PVZPolicyCacheMock.GetPolicy invocations
+func (mmGetPolicy *PVZPolicyCacheMock) GetPolicyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPolicy.afterGetPolicyCounter)
+}
+
+// GetPolicyBeforeCounter returns a count of PVZPolicyCacheMock.GetPolicy invocations
+func (mmGetPolicy *PVZPolicyCacheMock) GetPolicyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPolicy.beforeGetPolicyCounter)
+}
+
+// Calls returns a list of arguments used in each call to PVZPolicyCacheMock.GetPolicy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPolicy *mPVZPolicyCacheMockGetPolicy) Calls() []*PVZPolicyCacheMockGetPolicyParams {
+	mmGetPolicy.mutex.RLock()
+
+	argCopy := make([]*PVZPolicyCacheMockGetPolicyParams, len(mmGetPolicy.callArgs))
+	copy(argCopy, mmGetPolicy.callArgs)
+
+	mmGetPolicy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPolicyDone returns true if the count of the GetPolicy invocations corresponds
+// the number of defined expectations
+func (m *PVZPolicyCacheMock) MinimockGetPolicyDone() bool {
+	if m.GetPolicyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPolicyMock.invocationsDone()
+}
+
+// MinimockGetPolicyInspect logs each unmet expectation
+func (m *PVZPolicyCacheMock) MinimockGetPolicyInspect() {
+	for _, e := range m.GetPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PVZPolicyCacheMock.GetPolicy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPolicyCounter := mm_atomic.LoadUint64(&m.afterGetPolicyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPolicyMock.defaultExpectation != nil && afterGetPolicyCounter < 1 {
+		if m.GetPolicyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PVZPolicyCacheMock.GetPolicy at\n%s", m.GetPolicyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PVZPolicyCacheMock.GetPolicy at\n%s with params: %#v", m.GetPolicyMock.defaultExpectation.expectationOrigins.origin, *m.GetPolicyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPolicy != nil && afterGetPolicyCounter < 1 {
+		m.t.Errorf("Expected call to PVZPolicyCacheMock.GetPolicy at\n%s", m.funcGetPolicyOrigin)
+	}
+
+	if !m.GetPolicyMock.invocationsDone() && afterGetPolicyCounter > 0 {
+		m.t.Errorf("Expected %d calls to PVZPolicyCacheMock.GetPolicy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPolicyMock.expectedInvocations), m.GetPolicyMock.expectedInvocationsOrigin, afterGetPolicyCounter)
+	}
+}
+
+type mPVZPolicyCacheMockSetPolicy struct {
+	optional           bool
+	mock               *PVZPolicyCacheMock
+	defaultExpectation *PVZPolicyCacheMockSetPolicyExpectation
+	expectations       []*PVZPolicyCacheMockSetPolicyExpectation
+
+	callArgs []*PVZPolicyCacheMockSetPolicyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PVZPolicyCacheMockSetPolicyExpectation specifies expectation struct of the PVZPolicyCache.SetPolicy
+type PVZPolicyCacheMockSetPolicyExpectation struct {
+	mock               *PVZPolicyCacheMock
+	params             *PVZPolicyCacheMockSetPolicyParams
+	paramPtrs          *PVZPolicyCacheMockSetPolicyParamPtrs
+	expectationOrigins PVZPolicyCacheMockSetPolicyExpectationOrigins
+	results            *PVZPolicyCacheMockSetPolicyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PVZPolicyCacheMockSetPolicyParams contains parameters of the PVZPolicyCache.SetPolicy
+type PVZPolicyCacheMockSetPolicyParams struct {
+	ctx    context.Context
+	policy domain.PVZPolicy
+}
+
+// PVZPolicyCacheMockSetPolicyParamPtrs contains pointers to parameters of the PVZPolicyCache.SetPolicy
+type PVZPolicyCacheMockSetPolicyParamPtrs struct {
+	ctx    *context.Context
+	policy *domain.PVZPolicy
+}
+
+// PVZPolicyCacheMockSetPolicyResults contains results of the PVZPolicyCache.SetPolicy
+type PVZPolicyCacheMockSetPolicyResults struct {
+	err error
+}
+
+// PVZPolicyCacheMockSetPolicyOrigins contains origins of expectations of the PVZPolicyCache.SetPolicy
+type PVZPolicyCacheMockSetPolicyExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originPolicy string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetPolicy *mPVZPolicyCacheMockSetPolicy) Optional() *mPVZPolicyCacheMockSetPolicy {
+	mmSetPolicy.optional = true
+	return mmSetPolicy
+}
+
+// Expect sets up expected params for PVZPolicyCache.SetPolicy
+func (mmSetPolicy *mPVZPolicyCacheMockSetPolicy) Expect(ctx context.Context, policy domain.PVZPolicy) *mPVZPolicyCacheMockSetPolicy {
+	if mmSetPolicy.mock.funcSetPolicy != nil {
+		mmSetPolicy.mock.t.Fatalf("PVZPolicyCacheMock.SetPolicy mock is already set by Set")
+	}
+
+	if mmSetPolicy.defaultExpectation == nil {
+		mmSetPolicy.defaultExpectation = &PVZPolicyCacheMockSetPolicyExpectation{}
+	}
+
+	if mmSetPolicy.defaultExpectation.paramPtrs != nil {
+		mmSetPolicy.mock.t.Fatalf("PVZPolicyCacheMock.SetPolicy mock is already set by ExpectParams functions")
+	}
+
+	mmSetPolicy.defaultExpectation.params = &PVZPolicyCacheMockSetPolicyParams{ctx, policy}
+	mmSetPolicy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetPolicy.expectations {
+		if minimock.Equal(e.params, mmSetPolicy.defaultExpectation.params) {
+			mmSetPolicy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetPolicy.defaultExpectation.params)
+		}
+	}
+
+	return mmSetPolicy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PVZPolicyCache.SetPolicy
+func (mmSetPolicy *mPVZPolicyCacheMockSetPolicy) ExpectCtxParam1(ctx context.Context) *mPVZPolicyCacheMockSetPolicy {
+	if mmSetPolicy.mock.funcSetPolicy != nil {
+		mmSetPolicy.mock.t.Fatalf("PVZPolicyCacheMock.SetPolicy mock is already set by Set")
+	}
+
+	if mmSetPolicy.defaultExpectation == nil {
+		mmSetPolicy.defaultExpectation = &PVZPolicyCacheMockSetPolicyExpectation{}
+	}
+
+	if mmSetPolicy.defaultExpectation.params != nil {
+		mmSetPolicy.mock.t.Fatalf("PVZPolicyCacheMock.SetPolicy mock is already set by Expect")
+	}
+
+	if mmSetPolicy.defaultExpectation.paramPtrs == nil {
+		mmSetPolicy.defaultExpectation.paramPtrs = &PVZPolicyCacheMockSetPolicyParamPtrs{}
+	}
+	mmSetPolicy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetPolicy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetPolicy
+}
+
+// ExpectPolicyParam2 sets up expected param policy for PVZPolicyCache.SetPolicy
+func (mmSetPolicy *mPVZPolicyCacheMockSetPolicy) ExpectPolicyParam2(policy domain.PVZPolicy) *mPVZPolicyCacheMockSetPolicy {
+	if mmSetPolicy.mock.funcSetPolicy != nil {
+		mmSetPolicy.mock.t.Fatalf("PVZPolicyCacheMock.SetPolicy mock is already set by Set")
+	}
+
+	if mmSetPolicy.defaultExpectation == nil {
+		mmSetPolicy.defaultExpectation = &PVZPolicyCacheMockSetPolicyExpectation{}
+	}
+
+	if mmSetPolicy.defaultExpectation.params != nil {
+		mmSetPolicy.mock.t.Fatalf("PVZPolicyCacheMock.SetPolicy mock is already set by Expect")
+	}
+
+	if mmSetPolicy.defaultExpectation.paramPtrs == nil {
+		mmSetPolicy.defaultExpectation.paramPtrs = &PVZPolicyCacheMockSetPolicyParamPtrs{}
+	}
+	mmSetPolicy.defaultExpectation.paramPtrs.policy = &policy
+	mmSetPolicy.defaultExpectation.expectationOrigins.originPolicy = minimock.CallerInfo(1)
+
+	return mmSetPolicy
+}
+
+// Inspect accepts an inspector function that has same arguments as the PVZPolicyCache.SetPolicy
+func (mmSetPolicy *mPVZPolicyCacheMockSetPolicy) Inspect(f func(ctx context.Context, policy domain.PVZPolicy)) *mPVZPolicyCacheMockSetPolicy {
+	if mmSetPolicy.mock.inspectFuncSetPolicy != nil {
+		mmSetPolicy.mock.t.Fatalf("Inspect function is already set for PVZPolicyCacheMock.SetPolicy")
+	}
+
+	mmSetPolicy.mock.inspectFuncSetPolicy = f
+
+	return mmSetPolicy
+}
+
+// Return sets up results that will be returned by PVZPolicyCache.SetPolicy
+func (mmSetPolicy *mPVZPolicyCacheMockSetPolicy) Return(err error) *PVZPolicyCacheMock {
+	if mmSetPolicy.mock.funcSetPolicy != nil {
+		mmSetPolicy.mock.t.Fatalf("PVZPolicyCacheMock.SetPolicy mock is already set by Set")
+	}
+
+	if mmSetPolicy.defaultExpectation == nil {
+		mmSetPolicy.defaultExpectation = &PVZPolicyCacheMockSetPolicyExpectation{mock: mmSetPolicy.mock}
+	}
+	mmSetPolicy.defaultExpectation.results = &PVZPolicyCacheMockSetPolicyResults{err}
+	mmSetPolicy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetPolicy.mock
+}
+
+// Set uses given function f to mock the PVZPolicyCache.SetPolicy method
+func (mmSetPolicy *mPVZPolicyCacheMockSetPolicy) Set(f func(ctx context.Context, policy domain.PVZPolicy) (err error)) *PVZPolicyCacheMock {
+	if mmSetPolicy.defaultExpectation != nil {
+		mmSetPolicy.mock.t.Fatalf("Default expectation is already set for the PVZPolicyCache.SetPolicy method")
+	}
+
+	if len(mmSetPolicy.expectations) > 0 {
+		mmSetPolicy.mock.t.Fatalf("Some expectations are already set for the PVZPolicyCache.SetPolicy method")
+	}
+
+	mmSetPolicy.mock.funcSetPolicy = f
+	mmSetPolicy.mock.funcSetPolicyOrigin = minimock.CallerInfo(1)
+	return mmSetPolicy.mock
+}
+
+// When sets expectation for the PVZPolicyCache.SetPolicy which will trigger the result defined by the following
+// Then helper
+func (mmSetPolicy *mPVZPolicyCacheMockSetPolicy) When(ctx context.Context, policy domain.PVZPolicy) *PVZPolicyCacheMockSetPolicyExpectation {
+	if mmSetPolicy.mock.funcSetPolicy != nil {
+		mmSetPolicy.mock.t.Fatalf("PVZPolicyCacheMock.SetPolicy mock is already set by Set")
+	}
+
+	expectation := &PVZPolicyCacheMockSetPolicyExpectation{
+		mock:               mmSetPolicy.mock,
+		params:             &PVZPolicyCacheMockSetPolicyParams{ctx, policy},
+		expectationOrigins: PVZPolicyCacheMockSetPolicyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetPolicy.expectations = append(mmSetPolicy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PVZPolicyCache.SetPolicy return parameters for the expectation previously defined by the When method
+func (e *PVZPolicyCacheMockSetPolicyExpectation) Then(err error) *PVZPolicyCacheMock {
+	e.results = &PVZPolicyCacheMockSetPolicyResults{err}
+	return e.mock
+}
+
+// Times sets number of times PVZPolicyCache.SetPolicy should be invoked
+func (mmSetPolicy *mPVZPolicyCacheMockSetPolicy) Times(n uint64) *mPVZPolicyCacheMockSetPolicy {
+	if n == 0 {
+		mmSetPolicy.mock.t.Fatalf("Times of PVZPolicyCacheMock.SetPolicy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetPolicy.expectedInvocations, n)
+	mmSetPolicy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetPolicy
+}
+
+func (mmSetPolicy *mPVZPolicyCacheMockSetPolicy) invocationsDone() bool {
+	if len(mmSetPolicy.expectations) == 0 && mmSetPolicy.defaultExpectation == nil && mmSetPolicy.mock.funcSetPolicy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetPolicy.mock.afterSetPolicyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetPolicy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetPolicy implements mm_usecases.PVZPolicyCache
+func (mmSetPolicy *PVZPolicyCacheMock) SetPolicy(ctx context.Context, policy domain.PVZPolicy) (err error) {
+	mm_atomic.AddUint64(&mmSetPolicy.beforeSetPolicyCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetPolicy.afterSetPolicyCounter, 1)
+
+	mmSetPolicy.t.Helper()
+
+	if mmSetPolicy.inspectFuncSetPolicy != nil {
+		mmSetPolicy.inspectFuncSetPolicy(ctx, policy)
+	}
+
+	mm_params := PVZPolicyCacheMockSetPolicyParams{ctx, policy}
+
+	// Record call args
+	mmSetPolicy.SetPolicyMock.mutex.Lock()
+	mmSetPolicy.SetPolicyMock.callArgs = append(mmSetPolicy.SetPolicyMock.callArgs, &mm_params)
+	mmSetPolicy.SetPolicyMock.mutex.Unlock()
+
+	for _, e := range mmSetPolicy.SetPolicyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetPolicy.SetPolicyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetPolicy.SetPolicyMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetPolicy.SetPolicyMock.defaultExpectation.params
+		mm_want_ptrs := mmSetPolicy.SetPolicyMock.defaultExpectation.paramPtrs
+
+		mm_got := PVZPolicyCacheMockSetPolicyParams{ctx, policy}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetPolicy.t.Errorf("PVZPolicyCacheMock.SetPolicy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPolicy.SetPolicyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.policy != nil && !minimock.Equal(*mm_want_ptrs.policy, mm_got.policy) {
+				mmSetPolicy.t.Errorf("PVZPolicyCacheMock.SetPolicy got unexpected parameter policy, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPolicy.SetPolicyMock.defaultExpectation.expectationOrigins.originPolicy, *mm_want_ptrs.policy, mm_got.policy, minimock.Diff(*mm_want_ptrs.policy, mm_got.policy))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetPolicy.t.Errorf("PVZPolicyCacheMock.SetPolicy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetPolicy.SetPolicyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetPolicy.SetPolicyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetPolicy.t.Fatal("No results are set for the PVZPolicyCacheMock.SetPolicy")
+		}
+		return (*mm_results).err
+	}
+	if mmSetPolicy.funcSetPolicy != nil {
+		return mmSetPolicy.funcSetPolicy(ctx, policy)
+	}
+	mmSetPolicy.t.Fatalf("Unexpected call to PVZPolicyCacheMock.SetPolicy. %v %v", ctx, policy)
+	return
+}
+
+// SetPolicyAfterCounter returns a count of finished PVZPolicyCacheMock.SetPolicy invocations
+func (mmSetPolicy *PVZPolicyCacheMock) SetPolicyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPolicy.afterSetPolicyCounter)
+}
+
+// SetPolicyBeforeCounter returns a count of PVZPolicyCacheMock.SetPolicy invocations
+func (mmSetPolicy *PVZPolicyCacheMock) SetPolicyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPolicy.beforeSetPolicyCounter)
+}
+
+// Calls returns a list of arguments used in each call to PVZPolicyCacheMock.SetPolicy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetPolicy *mPVZPolicyCacheMockSetPolicy) Calls() []*PVZPolicyCacheMockSetPolicyParams {
+	mmSetPolicy.mutex.RLock()
+
+	argCopy := make([]*PVZPolicyCacheMockSetPolicyParams, len(mmSetPolicy.callArgs))
+	copy(argCopy, mmSetPolicy.callArgs)
+
+	mmSetPolicy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetPolicyDone returns true if the count of the SetPolicy invocations corresponds
+// the number of defined expectations
+func (m *PVZPolicyCacheMock) MinimockSetPolicyDone() bool {
+	if m.SetPolicyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetPolicyMock.invocationsDone()
+}
+
+// MinimockSetPolicyInspect logs each unmet expectation
+func (m *PVZPolicyCacheMock) MinimockSetPolicyInspect() {
+	for _, e := range m.SetPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PVZPolicyCacheMock.SetPolicy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetPolicyCounter := mm_atomic.LoadUint64(&m.afterSetPolicyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetPolicyMock.defaultExpectation != nil && afterSetPolicyCounter < 1 {
+		if m.SetPolicyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PVZPolicyCacheMock.SetPolicy at\n%s", m.SetPolicyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PVZPolicyCacheMock.SetPolicy at\n%s with params: %#v", m.SetPolicyMock.defaultExpectation.expectationOrigins.origin, *m.SetPolicyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetPolicy != nil && afterSetPolicyCounter < 1 {
+		m.t.Errorf("Expected call to PVZPolicyCacheMock.SetPolicy at\n%s", m.funcSetPolicyOrigin)
+	}
+
+	if !m.SetPolicyMock.invocationsDone() && afterSetPolicyCounter > 0 {
+		m.t.Errorf("Expected %d calls to PVZPolicyCacheMock.SetPolicy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetPolicyMock.expectedInvocations), m.SetPolicyMock.expectedInvocationsOrigin, afterSetPolicyCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PVZPolicyCacheMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetPolicyInspect()
+
+			m.MinimockSetPolicyInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PVZPolicyCacheMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PVZPolicyCacheMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetPolicyDone() &&
+		m.MinimockSetPolicyDone()
+}
@@ -0,0 +1,803 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"homework/internal/domain"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// PVZPolicyRepositoryMock implements mm_usecases.PVZPolicyRepository
+type PVZPolicyRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcGetPolicy          func(ctx context.Context, pvzID string) (p1 domain.PVZPolicy, err error)
+	funcGetPolicyOrigin    string
+	inspectFuncGetPolicy   func(ctx context.Context, pvzID string)
+	afterGetPolicyCounter  uint64
+	beforeGetPolicyCounter uint64
+	GetPolicyMock          mPVZPolicyRepositoryMockGetPolicy
+
+	funcSavePolicy          func(ctx context.Context, policy domain.PVZPolicy, expectedVersion int64) (p1 domain.PVZPolicy, err error)
+	funcSavePolicyOrigin    string
+	inspectFuncSavePolicy   func(ctx context.Context, policy domain.PVZPolicy, expectedVersion int64)
+	afterSavePolicyCounter  uint64
+	beforeSavePolicyCounter uint64
+	SavePolicyMock          mPVZPolicyRepositoryMockSavePolicy
+}
+
+// NewPVZPolicyRepositoryMock returns a mock for mm_usecases.PVZPolicyRepository
+func NewPVZPolicyRepositoryMock(t minimock.Tester) *PVZPolicyRepositoryMock {
+	m := &PVZPolicyRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.GetPolicyMock = mPVZPolicyRepositoryMockGetPolicy{mock: m}
+	m.GetPolicyMock.callArgs = []*PVZPolicyRepositoryMockGetPolicyParams{}
+
+	m.SavePolicyMock = mPVZPolicyRepositoryMockSavePolicy{mock: m}
+	m.SavePolicyMock.callArgs = []*PVZPolicyRepositoryMockSavePolicyParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPVZPolicyRepositoryMockGetPolicy struct {
+	optional           bool
+	mock               *PVZPolicyRepositoryMock
+	defaultExpectation *PVZPolicyRepositoryMockGetPolicyExpectation
+	expectations       []*PVZPolicyRepositoryMockGetPolicyExpectation
+
+	callArgs []*PVZPolicyRepositoryMockGetPolicyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PVZPolicyRepositoryMockGetPolicyExpectation specifies expectation struct of the PVZPolicyRepository.GetPolicy
+type PVZPolicyRepositoryMockGetPolicyExpectation struct {
+	mock               *PVZPolicyRepositoryMock
+	params             *PVZPolicyRepositoryMockGetPolicyParams
+	paramPtrs          *PVZPolicyRepositoryMockGetPolicyParamPtrs
+	expectationOrigins PVZPolicyRepositoryMockGetPolicyExpectationOrigins
+	results            *PVZPolicyRepositoryMockGetPolicyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PVZPolicyRepositoryMockGetPolicyParams contains parameters of the PVZPolicyRepository.GetPolicy
+type PVZPolicyRepositoryMockGetPolicyParams struct {
+	ctx   context.Context
+	pvzID string
+}
+
+// PVZPolicyRepositoryMockGetPolicyParamPtrs contains pointers to parameters of the PVZPolicyRepository.GetPolicy
+type PVZPolicyRepositoryMockGetPolicyParamPtrs struct {
+	ctx   *context.Context
+	pvzID *string
+}
+
+// PVZPolicyRepositoryMockGetPolicyResults contains results of the PVZPolicyRepository.GetPolicy
+type PVZPolicyRepositoryMockGetPolicyResults struct {
+	p1  domain.PVZPolicy
+	err error
+}
+
+// PVZPolicyRepositoryMockGetPolicyOrigins contains origins of expectations of the PVZPolicyRepository.GetPolicy
+type PVZPolicyRepositoryMockGetPolicyExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originPvzID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPolicy *mPVZPolicyRepositoryMockGetPolicy) Optional() *mPVZPolicyRepositoryMockGetPolicy {
+	mmGetPolicy.optional = true
+	return mmGetPolicy
+}
+
+// Expect sets up expected params for PVZPolicyRepository.GetPolicy
+func (mmGetPolicy *mPVZPolicyRepositoryMockGetPolicy) Expect(ctx context.Context, pvzID string) *mPVZPolicyRepositoryMockGetPolicy {
+	if mmGetPolicy.mock.funcGetPolicy != nil {
+		mmGetPolicy.mock.t.Fatalf("PVZPolicyRepositoryMock.GetPolicy mock is already set by Set")
+	}
+
+	if mmGetPolicy.defaultExpectation == nil {
+		mmGetPolicy.defaultExpectation = &PVZPolicyRepositoryMockGetPolicyExpectation{}
+	}
+
+	if mmGetPolicy.defaultExpectation.paramPtrs != nil {
+		mmGetPolicy.mock.t.Fatalf("PVZPolicyRepositoryMock.GetPolicy mock is already set by ExpectParams functions")
+	}
+
+	mmGetPolicy.defaultExpectation.params = &PVZPolicyRepositoryMockGetPolicyParams{ctx, pvzID}
+	mmGetPolicy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPolicy.expectations {
+		if minimock.Equal(e.params, mmGetPolicy.defaultExpectation.params) {
+			mmGetPolicy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPolicy.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPolicy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PVZPolicyRepository.GetPolicy
+func (mmGetPolicy *mPVZPolicyRepositoryMockGetPolicy) ExpectCtxParam1(ctx context.Context) *mPVZPolicyRepositoryMockGetPolicy {
+	if mmGetPolicy.mock.funcGetPolicy != nil {
+		mmGetPolicy.mock.t.Fatalf("PVZPolicyRepositoryMock.GetPolicy mock is already set by Set")
+	}
+
+	if mmGetPolicy.defaultExpectation == nil {
+		mmGetPolicy.defaultExpectation = &PVZPolicyRepositoryMockGetPolicyExpectation{}
+	}
+
+	if mmGetPolicy.defaultExpectation.params != nil {
+		mmGetPolicy.mock.t.Fatalf("PVZPolicyRepositoryMock.GetPolicy mock is already set by Expect")
+	}
+
+	if mmGetPolicy.defaultExpectation.paramPtrs == nil {
+		mmGetPolicy.defaultExpectation.paramPtrs = &PVZPolicyRepositoryMockGetPolicyParamPtrs{}
+	}
+	mmGetPolicy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPolicy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPolicy
+}
+
+// ExpectPvzIDParam2 sets up expected param pvzID for PVZPolicyRepository.GetPolicy
+func (mmGetPolicy *mPVZPolicyRepositoryMockGetPolicy) ExpectPvzIDParam2(pvzID string) *mPVZPolicyRepositoryMockGetPolicy {
+	if mmGetPolicy.mock.funcGetPolicy != nil {
+		mmGetPolicy.mock.t.Fatalf("PVZPolicyRepositoryMock.GetPolicy mock is already set by Set")
+	}
+
+	if mmGetPolicy.defaultExpectation == nil {
+		mmGetPolicy.defaultExpectation = &PVZPolicyRepositoryMockGetPolicyExpectation{}
+	}
+
+	if mmGetPolicy.defaultExpectation.params != nil {
+		mmGetPolicy.mock.t.Fatalf("PVZPolicyRepositoryMock.GetPolicy mock is already set by Expect")
+	}
+
+	if mmGetPolicy.defaultExpectation.paramPtrs == nil {
+		mmGetPolicy.defaultExpectation.paramPtrs = &PVZPolicyRepositoryMockGetPolicyParamPtrs{}
+	}
+	mmGetPolicy.defaultExpectation.paramPtrs.pvzID = &pvzID
+	mmGetPolicy.defaultExpectation.expectationOrigins.originPvzID = minimock.CallerInfo(1)
+
+	return mmGetPolicy
+}
+
+// Inspect accepts an inspector function that has same arguments as the PVZPolicyRepository.GetPolicy
+func (mmGetPolicy *mPVZPolicyRepositoryMockGetPolicy) Inspect(f func(ctx context.Context, pvzID string)) *mPVZPolicyRepositoryMockGetPolicy {
+	if mmGetPolicy.mock.inspectFuncGetPolicy != nil {
+		mmGetPolicy.mock.t.Fatalf("Inspect function is already set for PVZPolicyRepositoryMock.GetPolicy")
+	}
+
+	mmGetPolicy.mock.inspectFuncGetPolicy = f
+
+	return mmGetPolicy
+}
+
+// Return sets up results that will be returned by PVZPolicyRepository.GetPolicy
+func (mmGetPolicy *mPVZPolicyRepositoryMockGetPolicy) Return(p1 domain.PVZPolicy, err error) *PVZPolicyRepositoryMock {
+	if mmGetPolicy.mock.funcGetPolicy != nil {
+		mmGetPolicy.mock.t.Fatalf("PVZPolicyRepositoryMock.GetPolicy mock is already set by Set")
+	}
+
+	if mmGetPolicy.defaultExpectation == nil {
+		mmGetPolicy.defaultExpectation = &PVZPolicyRepositoryMockGetPolicyExpectation{mock: mmGetPolicy.mock}
+	}
+	mmGetPolicy.defaultExpectation.results = &PVZPolicyRepositoryMockGetPolicyResults{p1, err}
+	mmGetPolicy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPolicy.mock
+}
+
+// Set uses given function f to mock the PVZPolicyRepository.GetPolicy method
+func (mmGetPolicy *mPVZPolicyRepositoryMockGetPolicy) Set(f func(ctx context.Context, pvzID string) (p1 domain.PVZPolicy, err error)) *PVZPolicyRepositoryMock {
+	if mmGetPolicy.defaultExpectation != nil {
+		mmGetPolicy.mock.t.Fatalf("Default expectation is already set for the PVZPolicyRepository.GetPolicy method")
+	}
+
+	if len(mmGetPolicy.expectations) > 0 {
+		mmGetPolicy.mock.t.Fatalf("Some expectations are already set for the PVZPolicyRepository.GetPolicy method")
+	}
+
+	mmGetPolicy.mock.funcGetPolicy = f
+	mmGetPolicy.mock.funcGetPolicyOrigin = minimock.CallerInfo(1)
+	return mmGetPolicy.mock
+}
+
+// When sets expectation for the PVZPolicyRepository.GetPolicy which will trigger the result defined by the following
+// Then helper
+func (mmGetPolicy *mPVZPolicyRepositoryMockGetPolicy) When(ctx context.Context, pvzID string) *PVZPolicyRepositoryMockGetPolicyExpectation {
+	if mmGetPolicy.mock.funcGetPolicy != nil {
+		mmGetPolicy.mock.t.Fatalf("PVZPolicyRepositoryMock.GetPolicy mock is already set by Set")
+	}
+
+	expectation := &PVZPolicyRepositoryMockGetPolicyExpectation{
+		mock:               mmGetPolicy.mock,
+		params:             &PVZPolicyRepositoryMockGetPolicyParams{ctx, pvzID},
+		expectationOrigins: PVZPolicyRepositoryMockGetPolicyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPolicy.expectations = append(mmGetPolicy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PVZPolicyRepository.GetPolicy return parameters for the expectation previously defined by the When method
+func (e *PVZPolicyRepositoryMockGetPolicyExpectation) Then(p1 domain.PVZPolicy, err error) *PVZPolicyRepositoryMock {
+	e.results = &PVZPolicyRepositoryMockGetPolicyResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times PVZPolicyRepository.GetPolicy should be invoked
+func (mmGetPolicy *mPVZPolicyRepositoryMockGetPolicy) Times(n uint64) *mPVZPolicyRepositoryMockGetPolicy {
+	if n == 0 {
+		mmGetPolicy.mock.t.Fatalf("Times of PVZPolicyRepositoryMock.GetPolicy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPolicy.expectedInvocations, n)
+	mmGetPolicy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPolicy
+}
+
+func (mmGetPolicy *mPVZPolicyRepositoryMockGetPolicy) invocationsDone() bool {
+	if len(mmGetPolicy.expectations) == 0 && mmGetPolicy.defaultExpectation == nil && mmGetPolicy.mock.funcGetPolicy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPolicy.mock.afterGetPolicyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPolicy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPolicy implements mm_usecases.PVZPolicyRepository
+func (mmGetPolicy *PVZPolicyRepositoryMock) GetPolicy(ctx context.Context, pvzID string) (p1 domain.PVZPolicy, err error) {
+	mm_atomic.AddUint64(&mmGetPolicy.beforeGetPolicyCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPolicy.afterGetPolicyCounter, 1)
+
+	mmGetPolicy.t.Helper()
+
+	if mmGetPolicy.inspectFuncGetPolicy != nil {
+		mmGetPolicy.inspectFuncGetPolicy(ctx, pvzID)
+	}
+
+	mm_params := PVZPolicyRepositoryMockGetPolicyParams{ctx, pvzID}
+
+	// Record call args
+	mmGetPolicy.GetPolicyMock.mutex.Lock()
+	mmGetPolicy.GetPolicyMock.callArgs = append(mmGetPolicy.GetPolicyMock.callArgs, &mm_params)
+	mmGetPolicy.GetPolicyMock.mutex.Unlock()
+
+	for _, e := range mmGetPolicy.GetPolicyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmGetPolicy.GetPolicyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPolicy.GetPolicyMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPolicy.GetPolicyMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPolicy.GetPolicyMock.defaultExpectation.paramPtrs
+
+		mm_got := PVZPolicyRepositoryMockGetPolicyParams{ctx, pvzID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPolicy.t.Errorf("PVZPolicyRepositoryMock.GetPolicy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPolicy.GetPolicyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pvzID != nil && !minimock.Equal(*mm_want_ptrs.pvzID, mm_got.pvzID) {
+				mmGetPolicy.t.Errorf("PVZPolicyRepositoryMock.GetPolicy got unexpected parameter pvzID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPolicy.GetPolicyMock.defaultExpectation.expectationOrigins.originPvzID, *mm_want_ptrs.pvzID, mm_got.pvzID, minimock.Diff(*mm_want_ptrs.pvzID, mm_got.pvzID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPolicy.t.Errorf("PVZPolicyRepositoryMock.GetPolicy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPolicy.GetPolicyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPolicy.GetPolicyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPolicy.t.Fatal("No results are set for the PVZPolicyRepositoryMock.GetPolicy")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmGetPolicy.funcGetPolicy != nil {
+		return mmGetPolicy.funcGetPolicy(ctx, pvzID)
+	}
+	mmGetPolicy.t.Fatalf("Unexpected call to PVZPolicyRepositoryMock.GetPolicy. %v %v", ctx, pvzID)
+	return
+}
+
+// GetPolicyAfterCounter returns a count of finished PVZPolicyRepositoryMock.GetPolicy invocations
+func (mmGetPolicy *PVZPolicyRepositoryMock) GetPolicyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPolicy.afterGetPolicyCounter)
+}
+
+// GetPolicyBeforeCounter returns a count of PVZPolicyRepositoryMock.GetPolicy invocations
+func (mmGetPolicy *PVZPolicyRepositoryMock) GetPolicyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPolicy.beforeGetPolicyCounter)
+}
+
+// Calls returns a list of arguments used in each call to PVZPolicyRepositoryMock.GetPolicy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPolicy *mPVZPolicyRepositoryMockGetPolicy) Calls() []*PVZPolicyRepositoryMockGetPolicyParams {
+	mmGetPolicy.mutex.RLock()
+
+	argCopy := make([]*PVZPolicyRepositoryMockGetPolicyParams, len(mmGetPolicy.callArgs))
+	copy(argCopy, mmGetPolicy.callArgs)
+
+	mmGetPolicy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPolicyDone returns true if the count of the GetPolicy invocations corresponds
+// the number of defined expectations
+func (m *PVZPolicyRepositoryMock) MinimockGetPolicyDone() bool {
+	if m.GetPolicyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPolicyMock.invocationsDone()
+}
+
+// MinimockGetPolicyInspect logs each unmet expectation
+func (m *PVZPolicyRepositoryMock) MinimockGetPolicyInspect() {
+	for _, e := range m.GetPolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PVZPolicyRepositoryMock.GetPolicy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPolicyCounter := mm_atomic.LoadUint64(&m.afterGetPolicyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPolicyMock.defaultExpectation != nil && afterGetPolicyCounter < 1 {
+		if m.GetPolicyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PVZPolicyRepositoryMock.GetPolicy at\n%s", m.GetPolicyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PVZPolicyRepositoryMock.GetPolicy at\n%s with params: %#v", m.GetPolicyMock.defaultExpectation.expectationOrigins.origin, *m.GetPolicyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPolicy != nil && afterGetPolicyCounter < 1 {
+		m.t.Errorf("Expected call to PVZPolicyRepositoryMock.GetPolicy at\n%s", m.funcGetPolicyOrigin)
+	}
+
+	if !m.GetPolicyMock.invocationsDone() && afterGetPolicyCounter > 0 {
+		m.t.Errorf("Expected %d calls to PVZPolicyRepositoryMock.GetPolicy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPolicyMock.expectedInvocations), m.GetPolicyMock.expectedInvocationsOrigin, afterGetPolicyCounter)
+	}
+}
+
+type mPVZPolicyRepositoryMockSavePolicy struct {
+	optional           bool
+	mock               *PVZPolicyRepositoryMock
+	defaultExpectation *PVZPolicyRepositoryMockSavePolicyExpectation
+	expectations       []*PVZPolicyRepositoryMockSavePolicyExpectation
+
+	callArgs []*PVZPolicyRepositoryMockSavePolicyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PVZPolicyRepositoryMockSavePolicyExpectation specifies expectation struct of the PVZPolicyRepository.SavePolicy
+type PVZPolicyRepositoryMockSavePolicyExpectation struct {
+	mock               *PVZPolicyRepositoryMock
+	params             *PVZPolicyRepositoryMockSavePolicyParams
+	paramPtrs          *PVZPolicyRepositoryMockSavePolicyParamPtrs
+	expectationOrigins PVZPolicyRepositoryMockSavePolicyExpectationOrigins
+	results            *PVZPolicyRepositoryMockSavePolicyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PVZPolicyRepositoryMockSavePolicyParams contains parameters of the PVZPolicyRepository.SavePolicy
+type PVZPolicyRepositoryMockSavePolicyParams struct {
+	ctx             context.Context
+	policy          domain.PVZPolicy
+	expectedVersion int64
+}
+
+// PVZPolicyRepositoryMockSavePolicyParamPtrs contains pointers to parameters of the PVZPolicyRepository.SavePolicy
+type PVZPolicyRepositoryMockSavePolicyParamPtrs struct {
+	ctx             *context.Context
+	policy          *domain.PVZPolicy
+	expectedVersion *int64
+}
+
+// PVZPolicyRepositoryMockSavePolicyResults contains results of the PVZPolicyRepository.SavePolicy
+type PVZPolicyRepositoryMockSavePolicyResults struct {
+	p1  domain.PVZPolicy
+	err error
+}
+
+// PVZPolicyRepositoryMockSavePolicyOrigins contains origins of expectations of the PVZPolicyRepository.SavePolicy
+type PVZPolicyRepositoryMockSavePolicyExpectationOrigins struct {
+	origin                string
+	originCtx             string
+	originPolicy          string
+	originExpectedVersion string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSavePolicy *mPVZPolicyRepositoryMockSavePolicy) Optional() *mPVZPolicyRepositoryMockSavePolicy {
+	mmSavePolicy.optional = true
+	return mmSavePolicy
+}
+
+// Expect sets up expected params for PVZPolicyRepository.SavePolicy
+func (mmSavePolicy *mPVZPolicyRepositoryMockSavePolicy) Expect(ctx context.Context, policy domain.PVZPolicy, expectedVersion int64) *mPVZPolicyRepositoryMockSavePolicy {
+	if mmSavePolicy.mock.funcSavePolicy != nil {
+		mmSavePolicy.mock.t.Fatalf("PVZPolicyRepositoryMock.SavePolicy mock is already set by Set")
+	}
+
+	if mmSavePolicy.defaultExpectation == nil {
+		mmSavePolicy.defaultExpectation = &PVZPolicyRepositoryMockSavePolicyExpectation{}
+	}
+
+	if mmSavePolicy.defaultExpectation.paramPtrs != nil {
+		mmSavePolicy.mock.t.Fatalf("PVZPolicyRepositoryMock.SavePolicy mock is already set by ExpectParams functions")
+	}
+
+	mmSavePolicy.defaultExpectation.params = &PVZPolicyRepositoryMockSavePolicyParams{ctx, policy, expectedVersion}
+	mmSavePolicy.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSavePolicy.expectations {
+		if minimock.Equal(e.params, mmSavePolicy.defaultExpectation.params) {
+			mmSavePolicy.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSavePolicy.defaultExpectation.params)
+		}
+	}
+
+	return mmSavePolicy
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PVZPolicyRepository.SavePolicy
+func (mmSavePolicy *mPVZPolicyRepositoryMockSavePolicy) ExpectCtxParam1(ctx context.Context) *mPVZPolicyRepositoryMockSavePolicy {
+	if mmSavePolicy.mock.funcSavePolicy != nil {
+		mmSavePolicy.mock.t.Fatalf("PVZPolicyRepositoryMock.SavePolicy mock is already set by Set")
+	}
+
+	if mmSavePolicy.defaultExpectation == nil {
+		mmSavePolicy.defaultExpectation = &PVZPolicyRepositoryMockSavePolicyExpectation{}
+	}
+
+	if mmSavePolicy.defaultExpectation.params != nil {
+		mmSavePolicy.mock.t.Fatalf("PVZPolicyRepositoryMock.SavePolicy mock is already set by Expect")
+	}
+
+	if mmSavePolicy.defaultExpectation.paramPtrs == nil {
+		mmSavePolicy.defaultExpectation.paramPtrs = &PVZPolicyRepositoryMockSavePolicyParamPtrs{}
+	}
+	mmSavePolicy.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSavePolicy.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSavePolicy
+}
+
+// ExpectPolicyParam2 sets up expected param policy for PVZPolicyRepository.SavePolicy
+func (mmSavePolicy *mPVZPolicyRepositoryMockSavePolicy) ExpectPolicyParam2(policy domain.PVZPolicy) *mPVZPolicyRepositoryMockSavePolicy {
+	if mmSavePolicy.mock.funcSavePolicy != nil {
+		mmSavePolicy.mock.t.Fatalf("PVZPolicyRepositoryMock.SavePolicy mock is already set by Set")
+	}
+
+	if mmSavePolicy.defaultExpectation == nil {
+		mmSavePolicy.defaultExpectation = &PVZPolicyRepositoryMockSavePolicyExpectation{}
+	}
+
+	if mmSavePolicy.defaultExpectation.params != nil {
+		mmSavePolicy.mock.t.Fatalf("PVZPolicyRepositoryMock.SavePolicy mock is already set by Expect")
+	}
+
+	if mmSavePolicy.defaultExpectation.paramPtrs == nil {
+		mmSavePolicy.defaultExpectation.paramPtrs = &PVZPolicyRepositoryMockSavePolicyParamPtrs{}
+	}
+	mmSavePolicy.defaultExpectation.paramPtrs.policy = &policy
+	mmSavePolicy.defaultExpectation.expectationOrigins.originPolicy = minimock.CallerInfo(1)
+
+	return mmSavePolicy
+}
+
+// ExpectExpectedVersionParam3 sets up expected param expectedVersion for PVZPolicyRepository.SavePolicy
+func (mmSavePolicy *mPVZPolicyRepositoryMockSavePolicy) ExpectExpectedVersionParam3(expectedVersion int64) *mPVZPolicyRepositoryMockSavePolicy {
+	if mmSavePolicy.mock.funcSavePolicy != nil {
+		mmSavePolicy.mock.t.Fatalf("PVZPolicyRepositoryMock.SavePolicy mock is already set by Set")
+	}
+
+	if mmSavePolicy.defaultExpectation == nil {
+		mmSavePolicy.defaultExpectation = &PVZPolicyRepositoryMockSavePolicyExpectation{}
+	}
+
+	if mmSavePolicy.defaultExpectation.params != nil {
+		mmSavePolicy.mock.t.Fatalf("PVZPolicyRepositoryMock.SavePolicy mock is already set by Expect")
+	}
+
+	if mmSavePolicy.defaultExpectation.paramPtrs == nil {
+		mmSavePolicy.defaultExpectation.paramPtrs = &PVZPolicyRepositoryMockSavePolicyParamPtrs{}
+	}
+	mmSavePolicy.defaultExpectation.paramPtrs.expectedVersion = &expectedVersion
+	mmSavePolicy.defaultExpectation.expectationOrigins.originExpectedVersion = minimock.CallerInfo(1)
+
+	return mmSavePolicy
+}
+
+// Inspect accepts an inspector function that has same arguments as the PVZPolicyRepository.SavePolicy
+func (mmSavePolicy *mPVZPolicyRepositoryMockSavePolicy) Inspect(f func(ctx context.Context, policy domain.PVZPolicy, expectedVersion int64)) *mPVZPolicyRepositoryMockSavePolicy {
+	if mmSavePolicy.mock.inspectFuncSavePolicy != nil {
+		mmSavePolicy.mock.t.Fatalf("Inspect function is already set for PVZPolicyRepositoryMock.SavePolicy")
+	}
+
+	mmSavePolicy.mock.inspectFuncSavePolicy = f
+
+	return mmSavePolicy
+}
+
+// Return sets up results that will be returned by PVZPolicyRepository.SavePolicy
+func (mmSavePolicy *mPVZPolicyRepositoryMockSavePolicy) Return(p1 domain.PVZPolicy, err error) *PVZPolicyRepositoryMock {
+	if mmSavePolicy.mock.funcSavePolicy != nil {
+		mmSavePolicy.mock.t.Fatalf("PVZPolicyRepositoryMock.SavePolicy mock is already set by Set")
+	}
+
+	if mmSavePolicy.defaultExpectation == nil {
+		mmSavePolicy.defaultExpectation = &PVZPolicyRepositoryMockSavePolicyExpectation{mock: mmSavePolicy.mock}
+	}
+	mmSavePolicy.defaultExpectation.results = &PVZPolicyRepositoryMockSavePolicyResults{p1, err}
+	mmSavePolicy.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSavePolicy.mock
+}
+
+// Set uses given function f to mock the PVZPolicyRepository.SavePolicy method
+func (mmSavePolicy *mPVZPolicyRepositoryMockSavePolicy) Set(f func(ctx context.Context, policy domain.PVZPolicy, expectedVersion int64) (p1 domain.PVZPolicy, err error)) *PVZPolicyRepositoryMock {
+	if mmSavePolicy.defaultExpectation != nil {
+		mmSavePolicy.mock.t.Fatalf("Default expectation is already set for the PVZPolicyRepository.SavePolicy method")
+	}
+
+	if len(mmSavePolicy.expectations) > 0 {
+		mmSavePolicy.mock.t.Fatalf("Some expectations are already set for the PVZPolicyRepository.SavePolicy method")
+	}
+
+	mmSavePolicy.mock.funcSavePolicy = f
+	mmSavePolicy.mock.funcSavePolicyOrigin = minimock.CallerInfo(1)
+	return mmSavePolicy.mock
+}
+
+// When sets expectation for the PVZPolicyRepository.SavePolicy which will trigger the result defined by the following
+// Then helper
+func (mmSavePolicy *mPVZPolicyRepositoryMockSavePolicy) When(ctx context.Context, policy domain.PVZPolicy, expectedVersion int64) *PVZPolicyRepositoryMockSavePolicyExpectation {
+	if mmSavePolicy.mock.funcSavePolicy != nil {
+		mmSavePolicy.mock.t.Fatalf("PVZPolicyRepositoryMock.SavePolicy mock is already set by Set")
+	}
+
+	expectation := &PVZPolicyRepositoryMockSavePolicyExpectation{
+		mock:               mmSavePolicy.mock,
+		params:             &PVZPolicyRepositoryMockSavePolicyParams{ctx, policy, expectedVersion},
+		expectationOrigins: PVZPolicyRepositoryMockSavePolicyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSavePolicy.expectations = append(mmSavePolicy.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PVZPolicyRepository.SavePolicy return parameters for the expectation previously defined by the When method
+func (e *PVZPolicyRepositoryMockSavePolicyExpectation) Then(p1 domain.PVZPolicy, err error) *PVZPolicyRepositoryMock {
+	e.results = &PVZPolicyRepositoryMockSavePolicyResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times PVZPolicyRepository.SavePolicy should be invoked
+func (mmSavePolicy *mPVZPolicyRepositoryMockSavePolicy) Times(n uint64) *mPVZPolicyRepositoryMockSavePolicy {
+	if n == 0 {
+		mmSavePolicy.mock.t.Fatalf("Times of PVZPolicyRepositoryMock.SavePolicy mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSavePolicy.expectedInvocations, n)
+	mmSavePolicy.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSavePolicy
+}
+
+func (mmSavePolicy *mPVZPolicyRepositoryMockSavePolicy) invocationsDone() bool {
+	if len(mmSavePolicy.expectations) == 0 && mmSavePolicy.defaultExpectation == nil && mmSavePolicy.mock.funcSavePolicy == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSavePolicy.mock.afterSavePolicyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSavePolicy.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SavePolicy implements mm_usecases.PVZPolicyRepository
+func (mmSavePolicy *PVZPolicyRepositoryMock) SavePolicy(ctx context.Context, policy domain.PVZPolicy, expectedVersion int64) (p1 domain.PVZPolicy, err error) {
+	mm_atomic.AddUint64(&mmSavePolicy.beforeSavePolicyCounter, 1)
+	defer mm_atomic.AddUint64(&mmSavePolicy.afterSavePolicyCounter, 1)
+
+	mmSavePolicy.t.Helper()
+
+	if mmSavePolicy.inspectFuncSavePolicy != nil {
+		mmSavePolicy.inspectFuncSavePolicy(ctx, policy, expectedVersion)
+	}
+
+	mm_params := PVZPolicyRepositoryMockSavePolicyParams{ctx, policy, expectedVersion}
+
+	// Record call args
+	mmSavePolicy.SavePolicyMock.mutex.Lock()
+	mmSavePolicy.SavePolicyMock.callArgs = append(mmSavePolicy.SavePolicyMock.callArgs, &mm_params)
+	mmSavePolicy.SavePolicyMock.mutex.Unlock()
+
+	for _, e := range mmSavePolicy.SavePolicyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmSavePolicy.SavePolicyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSavePolicy.SavePolicyMock.defaultExpectation.Counter, 1)
+		mm_want := mmSavePolicy.SavePolicyMock.defaultExpectation.params
+		mm_want_ptrs := mmSavePolicy.SavePolicyMock.defaultExpectation.paramPtrs
+
+		mm_got := PVZPolicyRepositoryMockSavePolicyParams{ctx, policy, expectedVersion}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSavePolicy.t.Errorf("PVZPolicyRepositoryMock.SavePolicy got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSavePolicy.SavePolicyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.policy != nil && !minimock.Equal(*mm_want_ptrs.policy, mm_got.policy) {
+				mmSavePolicy.t.Errorf("PVZPolicyRepositoryMock.SavePolicy got unexpected parameter policy, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSavePolicy.SavePolicyMock.defaultExpectation.expectationOrigins.originPolicy, *mm_want_ptrs.policy, mm_got.policy, minimock.Diff(*mm_want_ptrs.policy, mm_got.policy))
+			}
+
+			if mm_want_ptrs.expectedVersion != nil && !minimock.Equal(*mm_want_ptrs.expectedVersion, mm_got.expectedVersion) {
+				mmSavePolicy.t.Errorf("PVZPolicyRepositoryMock.SavePolicy got unexpected parameter expectedVersion, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSavePolicy.SavePolicyMock.defaultExpectation.expectationOrigins.originExpectedVersion, *mm_want_ptrs.expectedVersion, mm_got.expectedVersion, minimock.Diff(*mm_want_ptrs.expectedVersion, mm_got.expectedVersion))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSavePolicy.t.Errorf("PVZPolicyRepositoryMock.SavePolicy got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSavePolicy.SavePolicyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSavePolicy.SavePolicyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSavePolicy.t.Fatal("No results are set for the PVZPolicyRepositoryMock.SavePolicy")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmSavePolicy.funcSavePolicy != nil {
+		return mmSavePolicy.funcSavePolicy(ctx, policy, expectedVersion)
+	}
+	mmSavePolicy.t.Fatalf("Unexpected call to PVZPolicyRepositoryMock.SavePolicy. %v %v %v", ctx, policy, expectedVersion)
+	return
+}
+
+// SavePolicyAfterCounter returns a count of finished PVZPolicyRepositoryMock.SavePolicy invocations
+func (mmSavePolicy *PVZPolicyRepositoryMock) SavePolicyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSavePolicy.afterSavePolicyCounter)
+}
+
+// SavePolicyBeforeCounter returns a count of PVZPolicyRepositoryMock.SavePolicy invocations
+func (mmSavePolicy *PVZPolicyRepositoryMock) SavePolicyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSavePolicy.beforeSavePolicyCounter)
+}
+
+// Calls returns a list of arguments used in each call to PVZPolicyRepositoryMock.SavePolicy.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSavePolicy *mPVZPolicyRepositoryMockSavePolicy) Calls() []*PVZPolicyRepositoryMockSavePolicyParams {
+	mmSavePolicy.mutex.RLock()
+
+	argCopy := make([]*PVZPolicyRepositoryMockSavePolicyParams, len(mmSavePolicy.callArgs))
+	copy(argCopy, mmSavePolicy.callArgs)
+
+	mmSavePolicy.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSavePolicyDone returns true if the count of the SavePolicy invocations corresponds
+// the number of defined expectations
+func (m *PVZPolicyRepositoryMock) MinimockSavePolicyDone() bool {
+	if m.SavePolicyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SavePolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SavePolicyMock.invocationsDone()
+}
+
+// MinimockSavePolicyInspect logs each unmet expectation
+func (m *PVZPolicyRepositoryMock) MinimockSavePolicyInspect() {
+	for _, e := range m.SavePolicyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PVZPolicyRepositoryMock.SavePolicy at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSavePolicyCounter := mm_atomic.LoadUint64(&m.afterSavePolicyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SavePolicyMock.defaultExpectation != nil && afterSavePolicyCounter < 1 {
+		if m.SavePolicyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PVZPolicyRepositoryMock.SavePolicy at\n%s", m.SavePolicyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PVZPolicyRepositoryMock.SavePolicy at\n%s with params: %#v", m.SavePolicyMock.defaultExpectation.expectationOrigins.origin, *m.SavePolicyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSavePolicy != nil && afterSavePolicyCounter < 1 {
+		m.t.Errorf("Expected call to PVZPolicyRepositoryMock.SavePolicy at\n%s", m.funcSavePolicyOrigin)
+	}
+
+	if !m.SavePolicyMock.invocationsDone() && afterSavePolicyCounter > 0 {
+		m.t.Errorf("Expected %d calls to PVZPolicyRepositoryMock.SavePolicy at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SavePolicyMock.expectedInvocations), m.SavePolicyMock.expectedInvocationsOrigin, afterSavePolicyCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PVZPolicyRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockGetPolicyInspect()
+
+			m.MinimockSavePolicyInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PVZPolicyRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PVZPolicyRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockGetPolicyDone() &&
+		m.MinimockSavePolicyDone()
+}
@@ -0,0 +1,415 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// PVZRegistryMock implements mm_usecases.PVZRegistry
+type PVZRegistryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcExists          func(ctx context.Context, pvzID string) (b1 bool, err error)
+	funcExistsOrigin    string
+	inspectFuncExists   func(ctx context.Context, pvzID string)
+	afterExistsCounter  uint64
+	beforeExistsCounter uint64
+	ExistsMock          mPVZRegistryMockExists
+}
+
+// NewPVZRegistryMock returns a mock for mm_usecases.PVZRegistry
+func NewPVZRegistryMock(t minimock.Tester) *PVZRegistryMock {
+	m := &PVZRegistryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ExistsMock = mPVZRegistryMockExists{mock: m}
+	m.ExistsMock.callArgs = []*PVZRegistryMockExistsParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPVZRegistryMockExists struct {
+	optional           bool
+	mock               *PVZRegistryMock
+	defaultExpectation *PVZRegistryMockExistsExpectation
+	expectations       []*PVZRegistryMockExistsExpectation
+
+	callArgs []*PVZRegistryMockExistsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PVZRegistryMockExistsExpectation specifies expectation struct of the PVZRegistry.Exists
+type PVZRegistryMockExistsExpectation struct {
+	mock               *PVZRegistryMock
+	params             *PVZRegistryMockExistsParams
+	paramPtrs          *PVZRegistryMockExistsParamPtrs
+	expectationOrigins PVZRegistryMockExistsExpectationOrigins
+	results            *PVZRegistryMockExistsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PVZRegistryMockExistsParams contains parameters of the PVZRegistry.Exists
+type PVZRegistryMockExistsParams struct {
+	ctx   context.Context
+	pvzID string
+}
+
+// PVZRegistryMockExistsParamPtrs contains pointers to parameters of the PVZRegistry.Exists
+type PVZRegistryMockExistsParamPtrs struct {
+	ctx   *context.Context
+	pvzID *string
+}
+
+// PVZRegistryMockExistsResults contains results of the PVZRegistry.Exists
+type PVZRegistryMockExistsResults struct {
+	b1  bool
+	err error
+}
+
+// PVZRegistryMockExistsOrigins contains origins of expectations of the PVZRegistry.Exists
+type PVZRegistryMockExistsExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originPvzID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExists *mPVZRegistryMockExists) Optional() *mPVZRegistryMockExists {
+	mmExists.optional = true
+	return mmExists
+}
+
+// Expect sets up expected params for PVZRegistry.Exists
+func (mmExists *mPVZRegistryMockExists) Expect(ctx context.Context, pvzID string) *mPVZRegistryMockExists {
+	if mmExists.mock.funcExists != nil {
+		mmExists.mock.t.Fatalf("PVZRegistryMock.Exists mock is already set by Set")
+	}
+
+	if mmExists.defaultExpectation == nil {
+		mmExists.defaultExpectation = &PVZRegistryMockExistsExpectation{}
+	}
+
+	if mmExists.defaultExpectation.paramPtrs != nil {
+		mmExists.mock.t.Fatalf("PVZRegistryMock.Exists mock is already set by ExpectParams functions")
+	}
+
+	mmExists.defaultExpectation.params = &PVZRegistryMockExistsParams{ctx, pvzID}
+	mmExists.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExists.expectations {
+		if minimock.Equal(e.params, mmExists.defaultExpectation.params) {
+			mmExists.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExists.defaultExpectation.params)
+		}
+	}
+
+	return mmExists
+}
+
+// ExpectCtxParam1 sets up expected param ctx for PVZRegistry.Exists
+func (mmExists *mPVZRegistryMockExists) ExpectCtxParam1(ctx context.Context) *mPVZRegistryMockExists {
+	if mmExists.mock.funcExists != nil {
+		mmExists.mock.t.Fatalf("PVZRegistryMock.Exists mock is already set by Set")
+	}
+
+	if mmExists.defaultExpectation == nil {
+		mmExists.defaultExpectation = &PVZRegistryMockExistsExpectation{}
+	}
+
+	if mmExists.defaultExpectation.params != nil {
+		mmExists.mock.t.Fatalf("PVZRegistryMock.Exists mock is already set by Expect")
+	}
+
+	if mmExists.defaultExpectation.paramPtrs == nil {
+		mmExists.defaultExpectation.paramPtrs = &PVZRegistryMockExistsParamPtrs{}
+	}
+	mmExists.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExists.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExists
+}
+
+// ExpectPvzIDParam2 sets up expected param pvzID for PVZRegistry.Exists
+func (mmExists *mPVZRegistryMockExists) ExpectPvzIDParam2(pvzID string) *mPVZRegistryMockExists {
+	if mmExists.mock.funcExists != nil {
+		mmExists.mock.t.Fatalf("PVZRegistryMock.Exists mock is already set by Set")
+	}
+
+	if mmExists.defaultExpectation == nil {
+		mmExists.defaultExpectation = &PVZRegistryMockExistsExpectation{}
+	}
+
+	if mmExists.defaultExpectation.params != nil {
+		mmExists.mock.t.Fatalf("PVZRegistryMock.Exists mock is already set by Expect")
+	}
+
+	if mmExists.defaultExpectation.paramPtrs == nil {
+		mmExists.defaultExpectation.paramPtrs = &PVZRegistryMockExistsParamPtrs{}
+	}
+	mmExists.defaultExpectation.paramPtrs.pvzID = &pvzID
+	mmExists.defaultExpectation.expectationOrigins.originPvzID = minimock.CallerInfo(1)
+
+	return mmExists
+}
+
+// Inspect accepts an inspector function that has same arguments as the PVZRegistry.Exists
+func (mmExists *mPVZRegistryMockExists) Inspect(f func(ctx context.Context, pvzID string)) *mPVZRegistryMockExists {
+	if mmExists.mock.inspectFuncExists != nil {
+		mmExists.mock.t.Fatalf("Inspect function is already set for PVZRegistryMock.Exists")
+	}
+
+	mmExists.mock.inspectFuncExists = f
+
+	return mmExists
+}
+
+// Return sets up results that will be returned by PVZRegistry.Exists
+func (mmExists *mPVZRegistryMockExists) Return(b1 bool, err error) *PVZRegistryMock {
+	if mmExists.mock.funcExists != nil {
+		mmExists.mock.t.Fatalf("PVZRegistryMock.Exists mock is already set by Set")
+	}
+
+	if mmExists.defaultExpectation == nil {
+		mmExists.defaultExpectation = &PVZRegistryMockExistsExpectation{mock: mmExists.mock}
+	}
+	mmExists.defaultExpectation.results = &PVZRegistryMockExistsResults{b1, err}
+	mmExists.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExists.mock
+}
+
+// Set uses given function f to mock the PVZRegistry.Exists method
+func (mmExists *mPVZRegistryMockExists) Set(f func(ctx context.Context, pvzID string) (b1 bool, err error)) *PVZRegistryMock {
+	if mmExists.defaultExpectation != nil {
+		mmExists.mock.t.Fatalf("Default expectation is already set for the PVZRegistry.Exists method")
+	}
+
+	if len(mmExists.expectations) > 0 {
+		mmExists.mock.t.Fatalf("Some expectations are already set for the PVZRegistry.Exists method")
+	}
+
+	mmExists.mock.funcExists = f
+	mmExists.mock.funcExistsOrigin = minimock.CallerInfo(1)
+	return mmExists.mock
+}
+
+// When sets expectation for the PVZRegistry.Exists which will trigger the result defined by the following
+// Then helper
+func (mmExists *mPVZRegistryMockExists) When(ctx context.Context, pvzID string) *PVZRegistryMockExistsExpectation {
+	if mmExists.mock.funcExists != nil {
+		mmExists.mock.t.Fatalf("PVZRegistryMock.Exists mock is already set by Set")
+	}
+
+	expectation := &PVZRegistryMockExistsExpectation{
+		mock:               mmExists.mock,
+		params:             &PVZRegistryMockExistsParams{ctx, pvzID},
+		expectationOrigins: PVZRegistryMockExistsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExists.expectations = append(mmExists.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PVZRegistry.Exists return parameters for the expectation previously defined by the When method
+func (e *PVZRegistryMockExistsExpectation) Then(b1 bool, err error) *PVZRegistryMock {
+	e.results = &PVZRegistryMockExistsResults{b1, err}
+	return e.mock
+}
+
+// Times sets number of times PVZRegistry.Exists should be invoked
+func (mmExists *mPVZRegistryMockExists) Times(n uint64) *mPVZRegistryMockExists {
+	if n == 0 {
+		mmExists.mock.t.Fatalf("Times of PVZRegistryMock.Exists mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExists.expectedInvocations, n)
+	mmExists.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExists
+}
+
+func (mmExists *mPVZRegistryMockExists) invocationsDone() bool {
+	if len(mmExists.expectations) == 0 && mmExists.defaultExpectation == nil && mmExists.mock.funcExists == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExists.mock.afterExistsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExists.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Exists implements mm_usecases.PVZRegistry
+func (mmExists *PVZRegistryMock) Exists(ctx context.Context, pvzID string) (b1 bool, err error) {
+	mm_atomic.AddUint64(&mmExists.beforeExistsCounter, 1)
+	defer mm_atomic.AddUint64(&mmExists.afterExistsCounter, 1)
+
+	mmExists.t.Helper()
+
+	if mmExists.inspectFuncExists != nil {
+		mmExists.inspectFuncExists(ctx, pvzID)
+	}
+
+	mm_params := PVZRegistryMockExistsParams{ctx, pvzID}
+
+	// Record call args
+	mmExists.ExistsMock.mutex.Lock()
+	mmExists.ExistsMock.callArgs = append(mmExists.ExistsMock.callArgs, &mm_params)
+	mmExists.ExistsMock.mutex.Unlock()
+
+	for _, e := range mmExists.ExistsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1, e.results.err
+		}
+	}
+
+	if mmExists.ExistsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExists.ExistsMock.defaultExpectation.Counter, 1)
+		mm_want := mmExists.ExistsMock.defaultExpectation.params
+		mm_want_ptrs := mmExists.ExistsMock.defaultExpectation.paramPtrs
+
+		mm_got := PVZRegistryMockExistsParams{ctx, pvzID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExists.t.Errorf("PVZRegistryMock.Exists got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExists.ExistsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.pvzID != nil && !minimock.Equal(*mm_want_ptrs.pvzID, mm_got.pvzID) {
+				mmExists.t.Errorf("PVZRegistryMock.Exists got unexpected parameter pvzID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExists.ExistsMock.defaultExpectation.expectationOrigins.originPvzID, *mm_want_ptrs.pvzID, mm_got.pvzID, minimock.Diff(*mm_want_ptrs.pvzID, mm_got.pvzID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExists.t.Errorf("PVZRegistryMock.Exists got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExists.ExistsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExists.ExistsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExists.t.Fatal("No results are set for the PVZRegistryMock.Exists")
+		}
+		return (*mm_results).b1, (*mm_results).err
+	}
+	if mmExists.funcExists != nil {
+		return mmExists.funcExists(ctx, pvzID)
+	}
+	mmExists.t.Fatalf("Unexpected call to PVZRegistryMock.Exists. %v %v", ctx, pvzID)
+	return
+}
+
+// ExistsAfterCounter returns a count of finished PVZRegistryMock.Exists invocations
+func (mmExists *PVZRegistryMock) ExistsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExists.afterExistsCounter)
+}
+
+// ExistsBeforeCounter returns a count of PVZRegistryMock.Exists invocations
+func (mmExists *PVZRegistryMock) ExistsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExists.beforeExistsCounter)
+}
+
+// Calls returns a list of arguments used in each call to PVZRegistryMock.Exists.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExists *mPVZRegistryMockExists) Calls() []*PVZRegistryMockExistsParams {
+	mmExists.mutex.RLock()
+
+	argCopy := make([]*PVZRegistryMockExistsParams, len(mmExists.callArgs))
+	copy(argCopy, mmExists.callArgs)
+
+	mmExists.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExistsDone returns true if the count of the Exists invocations corresponds
+// the number of defined expectations
+func (m *PVZRegistryMock) MinimockExistsDone() bool {
+	if m.ExistsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExistsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExistsMock.invocationsDone()
+}
+
+// MinimockExistsInspect logs each unmet expectation
+func (m *PVZRegistryMock) MinimockExistsInspect() {
+	for _, e := range m.ExistsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PVZRegistryMock.Exists at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExistsCounter := mm_atomic.LoadUint64(&m.afterExistsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExistsMock.defaultExpectation != nil && afterExistsCounter < 1 {
+		if m.ExistsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PVZRegistryMock.Exists at\n%s", m.ExistsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PVZRegistryMock.Exists at\n%s with params: %#v", m.ExistsMock.defaultExpectation.expectationOrigins.origin, *m.ExistsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExists != nil && afterExistsCounter < 1 {
+		m.t.Errorf("Expected call to PVZRegistryMock.Exists at\n%s", m.funcExistsOrigin)
+	}
+
+	if !m.ExistsMock.invocationsDone() && afterExistsCounter > 0 {
+		m.t.Errorf("Expected %d calls to PVZRegistryMock.Exists at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExistsMock.expectedInvocations), m.ExistsMock.expectedInvocationsOrigin, afterExistsCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PVZRegistryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockExistsInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PVZRegistryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PVZRegistryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockExistsDone()
+}
@@ -0,0 +1,1190 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"homework/internal/domain"
+	"sync"
+	mm_atomic "sync/atomic"
+	"time"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// ReturnShipmentRepositoryMock implements mm_usecases.ReturnShipmentRepository
+type ReturnShipmentRepositoryMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateReturnShipment          func(ctx context.Context, shipment domain.ReturnShipment) (err error)
+	funcCreateReturnShipmentOrigin    string
+	inspectFuncCreateReturnShipment   func(ctx context.Context, shipment domain.ReturnShipment)
+	afterCreateReturnShipmentCounter  uint64
+	beforeCreateReturnShipmentCounter uint64
+	CreateReturnShipmentMock          mReturnShipmentRepositoryMockCreateReturnShipment
+
+	funcDispatchReturnShipment          func(ctx context.Context, shipmentID string, courierID string, dispatchedAt time.Time) (r1 domain.ReturnManifest, err error)
+	funcDispatchReturnShipmentOrigin    string
+	inspectFuncDispatchReturnShipment   func(ctx context.Context, shipmentID string, courierID string, dispatchedAt time.Time)
+	afterDispatchReturnShipmentCounter  uint64
+	beforeDispatchReturnShipmentCounter uint64
+	DispatchReturnShipmentMock          mReturnShipmentRepositoryMockDispatchReturnShipment
+
+	funcGetReturnShipment          func(ctx context.Context, shipmentID string) (r1 domain.ReturnShipment, err error)
+	funcGetReturnShipmentOrigin    string
+	inspectFuncGetReturnShipment   func(ctx context.Context, shipmentID string)
+	afterGetReturnShipmentCounter  uint64
+	beforeGetReturnShipmentCounter uint64
+	GetReturnShipmentMock          mReturnShipmentRepositoryMockGetReturnShipment
+}
+
+// NewReturnShipmentRepositoryMock returns a mock for mm_usecases.ReturnShipmentRepository
+func NewReturnShipmentRepositoryMock(t minimock.Tester) *ReturnShipmentRepositoryMock {
+	m := &ReturnShipmentRepositoryMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateReturnShipmentMock = mReturnShipmentRepositoryMockCreateReturnShipment{mock: m}
+	m.CreateReturnShipmentMock.callArgs = []*ReturnShipmentRepositoryMockCreateReturnShipmentParams{}
+
+	m.DispatchReturnShipmentMock = mReturnShipmentRepositoryMockDispatchReturnShipment{mock: m}
+	m.DispatchReturnShipmentMock.callArgs = []*ReturnShipmentRepositoryMockDispatchReturnShipmentParams{}
+
+	m.GetReturnShipmentMock = mReturnShipmentRepositoryMockGetReturnShipment{mock: m}
+	m.GetReturnShipmentMock.callArgs = []*ReturnShipmentRepositoryMockGetReturnShipmentParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mReturnShipmentRepositoryMockCreateReturnShipment struct {
+	optional           bool
+	mock               *ReturnShipmentRepositoryMock
+	defaultExpectation *ReturnShipmentRepositoryMockCreateReturnShipmentExpectation
+	expectations       []*ReturnShipmentRepositoryMockCreateReturnShipmentExpectation
+
+	callArgs []*ReturnShipmentRepositoryMockCreateReturnShipmentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ReturnShipmentRepositoryMockCreateReturnShipmentExpectation specifies expectation struct of the ReturnShipmentRepository.CreateReturnShipment
+type ReturnShipmentRepositoryMockCreateReturnShipmentExpectation struct {
+	mock               *ReturnShipmentRepositoryMock
+	params             *ReturnShipmentRepositoryMockCreateReturnShipmentParams
+	paramPtrs          *ReturnShipmentRepositoryMockCreateReturnShipmentParamPtrs
+	expectationOrigins ReturnShipmentRepositoryMockCreateReturnShipmentExpectationOrigins
+	results            *ReturnShipmentRepositoryMockCreateReturnShipmentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ReturnShipmentRepositoryMockCreateReturnShipmentParams contains parameters of the ReturnShipmentRepository.CreateReturnShipment
+type ReturnShipmentRepositoryMockCreateReturnShipmentParams struct {
+	ctx      context.Context
+	shipment domain.ReturnShipment
+}
+
+// ReturnShipmentRepositoryMockCreateReturnShipmentParamPtrs contains pointers to parameters of the ReturnShipmentRepository.CreateReturnShipment
+type ReturnShipmentRepositoryMockCreateReturnShipmentParamPtrs struct {
+	ctx      *context.Context
+	shipment *domain.ReturnShipment
+}
+
+// ReturnShipmentRepositoryMockCreateReturnShipmentResults contains results of the ReturnShipmentRepository.CreateReturnShipment
+type ReturnShipmentRepositoryMockCreateReturnShipmentResults struct {
+	err error
+}
+
+// ReturnShipmentRepositoryMockCreateReturnShipmentOrigins contains origins of expectations of the ReturnShipmentRepository.CreateReturnShipment
+type ReturnShipmentRepositoryMockCreateReturnShipmentExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originShipment string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateReturnShipment *mReturnShipmentRepositoryMockCreateReturnShipment) Optional() *mReturnShipmentRepositoryMockCreateReturnShipment {
+	mmCreateReturnShipment.optional = true
+	return mmCreateReturnShipment
+}
+
+// Expect sets up expected params for ReturnShipmentRepository.CreateReturnShipment
+func (mmCreateReturnShipment *mReturnShipmentRepositoryMockCreateReturnShipment) Expect(ctx context.Context, shipment domain.ReturnShipment) *mReturnShipmentRepositoryMockCreateReturnShipment {
+	if mmCreateReturnShipment.mock.funcCreateReturnShipment != nil {
+		mmCreateReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.CreateReturnShipment mock is already set by Set")
+	}
+
+	if mmCreateReturnShipment.defaultExpectation == nil {
+		mmCreateReturnShipment.defaultExpectation = &ReturnShipmentRepositoryMockCreateReturnShipmentExpectation{}
+	}
+
+	if mmCreateReturnShipment.defaultExpectation.paramPtrs != nil {
+		mmCreateReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.CreateReturnShipment mock is already set by ExpectParams functions")
+	}
+
+	mmCreateReturnShipment.defaultExpectation.params = &ReturnShipmentRepositoryMockCreateReturnShipmentParams{ctx, shipment}
+	mmCreateReturnShipment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateReturnShipment.expectations {
+		if minimock.Equal(e.params, mmCreateReturnShipment.defaultExpectation.params) {
+			mmCreateReturnShipment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateReturnShipment.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateReturnShipment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReturnShipmentRepository.CreateReturnShipment
+func (mmCreateReturnShipment *mReturnShipmentRepositoryMockCreateReturnShipment) ExpectCtxParam1(ctx context.Context) *mReturnShipmentRepositoryMockCreateReturnShipment {
+	if mmCreateReturnShipment.mock.funcCreateReturnShipment != nil {
+		mmCreateReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.CreateReturnShipment mock is already set by Set")
+	}
+
+	if mmCreateReturnShipment.defaultExpectation == nil {
+		mmCreateReturnShipment.defaultExpectation = &ReturnShipmentRepositoryMockCreateReturnShipmentExpectation{}
+	}
+
+	if mmCreateReturnShipment.defaultExpectation.params != nil {
+		mmCreateReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.CreateReturnShipment mock is already set by Expect")
+	}
+
+	if mmCreateReturnShipment.defaultExpectation.paramPtrs == nil {
+		mmCreateReturnShipment.defaultExpectation.paramPtrs = &ReturnShipmentRepositoryMockCreateReturnShipmentParamPtrs{}
+	}
+	mmCreateReturnShipment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateReturnShipment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateReturnShipment
+}
+
+// ExpectShipmentParam2 sets up expected param shipment for ReturnShipmentRepository.CreateReturnShipment
+func (mmCreateReturnShipment *mReturnShipmentRepositoryMockCreateReturnShipment) ExpectShipmentParam2(shipment domain.ReturnShipment) *mReturnShipmentRepositoryMockCreateReturnShipment {
+	if mmCreateReturnShipment.mock.funcCreateReturnShipment != nil {
+		mmCreateReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.CreateReturnShipment mock is already set by Set")
+	}
+
+	if mmCreateReturnShipment.defaultExpectation == nil {
+		mmCreateReturnShipment.defaultExpectation = &ReturnShipmentRepositoryMockCreateReturnShipmentExpectation{}
+	}
+
+	if mmCreateReturnShipment.defaultExpectation.params != nil {
+		mmCreateReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.CreateReturnShipment mock is already set by Expect")
+	}
+
+	if mmCreateReturnShipment.defaultExpectation.paramPtrs == nil {
+		mmCreateReturnShipment.defaultExpectation.paramPtrs = &ReturnShipmentRepositoryMockCreateReturnShipmentParamPtrs{}
+	}
+	mmCreateReturnShipment.defaultExpectation.paramPtrs.shipment = &shipment
+	mmCreateReturnShipment.defaultExpectation.expectationOrigins.originShipment = minimock.CallerInfo(1)
+
+	return mmCreateReturnShipment
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReturnShipmentRepository.CreateReturnShipment
+func (mmCreateReturnShipment *mReturnShipmentRepositoryMockCreateReturnShipment) Inspect(f func(ctx context.Context, shipment domain.ReturnShipment)) *mReturnShipmentRepositoryMockCreateReturnShipment {
+	if mmCreateReturnShipment.mock.inspectFuncCreateReturnShipment != nil {
+		mmCreateReturnShipment.mock.t.Fatalf("Inspect function is already set for ReturnShipmentRepositoryMock.CreateReturnShipment")
+	}
+
+	mmCreateReturnShipment.mock.inspectFuncCreateReturnShipment = f
+
+	return mmCreateReturnShipment
+}
+
+// Return sets up results that will be returned by ReturnShipmentRepository.CreateReturnShipment
+func (mmCreateReturnShipment *mReturnShipmentRepositoryMockCreateReturnShipment) Return(err error) *ReturnShipmentRepositoryMock {
+	if mmCreateReturnShipment.mock.funcCreateReturnShipment != nil {
+		mmCreateReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.CreateReturnShipment mock is already set by Set")
+	}
+
+	if mmCreateReturnShipment.defaultExpectation == nil {
+		mmCreateReturnShipment.defaultExpectation = &ReturnShipmentRepositoryMockCreateReturnShipmentExpectation{mock: mmCreateReturnShipment.mock}
+	}
+	mmCreateReturnShipment.defaultExpectation.results = &ReturnShipmentRepositoryMockCreateReturnShipmentResults{err}
+	mmCreateReturnShipment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateReturnShipment.mock
+}
+
+// Set uses given function f to mock the ReturnShipmentRepository.CreateReturnShipment method
+func (mmCreateReturnShipment *mReturnShipmentRepositoryMockCreateReturnShipment) Set(f func(ctx context.Context, shipment domain.ReturnShipment) (err error)) *ReturnShipmentRepositoryMock {
+	if mmCreateReturnShipment.defaultExpectation != nil {
+		mmCreateReturnShipment.mock.t.Fatalf("Default expectation is already set for the ReturnShipmentRepository.CreateReturnShipment method")
+	}
+
+	if len(mmCreateReturnShipment.expectations) > 0 {
+		mmCreateReturnShipment.mock.t.Fatalf("Some expectations are already set for the ReturnShipmentRepository.CreateReturnShipment method")
+	}
+
+	mmCreateReturnShipment.mock.funcCreateReturnShipment = f
+	mmCreateReturnShipment.mock.funcCreateReturnShipmentOrigin = minimock.CallerInfo(1)
+	return mmCreateReturnShipment.mock
+}
+
+// When sets expectation for the ReturnShipmentRepository.CreateReturnShipment which will trigger the result defined by the following
+// Then helper
+func (mmCreateReturnShipment *mReturnShipmentRepositoryMockCreateReturnShipment) When(ctx context.Context, shipment domain.ReturnShipment) *ReturnShipmentRepositoryMockCreateReturnShipmentExpectation {
+	if mmCreateReturnShipment.mock.funcCreateReturnShipment != nil {
+		mmCreateReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.CreateReturnShipment mock is already set by Set")
+	}
+
+	expectation := &ReturnShipmentRepositoryMockCreateReturnShipmentExpectation{
+		mock:               mmCreateReturnShipment.mock,
+		params:             &ReturnShipmentRepositoryMockCreateReturnShipmentParams{ctx, shipment},
+		expectationOrigins: ReturnShipmentRepositoryMockCreateReturnShipmentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateReturnShipment.expectations = append(mmCreateReturnShipment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReturnShipmentRepository.CreateReturnShipment return parameters for the expectation previously defined by the When method
+func (e *ReturnShipmentRepositoryMockCreateReturnShipmentExpectation) Then(err error) *ReturnShipmentRepositoryMock {
+	e.results = &ReturnShipmentRepositoryMockCreateReturnShipmentResults{err}
+	return e.mock
+}
+
+// Times sets number of times ReturnShipmentRepository.CreateReturnShipment should be invoked
+func (mmCreateReturnShipment *mReturnShipmentRepositoryMockCreateReturnShipment) Times(n uint64) *mReturnShipmentRepositoryMockCreateReturnShipment {
+	if n == 0 {
+		mmCreateReturnShipment.mock.t.Fatalf("Times of ReturnShipmentRepositoryMock.CreateReturnShipment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateReturnShipment.expectedInvocations, n)
+	mmCreateReturnShipment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateReturnShipment
+}
+
+func (mmCreateReturnShipment *mReturnShipmentRepositoryMockCreateReturnShipment) invocationsDone() bool {
+	if len(mmCreateReturnShipment.expectations) == 0 && mmCreateReturnShipment.defaultExpectation == nil && mmCreateReturnShipment.mock.funcCreateReturnShipment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateReturnShipment.mock.afterCreateReturnShipmentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateReturnShipment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateReturnShipment implements mm_usecases.ReturnShipmentRepository
+func (mmCreateReturnShipment *ReturnShipmentRepositoryMock) CreateReturnShipment(ctx context.Context, shipment domain.ReturnShipment) (err error) {
+	mm_atomic.AddUint64(&mmCreateReturnShipment.beforeCreateReturnShipmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateReturnShipment.afterCreateReturnShipmentCounter, 1)
+
+	mmCreateReturnShipment.t.Helper()
+
+	if mmCreateReturnShipment.inspectFuncCreateReturnShipment != nil {
+		mmCreateReturnShipment.inspectFuncCreateReturnShipment(ctx, shipment)
+	}
+
+	mm_params := ReturnShipmentRepositoryMockCreateReturnShipmentParams{ctx, shipment}
+
+	// Record call args
+	mmCreateReturnShipment.CreateReturnShipmentMock.mutex.Lock()
+	mmCreateReturnShipment.CreateReturnShipmentMock.callArgs = append(mmCreateReturnShipment.CreateReturnShipmentMock.callArgs, &mm_params)
+	mmCreateReturnShipment.CreateReturnShipmentMock.mutex.Unlock()
+
+	for _, e := range mmCreateReturnShipment.CreateReturnShipmentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateReturnShipment.CreateReturnShipmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateReturnShipment.CreateReturnShipmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateReturnShipment.CreateReturnShipmentMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateReturnShipment.CreateReturnShipmentMock.defaultExpectation.paramPtrs
+
+		mm_got := ReturnShipmentRepositoryMockCreateReturnShipmentParams{ctx, shipment}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateReturnShipment.t.Errorf("ReturnShipmentRepositoryMock.CreateReturnShipment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateReturnShipment.CreateReturnShipmentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.shipment != nil && !minimock.Equal(*mm_want_ptrs.shipment, mm_got.shipment) {
+				mmCreateReturnShipment.t.Errorf("ReturnShipmentRepositoryMock.CreateReturnShipment got unexpected parameter shipment, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateReturnShipment.CreateReturnShipmentMock.defaultExpectation.expectationOrigins.originShipment, *mm_want_ptrs.shipment, mm_got.shipment, minimock.Diff(*mm_want_ptrs.shipment, mm_got.shipment))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateReturnShipment.t.Errorf("ReturnShipmentRepositoryMock.CreateReturnShipment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateReturnShipment.CreateReturnShipmentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateReturnShipment.CreateReturnShipmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateReturnShipment.t.Fatal("No results are set for the ReturnShipmentRepositoryMock.CreateReturnShipment")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateReturnShipment.funcCreateReturnShipment != nil {
+		return mmCreateReturnShipment.funcCreateReturnShipment(ctx, shipment)
+	}
+	mmCreateReturnShipment.t.Fatalf("Unexpected call to ReturnShipmentRepositoryMock.CreateReturnShipment. %v %v", ctx, shipment)
+	return
+}
+
+// CreateReturnShipmentAfterCounter returns a count of finished ReturnShipmentRepositoryMock.CreateReturnShipment invocations
+func (mmCreateReturnShipment *ReturnShipmentRepositoryMock) CreateReturnShipmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateReturnShipment.afterCreateReturnShipmentCounter)
+}
+
+// CreateReturnShipmentBeforeCounter returns a count of ReturnShipmentRepositoryMock.CreateReturnShipment invocations
+func (mmCreateReturnShipment *ReturnShipmentRepositoryMock) CreateReturnShipmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateReturnShipment.beforeCreateReturnShipmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReturnShipmentRepositoryMock.CreateReturnShipment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateReturnShipment *mReturnShipmentRepositoryMockCreateReturnShipment) Calls() []*ReturnShipmentRepositoryMockCreateReturnShipmentParams {
+	mmCreateReturnShipment.mutex.RLock()
+
+	argCopy := make([]*ReturnShipmentRepositoryMockCreateReturnShipmentParams, len(mmCreateReturnShipment.callArgs))
+	copy(argCopy, mmCreateReturnShipment.callArgs)
+
+	mmCreateReturnShipment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateReturnShipmentDone returns true if the count of the CreateReturnShipment invocations corresponds
+// the number of defined expectations
+func (m *ReturnShipmentRepositoryMock) MinimockCreateReturnShipmentDone() bool {
+	if m.CreateReturnShipmentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateReturnShipmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateReturnShipmentMock.invocationsDone()
+}
+
+// MinimockCreateReturnShipmentInspect logs each unmet expectation
+func (m *ReturnShipmentRepositoryMock) MinimockCreateReturnShipmentInspect() {
+	for _, e := range m.CreateReturnShipmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReturnShipmentRepositoryMock.CreateReturnShipment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateReturnShipmentCounter := mm_atomic.LoadUint64(&m.afterCreateReturnShipmentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateReturnShipmentMock.defaultExpectation != nil && afterCreateReturnShipmentCounter < 1 {
+		if m.CreateReturnShipmentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReturnShipmentRepositoryMock.CreateReturnShipment at\n%s", m.CreateReturnShipmentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ReturnShipmentRepositoryMock.CreateReturnShipment at\n%s with params: %#v", m.CreateReturnShipmentMock.defaultExpectation.expectationOrigins.origin, *m.CreateReturnShipmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateReturnShipment != nil && afterCreateReturnShipmentCounter < 1 {
+		m.t.Errorf("Expected call to ReturnShipmentRepositoryMock.CreateReturnShipment at\n%s", m.funcCreateReturnShipmentOrigin)
+	}
+
+	if !m.CreateReturnShipmentMock.invocationsDone() && afterCreateReturnShipmentCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReturnShipmentRepositoryMock.CreateReturnShipment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateReturnShipmentMock.expectedInvocations), m.CreateReturnShipmentMock.expectedInvocationsOrigin, afterCreateReturnShipmentCounter)
+	}
+}
+
+type mReturnShipmentRepositoryMockDispatchReturnShipment struct {
+	optional           bool
+	mock               *ReturnShipmentRepositoryMock
+	defaultExpectation *ReturnShipmentRepositoryMockDispatchReturnShipmentExpectation
+	expectations       []*ReturnShipmentRepositoryMockDispatchReturnShipmentExpectation
+
+	callArgs []*ReturnShipmentRepositoryMockDispatchReturnShipmentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ReturnShipmentRepositoryMockDispatchReturnShipmentExpectation specifies expectation struct of the ReturnShipmentRepository.DispatchReturnShipment
+type ReturnShipmentRepositoryMockDispatchReturnShipmentExpectation struct {
+	mock               *ReturnShipmentRepositoryMock
+	params             *ReturnShipmentRepositoryMockDispatchReturnShipmentParams
+	paramPtrs          *ReturnShipmentRepositoryMockDispatchReturnShipmentParamPtrs
+	expectationOrigins ReturnShipmentRepositoryMockDispatchReturnShipmentExpectationOrigins
+	results            *ReturnShipmentRepositoryMockDispatchReturnShipmentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ReturnShipmentRepositoryMockDispatchReturnShipmentParams contains parameters of the ReturnShipmentRepository.DispatchReturnShipment
+type ReturnShipmentRepositoryMockDispatchReturnShipmentParams struct {
+	ctx          context.Context
+	shipmentID   string
+	courierID    string
+	dispatchedAt time.Time
+}
+
+// ReturnShipmentRepositoryMockDispatchReturnShipmentParamPtrs contains pointers to parameters of the ReturnShipmentRepository.DispatchReturnShipment
+type ReturnShipmentRepositoryMockDispatchReturnShipmentParamPtrs struct {
+	ctx          *context.Context
+	shipmentID   *string
+	courierID    *string
+	dispatchedAt *time.Time
+}
+
+// ReturnShipmentRepositoryMockDispatchReturnShipmentResults contains results of the ReturnShipmentRepository.DispatchReturnShipment
+type ReturnShipmentRepositoryMockDispatchReturnShipmentResults struct {
+	r1  domain.ReturnManifest
+	err error
+}
+
+// ReturnShipmentRepositoryMockDispatchReturnShipmentOrigins contains origins of expectations of the ReturnShipmentRepository.DispatchReturnShipment
+type ReturnShipmentRepositoryMockDispatchReturnShipmentExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originShipmentID   string
+	originCourierID    string
+	originDispatchedAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDispatchReturnShipment *mReturnShipmentRepositoryMockDispatchReturnShipment) Optional() *mReturnShipmentRepositoryMockDispatchReturnShipment {
+	mmDispatchReturnShipment.optional = true
+	return mmDispatchReturnShipment
+}
+
+// Expect sets up expected params for ReturnShipmentRepository.DispatchReturnShipment
+func (mmDispatchReturnShipment *mReturnShipmentRepositoryMockDispatchReturnShipment) Expect(ctx context.Context, shipmentID string, courierID string, dispatchedAt time.Time) *mReturnShipmentRepositoryMockDispatchReturnShipment {
+	if mmDispatchReturnShipment.mock.funcDispatchReturnShipment != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.DispatchReturnShipment mock is already set by Set")
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation == nil {
+		mmDispatchReturnShipment.defaultExpectation = &ReturnShipmentRepositoryMockDispatchReturnShipmentExpectation{}
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation.paramPtrs != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.DispatchReturnShipment mock is already set by ExpectParams functions")
+	}
+
+	mmDispatchReturnShipment.defaultExpectation.params = &ReturnShipmentRepositoryMockDispatchReturnShipmentParams{ctx, shipmentID, courierID, dispatchedAt}
+	mmDispatchReturnShipment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDispatchReturnShipment.expectations {
+		if minimock.Equal(e.params, mmDispatchReturnShipment.defaultExpectation.params) {
+			mmDispatchReturnShipment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDispatchReturnShipment.defaultExpectation.params)
+		}
+	}
+
+	return mmDispatchReturnShipment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReturnShipmentRepository.DispatchReturnShipment
+func (mmDispatchReturnShipment *mReturnShipmentRepositoryMockDispatchReturnShipment) ExpectCtxParam1(ctx context.Context) *mReturnShipmentRepositoryMockDispatchReturnShipment {
+	if mmDispatchReturnShipment.mock.funcDispatchReturnShipment != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.DispatchReturnShipment mock is already set by Set")
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation == nil {
+		mmDispatchReturnShipment.defaultExpectation = &ReturnShipmentRepositoryMockDispatchReturnShipmentExpectation{}
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation.params != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.DispatchReturnShipment mock is already set by Expect")
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation.paramPtrs == nil {
+		mmDispatchReturnShipment.defaultExpectation.paramPtrs = &ReturnShipmentRepositoryMockDispatchReturnShipmentParamPtrs{}
+	}
+	mmDispatchReturnShipment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDispatchReturnShipment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDispatchReturnShipment
+}
+
+// ExpectShipmentIDParam2 sets up expected param shipmentID for ReturnShipmentRepository.DispatchReturnShipment
+func (mmDispatchReturnShipment *mReturnShipmentRepositoryMockDispatchReturnShipment) ExpectShipmentIDParam2(shipmentID string) *mReturnShipmentRepositoryMockDispatchReturnShipment {
+	if mmDispatchReturnShipment.mock.funcDispatchReturnShipment != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.DispatchReturnShipment mock is already set by Set")
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation == nil {
+		mmDispatchReturnShipment.defaultExpectation = &ReturnShipmentRepositoryMockDispatchReturnShipmentExpectation{}
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation.params != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.DispatchReturnShipment mock is already set by Expect")
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation.paramPtrs == nil {
+		mmDispatchReturnShipment.defaultExpectation.paramPtrs = &ReturnShipmentRepositoryMockDispatchReturnShipmentParamPtrs{}
+	}
+	mmDispatchReturnShipment.defaultExpectation.paramPtrs.shipmentID = &shipmentID
+	mmDispatchReturnShipment.defaultExpectation.expectationOrigins.originShipmentID = minimock.CallerInfo(1)
+
+	return mmDispatchReturnShipment
+}
+
+// ExpectCourierIDParam3 sets up expected param courierID for ReturnShipmentRepository.DispatchReturnShipment
+func (mmDispatchReturnShipment *mReturnShipmentRepositoryMockDispatchReturnShipment) ExpectCourierIDParam3(courierID string) *mReturnShipmentRepositoryMockDispatchReturnShipment {
+	if mmDispatchReturnShipment.mock.funcDispatchReturnShipment != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.DispatchReturnShipment mock is already set by Set")
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation == nil {
+		mmDispatchReturnShipment.defaultExpectation = &ReturnShipmentRepositoryMockDispatchReturnShipmentExpectation{}
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation.params != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.DispatchReturnShipment mock is already set by Expect")
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation.paramPtrs == nil {
+		mmDispatchReturnShipment.defaultExpectation.paramPtrs = &ReturnShipmentRepositoryMockDispatchReturnShipmentParamPtrs{}
+	}
+	mmDispatchReturnShipment.defaultExpectation.paramPtrs.courierID = &courierID
+	mmDispatchReturnShipment.defaultExpectation.expectationOrigins.originCourierID = minimock.CallerInfo(1)
+
+	return mmDispatchReturnShipment
+}
+
+// ExpectDispatchedAtParam4 sets up expected param dispatchedAt for ReturnShipmentRepository.DispatchReturnShipment
+func (mmDispatchReturnShipment *mReturnShipmentRepositoryMockDispatchReturnShipment) ExpectDispatchedAtParam4(dispatchedAt time.Time) *mReturnShipmentRepositoryMockDispatchReturnShipment {
+	if mmDispatchReturnShipment.mock.funcDispatchReturnShipment != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.DispatchReturnShipment mock is already set by Set")
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation == nil {
+		mmDispatchReturnShipment.defaultExpectation = &ReturnShipmentRepositoryMockDispatchReturnShipmentExpectation{}
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation.params != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.DispatchReturnShipment mock is already set by Expect")
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation.paramPtrs == nil {
+		mmDispatchReturnShipment.defaultExpectation.paramPtrs = &ReturnShipmentRepositoryMockDispatchReturnShipmentParamPtrs{}
+	}
+	mmDispatchReturnShipment.defaultExpectation.paramPtrs.dispatchedAt = &dispatchedAt
+	mmDispatchReturnShipment.defaultExpectation.expectationOrigins.originDispatchedAt = minimock.CallerInfo(1)
+
+	return mmDispatchReturnShipment
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReturnShipmentRepository.DispatchReturnShipment
+func (mmDispatchReturnShipment *mReturnShipmentRepositoryMockDispatchReturnShipment) Inspect(f func(ctx context.Context, shipmentID string, courierID string, dispatchedAt time.Time)) *mReturnShipmentRepositoryMockDispatchReturnShipment {
+	if mmDispatchReturnShipment.mock.inspectFuncDispatchReturnShipment != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("Inspect function is already set for ReturnShipmentRepositoryMock.DispatchReturnShipment")
+	}
+
+	mmDispatchReturnShipment.mock.inspectFuncDispatchReturnShipment = f
+
+	return mmDispatchReturnShipment
+}
+
+// Return sets up results that will be returned by ReturnShipmentRepository.DispatchReturnShipment
+func (mmDispatchReturnShipment *mReturnShipmentRepositoryMockDispatchReturnShipment) Return(r1 domain.ReturnManifest, err error) *ReturnShipmentRepositoryMock {
+	if mmDispatchReturnShipment.mock.funcDispatchReturnShipment != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.DispatchReturnShipment mock is already set by Set")
+	}
+
+	if mmDispatchReturnShipment.defaultExpectation == nil {
+		mmDispatchReturnShipment.defaultExpectation = &ReturnShipmentRepositoryMockDispatchReturnShipmentExpectation{mock: mmDispatchReturnShipment.mock}
+	}
+	mmDispatchReturnShipment.defaultExpectation.results = &ReturnShipmentRepositoryMockDispatchReturnShipmentResults{r1, err}
+	mmDispatchReturnShipment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDispatchReturnShipment.mock
+}
+
+// Set uses given function f to mock the ReturnShipmentRepository.DispatchReturnShipment method
+func (mmDispatchReturnShipment *mReturnShipmentRepositoryMockDispatchReturnShipment) Set(f func(ctx context.Context, shipmentID string, courierID string, dispatchedAt time.Time) (r1 domain.ReturnManifest, err error)) *ReturnShipmentRepositoryMock {
+	if mmDispatchReturnShipment.defaultExpectation != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("Default expectation is already set for the ReturnShipmentRepository.DispatchReturnShipment method")
+	}
+
+	if len(mmDispatchReturnShipment.expectations) > 0 {
+		mmDispatchReturnShipment.mock.t.Fatalf("Some expectations are already set for the ReturnShipmentRepository.DispatchReturnShipment method")
+	}
+
+	mmDispatchReturnShipment.mock.funcDispatchReturnShipment = f
+	mmDispatchReturnShipment.mock.funcDispatchReturnShipmentOrigin = minimock.CallerInfo(1)
+	return mmDispatchReturnShipment.mock
+}
+
+// When sets expectation for the ReturnShipmentRepository.DispatchReturnShipment which will trigger the result defined by the following
+// Then helper
+func (mmDispatchReturnShipment *mReturnShipmentRepositoryMockDispatchReturnShipment) When(ctx context.Context, shipmentID string, courierID string, dispatchedAt time.Time) *ReturnShipmentRepositoryMockDispatchReturnShipmentExpectation {
+	if mmDispatchReturnShipment.mock.funcDispatchReturnShipment != nil {
+		mmDispatchReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.DispatchReturnShipment mock is already set by Set")
+	}
+
+	expectation := &ReturnShipmentRepositoryMockDispatchReturnShipmentExpectation{
+		mock:               mmDispatchReturnShipment.mock,
+		params:             &ReturnShipmentRepositoryMockDispatchReturnShipmentParams{ctx, shipmentID, courierID, dispatchedAt},
+		expectationOrigins: ReturnShipmentRepositoryMockDispatchReturnShipmentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDispatchReturnShipment.expectations = append(mmDispatchReturnShipment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReturnShipmentRepository.DispatchReturnShipment return parameters for the expectation previously defined by the When method
+func (e *ReturnShipmentRepositoryMockDispatchReturnShipmentExpectation) Then(r1 domain.ReturnManifest, err error) *ReturnShipmentRepositoryMock {
+	e.results = &ReturnShipmentRepositoryMockDispatchReturnShipmentResults{r1, err}
+	return e.mock
+}
+
+// Times sets number of times ReturnShipmentRepository.DispatchReturnShipment should be invoked
+func (mmDispatchReturnShipment *mReturnShipmentRepositoryMockDispatchReturnShipment) Times(n uint64) *mReturnShipmentRepositoryMockDispatchReturnShipment {
+	if n == 0 {
+		mmDispatchReturnShipment.mock.t.Fatalf("Times of ReturnShipmentRepositoryMock.DispatchReturnShipment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDispatchReturnShipment.expectedInvocations, n)
+	mmDispatchReturnShipment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDispatchReturnShipment
+}
+
+func (mmDispatchReturnShipment *mReturnShipmentRepositoryMockDispatchReturnShipment) invocationsDone() bool {
+	if len(mmDispatchReturnShipment.expectations) == 0 && mmDispatchReturnShipment.defaultExpectation == nil && mmDispatchReturnShipment.mock.funcDispatchReturnShipment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDispatchReturnShipment.mock.afterDispatchReturnShipmentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDispatchReturnShipment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DispatchReturnShipment implements mm_usecases.ReturnShipmentRepository
+func (mmDispatchReturnShipment *ReturnShipmentRepositoryMock) DispatchReturnShipment(ctx context.Context, shipmentID string, courierID string, dispatchedAt time.Time) (r1 domain.ReturnManifest, err error) {
+	mm_atomic.AddUint64(&mmDispatchReturnShipment.beforeDispatchReturnShipmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmDispatchReturnShipment.afterDispatchReturnShipmentCounter, 1)
+
+	mmDispatchReturnShipment.t.Helper()
+
+	if mmDispatchReturnShipment.inspectFuncDispatchReturnShipment != nil {
+		mmDispatchReturnShipment.inspectFuncDispatchReturnShipment(ctx, shipmentID, courierID, dispatchedAt)
+	}
+
+	mm_params := ReturnShipmentRepositoryMockDispatchReturnShipmentParams{ctx, shipmentID, courierID, dispatchedAt}
+
+	// Record call args
+	mmDispatchReturnShipment.DispatchReturnShipmentMock.mutex.Lock()
+	mmDispatchReturnShipment.DispatchReturnShipmentMock.callArgs = append(mmDispatchReturnShipment.DispatchReturnShipmentMock.callArgs, &mm_params)
+	mmDispatchReturnShipment.DispatchReturnShipmentMock.mutex.Unlock()
+
+	for _, e := range mmDispatchReturnShipment.DispatchReturnShipmentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.r1, e.results.err
+		}
+	}
+
+	if mmDispatchReturnShipment.DispatchReturnShipmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDispatchReturnShipment.DispatchReturnShipmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmDispatchReturnShipment.DispatchReturnShipmentMock.defaultExpectation.params
+		mm_want_ptrs := mmDispatchReturnShipment.DispatchReturnShipmentMock.defaultExpectation.paramPtrs
+
+		mm_got := ReturnShipmentRepositoryMockDispatchReturnShipmentParams{ctx, shipmentID, courierID, dispatchedAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDispatchReturnShipment.t.Errorf("ReturnShipmentRepositoryMock.DispatchReturnShipment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDispatchReturnShipment.DispatchReturnShipmentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.shipmentID != nil && !minimock.Equal(*mm_want_ptrs.shipmentID, mm_got.shipmentID) {
+				mmDispatchReturnShipment.t.Errorf("ReturnShipmentRepositoryMock.DispatchReturnShipment got unexpected parameter shipmentID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDispatchReturnShipment.DispatchReturnShipmentMock.defaultExpectation.expectationOrigins.originShipmentID, *mm_want_ptrs.shipmentID, mm_got.shipmentID, minimock.Diff(*mm_want_ptrs.shipmentID, mm_got.shipmentID))
+			}
+
+			if mm_want_ptrs.courierID != nil && !minimock.Equal(*mm_want_ptrs.courierID, mm_got.courierID) {
+				mmDispatchReturnShipment.t.Errorf("ReturnShipmentRepositoryMock.DispatchReturnShipment got unexpected parameter courierID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDispatchReturnShipment.DispatchReturnShipmentMock.defaultExpectation.expectationOrigins.originCourierID, *mm_want_ptrs.courierID, mm_got.courierID, minimock.Diff(*mm_want_ptrs.courierID, mm_got.courierID))
+			}
+
+			if mm_want_ptrs.dispatchedAt != nil && !minimock.Equal(*mm_want_ptrs.dispatchedAt, mm_got.dispatchedAt) {
+				mmDispatchReturnShipment.t.Errorf("ReturnShipmentRepositoryMock.DispatchReturnShipment got unexpected parameter dispatchedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDispatchReturnShipment.DispatchReturnShipmentMock.defaultExpectation.expectationOrigins.originDispatchedAt, *mm_want_ptrs.dispatchedAt, mm_got.dispatchedAt, minimock.Diff(*mm_want_ptrs.dispatchedAt, mm_got.dispatchedAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDispatchReturnShipment.t.Errorf("ReturnShipmentRepositoryMock.DispatchReturnShipment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDispatchReturnShipment.DispatchReturnShipmentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDispatchReturnShipment.DispatchReturnShipmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDispatchReturnShipment.t.Fatal("No results are set for the ReturnShipmentRepositoryMock.DispatchReturnShipment")
+		}
+		return (*mm_results).r1, (*mm_results).err
+	}
+	if mmDispatchReturnShipment.funcDispatchReturnShipment != nil {
+		return mmDispatchReturnShipment.funcDispatchReturnShipment(ctx, shipmentID, courierID, dispatchedAt)
+	}
+	mmDispatchReturnShipment.t.Fatalf("Unexpected call to ReturnShipmentRepositoryMock.DispatchReturnShipment. %v %v %v %v", ctx, shipmentID, courierID, dispatchedAt)
+	return
+}
+
+// DispatchReturnShipmentAfterCounter returns a count of finished ReturnShipmentRepositoryMock.DispatchReturnShipment invocations
+func (mmDispatchReturnShipment *ReturnShipmentRepositoryMock) DispatchReturnShipmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDispatchReturnShipment.afterDispatchReturnShipmentCounter)
+}
+
+// DispatchReturnShipmentBeforeCounter returns a count of ReturnShipmentRepositoryMock.DispatchReturnShipment invocations
+func (mmDispatchReturnShipment *ReturnShipmentRepositoryMock) DispatchReturnShipmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDispatchReturnShipment.beforeDispatchReturnShipmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReturnShipmentRepositoryMock.DispatchReturnShipment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDispatchReturnShipment *mReturnShipmentRepositoryMockDispatchReturnShipment) Calls() []*ReturnShipmentRepositoryMockDispatchReturnShipmentParams {
+	mmDispatchReturnShipment.mutex.RLock()
+
+	argCopy := make([]*ReturnShipmentRepositoryMockDispatchReturnShipmentParams, len(mmDispatchReturnShipment.callArgs))
+	copy(argCopy, mmDispatchReturnShipment.callArgs)
+
+	mmDispatchReturnShipment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDispatchReturnShipmentDone returns true if the count of the DispatchReturnShipment invocations corresponds
+// the number of defined expectations
+func (m *ReturnShipmentRepositoryMock) MinimockDispatchReturnShipmentDone() bool {
+	if m.DispatchReturnShipmentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DispatchReturnShipmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DispatchReturnShipmentMock.invocationsDone()
+}
+
+// MinimockDispatchReturnShipmentInspect logs each unmet expectation
+func (m *ReturnShipmentRepositoryMock) MinimockDispatchReturnShipmentInspect() {
+	for _, e := range m.DispatchReturnShipmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReturnShipmentRepositoryMock.DispatchReturnShipment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDispatchReturnShipmentCounter := mm_atomic.LoadUint64(&m.afterDispatchReturnShipmentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DispatchReturnShipmentMock.defaultExpectation != nil && afterDispatchReturnShipmentCounter < 1 {
+		if m.DispatchReturnShipmentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReturnShipmentRepositoryMock.DispatchReturnShipment at\n%s", m.DispatchReturnShipmentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ReturnShipmentRepositoryMock.DispatchReturnShipment at\n%s with params: %#v", m.DispatchReturnShipmentMock.defaultExpectation.expectationOrigins.origin, *m.DispatchReturnShipmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDispatchReturnShipment != nil && afterDispatchReturnShipmentCounter < 1 {
+		m.t.Errorf("Expected call to ReturnShipmentRepositoryMock.DispatchReturnShipment at\n%s", m.funcDispatchReturnShipmentOrigin)
+	}
+
+	if !m.DispatchReturnShipmentMock.invocationsDone() && afterDispatchReturnShipmentCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReturnShipmentRepositoryMock.DispatchReturnShipment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DispatchReturnShipmentMock.expectedInvocations), m.DispatchReturnShipmentMock.expectedInvocationsOrigin, afterDispatchReturnShipmentCounter)
+	}
+}
+
+type mReturnShipmentRepositoryMockGetReturnShipment struct {
+	optional           bool
+	mock               *ReturnShipmentRepositoryMock
+	defaultExpectation *ReturnShipmentRepositoryMockGetReturnShipmentExpectation
+	expectations       []*ReturnShipmentRepositoryMockGetReturnShipmentExpectation
+
+	callArgs []*ReturnShipmentRepositoryMockGetReturnShipmentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ReturnShipmentRepositoryMockGetReturnShipmentExpectation specifies expectation struct of the ReturnShipmentRepository.GetReturnShipment
+type ReturnShipmentRepositoryMockGetReturnShipmentExpectation struct {
+	mock               *ReturnShipmentRepositoryMock
+	params             *ReturnShipmentRepositoryMockGetReturnShipmentParams
+	paramPtrs          *ReturnShipmentRepositoryMockGetReturnShipmentParamPtrs
+	expectationOrigins ReturnShipmentRepositoryMockGetReturnShipmentExpectationOrigins
+	results            *ReturnShipmentRepositoryMockGetReturnShipmentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ReturnShipmentRepositoryMockGetReturnShipmentParams contains parameters of the ReturnShipmentRepository.GetReturnShipment
+type ReturnShipmentRepositoryMockGetReturnShipmentParams struct {
+	ctx        context.Context
+	shipmentID string
+}
+
+// ReturnShipmentRepositoryMockGetReturnShipmentParamPtrs contains pointers to parameters of the ReturnShipmentRepository.GetReturnShipment
+type ReturnShipmentRepositoryMockGetReturnShipmentParamPtrs struct {
+	ctx        *context.Context
+	shipmentID *string
+}
+
+// ReturnShipmentRepositoryMockGetReturnShipmentResults contains results of the ReturnShipmentRepository.GetReturnShipment
+type ReturnShipmentRepositoryMockGetReturnShipmentResults struct {
+	r1  domain.ReturnShipment
+	err error
+}
+
+// ReturnShipmentRepositoryMockGetReturnShipmentOrigins contains origins of expectations of the ReturnShipmentRepository.GetReturnShipment
+type ReturnShipmentRepositoryMockGetReturnShipmentExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originShipmentID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetReturnShipment *mReturnShipmentRepositoryMockGetReturnShipment) Optional() *mReturnShipmentRepositoryMockGetReturnShipment {
+	mmGetReturnShipment.optional = true
+	return mmGetReturnShipment
+}
+
+// Expect sets up expected params for ReturnShipmentRepository.GetReturnShipment
+func (mmGetReturnShipment *mReturnShipmentRepositoryMockGetReturnShipment) Expect(ctx context.Context, shipmentID string) *mReturnShipmentRepositoryMockGetReturnShipment {
+	if mmGetReturnShipment.mock.funcGetReturnShipment != nil {
+		mmGetReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.GetReturnShipment mock is already set by Set")
+	}
+
+	if mmGetReturnShipment.defaultExpectation == nil {
+		mmGetReturnShipment.defaultExpectation = &ReturnShipmentRepositoryMockGetReturnShipmentExpectation{}
+	}
+
+	if mmGetReturnShipment.defaultExpectation.paramPtrs != nil {
+		mmGetReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.GetReturnShipment mock is already set by ExpectParams functions")
+	}
+
+	mmGetReturnShipment.defaultExpectation.params = &ReturnShipmentRepositoryMockGetReturnShipmentParams{ctx, shipmentID}
+	mmGetReturnShipment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetReturnShipment.expectations {
+		if minimock.Equal(e.params, mmGetReturnShipment.defaultExpectation.params) {
+			mmGetReturnShipment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetReturnShipment.defaultExpectation.params)
+		}
+	}
+
+	return mmGetReturnShipment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ReturnShipmentRepository.GetReturnShipment
+func (mmGetReturnShipment *mReturnShipmentRepositoryMockGetReturnShipment) ExpectCtxParam1(ctx context.Context) *mReturnShipmentRepositoryMockGetReturnShipment {
+	if mmGetReturnShipment.mock.funcGetReturnShipment != nil {
+		mmGetReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.GetReturnShipment mock is already set by Set")
+	}
+
+	if mmGetReturnShipment.defaultExpectation == nil {
+		mmGetReturnShipment.defaultExpectation = &ReturnShipmentRepositoryMockGetReturnShipmentExpectation{}
+	}
+
+	if mmGetReturnShipment.defaultExpectation.params != nil {
+		mmGetReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.GetReturnShipment mock is already set by Expect")
+	}
+
+	if mmGetReturnShipment.defaultExpectation.paramPtrs == nil {
+		mmGetReturnShipment.defaultExpectation.paramPtrs = &ReturnShipmentRepositoryMockGetReturnShipmentParamPtrs{}
+	}
+	mmGetReturnShipment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetReturnShipment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetReturnShipment
+}
+
+// ExpectShipmentIDParam2 sets up expected param shipmentID for ReturnShipmentRepository.GetReturnShipment
+func (mmGetReturnShipment *mReturnShipmentRepositoryMockGetReturnShipment) ExpectShipmentIDParam2(shipmentID string) *mReturnShipmentRepositoryMockGetReturnShipment {
+	if mmGetReturnShipment.mock.funcGetReturnShipment != nil {
+		mmGetReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.GetReturnShipment mock is already set by Set")
+	}
+
+	if mmGetReturnShipment.defaultExpectation == nil {
+		mmGetReturnShipment.defaultExpectation = &ReturnShipmentRepositoryMockGetReturnShipmentExpectation{}
+	}
+
+	if mmGetReturnShipment.defaultExpectation.params != nil {
+		mmGetReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.GetReturnShipment mock is already set by Expect")
+	}
+
+	if mmGetReturnShipment.defaultExpectation.paramPtrs == nil {
+		mmGetReturnShipment.defaultExpectation.paramPtrs = &ReturnShipmentRepositoryMockGetReturnShipmentParamPtrs{}
+	}
+	mmGetReturnShipment.defaultExpectation.paramPtrs.shipmentID = &shipmentID
+	mmGetReturnShipment.defaultExpectation.expectationOrigins.originShipmentID = minimock.CallerInfo(1)
+
+	return mmGetReturnShipment
+}
+
+// Inspect accepts an inspector function that has same arguments as the ReturnShipmentRepository.GetReturnShipment
+func (mmGetReturnShipment *mReturnShipmentRepositoryMockGetReturnShipment) Inspect(f func(ctx context.Context, shipmentID string)) *mReturnShipmentRepositoryMockGetReturnShipment {
+	if mmGetReturnShipment.mock.inspectFuncGetReturnShipment != nil {
+		mmGetReturnShipment.mock.t.Fatalf("Inspect function is already set for ReturnShipmentRepositoryMock.GetReturnShipment")
+	}
+
+	mmGetReturnShipment.mock.inspectFuncGetReturnShipment = f
+
+	return mmGetReturnShipment
+}
+
+// Return sets up results that will be returned by ReturnShipmentRepository.GetReturnShipment
+func (mmGetReturnShipment *mReturnShipmentRepositoryMockGetReturnShipment) Return(r1 domain.ReturnShipment, err error) *ReturnShipmentRepositoryMock {
+	if mmGetReturnShipment.mock.funcGetReturnShipment != nil {
+		mmGetReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.GetReturnShipment mock is already set by Set")
+	}
+
+	if mmGetReturnShipment.defaultExpectation == nil {
+		mmGetReturnShipment.defaultExpectation = &ReturnShipmentRepositoryMockGetReturnShipmentExpectation{mock: mmGetReturnShipment.mock}
+	}
+	mmGetReturnShipment.defaultExpectation.results = &ReturnShipmentRepositoryMockGetReturnShipmentResults{r1, err}
+	mmGetReturnShipment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetReturnShipment.mock
+}
+
+// Set uses given function f to mock the ReturnShipmentRepository.GetReturnShipment method
+func (mmGetReturnShipment *mReturnShipmentRepositoryMockGetReturnShipment) Set(f func(ctx context.Context, shipmentID string) (r1 domain.ReturnShipment, err error)) *ReturnShipmentRepositoryMock {
+	if mmGetReturnShipment.defaultExpectation != nil {
+		mmGetReturnShipment.mock.t.Fatalf("Default expectation is already set for the ReturnShipmentRepository.GetReturnShipment method")
+	}
+
+	if len(mmGetReturnShipment.expectations) > 0 {
+		mmGetReturnShipment.mock.t.Fatalf("Some expectations are already set for the ReturnShipmentRepository.GetReturnShipment method")
+	}
+
+	mmGetReturnShipment.mock.funcGetReturnShipment = f
+	mmGetReturnShipment.mock.funcGetReturnShipmentOrigin = minimock.CallerInfo(1)
+	return mmGetReturnShipment.mock
+}
+
+// When sets expectation for the ReturnShipmentRepository.GetReturnShipment which will trigger the result defined by the following
+// Then helper
+func (mmGetReturnShipment *mReturnShipmentRepositoryMockGetReturnShipment) When(ctx context.Context, shipmentID string) *ReturnShipmentRepositoryMockGetReturnShipmentExpectation {
+	if mmGetReturnShipment.mock.funcGetReturnShipment != nil {
+		mmGetReturnShipment.mock.t.Fatalf("ReturnShipmentRepositoryMock.GetReturnShipment mock is already set by Set")
+	}
+
+	expectation := &ReturnShipmentRepositoryMockGetReturnShipmentExpectation{
+		mock:               mmGetReturnShipment.mock,
+		params:             &ReturnShipmentRepositoryMockGetReturnShipmentParams{ctx, shipmentID},
+		expectationOrigins: ReturnShipmentRepositoryMockGetReturnShipmentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetReturnShipment.expectations = append(mmGetReturnShipment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ReturnShipmentRepository.GetReturnShipment return parameters for the expectation previously defined by the When method
+func (e *ReturnShipmentRepositoryMockGetReturnShipmentExpectation) Then(r1 domain.ReturnShipment, err error) *ReturnShipmentRepositoryMock {
+	e.results = &ReturnShipmentRepositoryMockGetReturnShipmentResults{r1, err}
+	return e.mock
+}
+
+// Times sets number of times ReturnShipmentRepository.GetReturnShipment should be invoked
+func (mmGetReturnShipment *mReturnShipmentRepositoryMockGetReturnShipment) Times(n uint64) *mReturnShipmentRepositoryMockGetReturnShipment {
+	if n == 0 {
+		mmGetReturnShipment.mock.t.Fatalf("Times of ReturnShipmentRepositoryMock.GetReturnShipment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetReturnShipment.expectedInvocations, n)
+	mmGetReturnShipment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetReturnShipment
+}
+
+func (mmGetReturnShipment *mReturnShipmentRepositoryMockGetReturnShipment) invocationsDone() bool {
+	if len(mmGetReturnShipment.expectations) == 0 && mmGetReturnShipment.defaultExpectation == nil && mmGetReturnShipment.mock.funcGetReturnShipment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetReturnShipment.mock.afterGetReturnShipmentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetReturnShipment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetReturnShipment implements mm_usecases.ReturnShipmentRepository
+func (mmGetReturnShipment *ReturnShipmentRepositoryMock) GetReturnShipment(ctx context.Context, shipmentID string) (r1 domain.ReturnShipment, err error) {
+	mm_atomic.AddUint64(&mmGetReturnShipment.beforeGetReturnShipmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetReturnShipment.afterGetReturnShipmentCounter, 1)
+
+	mmGetReturnShipment.t.Helper()
+
+	if mmGetReturnShipment.inspectFuncGetReturnShipment != nil {
+		mmGetReturnShipment.inspectFuncGetReturnShipment(ctx, shipmentID)
+	}
+
+	mm_params := ReturnShipmentRepositoryMockGetReturnShipmentParams{ctx, shipmentID}
+
+	// Record call args
+	mmGetReturnShipment.GetReturnShipmentMock.mutex.Lock()
+	mmGetReturnShipment.GetReturnShipmentMock.callArgs = append(mmGetReturnShipment.GetReturnShipmentMock.callArgs, &mm_params)
+	mmGetReturnShipment.GetReturnShipmentMock.mutex.Unlock()
+
+	for _, e := range mmGetReturnShipment.GetReturnShipmentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.r1, e.results.err
+		}
+	}
+
+	if mmGetReturnShipment.GetReturnShipmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetReturnShipment.GetReturnShipmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetReturnShipment.GetReturnShipmentMock.defaultExpectation.params
+		mm_want_ptrs := mmGetReturnShipment.GetReturnShipmentMock.defaultExpectation.paramPtrs
+
+		mm_got := ReturnShipmentRepositoryMockGetReturnShipmentParams{ctx, shipmentID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetReturnShipment.t.Errorf("ReturnShipmentRepositoryMock.GetReturnShipment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReturnShipment.GetReturnShipmentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.shipmentID != nil && !minimock.Equal(*mm_want_ptrs.shipmentID, mm_got.shipmentID) {
+				mmGetReturnShipment.t.Errorf("ReturnShipmentRepositoryMock.GetReturnShipment got unexpected parameter shipmentID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetReturnShipment.GetReturnShipmentMock.defaultExpectation.expectationOrigins.originShipmentID, *mm_want_ptrs.shipmentID, mm_got.shipmentID, minimock.Diff(*mm_want_ptrs.shipmentID, mm_got.shipmentID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetReturnShipment.t.Errorf("ReturnShipmentRepositoryMock.GetReturnShipment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetReturnShipment.GetReturnShipmentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetReturnShipment.GetReturnShipmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetReturnShipment.t.Fatal("No results are set for the ReturnShipmentRepositoryMock.GetReturnShipment")
+		}
+		return (*mm_results).r1, (*mm_results).err
+	}
+	if mmGetReturnShipment.funcGetReturnShipment != nil {
+		return mmGetReturnShipment.funcGetReturnShipment(ctx, shipmentID)
+	}
+	mmGetReturnShipment.t.Fatalf("Unexpected call to ReturnShipmentRepositoryMock.GetReturnShipment. %v %v", ctx, shipmentID)
+	return
+}
+
+// GetReturnShipmentAfterCounter returns a count of finished ReturnShipmentRepositoryMock.GetReturnShipment invocations
+func (mmGetReturnShipment *ReturnShipmentRepositoryMock) GetReturnShipmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReturnShipment.afterGetReturnShipmentCounter)
+}
+
+// GetReturnShipmentBeforeCounter returns a count of ReturnShipmentRepositoryMock.GetReturnShipment invocations
+func (mmGetReturnShipment *ReturnShipmentRepositoryMock) GetReturnShipmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetReturnShipment.beforeGetReturnShipmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to ReturnShipmentRepositoryMock.GetReturnShipment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetReturnShipment *mReturnShipmentRepositoryMockGetReturnShipment) Calls() []*ReturnShipmentRepositoryMockGetReturnShipmentParams {
+	mmGetReturnShipment.mutex.RLock()
+
+	argCopy := make([]*ReturnShipmentRepositoryMockGetReturnShipmentParams, len(mmGetReturnShipment.callArgs))
+	copy(argCopy, mmGetReturnShipment.callArgs)
+
+	mmGetReturnShipment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetReturnShipmentDone returns true if the count of the GetReturnShipment invocations corresponds
+// the number of defined expectations
+func (m *ReturnShipmentRepositoryMock) MinimockGetReturnShipmentDone() bool {
+	if m.GetReturnShipmentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetReturnShipmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetReturnShipmentMock.invocationsDone()
+}
+
+// MinimockGetReturnShipmentInspect logs each unmet expectation
+func (m *ReturnShipmentRepositoryMock) MinimockGetReturnShipmentInspect() {
+	for _, e := range m.GetReturnShipmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ReturnShipmentRepositoryMock.GetReturnShipment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetReturnShipmentCounter := mm_atomic.LoadUint64(&m.afterGetReturnShipmentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetReturnShipmentMock.defaultExpectation != nil && afterGetReturnShipmentCounter < 1 {
+		if m.GetReturnShipmentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ReturnShipmentRepositoryMock.GetReturnShipment at\n%s", m.GetReturnShipmentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ReturnShipmentRepositoryMock.GetReturnShipment at\n%s with params: %#v", m.GetReturnShipmentMock.defaultExpectation.expectationOrigins.origin, *m.GetReturnShipmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetReturnShipment != nil && afterGetReturnShipmentCounter < 1 {
+		m.t.Errorf("Expected call to ReturnShipmentRepositoryMock.GetReturnShipment at\n%s", m.funcGetReturnShipmentOrigin)
+	}
+
+	if !m.GetReturnShipmentMock.invocationsDone() && afterGetReturnShipmentCounter > 0 {
+		m.t.Errorf("Expected %d calls to ReturnShipmentRepositoryMock.GetReturnShipment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetReturnShipmentMock.expectedInvocations), m.GetReturnShipmentMock.expectedInvocationsOrigin, afterGetReturnShipmentCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *ReturnShipmentRepositoryMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateReturnShipmentInspect()
+
+			m.MinimockDispatchReturnShipmentInspect()
+
+			m.MinimockGetReturnShipmentInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *ReturnShipmentRepositoryMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *ReturnShipmentRepositoryMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateReturnShipmentDone() &&
+		m.MinimockDispatchReturnShipmentDone() &&
+		m.MinimockGetReturnShipmentDone()
+}
@@ -0,0 +1,414 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.7). DO NOT EDIT.
+
+package mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gojuno/minimock/v3"
+)
+
+// TxManagerMock implements mm_usecases.TxManager
+type TxManagerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcRunReadCommittedTransaction          func(ctx context.Context, f func(ctx context.Context) error) (err error)
+	funcRunReadCommittedTransactionOrigin    string
+	inspectFuncRunReadCommittedTransaction   func(ctx context.Context, f func(ctx context.Context) error)
+	afterRunReadCommittedTransactionCounter  uint64
+	beforeRunReadCommittedTransactionCounter uint64
+	RunReadCommittedTransactionMock          mTxManagerMockRunReadCommittedTransaction
+}
+
+// NewTxManagerMock returns a mock for mm_usecases.TxManager
+func NewTxManagerMock(t minimock.Tester) *TxManagerMock {
+	m := &TxManagerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.RunReadCommittedTransactionMock = mTxManagerMockRunReadCommittedTransaction{mock: m}
+	m.RunReadCommittedTransactionMock.callArgs = []*TxManagerMockRunReadCommittedTransactionParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mTxManagerMockRunReadCommittedTransaction struct {
+	optional           bool
+	mock               *TxManagerMock
+	defaultExpectation *TxManagerMockRunReadCommittedTransactionExpectation
+	expectations       []*TxManagerMockRunReadCommittedTransactionExpectation
+
+	callArgs []*TxManagerMockRunReadCommittedTransactionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TxManagerMockRunReadCommittedTransactionExpectation specifies expectation struct of the TxManager.RunReadCommittedTransaction
+type TxManagerMockRunReadCommittedTransactionExpectation struct {
+	mock               *TxManagerMock
+	params             *TxManagerMockRunReadCommittedTransactionParams
+	paramPtrs          *TxManagerMockRunReadCommittedTransactionParamPtrs
+	expectationOrigins TxManagerMockRunReadCommittedTransactionExpectationOrigins
+	results            *TxManagerMockRunReadCommittedTransactionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TxManagerMockRunReadCommittedTransactionParams contains parameters of the TxManager.RunReadCommittedTransaction
+type TxManagerMockRunReadCommittedTransactionParams struct {
+	ctx context.Context
+	f   func(ctx context.Context) error
+}
+
+// TxManagerMockRunReadCommittedTransactionParamPtrs contains pointers to parameters of the TxManager.RunReadCommittedTransaction
+type TxManagerMockRunReadCommittedTransactionParamPtrs struct {
+	ctx *context.Context
+	f   *func(ctx context.Context) error
+}
+
+// TxManagerMockRunReadCommittedTransactionResults contains results of the TxManager.RunReadCommittedTransaction
+type TxManagerMockRunReadCommittedTransactionResults struct {
+	err error
+}
+
+// TxManagerMockRunReadCommittedTransactionOrigins contains origins of expectations of the TxManager.RunReadCommittedTransaction
+type TxManagerMockRunReadCommittedTransactionExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originF   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRunReadCommittedTransaction *mTxManagerMockRunReadCommittedTransaction) Optional() *mTxManagerMockRunReadCommittedTransaction {
+	mmRunReadCommittedTransaction.optional = true
+	return mmRunReadCommittedTransaction
+}
+
+// Expect sets up expected params for TxManager.RunReadCommittedTransaction
+func (mmRunReadCommittedTransaction *mTxManagerMockRunReadCommittedTransaction) Expect(ctx context.Context, f func(ctx context.Context) error) *mTxManagerMockRunReadCommittedTransaction {
+	if mmRunReadCommittedTransaction.mock.funcRunReadCommittedTransaction != nil {
+		mmRunReadCommittedTransaction.mock.t.Fatalf("TxManagerMock.RunReadCommittedTransaction mock is already set by Set")
+	}
+
+	if mmRunReadCommittedTransaction.defaultExpectation == nil {
+		mmRunReadCommittedTransaction.defaultExpectation = &TxManagerMockRunReadCommittedTransactionExpectation{}
+	}
+
+	if mmRunReadCommittedTransaction.defaultExpectation.paramPtrs != nil {
+		mmRunReadCommittedTransaction.mock.t.Fatalf("TxManagerMock.RunReadCommittedTransaction mock is already set by ExpectParams functions")
+	}
+
+	mmRunReadCommittedTransaction.defaultExpectation.params = &TxManagerMockRunReadCommittedTransactionParams{ctx, f}
+	mmRunReadCommittedTransaction.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRunReadCommittedTransaction.expectations {
+		if minimock.Equal(e.params, mmRunReadCommittedTransaction.defaultExpectation.params) {
+			mmRunReadCommittedTransaction.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRunReadCommittedTransaction.defaultExpectation.params)
+		}
+	}
+
+	return mmRunReadCommittedTransaction
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TxManager.RunReadCommittedTransaction
+func (mmRunReadCommittedTransaction *mTxManagerMockRunReadCommittedTransaction) ExpectCtxParam1(ctx context.Context) *mTxManagerMockRunReadCommittedTransaction {
+	if mmRunReadCommittedTransaction.mock.funcRunReadCommittedTransaction != nil {
+		mmRunReadCommittedTransaction.mock.t.Fatalf("TxManagerMock.RunReadCommittedTransaction mock is already set by Set")
+	}
+
+	if mmRunReadCommittedTransaction.defaultExpectation == nil {
+		mmRunReadCommittedTransaction.defaultExpectation = &TxManagerMockRunReadCommittedTransactionExpectation{}
+	}
+
+	if mmRunReadCommittedTransaction.defaultExpectation.params != nil {
+		mmRunReadCommittedTransaction.mock.t.Fatalf("TxManagerMock.RunReadCommittedTransaction mock is already set by Expect")
+	}
+
+	if mmRunReadCommittedTransaction.defaultExpectation.paramPtrs == nil {
+		mmRunReadCommittedTransaction.defaultExpectation.paramPtrs = &TxManagerMockRunReadCommittedTransactionParamPtrs{}
+	}
+	mmRunReadCommittedTransaction.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRunReadCommittedTransaction.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRunReadCommittedTransaction
+}
+
+// ExpectFParam2 sets up expected param f for TxManager.RunReadCommittedTransaction
+func (mmRunReadCommittedTransaction *mTxManagerMockRunReadCommittedTransaction) ExpectFParam2(f func(ctx context.Context) error) *mTxManagerMockRunReadCommittedTransaction {
+	if mmRunReadCommittedTransaction.mock.funcRunReadCommittedTransaction != nil {
+		mmRunReadCommittedTransaction.mock.t.Fatalf("TxManagerMock.RunReadCommittedTransaction mock is already set by Set")
+	}
+
+	if mmRunReadCommittedTransaction.defaultExpectation == nil {
+		mmRunReadCommittedTransaction.defaultExpectation = &TxManagerMockRunReadCommittedTransactionExpectation{}
+	}
+
+	if mmRunReadCommittedTransaction.defaultExpectation.params != nil {
+		mmRunReadCommittedTransaction.mock.t.Fatalf("TxManagerMock.RunReadCommittedTransaction mock is already set by Expect")
+	}
+
+	if mmRunReadCommittedTransaction.defaultExpectation.paramPtrs == nil {
+		mmRunReadCommittedTransaction.defaultExpectation.paramPtrs = &TxManagerMockRunReadCommittedTransactionParamPtrs{}
+	}
+	mmRunReadCommittedTransaction.defaultExpectation.paramPtrs.f = &f
+	mmRunReadCommittedTransaction.defaultExpectation.expectationOrigins.originF = minimock.CallerInfo(1)
+
+	return mmRunReadCommittedTransaction
+}
+
+// Inspect accepts an inspector function that has same arguments as the TxManager.RunReadCommittedTransaction
+func (mmRunReadCommittedTransaction *mTxManagerMockRunReadCommittedTransaction) Inspect(f func(ctx context.Context, f func(ctx context.Context) error)) *mTxManagerMockRunReadCommittedTransaction {
+	if mmRunReadCommittedTransaction.mock.inspectFuncRunReadCommittedTransaction != nil {
+		mmRunReadCommittedTransaction.mock.t.Fatalf("Inspect function is already set for TxManagerMock.RunReadCommittedTransaction")
+	}
+
+	mmRunReadCommittedTransaction.mock.inspectFuncRunReadCommittedTransaction = f
+
+	return mmRunReadCommittedTransaction
+}
+
+// Return sets up results that will be returned by TxManager.RunReadCommittedTransaction
+func (mmRunReadCommittedTransaction *mTxManagerMockRunReadCommittedTransaction) Return(err error) *TxManagerMock {
+	if mmRunReadCommittedTransaction.mock.funcRunReadCommittedTransaction != nil {
+		mmRunReadCommittedTransaction.mock.t.Fatalf("TxManagerMock.RunReadCommittedTransaction mock is already set by Set")
+	}
+
+	if mmRunReadCommittedTransaction.defaultExpectation == nil {
+		mmRunReadCommittedTransaction.defaultExpectation = &TxManagerMockRunReadCommittedTransactionExpectation{mock: mmRunReadCommittedTransaction.mock}
+	}
+	mmRunReadCommittedTransaction.defaultExpectation.results = &TxManagerMockRunReadCommittedTransactionResults{err}
+	mmRunReadCommittedTransaction.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRunReadCommittedTransaction.mock
+}
+
+// Set uses given function f to mock the TxManager.RunReadCommittedTransaction method
+func (mmRunReadCommittedTransaction *mTxManagerMockRunReadCommittedTransaction) Set(f func(ctx context.Context, f func(ctx context.Context) error) (err error)) *TxManagerMock {
+	if mmRunReadCommittedTransaction.defaultExpectation != nil {
+		mmRunReadCommittedTransaction.mock.t.Fatalf("Default expectation is already set for the TxManager.RunReadCommittedTransaction method")
+	}
+
+	if len(mmRunReadCommittedTransaction.expectations) > 0 {
+		mmRunReadCommittedTransaction.mock.t.Fatalf("Some expectations are already set for the TxManager.RunReadCommittedTransaction method")
+	}
+
+	mmRunReadCommittedTransaction.mock.funcRunReadCommittedTransaction = f
+	mmRunReadCommittedTransaction.mock.funcRunReadCommittedTransactionOrigin = minimock.CallerInfo(1)
+	return mmRunReadCommittedTransaction.mock
+}
+
+// When sets expectation for the TxManager.RunReadCommittedTransaction which will trigger the result defined by the following
+// Then helper
+func (mmRunReadCommittedTransaction *mTxManagerMockRunReadCommittedTransaction) When(ctx context.Context, f func(ctx context.Context) error) *TxManagerMockRunReadCommittedTransactionExpectation {
+	if mmRunReadCommittedTransaction.mock.funcRunReadCommittedTransaction != nil {
+		mmRunReadCommittedTransaction.mock.t.Fatalf("TxManagerMock.RunReadCommittedTransaction mock is already set by Set")
+	}
+
+	expectation := &TxManagerMockRunReadCommittedTransactionExpectation{
+		mock:               mmRunReadCommittedTransaction.mock,
+		params:             &TxManagerMockRunReadCommittedTransactionParams{ctx, f},
+		expectationOrigins: TxManagerMockRunReadCommittedTransactionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRunReadCommittedTransaction.expectations = append(mmRunReadCommittedTransaction.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TxManager.RunReadCommittedTransaction return parameters for the expectation previously defined by the When method
+func (e *TxManagerMockRunReadCommittedTransactionExpectation) Then(err error) *TxManagerMock {
+	e.results = &TxManagerMockRunReadCommittedTransactionResults{err}
+	return e.mock
+}
+
+// Times sets number of times TxManager.RunReadCommittedTransaction should be invoked
+func (mmRunReadCommittedTransaction *mTxManagerMockRunReadCommittedTransaction) Times(n uint64) *mTxManagerMockRunReadCommittedTransaction {
+	if n == 0 {
+		mmRunReadCommittedTransaction.mock.t.Fatalf("Times of TxManagerMock.RunReadCommittedTransaction mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRunReadCommittedTransaction.expectedInvocations, n)
+	mmRunReadCommittedTransaction.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRunReadCommittedTransaction
+}
+
+func (mmRunReadCommittedTransaction *mTxManagerMockRunReadCommittedTransaction) invocationsDone() bool {
+	if len(mmRunReadCommittedTransaction.expectations) == 0 && mmRunReadCommittedTransaction.defaultExpectation == nil && mmRunReadCommittedTransaction.mock.funcRunReadCommittedTransaction == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRunReadCommittedTransaction.mock.afterRunReadCommittedTransactionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRunReadCommittedTransaction.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RunReadCommittedTransaction implements mm_usecases.TxManager
+func (mmRunReadCommittedTransaction *TxManagerMock) RunReadCommittedTransaction(ctx context.Context, f func(ctx context.Context) error) (err error) {
+	mm_atomic.AddUint64(&mmRunReadCommittedTransaction.beforeRunReadCommittedTransactionCounter, 1)
+	defer mm_atomic.AddUint64(&mmRunReadCommittedTransaction.afterRunReadCommittedTransactionCounter, 1)
+
+	mmRunReadCommittedTransaction.t.Helper()
+
+	if mmRunReadCommittedTransaction.inspectFuncRunReadCommittedTransaction != nil {
+		mmRunReadCommittedTransaction.inspectFuncRunReadCommittedTransaction(ctx, f)
+	}
+
+	mm_params := TxManagerMockRunReadCommittedTransactionParams{ctx, f}
+
+	// Record call args
+	mmRunReadCommittedTransaction.RunReadCommittedTransactionMock.mutex.Lock()
+	mmRunReadCommittedTransaction.RunReadCommittedTransactionMock.callArgs = append(mmRunReadCommittedTransaction.RunReadCommittedTransactionMock.callArgs, &mm_params)
+	mmRunReadCommittedTransaction.RunReadCommittedTransactionMock.mutex.Unlock()
+
+	for _, e := range mmRunReadCommittedTransaction.RunReadCommittedTransactionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRunReadCommittedTransaction.RunReadCommittedTransactionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRunReadCommittedTransaction.RunReadCommittedTransactionMock.defaultExpectation.Counter, 1)
+		mm_want := mmRunReadCommittedTransaction.RunReadCommittedTransactionMock.defaultExpectation.params
+		mm_want_ptrs := mmRunReadCommittedTransaction.RunReadCommittedTransactionMock.defaultExpectation.paramPtrs
+
+		mm_got := TxManagerMockRunReadCommittedTransactionParams{ctx, f}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRunReadCommittedTransaction.t.Errorf("TxManagerMock.RunReadCommittedTransaction got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRunReadCommittedTransaction.RunReadCommittedTransactionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.f != nil && !minimock.Equal(*mm_want_ptrs.f, mm_got.f) {
+				mmRunReadCommittedTransaction.t.Errorf("TxManagerMock.RunReadCommittedTransaction got unexpected parameter f, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRunReadCommittedTransaction.RunReadCommittedTransactionMock.defaultExpectation.expectationOrigins.originF, *mm_want_ptrs.f, mm_got.f, minimock.Diff(*mm_want_ptrs.f, mm_got.f))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRunReadCommittedTransaction.t.Errorf("TxManagerMock.RunReadCommittedTransaction got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRunReadCommittedTransaction.RunReadCommittedTransactionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRunReadCommittedTransaction.RunReadCommittedTransactionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRunReadCommittedTransaction.t.Fatal("No results are set for the TxManagerMock.RunReadCommittedTransaction")
+		}
+		return (*mm_results).err
+	}
+	if mmRunReadCommittedTransaction.funcRunReadCommittedTransaction != nil {
+		return mmRunReadCommittedTransaction.funcRunReadCommittedTransaction(ctx, f)
+	}
+	mmRunReadCommittedTransaction.t.Fatalf("Unexpected call to TxManagerMock.RunReadCommittedTransaction. %v %v", ctx, f)
+	return
+}
+
+// RunReadCommittedTransactionAfterCounter returns a count of finished TxManagerMock.RunReadCommittedTransaction invocations
+func (mmRunReadCommittedTransaction *TxManagerMock) RunReadCommittedTransactionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRunReadCommittedTransaction.afterRunReadCommittedTransactionCounter)
+}
+
+// RunReadCommittedTransactionBeforeCounter returns a count of TxManagerMock.RunReadCommittedTransaction invocations
+func (mmRunReadCommittedTransaction *TxManagerMock) RunReadCommittedTransactionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRunReadCommittedTransaction.beforeRunReadCommittedTransactionCounter)
+}
+
+// Calls returns a list of arguments used in each call to TxManagerMock.RunReadCommittedTransaction.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRunReadCommittedTransaction *mTxManagerMockRunReadCommittedTransaction) Calls() []*TxManagerMockRunReadCommittedTransactionParams {
+	mmRunReadCommittedTransaction.mutex.RLock()
+
+	argCopy := make([]*TxManagerMockRunReadCommittedTransactionParams, len(mmRunReadCommittedTransaction.callArgs))
+	copy(argCopy, mmRunReadCommittedTransaction.callArgs)
+
+	mmRunReadCommittedTransaction.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRunReadCommittedTransactionDone returns true if the count of the RunReadCommittedTransaction invocations corresponds
+// the number of defined expectations
+func (m *TxManagerMock) MinimockRunReadCommittedTransactionDone() bool {
+	if m.RunReadCommittedTransactionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RunReadCommittedTransactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RunReadCommittedTransactionMock.invocationsDone()
+}
+
+// MinimockRunReadCommittedTransactionInspect logs each unmet expectation
+func (m *TxManagerMock) MinimockRunReadCommittedTransactionInspect() {
+	for _, e := range m.RunReadCommittedTransactionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TxManagerMock.RunReadCommittedTransaction at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRunReadCommittedTransactionCounter := mm_atomic.LoadUint64(&m.afterRunReadCommittedTransactionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RunReadCommittedTransactionMock.defaultExpectation != nil && afterRunReadCommittedTransactionCounter < 1 {
+		if m.RunReadCommittedTransactionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TxManagerMock.RunReadCommittedTransaction at\n%s", m.RunReadCommittedTransactionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TxManagerMock.RunReadCommittedTransaction at\n%s with params: %#v", m.RunReadCommittedTransactionMock.defaultExpectation.expectationOrigins.origin, *m.RunReadCommittedTransactionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRunReadCommittedTransaction != nil && afterRunReadCommittedTransactionCounter < 1 {
+		m.t.Errorf("Expected call to TxManagerMock.RunReadCommittedTransaction at\n%s", m.funcRunReadCommittedTransactionOrigin)
+	}
+
+	if !m.RunReadCommittedTransactionMock.invocationsDone() && afterRunReadCommittedTransactionCounter > 0 {
+		m.t.Errorf("Expected %d calls to TxManagerMock.RunReadCommittedTransaction at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RunReadCommittedTransactionMock.expectedInvocations), m.RunReadCommittedTransactionMock.expectedInvocationsOrigin, afterRunReadCommittedTransactionCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *TxManagerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockRunReadCommittedTransactionInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *TxManagerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *TxManagerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockRunReadCommittedTransactionDone()
+}
@@ -0,0 +1,121 @@
+package packager
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+
+	"homework/internal/domain"
+)
+
+// catalogFile is a format of the packaging catalog config
+type catalogFile struct {
+	// Currency of the packaging costs, domain.DefaultCurrency if it is not set
+	Currency  string        `json:"currency" yaml:"currency"`
+	Packaging []catalogItem `json:"packaging" yaml:"packaging"`
+}
+
+type catalogItem struct {
+	Code           string             `json:"code" yaml:"code"`
+	Cost           int64              `json:"cost" yaml:"cost"`
+	WeightLimit    int                `json:"weight_limit" yaml:"weight_limit"`
+	MaxDimensions  *catalogDimensions `json:"max_dimensions" yaml:"max_dimensions"`
+	CombinableWith []string           `json:"combinable_with" yaml:"combinable_with"`
+}
+
+type catalogDimensions struct {
+	Length int `json:"length" yaml:"length"`
+	Width  int `json:"width" yaml:"width"`
+	Height int `json:"height" yaml:"height"`
+}
+
+// LoadCatalog loads the packaging catalog from the YAML or JSON file, the format is chosen by the extension
+func LoadCatalog(path string) ([]domain.PackagingSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read packaging catalog: %w", err)
+	}
+
+	var file catalogFile
+	switch filepath.Ext(path) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &file)
+	case ".json":
+		err = json.Unmarshal(data, &file)
+	default:
+		return nil, fmt.Errorf("unsupported packaging catalog format %s (available formats: yaml, json)", filepath.Ext(path))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse packaging catalog: %w", err)
+	}
+
+	return newCatalog(file)
+}
+
+func newCatalog(file catalogFile) ([]domain.PackagingSpec, error) {
+	if len(file.Packaging) == 0 {
+		return nil, fmt.Errorf("%w: packaging catalog is empty", domain.ErrInvalidArgument)
+	}
+
+	currency := domain.DefaultCurrency
+	if file.Currency != "" {
+		var err error
+		currency, err = domain.NewCurrency(file.Currency)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	catalog := make([]domain.PackagingSpec, 0, len(file.Packaging))
+	known := make(map[domain.PackagingType]struct{}, len(file.Packaging))
+
+	for _, item := range file.Packaging {
+		packaging, err := domain.NewPackagingType(item.Code)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := known[packaging]; ok {
+			return nil, fmt.Errorf("%w: duplicate packaging type %s", domain.ErrInvalidArgument, packaging)
+		}
+		known[packaging] = struct{}{}
+
+		if item.Cost < 0 || item.WeightLimit < 0 {
+			return nil, fmt.Errorf("%w: cost and weight limit of packaging type %s must not be negative", domain.ErrInvalidArgument, packaging)
+		}
+
+		spec := domain.PackagingSpec{
+			Type:        packaging,
+			Cost:        domain.NewMoney(item.Cost, currency),
+			WeightLimit: item.WeightLimit,
+		}
+		if item.MaxDimensions != nil {
+			spec.MaxDimensions, err = domain.NewDimensions(item.MaxDimensions.Length, item.MaxDimensions.Width, item.MaxDimensions.Height)
+			if err != nil {
+				return nil, fmt.Errorf("max dimensions of packaging type %s: %w", packaging, err)
+			}
+		}
+		for _, code := range item.CombinableWith {
+			combinable, err := domain.NewPackagingType(code)
+			if err != nil {
+				return nil, err
+			}
+			spec.CombinableWith = append(spec.CombinableWith, combinable)
+		}
+
+		catalog = append(catalog, spec)
+	}
+
+	for _, spec := range catalog {
+		for _, combinable := range spec.CombinableWith {
+			if _, ok := known[combinable]; !ok || combinable == spec.Type {
+				return nil, fmt.Errorf("%w: packaging type %s can not be combined with %s", domain.ErrInvalidArgument, spec.Type, combinable)
+			}
+		}
+	}
+
+	return catalog, nil
+}
@@ -0,0 +1,117 @@
+package packager
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"homework/internal/domain"
+)
+
+func TestLoadCatalog(t *testing.T) {
+	t.Parallel()
+
+	const yamlCatalog = `
+packaging:
+  - code: box
+    cost: 2000
+    weight_limit: 30000
+    combinable_with: [film]
+  - code: large_box
+    cost: 3500
+    weight_limit: 60000
+    max_dimensions: {length: 150, width: 100, height: 100}
+    combinable_with: [film]
+  - code: film
+    cost: 100
+`
+
+	const jsonCatalog = `{"currency": "KZT", "packaging": [{"code": "bag", "cost": 500, "weight_limit": 10000}]}`
+
+	isInvalidArgument := func(t assert.TestingT, err error, i ...interface{}) bool {
+		return assert.Error(t, err) && errors.Is(err, domain.ErrInvalidArgument)
+	}
+
+	tests := []struct {
+		name    string
+		file    string
+		content string
+		want    []domain.PackagingSpec
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "yaml",
+			file:    "packaging.yaml",
+			content: yamlCatalog,
+			want: []domain.PackagingSpec{
+				{Type: domain.PackagingTypeBox, Cost: domain.RUB(2000), WeightLimit: 30000, CombinableWith: []domain.PackagingType{domain.PackagingTypeFilm}},
+				{
+					Type:           "large_box",
+					Cost:           domain.RUB(3500),
+					WeightLimit:    60000,
+					MaxDimensions:  domain.Dimensions{Length: 150, Width: 100, Height: 100},
+					CombinableWith: []domain.PackagingType{domain.PackagingTypeFilm},
+				},
+				{Type: domain.PackagingTypeFilm, Cost: domain.RUB(100)},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "json",
+			file:    "packaging.json",
+			content: jsonCatalog,
+			want: []domain.PackagingSpec{
+				{Type: domain.PackagingTypeBag, Cost: domain.NewMoney(500, domain.CurrencyKZT), WeightLimit: 10000},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "unknown combinable type",
+			file:    "packaging.yaml",
+			content: "packaging:\n  - code: box\n    combinable_with: [film]\n",
+			wantErr: isInvalidArgument,
+		},
+		{
+			name:    "duplicate type",
+			file:    "packaging.yaml",
+			content: "packaging:\n  - code: box\n  - code: box\n",
+			wantErr: isInvalidArgument,
+		},
+		{
+			name:    "partial max dimensions",
+			file:    "packaging.yaml",
+			content: "packaging:\n  - code: box\n    max_dimensions: {length: 100}\n",
+			wantErr: isInvalidArgument,
+		},
+		{
+			name:    "negative cost",
+			file:    "packaging.json",
+			content: `{"packaging": [{"code": "box", "cost": -1}]}`,
+			wantErr: isInvalidArgument,
+		},
+		{
+			name:    "unsupported format",
+			file:    "packaging.toml",
+			content: "",
+			wantErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), tt.file)
+			assert.NoError(t, os.WriteFile(path, []byte(tt.content), 0o600))
+
+			got, err := LoadCatalog(path)
+			if !tt.wantErr(t, err) {
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package packager
 
 import (
+	"errors"
 	"fmt"
 	"homework/internal/domain"
 	"homework/internal/usecases"
@@ -17,14 +18,30 @@ type OrderPackagerStrategy interface {
 
 // OrderPackager is a packager for orders
 type OrderPackager struct {
+	// types keeps the order of the catalog
+	types      []domain.PackagingType
+	specs      map[domain.PackagingType]domain.PackagingSpec
 	strategies map[domain.PackagingType]OrderPackagerStrategy
 }
 
-// NewOrderPackager creates a new order packager
-func NewOrderPackager(strategies map[domain.PackagingType]OrderPackagerStrategy) *OrderPackager {
+// NewOrderPackager creates a new order packager for the packaging types of the catalog.
+// Every packaging type of the catalog must have a strategy
+func NewOrderPackager(catalog []domain.PackagingSpec, strategies map[domain.PackagingType]OrderPackagerStrategy) (*OrderPackager, error) {
+	types := make([]domain.PackagingType, 0, len(catalog))
+	specs := make(map[domain.PackagingType]domain.PackagingSpec, len(catalog))
+	for _, spec := range catalog {
+		if _, ok := strategies[spec.Type]; !ok {
+			return nil, fmt.Errorf("no strategy for packaging type %s", spec.Type)
+		}
+		types = append(types, spec.Type)
+		specs[spec.Type] = spec
+	}
+
 	return &OrderPackager{
+		types:      types,
+		specs:      specs,
 		strategies: strategies,
-	}
+	}, nil
 }
 
 // PackageOrder packages an order
@@ -33,7 +50,7 @@ func (o OrderPackager) PackageOrder(
 ) (domain.PVZOrder, error) {
 	strategy, ok := o.strategies[packaging]
 	if !ok {
-		return domain.PVZOrder{}, fmt.Errorf("unknown packaging type: %s", domain.ErrInvalidArgument)
+		return domain.PVZOrder{}, fmt.Errorf("%w: unknown packaging type %s", domain.ErrInvalidArgument, packaging)
 	}
 
 	order, err := strategy.PackageOrder(order)
@@ -43,3 +60,40 @@ func (o OrderPackager) PackageOrder(
 
 	return order, nil
 }
+
+// ValidateCombination checks if the additional packaging may be added on top of the base one
+func (o OrderPackager) ValidateCombination(base, additional domain.PackagingType) error {
+	spec, ok := o.specs[base]
+	if !ok {
+		return fmt.Errorf("%w: unknown packaging type %s", domain.ErrInvalidArgument, base)
+	}
+
+	if !spec.CanCombineWith(additional) {
+		return fmt.Errorf("%w: %s packaging can not be added to %s packaging", domain.ErrInvalidArgument, additional, base)
+	}
+
+	return nil
+}
+
+// QuotePackaging runs every packaging strategy on the order and returns the valid options
+// in the order of the catalog. The order itself is not changed
+func (o OrderPackager) QuotePackaging(order domain.PVZOrder) ([]domain.PackagingQuote, error) {
+	quotes := make([]domain.PackagingQuote, 0, len(o.types))
+
+	for _, packaging := range o.types {
+		packed, err := o.strategies[packaging].PackageOrder(order)
+		if errors.Is(err, domain.ErrInvalidArgument) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		quotes = append(quotes, domain.PackagingQuote{
+			Packaging: packaging,
+			Price:     packed.Cost,
+		})
+	}
+
+	return quotes, nil
+}
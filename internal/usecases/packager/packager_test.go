@@ -69,3 +69,93 @@ func TestOrderPackager_PackageOrder(t *testing.T) {
 		})
 	}
 }
+
+func TestOrderPackager_ValidateCombination(t *testing.T) {
+	t.Parallel()
+
+	catalog := []domain.PackagingSpec{
+		{Type: domain.PackagingTypeBox, CombinableWith: []domain.PackagingType{domain.PackagingTypeFilm}},
+		{Type: domain.PackagingTypeFilm},
+	}
+
+	ctrl := minimock.NewController(t)
+
+	o, err := NewOrderPackager(catalog, map[domain.PackagingType]OrderPackagerStrategy{
+		domain.PackagingTypeBox:  mocks.NewOrderPackagerStrategyMock(ctrl),
+		domain.PackagingTypeFilm: mocks.NewOrderPackagerStrategyMock(ctrl),
+	})
+	assert.NoError(t, err)
+
+	isInvalidArgument := func(t assert.TestingT, err error, i ...interface{}) bool {
+		return assert.Error(t, err) && errors.Is(err, domain.ErrInvalidArgument)
+	}
+
+	tests := []struct {
+		name       string
+		base       domain.PackagingType
+		additional domain.PackagingType
+		wantErr    assert.ErrorAssertionFunc
+	}{
+		{
+			name:       "film on a box",
+			base:       domain.PackagingTypeBox,
+			additional: domain.PackagingTypeFilm,
+			wantErr:    assert.NoError,
+		},
+		{
+			name:       "film on a film",
+			base:       domain.PackagingTypeFilm,
+			additional: domain.PackagingTypeFilm,
+			wantErr:    isInvalidArgument,
+		},
+		{
+			name:       "unknown packaging type",
+			base:       domain.PackagingTypeBag,
+			additional: domain.PackagingTypeFilm,
+			wantErr:    isInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.wantErr(t, o.ValidateCombination(tt.base, tt.additional))
+		})
+	}
+}
+
+func TestNewOrderPackager_MissingStrategy(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewOrderPackager(
+		[]domain.PackagingSpec{{Type: domain.PackagingTypeBox}},
+		map[domain.PackagingType]OrderPackagerStrategy{},
+	)
+	assert.Error(t, err)
+}
+
+func TestOrderPackager_QuotePackaging(t *testing.T) {
+	t.Parallel()
+
+	catalog := []domain.PackagingSpec{
+		{Type: domain.PackagingTypeBox},
+		{Type: domain.PackagingTypeBag},
+		{Type: domain.PackagingTypeFilm},
+	}
+
+	ctrl := minimock.NewController(t)
+
+	o, err := NewOrderPackager(catalog, map[domain.PackagingType]OrderPackagerStrategy{
+		domain.PackagingTypeBox:  mocks.NewOrderPackagerStrategyMock(ctrl).PackageOrderMock.Return(domain.PVZOrder{Cost: domain.RUB(1200)}, nil),
+		domain.PackagingTypeBag:  mocks.NewOrderPackagerStrategyMock(ctrl).PackageOrderMock.Return(domain.PVZOrder{}, domain.ErrInvalidArgument),
+		domain.PackagingTypeFilm: mocks.NewOrderPackagerStrategyMock(ctrl).PackageOrderMock.Return(domain.PVZOrder{Cost: domain.RUB(1100)}, nil),
+	})
+	assert.NoError(t, err)
+
+	quotes, err := o.QuotePackaging(domain.PVZOrder{Cost: domain.RUB(1000), Weight: 20000})
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.PackagingQuote{
+		{Packaging: domain.PackagingTypeBox, Price: domain.RUB(1200)},
+		{Packaging: domain.PackagingTypeFilm, Price: domain.RUB(1100)},
+	}, quotes)
+}
@@ -0,0 +1,49 @@
+package strategies
+
+import (
+	"fmt"
+	"homework/internal/domain"
+	"homework/internal/usecases/packager"
+)
+
+var _ packager.OrderPackagerStrategy = &SpecPackager{}
+
+// SpecPackager is a packager for orders which follows the packaging spec of the catalog
+type SpecPackager struct {
+	spec domain.PackagingSpec
+}
+
+// NewSpecPackager creates a new packager for the packaging spec
+func NewSpecPackager(spec domain.PackagingSpec) *SpecPackager {
+	return &SpecPackager{
+		spec: spec,
+	}
+}
+
+// PackageOrder packages an order
+func (s SpecPackager) PackageOrder(order domain.PVZOrder) (domain.PVZOrder, error) {
+	if !s.spec.FitsWeight(order.ChargeableWeight()) {
+		return domain.PVZOrder{}, fmt.Errorf("%w: weight limit of %s packaging exceeded", domain.ErrInvalidArgument, s.spec.Type)
+	}
+
+	if !s.spec.FitsDimensions(order.Dimensions) {
+		return domain.PVZOrder{}, fmt.Errorf("%w: parcel %s does not fit into %s packaging", domain.ErrInvalidArgument, order.Dimensions, s.spec.Type)
+	}
+
+	cost, err := order.Cost.Add(s.spec.Cost)
+	if err != nil {
+		return domain.PVZOrder{}, fmt.Errorf("failed to add %s packaging cost: %w", s.spec.Type, err)
+	}
+
+	order.Cost = cost
+	return order, nil
+}
+
+// FromCatalog creates the packagers for every packaging type of the catalog
+func FromCatalog(catalog []domain.PackagingSpec) map[domain.PackagingType]packager.OrderPackagerStrategy {
+	strategies := make(map[domain.PackagingType]packager.OrderPackagerStrategy, len(catalog))
+	for _, spec := range catalog {
+		strategies[spec.Type] = NewSpecPackager(spec)
+	}
+	return strategies
+}
@@ -0,0 +1,71 @@
+package strategies
+
+import (
+	"errors"
+	"homework/internal/domain"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpecPackager_PackageOrder(t *testing.T) {
+	t.Parallel()
+
+	bag := NewSpecPackager(domain.PackagingSpec{
+		Type:          domain.PackagingTypeBag,
+		Cost:          domain.RUB(500),
+		WeightLimit:   5000,
+		MaxDimensions: domain.Dimensions{Length: 60, Width: 40, Height: 20},
+	})
+
+	isInvalidArgument := func(t assert.TestingT, err error, i ...interface{}) bool {
+		return assert.Error(t, err) && errors.Is(err, domain.ErrInvalidArgument)
+	}
+
+	tests := []struct {
+		name     string
+		order    domain.PVZOrder
+		wantCost domain.Money
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "without dimensions",
+			order:    domain.PVZOrder{Cost: domain.RUB(1000), Weight: 5000},
+			wantCost: domain.RUB(1500),
+			wantErr:  assert.NoError,
+		},
+		{
+			name:     "rotated parcel fits",
+			order:    domain.PVZOrder{Cost: domain.RUB(1000), Weight: 1000, Dimensions: domain.Dimensions{Length: 20, Width: 30, Height: 40}},
+			wantCost: domain.RUB(1500),
+			wantErr:  assert.NoError,
+		},
+		{
+			name:    "parcel is too large",
+			order:   domain.PVZOrder{Cost: domain.RUB(1000), Weight: 1000, Dimensions: domain.Dimensions{Length: 70, Width: 10, Height: 10}},
+			wantErr: isInvalidArgument,
+		},
+		{
+			// 60x40x20 is 48000 cm³, so the volumetric weight is 9600 g
+			name:    "volumetric weight exceeds the limit",
+			order:   domain.PVZOrder{Cost: domain.RUB(1000), Weight: 100, Dimensions: domain.Dimensions{Length: 60, Width: 40, Height: 20}},
+			wantErr: isInvalidArgument,
+		},
+		{
+			name:    "actual weight exceeds the limit",
+			order:   domain.PVZOrder{Cost: domain.RUB(1000), Weight: 6000, Dimensions: domain.Dimensions{Length: 10, Width: 10, Height: 10}},
+			wantErr: isInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := bag.PackageOrder(tt.order)
+			if !tt.wantErr(t, err) || err != nil {
+				return
+			}
+			assert.Equal(t, tt.wantCost, got.Cost)
+		})
+	}
+}
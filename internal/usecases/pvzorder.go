@@ -17,8 +17,12 @@ import (
 )
 
 const (
-	// TimeForReturn is a time for return
-	TimeForReturn = 2 * 24 * time.Hour
+	// MaxPickupAttempts is a number of failed pickup code attempts after which the pickup is locked
+	MaxPickupAttempts = 5
+	// PickupLockoutTime is a time for which the pickup is locked after too many failed attempts
+	PickupLockoutTime = 15 * time.Minute
+	// MaxDeliveryBatchSize is a maximum number of parcels accepted at once
+	MaxDeliveryBatchSize = 500
 )
 
 var _ abstractions.IPVZOrderUseCase = &PVZOrderUseCase{}
@@ -26,47 +30,93 @@ var _ abstractions.IPVZOrderUseCase = &PVZOrderUseCase{}
 //go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i PVZOrderRepository -s _mock.go -o ./mocks
 //go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i OrderPackagerInterface -s _mock.go -o ./mocks
 //go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i PVZOrderCache -s _mock.go -o ./mocks
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i PVZPolicies -s _mock.go -o ./mocks
 
 // PVZOrderRepository is an interface for order repository
 type PVZOrderRepository interface {
-	CreateOrder(ctx context.Context, order domain.PVZOrder) error
-	DeleteOrder(ctx context.Context, orderID string) error
-	SetOrderIssued(ctx context.Context, orderID string) error
-	SetOrderReturned(ctx context.Context, orderID string) error
+	CreateOrder(ctx context.Context, order domain.PVZOrder, pickupCode string) error
+	// CreateOrders creates the orders at once with the pickup codes by order IDs. The orders which already exist
+	// are skipped, the IDs of the created ones are returned
+	CreateOrders(ctx context.Context, orders []domain.PVZOrder, pickupCodes map[string]string) ([]string, error)
+	DeleteOrder(ctx context.Context, orderID string, expectedVersion int64) error
+	SetOrdersIssued(ctx context.Context, decisions []domain.IssueDecision) error
+	// SetOrderReturned marks the issued order as returned by the client with the details of the return
+	SetOrderReturned(ctx context.Context, orderID string, details domain.ReturnDetails, expectedVersion int64) error
+	SetOrderRefused(ctx context.Context, orderID, reason string, expectedVersion int64) error
 	GetOrders(ctx context.Context, userID string, options ...abstractions.GetOrdersOptFunc) ([]domain.PVZOrder, error)
 	GetOrder(ctx context.Context, orderID string) (domain.PVZOrder, error)
-	GetReturns(ctx context.Context, options ...abstractions.PagePaginationOptFunc) ([]domain.PVZOrder, error)
+	GetReturns(ctx context.Context, pvzID string, options ...abstractions.PagePaginationOptFunc) ([]domain.PVZOrder, error)
+	GetOrderHistory(ctx context.Context, orderID string) ([]domain.Event, error)
+	// ExtendStorage adds the extension to the storage time of the order and sets its new expiry
+	ExtendStorage(ctx context.Context, orderID string, extension time.Duration, expiresAt time.Time, extendedBy string, expectedVersion int64) error
+	// RegisterFailedPickupAttempt counts the failed attempt and locks the pickup for lockoutTime after maxAttempts of them.
+	// It returns the time until which the pickup is locked, which is zero or in the past if it is not locked
+	RegisterFailedPickupAttempt(ctx context.Context, orderID string, maxAttempts int, lockoutTime time.Duration) (time.Time, error)
+	// GetWeightDiscrepancies returns the orders of the PVZ accepted with the weight discrepancy in [from, to)
+	GetWeightDiscrepancies(ctx context.Context, pvzID string, from, to time.Time) ([]domain.PVZOrder, error)
 }
 
 type OrderPackagerInterface interface {
 	PackageOrder(order domain.PVZOrder, packagingType domain.PackagingType) (domain.PVZOrder, error)
+	ValidateCombination(base, additional domain.PackagingType) error
+	QuotePackaging(order domain.PVZOrder) ([]domain.PackagingQuote, error)
 }
 
 type PVZOrderCache interface {
 	GetOrders(ctx context.Context, userID string, options ...abstractions.GetOrdersOptFunc) ([]domain.PVZOrder, error, bool)
-	GetReturns(ctx context.Context, options ...abstractions.PagePaginationOptFunc) ([]domain.PVZOrder, error, bool)
+	GetReturns(ctx context.Context, pvzID string, options ...abstractions.PagePaginationOptFunc) ([]domain.PVZOrder, error, bool)
 	SetGetOrders(ctx context.Context, userID string, orders []domain.PVZOrder, options ...abstractions.GetOrdersOptFunc) error
-	SetGetReturns(ctx context.Context, orders []domain.PVZOrder, options ...abstractions.PagePaginationOptFunc) error
+	SetGetReturns(ctx context.Context, pvzID string, orders []domain.PVZOrder, options ...abstractions.PagePaginationOptFunc) error
 	GetOrder(ctx context.Context, orderID string) (domain.PVZOrder, error, bool)
 	SetOrder(ctx context.Context, order domain.PVZOrder) error
 }
 
+// PVZPolicies provides the rules which may differ from one PVZ to another
+type PVZPolicies interface {
+	// Policy is the return window, the maximum storage time and the limits of the orders the PVZ accepts
+	Policy(ctx context.Context, pvzID string) (domain.PVZPolicy, error)
+	// WeightTolerancePercent is how much the measured weight of the parcel may differ from the declared one
+	WeightTolerancePercent(ctx context.Context, pvzID string) (int, error)
+	// PaidStorage is the paid storage after the storage time for the orders in the given packaging
+	PaidStorage(ctx context.Context, pvzID string, packaging domain.PackagingType) (domain.PaidStorage, error)
+	// Calendar is the working calendar the storage and return periods are counted by
+	Calendar(ctx context.Context, pvzID string) (domain.Calendar, error)
+}
+
 // PVZOrderUseCase is a use case for order operations
 type PVZOrderUseCase struct {
-	repo         PVZOrderRepository
-	packager     OrderPackagerInterface
-	currentPVZID string
-	cache        PVZOrderCache
+	repo     PVZOrderRepository
+	packager OrderPackagerInterface
+	cache    PVZOrderCache
+	policies PVZPolicies
+	// pickupCodeKey is the key the pickup codes are hashed with
+	pickupCodeKey domain.PickupCodeKey
 }
 
 // NewPVZOrderUseCase creates a new order use case
-func NewPVZOrderUseCase(repo PVZOrderRepository, packager OrderPackagerInterface, currentPVZID string, cache PVZOrderCache) *PVZOrderUseCase {
+func NewPVZOrderUseCase(
+	repo PVZOrderRepository,
+	packager OrderPackagerInterface,
+	cache PVZOrderCache,
+	policies PVZPolicies,
+	pickupCodeKey domain.PickupCodeKey,
+) *PVZOrderUseCase {
 	return &PVZOrderUseCase{
-		repo:         repo,
-		packager:     packager,
-		currentPVZID: currentPVZID,
-		cache:        cache,
+		repo:          repo,
+		packager:      packager,
+		cache:         cache,
+		policies:      policies,
+		pickupCodeKey: pickupCodeKey,
+	}
+}
+
+// currentPVZID returns the PVZ the request is served for
+func currentPVZID(ctx context.Context) (string, error) {
+	pvzID, ok := abstractions.PVZIDFromContext(ctx)
+	if !ok {
+		return "", fmt.Errorf("%w: pvz id is not provided", domain.ErrInvalidArgument)
 	}
+	return pvzID, nil
 }
 
 func (P *PVZOrderUseCase) getOrder(ctx context.Context, orderID string) (domain.PVZOrder, error) {
@@ -119,133 +169,503 @@ func (P *PVZOrderUseCase) packageOrder(order domain.PVZOrder, packaging domain.P
 	return order, nil
 }
 
-// AcceptOrderDelivery accepts order delivery
-func (P *PVZOrderUseCase) AcceptOrderDelivery(ctx context.Context, orderID, recipientID string, storageTime time.Duration, cost, weight int, packaging domain.PackagingType, additionalFilm bool) error {
+// AcceptOrderDelivery accepts order delivery and returns the created order with the packaging fees included.
+// If the parcel was weighed and its weight differs from the declared one too much, the override reason is required
+// and the order is flagged with the weight discrepancy
+func (P *PVZOrderUseCase) AcceptOrderDelivery(ctx context.Context, orderID, recipientID string, storageTime time.Duration, cost domain.Money, weight int, dimensions domain.Dimensions, packaging domain.PackagingType, additionalFilm bool, options ...abstractions.AcceptOptFunc) (domain.PVZOrder, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZOrderUseCase.AcceptOrderDelivery")
 	defer span.Finish()
 
+	pvzID, err := currentPVZID(ctx)
+	if err != nil {
+		return domain.PVZOrder{}, err
+	}
+
+	opts, err := abstractions.NewAcceptOptions(options...)
+	if err != nil {
+		return domain.PVZOrder{}, err
+	}
+
+	if err := validateCost(cost); err != nil {
+		return domain.PVZOrder{}, err
+	}
+
 	if err := P.checkOrderID(ctx, orderID); err != nil {
-		return err
+		return domain.PVZOrder{}, err
 	}
 
-	if packaging == domain.PackagingTypeFilm && additionalFilm {
-		return fmt.Errorf("%w: additional film is not allowed for film packaging", domain.ErrInvalidArgument)
+	item := domain.DeliveryItem{
+		OrderID:              orderID,
+		RecipientID:          recipientID,
+		StorageTime:          storageTime,
+		Cost:                 cost,
+		Weight:               weight,
+		Dimensions:           dimensions,
+		Packaging:            packaging,
+		AdditionalFilm:       additionalFilm,
+		MeasuredWeight:       opts.MeasuredWeight,
+		WeightOverrideReason: opts.WeightOverrideReason,
+	}
+
+	policies, err := P.acceptPolicies(ctx, pvzID, item)
+	if err != nil {
+		return domain.PVZOrder{}, err
+	}
+
+	policies.paidStorage, err = P.policies.PaidStorage(ctx, pvzID, packaging)
+	if err != nil {
+		return domain.PVZOrder{}, err
+	}
+
+	order, pickupCode, err := P.newAcceptedOrder(pvzID, item, policies)
+	if err != nil {
+		return domain.PVZOrder{}, err
+	}
+
+	if err := P.repo.CreateOrder(ctx, order, pickupCode); err != nil {
+		return domain.PVZOrder{}, err
+	}
+
+	return order, nil
+}
+
+// weightTolerancePercent returns the tolerance of the PVZ if any of the parcels was weighed,
+// so the policies are not asked for the parcels accepted without the scales
+func (P *PVZOrderUseCase) weightTolerancePercent(ctx context.Context, pvzID string, items ...domain.DeliveryItem) (int, error) {
+	for _, item := range items {
+		if item.MeasuredWeight > 0 {
+			return P.policies.WeightTolerancePercent(ctx, pvzID)
+		}
+	}
+	return 0, nil
+}
+
+// paidStorage returns the paid storage of the PVZ for the packaging, the policies are asked once per packaging
+func (P *PVZOrderUseCase) paidStorage(ctx context.Context, pvzID string, packaging domain.PackagingType, known map[domain.PackagingType]domain.PaidStorage) (domain.PaidStorage, error) {
+	if paidStorage, ok := known[packaging]; ok {
+		return paidStorage, nil
+	}
+
+	paidStorage, err := P.policies.PaidStorage(ctx, pvzID, packaging)
+	if err != nil {
+		return domain.PaidStorage{}, err
+	}
+
+	known[packaging] = paidStorage
+	return paidStorage, nil
+}
+
+// acceptPolicies are the policies of the PVZ the parcels are accepted by
+type acceptPolicies struct {
+	policy                 domain.PVZPolicy
+	weightTolerancePercent int
+	paidStorage            domain.PaidStorage
+	calendar               domain.Calendar
+}
+
+// acceptPolicies returns the policies of the PVZ which are the same for all the parcels
+func (P *PVZOrderUseCase) acceptPolicies(ctx context.Context, pvzID string, items ...domain.DeliveryItem) (acceptPolicies, error) {
+	policy, err := P.policies.Policy(ctx, pvzID)
+	if err != nil {
+		return acceptPolicies{}, err
+	}
+
+	tolerance, err := P.weightTolerancePercent(ctx, pvzID, items...)
+	if err != nil {
+		return acceptPolicies{}, err
+	}
+
+	calendar, err := P.policies.Calendar(ctx, pvzID)
+	if err != nil {
+		return acceptPolicies{}, err
+	}
+
+	return acceptPolicies{policy: policy, weightTolerancePercent: tolerance, calendar: calendar}, nil
+}
+
+// newAcceptedOrder checks the measured weight, packages the parcel and issues the pickup code for the order.
+// The expiry and the paid storage are fixed on the order, so the later changes of the policies do not affect them
+func (P *PVZOrderUseCase) newAcceptedOrder(pvzID string, item domain.DeliveryItem, policies acceptPolicies) (domain.PVZOrder, string, error) {
+	if item.AdditionalFilm {
+		if err := P.packager.ValidateCombination(item.Packaging, domain.PackagingTypeFilm); err != nil {
+			return domain.PVZOrder{}, "", err
+		}
 	}
 
 	order := domain.NewPVZOrder(
-		orderID,
-		P.currentPVZID,
-		recipientID,
-		cost,
-		weight,
-		storageTime,
-		packaging,
-		additionalFilm,
+		item.OrderID,
+		pvzID,
+		item.RecipientID,
+		item.Cost,
+		item.Weight,
+		item.Dimensions,
+		item.StorageTime,
+		item.Packaging,
+		item.AdditionalFilm,
 	)
 
-	order, err := P.packageOrder(order, packaging, additionalFilm)
+	if err := order.SetMeasuredWeight(item.MeasuredWeight, policies.weightTolerancePercent, item.WeightOverrideReason); err != nil {
+		return domain.PVZOrder{}, "", err
+	}
+
+	if err := policies.policy.ValidateDelivery(order); err != nil {
+		return domain.PVZOrder{}, "", err
+	}
+
+	order, err := P.packageOrder(order, item.Packaging, item.AdditionalFilm)
 	if err != nil {
-		return err
+		return domain.PVZOrder{}, "", err
+	}
+
+	if err := policies.paidStorage.Validate(); err != nil {
+		return domain.PVZOrder{}, "", err
+	}
+	order.PaidStorage = policies.paidStorage
+	order.Expiry = policies.calendar.Deadline(order.ReceivedAt, order.StorageTime)
+
+	pickupCode, err := domain.NewPickupCode()
+	if err != nil {
+		return domain.PVZOrder{}, "", err
 	}
 
-	return P.repo.CreateOrder(ctx, order)
+	order.PickupCodeHash = P.pickupCodeKey.HashPickupCode(pickupCode)
+
+	return order, pickupCode, nil
+}
+
+// BatchAcceptOrderDelivery accepts the parcels the courier hands over at once.
+// Every parcel is validated and packaged independently: the result of each one is reported
+// in the returned list, and the valid ones are created together
+func (P *PVZOrderUseCase) BatchAcceptOrderDelivery(ctx context.Context, items []domain.DeliveryItem) ([]domain.DeliveryResult, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZOrderUseCase.BatchAcceptOrderDelivery")
+	defer span.Finish()
+
+	if len(items) == 0 {
+		return nil, fmt.Errorf("%w: items is empty", domain.ErrInvalidArgument)
+	}
+
+	if len(items) > MaxDeliveryBatchSize {
+		return nil, fmt.Errorf("%w: at most %d items can be accepted at once, got %d", domain.ErrInvalidArgument, MaxDeliveryBatchSize, len(items))
+	}
+
+	pvzID, err := currentPVZID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := P.acceptPolicies(ctx, pvzID, items...)
+	if err != nil {
+		return nil, err
+	}
+
+	paidStorages := make(map[domain.PackagingType]domain.PaidStorage)
+
+	results := make([]domain.DeliveryResult, len(items))
+	orders := make([]domain.PVZOrder, 0, len(items))
+	pickupCodes := make(map[string]string, len(items))
+	indexes := make(map[string]int, len(items))
+
+	for i, item := range items {
+		results[i].OrderID = item.OrderID
+
+		if item.OrderID == "" {
+			results[i].Err = fmt.Errorf("%w: orderID is empty", domain.ErrInvalidArgument)
+			continue
+		}
+
+		if _, ok := indexes[item.OrderID]; ok {
+			results[i].Err = fmt.Errorf("%w: order %s is repeated in the batch", domain.ErrInvalidArgument, item.OrderID)
+			continue
+		}
+
+		if err := validateCost(item.Cost); err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		policies.paidStorage, err = P.paidStorage(ctx, pvzID, item.Packaging, paidStorages)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		order, pickupCode, err := P.newAcceptedOrder(pvzID, item, policies)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		indexes[item.OrderID] = i
+		orders = append(orders, order)
+		pickupCodes[item.OrderID] = pickupCode
+	}
+
+	if len(orders) == 0 {
+		return results, nil
+	}
+
+	created, err := P.repo.CreateOrders(ctx, orders, pickupCodes)
+	if err != nil {
+		for _, order := range orders {
+			results[indexes[order.OrderID]].Err = err
+		}
+		return results, nil
+	}
+
+	createdIDs := make(map[string]struct{}, len(created))
+	for _, orderID := range created {
+		createdIDs[orderID] = struct{}{}
+	}
+
+	for _, order := range orders {
+		i := indexes[order.OrderID]
+		if _, ok := createdIDs[order.OrderID]; !ok {
+			results[i].Err = fmt.Errorf("%w: order already exists", domain.ErrAlreadyExists)
+			continue
+		}
+		results[i].Order = order
+	}
+
+	return results, nil
 }
 
 // ReturnOrderDelivery returns order delivery
-func (P *PVZOrderUseCase) ReturnOrderDelivery(ctx context.Context, orderID string) error {
+func (P *PVZOrderUseCase) ReturnOrderDelivery(ctx context.Context, orderID string, options ...abstractions.MutationOptFunc) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZOrderUseCase.ReturnOrderDelivery")
 	defer span.Finish()
 
+	opts, err := abstractions.NewMutationOptions(options...)
+	if err != nil {
+		return err
+	}
+
+	pvzID, err := currentPVZID(ctx)
+	if err != nil {
+		return err
+	}
+
 	order, err := P.getOrder(ctx, orderID)
 	if err != nil {
 		return err
 	}
 
-	if order.PVZID != P.currentPVZID {
+	if order.PVZID != pvzID {
 		return fmt.Errorf("%w: order does not belong to this PVZ", domain.ErrInvalidArgument)
 	}
 
-	if order.ReceivedAt.Add(order.StorageTime).After(time.Now()) {
-		return fmt.Errorf("%w: storage time has not expired", domain.ErrInvalidArgument)
+	if err := order.Status.ValidateTransition(domain.OrderStatusReturnedToCourier); err != nil {
+		return err
+	}
+
+	if order.Status != domain.OrderStatusExpired && !order.IsStorageOver(time.Now()) {
+		storedTooLong, err := P.storedLongerThanAllowed(ctx, order)
+		if err != nil {
+			return err
+		}
+		if !storedTooLong {
+			return fmt.Errorf("%w: storage time has not expired", domain.ErrInvalidArgument)
+		}
+	}
+
+	return P.repo.DeleteOrder(ctx, orderID, opts.ExpectedVersion)
+}
+
+// storedLongerThanAllowed checks if the order has been stored longer than the maximum storage time of the PVZ.
+// It happens when the maximum is lowered after the order is accepted or extended, such an order may be returned
+// to the courier before its own storage time is over
+func (P *PVZOrderUseCase) storedLongerThanAllowed(ctx context.Context, order domain.PVZOrder) (bool, error) {
+	policy, err := P.policies.Policy(ctx, order.PVZID)
+	if err != nil {
+		return false, err
 	}
 
-	if !order.IssuedAt.IsZero() {
-		return fmt.Errorf("%w: order is already issued", domain.ErrInvalidArgument)
+	if policy.MaxStorageTime <= 0 || order.StorageTime <= policy.MaxStorageTime {
+		return false, nil
 	}
 
-	return P.repo.DeleteOrder(ctx, orderID)
+	calendar, err := P.policies.Calendar(ctx, order.PVZID)
+	if err != nil {
+		return false, err
+	}
+
+	return calendar.Deadline(order.ReceivedAt, policy.MaxStorageTime).Before(time.Now()), nil
 }
 
-// GiveOrderToClient gives order to client
-func (P *PVZOrderUseCase) GiveOrderToClient(ctx context.Context, orderIDs []string) error {
+// GiveOrderToClient applies the client's decisions (issue or refuse) to the orders at pickup.
+// Decisions are applied independently: the result of each one is reported in the returned list
+// and a failed decision does not abort the others
+func (P *PVZOrderUseCase) GiveOrderToClient(ctx context.Context, decisions []domain.IssueDecision) ([]domain.IssueResult, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZOrderUseCase.GiveOrderToClient")
 	defer span.Finish()
 
-	if len(orderIDs) == 0 {
-		return fmt.Errorf("%w: orderIDs is empty", domain.ErrInvalidArgument)
+	if len(decisions) == 0 {
+		return nil, fmt.Errorf("%w: decisions is empty", domain.ErrInvalidArgument)
+	}
+
+	if err := validateIssueDecisions(decisions); err != nil {
+		return nil, err
 	}
 
-	orders := make([]domain.PVZOrder, len(orderIDs))
+	pvzID, err := currentPVZID(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	var err error
-	for i, orderID := range orderIDs {
-		orders[i], err = P.getOrder(ctx, orderID)
-		if err != nil {
-			return err
+	results := make([]domain.IssueResult, len(decisions))
+	orders := make([]domain.PVZOrder, len(decisions))
+
+	for i, decision := range decisions {
+		results[i] = domain.IssueResult{OrderID: decision.OrderID, Action: decision.Action}
+		orders[i], results[i].Err = P.getOrder(ctx, decision.OrderID)
+	}
+
+	userID := pickupRecipient(orders, results)
+
+	var issued []int
+	for i, decision := range decisions {
+		if results[i].Err != nil {
+			continue
+		}
+
+		if err := validateIssueDecision(orders[i], decision, pvzID, userID); err != nil {
+			results[i].Err = err
+			continue
+		}
+
+		if err := P.verifyPickupCode(ctx, orders[i], decision.PickupCode); err != nil {
+			results[i].Err = err
+			continue
 		}
+
+		if decision.Action == domain.IssueActionRefuse {
+			results[i].Err = P.repo.SetOrderRefused(ctx, decision.OrderID, decision.RefusalReason, decision.ExpectedVersion)
+			continue
+		}
+
+		issued = append(issued, i)
+	}
+
+	P.setOrdersIssued(ctx, pvzID, decisions, issued, results)
+
+	return results, nil
+}
+
+// setOrdersIssued issues the orders at the given indexes all at once,
+// so the client never leaves with only a part of the orders marked as issued
+func (P *PVZOrderUseCase) setOrdersIssued(ctx context.Context, pvzID string, decisions []domain.IssueDecision, indexes []int, results []domain.IssueResult) {
+	if len(indexes) == 0 {
+		return
 	}
 
-	return P.processOrders(ctx, orders)
+	issued := make([]domain.IssueDecision, len(indexes))
+	for i, idx := range indexes {
+		issued[i] = decisions[idx]
+	}
+
+	err := P.repo.SetOrdersIssued(ctx, issued)
+	for _, idx := range indexes {
+		results[idx].Err = err
+		if err == nil {
+			metrics.IncOrdersIssued(pvzID)
+		}
+	}
 }
 
-func (P *PVZOrderUseCase) processOrders(ctx context.Context, orders []domain.PVZOrder) error {
-	userID := orders[0].RecipientID
+// verifyPickupCode checks the code the client shows for the order. Failed attempts are counted,
+// so the code can not be brute forced: the pickup is locked after MaxPickupAttempts of them
+func (P *PVZOrderUseCase) verifyPickupCode(ctx context.Context, order domain.PVZOrder, pickupCode string) error {
+	if !order.RequiresPickupCode() {
+		return nil
+	}
+
+	if order.PickupLocked(time.Now()) {
+		return fmt.Errorf("%w: pickup of order %s is locked until %s", domain.ErrTooManyAttempts, order.OrderID, order.PickupLockedUntil.Format(time.RFC3339))
+	}
+
+	if pickupCode == "" {
+		return fmt.Errorf("%w: pickup code is required for order %s", domain.ErrInvalidArgument, order.OrderID)
+	}
+
+	if order.CheckPickupCode(P.pickupCodeKey, pickupCode) {
+		return nil
+	}
 
-	if err := validateGiveOrdersToClient(orders, P.currentPVZID, userID); err != nil {
+	lockedUntil, err := P.repo.RegisterFailedPickupAttempt(ctx, order.OrderID, MaxPickupAttempts, PickupLockoutTime)
+	if err != nil {
 		return err
 	}
 
-	return P.setOrdersIssued(ctx, orders)
+	if lockedUntil.After(time.Now()) {
+		return fmt.Errorf("%w: pickup of order %s is locked until %s", domain.ErrTooManyAttempts, order.OrderID, lockedUntil.Format(time.RFC3339))
+	}
+
+	return fmt.Errorf("%w: invalid pickup code for order %s", domain.ErrInvalidArgument, order.OrderID)
 }
 
-func validateGiveOrdersToClient(orders []domain.PVZOrder, currentPVZID string, userID string) error {
-	for _, order := range orders {
-		if err := validateGiveOrderToClient(order, currentPVZID); err != nil {
-			return err
+func validateIssueDecisions(decisions []domain.IssueDecision) error {
+	seen := make(map[string]struct{}, len(decisions))
+
+	for _, decision := range decisions {
+		if decision.OrderID == "" {
+			return fmt.Errorf("%w: orderID is empty", domain.ErrInvalidArgument)
 		}
 
-		if order.RecipientID != userID {
-			return fmt.Errorf("%w: orders do not belong to the same user", domain.ErrInvalidArgument)
+		if _, ok := seen[decision.OrderID]; ok {
+			return fmt.Errorf("%w: duplicate decision for order %s", domain.ErrInvalidArgument, decision.OrderID)
+		}
+		seen[decision.OrderID] = struct{}{}
+
+		switch decision.Action {
+		case domain.IssueActionIssue:
+		case domain.IssueActionRefuse:
+			if decision.RefusalReason == "" {
+				return fmt.Errorf("%w: refusal reason is empty for order %s", domain.ErrInvalidArgument, decision.OrderID)
+			}
+		default:
+			return fmt.Errorf("%w: unknown issue action %s for order %s", domain.ErrInvalidArgument, decision.Action, decision.OrderID)
 		}
 	}
 
 	return nil
 }
 
-func validateGiveOrderToClient(order domain.PVZOrder, currentPVZID string) error {
+// pickupRecipient returns the client who picks the orders up, that is the recipient of the first found order
+func pickupRecipient(orders []domain.PVZOrder, results []domain.IssueResult) string {
+	for i, order := range orders {
+		if results[i].Err == nil {
+			return order.RecipientID
+		}
+	}
+	return ""
+}
+
+func validateIssueDecision(order domain.PVZOrder, decision domain.IssueDecision, currentPVZID string, userID string) error {
 	if order.PVZID != currentPVZID {
 		return fmt.Errorf("%w: order does not belong to this PVZ", domain.ErrInvalidArgument)
 	}
 
-	if !order.IssuedAt.IsZero() {
-		return fmt.Errorf("%w: order is already issued", domain.ErrInvalidArgument)
+	if order.RecipientID != userID {
+		return fmt.Errorf("%w: orders do not belong to the same user", domain.ErrInvalidArgument)
 	}
 
-	if order.ReceivedAt.Add(order.StorageTime).Before(time.Now()) {
-		return fmt.Errorf("%w: orders storage time has expired", domain.ErrInvalidArgument)
+	next := domain.OrderStatusIssued
+	if decision.Action == domain.IssueActionRefuse {
+		next = domain.OrderStatusRefused
 	}
 
-	return nil
-}
+	if err := order.Status.ValidateTransition(next); err != nil {
+		return err
+	}
 
-func (P *PVZOrderUseCase) setOrdersIssued(ctx context.Context, orders []domain.PVZOrder) error {
-	for _, order := range orders {
-		err := P.repo.SetOrderIssued(ctx, order.OrderID)
-		if err != nil {
-			return err
-		}
-		metrics.IncOrdersIssued(P.currentPVZID)
+	now := time.Now()
+	if order.IsStorageOver(now) {
+		return fmt.Errorf("%w: orders storage time has expired", domain.ErrInvalidArgument)
 	}
+
+	if decision.Action == domain.IssueActionIssue {
+		return order.ValidateStorageFeePaid(now, decision.StorageFeePaid)
+	}
+
 	return nil
 }
 
@@ -259,7 +679,11 @@ func (P *PVZOrderUseCase) GetOrders(ctx context.Context, userID string, options
 		_ = optFunc(opts)
 		return opts.SamePVZ
 	}) {
-		options = append(options, abstractions.WithPVZID(P.currentPVZID))
+		pvzID, err := currentPVZID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, abstractions.WithPVZID(pvzID))
 	}
 
 	orders, err, ok := P.cache.GetOrders(ctx, userID, options...)
@@ -268,7 +692,7 @@ func (P *PVZOrderUseCase) GetOrders(ctx context.Context, userID string, options
 	}
 
 	if ok {
-		return orders, nil
+		return P.withReturnDeadlines(ctx, orders)
 	}
 
 	orders, err = P.repo.GetOrders(ctx, userID, options...)
@@ -281,44 +705,118 @@ func (P *PVZOrderUseCase) GetOrders(ctx context.Context, userID string, options
 		return nil, err
 	}
 
+	return P.withReturnDeadlines(ctx, orders)
+}
+
+// withReturnDeadlines returns a copy of the orders with the return deadlines of the issued ones
+// counted by the calendars of their PVZs
+func (P *PVZOrderUseCase) withReturnDeadlines(ctx context.Context, orders []domain.PVZOrder) ([]domain.PVZOrder, error) {
+	if !slices.ContainsFunc(orders, func(order domain.PVZOrder) bool { return order.Status == domain.OrderStatusIssued }) {
+		return orders, nil
+	}
+
+	orders = slices.Clone(orders)
+	deadlines := make(map[string]func(issuedAt time.Time) time.Time)
+
+	for i, order := range orders {
+		if order.Status != domain.OrderStatusIssued {
+			continue
+		}
+
+		deadline, ok := deadlines[order.PVZID]
+		if !ok {
+			var err error
+			deadline, err = P.returnDeadlineFunc(ctx, order.PVZID)
+			if err != nil {
+				return nil, err
+			}
+			deadlines[order.PVZID] = deadline
+		}
+
+		orders[i].ReturnDeadline = deadline(order.IssuedAt)
+	}
+
 	return orders, nil
 }
 
+// returnDeadline returns the time until which the issued order may be returned by the client
+func (P *PVZOrderUseCase) returnDeadline(ctx context.Context, order domain.PVZOrder) (time.Time, error) {
+	deadline, err := P.returnDeadlineFunc(ctx, order.PVZID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return deadline(order.IssuedAt), nil
+}
+
+// returnDeadlineFunc returns a function which counts the return deadline by the return window and the calendar of the PVZ
+func (P *PVZOrderUseCase) returnDeadlineFunc(ctx context.Context, pvzID string) (func(issuedAt time.Time) time.Time, error) {
+	policy, err := P.policies.Policy(ctx, pvzID)
+	if err != nil {
+		return nil, err
+	}
+
+	calendar, err := P.policies.Calendar(ctx, pvzID)
+	if err != nil {
+		return nil, err
+	}
+
+	returnWindow := policy.ReturnWindow
+	if returnWindow <= 0 {
+		returnWindow = domain.DefaultReturnWindow
+	}
+
+	return func(issuedAt time.Time) time.Time {
+		return calendar.Deadline(issuedAt, returnWindow)
+	}, nil
+}
+
 func validateAcceptReturn(userID string, order domain.PVZOrder) error {
 	if order.RecipientID != userID {
 		return fmt.Errorf("%w: user is not recipient", domain.ErrInvalidArgument)
 	}
 
-	if !order.ReturnedAt.IsZero() {
-		return fmt.Errorf("%w: order is already returned", domain.ErrInvalidArgument)
-	}
-
-	if order.IssuedAt.IsZero() {
-		return fmt.Errorf("%w: order is not issued", domain.ErrInvalidArgument)
+	if err := order.Status.ValidateTransition(domain.OrderStatusReturnedByClient); err != nil {
+		return err
 	}
 
-	if order.IssuedAt.Add(TimeForReturn).Before(time.Now()) {
+	if order.ReturnDeadline.Before(time.Now()) {
 		return fmt.Errorf("%w: time for return has expired", domain.ErrInvalidArgument)
 	}
 
 	return nil
 }
 
-// AcceptReturn accepts return
-func (P *PVZOrderUseCase) AcceptReturn(ctx context.Context, userID, orderID string) error {
+// AcceptReturn accepts return with the reason of the client and the inspection outcome of the operator
+func (P *PVZOrderUseCase) AcceptReturn(ctx context.Context, userID, orderID string, details domain.ReturnDetails, options ...abstractions.MutationOptFunc) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZOrderUseCase.AcceptReturn")
 	defer span.Finish()
 
+	opts, err := abstractions.NewMutationOptions(options...)
+	if err != nil {
+		return err
+	}
+
+	if err := details.Validate(); err != nil {
+		return err
+	}
+
 	order, err := P.getOrder(ctx, orderID)
 	if err != nil {
 		return err
 	}
 
+	if order.Status == domain.OrderStatusIssued {
+		order.ReturnDeadline, err = P.returnDeadline(ctx, order)
+		if err != nil {
+			return err
+		}
+	}
+
 	if err := validateAcceptReturn(userID, order); err != nil {
 		return err
 	}
 
-	return P.repo.SetOrderReturned(ctx, orderID)
+	return P.repo.SetOrderReturned(ctx, orderID, details, opts.ExpectedVersion)
 }
 
 // GetReturns gets returns
@@ -326,7 +824,12 @@ func (P *PVZOrderUseCase) GetReturns(ctx context.Context, options ...abstraction
 	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZOrderUseCase.GetReturns")
 	defer span.Finish()
 
-	orders, err, ok := P.cache.GetReturns(ctx, options...)
+	pvzID, err := currentPVZID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orders, err, ok := P.cache.GetReturns(ctx, pvzID, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -335,15 +838,194 @@ func (P *PVZOrderUseCase) GetReturns(ctx context.Context, options ...abstraction
 		return orders, nil
 	}
 
-	orders, err = P.repo.GetReturns(ctx, options...)
+	orders, err = P.repo.GetReturns(ctx, pvzID, options...)
 	if err != nil {
 		return nil, err
 	}
 
-	err = P.cache.SetGetReturns(ctx, orders, options...)
+	err = P.cache.SetGetReturns(ctx, pvzID, orders, options...)
 	if err != nil {
 		return nil, err
 	}
 
 	return orders, nil
 }
+
+// GetWeightDiscrepancyReport returns the orders of the current PVZ accepted with the weight discrepancy on the date.
+// The day is taken in the time zone of the PVZ calendar, the zero date means today
+func (P *PVZOrderUseCase) GetWeightDiscrepancyReport(ctx context.Context, date time.Time) (domain.WeightDiscrepancyReport, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZOrderUseCase.GetWeightDiscrepancyReport")
+	defer span.Finish()
+
+	pvzID, err := currentPVZID(ctx)
+	if err != nil {
+		return domain.WeightDiscrepancyReport{}, err
+	}
+
+	calendar, err := P.policies.Calendar(ctx, pvzID)
+	if err != nil {
+		return domain.WeightDiscrepancyReport{}, err
+	}
+
+	if date.IsZero() {
+		date = calendar.Today()
+	}
+	from, to := calendar.Day(date)
+
+	orders, err := P.repo.GetWeightDiscrepancies(ctx, pvzID, from, to)
+	if err != nil {
+		return domain.WeightDiscrepancyReport{}, err
+	}
+
+	return domain.WeightDiscrepancyReport{
+		PVZID:  pvzID,
+		Date:   from,
+		Orders: orders,
+	}, nil
+}
+
+// GetOrderHistory gets the chronological list of events of the order
+func (P *PVZOrderUseCase) GetOrderHistory(ctx context.Context, orderID string) ([]domain.Event, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZOrderUseCase.GetOrderHistory")
+	defer span.Finish()
+
+	if orderID == "" {
+		return nil, fmt.Errorf("%w: orderID is empty", domain.ErrInvalidArgument)
+	}
+
+	events, err := P.repo.GetOrderHistory(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range events {
+		events[i] = events[i].Redacted()
+	}
+
+	return events, nil
+}
+
+// ExtendStorage extends the storage time of the order within the maximum allowed in the PVZ
+func (P *PVZOrderUseCase) ExtendStorage(ctx context.Context, orderID string, extension time.Duration, extendedBy string, options ...abstractions.MutationOptFunc) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZOrderUseCase.ExtendStorage")
+	defer span.Finish()
+
+	opts, err := abstractions.NewMutationOptions(options...)
+	if err != nil {
+		return err
+	}
+
+	if extension <= 0 {
+		return fmt.Errorf("%w: extension must be positive", domain.ErrInvalidArgument)
+	}
+
+	if extendedBy == "" {
+		return fmt.Errorf("%w: extendedBy is empty", domain.ErrInvalidArgument)
+	}
+
+	pvzID, err := currentPVZID(ctx)
+	if err != nil {
+		return err
+	}
+
+	// The new expiry is counted from the storage time of the order, so the order is read past the cache
+	// and is only extended if it has not changed since
+	order, err := P.repo.GetOrder(ctx, orderID)
+	if err != nil {
+		return err
+	}
+
+	policy, err := P.policies.Policy(ctx, pvzID)
+	if err != nil {
+		return err
+	}
+
+	if err := validateExtendStorage(order, extension, pvzID, policy.MaxStorageTime); err != nil {
+		return err
+	}
+
+	calendar, err := P.policies.Calendar(ctx, order.PVZID)
+	if err != nil {
+		return err
+	}
+	expiresAt := calendar.Deadline(order.ReceivedAt, order.StorageTime+extension)
+
+	expectedVersion := opts.ExpectedVersion
+	if expectedVersion == 0 {
+		expectedVersion = order.Version
+	}
+
+	return P.repo.ExtendStorage(ctx, orderID, extension, expiresAt, extendedBy, expectedVersion)
+}
+
+func validateExtendStorage(order domain.PVZOrder, extension time.Duration, currentPVZID string, maxStorageTime time.Duration) error {
+	if order.PVZID != currentPVZID {
+		return fmt.Errorf("%w: order does not belong to this PVZ", domain.ErrInvalidArgument)
+	}
+
+	if order.Status != domain.OrderStatusAccepted {
+		return fmt.Errorf("%w: order in status %s can not be extended", domain.ErrInvalidArgument, order.Status)
+	}
+
+	if order.ExpiresAt().Before(time.Now()) {
+		return fmt.Errorf("%w: orders storage time has expired", domain.ErrInvalidArgument)
+	}
+
+	// The storage time is unlimited in the PVZ without the max storage time
+	if maxStorageTime > 0 && order.StorageTime+extension > maxStorageTime {
+		return fmt.Errorf("%w: storage time can not exceed %s in this PVZ", domain.ErrInvalidArgument, maxStorageTime)
+	}
+
+	return nil
+}
+
+// QuotePackaging returns the packaging options which are valid for the order of the given weight, dimensions and cost,
+// with the final price of the order. Dimensions may be zero if they are unknown. No order is created
+func (P *PVZOrderUseCase) QuotePackaging(ctx context.Context, cost domain.Money, weight int, dimensions domain.Dimensions) ([]domain.PackagingQuote, error) {
+	span, _ := opentracing.StartSpanFromContext(ctx, "PVZOrderUseCase.QuotePackaging")
+	defer span.Finish()
+
+	if err := validateCost(cost); err != nil {
+		return nil, err
+	}
+
+	if weight < 0 {
+		return nil, fmt.Errorf("%w: weight must not be negative", domain.ErrInvalidArgument)
+	}
+
+	quotes, err := P.packager.QuotePackaging(domain.PVZOrder{Cost: cost, Weight: weight, Dimensions: dimensions})
+	if err != nil {
+		return nil, err
+	}
+
+	markCheapest(quotes)
+
+	return quotes, nil
+}
+
+// validateCost checks that the cost of the order is not negative and its currency is supported
+func validateCost(cost domain.Money) error {
+	if _, err := domain.NewCurrency(cost.Currency.String()); err != nil {
+		return err
+	}
+
+	if cost.IsNegative() {
+		return fmt.Errorf("%w: cost must not be negative", domain.ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+// markCheapest marks the first of the options with the lowest price
+func markCheapest(quotes []domain.PackagingQuote) {
+	cheapest := -1
+	for i, quote := range quotes {
+		if cheapest == -1 || quote.Price.Less(quotes[cheapest].Price) {
+			cheapest = i
+		}
+	}
+
+	if cheapest != -1 {
+		quotes[cheapest].Cheapest = true
+	}
+}
@@ -3,6 +3,7 @@ package usecases
 import (
 	"context"
 	"errors"
+	"homework/internal/abstractions"
 	"homework/internal/domain"
 	"homework/internal/usecases/mocks"
 	"testing"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
 )
 
 func TestPVZOrderUseCase_AcceptOrderDelivery(t *testing.T) {
@@ -20,19 +22,27 @@ func TestPVZOrderUseCase_AcceptOrderDelivery(t *testing.T) {
 	type args struct {
 		orderID, recipientID string
 		storageTime          time.Duration
-		cost, weight         int
+		cost                 domain.Money
+		weight               int
+		dimensions           domain.Dimensions
 		packaging            domain.PackagingType
 		additionalFilm       bool
 	}
 
-	ctx := context.Background()
+	ctx := abstractions.ContextWithPVZID(context.Background(), pvzID)
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	isInvalidArgument := func(t assert.TestingT, err error, i ...interface{}) bool {
+		return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
+	}
+
 	tests := []struct {
 		name    string
 		args    args
+		policy  domain.PVZPolicy
 		setup   func(repoMock *mocks.PVZOrderRepositoryMock, packagerMock *mocks.OrderPackagerInterfaceMock, cacheMock *mocks.PVZOrderCacheMock)
+		want    domain.PVZOrder
 		wantErr assert.ErrorAssertionFunc
 	}{
 		{
@@ -41,25 +51,42 @@ func TestPVZOrderUseCase_AcceptOrderDelivery(t *testing.T) {
 				orderID:        "orderID",
 				recipientID:    "recipientID",
 				storageTime:    1 * time.Hour,
-				cost:           100,
+				cost:           domain.RUB(100),
 				weight:         1,
 				packaging:      domain.PackagingTypeBox,
 				additionalFilm: false,
 			},
 			setup: func(repoMock *mocks.PVZOrderRepositoryMock, packagerMock *mocks.OrderPackagerInterfaceMock, _ *mocks.PVZOrderCacheMock) {
 				repoMock.GetOrderMock.Return(domain.PVZOrder{}, domain.ErrNotFound)
-				packagerMock.PackageOrderMock.Return(domain.PVZOrder{}, nil)
+				packagerMock.PackageOrderMock.Return(domain.PVZOrder{OrderID: "orderID", Cost: domain.RUB(2100)}, nil)
 				repoMock.CreateOrderMock.Return(nil)
 			},
+			want:    domain.PVZOrder{OrderID: "orderID", Cost: domain.RUB(2100)},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "Unsupported currency",
+			args: args{
+				orderID:     "orderID",
+				recipientID: "recipientID",
+				storageTime: 1 * time.Hour,
+				cost:        domain.NewMoney(100, "XXX"),
+				weight:      1,
+				packaging:   domain.PackagingTypeBox,
+			},
+			setup: func(_ *mocks.PVZOrderRepositoryMock, _ *mocks.OrderPackagerInterfaceMock, _ *mocks.PVZOrderCacheMock) {
+			},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
+			},
+		},
 		{
 			name: "Order already exists",
 			args: args{
 				orderID:        "orderID",
 				recipientID:    "recipientID",
 				storageTime:    1 * time.Hour,
-				cost:           100,
+				cost:           domain.RUB(100),
 				weight:         1,
 				packaging:      domain.PackagingTypeBox,
 				additionalFilm: false,
@@ -77,18 +104,104 @@ func TestPVZOrderUseCase_AcceptOrderDelivery(t *testing.T) {
 				orderID:        "orderID",
 				recipientID:    "recipientID",
 				storageTime:    1 * time.Hour,
-				cost:           100,
+				cost:           domain.RUB(100),
 				weight:         1,
 				packaging:      domain.PackagingTypeFilm,
 				additionalFilm: true,
 			},
-			setup: func(repoMock *mocks.PVZOrderRepositoryMock, _ *mocks.OrderPackagerInterfaceMock, _ *mocks.PVZOrderCacheMock) {
+			setup: func(repoMock *mocks.PVZOrderRepositoryMock, packagerMock *mocks.OrderPackagerInterfaceMock, _ *mocks.PVZOrderCacheMock) {
 				repoMock.GetOrderMock.Return(domain.PVZOrder{}, domain.ErrNotFound)
+				packagerMock.ValidateCombinationMock.Expect(domain.PackagingTypeFilm, domain.PackagingTypeFilm).Return(domain.ErrInvalidArgument)
 			},
 			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
 				return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
 			},
 		},
+		{
+			name: "Additional film on a bag",
+			args: args{
+				orderID:        "orderID",
+				recipientID:    "recipientID",
+				storageTime:    1 * time.Hour,
+				cost:           domain.RUB(100),
+				weight:         1,
+				packaging:      domain.PackagingTypeBag,
+				additionalFilm: true,
+			},
+			setup: func(repoMock *mocks.PVZOrderRepositoryMock, packagerMock *mocks.OrderPackagerInterfaceMock, _ *mocks.PVZOrderCacheMock) {
+				repoMock.GetOrderMock.Return(domain.PVZOrder{}, domain.ErrNotFound)
+				packagerMock.ValidateCombinationMock.Expect(domain.PackagingTypeBag, domain.PackagingTypeFilm).Return(nil)
+				packagerMock.PackageOrderMock.Return(domain.PVZOrder{}, nil)
+				repoMock.CreateOrderMock.Return(nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Storage time exceeds the maximum of the PVZ",
+			args: args{
+				orderID:     "orderID",
+				recipientID: "recipientID",
+				storageTime: 10 * 24 * time.Hour,
+				cost:        domain.RUB(100),
+				weight:      1,
+				packaging:   domain.PackagingTypeBox,
+			},
+			policy: domain.PVZPolicy{MaxStorageTime: 7 * 24 * time.Hour},
+			setup: func(repoMock *mocks.PVZOrderRepositoryMock, _ *mocks.OrderPackagerInterfaceMock, _ *mocks.PVZOrderCacheMock) {
+				repoMock.GetOrderMock.Return(domain.PVZOrder{}, domain.ErrNotFound)
+			},
+			wantErr: isInvalidArgument,
+		},
+		{
+			name: "Order is heavier than the PVZ accepts",
+			args: args{
+				orderID:     "orderID",
+				recipientID: "recipientID",
+				storageTime: 1 * time.Hour,
+				cost:        domain.RUB(100),
+				weight:      30,
+				packaging:   domain.PackagingTypeBox,
+			},
+			policy: domain.PVZPolicy{MaxOrderWeight: 20},
+			setup: func(repoMock *mocks.PVZOrderRepositoryMock, _ *mocks.OrderPackagerInterfaceMock, _ *mocks.PVZOrderCacheMock) {
+				repoMock.GetOrderMock.Return(domain.PVZOrder{}, domain.ErrNotFound)
+			},
+			wantErr: isInvalidArgument,
+		},
+		{
+			name: "Packaging is not accepted in the PVZ",
+			args: args{
+				orderID:     "orderID",
+				recipientID: "recipientID",
+				storageTime: 1 * time.Hour,
+				cost:        domain.RUB(100),
+				weight:      1,
+				packaging:   domain.PackagingTypeBox,
+			},
+			policy: domain.PVZPolicy{AllowedPackaging: []domain.PackagingType{domain.PackagingTypeBag}},
+			setup: func(repoMock *mocks.PVZOrderRepositoryMock, _ *mocks.OrderPackagerInterfaceMock, _ *mocks.PVZOrderCacheMock) {
+				repoMock.GetOrderMock.Return(domain.PVZOrder{}, domain.ErrNotFound)
+			},
+			wantErr: isInvalidArgument,
+		},
+		{
+			name: "Additional film is not accepted in the PVZ",
+			args: args{
+				orderID:        "orderID",
+				recipientID:    "recipientID",
+				storageTime:    1 * time.Hour,
+				cost:           domain.RUB(100),
+				weight:         1,
+				packaging:      domain.PackagingTypeBag,
+				additionalFilm: true,
+			},
+			policy: domain.PVZPolicy{AllowedPackaging: []domain.PackagingType{domain.PackagingTypeBag}},
+			setup: func(repoMock *mocks.PVZOrderRepositoryMock, packagerMock *mocks.OrderPackagerInterfaceMock, _ *mocks.PVZOrderCacheMock) {
+				repoMock.GetOrderMock.Return(domain.PVZOrder{}, domain.ErrNotFound)
+				packagerMock.ValidateCombinationMock.Expect(domain.PackagingTypeBag, domain.PackagingTypeFilm).Return(nil)
+			},
+			wantErr: isInvalidArgument,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -97,10 +210,329 @@ func TestPVZOrderUseCase_AcceptOrderDelivery(t *testing.T) {
 			repoMock := mocks.NewPVZOrderRepositoryMock(ctrl)
 			packagerMock := mocks.NewOrderPackagerInterfaceMock(ctrl)
 			cacheMock := mocks.NewPVZOrderCacheMock(ctrl)
-			uc := NewPVZOrderUseCase(repoMock, packagerMock, pvzID, cacheMock)
+			policiesMock := mocks.NewPVZPoliciesMock(ctrl)
+			policiesMock.PaidStorageMock.Optional().Return(domain.PaidStorage{}, nil)
+			policiesMock.CalendarMock.Optional().Return(domain.Calendar{}, nil)
+			policiesMock.PolicyMock.Optional().Return(tt.policy, nil)
+			uc := NewPVZOrderUseCase(repoMock, packagerMock, cacheMock, policiesMock, nil)
 			tt.setup(repoMock, packagerMock, cacheMock)
-			err := uc.AcceptOrderDelivery(ctx, tt.args.orderID, tt.args.recipientID, tt.args.storageTime, tt.args.cost, tt.args.weight, tt.args.packaging, tt.args.additionalFilm)
-			tt.wantErr(t, err)
+			got, err := uc.AcceptOrderDelivery(ctx, tt.args.orderID, tt.args.recipientID, tt.args.storageTime, tt.args.cost, tt.args.weight, tt.args.dimensions, tt.args.packaging, tt.args.additionalFilm)
+			if !tt.wantErr(t, err) || err != nil {
+				return
+			}
+			assert.Equal(t, tt.want.OrderID, got.OrderID)
+			assert.Equal(t, tt.want.Cost, got.Cost)
+			assert.NotEmpty(t, got.PickupCodeHash)
+		})
+	}
+}
+
+func TestPVZOrderUseCase_BatchAcceptOrderDelivery(t *testing.T) {
+	t.Parallel()
+
+	ctx := abstractions.ContextWithPVZID(context.Background(), "currentPVZID")
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	item := func(orderID string) domain.DeliveryItem {
+		return domain.DeliveryItem{
+			OrderID:     orderID,
+			RecipientID: "recipientID",
+			StorageTime: time.Hour,
+			Cost:        domain.RUB(100),
+			Weight:      1,
+			Packaging:   domain.PackagingTypeBox,
+		}
+	}
+
+	t.Run("Per-item results", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := minimock.NewController(t)
+		repoMock := mocks.NewPVZOrderRepositoryMock(ctrl)
+		packagerMock := mocks.NewOrderPackagerInterfaceMock(ctrl)
+		policiesMock := mocks.NewPVZPoliciesMock(ctrl)
+		uc := NewPVZOrderUseCase(repoMock, packagerMock, mocks.NewPVZOrderCacheMock(ctrl), policiesMock, nil)
+
+		paidStorage := domain.PaidStorage{Days: 3, DailyFee: domain.RUB(5000)}
+		policiesMock.PaidStorageMock.Expect(minimock.AnyContext, "currentPVZID", domain.PackagingTypeBox).Times(1).Return(paidStorage, nil)
+		calendar, err := domain.NewCalendar(time.UTC, 9*time.Hour, 21*time.Hour, []time.Weekday{time.Saturday, time.Sunday}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		policiesMock.CalendarMock.Expect(minimock.AnyContext, "currentPVZID").Return(calendar, nil)
+		policiesMock.PolicyMock.Expect(minimock.AnyContext, "currentPVZID").Times(1).Return(domain.PVZPolicy{}, nil)
+
+		unsupportedCurrency := item("unsupportedCurrency")
+		unsupportedCurrency.Cost = domain.NewMoney(100, "XXX")
+		tooHeavy := item("tooHeavy")
+		tooHeavy.Weight = 100000
+
+		packagerMock.PackageOrderMock.Set(func(order domain.PVZOrder, _ domain.PackagingType) (domain.PVZOrder, error) {
+			if order.Weight > 30000 {
+				return domain.PVZOrder{}, domain.ErrInvalidArgument
+			}
+			order.Cost = domain.RUB(2100)
+			return order, nil
+		})
+		repoMock.CreateOrdersMock.Set(func(_ context.Context, orders []domain.PVZOrder, pickupCodes map[string]string) ([]string, error) {
+			assert.Len(t, orders, 2)
+			assert.Len(t, pickupCodes, 2)
+			assert.Equal(t, "currentPVZID", orders[0].PVZID)
+			return []string{"accepted"}, nil
+		})
+
+		results, err := uc.BatchAcceptOrderDelivery(ctx, []domain.DeliveryItem{
+			item("accepted"),
+			unsupportedCurrency,
+			tooHeavy,
+			item("exists"),
+			item("accepted"),
+		})
+		assert.NoError(t, err)
+		if !assert.Len(t, results, 5) {
+			return
+		}
+
+		assert.NoError(t, results[0].Err)
+		assert.Equal(t, "accepted", results[0].Order.OrderID)
+		assert.Equal(t, domain.RUB(2100), results[0].Order.Cost)
+		assert.NotEmpty(t, results[0].Order.PickupCodeHash)
+		assert.Equal(t, paidStorage, results[0].Order.PaidStorage)
+		assert.Equal(t, calendar.Deadline(results[0].Order.ReceivedAt, time.Hour), results[0].Order.ExpiresAt())
+
+		assert.ErrorIs(t, results[1].Err, domain.ErrInvalidArgument)
+		assert.ErrorIs(t, results[2].Err, domain.ErrInvalidArgument)
+		assert.ErrorIs(t, results[3].Err, domain.ErrAlreadyExists)
+		assert.ErrorIs(t, results[4].Err, domain.ErrInvalidArgument)
+		for i, want := range []string{"accepted", "unsupportedCurrency", "tooHeavy", "exists", "accepted"} {
+			assert.Equal(t, want, results[i].OrderID)
+		}
+	})
+
+	t.Run("Repository error fails the valid items", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := minimock.NewController(t)
+		repoMock := mocks.NewPVZOrderRepositoryMock(ctrl)
+		packagerMock := mocks.NewOrderPackagerInterfaceMock(ctrl)
+		policiesMock := mocks.NewPVZPoliciesMock(ctrl)
+		policiesMock.PaidStorageMock.Return(domain.PaidStorage{}, nil)
+		policiesMock.CalendarMock.Return(domain.Calendar{}, nil)
+		policiesMock.PolicyMock.Return(domain.PVZPolicy{}, nil)
+		uc := NewPVZOrderUseCase(repoMock, packagerMock, mocks.NewPVZOrderCacheMock(ctrl), policiesMock, nil)
+
+		repoErr := errors.New("connection lost")
+		packagerMock.PackageOrderMock.Set(func(order domain.PVZOrder, _ domain.PackagingType) (domain.PVZOrder, error) {
+			return order, nil
+		})
+		repoMock.CreateOrdersMock.Return(nil, repoErr)
+
+		results, err := uc.BatchAcceptOrderDelivery(ctx, []domain.DeliveryItem{item("1"), item("2")})
+		assert.NoError(t, err)
+		for _, result := range results {
+			assert.ErrorIs(t, result.Err, repoErr)
+		}
+	})
+
+	t.Run("Empty batch", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := minimock.NewController(t)
+		uc := NewPVZOrderUseCase(mocks.NewPVZOrderRepositoryMock(ctrl), mocks.NewOrderPackagerInterfaceMock(ctrl), mocks.NewPVZOrderCacheMock(ctrl), nil, nil)
+
+		_, err := uc.BatchAcceptOrderDelivery(ctx, nil)
+		assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+	})
+
+	t.Run("Too large batch", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := minimock.NewController(t)
+		uc := NewPVZOrderUseCase(mocks.NewPVZOrderRepositoryMock(ctrl), mocks.NewOrderPackagerInterfaceMock(ctrl), mocks.NewPVZOrderCacheMock(ctrl), nil, nil)
+
+		_, err := uc.BatchAcceptOrderDelivery(ctx, make([]domain.DeliveryItem, MaxDeliveryBatchSize+1))
+		assert.ErrorIs(t, err, domain.ErrInvalidArgument)
+	})
+}
+
+func TestPVZOrderUseCase_AcceptOrderDelivery_MeasuredWeight(t *testing.T) {
+	t.Parallel()
+
+	const pvzID = "currentPVZID"
+
+	ctx := abstractions.ContextWithPVZID(context.Background(), pvzID)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	isInvalidArgument := func(t assert.TestingT, err error, i ...interface{}) bool {
+		return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
+	}
+
+	tests := []struct {
+		name    string
+		options []abstractions.AcceptOptFunc
+		setup   func(repoMock *mocks.PVZOrderRepositoryMock, packagerMock *mocks.OrderPackagerInterfaceMock, policiesMock *mocks.PVZPoliciesMock)
+		want    domain.PVZOrder
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Not weighed",
+			setup: func(repoMock *mocks.PVZOrderRepositoryMock, packagerMock *mocks.OrderPackagerInterfaceMock, _ *mocks.PVZPoliciesMock) {
+				repoMock.GetOrderMock.Return(domain.PVZOrder{}, domain.ErrNotFound)
+				packagerMock.PackageOrderMock.Set(func(order domain.PVZOrder, _ domain.PackagingType) (domain.PVZOrder, error) {
+					return order, nil
+				})
+				repoMock.CreateOrderMock.Return(nil)
+			},
+			want:    domain.PVZOrder{Weight: 1000},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "Within tolerance",
+			options: []abstractions.AcceptOptFunc{abstractions.WithMeasuredWeight(1100)},
+			setup: func(repoMock *mocks.PVZOrderRepositoryMock, packagerMock *mocks.OrderPackagerInterfaceMock, policiesMock *mocks.PVZPoliciesMock) {
+				repoMock.GetOrderMock.Return(domain.PVZOrder{}, domain.ErrNotFound)
+				policiesMock.WeightTolerancePercentMock.Expect(minimock.AnyContext, pvzID).Return(10, nil)
+				packagerMock.PackageOrderMock.Set(func(order domain.PVZOrder, _ domain.PackagingType) (domain.PVZOrder, error) {
+					return order, nil
+				})
+				repoMock.CreateOrderMock.Return(nil)
+			},
+			want:    domain.PVZOrder{Weight: 1000, MeasuredWeight: 1100},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "Discrepancy without override reason",
+			options: []abstractions.AcceptOptFunc{abstractions.WithMeasuredWeight(1500)},
+			setup: func(repoMock *mocks.PVZOrderRepositoryMock, _ *mocks.OrderPackagerInterfaceMock, policiesMock *mocks.PVZPoliciesMock) {
+				repoMock.GetOrderMock.Return(domain.PVZOrder{}, domain.ErrNotFound)
+				policiesMock.WeightTolerancePercentMock.Expect(minimock.AnyContext, pvzID).Return(10, nil)
+			},
+			wantErr: isInvalidArgument,
+		},
+		{
+			name: "Discrepancy with override reason",
+			options: []abstractions.AcceptOptFunc{
+				abstractions.WithMeasuredWeight(1500),
+				abstractions.WithWeightOverrideReason("scales checked twice"),
+			},
+			setup: func(repoMock *mocks.PVZOrderRepositoryMock, packagerMock *mocks.OrderPackagerInterfaceMock, policiesMock *mocks.PVZPoliciesMock) {
+				repoMock.GetOrderMock.Return(domain.PVZOrder{}, domain.ErrNotFound)
+				policiesMock.WeightTolerancePercentMock.Expect(minimock.AnyContext, pvzID).Return(10, nil)
+				packagerMock.PackageOrderMock.Set(func(order domain.PVZOrder, _ domain.PackagingType) (domain.PVZOrder, error) {
+					return order, nil
+				})
+				repoMock.CreateOrderMock.Set(func(_ context.Context, order domain.PVZOrder, _ string) error {
+					assert.True(t, order.WeightDiscrepancy)
+					return nil
+				})
+			},
+			want: domain.PVZOrder{
+				Weight:               1000,
+				MeasuredWeight:       1500,
+				WeightDiscrepancy:    true,
+				WeightOverrideReason: "scales checked twice",
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "Negative measured weight",
+			options: []abstractions.AcceptOptFunc{abstractions.WithMeasuredWeight(-1)},
+			setup: func(_ *mocks.PVZOrderRepositoryMock, _ *mocks.OrderPackagerInterfaceMock, _ *mocks.PVZPoliciesMock) {
+			},
+			wantErr: isInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := minimock.NewController(t)
+			repoMock := mocks.NewPVZOrderRepositoryMock(ctrl)
+			packagerMock := mocks.NewOrderPackagerInterfaceMock(ctrl)
+			policiesMock := mocks.NewPVZPoliciesMock(ctrl)
+			policiesMock.PaidStorageMock.Optional().Return(domain.PaidStorage{}, nil)
+			policiesMock.CalendarMock.Optional().Return(domain.Calendar{}, nil)
+			policiesMock.PolicyMock.Optional().Return(domain.PVZPolicy{}, nil)
+			tt.setup(repoMock, packagerMock, policiesMock)
+
+			useCase := NewPVZOrderUseCase(repoMock, packagerMock, nil, policiesMock, nil)
+
+			got, err := useCase.AcceptOrderDelivery(ctx, "orderID", "recipientID", time.Hour, domain.RUB(100), 1000, domain.Dimensions{}, domain.PackagingTypeBox, false, tt.options...)
+			if !tt.wantErr(t, err) || err != nil {
+				return
+			}
+
+			assert.Equal(t, tt.want.Weight, got.Weight)
+			assert.Equal(t, tt.want.MeasuredWeight, got.MeasuredWeight)
+			assert.Equal(t, tt.want.WeightDiscrepancy, got.WeightDiscrepancy)
+			assert.Equal(t, tt.want.WeightOverrideReason, got.WeightOverrideReason)
+		})
+	}
+}
+
+func TestPVZOrderUseCase_GetWeightDiscrepancyReport(t *testing.T) {
+	t.Parallel()
+
+	const pvzID = "currentPVZID"
+
+	ctx := abstractions.ContextWithPVZID(context.Background(), pvzID)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	vladivostok := time.FixedZone("UTC+10", 10*60*60)
+
+	tests := []struct {
+		name     string
+		location *time.Location
+		date     time.Time
+		wantFrom time.Time
+		wantTo   time.Time
+	}{
+		{
+			name:     "UTC PVZ",
+			date:     time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC),
+			wantFrom: time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC),
+			wantTo:   time.Date(2024, 10, 2, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			// The orders accepted in the morning of the day in the PVZ are still on the previous day in UTC
+			name:     "Non-UTC PVZ",
+			location: vladivostok,
+			date:     time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC),
+			wantFrom: time.Date(2024, 9, 30, 14, 0, 0, 0, time.UTC),
+			wantTo:   time.Date(2024, 10, 1, 14, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := minimock.NewController(t)
+			repoMock := mocks.NewPVZOrderRepositoryMock(ctrl)
+			policiesMock := mocks.NewPVZPoliciesMock(ctrl)
+
+			calendar := domain.Calendar{Location: tt.location}
+			policiesMock.CalendarMock.Expect(minimock.AnyContext, pvzID).Return(calendar, nil)
+
+			orders := []domain.PVZOrder{{OrderID: "orderID", PVZID: pvzID, WeightDiscrepancy: true}}
+			repoMock.GetWeightDiscrepanciesMock.Set(func(_ context.Context, gotPVZID string, from, to time.Time) ([]domain.PVZOrder, error) {
+				assert.Equal(t, pvzID, gotPVZID)
+				assert.True(t, tt.wantFrom.Equal(from), "from %s, want %s", from, tt.wantFrom)
+				assert.True(t, tt.wantTo.Equal(to), "to %s, want %s", to, tt.wantTo)
+				return orders, nil
+			})
+
+			useCase := NewPVZOrderUseCase(repoMock, nil, nil, policiesMock, nil)
+
+			report, err := useCase.GetWeightDiscrepancyReport(ctx, tt.date)
+			assert.NoError(t, err)
+			assert.Equal(t, pvzID, report.PVZID)
+			assert.Equal(t, tt.date.Format(time.DateOnly), report.Date.Format(time.DateOnly))
+			assert.Equal(t, orders, report.Orders)
 		})
 	}
 }
@@ -114,13 +546,14 @@ func TestPVZOrderUseCase_ReturnOrderDelivery(t *testing.T) {
 		orderID string
 	}
 
-	ctx := context.Background()
+	ctx := abstractions.ContextWithPVZID(context.Background(), pvzID)
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	tests := []struct {
 		name    string
 		args    args
+		policy  domain.PVZPolicy
 		setup   func(repoMock *mocks.PVZOrderRepositoryMock, cacheMock *mocks.PVZOrderCacheMock)
 		wantErr assert.ErrorAssertionFunc
 	}{
@@ -131,13 +564,34 @@ func TestPVZOrderUseCase_ReturnOrderDelivery(t *testing.T) {
 			},
 			setup: func(repoMock *mocks.PVZOrderRepositoryMock, cacheMock *mocks.PVZOrderCacheMock) {
 				cacheMock.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(domain.PVZOrder{}, nil, false)
-				order := domain.PVZOrder{PVZID: pvzID, ReceivedAt: time.Now().Add(-3 * time.Hour), StorageTime: 2 * time.Hour}
+				order := domain.PVZOrder{PVZID: pvzID, Status: domain.OrderStatusAccepted, ReceivedAt: time.Now().Add(-3 * time.Hour), StorageTime: 2 * time.Hour}
 				repoMock.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(order, nil)
 				cacheMock.SetOrderMock.Expect(minimock.AnyContext, order).Return(nil)
-				repoMock.DeleteOrderMock.Expect(minimock.AnyContext, "orderID").Return(nil)
+				repoMock.DeleteOrderMock.Expect(minimock.AnyContext, "orderID", int64(0)).Return(nil)
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "Order is in the paid storage",
+			args: args{
+				orderID: "orderID",
+			},
+			setup: func(repoMock *mocks.PVZOrderRepositoryMock, cacheMock *mocks.PVZOrderCacheMock) {
+				cacheMock.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(domain.PVZOrder{}, nil, false)
+				order := domain.PVZOrder{
+					PVZID:       pvzID,
+					Status:      domain.OrderStatusAccepted,
+					ReceivedAt:  time.Now().Add(-3 * time.Hour),
+					StorageTime: 2 * time.Hour,
+					PaidStorage: domain.PaidStorage{Days: 1, DailyFee: domain.RUB(5000)},
+				}
+				repoMock.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(order, nil)
+				cacheMock.SetOrderMock.Expect(minimock.AnyContext, order).Return(nil)
+			},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
+			},
+		},
 		{
 			name: "Order not found",
 			args: args{
@@ -173,7 +627,7 @@ func TestPVZOrderUseCase_ReturnOrderDelivery(t *testing.T) {
 			},
 			setup: func(repoMock *mocks.PVZOrderRepositoryMock, cacheMock *mocks.PVZOrderCacheMock) {
 				cacheMock.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(domain.PVZOrder{}, nil, false)
-				order := domain.PVZOrder{ReceivedAt: time.Now(), StorageTime: 2 * time.Hour}
+				order := domain.PVZOrder{PVZID: pvzID, Status: domain.OrderStatusAccepted, ReceivedAt: time.Now(), StorageTime: 2 * time.Hour}
 				repoMock.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(order, nil)
 				cacheMock.SetOrderMock.Expect(minimock.AnyContext, order).Return(nil)
 			},
@@ -181,6 +635,47 @@ func TestPVZOrderUseCase_ReturnOrderDelivery(t *testing.T) {
 				return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
 			},
 		},
+		{
+			name: "Order is stored longer than the lowered maximum of the PVZ",
+			args: args{
+				orderID: "orderID",
+			},
+			policy: domain.PVZPolicy{MaxStorageTime: 2 * 24 * time.Hour},
+			setup: func(repoMock *mocks.PVZOrderRepositoryMock, cacheMock *mocks.PVZOrderCacheMock) {
+				order := domain.PVZOrder{PVZID: pvzID, Status: domain.OrderStatusAccepted, ReceivedAt: time.Now().Add(-3 * 24 * time.Hour), StorageTime: 7 * 24 * time.Hour}
+				cacheMock.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(order, nil, true)
+				repoMock.DeleteOrderMock.Expect(minimock.AnyContext, "orderID", int64(0)).Return(nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Order is stored shorter than the lowered maximum of the PVZ",
+			args: args{
+				orderID: "orderID",
+			},
+			policy: domain.PVZPolicy{MaxStorageTime: 2 * 24 * time.Hour},
+			setup: func(repoMock *mocks.PVZOrderRepositoryMock, cacheMock *mocks.PVZOrderCacheMock) {
+				order := domain.PVZOrder{PVZID: pvzID, Status: domain.OrderStatusAccepted, ReceivedAt: time.Now().Add(-24 * time.Hour), StorageTime: 7 * 24 * time.Hour}
+				cacheMock.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(order, nil, true)
+			},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
+			},
+		},
+		{
+			name: "Expired order is returned before storage time is over",
+			args: args{
+				orderID: "orderID",
+			},
+			setup: func(repoMock *mocks.PVZOrderRepositoryMock, cacheMock *mocks.PVZOrderCacheMock) {
+				cacheMock.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(domain.PVZOrder{}, nil, false)
+				order := domain.PVZOrder{PVZID: pvzID, Status: domain.OrderStatusExpired, ReceivedAt: time.Now(), StorageTime: 2 * time.Hour}
+				repoMock.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(order, nil)
+				cacheMock.SetOrderMock.Expect(minimock.AnyContext, order).Return(nil)
+				repoMock.DeleteOrderMock.Expect(minimock.AnyContext, "orderID", int64(0)).Return(nil)
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name: "Order is already issued",
 			args: args{
@@ -188,12 +683,12 @@ func TestPVZOrderUseCase_ReturnOrderDelivery(t *testing.T) {
 			},
 			setup: func(repoMock *mocks.PVZOrderRepositoryMock, cacheMock *mocks.PVZOrderCacheMock) {
 				cacheMock.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(domain.PVZOrder{}, nil, false)
-				order := domain.PVZOrder{IssuedAt: time.Now()}
+				order := domain.PVZOrder{PVZID: pvzID, Status: domain.OrderStatusIssued, IssuedAt: time.Now()}
 				repoMock.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(order, nil)
 				cacheMock.SetOrderMock.Expect(minimock.AnyContext, order).Return(nil)
 			},
 			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
-				return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
+				return assert.ErrorIs(t, err, domain.ErrConflict, i...)
 			},
 		},
 	}
@@ -204,7 +699,10 @@ func TestPVZOrderUseCase_ReturnOrderDelivery(t *testing.T) {
 			ctrl := minimock.NewController(t)
 			repo := mocks.NewPVZOrderRepositoryMock(ctrl)
 			cache := mocks.NewPVZOrderCacheMock(ctrl)
-			uc := NewPVZOrderUseCase(repo, nil, pvzID, cache)
+			policies := mocks.NewPVZPoliciesMock(ctrl)
+			policies.PolicyMock.Optional().Return(tt.policy, nil)
+			policies.CalendarMock.Optional().Return(domain.Calendar{}, nil)
+			uc := NewPVZOrderUseCase(repo, nil, cache, policies, nil)
 			tt.setup(repo, cache)
 			err := uc.ReturnOrderDelivery(ctx, tt.args.orderID)
 			tt.wantErr(t, err)
@@ -218,99 +716,188 @@ func TestPVZOrderUseCase_GiveOrderToClient(t *testing.T) {
 	const pvzID = "currentPVZID"
 
 	type args struct {
-		orderIDs []string
+		decisions []domain.IssueDecision
 	}
 
-	ctx := context.Background()
+	ctx := abstractions.ContextWithPVZID(context.Background(), pvzID)
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	isInvalidArgument := func(t assert.TestingT, err error, i ...interface{}) bool {
+		return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
+	}
+
+	isConflict := func(t assert.TestingT, err error, i ...interface{}) bool {
+		return assert.ErrorIs(t, err, domain.ErrConflict, i...)
+	}
+
+	newOrder := func(orderID, recipientID string) domain.PVZOrder {
+		return domain.PVZOrder{
+			OrderID:     orderID,
+			RecipientID: recipientID,
+			PVZID:       pvzID,
+			Status:      domain.OrderStatusAccepted,
+			ReceivedAt:  time.Now().Add(-1 * time.Hour),
+			StorageTime: 2 * time.Hour,
+		}
+	}
+
 	tests := []struct {
-		name    string
-		args    args
-		setup   func(repo *mocks.PVZOrderRepositoryMock, cache *mocks.PVZOrderCacheMock)
-		wantErr assert.ErrorAssertionFunc
+		name        string
+		args        args
+		setup       func(repo *mocks.PVZOrderRepositoryMock, cache *mocks.PVZOrderCacheMock)
+		wantErr     assert.ErrorAssertionFunc
+		wantResults []assert.ErrorAssertionFunc
 	}{
 		{
 			name: "Success",
 			args: args{
-				orderIDs: []string{"orderID"},
+				decisions: []domain.IssueDecision{domain.NewIssueDecision("orderID")},
 			},
 			setup: func(repo *mocks.PVZOrderRepositoryMock, cache *mocks.PVZOrderCacheMock) {
 				cache.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(domain.PVZOrder{}, nil, false)
-				order := domain.PVZOrder{
-					OrderID:     "orderID",
-					RecipientID: "userID",
-					PVZID:       pvzID,
-					ReceivedAt:  time.Now().Add(-1 * time.Hour),
-					StorageTime: 2 * time.Hour,
-				}
+				order := newOrder("orderID", "userID")
 				repo.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(order, nil)
 				cache.SetOrderMock.Expect(minimock.AnyContext, order).Return(nil)
-				repo.SetOrderIssuedMock.Expect(minimock.AnyContext, "orderID").Return(nil)
+				repo.SetOrdersIssuedMock.Expect(minimock.AnyContext, []domain.IssueDecision{domain.NewIssueDecision("orderID")}).Return(nil)
 			},
-			wantErr: assert.NoError,
+			wantErr:     assert.NoError,
+			wantResults: []assert.ErrorAssertionFunc{assert.NoError},
 		},
 		{
-			name: "Order not found",
+			name: "Issue one order and refuse another",
 			args: args{
-				orderIDs: []string{"orderID"},
+				decisions: []domain.IssueDecision{
+					domain.NewIssueDecision("orderID"),
+					domain.NewRefuseDecision("anotherOrderID", "damaged"),
+				},
 			},
 			setup: func(repo *mocks.PVZOrderRepositoryMock, cache *mocks.PVZOrderCacheMock) {
-				cache.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(domain.PVZOrder{}, nil, false)
-				repo.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(domain.PVZOrder{}, domain.ErrNotFound)
+				cache.GetOrderMock.Set(func(_ context.Context, id string) (domain.PVZOrder, error, bool) {
+					return domain.PVZOrder{}, nil, false
+				})
+				repo.GetOrderMock.Set(func(_ context.Context, id string) (domain.PVZOrder, error) {
+					return newOrder(id, "userID"), nil
+				})
+				cache.SetOrderMock.Set(func(_ context.Context, _ domain.PVZOrder) error { return nil })
+				repo.SetOrdersIssuedMock.Expect(minimock.AnyContext, []domain.IssueDecision{domain.NewIssueDecision("orderID")}).Return(nil)
+				repo.SetOrderRefusedMock.Expect(minimock.AnyContext, "anotherOrderID", "damaged", int64(0)).Return(nil)
 			},
-			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
-				return assert.Error(t, err, i) && errors.Is(err, domain.ErrNotFound)
+			wantErr:     assert.NoError,
+			wantResults: []assert.ErrorAssertionFunc{assert.NoError, assert.NoError},
+		},
+		{
+			name: "One order is not found, another is issued",
+			args: args{
+				decisions: []domain.IssueDecision{
+					domain.NewIssueDecision("unknownOrderID"),
+					domain.NewIssueDecision("orderID"),
+				},
+			},
+			setup: func(repo *mocks.PVZOrderRepositoryMock, cache *mocks.PVZOrderCacheMock) {
+				cache.GetOrderMock.Set(func(_ context.Context, id string) (domain.PVZOrder, error, bool) {
+					return domain.PVZOrder{}, nil, false
+				})
+				repo.GetOrderMock.Set(func(_ context.Context, id string) (domain.PVZOrder, error) {
+					if id == "orderID" {
+						return newOrder(id, "userID"), nil
+					}
+					return domain.PVZOrder{}, domain.ErrNotFound
+				})
+				cache.SetOrderMock.Set(func(_ context.Context, _ domain.PVZOrder) error { return nil })
+				repo.SetOrdersIssuedMock.Expect(minimock.AnyContext, []domain.IssueDecision{domain.NewIssueDecision("orderID")}).Return(nil)
+			},
+			wantErr: assert.NoError,
+			wantResults: []assert.ErrorAssertionFunc{
+				func(t assert.TestingT, err error, i ...interface{}) bool {
+					return assert.Error(t, err, i) && errors.Is(err, domain.ErrNotFound)
+				},
+				assert.NoError,
+			},
+		},
+		{
+			name: "Batch issuance fails as a whole",
+			args: args{
+				decisions: []domain.IssueDecision{
+					domain.NewIssueDecision("orderID"),
+					domain.NewRefuseDecision("refusedOrderID", "damaged"),
+					domain.NewIssueDecision("anotherOrderID"),
+				},
+			},
+			setup: func(repo *mocks.PVZOrderRepositoryMock, cache *mocks.PVZOrderCacheMock) {
+				cache.GetOrderMock.Set(func(_ context.Context, id string) (domain.PVZOrder, error, bool) {
+					return domain.PVZOrder{}, nil, false
+				})
+				repo.GetOrderMock.Set(func(_ context.Context, id string) (domain.PVZOrder, error) {
+					return newOrder(id, "userID"), nil
+				})
+				cache.SetOrderMock.Set(func(_ context.Context, _ domain.PVZOrder) error { return nil })
+				repo.SetOrderRefusedMock.Expect(minimock.AnyContext, "refusedOrderID", "damaged", int64(0)).Return(nil)
+				repo.SetOrdersIssuedMock.Expect(minimock.AnyContext, []domain.IssueDecision{domain.NewIssueDecision("orderID"), domain.NewIssueDecision("anotherOrderID")}).Return(domain.ErrNotFound)
+			},
+			wantErr: assert.NoError,
+			wantResults: []assert.ErrorAssertionFunc{
+				func(t assert.TestingT, err error, i ...interface{}) bool {
+					return assert.Error(t, err, i) && errors.Is(err, domain.ErrNotFound)
+				},
+				assert.NoError,
+				func(t assert.TestingT, err error, i ...interface{}) bool {
+					return assert.Error(t, err, i) && errors.Is(err, domain.ErrNotFound)
+				},
 			},
 		},
 		{
 			name: "Order is not for current PVZ",
 			args: args{
-				orderIDs: []string{"orderID"},
+				decisions: []domain.IssueDecision{domain.NewIssueDecision("orderID")},
 			},
 			setup: func(repo *mocks.PVZOrderRepositoryMock, cache *mocks.PVZOrderCacheMock) {
 				cache.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(domain.PVZOrder{}, nil, false)
-				order := domain.PVZOrder{
-					OrderID:     "orderID",
-					RecipientID: "userID",
-					PVZID:       "anotherPVZID",
-					IssuedAt:    time.Now(),
-					ReceivedAt:  time.Now().Add(-1 * time.Hour),
-					StorageTime: 2 * time.Hour,
-				}
+				order := newOrder("orderID", "userID")
+				order.PVZID = "anotherPVZID"
 				repo.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(order, nil)
 				cache.SetOrderMock.Expect(minimock.AnyContext, order).Return(nil)
 			},
-			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
-				return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
-			},
+			wantErr:     assert.NoError,
+			wantResults: []assert.ErrorAssertionFunc{isInvalidArgument},
 		},
 		{
 			name: "Order storage time expired",
 			args: args{
-				orderIDs: []string{"orderID"},
+				decisions: []domain.IssueDecision{domain.NewRefuseDecision("orderID", "too late")},
 			},
 			setup: func(repo *mocks.PVZOrderRepositoryMock, cache *mocks.PVZOrderCacheMock) {
 				cache.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(domain.PVZOrder{}, nil, false)
-				order := domain.PVZOrder{
-					OrderID:     "orderID",
-					RecipientID: "userID",
-					PVZID:       pvzID,
-					ReceivedAt:  time.Now().Add(-3 * time.Hour),
-					StorageTime: 2 * time.Hour,
-				}
+				order := newOrder("orderID", "userID")
+				order.ReceivedAt = time.Now().Add(-3 * time.Hour)
 				repo.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(order, nil)
 				cache.SetOrderMock.Expect(minimock.AnyContext, order).Return(nil)
 			},
-			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
-				return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
+			wantErr:     assert.NoError,
+			wantResults: []assert.ErrorAssertionFunc{isInvalidArgument},
+		},
+		{
+			name: "Order is already issued",
+			args: args{
+				decisions: []domain.IssueDecision{domain.NewRefuseDecision("orderID", "changed my mind")},
 			},
+			setup: func(repo *mocks.PVZOrderRepositoryMock, cache *mocks.PVZOrderCacheMock) {
+				cache.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(domain.PVZOrder{}, nil, false)
+				order := newOrder("orderID", "userID")
+				order.Status = domain.OrderStatusIssued
+				repo.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(order, nil)
+				cache.SetOrderMock.Expect(minimock.AnyContext, order).Return(nil)
+			},
+			wantErr:     assert.NoError,
+			wantResults: []assert.ErrorAssertionFunc{isConflict},
 		},
 		{
 			name: "Orders do not belong to the same user",
 			args: args{
-				orderIDs: []string{"orderID", "anotherOrderID"},
+				decisions: []domain.IssueDecision{
+					domain.NewIssueDecision("orderID"),
+					domain.NewIssueDecision("anotherOrderID"),
+				},
 			},
 			setup: func(repo *mocks.PVZOrderRepositoryMock, cache *mocks.PVZOrderCacheMock) {
 				// Allow any order of calls and return data based on orderID
@@ -320,30 +907,45 @@ func TestPVZOrderUseCase_GiveOrderToClient(t *testing.T) {
 				repo.GetOrderMock.Set(func(_ context.Context, id string) (domain.PVZOrder, error) {
 					switch id {
 					case "orderID":
-						return domain.PVZOrder{
-							OrderID:     "orderID",
-							RecipientID: "userID",
-							PVZID:       pvzID,
-							ReceivedAt:  time.Now().Add(-1 * time.Hour),
-							StorageTime: 2 * time.Hour,
-						}, nil
+						return newOrder("orderID", "userID"), nil
 					case "anotherOrderID":
-						return domain.PVZOrder{
-							OrderID:     "anotherOrderID",
-							RecipientID: "anotherUserID",
-							PVZID:       pvzID,
-							ReceivedAt:  time.Now().Add(-1 * time.Hour),
-							StorageTime: 2 * time.Hour,
-						}, nil
+						return newOrder("anotherOrderID", "anotherUserID"), nil
 					default:
 						return domain.PVZOrder{}, errors.New("unexpected id")
 					}
 				})
 				cache.SetOrderMock.Set(func(_ context.Context, _ domain.PVZOrder) error { return nil })
+				repo.SetOrdersIssuedMock.Expect(minimock.AnyContext, []domain.IssueDecision{domain.NewIssueDecision("orderID")}).Return(nil)
 			},
-			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
-				return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
+			wantErr:     assert.NoError,
+			wantResults: []assert.ErrorAssertionFunc{assert.NoError, isInvalidArgument},
+		},
+		{
+			name: "Empty decisions",
+			args: args{
+				decisions: nil,
 			},
+			setup:   func(repo *mocks.PVZOrderRepositoryMock, cache *mocks.PVZOrderCacheMock) {},
+			wantErr: isInvalidArgument,
+		},
+		{
+			name: "Duplicate decisions",
+			args: args{
+				decisions: []domain.IssueDecision{
+					domain.NewIssueDecision("orderID"),
+					domain.NewRefuseDecision("orderID", "damaged"),
+				},
+			},
+			setup:   func(repo *mocks.PVZOrderRepositoryMock, cache *mocks.PVZOrderCacheMock) {},
+			wantErr: isInvalidArgument,
+		},
+		{
+			name: "Refusal without reason",
+			args: args{
+				decisions: []domain.IssueDecision{domain.NewRefuseDecision("orderID", "")},
+			},
+			setup:   func(repo *mocks.PVZOrderRepositoryMock, cache *mocks.PVZOrderCacheMock) {},
+			wantErr: isInvalidArgument,
 		},
 	}
 
@@ -353,10 +955,260 @@ func TestPVZOrderUseCase_GiveOrderToClient(t *testing.T) {
 			ctrl := minimock.NewController(t)
 			repo := mocks.NewPVZOrderRepositoryMock(ctrl)
 			cache := mocks.NewPVZOrderCacheMock(ctrl)
-			uc := NewPVZOrderUseCase(repo, nil, pvzID, cache)
+			uc := NewPVZOrderUseCase(repo, nil, cache, nil, nil)
 			tt.setup(repo, cache)
-			err := uc.GiveOrderToClient(ctx, tt.args.orderIDs)
+			results, err := uc.GiveOrderToClient(ctx, tt.args.decisions)
 			tt.wantErr(t, err)
+			if assert.Len(t, results, len(tt.wantResults)) {
+				for i, wantResult := range tt.wantResults {
+					assert.Equal(t, tt.args.decisions[i].OrderID, results[i].OrderID)
+					wantResult(t, results[i].Err)
+				}
+			}
+		})
+	}
+}
+
+func TestPVZOrderUseCase_GiveOrderToClient_PickupCode(t *testing.T) {
+	t.Parallel()
+
+	const (
+		pvzID      = "currentPVZID"
+		pickupCode = "123456"
+	)
+
+	ctx := abstractions.ContextWithPVZID(context.Background(), pvzID)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pickupCodeKey := domain.PickupCodeKey("test-pickup-code-key")
+	pickupCodeHash := pickupCodeKey.HashPickupCode(pickupCode)
+
+	legacyPickupCodeHash, err := bcrypt.GenerateFromPassword([]byte(pickupCode), bcrypt.MinCost)
+	assert.NoError(t, err)
+
+	newOrder := func(orderID string) domain.PVZOrder {
+		return domain.PVZOrder{
+			OrderID:        orderID,
+			RecipientID:    "userID",
+			PVZID:          pvzID,
+			Status:         domain.OrderStatusAccepted,
+			ReceivedAt:     time.Now().Add(-1 * time.Hour),
+			StorageTime:    2 * time.Hour,
+			PickupCodeHash: pickupCodeHash,
+		}
+	}
+
+	withCode := func(decision domain.IssueDecision, code string) domain.IssueDecision {
+		decision.PickupCode = code
+		return decision
+	}
+
+	isInvalidArgument := func(t assert.TestingT, err error, i ...interface{}) bool {
+		return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
+	}
+
+	isTooManyAttempts := func(t assert.TestingT, err error, i ...interface{}) bool {
+		return assert.Error(t, err, i) && errors.Is(err, domain.ErrTooManyAttempts)
+	}
+
+	tests := []struct {
+		name       string
+		decision   domain.IssueDecision
+		order      domain.PVZOrder
+		setup      func(repo *mocks.PVZOrderRepositoryMock)
+		wantResult assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "Correct code",
+			decision: withCode(domain.NewIssueDecision("orderID"), pickupCode),
+			order:    newOrder("orderID"),
+			setup: func(repo *mocks.PVZOrderRepositoryMock) {
+				repo.SetOrdersIssuedMock.Expect(minimock.AnyContext, []domain.IssueDecision{withCode(domain.NewIssueDecision("orderID"), pickupCode)}).Return(nil)
+			},
+			wantResult: assert.NoError,
+		},
+		{
+			name:     "Correct code hashed with bcrypt",
+			decision: withCode(domain.NewIssueDecision("orderID"), pickupCode),
+			order: func() domain.PVZOrder {
+				order := newOrder("orderID")
+				order.PickupCodeHash = string(legacyPickupCodeHash)
+				return order
+			}(),
+			setup: func(repo *mocks.PVZOrderRepositoryMock) {
+				repo.SetOrdersIssuedMock.Expect(minimock.AnyContext, []domain.IssueDecision{withCode(domain.NewIssueDecision("orderID"), pickupCode)}).Return(nil)
+			},
+			wantResult: assert.NoError,
+		},
+		{
+			name:     "Wrong code",
+			decision: withCode(domain.NewIssueDecision("orderID"), "000000"),
+			order:    newOrder("orderID"),
+			setup: func(repo *mocks.PVZOrderRepositoryMock) {
+				repo.RegisterFailedPickupAttemptMock.Expect(minimock.AnyContext, "orderID", MaxPickupAttempts, PickupLockoutTime).Return(time.Time{}, nil)
+			},
+			wantResult: isInvalidArgument,
+		},
+		{
+			name:     "Wrong code locks the pickup",
+			decision: withCode(domain.NewRefuseDecision("orderID", "damaged"), "000000"),
+			order:    newOrder("orderID"),
+			setup: func(repo *mocks.PVZOrderRepositoryMock) {
+				repo.RegisterFailedPickupAttemptMock.Expect(minimock.AnyContext, "orderID", MaxPickupAttempts, PickupLockoutTime).Return(time.Now().Add(PickupLockoutTime), nil)
+			},
+			wantResult: isTooManyAttempts,
+		},
+		{
+			name:     "Pickup is locked",
+			decision: withCode(domain.NewIssueDecision("orderID"), pickupCode),
+			order: func() domain.PVZOrder {
+				order := newOrder("orderID")
+				order.PickupLockedUntil = time.Now().Add(time.Minute)
+				return order
+			}(),
+			setup:      func(_ *mocks.PVZOrderRepositoryMock) {},
+			wantResult: isTooManyAttempts,
+		},
+		{
+			name:       "Code is missing",
+			decision:   domain.NewIssueDecision("orderID"),
+			order:      newOrder("orderID"),
+			setup:      func(_ *mocks.PVZOrderRepositoryMock) {},
+			wantResult: isInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := minimock.NewController(t)
+			repo := mocks.NewPVZOrderRepositoryMock(ctrl)
+			cache := mocks.NewPVZOrderCacheMock(ctrl)
+			uc := NewPVZOrderUseCase(repo, nil, cache, nil, pickupCodeKey)
+
+			cache.GetOrderMock.Expect(minimock.AnyContext, tt.order.OrderID).Return(tt.order, nil, true)
+			tt.setup(repo)
+
+			results, err := uc.GiveOrderToClient(ctx, []domain.IssueDecision{tt.decision})
+			assert.NoError(t, err)
+			if assert.Len(t, results, 1) {
+				tt.wantResult(t, results[0].Err)
+			}
+		})
+	}
+}
+
+func TestPVZOrderUseCase_GiveOrderToClient_PaidStorage(t *testing.T) {
+	t.Parallel()
+
+	const pvzID = "currentPVZID"
+
+	ctx := abstractions.ContextWithPVZID(context.Background(), pvzID)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// newOrder creates an order whose free storage time is over for the given time
+	newOrder := func(overdue time.Duration) domain.PVZOrder {
+		return domain.PVZOrder{
+			OrderID:     "orderID",
+			RecipientID: "userID",
+			PVZID:       pvzID,
+			Status:      domain.OrderStatusAccepted,
+			ReceivedAt:  time.Now().Add(-2*time.Hour - overdue),
+			StorageTime: 2 * time.Hour,
+			PaidStorage: domain.PaidStorage{Days: 3, DailyFee: domain.RUB(5000)},
+		}
+	}
+
+	withFee := func(fee domain.Money) domain.IssueDecision {
+		decision := domain.NewIssueDecision("orderID")
+		decision.StorageFeePaid = fee
+		return decision
+	}
+
+	isInvalidArgument := func(t assert.TestingT, err error, i ...interface{}) bool {
+		return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
+	}
+
+	tests := []struct {
+		name       string
+		decision   domain.IssueDecision
+		order      domain.PVZOrder
+		issued     bool
+		wantResult assert.ErrorAssertionFunc
+	}{
+		{
+			name:       "Free storage",
+			decision:   domain.NewIssueDecision("orderID"),
+			order:      newOrder(-time.Hour),
+			issued:     true,
+			wantResult: assert.NoError,
+		},
+		{
+			name:       "Fee of the started day is paid",
+			decision:   withFee(domain.RUB(5000)),
+			order:      newOrder(time.Hour),
+			issued:     true,
+			wantResult: assert.NoError,
+		},
+		{
+			name:       "Fee of two days is paid",
+			decision:   withFee(domain.RUB(10000)),
+			order:      newOrder(25 * time.Hour),
+			issued:     true,
+			wantResult: assert.NoError,
+		},
+		{
+			name:       "Fee is not paid",
+			decision:   domain.NewIssueDecision("orderID"),
+			order:      newOrder(time.Hour),
+			wantResult: isInvalidArgument,
+		},
+		{
+			name:       "Fee is underpaid",
+			decision:   withFee(domain.RUB(5000)),
+			order:      newOrder(25 * time.Hour),
+			wantResult: isInvalidArgument,
+		},
+		{
+			name:       "Fee is paid in another currency",
+			decision:   withFee(domain.NewMoney(5000, domain.CurrencyUSD)),
+			order:      newOrder(time.Hour),
+			wantResult: isInvalidArgument,
+		},
+		{
+			name:       "Fee is paid for the free storage",
+			decision:   withFee(domain.RUB(5000)),
+			order:      newOrder(-time.Hour),
+			wantResult: isInvalidArgument,
+		},
+		{
+			name:       "Paid storage is over",
+			decision:   withFee(domain.RUB(15000)),
+			order:      newOrder(73 * time.Hour),
+			wantResult: isInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := minimock.NewController(t)
+			repo := mocks.NewPVZOrderRepositoryMock(ctrl)
+			cache := mocks.NewPVZOrderCacheMock(ctrl)
+			uc := NewPVZOrderUseCase(repo, nil, cache, nil, nil)
+
+			cache.GetOrderMock.Expect(minimock.AnyContext, tt.order.OrderID).Return(tt.order, nil, true)
+			if tt.issued {
+				repo.SetOrdersIssuedMock.Expect(minimock.AnyContext, []domain.IssueDecision{tt.decision}).Return(nil)
+			}
+
+			results, err := uc.GiveOrderToClient(ctx, []domain.IssueDecision{tt.decision})
+			assert.NoError(t, err)
+			if assert.Len(t, results, 1) {
+				tt.wantResult(t, results[0].Err)
+			}
 		})
 	}
 }
@@ -374,9 +1226,9 @@ func TestPVZOrderUseCase_GetOrders(t *testing.T) {
 	repoMock := mocks.NewPVZOrderRepositoryMock(ctrl)
 	cacheMock := mocks.NewPVZOrderCacheMock(ctrl)
 
-	useCase := NewPVZOrderUseCase(repoMock, nil, pvzID, cacheMock)
+	useCase := NewPVZOrderUseCase(repoMock, nil, cacheMock, nil, nil)
 
-	ctx := context.Background()
+	ctx := abstractions.ContextWithPVZID(context.Background(), pvzID)
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -431,6 +1283,94 @@ func TestPVZOrderUseCase_GetOrders(t *testing.T) {
 	}
 }
 
+func TestPVZOrderUseCase_GetOrders_ReturnDeadline(t *testing.T) {
+	t.Parallel()
+
+	const pvzID = "currentPVZID"
+
+	ctx := abstractions.ContextWithPVZID(context.Background(), pvzID)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// Friday afternoon
+	issuedAt := time.Date(2026, 10, 16, 15, 0, 0, 0, time.UTC)
+	weekends := []time.Weekday{time.Saturday, time.Sunday}
+
+	tests := []struct {
+		name     string
+		calendar func() (domain.Calendar, error)
+		want     time.Time
+	}{
+		{
+			name:     "Around the clock every day",
+			calendar: func() (domain.Calendar, error) { return domain.Calendar{}, nil },
+			want:     issuedAt.Add(domain.DefaultReturnWindow),
+		},
+		{
+			name: "Weekends are skipped",
+			calendar: func() (domain.Calendar, error) {
+				return domain.NewCalendar(time.UTC, 0, 0, weekends, nil)
+			},
+			want: time.Date(2026, 10, 20, 15, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Deadline is moved to the closing time",
+			calendar: func() (domain.Calendar, error) {
+				return domain.NewCalendar(time.UTC, 9*time.Hour, 21*time.Hour, weekends, nil)
+			},
+			want: time.Date(2026, 10, 20, 21, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Holidays are skipped",
+			calendar: func() (domain.Calendar, error) {
+				return domain.NewCalendar(time.UTC, 9*time.Hour, 21*time.Hour, weekends, []time.Time{time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC)})
+			},
+			want: time.Date(2026, 10, 21, 21, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Time zone of the PVZ",
+			calendar: func() (domain.Calendar, error) {
+				// Friday 15:00 UTC is Saturday 00:00 in UTC+9, so the return period starts on Monday
+				return domain.NewCalendar(time.FixedZone("UTC+9", 9*60*60), 0, 0, weekends, nil)
+			},
+			want: time.Date(2026, 10, 21, 0, 0, 0, 0, time.FixedZone("UTC+9", 9*60*60)),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			calendar, err := tt.calendar()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ctrl := minimock.NewController(t)
+			cacheMock := mocks.NewPVZOrderCacheMock(ctrl)
+			policiesMock := mocks.NewPVZPoliciesMock(ctrl)
+			useCase := NewPVZOrderUseCase(mocks.NewPVZOrderRepositoryMock(ctrl), nil, cacheMock, policiesMock, nil)
+
+			cached := []domain.PVZOrder{
+				{OrderID: "issued", PVZID: pvzID, Status: domain.OrderStatusIssued, IssuedAt: issuedAt},
+				{OrderID: "accepted", PVZID: pvzID, Status: domain.OrderStatusAccepted},
+			}
+			cacheMock.GetOrdersMock.Expect(minimock.AnyContext, "userID").Return(cached, nil, true)
+			policiesMock.PolicyMock.Expect(minimock.AnyContext, pvzID).Times(1).Return(domain.PVZPolicy{}, nil)
+			policiesMock.CalendarMock.Expect(minimock.AnyContext, pvzID).Times(1).Return(calendar, nil)
+
+			got, err := useCase.GetOrders(ctx, "userID")
+			if !assert.NoError(t, err) || !assert.Len(t, got, 2) {
+				return
+			}
+
+			assert.True(t, tt.want.Equal(got[0].ReturnDeadline), "want %s, got %s", tt.want, got[0].ReturnDeadline)
+			assert.True(t, got[1].ReturnDeadline.IsZero())
+			assert.True(t, cached[0].ReturnDeadline.IsZero(), "cached orders must not be changed")
+		})
+	}
+}
+
 func TestPVZOrderUseCase_AcceptReturn(t *testing.T) {
 	t.Parallel()
 
@@ -439,38 +1379,99 @@ func TestPVZOrderUseCase_AcceptReturn(t *testing.T) {
 	type args struct {
 		userID  string
 		orderID string
+		details domain.ReturnDetails
 	}
 
-	ctx := context.Background()
+	ctx := abstractions.ContextWithPVZID(context.Background(), pvzID)
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
+	// The order was issued three days ago and the days after it are holidays
+	issuedAt := time.Now().UTC().Add(-3 * 24 * time.Hour)
+	holidays := []time.Time{issuedAt.AddDate(0, 0, 1), issuedAt.AddDate(0, 0, 2), issuedAt.AddDate(0, 0, 3)}
+	holidayCalendar, err := domain.NewCalendar(time.UTC, 0, 0, nil, holidays)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	details := domain.ReturnDetails{Reason: domain.ReturnReasonDefect, Inspection: domain.InspectionOutcomeDamaged, Comment: "broken screen"}
+
 	tests := []struct {
-		name    string
-		args    args
-		setup   func(repo *mocks.PVZOrderRepositoryMock, cache *mocks.PVZOrderCacheMock)
-		wantErr assert.ErrorAssertionFunc
+		name     string
+		args     args
+		calendar domain.Calendar
+		policy   domain.PVZPolicy
+		setup    func(repo *mocks.PVZOrderRepositoryMock, cache *mocks.PVZOrderCacheMock)
+		wantErr  assert.ErrorAssertionFunc
 	}{
 		{
 			name: "Success",
 			args: args{
 				userID:  "userID",
 				orderID: "orderID",
+				details: details,
 			},
 			setup: func(repo *mocks.PVZOrderRepositoryMock, cache *mocks.PVZOrderCacheMock) {
 				cache.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(domain.PVZOrder{}, nil, false)
-				order := domain.PVZOrder{RecipientID: "userID", IssuedAt: time.Now().Add(-(TimeForReturn - time.Hour))}
+				order := domain.PVZOrder{PVZID: pvzID, RecipientID: "userID", Status: domain.OrderStatusIssued, IssuedAt: time.Now().Add(-(domain.DefaultReturnWindow - time.Hour))}
 				repo.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(order, nil)
 				cache.SetOrderMock.Expect(minimock.AnyContext, order).Return(nil)
-				repo.SetOrderReturnedMock.Expect(minimock.AnyContext, "orderID").Return(nil)
+				repo.SetOrderReturnedMock.Expect(minimock.AnyContext, "orderID", details, int64(0)).Return(nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Holidays are not counted in the time for return",
+			args: args{
+				userID:  "userID",
+				orderID: "orderID",
+				details: details,
+			},
+			calendar: holidayCalendar,
+			setup: func(repo *mocks.PVZOrderRepositoryMock, cache *mocks.PVZOrderCacheMock) {
+				order := domain.PVZOrder{PVZID: pvzID, RecipientID: "userID", Status: domain.OrderStatusIssued, IssuedAt: issuedAt}
+				cache.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(order, nil, true)
+				repo.SetOrderReturnedMock.Expect(minimock.AnyContext, "orderID", details, int64(0)).Return(nil)
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "Return window of the PVZ is longer than the default one",
+			args: args{
+				userID:  "userID",
+				orderID: "orderID",
+				details: details,
+			},
+			policy: domain.PVZPolicy{ReturnWindow: 7 * 24 * time.Hour},
+			setup: func(repo *mocks.PVZOrderRepositoryMock, cache *mocks.PVZOrderCacheMock) {
+				order := domain.PVZOrder{PVZID: pvzID, RecipientID: "userID", Status: domain.OrderStatusIssued, IssuedAt: issuedAt}
+				cache.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(order, nil, true)
+				repo.SetOrderReturnedMock.Expect(minimock.AnyContext, "orderID", details, int64(0)).Return(nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Return window of the PVZ is shorter than the default one",
+			args: args{
+				userID:  "userID",
+				orderID: "orderID",
+				details: details,
+			},
+			policy: domain.PVZPolicy{ReturnWindow: time.Hour},
+			setup: func(repo *mocks.PVZOrderRepositoryMock, cache *mocks.PVZOrderCacheMock) {
+				order := domain.PVZOrder{PVZID: pvzID, RecipientID: "userID", Status: domain.OrderStatusIssued, IssuedAt: time.Now().Add(-2 * time.Hour)}
+				cache.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(order, nil, true)
+			},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
+			},
+		},
 		{
 			name: "Order not found",
 			args: args{
 				userID:  "userID",
 				orderID: "orderID",
+				details: details,
 			},
 			setup: func(repo *mocks.PVZOrderRepositoryMock, cache *mocks.PVZOrderCacheMock) {
 				cache.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(domain.PVZOrder{}, nil, false)
@@ -485,10 +1486,11 @@ func TestPVZOrderUseCase_AcceptReturn(t *testing.T) {
 			args: args{
 				userID:  "userID",
 				orderID: "orderID",
+				details: details,
 			},
 			setup: func(repo *mocks.PVZOrderRepositoryMock, cache *mocks.PVZOrderCacheMock) {
 				cache.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(domain.PVZOrder{}, nil, false)
-				order := domain.PVZOrder{RecipientID: "anotherUserID"}
+				order := domain.PVZOrder{PVZID: pvzID, RecipientID: "anotherUserID"}
 				repo.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(order, nil)
 				cache.SetOrderMock.Expect(minimock.AnyContext, order).Return(nil)
 			},
@@ -502,15 +1504,16 @@ func TestPVZOrderUseCase_AcceptReturn(t *testing.T) {
 			args: args{
 				userID:  "userID",
 				orderID: "orderID",
+				details: details,
 			},
 			setup: func(repo *mocks.PVZOrderRepositoryMock, cache *mocks.PVZOrderCacheMock) {
 				cache.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(domain.PVZOrder{}, nil, false)
-				order := domain.PVZOrder{RecipientID: "userID", ReturnedAt: time.Now()}
+				order := domain.PVZOrder{PVZID: pvzID, RecipientID: "userID", Status: domain.OrderStatusReturnedByClient, ReturnedAt: time.Now()}
 				repo.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(order, nil)
 				cache.SetOrderMock.Expect(minimock.AnyContext, order).Return(nil)
 			},
 			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
-				return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
+				return assert.ErrorIs(t, err, domain.ErrConflict, i...)
 			},
 		},
 		{
@@ -518,15 +1521,16 @@ func TestPVZOrderUseCase_AcceptReturn(t *testing.T) {
 			args: args{
 				userID:  "userID",
 				orderID: "orderID",
+				details: details,
 			},
 			setup: func(repo *mocks.PVZOrderRepositoryMock, cache *mocks.PVZOrderCacheMock) {
 				cache.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(domain.PVZOrder{}, nil, false)
-				order := domain.PVZOrder{RecipientID: "userID"}
+				order := domain.PVZOrder{PVZID: pvzID, RecipientID: "userID", Status: domain.OrderStatusAccepted}
 				repo.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(order, nil)
 				cache.SetOrderMock.Expect(minimock.AnyContext, order).Return(nil)
 			},
 			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
-				return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
+				return assert.ErrorIs(t, err, domain.ErrConflict, i...)
 			},
 		},
 		{
@@ -534,10 +1538,11 @@ func TestPVZOrderUseCase_AcceptReturn(t *testing.T) {
 			args: args{
 				userID:  "userID",
 				orderID: "orderID",
+				details: details,
 			},
 			setup: func(repo *mocks.PVZOrderRepositoryMock, cache *mocks.PVZOrderCacheMock) {
 				cache.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(domain.PVZOrder{}, nil, false)
-				order := domain.PVZOrder{RecipientID: "userID", IssuedAt: time.Now().Add(-(TimeForReturn + time.Hour))}
+				order := domain.PVZOrder{PVZID: pvzID, RecipientID: "userID", Status: domain.OrderStatusIssued, IssuedAt: time.Now().Add(-(domain.DefaultReturnWindow + time.Hour))}
 				repo.GetOrderMock.Expect(minimock.AnyContext, "orderID").Return(order, nil)
 				cache.SetOrderMock.Expect(minimock.AnyContext, order).Return(nil)
 			},
@@ -545,6 +1550,39 @@ func TestPVZOrderUseCase_AcceptReturn(t *testing.T) {
 				return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
 			},
 		},
+		{
+			name: "Unknown return reason",
+			args: args{
+				userID:  "userID",
+				orderID: "orderID",
+				details: domain.ReturnDetails{Reason: "lost_interest", Inspection: domain.InspectionOutcomeResellable},
+			},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
+			},
+		},
+		{
+			name: "Inspection outcome is not set",
+			args: args{
+				userID:  "userID",
+				orderID: "orderID",
+				details: domain.ReturnDetails{Reason: domain.ReturnReasonChangedMind},
+			},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
+			},
+		},
+		{
+			name: "Other reason without comment",
+			args: args{
+				userID:  "userID",
+				orderID: "orderID",
+				details: domain.ReturnDetails{Reason: domain.ReturnReasonOther, Inspection: domain.InspectionOutcomeResellable},
+			},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -553,9 +1591,14 @@ func TestPVZOrderUseCase_AcceptReturn(t *testing.T) {
 			ctrl := minimock.NewController(t)
 			repo := mocks.NewPVZOrderRepositoryMock(ctrl)
 			cache := mocks.NewPVZOrderCacheMock(ctrl)
-			uc := NewPVZOrderUseCase(repo, nil, pvzID, cache)
-			tt.setup(repo, cache)
-			err := uc.AcceptReturn(ctx, tt.args.userID, tt.args.orderID)
+			policies := mocks.NewPVZPoliciesMock(ctrl)
+			policies.PolicyMock.Optional().Return(tt.policy, nil)
+			policies.CalendarMock.Optional().Return(tt.calendar, nil)
+			uc := NewPVZOrderUseCase(repo, nil, cache, policies, nil)
+			if tt.setup != nil {
+				tt.setup(repo, cache)
+			}
+			err := uc.AcceptReturn(ctx, tt.args.userID, tt.args.orderID, tt.args.details)
 			tt.wantErr(t, err)
 		})
 	}
@@ -570,9 +1613,9 @@ func TestPVZOrderUseCase_GetReturns(t *testing.T) {
 	repoMock := mocks.NewPVZOrderRepositoryMock(ctrl)
 	cacheMock := mocks.NewPVZOrderCacheMock(ctrl)
 
-	useCase := NewPVZOrderUseCase(repoMock, nil, pvzID, cacheMock)
+	useCase := NewPVZOrderUseCase(repoMock, nil, cacheMock, nil, nil)
 
-	ctx := context.Background()
+	ctx := abstractions.ContextWithPVZID(context.Background(), pvzID)
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -584,27 +1627,27 @@ func TestPVZOrderUseCase_GetReturns(t *testing.T) {
 		{
 			name: "Success (cache miss)",
 			setup: func() {
-				cacheMock.GetReturnsMock.Expect(minimock.AnyContext).Return(nil, nil, false)
+				cacheMock.GetReturnsMock.Expect(minimock.AnyContext, pvzID).Return(nil, nil, false)
 				got := []domain.PVZOrder{{PVZID: pvzID}}
-				repoMock.GetReturnsMock.Expect(minimock.AnyContext).Return(got, nil)
-				cacheMock.SetGetReturnsMock.Expect(minimock.AnyContext, got).Return(nil)
+				repoMock.GetReturnsMock.Expect(minimock.AnyContext, pvzID).Return(got, nil)
+				cacheMock.SetGetReturnsMock.Expect(minimock.AnyContext, pvzID, got).Return(nil)
 			},
 			wantErr: assert.NoError,
 		},
 		{
 			name: "Success (cache hit)",
 			setup: func() {
-				cacheMock.GetReturnsMock.Expect(minimock.AnyContext).Return([]domain.PVZOrder{{PVZID: pvzID}}, nil, true)
+				cacheMock.GetReturnsMock.Expect(minimock.AnyContext, pvzID).Return([]domain.PVZOrder{{PVZID: pvzID}}, nil, true)
 			},
 			wantErr: assert.NoError,
 		},
 		{
 			name: "No returns",
 			setup: func() {
-				cacheMock.GetReturnsMock.Expect(minimock.AnyContext).Return(nil, nil, false)
+				cacheMock.GetReturnsMock.Expect(minimock.AnyContext, pvzID).Return(nil, nil, false)
 				got := []domain.PVZOrder{}
-				repoMock.GetReturnsMock.Expect(minimock.AnyContext).Return(got, nil)
-				cacheMock.SetGetReturnsMock.Expect(minimock.AnyContext, got).Return(nil)
+				repoMock.GetReturnsMock.Expect(minimock.AnyContext, pvzID).Return(got, nil)
+				cacheMock.SetGetReturnsMock.Expect(minimock.AnyContext, pvzID, got).Return(nil)
 			},
 			wantErr: assert.NoError,
 		},
@@ -618,3 +1661,401 @@ func TestPVZOrderUseCase_GetReturns(t *testing.T) {
 		})
 	}
 }
+
+func TestPVZOrderUseCase_GetOrderHistory(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	repoMock := mocks.NewPVZOrderRepositoryMock(ctrl)
+
+	useCase := NewPVZOrderUseCase(repoMock, nil, nil, nil, nil)
+
+	ctx := abstractions.ContextWithPVZID(context.Background(), "currentPVZID")
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type args struct {
+		orderID string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func()
+		want    []domain.Event
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success",
+			args: args{
+				orderID: "orderID",
+			},
+			setup: func() {
+				repoMock.GetOrderHistoryMock.Expect(minimock.AnyContext, "orderID").Return([]domain.Event{
+					domain.NewOrderIssuedEvent("orderID"),
+				}, nil)
+			},
+			want: []domain.Event{
+				{EventType: domain.EventTypeOrderIssued, Payload: map[string]interface{}{"order_id": "orderID"}},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Pickup code is redacted",
+			args: args{
+				orderID: "orderID",
+			},
+			setup: func() {
+				repoMock.GetOrderHistoryMock.Expect(minimock.AnyContext, "orderID").Return([]domain.Event{
+					domain.NewOrderPickupCodeIssuedEvent("orderID", "userID", "123456"),
+				}, nil)
+			},
+			want: []domain.Event{
+				{EventType: domain.EventTypeOrderPickupCodeIssued, Payload: map[string]interface{}{"order_id": "orderID", "recipient_id": "userID"}},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Order not found",
+			args: args{
+				orderID: "unknownOrderID",
+			},
+			setup: func() {
+				repoMock.GetOrderHistoryMock.Expect(minimock.AnyContext, "unknownOrderID").Return(nil, domain.ErrNotFound)
+			},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.Error(t, err, i) && errors.Is(err, domain.ErrNotFound)
+			},
+		},
+		{
+			name: "Empty order ID",
+			args: args{
+				orderID: "",
+			},
+			setup: func() {},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			got, err := useCase.GetOrderHistory(ctx, tt.args.orderID)
+			if !tt.wantErr(t, err) {
+				return
+			}
+			assert.Len(t, got, len(tt.want))
+			for i := range tt.want {
+				assert.Equal(t, tt.want[i].EventType, got[i].EventType)
+				assert.Equal(t, tt.want[i].Payload, got[i].Payload)
+			}
+		})
+	}
+}
+
+func TestPVZOrderUseCase_ExtendStorage(t *testing.T) {
+	t.Parallel()
+
+	const pvzID = "currentPVZID"
+
+	ctrl := minimock.NewController(t)
+	repoMock := mocks.NewPVZOrderRepositoryMock(ctrl)
+	policiesMock := mocks.NewPVZPoliciesMock(ctrl)
+
+	useCase := NewPVZOrderUseCase(repoMock, nil, nil, policiesMock, nil)
+
+	ctx := abstractions.ContextWithPVZID(context.Background(), pvzID)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	accepted := domain.PVZOrder{
+		OrderID:     "orderID",
+		PVZID:       pvzID,
+		Status:      domain.OrderStatusAccepted,
+		Version:     3,
+		ReceivedAt:  time.Now(),
+		StorageTime: 24 * time.Hour,
+	}
+
+	// The next two weeks are holidays, so the storage time is counted after them
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	holidays := make([]time.Time, 0, 14)
+	for day := 1; day <= 14; day++ {
+		holidays = append(holidays, today.AddDate(0, 0, day))
+	}
+	calendar, err := domain.NewCalendar(time.UTC, 0, 0, nil, holidays)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	isInvalidArgument := func(t assert.TestingT, err error, i ...interface{}) bool {
+		return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
+	}
+
+	type args struct {
+		orderID   string
+		extension time.Duration
+		options   []abstractions.MutationOptFunc
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func()
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success",
+			args: args{
+				orderID:   accepted.OrderID,
+				extension: 24 * time.Hour,
+				options:   []abstractions.MutationOptFunc{abstractions.WithExpectedVersion(2)},
+			},
+			setup: func() {
+				repoMock.GetOrderMock.Expect(minimock.AnyContext, accepted.OrderID).Return(accepted, nil)
+				policiesMock.PolicyMock.Expect(minimock.AnyContext, pvzID).Return(domain.PVZPolicy{MaxStorageTime: 48 * time.Hour}, nil)
+				policiesMock.CalendarMock.Expect(minimock.AnyContext, pvzID).Return(domain.Calendar{}, nil)
+				repoMock.ExtendStorageMock.Expect(
+					minimock.AnyContext, accepted.OrderID, 24*time.Hour, accepted.ReceivedAt.Add(48*time.Hour), "operatorID", int64(2),
+				).Return(nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Expiry is counted by the PVZ calendar",
+			args: args{
+				orderID:   accepted.OrderID,
+				extension: 24 * time.Hour,
+			},
+			setup: func() {
+				repoMock.GetOrderMock.Expect(minimock.AnyContext, accepted.OrderID).Return(accepted, nil)
+				policiesMock.PolicyMock.Expect(minimock.AnyContext, pvzID).Return(domain.PVZPolicy{MaxStorageTime: 48 * time.Hour}, nil)
+				policiesMock.CalendarMock.Expect(minimock.AnyContext, pvzID).Return(calendar, nil)
+				repoMock.ExtendStorageMock.Expect(
+					minimock.AnyContext, accepted.OrderID, 24*time.Hour, calendar.Deadline(accepted.ReceivedAt, 48*time.Hour), "operatorID", accepted.Version,
+				).Return(nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Exceeds max storage time",
+			args: args{
+				orderID:   accepted.OrderID,
+				extension: 25 * time.Hour,
+			},
+			setup: func() {
+				repoMock.GetOrderMock.Expect(minimock.AnyContext, accepted.OrderID).Return(accepted, nil)
+				policiesMock.PolicyMock.Expect(minimock.AnyContext, pvzID).Return(domain.PVZPolicy{MaxStorageTime: 48 * time.Hour}, nil)
+			},
+			wantErr: isInvalidArgument,
+		},
+		{
+			name: "Unlimited max storage time",
+			args: args{
+				orderID:   accepted.OrderID,
+				extension: 30 * 24 * time.Hour,
+			},
+			setup: func() {
+				repoMock.GetOrderMock.Expect(minimock.AnyContext, accepted.OrderID).Return(accepted, nil)
+				policiesMock.PolicyMock.Expect(minimock.AnyContext, pvzID).Return(domain.PVZPolicy{}, nil)
+				policiesMock.CalendarMock.Expect(minimock.AnyContext, pvzID).Return(domain.Calendar{}, nil)
+				repoMock.ExtendStorageMock.Expect(
+					minimock.AnyContext, accepted.OrderID, 30*24*time.Hour, accepted.ReceivedAt.Add(31*24*time.Hour), "operatorID", accepted.Version,
+				).Return(nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Order is issued",
+			args: args{
+				orderID:   "issuedOrderID",
+				extension: time.Hour,
+			},
+			setup: func() {
+				issued := accepted
+				issued.OrderID = "issuedOrderID"
+				issued.Status = domain.OrderStatusIssued
+				repoMock.GetOrderMock.Expect(minimock.AnyContext, issued.OrderID).Return(issued, nil)
+				policiesMock.PolicyMock.Expect(minimock.AnyContext, pvzID).Return(domain.PVZPolicy{MaxStorageTime: 48 * time.Hour}, nil)
+			},
+			wantErr: isInvalidArgument,
+		},
+		{
+			name: "Order from another PVZ",
+			args: args{
+				orderID:   "otherOrderID",
+				extension: time.Hour,
+			},
+			setup: func() {
+				other := accepted
+				other.OrderID = "otherOrderID"
+				other.PVZID = "otherPVZID"
+				repoMock.GetOrderMock.Expect(minimock.AnyContext, other.OrderID).Return(other, nil)
+				policiesMock.PolicyMock.Expect(minimock.AnyContext, pvzID).Return(domain.PVZPolicy{MaxStorageTime: 48 * time.Hour}, nil)
+			},
+			wantErr: isInvalidArgument,
+		},
+		{
+			name: "Non-positive extension",
+			args: args{
+				orderID:   accepted.OrderID,
+				extension: 0,
+			},
+			setup:   func() {},
+			wantErr: isInvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setup()
+			tt.wantErr(t, useCase.ExtendStorage(ctx, tt.args.orderID, tt.args.extension, "operatorID", tt.args.options...))
+		})
+	}
+}
+
+func TestPVZOrderUseCase_ExpectedVersion(t *testing.T) {
+	t.Parallel()
+
+	const pvzID = "currentPVZID"
+
+	ctx := abstractions.ContextWithPVZID(context.Background(), pvzID)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ctrl := minimock.NewController(t)
+	repoMock := mocks.NewPVZOrderRepositoryMock(ctrl)
+	cacheMock := mocks.NewPVZOrderCacheMock(ctrl)
+	policiesMock := mocks.NewPVZPoliciesMock(ctrl)
+
+	useCase := NewPVZOrderUseCase(repoMock, nil, cacheMock, policiesMock, nil)
+
+	expired := domain.PVZOrder{OrderID: "expiredOrderID", PVZID: pvzID, Status: domain.OrderStatusExpired, Version: 3}
+	issued := domain.PVZOrder{OrderID: "issuedOrderID", PVZID: pvzID, RecipientID: "userID", Status: domain.OrderStatusIssued, IssuedAt: time.Now(), Version: 2}
+	details := domain.ReturnDetails{Reason: domain.ReturnReasonChangedMind, Inspection: domain.InspectionOutcomeResellable}
+
+	cacheMock.GetOrderMock.Set(func(_ context.Context, id string) (domain.PVZOrder, error, bool) {
+		if id == expired.OrderID {
+			return expired, nil, true
+		}
+		return issued, nil, true
+	})
+	policiesMock.PolicyMock.Return(domain.PVZPolicy{}, nil)
+	policiesMock.CalendarMock.Return(domain.Calendar{}, nil)
+	repoMock.DeleteOrderMock.Expect(minimock.AnyContext, expired.OrderID, int64(3)).Return(nil)
+	repoMock.SetOrderReturnedMock.Expect(minimock.AnyContext, issued.OrderID, details, int64(1)).Return(domain.ErrVersionMismatch)
+
+	assert.NoError(t, useCase.ReturnOrderDelivery(ctx, expired.OrderID, abstractions.WithExpectedVersion(3)))
+	assert.ErrorIs(t, useCase.AcceptReturn(ctx, "userID", issued.OrderID, details, abstractions.WithExpectedVersion(1)), domain.ErrVersionMismatch)
+	assert.ErrorIs(t, useCase.AcceptReturn(ctx, "userID", issued.OrderID, details, abstractions.WithExpectedVersion(-1)), domain.ErrInvalidArgument)
+}
+
+func TestPVZOrderUseCase_PVZIsNotProvided(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	ctrl := minimock.NewController(t)
+	repoMock := mocks.NewPVZOrderRepositoryMock(ctrl)
+	cacheMock := mocks.NewPVZOrderCacheMock(ctrl)
+
+	useCase := NewPVZOrderUseCase(repoMock, nil, cacheMock, nil, nil)
+
+	isInvalidArgument := func(t assert.TestingT, err error, i ...interface{}) bool {
+		return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
+	}
+
+	_, err := useCase.AcceptOrderDelivery(ctx, "orderID", "userID", time.Hour, domain.RUB(100), 1, domain.Dimensions{}, domain.PackagingTypeBox, false)
+	isInvalidArgument(t, err)
+	isInvalidArgument(t, useCase.ReturnOrderDelivery(ctx, "orderID"))
+	_, err = useCase.GiveOrderToClient(ctx, []domain.IssueDecision{domain.NewIssueDecision("orderID")})
+	isInvalidArgument(t, err)
+	isInvalidArgument(t, useCase.ExtendStorage(ctx, "orderID", time.Hour, "operatorID"))
+
+	_, err = useCase.GetOrders(ctx, "userID", abstractions.WithSamePVZ())
+	isInvalidArgument(t, err)
+
+	_, err = useCase.GetReturns(ctx)
+	isInvalidArgument(t, err)
+}
+
+func TestPVZOrderUseCase_QuotePackaging(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	type args struct {
+		cost       domain.Money
+		weight     int
+		dimensions domain.Dimensions
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		setup   func(packagerMock *mocks.OrderPackagerInterfaceMock)
+		want    []domain.PackagingQuote
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Cheapest is marked",
+			args: args{cost: domain.RUB(1000), weight: 5000},
+			setup: func(packagerMock *mocks.OrderPackagerInterfaceMock) {
+				packagerMock.QuotePackagingMock.Expect(domain.PVZOrder{Cost: domain.RUB(1000), Weight: 5000}).Return([]domain.PackagingQuote{
+					{Packaging: domain.PackagingTypeBox, Price: domain.RUB(3000)},
+					{Packaging: domain.PackagingTypeBag, Price: domain.RUB(1500)},
+					{Packaging: domain.PackagingTypeFilm, Price: domain.RUB(1500)},
+				}, nil)
+			},
+			want: []domain.PackagingQuote{
+				{Packaging: domain.PackagingTypeBox, Price: domain.RUB(3000)},
+				{Packaging: domain.PackagingTypeBag, Price: domain.RUB(1500), Cheapest: true},
+				{Packaging: domain.PackagingTypeFilm, Price: domain.RUB(1500)},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "No packaging fits",
+			args: args{cost: domain.RUB(1000), weight: 500, dimensions: domain.Dimensions{Length: 300, Width: 200, Height: 100}},
+			setup: func(packagerMock *mocks.OrderPackagerInterfaceMock) {
+				packagerMock.QuotePackagingMock.Expect(domain.PVZOrder{
+					Cost:       domain.RUB(1000),
+					Weight:     500,
+					Dimensions: domain.Dimensions{Length: 300, Width: 200, Height: 100},
+				}).Return([]domain.PackagingQuote{}, nil)
+			},
+			want:    []domain.PackagingQuote{},
+			wantErr: assert.NoError,
+		},
+		{
+			name:  "Negative weight",
+			args:  args{cost: domain.RUB(1000), weight: -1},
+			setup: func(packagerMock *mocks.OrderPackagerInterfaceMock) {},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.Error(t, err, i) && errors.Is(err, domain.ErrInvalidArgument)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := minimock.NewController(t)
+			packagerMock := mocks.NewOrderPackagerInterfaceMock(ctrl)
+			tt.setup(packagerMock)
+
+			useCase := NewPVZOrderUseCase(nil, packagerMock, nil, nil, nil)
+
+			got, err := useCase.QuotePackaging(ctx, tt.args.cost, tt.args.weight, tt.args.dimensions)
+			if !tt.wantErr(t, err) {
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
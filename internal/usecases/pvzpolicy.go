@@ -0,0 +1,185 @@
+package usecases
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+
+	"homework/internal/abstractions"
+	"homework/internal/domain"
+)
+
+var (
+	_ abstractions.IPVZPolicyUseCase = &PVZPolicyUseCase{}
+	_ PVZPolicies                    = &PVZPolicyUseCase{}
+)
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i PVZPolicyRepository -s _mock.go -o ./mocks
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i PVZPolicyCache -s _mock.go -o ./mocks
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i PVZRegistry -s _mock.go -o ./mocks
+
+// PVZPolicyRepository is an interface for the store of the PVZ rules set by the administrator
+type PVZPolicyRepository interface {
+	// GetPolicy returns the stored policy of the PVZ, domain.ErrNotFound is returned if the PVZ has none
+	GetPolicy(ctx context.Context, pvzID string) (domain.PVZPolicy, error)
+	// SavePolicy creates or replaces the stored policy of the PVZ and returns it with the new version.
+	// domain.ErrVersionMismatch is returned if the stored policy is not of the expected version, 0 means any version
+	SavePolicy(ctx context.Context, policy domain.PVZPolicy, expectedVersion int64) (domain.PVZPolicy, error)
+}
+
+// PVZPolicyCache keeps the stored policies in the process, so the rules are not read on every operation.
+// The PVZs without the stored policy are cached with the zero version
+type PVZPolicyCache interface {
+	GetPolicy(ctx context.Context, pvzID string) (domain.PVZPolicy, error, bool)
+	SetPolicy(ctx context.Context, policy domain.PVZPolicy) error
+}
+
+// PVZRegistry is a registry of the known PVZs
+type PVZRegistry interface {
+	Exists(ctx context.Context, pvzID string) (bool, error)
+}
+
+// PVZPolicyUseCase provides the rules of the PVZs: the stored policies set by the administrator
+// override the defaults, the rules which can not be stored are taken from the defaults as is
+type PVZPolicyUseCase struct {
+	repo     PVZPolicyRepository
+	cache    PVZPolicyCache
+	defaults PVZPolicies
+	registry PVZRegistry
+}
+
+// NewPVZPolicyUseCase creates a new PVZ policy use case
+func NewPVZPolicyUseCase(repo PVZPolicyRepository, cache PVZPolicyCache, defaults PVZPolicies, registry PVZRegistry) *PVZPolicyUseCase {
+	return &PVZPolicyUseCase{
+		repo:     repo,
+		cache:    cache,
+		defaults: defaults,
+		registry: registry,
+	}
+}
+
+// Policy returns the rules in effect in the PVZ
+func (u *PVZPolicyUseCase) Policy(ctx context.Context, pvzID string) (domain.PVZPolicy, error) {
+	stored, err := u.storedPolicy(ctx, pvzID)
+	if err != nil {
+		return domain.PVZPolicy{}, err
+	}
+
+	defaults, err := u.defaults.Policy(ctx, pvzID)
+	if err != nil {
+		return domain.PVZPolicy{}, err
+	}
+
+	return defaults.WithOverrides(stored), nil
+}
+
+func (u *PVZPolicyUseCase) storedPolicy(ctx context.Context, pvzID string) (domain.PVZPolicy, error) {
+	policy, err, ok := u.cache.GetPolicy(ctx, pvzID)
+	if err != nil {
+		return domain.PVZPolicy{}, err
+	}
+
+	if ok {
+		return policy, nil
+	}
+
+	policy, err = u.repo.GetPolicy(ctx, pvzID)
+	if errors.Is(err, domain.ErrNotFound) {
+		policy, err = domain.PVZPolicy{PVZID: pvzID}, nil
+	}
+	if err != nil {
+		return domain.PVZPolicy{}, err
+	}
+
+	if err := u.cache.SetPolicy(ctx, policy); err != nil {
+		return domain.PVZPolicy{}, err
+	}
+
+	return policy, nil
+}
+
+func (u *PVZPolicyUseCase) WeightTolerancePercent(ctx context.Context, pvzID string) (int, error) {
+	return u.defaults.WeightTolerancePercent(ctx, pvzID)
+}
+
+func (u *PVZPolicyUseCase) PaidStorage(ctx context.Context, pvzID string, packaging domain.PackagingType) (domain.PaidStorage, error) {
+	return u.defaults.PaidStorage(ctx, pvzID, packaging)
+}
+
+func (u *PVZPolicyUseCase) Calendar(ctx context.Context, pvzID string) (domain.Calendar, error) {
+	return u.defaults.Calendar(ctx, pvzID)
+}
+
+// GetPVZPolicy returns the rules in effect in the PVZ
+func (u *PVZPolicyUseCase) GetPVZPolicy(ctx context.Context, pvzID string) (domain.PVZPolicy, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZPolicyUseCase.GetPVZPolicy")
+	defer span.Finish()
+
+	if pvzID == "" {
+		return domain.PVZPolicy{}, fmt.Errorf("%w: pvz id is empty", domain.ErrInvalidArgument)
+	}
+
+	if err := u.checkPVZExists(ctx, pvzID); err != nil {
+		return domain.PVZPolicy{}, err
+	}
+
+	return u.Policy(ctx, pvzID)
+}
+
+// checkPVZExists checks the PVZ of the admin request against the registry,
+// the admin methods take the PVZ in the request and are not checked by the PVZ middleware
+func (u *PVZPolicyUseCase) checkPVZExists(ctx context.Context, pvzID string) error {
+	ok, err := u.registry.Exists(ctx, pvzID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return fmt.Errorf("%w: unknown pvz %s", domain.ErrNotFound, pvzID)
+	}
+	return nil
+}
+
+// UpdatePVZPolicy replaces the stored rules of the PVZ, the rules which are not set are reset to the defaults.
+// The orders already accepted keep their storage time, the new rules apply to the next operations
+func (u *PVZPolicyUseCase) UpdatePVZPolicy(ctx context.Context, policy domain.PVZPolicy, options ...abstractions.MutationOptFunc) (domain.PVZPolicy, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "PVZPolicyUseCase.UpdatePVZPolicy")
+	defer span.Finish()
+
+	opts, err := abstractions.NewMutationOptions(options...)
+	if err != nil {
+		return domain.PVZPolicy{}, err
+	}
+
+	if err := policy.Validate(); err != nil {
+		return domain.PVZPolicy{}, err
+	}
+
+	if policy.UpdatedBy == "" {
+		return domain.PVZPolicy{}, fmt.Errorf("%w: updatedBy is empty", domain.ErrInvalidArgument)
+	}
+
+	if err := u.checkPVZExists(ctx, policy.PVZID); err != nil {
+		return domain.PVZPolicy{}, err
+	}
+
+	policy.UpdatedAt = time.Now().UTC()
+
+	stored, err := u.repo.SavePolicy(ctx, policy, opts.ExpectedVersion)
+	if err != nil {
+		return domain.PVZPolicy{}, err
+	}
+
+	if err := u.cache.SetPolicy(ctx, stored); err != nil {
+		return domain.PVZPolicy{}, err
+	}
+
+	defaults, err := u.defaults.Policy(ctx, policy.PVZID)
+	if err != nil {
+		return domain.PVZPolicy{}, err
+	}
+
+	return defaults.WithOverrides(stored), nil
+}
@@ -0,0 +1,279 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"homework/internal/abstractions"
+	"homework/internal/domain"
+	"homework/internal/usecases/mocks"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPVZPolicyUseCase_Policy(t *testing.T) {
+	t.Parallel()
+
+	const pvzID = "currentPVZID"
+
+	defaults := domain.PVZPolicy{PVZID: pvzID, ReturnWindow: domain.DefaultReturnWindow, MaxStorageTime: 7 * 24 * time.Hour}
+	stored := domain.PVZPolicy{
+		PVZID:            pvzID,
+		MaxOrderWeight:   20000,
+		AllowedPackaging: []domain.PackagingType{domain.PackagingTypeBag},
+		SetRules:         []domain.PVZPolicyRule{domain.PVZPolicyRuleMaxOrderWeight, domain.PVZPolicyRuleAllowedPackaging},
+		Version:          2,
+		UpdatedBy:        "admin",
+	}
+	setReturnWindow := []domain.PVZPolicyRule{domain.PVZPolicyRuleReturnWindow}
+
+	tests := []struct {
+		name    string
+		setup   func(repoMock *mocks.PVZPolicyRepositoryMock, cacheMock *mocks.PVZPolicyCacheMock)
+		want    domain.PVZPolicy
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Cache hit",
+			setup: func(_ *mocks.PVZPolicyRepositoryMock, cacheMock *mocks.PVZPolicyCacheMock) {
+				cacheMock.GetPolicyMock.Expect(minimock.AnyContext, pvzID).Return(stored, nil, true)
+			},
+			want: domain.PVZPolicy{
+				PVZID:            pvzID,
+				ReturnWindow:     domain.DefaultReturnWindow,
+				MaxStorageTime:   7 * 24 * time.Hour,
+				MaxOrderWeight:   20000,
+				AllowedPackaging: []domain.PackagingType{domain.PackagingTypeBag},
+				SetRules:         stored.SetRules,
+				Version:          2,
+				UpdatedBy:        "admin",
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Cache miss",
+			setup: func(repoMock *mocks.PVZPolicyRepositoryMock, cacheMock *mocks.PVZPolicyCacheMock) {
+				cacheMock.GetPolicyMock.Expect(minimock.AnyContext, pvzID).Return(domain.PVZPolicy{}, nil, false)
+				repoMock.GetPolicyMock.Expect(minimock.AnyContext, pvzID).Return(domain.PVZPolicy{PVZID: pvzID, ReturnWindow: time.Hour, SetRules: setReturnWindow, Version: 1}, nil)
+				cacheMock.SetPolicyMock.Expect(minimock.AnyContext, domain.PVZPolicy{PVZID: pvzID, ReturnWindow: time.Hour, SetRules: setReturnWindow, Version: 1}).Return(nil)
+			},
+			want:    domain.PVZPolicy{PVZID: pvzID, ReturnWindow: time.Hour, MaxStorageTime: 7 * 24 * time.Hour, SetRules: setReturnWindow, Version: 1},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Limit removed by administrator",
+			setup: func(_ *mocks.PVZPolicyRepositoryMock, cacheMock *mocks.PVZPolicyCacheMock) {
+				removed := domain.PVZPolicy{PVZID: pvzID, SetRules: []domain.PVZPolicyRule{domain.PVZPolicyRuleMaxStorageTime}, Version: 3}
+				cacheMock.GetPolicyMock.Expect(minimock.AnyContext, pvzID).Return(removed, nil, true)
+			},
+			want: domain.PVZPolicy{
+				PVZID:        pvzID,
+				ReturnWindow: domain.DefaultReturnWindow,
+				SetRules:     []domain.PVZPolicyRule{domain.PVZPolicyRuleMaxStorageTime},
+				Version:      3,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "PVZ has no stored policy",
+			setup: func(repoMock *mocks.PVZPolicyRepositoryMock, cacheMock *mocks.PVZPolicyCacheMock) {
+				cacheMock.GetPolicyMock.Expect(minimock.AnyContext, pvzID).Return(domain.PVZPolicy{}, nil, false)
+				repoMock.GetPolicyMock.Expect(minimock.AnyContext, pvzID).Return(domain.PVZPolicy{}, domain.ErrNotFound)
+				cacheMock.SetPolicyMock.Expect(minimock.AnyContext, domain.PVZPolicy{PVZID: pvzID}).Return(nil)
+			},
+			want:    defaults,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Repository error",
+			setup: func(repoMock *mocks.PVZPolicyRepositoryMock, cacheMock *mocks.PVZPolicyCacheMock) {
+				cacheMock.GetPolicyMock.Expect(minimock.AnyContext, pvzID).Return(domain.PVZPolicy{}, nil, false)
+				repoMock.GetPolicyMock.Expect(minimock.AnyContext, pvzID).Return(domain.PVZPolicy{}, assert.AnError)
+			},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, assert.AnError, i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := minimock.NewController(t)
+			repoMock := mocks.NewPVZPolicyRepositoryMock(ctrl)
+			cacheMock := mocks.NewPVZPolicyCacheMock(ctrl)
+			defaultsMock := mocks.NewPVZPoliciesMock(ctrl)
+			defaultsMock.PolicyMock.Optional().Return(defaults, nil)
+			tt.setup(repoMock, cacheMock)
+
+			got, err := NewPVZPolicyUseCase(repoMock, cacheMock, defaultsMock, nil).Policy(context.Background(), pvzID)
+			if !tt.wantErr(t, err) || err != nil {
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPVZPolicyUseCase_UpdatePVZPolicy(t *testing.T) {
+	t.Parallel()
+
+	const pvzID = "currentPVZID"
+
+	defaults := domain.PVZPolicy{PVZID: pvzID, ReturnWindow: domain.DefaultReturnWindow, MaxStorageTime: 7 * 24 * time.Hour}
+
+	isInvalidArgument := func(t assert.TestingT, err error, i ...interface{}) bool {
+		return assert.ErrorIs(t, err, domain.ErrInvalidArgument, i...)
+	}
+
+	setMaxStorageTime := []domain.PVZPolicyRule{domain.PVZPolicyRuleMaxStorageTime}
+
+	tests := []struct {
+		name    string
+		policy  domain.PVZPolicy
+		options []abstractions.MutationOptFunc
+		setup   func(repoMock *mocks.PVZPolicyRepositoryMock, cacheMock *mocks.PVZPolicyCacheMock)
+		want    domain.PVZPolicy
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "Success",
+			policy:  domain.PVZPolicy{PVZID: pvzID, MaxStorageTime: 3 * 24 * time.Hour, SetRules: setMaxStorageTime, UpdatedBy: "admin"},
+			options: []abstractions.MutationOptFunc{abstractions.WithExpectedVersion(1)},
+			setup: func(repoMock *mocks.PVZPolicyRepositoryMock, cacheMock *mocks.PVZPolicyCacheMock) {
+				repoMock.SavePolicyMock.Set(func(_ context.Context, policy domain.PVZPolicy, expectedVersion int64) (domain.PVZPolicy, error) {
+					assert.Equal(t, int64(1), expectedVersion)
+					assert.False(t, policy.UpdatedAt.IsZero())
+					policy.Version, policy.UpdatedAt = 2, time.Time{}
+					return policy, nil
+				})
+				cacheMock.SetPolicyMock.Expect(minimock.AnyContext, domain.PVZPolicy{PVZID: pvzID, MaxStorageTime: 3 * 24 * time.Hour, SetRules: setMaxStorageTime, UpdatedBy: "admin", Version: 2}).Return(nil)
+			},
+			want: domain.PVZPolicy{
+				PVZID:          pvzID,
+				ReturnWindow:   domain.DefaultReturnWindow,
+				MaxStorageTime: 3 * 24 * time.Hour,
+				SetRules:       setMaxStorageTime,
+				Version:        2,
+				UpdatedBy:      "admin",
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:   "Max storage time removed",
+			policy: domain.PVZPolicy{PVZID: pvzID, SetRules: setMaxStorageTime, UpdatedBy: "admin"},
+			setup: func(repoMock *mocks.PVZPolicyRepositoryMock, cacheMock *mocks.PVZPolicyCacheMock) {
+				repoMock.SavePolicyMock.Set(func(_ context.Context, policy domain.PVZPolicy, _ int64) (domain.PVZPolicy, error) {
+					policy.Version, policy.UpdatedAt = 1, time.Time{}
+					return policy, nil
+				})
+				cacheMock.SetPolicyMock.Return(nil)
+			},
+			want: domain.PVZPolicy{
+				PVZID:        pvzID,
+				ReturnWindow: domain.DefaultReturnWindow,
+				SetRules:     setMaxStorageTime,
+				Version:      1,
+				UpdatedBy:    "admin",
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "Version mismatch",
+			policy:  domain.PVZPolicy{PVZID: pvzID, UpdatedBy: "admin"},
+			options: []abstractions.MutationOptFunc{abstractions.WithExpectedVersion(1)},
+			setup: func(repoMock *mocks.PVZPolicyRepositoryMock, _ *mocks.PVZPolicyCacheMock) {
+				repoMock.SavePolicyMock.Return(domain.PVZPolicy{}, domain.ErrVersionMismatch)
+			},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, domain.ErrVersionMismatch, i...)
+			},
+		},
+		{
+			name:    "Negative max storage time",
+			policy:  domain.PVZPolicy{PVZID: pvzID, MaxStorageTime: -time.Hour, SetRules: setMaxStorageTime, UpdatedBy: "admin"},
+			wantErr: isInvalidArgument,
+		},
+		{
+			name:    "Max storage time is not set",
+			policy:  domain.PVZPolicy{PVZID: pvzID, MaxStorageTime: time.Hour, UpdatedBy: "admin"},
+			wantErr: isInvalidArgument,
+		},
+		{
+			name:    "Zero return window",
+			policy:  domain.PVZPolicy{PVZID: pvzID, SetRules: []domain.PVZPolicyRule{domain.PVZPolicyRuleReturnWindow}, UpdatedBy: "admin"},
+			wantErr: isInvalidArgument,
+		},
+		{
+			name:    "Unknown rule",
+			policy:  domain.PVZPolicy{PVZID: pvzID, SetRules: []domain.PVZPolicyRule{"max_volume"}, UpdatedBy: "admin"},
+			wantErr: isInvalidArgument,
+		},
+		{
+			name:    "Duplicate rule",
+			policy:  domain.PVZPolicy{PVZID: pvzID, SetRules: []domain.PVZPolicyRule{domain.PVZPolicyRuleMaxStorageTime, domain.PVZPolicyRuleMaxStorageTime}, UpdatedBy: "admin"},
+			wantErr: isInvalidArgument,
+		},
+		{
+			name:    "Duplicate packaging",
+			policy:  domain.PVZPolicy{PVZID: pvzID, AllowedPackaging: []domain.PackagingType{domain.PackagingTypeBox, domain.PackagingTypeBox}, SetRules: []domain.PVZPolicyRule{domain.PVZPolicyRuleAllowedPackaging}, UpdatedBy: "admin"},
+			wantErr: isInvalidArgument,
+		},
+		{
+			name:    "Empty updatedBy",
+			policy:  domain.PVZPolicy{PVZID: pvzID},
+			wantErr: isInvalidArgument,
+		},
+		{
+			name:    "Empty PVZ",
+			policy:  domain.PVZPolicy{UpdatedBy: "admin"},
+			wantErr: isInvalidArgument,
+		},
+		{
+			name:   "Unknown PVZ",
+			policy: domain.PVZPolicy{PVZID: "unknownPVZID", UpdatedBy: "admin"},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, domain.ErrNotFound, i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := minimock.NewController(t)
+			repoMock := mocks.NewPVZPolicyRepositoryMock(ctrl)
+			cacheMock := mocks.NewPVZPolicyCacheMock(ctrl)
+			defaultsMock := mocks.NewPVZPoliciesMock(ctrl)
+			defaultsMock.PolicyMock.Optional().Return(defaults, nil)
+			registryMock := mocks.NewPVZRegistryMock(ctrl)
+			registryMock.ExistsMock.Optional().Set(func(_ context.Context, id string) (bool, error) {
+				return id == pvzID, nil
+			})
+			if tt.setup != nil {
+				tt.setup(repoMock, cacheMock)
+			}
+
+			got, err := NewPVZPolicyUseCase(repoMock, cacheMock, defaultsMock, registryMock).UpdatePVZPolicy(context.Background(), tt.policy, tt.options...)
+			if !tt.wantErr(t, err) || err != nil {
+				return
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPVZPolicyUseCase_GetPVZPolicy(t *testing.T) {
+	t.Parallel()
+
+	ctrl := minimock.NewController(t)
+	registryMock := mocks.NewPVZRegistryMock(ctrl)
+	registryMock.ExistsMock.Expect(minimock.AnyContext, "unknownPVZID").Return(false, nil)
+
+	_, err := NewPVZPolicyUseCase(nil, nil, nil, registryMock).GetPVZPolicy(context.Background(), "unknownPVZID")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
@@ -0,0 +1,139 @@
+package usecases
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+
+	"homework/internal/abstractions"
+	"homework/internal/domain"
+)
+
+var _ abstractions.IReturnShipmentUseCase = &ReturnShipmentUseCase{}
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i ReturnShipmentRepository -s _mock.go -o ./mocks
+
+// ReturnShipmentRepository is an interface for the store of the shipments of the returned orders
+type ReturnShipmentRepository interface {
+	// CreateReturnShipment stores the shipment and includes its orders in it. domain.ErrConflict is returned
+	// if any of the orders can not be dispatched to the seller or is already included in another shipment
+	CreateReturnShipment(ctx context.Context, shipment domain.ReturnShipment) error
+	// GetReturnShipment returns the shipment with its orders in the order they were included
+	GetReturnShipment(ctx context.Context, shipmentID string) (domain.ReturnShipment, error)
+	// DispatchReturnShipment hands the open shipment over to the courier and marks its orders as dispatched
+	// to the seller. It returns the manifest of the shipment, domain.ErrConflict is returned if it is already dispatched
+	DispatchReturnShipment(ctx context.Context, shipmentID, courierID string, dispatchedAt time.Time) (domain.ReturnManifest, error)
+}
+
+// ReturnShipmentUseCase groups the orders returned by the clients or refused at pickup into the shipments
+// and hands them over to the couriers who take them back to the seller
+type ReturnShipmentUseCase struct {
+	repo ReturnShipmentRepository
+}
+
+// NewReturnShipmentUseCase creates a new return shipment use case
+func NewReturnShipmentUseCase(repo ReturnShipmentRepository) *ReturnShipmentUseCase {
+	return &ReturnShipmentUseCase{
+		repo: repo,
+	}
+}
+
+// CreateReturnShipment groups the returned orders of the current PVZ into a new shipment
+func (r *ReturnShipmentUseCase) CreateReturnShipment(ctx context.Context, orderIDs []string) (domain.ReturnShipment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ReturnShipmentUseCase.CreateReturnShipment")
+	defer span.Finish()
+
+	pvzID, err := currentPVZID(ctx)
+	if err != nil {
+		return domain.ReturnShipment{}, err
+	}
+
+	if err := validateReturnShipmentOrders(orderIDs); err != nil {
+		return domain.ReturnShipment{}, err
+	}
+
+	shipment := domain.NewReturnShipment(pvzID, orderIDs)
+	if err := r.repo.CreateReturnShipment(ctx, shipment); err != nil {
+		return domain.ReturnShipment{}, err
+	}
+
+	return shipment, nil
+}
+
+func validateReturnShipmentOrders(orderIDs []string) error {
+	if len(orderIDs) == 0 {
+		return fmt.Errorf("%w: return shipment is empty", domain.ErrInvalidArgument)
+	}
+
+	if len(orderIDs) > MaxDeliveryBatchSize {
+		return fmt.Errorf("%w: return shipment has more than %d orders", domain.ErrInvalidArgument, MaxDeliveryBatchSize)
+	}
+
+	seen := make(map[string]struct{}, len(orderIDs))
+	for _, orderID := range orderIDs {
+		if orderID == "" {
+			return fmt.Errorf("%w: order id is empty", domain.ErrInvalidArgument)
+		}
+		if _, ok := seen[orderID]; ok {
+			return fmt.Errorf("%w: order %s is repeated in the return shipment", domain.ErrInvalidArgument, orderID)
+		}
+		seen[orderID] = struct{}{}
+	}
+
+	return nil
+}
+
+// DispatchReturnShipment hands the shipment over to the courier and returns the manifest the courier takes with it.
+// The orders of the shipment are dispatched to the seller and leave the returns of the PVZ
+func (r *ReturnShipmentUseCase) DispatchReturnShipment(ctx context.Context, shipmentID, courierID string) (domain.ReturnManifest, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ReturnShipmentUseCase.DispatchReturnShipment")
+	defer span.Finish()
+
+	if courierID == "" {
+		return domain.ReturnManifest{}, fmt.Errorf("%w: courier id is empty", domain.ErrInvalidArgument)
+	}
+
+	shipment, err := r.getShipment(ctx, shipmentID)
+	if err != nil {
+		return domain.ReturnManifest{}, err
+	}
+
+	if !shipment.IsOpen() {
+		return domain.ReturnManifest{}, fmt.Errorf("%w: return shipment is already dispatched", domain.ErrConflict)
+	}
+
+	return r.repo.DispatchReturnShipment(ctx, shipment.ID, courierID, time.Now().UTC())
+}
+
+// GetReturnShipment returns the shipment with its orders and, if it is dispatched, the manifest
+func (r *ReturnShipmentUseCase) GetReturnShipment(ctx context.Context, shipmentID string) (domain.ReturnShipment, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "ReturnShipmentUseCase.GetReturnShipment")
+	defer span.Finish()
+
+	return r.getShipment(ctx, shipmentID)
+}
+
+// getShipment returns the shipment if it belongs to the current PVZ
+func (r *ReturnShipmentUseCase) getShipment(ctx context.Context, shipmentID string) (domain.ReturnShipment, error) {
+	pvzID, err := currentPVZID(ctx)
+	if err != nil {
+		return domain.ReturnShipment{}, err
+	}
+
+	if shipmentID == "" {
+		return domain.ReturnShipment{}, fmt.Errorf("%w: shipment id is empty", domain.ErrInvalidArgument)
+	}
+
+	shipment, err := r.repo.GetReturnShipment(ctx, shipmentID)
+	if err != nil {
+		return domain.ReturnShipment{}, err
+	}
+
+	if shipment.PVZID != pvzID {
+		return domain.ReturnShipment{}, fmt.Errorf("%w: return shipment is created in another pvz", domain.ErrNotFound)
+	}
+
+	return shipment, nil
+}
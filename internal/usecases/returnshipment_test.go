@@ -0,0 +1,191 @@
+package usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"homework/internal/abstractions"
+	"homework/internal/domain"
+	"homework/internal/usecases/mocks"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReturnShipmentUseCase_CreateReturnShipment(t *testing.T) {
+	t.Parallel()
+
+	ctx := abstractions.ContextWithPVZID(context.Background(), "currentPVZID")
+
+	tests := []struct {
+		name     string
+		orderIDs []string
+		setup    func(repoMock *mocks.ReturnShipmentRepositoryMock)
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "Success",
+			orderIDs: []string{"1", "2"},
+			setup: func(repoMock *mocks.ReturnShipmentRepositoryMock) {
+				repoMock.CreateReturnShipmentMock.Set(func(_ context.Context, shipment domain.ReturnShipment) error {
+					assert.Equal(t, "currentPVZID", shipment.PVZID)
+					assert.Equal(t, domain.ReturnShipmentStatusOpen, shipment.Status)
+					assert.Equal(t, []string{"1", "2"}, shipment.OrderIDs)
+					assert.NotEmpty(t, shipment.ID)
+					return nil
+				})
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Empty shipment",
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, domain.ErrInvalidArgument, i...)
+			},
+		},
+		{
+			name:     "Empty order id",
+			orderIDs: []string{"1", ""},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, domain.ErrInvalidArgument, i...)
+			},
+		},
+		{
+			name:     "Repeated order",
+			orderIDs: []string{"1", "1"},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, domain.ErrInvalidArgument, i...)
+			},
+		},
+		{
+			name:     "Order is not returned",
+			orderIDs: []string{"1"},
+			setup: func(repoMock *mocks.ReturnShipmentRepositoryMock) {
+				repoMock.CreateReturnShipmentMock.Return(domain.ErrConflict)
+			},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, domain.ErrConflict, i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := minimock.NewController(t)
+			repoMock := mocks.NewReturnShipmentRepositoryMock(ctrl)
+			if tt.setup != nil {
+				tt.setup(repoMock)
+			}
+
+			uc := NewReturnShipmentUseCase(repoMock)
+
+			_, err := uc.CreateReturnShipment(ctx, tt.orderIDs)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestReturnShipmentUseCase_DispatchReturnShipment(t *testing.T) {
+	t.Parallel()
+
+	ctx := abstractions.ContextWithPVZID(context.Background(), "currentPVZID")
+
+	shipment := func(pvzID string, status domain.ReturnShipmentStatus) domain.ReturnShipment {
+		s := domain.NewReturnShipment(pvzID, []string{"1", "2"})
+		s.ID = "shipmentID"
+		s.Status = status
+		return s
+	}
+
+	tests := []struct {
+		name       string
+		shipmentID string
+		courierID  string
+		setup      func(repoMock *mocks.ReturnShipmentRepositoryMock)
+		wantErr    assert.ErrorAssertionFunc
+	}{
+		{
+			name:       "Success",
+			shipmentID: "shipmentID",
+			courierID:  "courierID",
+			setup: func(repoMock *mocks.ReturnShipmentRepositoryMock) {
+				repoMock.GetReturnShipmentMock.Expect(minimock.AnyContext, "shipmentID").
+					Return(shipment("currentPVZID", domain.ReturnShipmentStatusOpen), nil)
+				repoMock.DispatchReturnShipmentMock.Set(func(_ context.Context, shipmentID, courierID string, dispatchedAt time.Time) (domain.ReturnManifest, error) {
+					assert.Equal(t, "shipmentID", shipmentID)
+					assert.Equal(t, "courierID", courierID)
+					assert.False(t, dispatchedAt.IsZero())
+					return domain.ReturnManifest{ShipmentID: shipmentID, CourierID: courierID, DispatchedAt: dispatchedAt}, nil
+				})
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:       "Already dispatched",
+			shipmentID: "shipmentID",
+			courierID:  "courierID",
+			setup: func(repoMock *mocks.ReturnShipmentRepositoryMock) {
+				repoMock.GetReturnShipmentMock.Return(shipment("currentPVZID", domain.ReturnShipmentStatusDispatched), nil)
+			},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, domain.ErrConflict, i...)
+			},
+		},
+		{
+			name:       "Another PVZ",
+			shipmentID: "shipmentID",
+			courierID:  "courierID",
+			setup: func(repoMock *mocks.ReturnShipmentRepositoryMock) {
+				repoMock.GetReturnShipmentMock.Return(shipment("anotherPVZID", domain.ReturnShipmentStatusOpen), nil)
+			},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, domain.ErrNotFound, i...)
+			},
+		},
+		{
+			name:       "Not found",
+			shipmentID: "shipmentID",
+			courierID:  "courierID",
+			setup: func(repoMock *mocks.ReturnShipmentRepositoryMock) {
+				repoMock.GetReturnShipmentMock.Return(domain.ReturnShipment{}, domain.ErrNotFound)
+			},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, domain.ErrNotFound, i...)
+			},
+		},
+		{
+			name:       "Empty courier",
+			shipmentID: "shipmentID",
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, domain.ErrInvalidArgument, i...)
+			},
+		},
+		{
+			name:      "Empty shipment id",
+			courierID: "courierID",
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.ErrorIs(t, err, domain.ErrInvalidArgument, i...)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := minimock.NewController(t)
+			repoMock := mocks.NewReturnShipmentRepositoryMock(ctrl)
+			if tt.setup != nil {
+				tt.setup(repoMock)
+			}
+
+			uc := NewReturnShipmentUseCase(repoMock)
+
+			_, err := uc.DispatchReturnShipment(ctx, tt.shipmentID, tt.courierID)
+			tt.wantErr(t, err)
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package usecases
+
+import "context"
+
+//go:generate go run github.com/gojuno/minimock/v3/cmd/minimock -g -i TxManager -s _mock.go -o ./mocks
+
+// TxManager runs the writes of several repositories in one transaction.
+// The repositories called with the context passed to f join the transaction
+type TxManager interface {
+	RunReadCommittedTransaction(ctx context.Context, f func(ctx context.Context) error) error
+}
@@ -0,0 +1,7 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_events_order_id ON events ((payload->>'order_id'), created_at);
+
+-- +goose NO TRANSACTION
+-- +goose Down
+DROP INDEX CONCURRENTLY IF EXISTS idx_events_order_id;
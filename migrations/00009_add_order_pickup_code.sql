@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS pickup_code_hash VARCHAR(255);
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS pickup_attempts INT NOT NULL DEFAULT 0;
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS pickup_locked_until TIMESTAMPTZ;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS pickup_locked_until;
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS pickup_attempts;
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS pickup_code_hash;
+-- +goose StatementEnd
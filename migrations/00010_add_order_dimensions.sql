@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS length INT NOT NULL DEFAULT 0;
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS width INT NOT NULL DEFAULT 0;
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS height INT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS height;
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS width;
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS length;
+-- +goose StatementEnd
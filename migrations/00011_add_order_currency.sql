@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The costs of the existing orders are in kopecks. BIGINT keeps the costs of expensive goods,
+-- the table is rewritten once, so the migration should run in a maintenance window
+-- squawk-ignore changing-column-type
+ALTER TABLE pvz_orders ALTER COLUMN cost TYPE BIGINT;
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'RUB';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS currency;
+-- squawk-ignore changing-column-type
+ALTER TABLE pvz_orders ALTER COLUMN cost TYPE INT;
+-- +goose StatementEnd
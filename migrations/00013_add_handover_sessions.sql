@@ -0,0 +1,30 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+-- The reconciliations of the parcels the couriers hand over, expected is the manifest
+-- and report is the discrepancy report set when the session is closed
+CREATE TABLE IF NOT EXISTS handover_sessions (
+    id UUID PRIMARY KEY,
+    pvz_id VARCHAR(255) NOT NULL,
+    courier_id VARCHAR(255) NOT NULL,
+    status VARCHAR(32) NOT NULL,
+    expected JSONB NOT NULL,
+    report JSONB,
+    opened_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    closed_at TIMESTAMP WITH TIME ZONE
+);
+CREATE TABLE IF NOT EXISTS handover_scans (
+    id BIGSERIAL PRIMARY KEY,
+    session_id UUID NOT NULL REFERENCES handover_sessions (id),
+    order_id VARCHAR(255) NOT NULL,
+    measured_weight INT NOT NULL DEFAULT 0,
+    result VARCHAR(32) NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    scanned_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_handover_scans_session_id ON handover_scans (session_id);
+
+-- +goose NO TRANSACTION
+-- +goose Down
+DROP INDEX CONCURRENTLY IF EXISTS idx_handover_scans_session_id;
+DROP TABLE IF EXISTS handover_scans;
+DROP TABLE IF EXISTS handover_sessions;
@@ -0,0 +1,14 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+-- weight stays the weight declared by the courier, measured_weight is 0 if the parcel was not weighed
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS measured_weight INT NOT NULL DEFAULT 0;
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS weight_discrepancy BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS weight_override_reason TEXT;
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_pvz_order_weight_discrepancy ON pvz_orders (pvz_id, received_at) WHERE weight_discrepancy;
+
+-- +goose NO TRANSACTION
+-- +goose Down
+DROP INDEX CONCURRENTLY IF EXISTS idx_pvz_order_weight_discrepancy;
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS weight_override_reason;
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS weight_discrepancy;
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS measured_weight;
@@ -0,0 +1,12 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+-- expiry_reminded_at is set when the recipient is reminded that the order expires soon,
+-- it is reset when the storage is extended
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS expiry_reminded_at TIMESTAMP WITH TIME ZONE;
+-- The expiry sweeper looks for the accepted orders only
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_pvz_order_accepted_received_at ON pvz_orders (received_at) WHERE status = 'accepted' AND deleted_at IS NULL;
+
+-- +goose NO TRANSACTION
+-- +goose Down
+DROP INDEX CONCURRENTLY IF EXISTS idx_pvz_order_accepted_received_at;
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS expiry_reminded_at;
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The paid storage is fixed at the acceptance: paid_storage_days after the storage time the order may still be issued
+-- for daily_storage_fee a day, the fees are in minor units of storage_fee_currency
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS paid_storage_days INT NOT NULL DEFAULT 0;
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS daily_storage_fee BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS storage_fee_currency VARCHAR(3) NOT NULL DEFAULT 'RUB';
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS storage_fee_paid BIGINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS storage_fee_paid;
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS storage_fee_currency;
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS daily_storage_fee;
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS paid_storage_days;
+-- +goose StatementEnd
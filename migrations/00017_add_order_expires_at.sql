@@ -0,0 +1,15 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+-- expires_at is the end of the storage time counted in the business days of the PVZ calendar,
+-- the orders accepted before the calendars count it in calendar days
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS expires_at TIMESTAMP WITH TIME ZONE;
+UPDATE pvz_orders SET expires_at = received_at + storage_time WHERE expires_at IS NULL;
+-- The expiry sweeper looks for the accepted orders by the end of the storage time
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_pvz_order_accepted_expires_at ON pvz_orders (expires_at) WHERE status = 'accepted' AND deleted_at IS NULL;
+DROP INDEX CONCURRENTLY IF EXISTS idx_pvz_order_accepted_received_at;
+
+-- +goose NO TRANSACTION
+-- +goose Down
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_pvz_order_accepted_received_at ON pvz_orders (received_at) WHERE status = 'accepted' AND deleted_at IS NULL;
+DROP INDEX CONCURRENTLY IF EXISTS idx_pvz_order_accepted_expires_at;
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS expires_at;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The rules of the PVZs set by the administrator, the NULL and zero rules are not set and the defaults
+-- of the service are used instead. allowed_packaging is empty if all the packaging types are accepted
+CREATE TABLE IF NOT EXISTS pvz_policies (
+    pvz_id VARCHAR(255) PRIMARY KEY,
+    return_window INTERVAL,
+    max_storage_time INTERVAL,
+    max_order_weight INT NOT NULL DEFAULT 0,
+    allowed_packaging TEXT[] NOT NULL DEFAULT '{}',
+    version BIGINT NOT NULL DEFAULT 1,
+    updated_by VARCHAR(255) NOT NULL,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS pvz_policies;
+-- +goose StatementEnd
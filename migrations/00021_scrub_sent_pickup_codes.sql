@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The plain pickup code is only kept in the event until it is delivered to the recipient
+CREATE OR REPLACE FUNCTION mark_event_as_sent(event_id UUID)
+    RETURNS VOID AS $$
+BEGIN
+    UPDATE events
+    SET sent_at = NOW(),
+        payload = payload - 'pickup_code'
+    WHERE id = event_id;
+
+    PERFORM pg_advisory_unlock(hashtext(event_id::text));
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+-- +goose StatementBegin
+UPDATE events
+SET payload = payload - 'pickup_code'
+WHERE sent_at IS NOT NULL
+  AND payload ? 'pickup_code';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION mark_event_as_sent(event_id UUID)
+    RETURNS VOID AS $$
+BEGIN
+    UPDATE events
+    SET sent_at = NOW()
+    WHERE id = event_id;
+
+    PERFORM pg_advisory_unlock(hashtext(event_id::text));
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
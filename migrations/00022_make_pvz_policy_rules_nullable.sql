@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The NULL rules of the PVZs are not set and the defaults of the service are used instead, so the administrator
+-- may set no weight limit with 0 and accept all the packaging types with the empty allowed_packaging
+ALTER TABLE pvz_policies
+    ALTER COLUMN max_order_weight DROP NOT NULL,
+    ALTER COLUMN max_order_weight DROP DEFAULT,
+    ALTER COLUMN allowed_packaging DROP NOT NULL,
+    ALTER COLUMN allowed_packaging DROP DEFAULT;
+
+UPDATE pvz_policies SET max_order_weight = NULL WHERE max_order_weight = 0;
+UPDATE pvz_policies SET allowed_packaging = NULL WHERE allowed_packaging = '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+UPDATE pvz_policies SET max_order_weight = 0 WHERE max_order_weight IS NULL;
+UPDATE pvz_policies SET allowed_packaging = '{}' WHERE allowed_packaging IS NULL;
+
+ALTER TABLE pvz_policies
+    ALTER COLUMN max_order_weight SET DEFAULT 0,
+    ALTER COLUMN max_order_weight SET NOT NULL,
+    ALTER COLUMN allowed_packaging SET DEFAULT '{}',
+    ALTER COLUMN allowed_packaging SET NOT NULL;
+-- +goose StatementEnd
@@ -0,0 +1,8 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+-- The history of an order includes the events of the lists, sessions and shipments with the order in order_ids
+CREATE INDEX CONCURRENTLY IF NOT EXISTS idx_events_order_ids ON events USING GIN ((payload->'order_ids'));
+
+-- +goose NO TRANSACTION
+-- +goose Down
+DROP INDEX CONCURRENTLY IF EXISTS idx_events_order_ids;
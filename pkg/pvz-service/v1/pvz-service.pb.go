@@ -10,10 +10,12 @@ import (
 	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	money "google.golang.org/genproto/googleapis/type/money"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -78,18 +80,411 @@ func (PackagingType) EnumDescriptor() ([]byte, []int) {
 	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{0}
 }
 
+type IssueAction int32
+
+const (
+	IssueAction_ISSUE_ACTION_UNKNOWN IssueAction = 0
+	IssueAction_ISSUE_ACTION_ISSUE   IssueAction = 1
+	IssueAction_ISSUE_ACTION_REFUSE  IssueAction = 2
+)
+
+// Enum value maps for IssueAction.
+var (
+	IssueAction_name = map[int32]string{
+		0: "ISSUE_ACTION_UNKNOWN",
+		1: "ISSUE_ACTION_ISSUE",
+		2: "ISSUE_ACTION_REFUSE",
+	}
+	IssueAction_value = map[string]int32{
+		"ISSUE_ACTION_UNKNOWN": 0,
+		"ISSUE_ACTION_ISSUE":   1,
+		"ISSUE_ACTION_REFUSE":  2,
+	}
+)
+
+func (x IssueAction) Enum() *IssueAction {
+	p := new(IssueAction)
+	*p = x
+	return p
+}
+
+func (x IssueAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (IssueAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_pvz_service_v1_pvz_service_proto_enumTypes[1].Descriptor()
+}
+
+func (IssueAction) Type() protoreflect.EnumType {
+	return &file_pvz_service_v1_pvz_service_proto_enumTypes[1]
+}
+
+func (x IssueAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use IssueAction.Descriptor instead.
+func (IssueAction) EnumDescriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{1}
+}
+
+type OrderStatus int32
+
+const (
+	OrderStatus_ORDER_STATUS_UNKNOWN              OrderStatus = 0
+	OrderStatus_ORDER_STATUS_ACCEPTED             OrderStatus = 1
+	OrderStatus_ORDER_STATUS_ISSUED               OrderStatus = 2
+	OrderStatus_ORDER_STATUS_RETURNED_BY_CLIENT   OrderStatus = 3
+	OrderStatus_ORDER_STATUS_RETURNED_TO_COURIER  OrderStatus = 4
+	OrderStatus_ORDER_STATUS_EXPIRED              OrderStatus = 5
+	OrderStatus_ORDER_STATUS_REFUSED              OrderStatus = 6
+	OrderStatus_ORDER_STATUS_DISPATCHED_TO_SELLER OrderStatus = 7
+)
+
+// Enum value maps for OrderStatus.
+var (
+	OrderStatus_name = map[int32]string{
+		0: "ORDER_STATUS_UNKNOWN",
+		1: "ORDER_STATUS_ACCEPTED",
+		2: "ORDER_STATUS_ISSUED",
+		3: "ORDER_STATUS_RETURNED_BY_CLIENT",
+		4: "ORDER_STATUS_RETURNED_TO_COURIER",
+		5: "ORDER_STATUS_EXPIRED",
+		6: "ORDER_STATUS_REFUSED",
+		7: "ORDER_STATUS_DISPATCHED_TO_SELLER",
+	}
+	OrderStatus_value = map[string]int32{
+		"ORDER_STATUS_UNKNOWN":              0,
+		"ORDER_STATUS_ACCEPTED":             1,
+		"ORDER_STATUS_ISSUED":               2,
+		"ORDER_STATUS_RETURNED_BY_CLIENT":   3,
+		"ORDER_STATUS_RETURNED_TO_COURIER":  4,
+		"ORDER_STATUS_EXPIRED":              5,
+		"ORDER_STATUS_REFUSED":              6,
+		"ORDER_STATUS_DISPATCHED_TO_SELLER": 7,
+	}
+)
+
+func (x OrderStatus) Enum() *OrderStatus {
+	p := new(OrderStatus)
+	*p = x
+	return p
+}
+
+func (x OrderStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OrderStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pvz_service_v1_pvz_service_proto_enumTypes[2].Descriptor()
+}
+
+func (OrderStatus) Type() protoreflect.EnumType {
+	return &file_pvz_service_v1_pvz_service_proto_enumTypes[2]
+}
+
+func (x OrderStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OrderStatus.Descriptor instead.
+func (OrderStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{2}
+}
+
+type ReturnReason int32
+
+const (
+	ReturnReason_RETURN_REASON_UNKNOWN          ReturnReason = 0
+	ReturnReason_RETURN_REASON_DEFECT           ReturnReason = 1
+	ReturnReason_RETURN_REASON_WRONG_ITEM       ReturnReason = 2
+	ReturnReason_RETURN_REASON_NOT_AS_DESCRIBED ReturnReason = 3
+	ReturnReason_RETURN_REASON_CHANGED_MIND     ReturnReason = 4
+	ReturnReason_RETURN_REASON_OTHER            ReturnReason = 5
+)
+
+// Enum value maps for ReturnReason.
+var (
+	ReturnReason_name = map[int32]string{
+		0: "RETURN_REASON_UNKNOWN",
+		1: "RETURN_REASON_DEFECT",
+		2: "RETURN_REASON_WRONG_ITEM",
+		3: "RETURN_REASON_NOT_AS_DESCRIBED",
+		4: "RETURN_REASON_CHANGED_MIND",
+		5: "RETURN_REASON_OTHER",
+	}
+	ReturnReason_value = map[string]int32{
+		"RETURN_REASON_UNKNOWN":          0,
+		"RETURN_REASON_DEFECT":           1,
+		"RETURN_REASON_WRONG_ITEM":       2,
+		"RETURN_REASON_NOT_AS_DESCRIBED": 3,
+		"RETURN_REASON_CHANGED_MIND":     4,
+		"RETURN_REASON_OTHER":            5,
+	}
+)
+
+func (x ReturnReason) Enum() *ReturnReason {
+	p := new(ReturnReason)
+	*p = x
+	return p
+}
+
+func (x ReturnReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReturnReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_pvz_service_v1_pvz_service_proto_enumTypes[3].Descriptor()
+}
+
+func (ReturnReason) Type() protoreflect.EnumType {
+	return &file_pvz_service_v1_pvz_service_proto_enumTypes[3]
+}
+
+func (x ReturnReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReturnReason.Descriptor instead.
+func (ReturnReason) EnumDescriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{3}
+}
+
+type InspectionOutcome int32
+
+const (
+	InspectionOutcome_INSPECTION_OUTCOME_UNKNOWN    InspectionOutcome = 0
+	InspectionOutcome_INSPECTION_OUTCOME_RESELLABLE InspectionOutcome = 1
+	InspectionOutcome_INSPECTION_OUTCOME_DAMAGED    InspectionOutcome = 2
+	InspectionOutcome_INSPECTION_OUTCOME_INCOMPLETE InspectionOutcome = 3
+)
+
+// Enum value maps for InspectionOutcome.
+var (
+	InspectionOutcome_name = map[int32]string{
+		0: "INSPECTION_OUTCOME_UNKNOWN",
+		1: "INSPECTION_OUTCOME_RESELLABLE",
+		2: "INSPECTION_OUTCOME_DAMAGED",
+		3: "INSPECTION_OUTCOME_INCOMPLETE",
+	}
+	InspectionOutcome_value = map[string]int32{
+		"INSPECTION_OUTCOME_UNKNOWN":    0,
+		"INSPECTION_OUTCOME_RESELLABLE": 1,
+		"INSPECTION_OUTCOME_DAMAGED":    2,
+		"INSPECTION_OUTCOME_INCOMPLETE": 3,
+	}
+)
+
+func (x InspectionOutcome) Enum() *InspectionOutcome {
+	p := new(InspectionOutcome)
+	*p = x
+	return p
+}
+
+func (x InspectionOutcome) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InspectionOutcome) Descriptor() protoreflect.EnumDescriptor {
+	return file_pvz_service_v1_pvz_service_proto_enumTypes[4].Descriptor()
+}
+
+func (InspectionOutcome) Type() protoreflect.EnumType {
+	return &file_pvz_service_v1_pvz_service_proto_enumTypes[4]
+}
+
+func (x InspectionOutcome) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InspectionOutcome.Descriptor instead.
+func (InspectionOutcome) EnumDescriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{4}
+}
+
+type HandoverSessionStatus int32
+
+const (
+	HandoverSessionStatus_HANDOVER_SESSION_STATUS_UNKNOWN HandoverSessionStatus = 0
+	HandoverSessionStatus_HANDOVER_SESSION_STATUS_OPEN    HandoverSessionStatus = 1
+	HandoverSessionStatus_HANDOVER_SESSION_STATUS_CLOSED  HandoverSessionStatus = 2
+)
+
+// Enum value maps for HandoverSessionStatus.
+var (
+	HandoverSessionStatus_name = map[int32]string{
+		0: "HANDOVER_SESSION_STATUS_UNKNOWN",
+		1: "HANDOVER_SESSION_STATUS_OPEN",
+		2: "HANDOVER_SESSION_STATUS_CLOSED",
+	}
+	HandoverSessionStatus_value = map[string]int32{
+		"HANDOVER_SESSION_STATUS_UNKNOWN": 0,
+		"HANDOVER_SESSION_STATUS_OPEN":    1,
+		"HANDOVER_SESSION_STATUS_CLOSED":  2,
+	}
+)
+
+func (x HandoverSessionStatus) Enum() *HandoverSessionStatus {
+	p := new(HandoverSessionStatus)
+	*p = x
+	return p
+}
+
+func (x HandoverSessionStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HandoverSessionStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pvz_service_v1_pvz_service_proto_enumTypes[5].Descriptor()
+}
+
+func (HandoverSessionStatus) Type() protoreflect.EnumType {
+	return &file_pvz_service_v1_pvz_service_proto_enumTypes[5]
+}
+
+func (x HandoverSessionStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HandoverSessionStatus.Descriptor instead.
+func (HandoverSessionStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{5}
+}
+
+type HandoverScanResult int32
+
+const (
+	HandoverScanResult_HANDOVER_SCAN_RESULT_UNKNOWN         HandoverScanResult = 0
+	HandoverScanResult_HANDOVER_SCAN_RESULT_MATCHED         HandoverScanResult = 1
+	HandoverScanResult_HANDOVER_SCAN_RESULT_UNEXPECTED      HandoverScanResult = 2
+	HandoverScanResult_HANDOVER_SCAN_RESULT_WEIGHT_MISMATCH HandoverScanResult = 3
+	HandoverScanResult_HANDOVER_SCAN_RESULT_DUPLICATE       HandoverScanResult = 4
+	HandoverScanResult_HANDOVER_SCAN_RESULT_REJECTED        HandoverScanResult = 5
+)
+
+// Enum value maps for HandoverScanResult.
+var (
+	HandoverScanResult_name = map[int32]string{
+		0: "HANDOVER_SCAN_RESULT_UNKNOWN",
+		1: "HANDOVER_SCAN_RESULT_MATCHED",
+		2: "HANDOVER_SCAN_RESULT_UNEXPECTED",
+		3: "HANDOVER_SCAN_RESULT_WEIGHT_MISMATCH",
+		4: "HANDOVER_SCAN_RESULT_DUPLICATE",
+		5: "HANDOVER_SCAN_RESULT_REJECTED",
+	}
+	HandoverScanResult_value = map[string]int32{
+		"HANDOVER_SCAN_RESULT_UNKNOWN":         0,
+		"HANDOVER_SCAN_RESULT_MATCHED":         1,
+		"HANDOVER_SCAN_RESULT_UNEXPECTED":      2,
+		"HANDOVER_SCAN_RESULT_WEIGHT_MISMATCH": 3,
+		"HANDOVER_SCAN_RESULT_DUPLICATE":       4,
+		"HANDOVER_SCAN_RESULT_REJECTED":        5,
+	}
+)
+
+func (x HandoverScanResult) Enum() *HandoverScanResult {
+	p := new(HandoverScanResult)
+	*p = x
+	return p
+}
+
+func (x HandoverScanResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (HandoverScanResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_pvz_service_v1_pvz_service_proto_enumTypes[6].Descriptor()
+}
+
+func (HandoverScanResult) Type() protoreflect.EnumType {
+	return &file_pvz_service_v1_pvz_service_proto_enumTypes[6]
+}
+
+func (x HandoverScanResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use HandoverScanResult.Descriptor instead.
+func (HandoverScanResult) EnumDescriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{6}
+}
+
+type ReturnShipmentStatus int32
+
+const (
+	ReturnShipmentStatus_RETURN_SHIPMENT_STATUS_UNKNOWN    ReturnShipmentStatus = 0
+	ReturnShipmentStatus_RETURN_SHIPMENT_STATUS_OPEN       ReturnShipmentStatus = 1
+	ReturnShipmentStatus_RETURN_SHIPMENT_STATUS_DISPATCHED ReturnShipmentStatus = 2
+)
+
+// Enum value maps for ReturnShipmentStatus.
+var (
+	ReturnShipmentStatus_name = map[int32]string{
+		0: "RETURN_SHIPMENT_STATUS_UNKNOWN",
+		1: "RETURN_SHIPMENT_STATUS_OPEN",
+		2: "RETURN_SHIPMENT_STATUS_DISPATCHED",
+	}
+	ReturnShipmentStatus_value = map[string]int32{
+		"RETURN_SHIPMENT_STATUS_UNKNOWN":    0,
+		"RETURN_SHIPMENT_STATUS_OPEN":       1,
+		"RETURN_SHIPMENT_STATUS_DISPATCHED": 2,
+	}
+)
+
+func (x ReturnShipmentStatus) Enum() *ReturnShipmentStatus {
+	p := new(ReturnShipmentStatus)
+	*p = x
+	return p
+}
+
+func (x ReturnShipmentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ReturnShipmentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pvz_service_v1_pvz_service_proto_enumTypes[7].Descriptor()
+}
+
+func (ReturnShipmentStatus) Type() protoreflect.EnumType {
+	return &file_pvz_service_v1_pvz_service_proto_enumTypes[7]
+}
+
+func (x ReturnShipmentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ReturnShipmentStatus.Descriptor instead.
+func (ReturnShipmentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{7}
+}
+
 type AcceptOrderDeliveryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderId        string               `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	RecipientId    string               `protobuf:"bytes,2,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
-	StorageTime    *durationpb.Duration `protobuf:"bytes,3,opt,name=storage_time,json=storageTime,proto3" json:"storage_time,omitempty"`
-	Cost           int32                `protobuf:"varint,4,opt,name=cost,proto3" json:"cost,omitempty"`
-	Weight         int32                `protobuf:"varint,5,opt,name=weight,proto3" json:"weight,omitempty"`
-	Packaging      PackagingType        `protobuf:"varint,6,opt,name=packaging,proto3,enum=pvz.v1.PackagingType" json:"packaging,omitempty"`
-	AdditionalFilm bool                 `protobuf:"varint,7,opt,name=additional_film,json=additionalFilm,proto3" json:"additional_film,omitempty"`
+	OrderId     string               `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	RecipientId string               `protobuf:"bytes,2,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	StorageTime *durationpb.Duration `protobuf:"bytes,3,opt,name=storage_time,json=storageTime,proto3" json:"storage_time,omitempty"`
+	// cost is kept for the clients which send roubles in kopecks, it is ignored if cost_money is set
+	Cost   int32 `protobuf:"varint,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	Weight int32 `protobuf:"varint,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	// packaging is kept for the clients which do not know packaging_code yet,
+	// it is ignored if packaging_code is set
+	Packaging      PackagingType `protobuf:"varint,6,opt,name=packaging,proto3,enum=pvz.v1.PackagingType" json:"packaging,omitempty"`
+	AdditionalFilm bool          `protobuf:"varint,7,opt,name=additional_film,json=additionalFilm,proto3" json:"additional_film,omitempty"`
+	// packaging_code is a code of the packaging type from the packaging catalog, e.g. "large_box"
+	PackagingCode string `protobuf:"bytes,8,opt,name=packaging_code,json=packagingCode,proto3" json:"packaging_code,omitempty"`
+	// dimensions of the parcel, only the weight is checked by the packaging if they are not set
+	Dimensions *Dimensions `protobuf:"bytes,9,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	// cost_money is the cost of the order in any supported currency
+	CostMoney *money.Money `protobuf:"bytes,10,opt,name=cost_money,json=costMoney,proto3" json:"cost_money,omitempty"`
+	// measured_weight is the weight of the parcel on the scales, 0 if it was not weighed
+	MeasuredWeight int32 `protobuf:"varint,11,opt,name=measured_weight,json=measuredWeight,proto3" json:"measured_weight,omitempty"`
+	// weight_override_reason is required to accept the parcel whose measured weight
+	// differs from the declared one by more than the tolerance of the PVZ
+	WeightOverrideReason string `protobuf:"bytes,12,opt,name=weight_override_reason,json=weightOverrideReason,proto3" json:"weight_override_reason,omitempty"`
 }
 
 func (x *AcceptOrderDeliveryRequest) Reset() {
@@ -171,28 +566,66 @@ func (x *AcceptOrderDeliveryRequest) GetAdditionalFilm() bool {
 	return false
 }
 
-type ReturnOrderDeliveryRequest struct {
+func (x *AcceptOrderDeliveryRequest) GetPackagingCode() string {
+	if x != nil {
+		return x.PackagingCode
+	}
+	return ""
+}
+
+func (x *AcceptOrderDeliveryRequest) GetDimensions() *Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *AcceptOrderDeliveryRequest) GetCostMoney() *money.Money {
+	if x != nil {
+		return x.CostMoney
+	}
+	return nil
+}
+
+func (x *AcceptOrderDeliveryRequest) GetMeasuredWeight() int32 {
+	if x != nil {
+		return x.MeasuredWeight
+	}
+	return 0
+}
+
+func (x *AcceptOrderDeliveryRequest) GetWeightOverrideReason() string {
+	if x != nil {
+		return x.WeightOverrideReason
+	}
+	return ""
+}
+
+// Dimensions are the sizes of the parcel in centimetres
+type Dimensions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Length int32 `protobuf:"varint,1,opt,name=length,proto3" json:"length,omitempty"`
+	Width  int32 `protobuf:"varint,2,opt,name=width,proto3" json:"width,omitempty"`
+	Height int32 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (x *ReturnOrderDeliveryRequest) Reset() {
-	*x = ReturnOrderDeliveryRequest{}
+func (x *Dimensions) Reset() {
+	*x = Dimensions{}
 	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReturnOrderDeliveryRequest) String() string {
+func (x *Dimensions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReturnOrderDeliveryRequest) ProtoMessage() {}
+func (*Dimensions) ProtoMessage() {}
 
-func (x *ReturnOrderDeliveryRequest) ProtoReflect() protoreflect.Message {
+func (x *Dimensions) ProtoReflect() protoreflect.Message {
 	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -204,40 +637,56 @@ func (x *ReturnOrderDeliveryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReturnOrderDeliveryRequest.ProtoReflect.Descriptor instead.
-func (*ReturnOrderDeliveryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Dimensions.ProtoReflect.Descriptor instead.
+func (*Dimensions) Descriptor() ([]byte, []int) {
 	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ReturnOrderDeliveryRequest) GetOrderId() string {
+func (x *Dimensions) GetLength() int32 {
 	if x != nil {
-		return x.OrderId
+		return x.Length
 	}
-	return ""
+	return 0
 }
 
-type GiveOrderToClientRequest struct {
+func (x *Dimensions) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *Dimensions) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type BatchAcceptOrderDeliveryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderIds []string `protobuf:"bytes,1,rep,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	// items are the parcels the courier hands over, every item is validated on its own,
+	// so an invalid one is reported in the results and does not fail the others
+	Items []*AcceptOrderDeliveryRequest `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 }
 
-func (x *GiveOrderToClientRequest) Reset() {
-	*x = GiveOrderToClientRequest{}
+func (x *BatchAcceptOrderDeliveryRequest) Reset() {
+	*x = BatchAcceptOrderDeliveryRequest{}
 	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GiveOrderToClientRequest) String() string {
+func (x *BatchAcceptOrderDeliveryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GiveOrderToClientRequest) ProtoMessage() {}
+func (*BatchAcceptOrderDeliveryRequest) ProtoMessage() {}
 
-func (x *GiveOrderToClientRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchAcceptOrderDeliveryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -249,44 +698,41 @@ func (x *GiveOrderToClientRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GiveOrderToClientRequest.ProtoReflect.Descriptor instead.
-func (*GiveOrderToClientRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchAcceptOrderDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*BatchAcceptOrderDeliveryRequest) Descriptor() ([]byte, []int) {
 	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GiveOrderToClientRequest) GetOrderIds() []string {
+func (x *BatchAcceptOrderDeliveryRequest) GetItems() []*AcceptOrderDeliveryRequest {
 	if x != nil {
-		return x.OrderIds
+		return x.Items
 	}
 	return nil
 }
 
-type GetOrdersRequest struct {
+type BatchAcceptOrderDeliveryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId  string  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	LastN   *int32  `protobuf:"varint,2,opt,name=lastN,proto3,oneof" json:"lastN,omitempty"`
-	SamePVZ *bool   `protobuf:"varint,3,opt,name=samePVZ,proto3,oneof" json:"samePVZ,omitempty"`
-	Cursor  *string `protobuf:"bytes,4,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
-	Limit   *int32  `protobuf:"varint,5,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	// results are in the order of the items of the request
+	Results []*AcceptOrderDeliveryResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *GetOrdersRequest) Reset() {
-	*x = GetOrdersRequest{}
+func (x *BatchAcceptOrderDeliveryResponse) Reset() {
+	*x = BatchAcceptOrderDeliveryResponse{}
 	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrdersRequest) String() string {
+func (x *BatchAcceptOrderDeliveryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrdersRequest) ProtoMessage() {}
+func (*BatchAcceptOrderDeliveryResponse) ProtoMessage() {}
 
-func (x *GetOrdersRequest) ProtoReflect() protoreflect.Message {
+func (x *BatchAcceptOrderDeliveryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -298,69 +744,2770 @@ func (x *GetOrdersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrdersRequest.ProtoReflect.Descriptor instead.
-func (*GetOrdersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchAcceptOrderDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*BatchAcceptOrderDeliveryResponse) Descriptor() ([]byte, []int) {
 	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetOrdersRequest) GetUserId() string {
+func (x *BatchAcceptOrderDeliveryResponse) GetResults() []*AcceptOrderDeliveryResult {
 	if x != nil {
-		return x.UserId
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-func (x *GetOrdersRequest) GetLastN() int32 {
-	if x != nil && x.LastN != nil {
-		return *x.LastN
+type AcceptOrderDeliveryResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId string  `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Success bool    `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Error   *string `protobuf:"bytes,3,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	// order is the accepted order, it is set on success
+	Order *PVZOrder `protobuf:"bytes,4,opt,name=order,proto3" json:"order,omitempty"`
+}
+
+func (x *AcceptOrderDeliveryResult) Reset() {
+	*x = AcceptOrderDeliveryResult{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptOrderDeliveryResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptOrderDeliveryResult) ProtoMessage() {}
+
+func (x *AcceptOrderDeliveryResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetOrdersRequest) GetSamePVZ() bool {
-	if x != nil && x.SamePVZ != nil {
-		return *x.SamePVZ
+// Deprecated: Use AcceptOrderDeliveryResult.ProtoReflect.Descriptor instead.
+func (*AcceptOrderDeliveryResult) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AcceptOrderDeliveryResult) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AcceptOrderDeliveryResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
 	}
 	return false
 }
 
-func (x *GetOrdersRequest) GetCursor() string {
-	if x != nil && x.Cursor != nil {
-		return *x.Cursor
+func (x *AcceptOrderDeliveryResult) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
 	}
 	return ""
 }
 
-func (x *GetOrdersRequest) GetLimit() int32 {
-	if x != nil && x.Limit != nil {
-		return *x.Limit
+func (x *AcceptOrderDeliveryResult) GetOrder() *PVZOrder {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type ReturnOrderDeliveryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// expected_version is the version of the order the operator has seen,
+	// the request fails with ABORTED if the order has been changed since then
+	ExpectedVersion *int64 `protobuf:"varint,2,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+}
+
+func (x *ReturnOrderDeliveryRequest) Reset() {
+	*x = ReturnOrderDeliveryRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnOrderDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnOrderDeliveryRequest) ProtoMessage() {}
+
+func (x *ReturnOrderDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReturnOrderDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*ReturnOrderDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReturnOrderDeliveryRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ReturnOrderDeliveryRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
 	}
 	return 0
 }
 
-type GetOrdersResponse struct {
+type GiveOrderToClientRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Orders []*PVZOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	// order_ids are the orders to be issued to the client as is
+	OrderIds []string `protobuf:"bytes,1,rep,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	// decisions are the per-order decisions of the client (issue or refuse)
+	Decisions []*IssueDecision `protobuf:"bytes,2,rep,name=decisions,proto3" json:"decisions,omitempty"`
 }
 
-func (x *GetOrdersResponse) Reset() {
-	*x = GetOrdersResponse{}
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[4]
+func (x *GiveOrderToClientRequest) Reset() {
+	*x = GiveOrderToClientRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrdersResponse) String() string {
+func (x *GiveOrderToClientRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrdersResponse) ProtoMessage() {}
+func (*GiveOrderToClientRequest) ProtoMessage() {}
+
+func (x *GiveOrderToClientRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiveOrderToClientRequest.ProtoReflect.Descriptor instead.
+func (*GiveOrderToClientRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GiveOrderToClientRequest) GetOrderIds() []string {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+func (x *GiveOrderToClientRequest) GetDecisions() []*IssueDecision {
+	if x != nil {
+		return x.Decisions
+	}
+	return nil
+}
+
+type IssueDecision struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId       string      `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Action        IssueAction `protobuf:"varint,2,opt,name=action,proto3,enum=pvz.v1.IssueAction" json:"action,omitempty"`
+	RefusalReason *string     `protobuf:"bytes,3,opt,name=refusal_reason,json=refusalReason,proto3,oneof" json:"refusal_reason,omitempty"`
+	// expected_version is the version of the order the operator has seen,
+	// the decision fails if the order has been changed since then
+	ExpectedVersion *int64 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	// pickup_code is the one-time code the recipient has received when the order was accepted.
+	// The pickup is locked for a while after too many wrong codes
+	PickupCode *string `protobuf:"bytes,5,opt,name=pickup_code,json=pickupCode,proto3,oneof" json:"pickup_code,omitempty"`
+	// storage_fee_paid is the storage fee the client pays for the paid storage of the order,
+	// it must be equal to the storage_fee of the order
+	StorageFeePaid *money.Money `protobuf:"bytes,6,opt,name=storage_fee_paid,json=storageFeePaid,proto3" json:"storage_fee_paid,omitempty"`
+}
+
+func (x *IssueDecision) Reset() {
+	*x = IssueDecision{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueDecision) ProtoMessage() {}
+
+func (x *IssueDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueDecision.ProtoReflect.Descriptor instead.
+func (*IssueDecision) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *IssueDecision) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *IssueDecision) GetAction() IssueAction {
+	if x != nil {
+		return x.Action
+	}
+	return IssueAction_ISSUE_ACTION_UNKNOWN
+}
+
+func (x *IssueDecision) GetRefusalReason() string {
+	if x != nil && x.RefusalReason != nil {
+		return *x.RefusalReason
+	}
+	return ""
+}
+
+func (x *IssueDecision) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *IssueDecision) GetPickupCode() string {
+	if x != nil && x.PickupCode != nil {
+		return *x.PickupCode
+	}
+	return ""
+}
+
+func (x *IssueDecision) GetStorageFeePaid() *money.Money {
+	if x != nil {
+		return x.StorageFeePaid
+	}
+	return nil
+}
+
+type GiveOrderToClientResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Results []*IssueResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *GiveOrderToClientResponse) Reset() {
+	*x = GiveOrderToClientResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GiveOrderToClientResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GiveOrderToClientResponse) ProtoMessage() {}
+
+func (x *GiveOrderToClientResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GiveOrderToClientResponse.ProtoReflect.Descriptor instead.
+func (*GiveOrderToClientResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GiveOrderToClientResponse) GetResults() []*IssueResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type IssueResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId string      `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Action  IssueAction `protobuf:"varint,2,opt,name=action,proto3,enum=pvz.v1.IssueAction" json:"action,omitempty"`
+	Success bool        `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Error   *string     `protobuf:"bytes,4,opt,name=error,proto3,oneof" json:"error,omitempty"`
+}
+
+func (x *IssueResult) Reset() {
+	*x = IssueResult{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IssueResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IssueResult) ProtoMessage() {}
+
+func (x *IssueResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IssueResult.ProtoReflect.Descriptor instead.
+func (*IssueResult) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *IssueResult) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *IssueResult) GetAction() IssueAction {
+	if x != nil {
+		return x.Action
+	}
+	return IssueAction_ISSUE_ACTION_UNKNOWN
+}
+
+func (x *IssueResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *IssueResult) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+type GetOrdersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId   string        `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	LastN    *int32        `protobuf:"varint,2,opt,name=lastN,proto3,oneof" json:"lastN,omitempty"`
+	SamePVZ  *bool         `protobuf:"varint,3,opt,name=samePVZ,proto3,oneof" json:"samePVZ,omitempty"`
+	Cursor   *string       `protobuf:"bytes,4,opt,name=cursor,proto3,oneof" json:"cursor,omitempty"`
+	Limit    *int32        `protobuf:"varint,5,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Statuses []OrderStatus `protobuf:"varint,6,rep,packed,name=statuses,proto3,enum=pvz.v1.OrderStatus" json:"statuses,omitempty"`
+	// weight_discrepancy limits the orders to the ones accepted with the weight discrepancy
+	WeightDiscrepancy *bool `protobuf:"varint,7,opt,name=weight_discrepancy,json=weightDiscrepancy,proto3,oneof" json:"weight_discrepancy,omitempty"`
+}
+
+func (x *GetOrdersRequest) Reset() {
+	*x = GetOrdersRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrdersRequest) ProtoMessage() {}
+
+func (x *GetOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrdersRequest.ProtoReflect.Descriptor instead.
+func (*GetOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetOrdersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetOrdersRequest) GetLastN() int32 {
+	if x != nil && x.LastN != nil {
+		return *x.LastN
+	}
+	return 0
+}
+
+func (x *GetOrdersRequest) GetSamePVZ() bool {
+	if x != nil && x.SamePVZ != nil {
+		return *x.SamePVZ
+	}
+	return false
+}
+
+func (x *GetOrdersRequest) GetCursor() string {
+	if x != nil && x.Cursor != nil {
+		return *x.Cursor
+	}
+	return ""
+}
+
+func (x *GetOrdersRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *GetOrdersRequest) GetStatuses() []OrderStatus {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *GetOrdersRequest) GetWeightDiscrepancy() bool {
+	if x != nil && x.WeightDiscrepancy != nil {
+		return *x.WeightDiscrepancy
+	}
+	return false
+}
+
+type GetOrdersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Orders []*PVZOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+}
+
+func (x *GetOrdersResponse) Reset() {
+	*x = GetOrdersResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrdersResponse) ProtoMessage() {}
+
+func (x *GetOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrdersResponse.ProtoReflect.Descriptor instead.
+func (*GetOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetOrdersResponse) GetOrders() []*PVZOrder {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type AcceptReturnRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// expected_version is the version of the order the operator has seen,
+	// the request fails with ABORTED if the order has been changed since then
+	ExpectedVersion *int64       `protobuf:"varint,3,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	Reason          ReturnReason `protobuf:"varint,4,opt,name=reason,proto3,enum=pvz.v1.ReturnReason" json:"reason,omitempty"`
+	// inspection is the state of the order the operator found on the inspection
+	Inspection InspectionOutcome `protobuf:"varint,5,opt,name=inspection,proto3,enum=pvz.v1.InspectionOutcome" json:"inspection,omitempty"`
+	// comment is required for the RETURN_REASON_OTHER reason
+	Comment string `protobuf:"bytes,6,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *AcceptReturnRequest) Reset() {
+	*x = AcceptReturnRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptReturnRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptReturnRequest) ProtoMessage() {}
+
+func (x *AcceptReturnRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptReturnRequest.ProtoReflect.Descriptor instead.
+func (*AcceptReturnRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AcceptReturnRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AcceptReturnRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *AcceptReturnRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *AcceptReturnRequest) GetReason() ReturnReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ReturnReason_RETURN_REASON_UNKNOWN
+}
+
+func (x *AcceptReturnRequest) GetInspection() InspectionOutcome {
+	if x != nil {
+		return x.Inspection
+	}
+	return InspectionOutcome_INSPECTION_OUTCOME_UNKNOWN
+}
+
+func (x *AcceptReturnRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type GetReturnsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Page     *int32 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
+	PageSize *int32 `protobuf:"varint,2,opt,name=pageSize,proto3,oneof" json:"pageSize,omitempty"`
+	// reasons limits the returns to the ones with the given reasons, all returns are listed if it is empty
+	Reasons []ReturnReason `protobuf:"varint,3,rep,packed,name=reasons,proto3,enum=pvz.v1.ReturnReason" json:"reasons,omitempty"`
+	// inspections limits the returns to the ones with the given inspection outcomes
+	Inspections []InspectionOutcome `protobuf:"varint,4,rep,packed,name=inspections,proto3,enum=pvz.v1.InspectionOutcome" json:"inspections,omitempty"`
+}
+
+func (x *GetReturnsRequest) Reset() {
+	*x = GetReturnsRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReturnsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReturnsRequest) ProtoMessage() {}
+
+func (x *GetReturnsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReturnsRequest.ProtoReflect.Descriptor instead.
+func (*GetReturnsRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetReturnsRequest) GetPage() int32 {
+	if x != nil && x.Page != nil {
+		return *x.Page
+	}
+	return 0
+}
+
+func (x *GetReturnsRequest) GetPageSize() int32 {
+	if x != nil && x.PageSize != nil {
+		return *x.PageSize
+	}
+	return 0
+}
+
+func (x *GetReturnsRequest) GetReasons() []ReturnReason {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *GetReturnsRequest) GetInspections() []InspectionOutcome {
+	if x != nil {
+		return x.Inspections
+	}
+	return nil
+}
+
+type GetReturnsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Returns []*PVZOrder `protobuf:"bytes,1,rep,name=returns,proto3" json:"returns,omitempty"`
+}
+
+func (x *GetReturnsResponse) Reset() {
+	*x = GetReturnsResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReturnsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReturnsResponse) ProtoMessage() {}
+
+func (x *GetReturnsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReturnsResponse.ProtoReflect.Descriptor instead.
+func (*GetReturnsResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetReturnsResponse) GetReturns() []*PVZOrder {
+	if x != nil {
+		return x.Returns
+	}
+	return nil
+}
+
+type GetOrderHistoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (x *GetOrderHistoryRequest) Reset() {
+	*x = GetOrderHistoryRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderHistoryRequest) ProtoMessage() {}
+
+func (x *GetOrderHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetOrderHistoryRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type GetOrderHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*OrderEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *GetOrderHistoryResponse) Reset() {
+	*x = GetOrderHistoryResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderHistoryResponse) ProtoMessage() {}
+
+func (x *GetOrderHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetOrderHistoryResponse) GetEvents() []*OrderEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+type OrderEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventType string                 `protobuf:"bytes,2,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	Payload   *structpb.Struct       `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	SentAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=sent_at,json=sentAt,proto3,oneof" json:"sent_at,omitempty"`
+}
+
+func (x *OrderEvent) Reset() {
+	*x = OrderEvent{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderEvent) ProtoMessage() {}
+
+func (x *OrderEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderEvent.ProtoReflect.Descriptor instead.
+func (*OrderEvent) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *OrderEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *OrderEvent) GetPayload() *structpb.Struct {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *OrderEvent) GetSentAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentAt
+	}
+	return nil
+}
+
+type ExtendStorageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// extension is added to the storage time of the order,
+	// the total storage time can not exceed the maximum of the PVZ
+	Extension *durationpb.Duration `protobuf:"bytes,2,opt,name=extension,proto3" json:"extension,omitempty"`
+	// extended_by is the operator who extends the storage time
+	ExtendedBy string `protobuf:"bytes,3,opt,name=extended_by,json=extendedBy,proto3" json:"extended_by,omitempty"`
+	// expected_version is the version of the order the operator has seen,
+	// the request fails with ABORTED if the order has been changed since then
+	ExpectedVersion *int64 `protobuf:"varint,4,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+}
+
+func (x *ExtendStorageRequest) Reset() {
+	*x = ExtendStorageRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendStorageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendStorageRequest) ProtoMessage() {}
+
+func (x *ExtendStorageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendStorageRequest.ProtoReflect.Descriptor instead.
+func (*ExtendStorageRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExtendStorageRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ExtendStorageRequest) GetExtension() *durationpb.Duration {
+	if x != nil {
+		return x.Extension
+	}
+	return nil
+}
+
+func (x *ExtendStorageRequest) GetExtendedBy() string {
+	if x != nil {
+		return x.ExtendedBy
+	}
+	return ""
+}
+
+func (x *ExtendStorageRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+type AcceptOrderDeliveryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// order is the accepted order, its cost includes the packaging fees
+	Order *PVZOrder `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+}
+
+func (x *AcceptOrderDeliveryResponse) Reset() {
+	*x = AcceptOrderDeliveryResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptOrderDeliveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptOrderDeliveryResponse) ProtoMessage() {}
+
+func (x *AcceptOrderDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptOrderDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*AcceptOrderDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AcceptOrderDeliveryResponse) GetOrder() *PVZOrder {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
+type GetWeightDiscrepancyReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// date is a day in the time zone of the PVZ formatted as YYYY-MM-DD, today if it is empty
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+}
+
+func (x *GetWeightDiscrepancyReportRequest) Reset() {
+	*x = GetWeightDiscrepancyReportRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWeightDiscrepancyReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWeightDiscrepancyReportRequest) ProtoMessage() {}
+
+func (x *GetWeightDiscrepancyReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWeightDiscrepancyReportRequest.ProtoReflect.Descriptor instead.
+func (*GetWeightDiscrepancyReportRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetWeightDiscrepancyReportRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type GetWeightDiscrepancyReportResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// orders are accepted with the weight discrepancy on the date, in the order of acceptance
+	Orders []*PVZOrder `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders,omitempty"`
+}
+
+func (x *GetWeightDiscrepancyReportResponse) Reset() {
+	*x = GetWeightDiscrepancyReportResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWeightDiscrepancyReportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWeightDiscrepancyReportResponse) ProtoMessage() {}
+
+func (x *GetWeightDiscrepancyReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWeightDiscrepancyReportResponse.ProtoReflect.Descriptor instead.
+func (*GetWeightDiscrepancyReportResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetWeightDiscrepancyReportResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetWeightDiscrepancyReportResponse) GetOrders() []*PVZOrder {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+type QuotePackagingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// cost is kept for the clients which send roubles in kopecks, it is ignored if cost_money is set
+	Cost       int32        `protobuf:"varint,1,opt,name=cost,proto3" json:"cost,omitempty"`
+	Weight     int32        `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	Dimensions *Dimensions  `protobuf:"bytes,3,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	CostMoney  *money.Money `protobuf:"bytes,4,opt,name=cost_money,json=costMoney,proto3" json:"cost_money,omitempty"`
+}
+
+func (x *QuotePackagingRequest) Reset() {
+	*x = QuotePackagingRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotePackagingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotePackagingRequest) ProtoMessage() {}
+
+func (x *QuotePackagingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotePackagingRequest.ProtoReflect.Descriptor instead.
+func (*QuotePackagingRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *QuotePackagingRequest) GetCost() int32 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *QuotePackagingRequest) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *QuotePackagingRequest) GetDimensions() *Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *QuotePackagingRequest) GetCostMoney() *money.Money {
+	if x != nil {
+		return x.CostMoney
+	}
+	return nil
+}
+
+type QuotePackagingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// quotes are the packaging options which are valid for the order, in the order of the packaging catalog
+	Quotes []*PackagingQuote `protobuf:"bytes,1,rep,name=quotes,proto3" json:"quotes,omitempty"`
+}
+
+func (x *QuotePackagingResponse) Reset() {
+	*x = QuotePackagingResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuotePackagingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotePackagingResponse) ProtoMessage() {}
+
+func (x *QuotePackagingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotePackagingResponse.ProtoReflect.Descriptor instead.
+func (*QuotePackagingResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *QuotePackagingResponse) GetQuotes() []*PackagingQuote {
+	if x != nil {
+		return x.Quotes
+	}
+	return nil
+}
+
+type PackagingQuote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PackagingCode string `protobuf:"bytes,1,opt,name=packaging_code,json=packagingCode,proto3" json:"packaging_code,omitempty"`
+	// price is the cost of the order with the packaging in kopecks, 0 if it does not fit or is not in roubles
+	Price    int32 `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	Cheapest bool  `protobuf:"varint,3,opt,name=cheapest,proto3" json:"cheapest,omitempty"`
+	// price_money is the cost of the order with the packaging
+	PriceMoney *money.Money `protobuf:"bytes,4,opt,name=price_money,json=priceMoney,proto3" json:"price_money,omitempty"`
+}
+
+func (x *PackagingQuote) Reset() {
+	*x = PackagingQuote{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PackagingQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackagingQuote) ProtoMessage() {}
+
+func (x *PackagingQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackagingQuote.ProtoReflect.Descriptor instead.
+func (*PackagingQuote) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *PackagingQuote) GetPackagingCode() string {
+	if x != nil {
+		return x.PackagingCode
+	}
+	return ""
+}
+
+func (x *PackagingQuote) GetPrice() int32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PackagingQuote) GetCheapest() bool {
+	if x != nil {
+		return x.Cheapest
+	}
+	return false
+}
+
+func (x *PackagingQuote) GetPriceMoney() *money.Money {
+	if x != nil {
+		return x.PriceMoney
+	}
+	return nil
+}
+
+type OpenHandoverSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourierId string `protobuf:"bytes,1,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	// expected is the manifest of the courier, the parcels are accepted as they are scanned
+	Expected []*AcceptOrderDeliveryRequest `protobuf:"bytes,2,rep,name=expected,proto3" json:"expected,omitempty"`
+}
+
+func (x *OpenHandoverSessionRequest) Reset() {
+	*x = OpenHandoverSessionRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenHandoverSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenHandoverSessionRequest) ProtoMessage() {}
+
+func (x *OpenHandoverSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenHandoverSessionRequest.ProtoReflect.Descriptor instead.
+func (*OpenHandoverSessionRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *OpenHandoverSessionRequest) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *OpenHandoverSessionRequest) GetExpected() []*AcceptOrderDeliveryRequest {
+	if x != nil {
+		return x.Expected
+	}
+	return nil
+}
+
+type OpenHandoverSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Session *HandoverSession `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+}
+
+func (x *OpenHandoverSessionResponse) Reset() {
+	*x = OpenHandoverSessionResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OpenHandoverSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenHandoverSessionResponse) ProtoMessage() {}
+
+func (x *OpenHandoverSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenHandoverSessionResponse.ProtoReflect.Descriptor instead.
+func (*OpenHandoverSessionResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *OpenHandoverSessionResponse) GetSession() *HandoverSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type ScanHandoverParcelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	OrderId   string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// measured_weight is the weight of the parcel on the scales, the weight is not checked if it is 0
+	MeasuredWeight int32 `protobuf:"varint,3,opt,name=measured_weight,json=measuredWeight,proto3" json:"measured_weight,omitempty"`
+}
+
+func (x *ScanHandoverParcelRequest) Reset() {
+	*x = ScanHandoverParcelRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanHandoverParcelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanHandoverParcelRequest) ProtoMessage() {}
+
+func (x *ScanHandoverParcelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanHandoverParcelRequest.ProtoReflect.Descriptor instead.
+func (*ScanHandoverParcelRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ScanHandoverParcelRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *ScanHandoverParcelRequest) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *ScanHandoverParcelRequest) GetMeasuredWeight() int32 {
+	if x != nil {
+		return x.MeasuredWeight
+	}
+	return 0
+}
+
+type ScanHandoverParcelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Scan *HandoverScan `protobuf:"bytes,1,opt,name=scan,proto3" json:"scan,omitempty"`
+}
+
+func (x *ScanHandoverParcelResponse) Reset() {
+	*x = ScanHandoverParcelResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScanHandoverParcelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScanHandoverParcelResponse) ProtoMessage() {}
+
+func (x *ScanHandoverParcelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScanHandoverParcelResponse.ProtoReflect.Descriptor instead.
+func (*ScanHandoverParcelResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ScanHandoverParcelResponse) GetScan() *HandoverScan {
+	if x != nil {
+		return x.Scan
+	}
+	return nil
+}
+
+type CloseHandoverSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *CloseHandoverSessionRequest) Reset() {
+	*x = CloseHandoverSessionRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseHandoverSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseHandoverSessionRequest) ProtoMessage() {}
+
+func (x *CloseHandoverSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseHandoverSessionRequest.ProtoReflect.Descriptor instead.
+func (*CloseHandoverSessionRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CloseHandoverSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type CloseHandoverSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Report *HandoverReport `protobuf:"bytes,1,opt,name=report,proto3" json:"report,omitempty"`
+}
+
+func (x *CloseHandoverSessionResponse) Reset() {
+	*x = CloseHandoverSessionResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseHandoverSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseHandoverSessionResponse) ProtoMessage() {}
+
+func (x *CloseHandoverSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseHandoverSessionResponse.ProtoReflect.Descriptor instead.
+func (*CloseHandoverSessionResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CloseHandoverSessionResponse) GetReport() *HandoverReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type GetHandoverSessionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+}
+
+func (x *GetHandoverSessionRequest) Reset() {
+	*x = GetHandoverSessionRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHandoverSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHandoverSessionRequest) ProtoMessage() {}
+
+func (x *GetHandoverSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHandoverSessionRequest.ProtoReflect.Descriptor instead.
+func (*GetHandoverSessionRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetHandoverSessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type GetHandoverSessionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Session *HandoverSession `protobuf:"bytes,1,opt,name=session,proto3" json:"session,omitempty"`
+}
+
+func (x *GetHandoverSessionResponse) Reset() {
+	*x = GetHandoverSessionResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHandoverSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHandoverSessionResponse) ProtoMessage() {}
+
+func (x *GetHandoverSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHandoverSessionResponse.ProtoReflect.Descriptor instead.
+func (*GetHandoverSessionResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetHandoverSessionResponse) GetSession() *HandoverSession {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+type HandoverSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id               string                `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PvzId            string                `protobuf:"bytes,2,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	CourierId        string                `protobuf:"bytes,3,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	Status           HandoverSessionStatus `protobuf:"varint,4,opt,name=status,proto3,enum=pvz.v1.HandoverSessionStatus" json:"status,omitempty"`
+	ExpectedOrderIds []string              `protobuf:"bytes,5,rep,name=expected_order_ids,json=expectedOrderIds,proto3" json:"expected_order_ids,omitempty"`
+	// scans are in the order they were made
+	Scans    []*HandoverScan        `protobuf:"bytes,6,rep,name=scans,proto3" json:"scans,omitempty"`
+	OpenedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"`
+	ClosedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=closed_at,json=closedAt,proto3,oneof" json:"closed_at,omitempty"`
+	// report is set when the session is closed
+	Report *HandoverReport `protobuf:"bytes,9,opt,name=report,proto3" json:"report,omitempty"`
+}
+
+func (x *HandoverSession) Reset() {
+	*x = HandoverSession{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandoverSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandoverSession) ProtoMessage() {}
+
+func (x *HandoverSession) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandoverSession.ProtoReflect.Descriptor instead.
+func (*HandoverSession) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *HandoverSession) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *HandoverSession) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *HandoverSession) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *HandoverSession) GetStatus() HandoverSessionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return HandoverSessionStatus_HANDOVER_SESSION_STATUS_UNKNOWN
+}
+
+func (x *HandoverSession) GetExpectedOrderIds() []string {
+	if x != nil {
+		return x.ExpectedOrderIds
+	}
+	return nil
+}
+
+func (x *HandoverSession) GetScans() []*HandoverScan {
+	if x != nil {
+		return x.Scans
+	}
+	return nil
+}
+
+func (x *HandoverSession) GetOpenedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OpenedAt
+	}
+	return nil
+}
+
+func (x *HandoverSession) GetClosedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ClosedAt
+	}
+	return nil
+}
+
+func (x *HandoverSession) GetReport() *HandoverReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+type HandoverScan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId        string             `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	MeasuredWeight int32              `protobuf:"varint,2,opt,name=measured_weight,json=measuredWeight,proto3" json:"measured_weight,omitempty"`
+	Result         HandoverScanResult `protobuf:"varint,3,opt,name=result,proto3,enum=pvz.v1.HandoverScanResult" json:"result,omitempty"`
+	// reason explains why the parcel was not accepted
+	Reason    string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	ScannedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=scanned_at,json=scannedAt,proto3" json:"scanned_at,omitempty"`
+}
+
+func (x *HandoverScan) Reset() {
+	*x = HandoverScan{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandoverScan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandoverScan) ProtoMessage() {}
+
+func (x *HandoverScan) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandoverScan.ProtoReflect.Descriptor instead.
+func (*HandoverScan) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *HandoverScan) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *HandoverScan) GetMeasuredWeight() int32 {
+	if x != nil {
+		return x.MeasuredWeight
+	}
+	return 0
+}
+
+func (x *HandoverScan) GetResult() HandoverScanResult {
+	if x != nil {
+		return x.Result
+	}
+	return HandoverScanResult_HANDOVER_SCAN_RESULT_UNKNOWN
+}
+
+func (x *HandoverScan) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *HandoverScan) GetScannedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScannedAt
+	}
+	return nil
+}
+
+type HandoverReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SessionId string   `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3" json:"session_id,omitempty"`
+	Accepted  []string `protobuf:"bytes,2,rep,name=accepted,proto3" json:"accepted,omitempty"`
+	// missing are the parcels of the manifest which were not scanned
+	Missing []string `protobuf:"bytes,3,rep,name=missing,proto3" json:"missing,omitempty"`
+	// unexpected are the scanned parcels which are not in the manifest
+	Unexpected       []string                  `protobuf:"bytes,4,rep,name=unexpected,proto3" json:"unexpected,omitempty"`
+	WeightMismatches []*HandoverWeightMismatch `protobuf:"bytes,5,rep,name=weight_mismatches,json=weightMismatches,proto3" json:"weight_mismatches,omitempty"`
+	// rejected are the parcels of the manifest which were scanned, but could not be accepted
+	Rejected []*HandoverRejection `protobuf:"bytes,6,rep,name=rejected,proto3" json:"rejected,omitempty"`
+}
+
+func (x *HandoverReport) Reset() {
+	*x = HandoverReport{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandoverReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandoverReport) ProtoMessage() {}
+
+func (x *HandoverReport) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandoverReport.ProtoReflect.Descriptor instead.
+func (*HandoverReport) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *HandoverReport) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+func (x *HandoverReport) GetAccepted() []string {
+	if x != nil {
+		return x.Accepted
+	}
+	return nil
+}
+
+func (x *HandoverReport) GetMissing() []string {
+	if x != nil {
+		return x.Missing
+	}
+	return nil
+}
+
+func (x *HandoverReport) GetUnexpected() []string {
+	if x != nil {
+		return x.Unexpected
+	}
+	return nil
+}
+
+func (x *HandoverReport) GetWeightMismatches() []*HandoverWeightMismatch {
+	if x != nil {
+		return x.WeightMismatches
+	}
+	return nil
+}
+
+func (x *HandoverReport) GetRejected() []*HandoverRejection {
+	if x != nil {
+		return x.Rejected
+	}
+	return nil
+}
+
+type HandoverWeightMismatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId        string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	DeclaredWeight int32  `protobuf:"varint,2,opt,name=declared_weight,json=declaredWeight,proto3" json:"declared_weight,omitempty"`
+	MeasuredWeight int32  `protobuf:"varint,3,opt,name=measured_weight,json=measuredWeight,proto3" json:"measured_weight,omitempty"`
+}
+
+func (x *HandoverWeightMismatch) Reset() {
+	*x = HandoverWeightMismatch{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandoverWeightMismatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandoverWeightMismatch) ProtoMessage() {}
+
+func (x *HandoverWeightMismatch) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandoverWeightMismatch.ProtoReflect.Descriptor instead.
+func (*HandoverWeightMismatch) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *HandoverWeightMismatch) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *HandoverWeightMismatch) GetDeclaredWeight() int32 {
+	if x != nil {
+		return x.DeclaredWeight
+	}
+	return 0
+}
+
+func (x *HandoverWeightMismatch) GetMeasuredWeight() int32 {
+	if x != nil {
+		return x.MeasuredWeight
+	}
+	return 0
+}
+
+type HandoverRejection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *HandoverRejection) Reset() {
+	*x = HandoverRejection{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandoverRejection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandoverRejection) ProtoMessage() {}
+
+func (x *HandoverRejection) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandoverRejection.ProtoReflect.Descriptor instead.
+func (*HandoverRejection) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *HandoverRejection) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *HandoverRejection) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetPVZPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PvzId string `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+}
+
+func (x *GetPVZPolicyRequest) Reset() {
+	*x = GetPVZPolicyRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZPolicyRequest) ProtoMessage() {}
+
+func (x *GetPVZPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZPolicyRequest.ProtoReflect.Descriptor instead.
+func (*GetPVZPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetPVZPolicyRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+type GetPVZPolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *PVZPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *GetPVZPolicyResponse) Reset() {
+	*x = GetPVZPolicyResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZPolicyResponse) ProtoMessage() {}
+
+func (x *GetPVZPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZPolicyResponse.ProtoReflect.Descriptor instead.
+func (*GetPVZPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetPVZPolicyResponse) GetPolicy() *PVZPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type UpdatePVZPolicyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PvzId string `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	// the rules which are not set are reset to the defaults of the service, the rules set to 0 have no limit
+	ReturnWindow   *durationpb.Duration `protobuf:"bytes,2,opt,name=return_window,json=returnWindow,proto3" json:"return_window,omitempty"`
+	MaxStorageTime *durationpb.Duration `protobuf:"bytes,3,opt,name=max_storage_time,json=maxStorageTime,proto3" json:"max_storage_time,omitempty"`
+	MaxOrderWeight *int32               `protobuf:"varint,4,opt,name=max_order_weight,json=maxOrderWeight,proto3,oneof" json:"max_order_weight,omitempty"`
+	// allowed_packaging are the codes of the packaging catalog the PVZ accepts,
+	// empty means the defaults of the service unless all_packaging_allowed is set
+	AllowedPackaging []string `protobuf:"bytes,5,rep,name=allowed_packaging,json=allowedPackaging,proto3" json:"allowed_packaging,omitempty"`
+	// updated_by is the administrator who changes the policy
+	UpdatedBy string `protobuf:"bytes,6,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	// expected_version is the version of the policy the administrator has seen,
+	// the request fails with ABORTED if the policy has been changed since then
+	ExpectedVersion *int64 `protobuf:"varint,7,opt,name=expected_version,json=expectedVersion,proto3,oneof" json:"expected_version,omitempty"`
+	// all_packaging_allowed makes the PVZ accept all the packaging types of the catalog,
+	// allowed_packaging must be empty then
+	AllPackagingAllowed bool `protobuf:"varint,8,opt,name=all_packaging_allowed,json=allPackagingAllowed,proto3" json:"all_packaging_allowed,omitempty"`
+}
+
+func (x *UpdatePVZPolicyRequest) Reset() {
+	*x = UpdatePVZPolicyRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePVZPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePVZPolicyRequest) ProtoMessage() {}
+
+func (x *UpdatePVZPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePVZPolicyRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePVZPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdatePVZPolicyRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *UpdatePVZPolicyRequest) GetReturnWindow() *durationpb.Duration {
+	if x != nil {
+		return x.ReturnWindow
+	}
+	return nil
+}
+
+func (x *UpdatePVZPolicyRequest) GetMaxStorageTime() *durationpb.Duration {
+	if x != nil {
+		return x.MaxStorageTime
+	}
+	return nil
+}
+
+func (x *UpdatePVZPolicyRequest) GetMaxOrderWeight() int32 {
+	if x != nil && x.MaxOrderWeight != nil {
+		return *x.MaxOrderWeight
+	}
+	return 0
+}
+
+func (x *UpdatePVZPolicyRequest) GetAllowedPackaging() []string {
+	if x != nil {
+		return x.AllowedPackaging
+	}
+	return nil
+}
+
+func (x *UpdatePVZPolicyRequest) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *UpdatePVZPolicyRequest) GetExpectedVersion() int64 {
+	if x != nil && x.ExpectedVersion != nil {
+		return *x.ExpectedVersion
+	}
+	return 0
+}
+
+func (x *UpdatePVZPolicyRequest) GetAllPackagingAllowed() bool {
+	if x != nil {
+		return x.AllPackagingAllowed
+	}
+	return false
+}
+
+type UpdatePVZPolicyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy *PVZPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+}
+
+func (x *UpdatePVZPolicyResponse) Reset() {
+	*x = UpdatePVZPolicyResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePVZPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePVZPolicyResponse) ProtoMessage() {}
+
+func (x *UpdatePVZPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePVZPolicyResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePVZPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdatePVZPolicyResponse) GetPolicy() *PVZPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// PVZPolicy are the rules in effect in the PVZ, the defaults of the service are used for the rules
+// the administrator has not set
+type PVZPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PvzId string `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	// return_window is counted in the business days of the PVZ calendar
+	ReturnWindow *durationpb.Duration `protobuf:"bytes,2,opt,name=return_window,json=returnWindow,proto3" json:"return_window,omitempty"`
+	// max_storage_time is the maximum storage time of an order including the extensions, 0 means there is no limit
+	MaxStorageTime *durationpb.Duration `protobuf:"bytes,3,opt,name=max_storage_time,json=maxStorageTime,proto3" json:"max_storage_time,omitempty"`
+	// max_order_weight is 0 if there is no limit
+	MaxOrderWeight int32 `protobuf:"varint,4,opt,name=max_order_weight,json=maxOrderWeight,proto3" json:"max_order_weight,omitempty"`
+	// allowed_packaging is empty if all the packaging types of the catalog are accepted
+	AllowedPackaging []string `protobuf:"bytes,5,rep,name=allowed_packaging,json=allowedPackaging,proto3" json:"allowed_packaging,omitempty"`
+	// version is 0 if the administrator has not set the policy of the PVZ
+	Version   int64                  `protobuf:"varint,6,opt,name=version,proto3" json:"version,omitempty"`
+	UpdatedBy string                 `protobuf:"bytes,7,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3,oneof" json:"updated_at,omitempty"`
+	// set_rules are the names of the rules the administrator has set, the other rules are the defaults of the service
+	SetRules []string `protobuf:"bytes,9,rep,name=set_rules,json=setRules,proto3" json:"set_rules,omitempty"`
+}
+
+func (x *PVZPolicy) Reset() {
+	*x = PVZPolicy{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVZPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVZPolicy) ProtoMessage() {}
+
+func (x *PVZPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVZPolicy.ProtoReflect.Descriptor instead.
+func (*PVZPolicy) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PVZPolicy) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *PVZPolicy) GetReturnWindow() *durationpb.Duration {
+	if x != nil {
+		return x.ReturnWindow
+	}
+	return nil
+}
+
+func (x *PVZPolicy) GetMaxStorageTime() *durationpb.Duration {
+	if x != nil {
+		return x.MaxStorageTime
+	}
+	return nil
+}
+
+func (x *PVZPolicy) GetMaxOrderWeight() int32 {
+	if x != nil {
+		return x.MaxOrderWeight
+	}
+	return 0
+}
+
+func (x *PVZPolicy) GetAllowedPackaging() []string {
+	if x != nil {
+		return x.AllowedPackaging
+	}
+	return nil
+}
+
+func (x *PVZPolicy) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PVZPolicy) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *PVZPolicy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *PVZPolicy) GetSetRules() []string {
+	if x != nil {
+		return x.SetRules
+	}
+	return nil
+}
+
+type CreateReturnShipmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// order_ids are the orders returned by the clients or refused at pickup the courier takes to the seller
+	OrderIds []string `protobuf:"bytes,1,rep,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+}
+
+func (x *CreateReturnShipmentRequest) Reset() {
+	*x = CreateReturnShipmentRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReturnShipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReturnShipmentRequest) ProtoMessage() {}
+
+func (x *CreateReturnShipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReturnShipmentRequest.ProtoReflect.Descriptor instead.
+func (*CreateReturnShipmentRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateReturnShipmentRequest) GetOrderIds() []string {
+	if x != nil {
+		return x.OrderIds
+	}
+	return nil
+}
+
+type CreateReturnShipmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shipment *ReturnShipment `protobuf:"bytes,1,opt,name=shipment,proto3" json:"shipment,omitempty"`
+}
+
+func (x *CreateReturnShipmentResponse) Reset() {
+	*x = CreateReturnShipmentResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReturnShipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReturnShipmentResponse) ProtoMessage() {}
+
+func (x *CreateReturnShipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReturnShipmentResponse.ProtoReflect.Descriptor instead.
+func (*CreateReturnShipmentResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateReturnShipmentResponse) GetShipment() *ReturnShipment {
+	if x != nil {
+		return x.Shipment
+	}
+	return nil
+}
+
+type DispatchReturnShipmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShipmentId string `protobuf:"bytes,1,opt,name=shipment_id,json=shipmentId,proto3" json:"shipment_id,omitempty"`
+	CourierId  string `protobuf:"bytes,2,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+}
+
+func (x *DispatchReturnShipmentRequest) Reset() {
+	*x = DispatchReturnShipmentRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DispatchReturnShipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchReturnShipmentRequest) ProtoMessage() {}
+
+func (x *DispatchReturnShipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DispatchReturnShipmentRequest.ProtoReflect.Descriptor instead.
+func (*DispatchReturnShipmentRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *DispatchReturnShipmentRequest) GetShipmentId() string {
+	if x != nil {
+		return x.ShipmentId
+	}
+	return ""
+}
+
+func (x *DispatchReturnShipmentRequest) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+type DispatchReturnShipmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Manifest *ReturnManifest `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest,omitempty"`
+}
+
+func (x *DispatchReturnShipmentResponse) Reset() {
+	*x = DispatchReturnShipmentResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DispatchReturnShipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchReturnShipmentResponse) ProtoMessage() {}
+
+func (x *DispatchReturnShipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DispatchReturnShipmentResponse.ProtoReflect.Descriptor instead.
+func (*DispatchReturnShipmentResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *DispatchReturnShipmentResponse) GetManifest() *ReturnManifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+type GetReturnShipmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShipmentId string `protobuf:"bytes,1,opt,name=shipment_id,json=shipmentId,proto3" json:"shipment_id,omitempty"`
+}
+
+func (x *GetReturnShipmentRequest) Reset() {
+	*x = GetReturnShipmentRequest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReturnShipmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReturnShipmentRequest) ProtoMessage() {}
+
+func (x *GetReturnShipmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReturnShipmentRequest.ProtoReflect.Descriptor instead.
+func (*GetReturnShipmentRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetReturnShipmentRequest) GetShipmentId() string {
+	if x != nil {
+		return x.ShipmentId
+	}
+	return ""
+}
+
+type GetReturnShipmentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shipment *ReturnShipment `protobuf:"bytes,1,opt,name=shipment,proto3" json:"shipment,omitempty"`
+}
+
+func (x *GetReturnShipmentResponse) Reset() {
+	*x = GetReturnShipmentResponse{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReturnShipmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReturnShipmentResponse) ProtoMessage() {}
+
+func (x *GetReturnShipmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReturnShipmentResponse.ProtoReflect.Descriptor instead.
+func (*GetReturnShipmentResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetReturnShipmentResponse) GetShipment() *ReturnShipment {
+	if x != nil {
+		return x.Shipment
+	}
+	return nil
+}
+
+type ReturnShipment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PvzId  string               `protobuf:"bytes,2,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Status ReturnShipmentStatus `protobuf:"varint,3,opt,name=status,proto3,enum=pvz.v1.ReturnShipmentStatus" json:"status,omitempty"`
+	// order_ids are in the order they were included
+	OrderIds     []string               `protobuf:"bytes,4,rep,name=order_ids,json=orderIds,proto3" json:"order_ids,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CourierId    *string                `protobuf:"bytes,6,opt,name=courier_id,json=courierId,proto3,oneof" json:"courier_id,omitempty"`
+	DispatchedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=dispatched_at,json=dispatchedAt,proto3,oneof" json:"dispatched_at,omitempty"`
+	// manifest is set when the shipment is dispatched
+	Manifest *ReturnManifest `protobuf:"bytes,8,opt,name=manifest,proto3" json:"manifest,omitempty"`
+}
+
+func (x *ReturnShipment) Reset() {
+	*x = ReturnShipment{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReturnShipment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReturnShipment) ProtoMessage() {}
 
-func (x *GetOrdersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[4]
+func (x *ReturnShipment) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -371,42 +3518,95 @@ func (x *GetOrdersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrdersResponse.ProtoReflect.Descriptor instead.
-func (*GetOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ReturnShipment.ProtoReflect.Descriptor instead.
+func (*ReturnShipment) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *GetOrdersResponse) GetOrders() []*PVZOrder {
+func (x *ReturnShipment) GetId() string {
 	if x != nil {
-		return x.Orders
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReturnShipment) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *ReturnShipment) GetStatus() ReturnShipmentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReturnShipmentStatus_RETURN_SHIPMENT_STATUS_UNKNOWN
+}
+
+func (x *ReturnShipment) GetOrderIds() []string {
+	if x != nil {
+		return x.OrderIds
 	}
 	return nil
 }
 
-type AcceptReturnRequest struct {
+func (x *ReturnShipment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ReturnShipment) GetCourierId() string {
+	if x != nil && x.CourierId != nil {
+		return *x.CourierId
+	}
+	return ""
+}
+
+func (x *ReturnShipment) GetDispatchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DispatchedAt
+	}
+	return nil
+}
+
+func (x *ReturnShipment) GetManifest() *ReturnManifest {
+	if x != nil {
+		return x.Manifest
+	}
+	return nil
+}
+
+// ReturnManifest is the list of the orders the courier takes to the seller
+type ReturnManifest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId  string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ShipmentId   string                 `protobuf:"bytes,1,opt,name=shipment_id,json=shipmentId,proto3" json:"shipment_id,omitempty"`
+	PvzId        string                 `protobuf:"bytes,2,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	CourierId    string                 `protobuf:"bytes,3,opt,name=courier_id,json=courierId,proto3" json:"courier_id,omitempty"`
+	Items        []*ReturnManifestItem  `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	DispatchedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=dispatched_at,json=dispatchedAt,proto3" json:"dispatched_at,omitempty"`
 }
 
-func (x *AcceptReturnRequest) Reset() {
-	*x = AcceptReturnRequest{}
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[5]
+func (x *ReturnManifest) Reset() {
+	*x = ReturnManifest{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AcceptReturnRequest) String() string {
+func (x *ReturnManifest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AcceptReturnRequest) ProtoMessage() {}
+func (*ReturnManifest) ProtoMessage() {}
 
-func (x *AcceptReturnRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[5]
+func (x *ReturnManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,49 +3617,80 @@ func (x *AcceptReturnRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AcceptReturnRequest.ProtoReflect.Descriptor instead.
-func (*AcceptReturnRequest) Descriptor() ([]byte, []int) {
-	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ReturnManifest.ProtoReflect.Descriptor instead.
+func (*ReturnManifest) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *AcceptReturnRequest) GetUserId() string {
+func (x *ReturnManifest) GetShipmentId() string {
 	if x != nil {
-		return x.UserId
+		return x.ShipmentId
 	}
 	return ""
 }
 
-func (x *AcceptReturnRequest) GetOrderId() string {
+func (x *ReturnManifest) GetPvzId() string {
 	if x != nil {
-		return x.OrderId
+		return x.PvzId
 	}
 	return ""
 }
 
-type GetReturnsRequest struct {
+func (x *ReturnManifest) GetCourierId() string {
+	if x != nil {
+		return x.CourierId
+	}
+	return ""
+}
+
+func (x *ReturnManifest) GetItems() []*ReturnManifestItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ReturnManifest) GetDispatchedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DispatchedAt
+	}
+	return nil
+}
+
+type ReturnManifestItem struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Page     *int32 `protobuf:"varint,1,opt,name=page,proto3,oneof" json:"page,omitempty"`
-	PageSize *int32 `protobuf:"varint,2,opt,name=pageSize,proto3,oneof" json:"pageSize,omitempty"`
+	OrderId     string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	RecipientId string `protobuf:"bytes,2,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	// status is the status of the order before it was dispatched: returned by the client or refused at pickup
+	Status        OrderStatus  `protobuf:"varint,3,opt,name=status,proto3,enum=pvz.v1.OrderStatus" json:"status,omitempty"`
+	Cost          *money.Money `protobuf:"bytes,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	Weight        int32        `protobuf:"varint,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	PackagingCode string       `protobuf:"bytes,6,opt,name=packaging_code,json=packagingCode,proto3" json:"packaging_code,omitempty"`
+	// return_reason, inspection and return_comment are set for the orders returned by the client
+	ReturnReason  ReturnReason           `protobuf:"varint,7,opt,name=return_reason,json=returnReason,proto3,enum=pvz.v1.ReturnReason" json:"return_reason,omitempty"`
+	Inspection    InspectionOutcome      `protobuf:"varint,8,opt,name=inspection,proto3,enum=pvz.v1.InspectionOutcome" json:"inspection,omitempty"`
+	ReturnComment *string                `protobuf:"bytes,9,opt,name=return_comment,json=returnComment,proto3,oneof" json:"return_comment,omitempty"`
+	ReturnedAt    *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=returned_at,json=returnedAt,proto3" json:"returned_at,omitempty"`
 }
 
-func (x *GetReturnsRequest) Reset() {
-	*x = GetReturnsRequest{}
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[6]
+func (x *ReturnManifestItem) Reset() {
+	*x = ReturnManifestItem{}
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetReturnsRequest) String() string {
+func (x *ReturnManifestItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetReturnsRequest) ProtoMessage() {}
+func (*ReturnManifestItem) ProtoMessage() {}
 
-func (x *GetReturnsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[6]
+func (x *ReturnManifestItem) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -470,66 +3701,77 @@ func (x *GetReturnsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetReturnsRequest.ProtoReflect.Descriptor instead.
-func (*GetReturnsRequest) Descriptor() ([]byte, []int) {
-	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ReturnManifestItem.ProtoReflect.Descriptor instead.
+func (*ReturnManifestItem) Descriptor() ([]byte, []int) {
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *GetReturnsRequest) GetPage() int32 {
-	if x != nil && x.Page != nil {
-		return *x.Page
+func (x *ReturnManifestItem) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
 	}
-	return 0
+	return ""
 }
 
-func (x *GetReturnsRequest) GetPageSize() int32 {
-	if x != nil && x.PageSize != nil {
-		return *x.PageSize
+func (x *ReturnManifestItem) GetRecipientId() string {
+	if x != nil {
+		return x.RecipientId
 	}
-	return 0
+	return ""
 }
 
-type GetReturnsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ReturnManifestItem) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNKNOWN
+}
 
-	Returns []*PVZOrder `protobuf:"bytes,1,rep,name=returns,proto3" json:"returns,omitempty"`
+func (x *ReturnManifestItem) GetCost() *money.Money {
+	if x != nil {
+		return x.Cost
+	}
+	return nil
 }
 
-func (x *GetReturnsResponse) Reset() {
-	*x = GetReturnsResponse{}
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ReturnManifestItem) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
 }
 
-func (x *GetReturnsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ReturnManifestItem) GetPackagingCode() string {
+	if x != nil {
+		return x.PackagingCode
+	}
+	return ""
 }
 
-func (*GetReturnsResponse) ProtoMessage() {}
+func (x *ReturnManifestItem) GetReturnReason() ReturnReason {
+	if x != nil {
+		return x.ReturnReason
+	}
+	return ReturnReason_RETURN_REASON_UNKNOWN
+}
 
-func (x *GetReturnsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[7]
+func (x *ReturnManifestItem) GetInspection() InspectionOutcome {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Inspection
 	}
-	return mi.MessageOf(x)
+	return InspectionOutcome_INSPECTION_OUTCOME_UNKNOWN
 }
 
-// Deprecated: Use GetReturnsResponse.ProtoReflect.Descriptor instead.
-func (*GetReturnsResponse) Descriptor() ([]byte, []int) {
-	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{7}
+func (x *ReturnManifestItem) GetReturnComment() string {
+	if x != nil && x.ReturnComment != nil {
+		return *x.ReturnComment
+	}
+	return ""
 }
 
-func (x *GetReturnsResponse) GetReturns() []*PVZOrder {
+func (x *ReturnManifestItem) GetReturnedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Returns
+		return x.ReturnedAt
 	}
 	return nil
 }
@@ -539,22 +3781,59 @@ type PVZOrder struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderId        string                 `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	PvzId          string                 `protobuf:"bytes,2,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
-	RecipientId    string                 `protobuf:"bytes,3,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
-	Cost           int32                  `protobuf:"varint,4,opt,name=cost,proto3" json:"cost,omitempty"`
-	Weight         int32                  `protobuf:"varint,5,opt,name=weight,proto3" json:"weight,omitempty"`
-	Packaging      PackagingType          `protobuf:"varint,6,opt,name=packaging,proto3,enum=pvz.v1.PackagingType" json:"packaging,omitempty"`
-	AdditionalFilm bool                   `protobuf:"varint,7,opt,name=additional_film,json=additionalFilm,proto3" json:"additional_film,omitempty"`
-	ReceivedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
-	StorageTime    *durationpb.Duration   `protobuf:"bytes,9,opt,name=storage_time,json=storageTime,proto3" json:"storage_time,omitempty"`
-	IssuedAt       *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=issued_at,json=issuedAt,proto3,oneof" json:"issued_at,omitempty"`
-	ReturnedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=returned_at,json=returnedAt,proto3,oneof" json:"returned_at,omitempty"`
+	OrderId     string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	PvzId       string `protobuf:"bytes,2,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	RecipientId string `protobuf:"bytes,3,opt,name=recipient_id,json=recipientId,proto3" json:"recipient_id,omitempty"`
+	// cost is in kopecks, 0 if it does not fit or is not in roubles, use cost_money instead
+	Cost              int32                  `protobuf:"varint,4,opt,name=cost,proto3" json:"cost,omitempty"`
+	Weight            int32                  `protobuf:"varint,5,opt,name=weight,proto3" json:"weight,omitempty"`
+	Packaging         PackagingType          `protobuf:"varint,6,opt,name=packaging,proto3,enum=pvz.v1.PackagingType" json:"packaging,omitempty"`
+	AdditionalFilm    bool                   `protobuf:"varint,7,opt,name=additional_film,json=additionalFilm,proto3" json:"additional_film,omitempty"`
+	ReceivedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	StorageTime       *durationpb.Duration   `protobuf:"bytes,9,opt,name=storage_time,json=storageTime,proto3" json:"storage_time,omitempty"`
+	IssuedAt          *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=issued_at,json=issuedAt,proto3,oneof" json:"issued_at,omitempty"`
+	ReturnedAt        *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=returned_at,json=returnedAt,proto3,oneof" json:"returned_at,omitempty"`
+	Status            OrderStatus            `protobuf:"varint,12,opt,name=status,proto3,enum=pvz.v1.OrderStatus" json:"status,omitempty"`
+	Version           int64                  `protobuf:"varint,13,opt,name=version,proto3" json:"version,omitempty"`
+	StorageExtensions int32                  `protobuf:"varint,14,opt,name=storage_extensions,json=storageExtensions,proto3" json:"storage_extensions,omitempty"`
+	ExtendedBy        *string                `protobuf:"bytes,15,opt,name=extended_by,json=extendedBy,proto3,oneof" json:"extended_by,omitempty"`
+	// packaging is UNKNOWN for the packaging types which have no enum value, use packaging_code instead
+	PackagingCode string `protobuf:"bytes,16,opt,name=packaging_code,json=packagingCode,proto3" json:"packaging_code,omitempty"`
+	// expires_at is received_at plus storage_time counted in the business days of the PVZ calendar,
+	// the order must be picked up before it or during the paid storage
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// dimensions are not set for the orders accepted without them
+	Dimensions *Dimensions `protobuf:"bytes,18,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	// volumetric_weight is the weight the parcel is charged for by its size, 0 if dimensions are not set
+	VolumetricWeight int32 `protobuf:"varint,19,opt,name=volumetric_weight,json=volumetricWeight,proto3" json:"volumetric_weight,omitempty"`
+	// cost_money is the cost of the order with the packaging fees
+	CostMoney *money.Money `protobuf:"bytes,20,opt,name=cost_money,json=costMoney,proto3" json:"cost_money,omitempty"`
+	// weight is declared by the courier, measured_weight is 0 if the parcel was not weighed
+	MeasuredWeight int32 `protobuf:"varint,21,opt,name=measured_weight,json=measuredWeight,proto3" json:"measured_weight,omitempty"`
+	// weight_discrepancy is set if the order was accepted by the override despite the measured weight
+	WeightDiscrepancy    bool    `protobuf:"varint,22,opt,name=weight_discrepancy,json=weightDiscrepancy,proto3" json:"weight_discrepancy,omitempty"`
+	WeightOverrideReason *string `protobuf:"bytes,23,opt,name=weight_override_reason,json=weightOverrideReason,proto3,oneof" json:"weight_override_reason,omitempty"`
+	// paid_storage_until is the end of the paid storage after expires_at, the order may be issued for a storage fee
+	// until it. It equals expires_at if there is no paid storage
+	PaidStorageUntil *timestamppb.Timestamp `protobuf:"bytes,24,opt,name=paid_storage_until,json=paidStorageUntil,proto3" json:"paid_storage_until,omitempty"`
+	// daily_storage_fee is charged for every started day after expires_at
+	DailyStorageFee *money.Money `protobuf:"bytes,25,opt,name=daily_storage_fee,json=dailyStorageFee,proto3" json:"daily_storage_fee,omitempty"`
+	// storage_fee is the fee accrued by now, it must be paid to issue the order
+	StorageFee *money.Money `protobuf:"bytes,26,opt,name=storage_fee,json=storageFee,proto3" json:"storage_fee,omitempty"`
+	// storage_fee_paid is the storage fee the client paid when the order was issued
+	StorageFeePaid *money.Money `protobuf:"bytes,27,opt,name=storage_fee_paid,json=storageFeePaid,proto3" json:"storage_fee_paid,omitempty"`
+	// return_deadline is the time until which the issued order may be returned by the client,
+	// it is counted in the business days of the PVZ calendar
+	ReturnDeadline *timestamppb.Timestamp `protobuf:"bytes,28,opt,name=return_deadline,json=returnDeadline,proto3,oneof" json:"return_deadline,omitempty"`
+	// return_reason, inspection and return_comment are set for the orders returned by the client
+	ReturnReason  ReturnReason      `protobuf:"varint,29,opt,name=return_reason,json=returnReason,proto3,enum=pvz.v1.ReturnReason" json:"return_reason,omitempty"`
+	Inspection    InspectionOutcome `protobuf:"varint,30,opt,name=inspection,proto3,enum=pvz.v1.InspectionOutcome" json:"inspection,omitempty"`
+	ReturnComment *string           `protobuf:"bytes,31,opt,name=return_comment,json=returnComment,proto3,oneof" json:"return_comment,omitempty"`
 }
 
 func (x *PVZOrder) Reset() {
 	*x = PVZOrder{}
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[8]
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -566,7 +3845,7 @@ func (x *PVZOrder) String() string {
 func (*PVZOrder) ProtoMessage() {}
 
 func (x *PVZOrder) ProtoReflect() protoreflect.Message {
-	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[8]
+	mi := &file_pvz_service_v1_pvz_service_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -579,7 +3858,7 @@ func (x *PVZOrder) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PVZOrder.ProtoReflect.Descriptor instead.
 func (*PVZOrder) Descriptor() ([]byte, []int) {
-	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{8}
+	return file_pvz_service_v1_pvz_service_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *PVZOrder) GetOrderId() string {
@@ -659,6 +3938,146 @@ func (x *PVZOrder) GetReturnedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *PVZOrder) GetStatus() OrderStatus {
+	if x != nil {
+		return x.Status
+	}
+	return OrderStatus_ORDER_STATUS_UNKNOWN
+}
+
+func (x *PVZOrder) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *PVZOrder) GetStorageExtensions() int32 {
+	if x != nil {
+		return x.StorageExtensions
+	}
+	return 0
+}
+
+func (x *PVZOrder) GetExtendedBy() string {
+	if x != nil && x.ExtendedBy != nil {
+		return *x.ExtendedBy
+	}
+	return ""
+}
+
+func (x *PVZOrder) GetPackagingCode() string {
+	if x != nil {
+		return x.PackagingCode
+	}
+	return ""
+}
+
+func (x *PVZOrder) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *PVZOrder) GetDimensions() *Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *PVZOrder) GetVolumetricWeight() int32 {
+	if x != nil {
+		return x.VolumetricWeight
+	}
+	return 0
+}
+
+func (x *PVZOrder) GetCostMoney() *money.Money {
+	if x != nil {
+		return x.CostMoney
+	}
+	return nil
+}
+
+func (x *PVZOrder) GetMeasuredWeight() int32 {
+	if x != nil {
+		return x.MeasuredWeight
+	}
+	return 0
+}
+
+func (x *PVZOrder) GetWeightDiscrepancy() bool {
+	if x != nil {
+		return x.WeightDiscrepancy
+	}
+	return false
+}
+
+func (x *PVZOrder) GetWeightOverrideReason() string {
+	if x != nil && x.WeightOverrideReason != nil {
+		return *x.WeightOverrideReason
+	}
+	return ""
+}
+
+func (x *PVZOrder) GetPaidStorageUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidStorageUntil
+	}
+	return nil
+}
+
+func (x *PVZOrder) GetDailyStorageFee() *money.Money {
+	if x != nil {
+		return x.DailyStorageFee
+	}
+	return nil
+}
+
+func (x *PVZOrder) GetStorageFee() *money.Money {
+	if x != nil {
+		return x.StorageFee
+	}
+	return nil
+}
+
+func (x *PVZOrder) GetStorageFeePaid() *money.Money {
+	if x != nil {
+		return x.StorageFeePaid
+	}
+	return nil
+}
+
+func (x *PVZOrder) GetReturnDeadline() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReturnDeadline
+	}
+	return nil
+}
+
+func (x *PVZOrder) GetReturnReason() ReturnReason {
+	if x != nil {
+		return x.ReturnReason
+	}
+	return ReturnReason_RETURN_REASON_UNKNOWN
+}
+
+func (x *PVZOrder) GetInspection() InspectionOutcome {
+	if x != nil {
+		return x.Inspection
+	}
+	return InspectionOutcome_INSPECTION_OUTCOME_UNKNOWN
+}
+
+func (x *PVZOrder) GetReturnComment() string {
+	if x != nil && x.ReturnComment != nil {
+		return *x.ReturnComment
+	}
+	return ""
+}
+
 var File_pvz_service_v1_pvz_service_proto protoreflect.FileDescriptor
 
 var file_pvz_service_v1_pvz_service_proto_rawDesc = []byte{
@@ -670,146 +4089,818 @@ var file_pvz_service_v1_pvz_service_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
 	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76,
-	0x69, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65,
-	0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61,
-	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xed, 0x02, 0x0a, 0x1a, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x62, 0x65, 0x68, 0x61, 0x76, 0x69, 0x6f,
+	0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x74, 0x79,
+	0x70, 0x65, 0x2f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
+	0x67, 0x65, 0x6e, 0x2d, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x32, 0x2f, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x89, 0x05, 0x0a, 0x1a, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x06,
+	0x72, 0x04, 0x10, 0x01, 0x18, 0x24, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x2f, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10,
+	0x01, 0x18, 0x24, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x49, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x0b, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x32, 0x00, 0x52, 0x0b,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x63,
+	0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x01, 0xfa, 0x42,
+	0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02,
+	0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x38, 0x0a, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x09,
+	0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x0f, 0x61, 0x64, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x6d, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x0e, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x46, 0x69, 0x6c, 0x6d, 0x12, 0x31, 0x0a, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61,
+	0x67, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0a, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x18, 0x20, 0x52, 0x0d, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x64, 0x69,
+	0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x0a, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x6e, 0x65,
+	0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x42, 0x03, 0xe0, 0x41, 0x01,
+	0x52, 0x09, 0x63, 0x6f, 0x73, 0x74, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12, 0x33, 0x0a, 0x0f, 0x6d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00,
+	0x52, 0x0e, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x41, 0x0a, 0x16, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72,
+	0x69, 0x64, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0b, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0xff, 0x01, 0x52, 0x14, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x22, 0x76, 0x0a, 0x0a, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x22, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00, 0x52, 0x06, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x20, 0x00,
+	0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x22, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a,
+	0x02, 0x20, 0x00, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x72, 0x0a, 0x1f, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x42, 0x15, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x0f, 0x92, 0x01, 0x0c, 0x08, 0x01, 0x10, 0xf4,
+	0x03, 0x22, 0x05, 0x8a, 0x01, 0x02, 0x08, 0x01, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22,
+	0x5f, 0x0a, 0x20, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x22, 0x9d, 0x01, 0x0a, 0x19, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x19,
+	0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x26,
+	0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e,
+	0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x56, 0x5a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0x96, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72,
 	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x27, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x42, 0x0c, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x24, 0x52,
-	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x69,
-	0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
-	0xe0, 0x41, 0x02, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x24, 0x52, 0x0b, 0x72, 0x65,
-	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x0c, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0b, 0xe0, 0x41, 0x02, 0xfa,
-	0x42, 0x05, 0xaa, 0x01, 0x02, 0x32, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x04,
-	0x63, 0x6f, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00,
-	0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x76,
-	0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x54, 0x79,
-	0x70, 0x65, 0x42, 0x03, 0xe0, 0x41, 0x02, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69,
-	0x6e, 0x67, 0x12, 0x2c, 0x0a, 0x0f, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
-	0x5f, 0x66, 0x69, 0x6c, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x42, 0x03, 0xe0, 0x41, 0x02,
-	0x52, 0x0e, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x69, 0x6c, 0x6d,
-	0x22, 0x45, 0x0a, 0x1a, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44,
-	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x42, 0x0a, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x01, 0x48, 0x00,
+	0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x18, 0x47, 0x69,
+	0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x11, 0xe0, 0x41, 0x01, 0xfa, 0x42,
+	0x0b, 0x92, 0x01, 0x08, 0x22, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x24, 0x52, 0x08, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x76, 0x7a, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0xa2, 0x03, 0x0a, 0x0d, 0x49, 0x73, 0x73, 0x75, 0x65, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01,
+	0x18, 0x24, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x76,
+	0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x0d, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x75, 0x73,
+	0x61, 0x6c, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0d, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x48, 0x00,
+	0x52, 0x0d, 0x72, 0x65, 0x66, 0x75, 0x73, 0x61, 0x6c, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x88,
+	0x01, 0x01, 0x12, 0x3a, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xe0, 0x41,
+	0x01, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x01, 0x48, 0x01, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3a,
+	0x0a, 0x0b, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x14, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x0e, 0x72, 0x0c, 0x32, 0x0a, 0x5e,
+	0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x7d, 0x24, 0x48, 0x02, 0x52, 0x0a, 0x70, 0x69, 0x63,
+	0x6b, 0x75, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x41, 0x0a, 0x10, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x0e, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x50, 0x61, 0x69, 0x64, 0x42, 0x11, 0x0a,
+	0x0f, 0x5f, 0x72, 0x65, 0x66, 0x75, 0x73, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x42, 0x13, 0x0a, 0x11, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x69, 0x63, 0x6b, 0x75, 0x70,
+	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x4a, 0x0a, 0x19, 0x47, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x73,
+	0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x22, 0x94, 0x01, 0x0a, 0x0b, 0x49, 0x73, 0x73, 0x75, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x06,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70,
+	0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x73, 0x73, 0x75, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x42, 0x08,
+	0x0a, 0x06, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x96, 0x03, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c,
+	0xe0, 0x41, 0x02, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x24, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x05, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x48,
+	0x00, 0x52, 0x05, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x07, 0x73,
+	0x61, 0x6d, 0x65, 0x50, 0x56, 0x5a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x03, 0xe0, 0x41,
+	0x01, 0x48, 0x01, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x65, 0x50, 0x56, 0x5a, 0x88, 0x01, 0x01, 0x12,
+	0x29, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0c, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x24, 0x48, 0x02, 0x52,
+	0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x05, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x01, 0xfa, 0x42,
+	0x04, 0x1a, 0x02, 0x28, 0x00, 0x48, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x43, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x12, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x0c,
+	0x92, 0x01, 0x09, 0x22, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x08, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x12, 0x37, 0x0a, 0x12, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x5f, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x48, 0x04, 0x52, 0x11, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x88, 0x01, 0x01, 0x42,
+	0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x61,
+	0x6d, 0x65, 0x50, 0x56, 0x5a, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72,
+	0x42, 0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x15, 0x0a, 0x13, 0x5f, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63,
+	0x79, 0x22, 0x47, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x56, 0x5a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02,
+	0x08, 0x01, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0xe4, 0x02, 0x0a, 0x13, 0x41,
+	0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0c, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18,
+	0x24, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x08, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe0, 0x41, 0x02,
+	0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x24, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x3a, 0x0a, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xe0, 0x41,
+	0x01, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x01, 0x48, 0x00, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x3b,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
+	0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x42, 0x0d, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04, 0x10,
+	0x01, 0x20, 0x00, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x48, 0x0a, 0x0a, 0x69,
+	0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x19, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x42, 0x0d, 0xe0, 0x41, 0x02, 0xfa,
+	0x42, 0x07, 0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x05, 0x72, 0x03,
+	0x18, 0xe8, 0x07, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x13, 0x0a, 0x11,
+	0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0x94, 0x02, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28,
+	0x00, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x08,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a,
+	0xe0, 0x41, 0x01, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x48, 0x01, 0x52, 0x08, 0x70, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x07, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x76, 0x7a,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x42, 0x14, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x0e, 0x92, 0x01, 0x0b, 0x18, 0x01, 0x22, 0x07, 0x82,
+	0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12,
+	0x51, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x42,
+	0x14, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x0e, 0x92, 0x01, 0x0b, 0x18, 0x01, 0x22, 0x07, 0x82, 0x01,
+	0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f,
+	0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x4a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34,
+	0x0a, 0x07, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x56, 0x5a, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x07, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x73, 0x22, 0x41, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27,
 	0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x0c, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x24, 0x52, 0x07,
-	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x18, 0x47, 0x69, 0x76, 0x65, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x30, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x13, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x0d, 0x92, 0x01,
-	0x0a, 0x08, 0x01, 0x22, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x24, 0x52, 0x08, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0x81, 0x02, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x75, 0x73,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xef,
+	0x01, 0x0a, 0x0a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x07,
+	0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x07, 0x73, 0x65,
+	0x6e, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x74, 0x41,
+	0x74, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x61, 0x74,
+	0x22, 0x87, 0x02, 0x0a, 0x14, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x08, 0x6f, 0x72, 0x64,
 	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe0, 0x41, 0x02,
-	0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x24, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x12, 0x25, 0x0a, 0x05, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x42, 0x0a, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x48, 0x00, 0x52, 0x05,
-	0x6c, 0x61, 0x73, 0x74, 0x4e, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x65,
-	0x50, 0x56, 0x5a, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x48, 0x01,
-	0x52, 0x07, 0x73, 0x61, 0x6d, 0x65, 0x50, 0x56, 0x5a, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x06,
-	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe0, 0x41,
-	0x01, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x24, 0x48, 0x02, 0x52, 0x06, 0x63, 0x75,
-	0x72, 0x73, 0x6f, 0x72, 0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x04, 0x1a, 0x02,
-	0x28, 0x00, 0x48, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x88, 0x01, 0x01, 0x42, 0x08,
-	0x0a, 0x06, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x73, 0x61, 0x6d,
-	0x65, 0x50, 0x56, 0x5a, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x42,
-	0x08, 0x0a, 0x06, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x47, 0x0a, 0x11, 0x47, 0x65, 0x74,
-	0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32,
-	0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10,
-	0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x56, 0x5a, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x73, 0x22, 0x65, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x52, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe0, 0x41, 0x02, 0xfa,
-	0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x24, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x27, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x0c, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x24,
-	0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x7b, 0x0a, 0x11, 0x47, 0x65, 0x74,
-	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
-	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41,
-	0x01, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x48, 0x00, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x2b, 0x0a, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28,
-	0x00, 0x48, 0x01, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x88, 0x01, 0x01,
-	0x42, 0x07, 0x0a, 0x05, 0x5f, 0x70, 0x61, 0x67, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x70, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x4a, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74,
-	0x75, 0x72, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07,
-	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e,
-	0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x56, 0x5a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x42,
-	0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x07, 0x72, 0x65, 0x74, 0x75, 0x72,
-	0x6e, 0x73, 0x22, 0x82, 0x04, 0x0a, 0x08, 0x50, 0x56, 0x5a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12,
-	0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x76,
+	0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x24, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x46, 0x0a, 0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x0d, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x07, 0xaa, 0x01, 0x04, 0x08, 0x01, 0x2a, 0x00, 0x52,
+	0x09, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x0b, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x0c, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x24, 0x52, 0x0a, 0x65,
+	0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3a, 0x0a, 0x10, 0x65, 0x78, 0x70,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x0a, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x01, 0x48,
+	0x00, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x45, 0x0a, 0x1b, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x56, 0x5a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x22, 0x62, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x44, 0x69,
+	0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x29, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x23, 0x72, 0x21, 0x32, 0x1f,
+	0x5e, 0x28, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x34, 0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d,
+	0x7b, 0x32, 0x7d, 0x2d, 0x5b, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x32, 0x7d, 0x29, 0x3f, 0x24, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x62, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x57, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x28, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x10, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x56, 0x5a, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0xcc, 0x01, 0x0a, 0x15, 0x51, 0x75,
+	0x6f, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x42, 0x0a, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x04, 0x63,
+	0x6f, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x37, 0x0a, 0x0a, 0x64, 0x69, 0x6d, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x76,
+	0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x42,
+	0x03, 0xe0, 0x41, 0x01, 0x52, 0x0a, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x36, 0x0a, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79,
+	0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x09, 0x63,
+	0x6f, 0x73, 0x74, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x22, 0x48, 0x0a, 0x16, 0x51, 0x75, 0x6f, 0x74,
+	0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x69, 0x6e, 0x67, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x06, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x73, 0x22, 0x9e, 0x01, 0x0a, 0x0e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69,
+	0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x65, 0x61, 0x70, 0x65, 0x73, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x68, 0x65, 0x61, 0x70, 0x65, 0x73, 0x74, 0x12, 0x33,
+	0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70,
+	0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63, 0x65, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x22, 0x99, 0x01, 0x0a, 0x1a, 0x4f, 0x70, 0x65, 0x6e, 0x48, 0x61, 0x6e, 0x64,
+	0x6f, 0x76, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2b, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x06, 0x72, 0x04,
+	0x10, 0x01, 0x18, 0x24, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x4e, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x0e, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x08, 0x92, 0x01, 0x05,
+	0x08, 0x01, 0x10, 0xf4, 0x03, 0x52, 0x08, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22,
+	0x50, 0x0a, 0x1b, 0x4f, 0x70, 0x65, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31,
+	0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65,
+	0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0xa5, 0x01, 0x0a, 0x19, 0x53, 0x63, 0x61, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76,
+	0x65, 0x72, 0x50, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2a, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x0b, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01,
+	0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x08, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xe0,
+	0x41, 0x02, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x24, 0x52, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x0f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64,
+	0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0,
+	0x41, 0x01, 0xfa, 0x42, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x0e, 0x6d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x46, 0x0a, 0x1a, 0x53, 0x63, 0x61,
+	0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x73, 0x63, 0x61, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x04, 0x73, 0x63, 0x61,
+	0x6e, 0x22, 0x49, 0x0a, 0x1b, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76,
+	0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2a, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01,
+	0x01, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x1c,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06,
+	0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
+	0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x47, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x0a, 0x73, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xe0,
+	0x41, 0x02, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x4f, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6e, 0x64,
+	0x6f, 0x76, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61,
+	0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x9d, 0x03, 0x0a, 0x0f, 0x48, 0x61, 0x6e, 0x64, 0x6f,
+	0x76, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x76,
 	0x7a, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x76, 0x7a, 0x49,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
-	0x12, 0x33, 0x0a, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63,
-	0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b,
-	0x61, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
-	0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x69, 0x6c, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
-	0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x46, 0x69, 0x6c, 0x6d, 0x12, 0x3b,
-	0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x35, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1d, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76,
+	0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x65, 0x78, 0x70, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x10, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6e, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61,
+	0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6e,
+	0x73, 0x12, 0x37, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x08, 0x6f, 0x70, 0x65, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3c, 0x0a, 0x09, 0x63, 0x6c,
+	0x6f, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76,
+	0x31, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x06, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x63, 0x6c, 0x6f,
+	0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xd9, 0x01, 0x0a, 0x0c, 0x48, 0x61, 0x6e, 0x64, 0x6f,
+	0x76, 0x65, 0x72, 0x53, 0x63, 0x61, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x65, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x76,
+	0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x63, 0x61,
+	0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x63, 0x61, 0x6e, 0x6e,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x63, 0x61, 0x6e, 0x6e, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x89, 0x02, 0x0a, 0x0e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e,
+	0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x75, 0x6e, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x4b, 0x0a, 0x11, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x5f, 0x6d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4d, 0x69, 0x73,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x52, 0x10, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4d, 0x69, 0x73,
+	0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x76, 0x7a, 0x2e,
+	0x76, 0x31, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x85,
+	0x01, 0x0a, 0x16, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x4d, 0x69, 0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64,
+	0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x64,
+	0x65, 0x63, 0x6c, 0x61, 0x72, 0x65, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x46, 0x0a, 0x11, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76,
+	0x65, 0x72, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f,
+	0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x3b,
+	0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x56, 0x5a, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x70, 0x76, 0x7a, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x07, 0x72, 0x05, 0x10,
+	0x01, 0x18, 0xff, 0x01, 0x52, 0x05, 0x70, 0x76, 0x7a, 0x49, 0x64, 0x22, 0x41, 0x0a, 0x14, 0x47,
+	0x65, 0x74, 0x50, 0x56, 0x5a, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x56, 0x5a,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0xa8,
+	0x04, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x56, 0x5a, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x70, 0x76, 0x7a,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xe0, 0x41, 0x02, 0xfa, 0x42,
+	0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01, 0x52, 0x05, 0x70, 0x76, 0x7a, 0x49, 0x64, 0x12,
+	0x4b, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x0b, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x0c,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x50, 0x0a, 0x10,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x0b, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x32, 0x00, 0x52, 0x0e,
+	0x6d, 0x61, 0x78, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x39,
+	0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x42, 0x0a, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x04,
+	0x1a, 0x02, 0x28, 0x00, 0x48, 0x00, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x88, 0x01, 0x01, 0x12, 0x42, 0x0a, 0x11, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x42, 0x15, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x0f, 0x92, 0x01, 0x0c, 0x10,
+	0x20, 0x18, 0x01, 0x22, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x20, 0x52, 0x10, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x2b, 0x0a,
+	0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0c, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x24, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3a, 0x0a, 0x10, 0x65, 0x78,
+	0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x0a, 0xe0, 0x41, 0x01, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x01,
+	0x48, 0x01, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x37, 0x0a, 0x15, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x61,
+	0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x42, 0x03, 0xe0, 0x41, 0x01, 0x52, 0x13, 0x61, 0x6c, 0x6c, 0x50,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42,
+	0x13, 0x0a, 0x11, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x17, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x56, 0x5a, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x56,
+	0x5a, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22,
+	0xa3, 0x03, 0x0a, 0x09, 0x50, 0x56, 0x5a, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x15, 0x0a,
+	0x06, 0x70, 0x76, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70,
+	0x76, 0x7a, 0x49, 0x64, 0x12, 0x3e, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x57, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x12, 0x43, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x61, 0x78,
+	0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x70,
+	0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x12, 0x3e, 0x0a, 0x0a, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x09, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x74,
+	0x5f, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x73, 0x65,
+	0x74, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x54, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x09, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x12, 0x92,
+	0x01, 0x0f, 0x08, 0x01, 0x10, 0xf4, 0x03, 0x18, 0x01, 0x22, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18,
+	0x24, 0x52, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x22, 0x52, 0x0a, 0x1c, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x68, 0x69, 0x70, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x73,
+	0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x68, 0x69,
+	0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x22,
+	0x7a, 0x0a, 0x1d, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2c, 0x0a, 0x0b, 0x73, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0,
+	0x01, 0x01, 0x52, 0x0a, 0x73, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b,
+	0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0c, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x24,
+	0x52, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x49, 0x64, 0x22, 0x54, 0x0a, 0x1e, 0x44,
+	0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x68, 0x69,
+	0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a,
+	0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4d,
+	0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x22, 0x48, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x68,
+	0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a,
+	0x0b, 0x73, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0b, 0xe0, 0x41, 0x02, 0xfa, 0x42, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52,
+	0x0a, 0x73, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x4f, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x08, 0x73, 0x68, 0x69, 0x70,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x76, 0x7a,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x08, 0x73, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x84, 0x03, 0x0a,
+	0x0e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x15, 0x0a, 0x06, 0x70, 0x76, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x76, 0x7a, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x72,
+	0x69, 0x65, 0x72, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x44, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x70,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0c, 0x64,
+	0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x32,
+	0x0a, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x52, 0x08, 0x6d, 0x61, 0x6e, 0x69, 0x66, 0x65,
+	0x73, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x63, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x22, 0xda, 0x01, 0x0a, 0x0e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4d, 0x61,
+	0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x69, 0x70, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x69,
+	0x70, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x76, 0x7a, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x76, 0x7a, 0x49, 0x64, 0x12, 0x1d,
+	0x0a, 0x0a, 0x63, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x72, 0x69, 0x65, 0x72, 0x49, 0x64, 0x12, 0x30, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70,
+	0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4d, 0x61, 0x6e, 0x69,
+	0x66, 0x65, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12,
+	0x3f, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0xd8, 0x03, 0x0a, 0x12, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4d, 0x61, 0x6e, 0x69, 0x66,
+	0x65, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x26, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x14, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0c, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63,
+	0x6f, 0x6d, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2a, 0x0a, 0x0e, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3b, 0x0a, 0x0b, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0xe2, 0x0c, 0x0a, 0x08,
+	0x50, 0x56, 0x5a, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x15, 0x0a, 0x06, 0x70, 0x76, 0x7a, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x76, 0x7a, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x63, 0x6f, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x33, 0x0a, 0x09, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x15, 0x2e, 0x70,
+	0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x09, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x27,
+	0x0a, 0x0f, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x5f, 0x66, 0x69, 0x6c,
+	0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x61, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x61, 0x6c, 0x46, 0x69, 0x6c, 0x6d, 0x12, 0x3b, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x48, 0x00, 0x52, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x40, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x48, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x13, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0b, 0x65, 0x78, 0x74, 0x65,
+	0x6e, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
+	0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x42, 0x79, 0x88, 0x01, 0x01, 0x12, 0x25,
+	0x0a, 0x0e, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e,
+	0x67, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x12, 0x32, 0x0a, 0x0a, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69,
+	0x6d, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0a, 0x64, 0x69, 0x6d, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x10, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x57, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x31, 0x0a, 0x0a, 0x63, 0x6f, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x6e, 0x65, 0x79, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74,
+	0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x09, 0x63, 0x6f, 0x73, 0x74, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64,
+	0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x6d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x64, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2d, 0x0a,
+	0x12, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61,
+	0x6e, 0x63, 0x79, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x12, 0x39, 0x0a, 0x16,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x5f,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x14,
+	0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x48, 0x0a, 0x12, 0x70, 0x61, 0x69, 0x64, 0x5f,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x18, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x0a, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3c, 0x0a, 0x0c, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x69, 0x73, 0x73,
-	0x75, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x08, 0x69, 0x73, 0x73, 0x75,
-	0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x72, 0x65, 0x74, 0x75, 0x72,
-	0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x73,
-	0x73, 0x75, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x74, 0x75,
-	0x72, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x2a, 0x38, 0x0a, 0x0d, 0x50, 0x61, 0x63, 0x6b, 0x61,
-	0x67, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e,
-	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x4f, 0x58, 0x10, 0x01, 0x12, 0x07,
-	0x0a, 0x03, 0x42, 0x41, 0x47, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x49, 0x4c, 0x4d, 0x10,
-	0x03, 0x32, 0xd7, 0x05, 0x0a, 0x0a, 0x50, 0x76, 0x7a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x83, 0x01, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
-	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x22, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c,
-	0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x01, 0x2a, 0x22,
-	0x25, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x76, 0x7a, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x2f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x2d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2d, 0x64, 0x65,
-	0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x83, 0x01, 0x0a, 0x13, 0x52, 0x65, 0x74, 0x75, 0x72,
-	0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x22,
-	0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x72,
-	0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x2a, 0x3a, 0x01, 0x2a, 0x22, 0x25, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x76, 0x7a, 0x2d, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x2d, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x2d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x7e, 0x0a, 0x11,
+	0x10, 0x70, 0x61, 0x69, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x55, 0x6e, 0x74, 0x69,
+	0x6c, 0x12, 0x3e, 0x0a, 0x11, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79,
+	0x52, 0x0f, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x65,
+	0x65, 0x12, 0x33, 0x0a, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x66, 0x65, 0x65,
+	0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0a, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x46, 0x65, 0x65, 0x12, 0x3c, 0x0a, 0x10, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0e, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x46, 0x65, 0x65,
+	0x50, 0x61, 0x69, 0x64, 0x12, 0x48, 0x0a, 0x0f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64,
+	0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x39,
+	0x0a, 0x0d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x1d, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x0c, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x69, 0x6e, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x0e, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x63,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x0d,
+	0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x88, 0x01, 0x01,
+	0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x42, 0x19,
+	0x0a, 0x17, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x72, 0x65,
+	0x74, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x42, 0x11, 0x0a,
+	0x0f, 0x5f, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x2a, 0x38, 0x0a, 0x0d, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x07,
+	0x0a, 0x03, 0x42, 0x4f, 0x58, 0x10, 0x01, 0x12, 0x07, 0x0a, 0x03, 0x42, 0x41, 0x47, 0x10, 0x02,
+	0x12, 0x08, 0x0a, 0x04, 0x46, 0x49, 0x4c, 0x4d, 0x10, 0x03, 0x2a, 0x58, 0x0a, 0x0b, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x53, 0x53,
+	0x55, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x49,
+	0x53, 0x53, 0x55, 0x45, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x46, 0x55,
+	0x53, 0x45, 0x10, 0x02, 0x2a, 0x81, 0x02, 0x0a, 0x0b, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x19,
+	0x0a, 0x15, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41,
+	0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x52, 0x44,
+	0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x43,
+	0x4c, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x45, 0x44,
+	0x5f, 0x54, 0x4f, 0x5f, 0x43, 0x4f, 0x55, 0x52, 0x49, 0x45, 0x52, 0x10, 0x04, 0x12, 0x18, 0x0a,
+	0x14, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45, 0x58,
+	0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x52, 0x44, 0x45, 0x52,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x46, 0x55, 0x53, 0x45, 0x44, 0x10,
+	0x06, 0x12, 0x25, 0x0a, 0x21, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x44, 0x49, 0x53, 0x50, 0x41, 0x54, 0x43, 0x48, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x5f,
+	0x53, 0x45, 0x4c, 0x4c, 0x45, 0x52, 0x10, 0x07, 0x2a, 0xbe, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x15, 0x52, 0x45, 0x54,
+	0x55, 0x52, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x5f, 0x52,
+	0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x43, 0x54, 0x10, 0x01, 0x12, 0x1c,
+	0x0a, 0x18, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f,
+	0x57, 0x52, 0x4f, 0x4e, 0x47, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e,
+	0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x41, 0x53, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x52, 0x49, 0x42, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x44, 0x5f, 0x4d, 0x49, 0x4e, 0x44, 0x10, 0x04,
+	0x12, 0x17, 0x0a, 0x13, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x5f, 0x52, 0x45, 0x41, 0x53, 0x4f,
+	0x4e, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x05, 0x2a, 0x99, 0x01, 0x0a, 0x11, 0x49, 0x6e,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x63, 0x6f, 0x6d, 0x65, 0x12,
+	0x1e, 0x0a, 0x1a, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x55,
+	0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x21, 0x0a, 0x1d, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x55,
+	0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x52, 0x45, 0x53, 0x45, 0x4c, 0x4c, 0x41, 0x42, 0x4c, 0x45,
+	0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x44, 0x41, 0x4d, 0x41, 0x47, 0x45, 0x44,
+	0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x49, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4f, 0x55, 0x54, 0x43, 0x4f, 0x4d, 0x45, 0x5f, 0x49, 0x4e, 0x43, 0x4f, 0x4d, 0x50, 0x4c,
+	0x45, 0x54, 0x45, 0x10, 0x03, 0x2a, 0x82, 0x01, 0x0a, 0x15, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76,
+	0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x23, 0x0a, 0x1f, 0x48, 0x41, 0x4e, 0x44, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x53, 0x53,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x48, 0x41, 0x4e, 0x44, 0x4f, 0x56, 0x45, 0x52,
+	0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x48, 0x41, 0x4e, 0x44, 0x4f, 0x56,
+	0x45, 0x52, 0x5f, 0x53, 0x45, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xee, 0x01, 0x0a, 0x12, 0x48,
+	0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x63, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x20, 0x0a, 0x1c, 0x48, 0x41, 0x4e, 0x44, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x53, 0x43,
+	0x41, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x48, 0x41, 0x4e, 0x44, 0x4f, 0x56, 0x45, 0x52, 0x5f,
+	0x53, 0x43, 0x41, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x4d, 0x41, 0x54, 0x43,
+	0x48, 0x45, 0x44, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x48, 0x41, 0x4e, 0x44, 0x4f, 0x56, 0x45,
+	0x52, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x55, 0x4e,
+	0x45, 0x58, 0x50, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x28, 0x0a, 0x24, 0x48, 0x41,
+	0x4e, 0x44, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x55,
+	0x4c, 0x54, 0x5f, 0x57, 0x45, 0x49, 0x47, 0x48, 0x54, 0x5f, 0x4d, 0x49, 0x53, 0x4d, 0x41, 0x54,
+	0x43, 0x48, 0x10, 0x03, 0x12, 0x22, 0x0a, 0x1e, 0x48, 0x41, 0x4e, 0x44, 0x4f, 0x56, 0x45, 0x52,
+	0x5f, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x44, 0x55, 0x50,
+	0x4c, 0x49, 0x43, 0x41, 0x54, 0x45, 0x10, 0x04, 0x12, 0x21, 0x0a, 0x1d, 0x48, 0x41, 0x4e, 0x44,
+	0x4f, 0x56, 0x45, 0x52, 0x5f, 0x53, 0x43, 0x41, 0x4e, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54,
+	0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x82, 0x01, 0x0a, 0x14,
+	0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x1e, 0x52, 0x45, 0x54, 0x55, 0x52, 0x4e, 0x5f, 0x53,
+	0x48, 0x49, 0x50, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x52, 0x45, 0x54, 0x55,
+	0x52, 0x4e, 0x5f, 0x53, 0x48, 0x49, 0x50, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x52, 0x45, 0x54,
+	0x55, 0x52, 0x4e, 0x5f, 0x53, 0x48, 0x49, 0x50, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x44, 0x49, 0x53, 0x50, 0x41, 0x54, 0x43, 0x48, 0x45, 0x44, 0x10, 0x02,
+	0x32, 0xa9, 0x15, 0x0a, 0x0a, 0x50, 0x76, 0x7a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x90, 0x01, 0x0a, 0x13, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x22, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x76,
+	0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x01, 0x2a, 0x22, 0x25, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x76, 0x7a, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x61, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x2d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x12, 0xa5, 0x01, 0x0a, 0x18, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12,
+	0x27, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x63,
+	0x63, 0x65, 0x70, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x3a, 0x01, 0x2a, 0x22, 0x2b, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x76, 0x7a, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x2d, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x2d, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x2d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x12, 0x83, 0x01, 0x0a, 0x13, 0x52,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x12, 0x22, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x30,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x01, 0x2a, 0x22, 0x25, 0x2f, 0x76, 0x31, 0x2f, 0x70,
+	0x76, 0x7a, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x72, 0x65, 0x74, 0x75, 0x72,
+	0x6e, 0x2d, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x2d, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x12, 0x89, 0x01, 0x0a, 0x11, 0x47, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x6f,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e,
 	0x47, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65, 0x6e,
-	0x74, 0x12, 0x20, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x69, 0x76, 0x65, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x54, 0x6f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x2f, 0x82, 0xd3, 0xe4,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x69, 0x76, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x54, 0x6f, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4,
 	0x93, 0x02, 0x29, 0x3a, 0x01, 0x2a, 0x22, 0x24, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x76, 0x7a, 0x2d,
 	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x67, 0x69, 0x76, 0x65, 0x2d, 0x6f, 0x72, 0x64,
 	0x65, 0x72, 0x2d, 0x74, 0x6f, 0x2d, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x64, 0x0a, 0x09,
@@ -832,14 +4923,127 @@ var file_pvz_service_v1_pvz_service_proto_rawDesc = []byte{
 	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x52, 0x65,
 	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b,
 	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x76, 0x7a, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f,
-	0x67, 0x65, 0x74, 0x2d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x42, 0x6a, 0x92, 0x41, 0x4e,
-	0x12, 0x25, 0x0a, 0x0b, 0x50, 0x56, 0x5a, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x0f, 0x50, 0x56, 0x5a, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x41, 0x50, 0x49,
-	0x32, 0x05, 0x31, 0x2e, 0x30, 0x2e, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61, 0x70,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x5a, 0x17,
-	0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x76, 0x7a,
-	0x2f, 0x76, 0x31, 0x3b, 0x70, 0x76, 0x7a, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x67, 0x65, 0x74, 0x2d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x12, 0x7d, 0x0a, 0x0f, 0x47,
+	0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1e,
+	0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
+	0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x76, 0x7a,
+	0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x2d, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x70, 0x0a, 0x0d, 0x45, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x76,
+	0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x64, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x29, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x3a, 0x01, 0x2a, 0x22, 0x1e, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x76, 0x7a, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x65, 0x78,
+	0x74, 0x65, 0x6e, 0x64, 0x2d, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x78, 0x0a, 0x0e,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x12, 0x1d,
+	0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x50, 0x61, 0x63, 0x6b,
+	0x61, 0x67, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x76, 0x7a, 0x2d, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x2d, 0x70, 0x61, 0x63,
+	0x6b, 0x61, 0x67, 0x69, 0x6e, 0x67, 0x12, 0xaa, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x57, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x29, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61,
+	0x6e, 0x63, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2a, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x44, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x2f, 0x12, 0x2d, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x76, 0x7a, 0x2d, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x2d, 0x64, 0x69, 0x73, 0x63, 0x72, 0x65, 0x70, 0x61, 0x6e, 0x63, 0x79, 0x2d, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x90, 0x01, 0x0a, 0x13, 0x4f, 0x70, 0x65, 0x6e, 0x48, 0x61, 0x6e, 0x64,
+	0x6f, 0x76, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x70, 0x76,
+	0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65,
+	0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x23, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x48, 0x61, 0x6e,
+	0x64, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x3a, 0x01, 0x2a, 0x22,
+	0x25, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x76, 0x7a, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x2d, 0x68, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x2d, 0x73,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x8c, 0x01, 0x0a, 0x12, 0x53, 0x63, 0x61, 0x6e, 0x48,
+	0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x12, 0x21, 0x2e,
+	0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x48, 0x61, 0x6e, 0x64, 0x6f,
+	0x76, 0x65, 0x72, 0x50, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x61, 0x6e, 0x48, 0x61,
+	0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x50, 0x61, 0x72, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x3a, 0x01, 0x2a, 0x22,
+	0x24, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x76, 0x7a, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2f, 0x73, 0x63, 0x61, 0x6e, 0x2d, 0x68, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x2d, 0x70,
+	0x61, 0x72, 0x63, 0x65, 0x6c, 0x12, 0x94, 0x01, 0x0a, 0x14, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x48,
+	0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x23,
+	0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x48, 0x61, 0x6e,
+	0x64, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f,
+	0x73, 0x65, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2b, 0x3a, 0x01, 0x2a, 0x22, 0x26, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x76, 0x7a, 0x2d, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x2d, 0x68, 0x61, 0x6e, 0x64,
+	0x6f, 0x76, 0x65, 0x72, 0x2d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x89, 0x01, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x48, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x26, 0x12, 0x24, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x76, 0x7a, 0x2d, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x68, 0x61, 0x6e, 0x64, 0x6f, 0x76, 0x65, 0x72,
+	0x2d, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x71, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x50,
+	0x56, 0x5a, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1b, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76,
+	0x31, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x56, 0x5a, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x50, 0x56, 0x5a, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x76, 0x7a, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x67, 0x65, 0x74,
+	0x2d, 0x70, 0x76, 0x7a, 0x2d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x80, 0x01, 0x0a, 0x0f,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x56, 0x5a, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12,
+	0x1e, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x56, 0x5a, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x56, 0x5a, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x3a, 0x01, 0x2a, 0x22, 0x21, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x76, 0x7a, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x2d, 0x70, 0x76, 0x7a, 0x2d, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x94,
+	0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53,
+	0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x68, 0x69,
+	0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70,
+	0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x31, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2b, 0x3a, 0x01, 0x2a, 0x22, 0x26, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x76, 0x7a, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x2d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x2d, 0x73, 0x68, 0x69,
+	0x70, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x9c, 0x01, 0x0a, 0x16, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x25, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x76, 0x7a, 0x2e, 0x76, 0x31,
+	0x2e, 0x44, 0x69, 0x73, 0x70, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53,
+	0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x3a, 0x01, 0x2a, 0x22, 0x28, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x76, 0x7a, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x64, 0x69, 0x73, 0x70,
+	0x61, 0x74, 0x63, 0x68, 0x2d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x2d, 0x73, 0x68, 0x69, 0x70,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x85, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x53, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x2e, 0x70, 0x76, 0x7a,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53, 0x68, 0x69,
+	0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70,
+	0x76, 0x7a, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x53,
+	0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x12, 0x23, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x76, 0x7a,
+	0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x67, 0x65, 0x74, 0x2d, 0x72, 0x65, 0x74,
+	0x75, 0x72, 0x6e, 0x2d, 0x73, 0x68, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6a, 0x92, 0x41,
+	0x4e, 0x12, 0x25, 0x0a, 0x0b, 0x50, 0x56, 0x5a, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x0f, 0x50, 0x56, 0x5a, 0x20, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x41, 0x50,
+	0x49, 0x32, 0x05, 0x31, 0x2e, 0x30, 0x2e, 0x30, 0x2a, 0x01, 0x01, 0x32, 0x10, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x10, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x5a,
+	0x17, 0x68, 0x6f, 0x6d, 0x65, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x70, 0x76,
+	0x7a, 0x2f, 0x76, 0x31, 0x3b, 0x70, 0x76, 0x7a, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -854,50 +5058,203 @@ func file_pvz_service_v1_pvz_service_proto_rawDescGZIP() []byte {
 	return file_pvz_service_v1_pvz_service_proto_rawDescData
 }
 
-var file_pvz_service_v1_pvz_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pvz_service_v1_pvz_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_pvz_service_v1_pvz_service_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
+var file_pvz_service_v1_pvz_service_proto_msgTypes = make([]protoimpl.MessageInfo, 53)
 var file_pvz_service_v1_pvz_service_proto_goTypes = []any{
-	(PackagingType)(0),                 // 0: pvz.v1.PackagingType
-	(*AcceptOrderDeliveryRequest)(nil), // 1: pvz.v1.AcceptOrderDeliveryRequest
-	(*ReturnOrderDeliveryRequest)(nil), // 2: pvz.v1.ReturnOrderDeliveryRequest
-	(*GiveOrderToClientRequest)(nil),   // 3: pvz.v1.GiveOrderToClientRequest
-	(*GetOrdersRequest)(nil),           // 4: pvz.v1.GetOrdersRequest
-	(*GetOrdersResponse)(nil),          // 5: pvz.v1.GetOrdersResponse
-	(*AcceptReturnRequest)(nil),        // 6: pvz.v1.AcceptReturnRequest
-	(*GetReturnsRequest)(nil),          // 7: pvz.v1.GetReturnsRequest
-	(*GetReturnsResponse)(nil),         // 8: pvz.v1.GetReturnsResponse
-	(*PVZOrder)(nil),                   // 9: pvz.v1.PVZOrder
-	(*durationpb.Duration)(nil),        // 10: google.protobuf.Duration
-	(*timestamppb.Timestamp)(nil),      // 11: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),              // 12: google.protobuf.Empty
+	(PackagingType)(0),                         // 0: pvz.v1.PackagingType
+	(IssueAction)(0),                           // 1: pvz.v1.IssueAction
+	(OrderStatus)(0),                           // 2: pvz.v1.OrderStatus
+	(ReturnReason)(0),                          // 3: pvz.v1.ReturnReason
+	(InspectionOutcome)(0),                     // 4: pvz.v1.InspectionOutcome
+	(HandoverSessionStatus)(0),                 // 5: pvz.v1.HandoverSessionStatus
+	(HandoverScanResult)(0),                    // 6: pvz.v1.HandoverScanResult
+	(ReturnShipmentStatus)(0),                  // 7: pvz.v1.ReturnShipmentStatus
+	(*AcceptOrderDeliveryRequest)(nil),         // 8: pvz.v1.AcceptOrderDeliveryRequest
+	(*Dimensions)(nil),                         // 9: pvz.v1.Dimensions
+	(*BatchAcceptOrderDeliveryRequest)(nil),    // 10: pvz.v1.BatchAcceptOrderDeliveryRequest
+	(*BatchAcceptOrderDeliveryResponse)(nil),   // 11: pvz.v1.BatchAcceptOrderDeliveryResponse
+	(*AcceptOrderDeliveryResult)(nil),          // 12: pvz.v1.AcceptOrderDeliveryResult
+	(*ReturnOrderDeliveryRequest)(nil),         // 13: pvz.v1.ReturnOrderDeliveryRequest
+	(*GiveOrderToClientRequest)(nil),           // 14: pvz.v1.GiveOrderToClientRequest
+	(*IssueDecision)(nil),                      // 15: pvz.v1.IssueDecision
+	(*GiveOrderToClientResponse)(nil),          // 16: pvz.v1.GiveOrderToClientResponse
+	(*IssueResult)(nil),                        // 17: pvz.v1.IssueResult
+	(*GetOrdersRequest)(nil),                   // 18: pvz.v1.GetOrdersRequest
+	(*GetOrdersResponse)(nil),                  // 19: pvz.v1.GetOrdersResponse
+	(*AcceptReturnRequest)(nil),                // 20: pvz.v1.AcceptReturnRequest
+	(*GetReturnsRequest)(nil),                  // 21: pvz.v1.GetReturnsRequest
+	(*GetReturnsResponse)(nil),                 // 22: pvz.v1.GetReturnsResponse
+	(*GetOrderHistoryRequest)(nil),             // 23: pvz.v1.GetOrderHistoryRequest
+	(*GetOrderHistoryResponse)(nil),            // 24: pvz.v1.GetOrderHistoryResponse
+	(*OrderEvent)(nil),                         // 25: pvz.v1.OrderEvent
+	(*ExtendStorageRequest)(nil),               // 26: pvz.v1.ExtendStorageRequest
+	(*AcceptOrderDeliveryResponse)(nil),        // 27: pvz.v1.AcceptOrderDeliveryResponse
+	(*GetWeightDiscrepancyReportRequest)(nil),  // 28: pvz.v1.GetWeightDiscrepancyReportRequest
+	(*GetWeightDiscrepancyReportResponse)(nil), // 29: pvz.v1.GetWeightDiscrepancyReportResponse
+	(*QuotePackagingRequest)(nil),              // 30: pvz.v1.QuotePackagingRequest
+	(*QuotePackagingResponse)(nil),             // 31: pvz.v1.QuotePackagingResponse
+	(*PackagingQuote)(nil),                     // 32: pvz.v1.PackagingQuote
+	(*OpenHandoverSessionRequest)(nil),         // 33: pvz.v1.OpenHandoverSessionRequest
+	(*OpenHandoverSessionResponse)(nil),        // 34: pvz.v1.OpenHandoverSessionResponse
+	(*ScanHandoverParcelRequest)(nil),          // 35: pvz.v1.ScanHandoverParcelRequest
+	(*ScanHandoverParcelResponse)(nil),         // 36: pvz.v1.ScanHandoverParcelResponse
+	(*CloseHandoverSessionRequest)(nil),        // 37: pvz.v1.CloseHandoverSessionRequest
+	(*CloseHandoverSessionResponse)(nil),       // 38: pvz.v1.CloseHandoverSessionResponse
+	(*GetHandoverSessionRequest)(nil),          // 39: pvz.v1.GetHandoverSessionRequest
+	(*GetHandoverSessionResponse)(nil),         // 40: pvz.v1.GetHandoverSessionResponse
+	(*HandoverSession)(nil),                    // 41: pvz.v1.HandoverSession
+	(*HandoverScan)(nil),                       // 42: pvz.v1.HandoverScan
+	(*HandoverReport)(nil),                     // 43: pvz.v1.HandoverReport
+	(*HandoverWeightMismatch)(nil),             // 44: pvz.v1.HandoverWeightMismatch
+	(*HandoverRejection)(nil),                  // 45: pvz.v1.HandoverRejection
+	(*GetPVZPolicyRequest)(nil),                // 46: pvz.v1.GetPVZPolicyRequest
+	(*GetPVZPolicyResponse)(nil),               // 47: pvz.v1.GetPVZPolicyResponse
+	(*UpdatePVZPolicyRequest)(nil),             // 48: pvz.v1.UpdatePVZPolicyRequest
+	(*UpdatePVZPolicyResponse)(nil),            // 49: pvz.v1.UpdatePVZPolicyResponse
+	(*PVZPolicy)(nil),                          // 50: pvz.v1.PVZPolicy
+	(*CreateReturnShipmentRequest)(nil),        // 51: pvz.v1.CreateReturnShipmentRequest
+	(*CreateReturnShipmentResponse)(nil),       // 52: pvz.v1.CreateReturnShipmentResponse
+	(*DispatchReturnShipmentRequest)(nil),      // 53: pvz.v1.DispatchReturnShipmentRequest
+	(*DispatchReturnShipmentResponse)(nil),     // 54: pvz.v1.DispatchReturnShipmentResponse
+	(*GetReturnShipmentRequest)(nil),           // 55: pvz.v1.GetReturnShipmentRequest
+	(*GetReturnShipmentResponse)(nil),          // 56: pvz.v1.GetReturnShipmentResponse
+	(*ReturnShipment)(nil),                     // 57: pvz.v1.ReturnShipment
+	(*ReturnManifest)(nil),                     // 58: pvz.v1.ReturnManifest
+	(*ReturnManifestItem)(nil),                 // 59: pvz.v1.ReturnManifestItem
+	(*PVZOrder)(nil),                           // 60: pvz.v1.PVZOrder
+	(*durationpb.Duration)(nil),                // 61: google.protobuf.Duration
+	(*money.Money)(nil),                        // 62: google.type.Money
+	(*structpb.Struct)(nil),                    // 63: google.protobuf.Struct
+	(*timestamppb.Timestamp)(nil),              // 64: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                      // 65: google.protobuf.Empty
 }
 var file_pvz_service_v1_pvz_service_proto_depIdxs = []int32{
-	10, // 0: pvz.v1.AcceptOrderDeliveryRequest.storage_time:type_name -> google.protobuf.Duration
-	0,  // 1: pvz.v1.AcceptOrderDeliveryRequest.packaging:type_name -> pvz.v1.PackagingType
-	9,  // 2: pvz.v1.GetOrdersResponse.orders:type_name -> pvz.v1.PVZOrder
-	9,  // 3: pvz.v1.GetReturnsResponse.returns:type_name -> pvz.v1.PVZOrder
-	0,  // 4: pvz.v1.PVZOrder.packaging:type_name -> pvz.v1.PackagingType
-	11, // 5: pvz.v1.PVZOrder.received_at:type_name -> google.protobuf.Timestamp
-	10, // 6: pvz.v1.PVZOrder.storage_time:type_name -> google.protobuf.Duration
-	11, // 7: pvz.v1.PVZOrder.issued_at:type_name -> google.protobuf.Timestamp
-	11, // 8: pvz.v1.PVZOrder.returned_at:type_name -> google.protobuf.Timestamp
-	1,  // 9: pvz.v1.PvzService.AcceptOrderDelivery:input_type -> pvz.v1.AcceptOrderDeliveryRequest
-	2,  // 10: pvz.v1.PvzService.ReturnOrderDelivery:input_type -> pvz.v1.ReturnOrderDeliveryRequest
-	3,  // 11: pvz.v1.PvzService.GiveOrderToClient:input_type -> pvz.v1.GiveOrderToClientRequest
-	4,  // 12: pvz.v1.PvzService.GetOrders:input_type -> pvz.v1.GetOrdersRequest
-	6,  // 13: pvz.v1.PvzService.AcceptReturn:input_type -> pvz.v1.AcceptReturnRequest
-	7,  // 14: pvz.v1.PvzService.GetReturns:input_type -> pvz.v1.GetReturnsRequest
-	12, // 15: pvz.v1.PvzService.AcceptOrderDelivery:output_type -> google.protobuf.Empty
-	12, // 16: pvz.v1.PvzService.ReturnOrderDelivery:output_type -> google.protobuf.Empty
-	12, // 17: pvz.v1.PvzService.GiveOrderToClient:output_type -> google.protobuf.Empty
-	5,  // 18: pvz.v1.PvzService.GetOrders:output_type -> pvz.v1.GetOrdersResponse
-	12, // 19: pvz.v1.PvzService.AcceptReturn:output_type -> google.protobuf.Empty
-	8,  // 20: pvz.v1.PvzService.GetReturns:output_type -> pvz.v1.GetReturnsResponse
-	15, // [15:21] is the sub-list for method output_type
-	9,  // [9:15] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	61,  // 0: pvz.v1.AcceptOrderDeliveryRequest.storage_time:type_name -> google.protobuf.Duration
+	0,   // 1: pvz.v1.AcceptOrderDeliveryRequest.packaging:type_name -> pvz.v1.PackagingType
+	9,   // 2: pvz.v1.AcceptOrderDeliveryRequest.dimensions:type_name -> pvz.v1.Dimensions
+	62,  // 3: pvz.v1.AcceptOrderDeliveryRequest.cost_money:type_name -> google.type.Money
+	8,   // 4: pvz.v1.BatchAcceptOrderDeliveryRequest.items:type_name -> pvz.v1.AcceptOrderDeliveryRequest
+	12,  // 5: pvz.v1.BatchAcceptOrderDeliveryResponse.results:type_name -> pvz.v1.AcceptOrderDeliveryResult
+	60,  // 6: pvz.v1.AcceptOrderDeliveryResult.order:type_name -> pvz.v1.PVZOrder
+	15,  // 7: pvz.v1.GiveOrderToClientRequest.decisions:type_name -> pvz.v1.IssueDecision
+	1,   // 8: pvz.v1.IssueDecision.action:type_name -> pvz.v1.IssueAction
+	62,  // 9: pvz.v1.IssueDecision.storage_fee_paid:type_name -> google.type.Money
+	17,  // 10: pvz.v1.GiveOrderToClientResponse.results:type_name -> pvz.v1.IssueResult
+	1,   // 11: pvz.v1.IssueResult.action:type_name -> pvz.v1.IssueAction
+	2,   // 12: pvz.v1.GetOrdersRequest.statuses:type_name -> pvz.v1.OrderStatus
+	60,  // 13: pvz.v1.GetOrdersResponse.orders:type_name -> pvz.v1.PVZOrder
+	3,   // 14: pvz.v1.AcceptReturnRequest.reason:type_name -> pvz.v1.ReturnReason
+	4,   // 15: pvz.v1.AcceptReturnRequest.inspection:type_name -> pvz.v1.InspectionOutcome
+	3,   // 16: pvz.v1.GetReturnsRequest.reasons:type_name -> pvz.v1.ReturnReason
+	4,   // 17: pvz.v1.GetReturnsRequest.inspections:type_name -> pvz.v1.InspectionOutcome
+	60,  // 18: pvz.v1.GetReturnsResponse.returns:type_name -> pvz.v1.PVZOrder
+	25,  // 19: pvz.v1.GetOrderHistoryResponse.events:type_name -> pvz.v1.OrderEvent
+	63,  // 20: pvz.v1.OrderEvent.payload:type_name -> google.protobuf.Struct
+	64,  // 21: pvz.v1.OrderEvent.created_at:type_name -> google.protobuf.Timestamp
+	64,  // 22: pvz.v1.OrderEvent.sent_at:type_name -> google.protobuf.Timestamp
+	61,  // 23: pvz.v1.ExtendStorageRequest.extension:type_name -> google.protobuf.Duration
+	60,  // 24: pvz.v1.AcceptOrderDeliveryResponse.order:type_name -> pvz.v1.PVZOrder
+	60,  // 25: pvz.v1.GetWeightDiscrepancyReportResponse.orders:type_name -> pvz.v1.PVZOrder
+	9,   // 26: pvz.v1.QuotePackagingRequest.dimensions:type_name -> pvz.v1.Dimensions
+	62,  // 27: pvz.v1.QuotePackagingRequest.cost_money:type_name -> google.type.Money
+	32,  // 28: pvz.v1.QuotePackagingResponse.quotes:type_name -> pvz.v1.PackagingQuote
+	62,  // 29: pvz.v1.PackagingQuote.price_money:type_name -> google.type.Money
+	8,   // 30: pvz.v1.OpenHandoverSessionRequest.expected:type_name -> pvz.v1.AcceptOrderDeliveryRequest
+	41,  // 31: pvz.v1.OpenHandoverSessionResponse.session:type_name -> pvz.v1.HandoverSession
+	42,  // 32: pvz.v1.ScanHandoverParcelResponse.scan:type_name -> pvz.v1.HandoverScan
+	43,  // 33: pvz.v1.CloseHandoverSessionResponse.report:type_name -> pvz.v1.HandoverReport
+	41,  // 34: pvz.v1.GetHandoverSessionResponse.session:type_name -> pvz.v1.HandoverSession
+	5,   // 35: pvz.v1.HandoverSession.status:type_name -> pvz.v1.HandoverSessionStatus
+	42,  // 36: pvz.v1.HandoverSession.scans:type_name -> pvz.v1.HandoverScan
+	64,  // 37: pvz.v1.HandoverSession.opened_at:type_name -> google.protobuf.Timestamp
+	64,  // 38: pvz.v1.HandoverSession.closed_at:type_name -> google.protobuf.Timestamp
+	43,  // 39: pvz.v1.HandoverSession.report:type_name -> pvz.v1.HandoverReport
+	6,   // 40: pvz.v1.HandoverScan.result:type_name -> pvz.v1.HandoverScanResult
+	64,  // 41: pvz.v1.HandoverScan.scanned_at:type_name -> google.protobuf.Timestamp
+	44,  // 42: pvz.v1.HandoverReport.weight_mismatches:type_name -> pvz.v1.HandoverWeightMismatch
+	45,  // 43: pvz.v1.HandoverReport.rejected:type_name -> pvz.v1.HandoverRejection
+	50,  // 44: pvz.v1.GetPVZPolicyResponse.policy:type_name -> pvz.v1.PVZPolicy
+	61,  // 45: pvz.v1.UpdatePVZPolicyRequest.return_window:type_name -> google.protobuf.Duration
+	61,  // 46: pvz.v1.UpdatePVZPolicyRequest.max_storage_time:type_name -> google.protobuf.Duration
+	50,  // 47: pvz.v1.UpdatePVZPolicyResponse.policy:type_name -> pvz.v1.PVZPolicy
+	61,  // 48: pvz.v1.PVZPolicy.return_window:type_name -> google.protobuf.Duration
+	61,  // 49: pvz.v1.PVZPolicy.max_storage_time:type_name -> google.protobuf.Duration
+	64,  // 50: pvz.v1.PVZPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	57,  // 51: pvz.v1.CreateReturnShipmentResponse.shipment:type_name -> pvz.v1.ReturnShipment
+	58,  // 52: pvz.v1.DispatchReturnShipmentResponse.manifest:type_name -> pvz.v1.ReturnManifest
+	57,  // 53: pvz.v1.GetReturnShipmentResponse.shipment:type_name -> pvz.v1.ReturnShipment
+	7,   // 54: pvz.v1.ReturnShipment.status:type_name -> pvz.v1.ReturnShipmentStatus
+	64,  // 55: pvz.v1.ReturnShipment.created_at:type_name -> google.protobuf.Timestamp
+	64,  // 56: pvz.v1.ReturnShipment.dispatched_at:type_name -> google.protobuf.Timestamp
+	58,  // 57: pvz.v1.ReturnShipment.manifest:type_name -> pvz.v1.ReturnManifest
+	59,  // 58: pvz.v1.ReturnManifest.items:type_name -> pvz.v1.ReturnManifestItem
+	64,  // 59: pvz.v1.ReturnManifest.dispatched_at:type_name -> google.protobuf.Timestamp
+	2,   // 60: pvz.v1.ReturnManifestItem.status:type_name -> pvz.v1.OrderStatus
+	62,  // 61: pvz.v1.ReturnManifestItem.cost:type_name -> google.type.Money
+	3,   // 62: pvz.v1.ReturnManifestItem.return_reason:type_name -> pvz.v1.ReturnReason
+	4,   // 63: pvz.v1.ReturnManifestItem.inspection:type_name -> pvz.v1.InspectionOutcome
+	64,  // 64: pvz.v1.ReturnManifestItem.returned_at:type_name -> google.protobuf.Timestamp
+	0,   // 65: pvz.v1.PVZOrder.packaging:type_name -> pvz.v1.PackagingType
+	64,  // 66: pvz.v1.PVZOrder.received_at:type_name -> google.protobuf.Timestamp
+	61,  // 67: pvz.v1.PVZOrder.storage_time:type_name -> google.protobuf.Duration
+	64,  // 68: pvz.v1.PVZOrder.issued_at:type_name -> google.protobuf.Timestamp
+	64,  // 69: pvz.v1.PVZOrder.returned_at:type_name -> google.protobuf.Timestamp
+	2,   // 70: pvz.v1.PVZOrder.status:type_name -> pvz.v1.OrderStatus
+	64,  // 71: pvz.v1.PVZOrder.expires_at:type_name -> google.protobuf.Timestamp
+	9,   // 72: pvz.v1.PVZOrder.dimensions:type_name -> pvz.v1.Dimensions
+	62,  // 73: pvz.v1.PVZOrder.cost_money:type_name -> google.type.Money
+	64,  // 74: pvz.v1.PVZOrder.paid_storage_until:type_name -> google.protobuf.Timestamp
+	62,  // 75: pvz.v1.PVZOrder.daily_storage_fee:type_name -> google.type.Money
+	62,  // 76: pvz.v1.PVZOrder.storage_fee:type_name -> google.type.Money
+	62,  // 77: pvz.v1.PVZOrder.storage_fee_paid:type_name -> google.type.Money
+	64,  // 78: pvz.v1.PVZOrder.return_deadline:type_name -> google.protobuf.Timestamp
+	3,   // 79: pvz.v1.PVZOrder.return_reason:type_name -> pvz.v1.ReturnReason
+	4,   // 80: pvz.v1.PVZOrder.inspection:type_name -> pvz.v1.InspectionOutcome
+	8,   // 81: pvz.v1.PvzService.AcceptOrderDelivery:input_type -> pvz.v1.AcceptOrderDeliveryRequest
+	10,  // 82: pvz.v1.PvzService.BatchAcceptOrderDelivery:input_type -> pvz.v1.BatchAcceptOrderDeliveryRequest
+	13,  // 83: pvz.v1.PvzService.ReturnOrderDelivery:input_type -> pvz.v1.ReturnOrderDeliveryRequest
+	14,  // 84: pvz.v1.PvzService.GiveOrderToClient:input_type -> pvz.v1.GiveOrderToClientRequest
+	18,  // 85: pvz.v1.PvzService.GetOrders:input_type -> pvz.v1.GetOrdersRequest
+	20,  // 86: pvz.v1.PvzService.AcceptReturn:input_type -> pvz.v1.AcceptReturnRequest
+	21,  // 87: pvz.v1.PvzService.GetReturns:input_type -> pvz.v1.GetReturnsRequest
+	23,  // 88: pvz.v1.PvzService.GetOrderHistory:input_type -> pvz.v1.GetOrderHistoryRequest
+	26,  // 89: pvz.v1.PvzService.ExtendStorage:input_type -> pvz.v1.ExtendStorageRequest
+	30,  // 90: pvz.v1.PvzService.QuotePackaging:input_type -> pvz.v1.QuotePackagingRequest
+	28,  // 91: pvz.v1.PvzService.GetWeightDiscrepancyReport:input_type -> pvz.v1.GetWeightDiscrepancyReportRequest
+	33,  // 92: pvz.v1.PvzService.OpenHandoverSession:input_type -> pvz.v1.OpenHandoverSessionRequest
+	35,  // 93: pvz.v1.PvzService.ScanHandoverParcel:input_type -> pvz.v1.ScanHandoverParcelRequest
+	37,  // 94: pvz.v1.PvzService.CloseHandoverSession:input_type -> pvz.v1.CloseHandoverSessionRequest
+	39,  // 95: pvz.v1.PvzService.GetHandoverSession:input_type -> pvz.v1.GetHandoverSessionRequest
+	46,  // 96: pvz.v1.PvzService.GetPVZPolicy:input_type -> pvz.v1.GetPVZPolicyRequest
+	48,  // 97: pvz.v1.PvzService.UpdatePVZPolicy:input_type -> pvz.v1.UpdatePVZPolicyRequest
+	51,  // 98: pvz.v1.PvzService.CreateReturnShipment:input_type -> pvz.v1.CreateReturnShipmentRequest
+	53,  // 99: pvz.v1.PvzService.DispatchReturnShipment:input_type -> pvz.v1.DispatchReturnShipmentRequest
+	55,  // 100: pvz.v1.PvzService.GetReturnShipment:input_type -> pvz.v1.GetReturnShipmentRequest
+	27,  // 101: pvz.v1.PvzService.AcceptOrderDelivery:output_type -> pvz.v1.AcceptOrderDeliveryResponse
+	11,  // 102: pvz.v1.PvzService.BatchAcceptOrderDelivery:output_type -> pvz.v1.BatchAcceptOrderDeliveryResponse
+	65,  // 103: pvz.v1.PvzService.ReturnOrderDelivery:output_type -> google.protobuf.Empty
+	16,  // 104: pvz.v1.PvzService.GiveOrderToClient:output_type -> pvz.v1.GiveOrderToClientResponse
+	19,  // 105: pvz.v1.PvzService.GetOrders:output_type -> pvz.v1.GetOrdersResponse
+	65,  // 106: pvz.v1.PvzService.AcceptReturn:output_type -> google.protobuf.Empty
+	22,  // 107: pvz.v1.PvzService.GetReturns:output_type -> pvz.v1.GetReturnsResponse
+	24,  // 108: pvz.v1.PvzService.GetOrderHistory:output_type -> pvz.v1.GetOrderHistoryResponse
+	65,  // 109: pvz.v1.PvzService.ExtendStorage:output_type -> google.protobuf.Empty
+	31,  // 110: pvz.v1.PvzService.QuotePackaging:output_type -> pvz.v1.QuotePackagingResponse
+	29,  // 111: pvz.v1.PvzService.GetWeightDiscrepancyReport:output_type -> pvz.v1.GetWeightDiscrepancyReportResponse
+	34,  // 112: pvz.v1.PvzService.OpenHandoverSession:output_type -> pvz.v1.OpenHandoverSessionResponse
+	36,  // 113: pvz.v1.PvzService.ScanHandoverParcel:output_type -> pvz.v1.ScanHandoverParcelResponse
+	38,  // 114: pvz.v1.PvzService.CloseHandoverSession:output_type -> pvz.v1.CloseHandoverSessionResponse
+	40,  // 115: pvz.v1.PvzService.GetHandoverSession:output_type -> pvz.v1.GetHandoverSessionResponse
+	47,  // 116: pvz.v1.PvzService.GetPVZPolicy:output_type -> pvz.v1.GetPVZPolicyResponse
+	49,  // 117: pvz.v1.PvzService.UpdatePVZPolicy:output_type -> pvz.v1.UpdatePVZPolicyResponse
+	52,  // 118: pvz.v1.PvzService.CreateReturnShipment:output_type -> pvz.v1.CreateReturnShipmentResponse
+	54,  // 119: pvz.v1.PvzService.DispatchReturnShipment:output_type -> pvz.v1.DispatchReturnShipmentResponse
+	56,  // 120: pvz.v1.PvzService.GetReturnShipment:output_type -> pvz.v1.GetReturnShipmentResponse
+	101, // [101:121] is the sub-list for method output_type
+	81,  // [81:101] is the sub-list for method input_type
+	81,  // [81:81] is the sub-list for extension type_name
+	81,  // [81:81] is the sub-list for extension extendee
+	0,   // [0:81] is the sub-list for field type_name
 }
 
 func init() { file_pvz_service_v1_pvz_service_proto_init() }
@@ -905,16 +5262,28 @@ func file_pvz_service_v1_pvz_service_proto_init() {
 	if File_pvz_service_v1_pvz_service_proto != nil {
 		return
 	}
-	file_pvz_service_v1_pvz_service_proto_msgTypes[3].OneofWrappers = []any{}
-	file_pvz_service_v1_pvz_service_proto_msgTypes[6].OneofWrappers = []any{}
-	file_pvz_service_v1_pvz_service_proto_msgTypes[8].OneofWrappers = []any{}
+	file_pvz_service_v1_pvz_service_proto_msgTypes[4].OneofWrappers = []any{}
+	file_pvz_service_v1_pvz_service_proto_msgTypes[5].OneofWrappers = []any{}
+	file_pvz_service_v1_pvz_service_proto_msgTypes[7].OneofWrappers = []any{}
+	file_pvz_service_v1_pvz_service_proto_msgTypes[9].OneofWrappers = []any{}
+	file_pvz_service_v1_pvz_service_proto_msgTypes[10].OneofWrappers = []any{}
+	file_pvz_service_v1_pvz_service_proto_msgTypes[12].OneofWrappers = []any{}
+	file_pvz_service_v1_pvz_service_proto_msgTypes[13].OneofWrappers = []any{}
+	file_pvz_service_v1_pvz_service_proto_msgTypes[17].OneofWrappers = []any{}
+	file_pvz_service_v1_pvz_service_proto_msgTypes[18].OneofWrappers = []any{}
+	file_pvz_service_v1_pvz_service_proto_msgTypes[33].OneofWrappers = []any{}
+	file_pvz_service_v1_pvz_service_proto_msgTypes[40].OneofWrappers = []any{}
+	file_pvz_service_v1_pvz_service_proto_msgTypes[42].OneofWrappers = []any{}
+	file_pvz_service_v1_pvz_service_proto_msgTypes[49].OneofWrappers = []any{}
+	file_pvz_service_v1_pvz_service_proto_msgTypes[51].OneofWrappers = []any{}
+	file_pvz_service_v1_pvz_service_proto_msgTypes[52].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pvz_service_v1_pvz_service_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   9,
+			NumEnums:      8,
+			NumMessages:   53,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
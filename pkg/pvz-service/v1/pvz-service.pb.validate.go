@@ -35,6 +35,9 @@ var (
 	_ = sort.Sort
 )
 
+// define the regex for a UUID once up-front
+var _pvz_service_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
 // Validate checks the field values on AcceptOrderDeliveryRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -135,6 +138,97 @@ func (m *AcceptOrderDeliveryRequest) validate(all bool) error {
 
 	// no validation rules for AdditionalFilm
 
+	if utf8.RuneCountInString(m.GetPackagingCode()) > 32 {
+		err := AcceptOrderDeliveryRequestValidationError{
+			field:  "PackagingCode",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDimensions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AcceptOrderDeliveryRequestValidationError{
+					field:  "Dimensions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AcceptOrderDeliveryRequestValidationError{
+					field:  "Dimensions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDimensions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AcceptOrderDeliveryRequestValidationError{
+				field:  "Dimensions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCostMoney()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AcceptOrderDeliveryRequestValidationError{
+					field:  "CostMoney",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AcceptOrderDeliveryRequestValidationError{
+					field:  "CostMoney",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCostMoney()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AcceptOrderDeliveryRequestValidationError{
+				field:  "CostMoney",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetMeasuredWeight() < 0 {
+		err := AcceptOrderDeliveryRequestValidationError{
+			field:  "MeasuredWeight",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetWeightOverrideReason()) > 255 {
+		err := AcceptOrderDeliveryRequestValidationError{
+			field:  "WeightOverrideReason",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return AcceptOrderDeliveryRequestMultiError(errors)
 	}
@@ -215,32 +309,54 @@ var _ interface {
 	ErrorName() string
 } = AcceptOrderDeliveryRequestValidationError{}
 
-// Validate checks the field values on ReturnOrderDeliveryRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReturnOrderDeliveryRequest) Validate() error {
+// Validate checks the field values on Dimensions with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Dimensions) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReturnOrderDeliveryRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ReturnOrderDeliveryRequestMultiError, or nil if none found.
-func (m *ReturnOrderDeliveryRequest) ValidateAll() error {
+// ValidateAll checks the field values on Dimensions with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DimensionsMultiError, or
+// nil if none found.
+func (m *Dimensions) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReturnOrderDeliveryRequest) validate(all bool) error {
+func (m *Dimensions) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetOrderId()); l < 1 || l > 36 {
-		err := ReturnOrderDeliveryRequestValidationError{
-			field:  "OrderId",
-			reason: "value length must be between 1 and 36 runes, inclusive",
+	if m.GetLength() <= 0 {
+		err := DimensionsValidationError{
+			field:  "Length",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWidth() <= 0 {
+		err := DimensionsValidationError{
+			field:  "Width",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetHeight() <= 0 {
+		err := DimensionsValidationError{
+			field:  "Height",
+			reason: "value must be greater than 0",
 		}
 		if !all {
 			return err
@@ -249,19 +365,18 @@ func (m *ReturnOrderDeliveryRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ReturnOrderDeliveryRequestMultiError(errors)
+		return DimensionsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReturnOrderDeliveryRequestMultiError is an error wrapping multiple
-// validation errors returned by ReturnOrderDeliveryRequest.ValidateAll() if
-// the designated constraints aren't met.
-type ReturnOrderDeliveryRequestMultiError []error
+// DimensionsMultiError is an error wrapping multiple validation errors
+// returned by Dimensions.ValidateAll() if the designated constraints aren't met.
+type DimensionsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReturnOrderDeliveryRequestMultiError) Error() string {
+func (m DimensionsMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -270,11 +385,11 @@ func (m ReturnOrderDeliveryRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReturnOrderDeliveryRequestMultiError) AllErrors() []error { return m }
+func (m DimensionsMultiError) AllErrors() []error { return m }
 
-// ReturnOrderDeliveryRequestValidationError is the validation error returned
-// by ReturnOrderDeliveryRequest.Validate if the designated constraints aren't met.
-type ReturnOrderDeliveryRequestValidationError struct {
+// DimensionsValidationError is the validation error returned by
+// Dimensions.Validate if the designated constraints aren't met.
+type DimensionsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -282,24 +397,22 @@ type ReturnOrderDeliveryRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReturnOrderDeliveryRequestValidationError) Field() string { return e.field }
+func (e DimensionsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReturnOrderDeliveryRequestValidationError) Reason() string { return e.reason }
+func (e DimensionsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReturnOrderDeliveryRequestValidationError) Cause() error { return e.cause }
+func (e DimensionsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReturnOrderDeliveryRequestValidationError) Key() bool { return e.key }
+func (e DimensionsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReturnOrderDeliveryRequestValidationError) ErrorName() string {
-	return "ReturnOrderDeliveryRequestValidationError"
-}
+func (e DimensionsValidationError) ErrorName() string { return "DimensionsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ReturnOrderDeliveryRequestValidationError) Error() string {
+func (e DimensionsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -311,14 +424,14 @@ func (e ReturnOrderDeliveryRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReturnOrderDeliveryRequest.%s: %s%s",
+		"invalid %sDimensions.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReturnOrderDeliveryRequestValidationError{}
+var _ error = DimensionsValidationError{}
 
 var _ interface {
 	Field() string
@@ -326,34 +439,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReturnOrderDeliveryRequestValidationError{}
+} = DimensionsValidationError{}
 
-// Validate checks the field values on GiveOrderToClientRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on BatchAcceptOrderDeliveryRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GiveOrderToClientRequest) Validate() error {
+func (m *BatchAcceptOrderDeliveryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GiveOrderToClientRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GiveOrderToClientRequestMultiError, or nil if none found.
-func (m *GiveOrderToClientRequest) ValidateAll() error {
+// ValidateAll checks the field values on BatchAcceptOrderDeliveryRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BatchAcceptOrderDeliveryRequestMultiError, or nil if none found.
+func (m *BatchAcceptOrderDeliveryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GiveOrderToClientRequest) validate(all bool) error {
+func (m *BatchAcceptOrderDeliveryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetOrderIds()) < 1 {
-		err := GiveOrderToClientRequestValidationError{
-			field:  "OrderIds",
-			reason: "value must contain at least 1 item(s)",
+	if l := len(m.GetItems()); l < 1 || l > 500 {
+		err := BatchAcceptOrderDeliveryRequestValidationError{
+			field:  "Items",
+			reason: "value must contain between 1 and 500 items, inclusive",
 		}
 		if !all {
 			return err
@@ -361,36 +474,27 @@ func (m *GiveOrderToClientRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetOrderIds() {
+	for idx, item := range m.GetItems() {
 		_, _ = idx, item
 
-		if l := utf8.RuneCountInString(item); l < 1 || l > 36 {
-			err := GiveOrderToClientRequestValidationError{
-				field:  fmt.Sprintf("OrderIds[%v]", idx),
-				reason: "value length must be between 1 and 36 runes, inclusive",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+		// skipping validation for items
 
 	}
 
 	if len(errors) > 0 {
-		return GiveOrderToClientRequestMultiError(errors)
+		return BatchAcceptOrderDeliveryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GiveOrderToClientRequestMultiError is an error wrapping multiple validation
-// errors returned by GiveOrderToClientRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GiveOrderToClientRequestMultiError []error
+// BatchAcceptOrderDeliveryRequestMultiError is an error wrapping multiple
+// validation errors returned by BatchAcceptOrderDeliveryRequest.ValidateAll()
+// if the designated constraints aren't met.
+type BatchAcceptOrderDeliveryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GiveOrderToClientRequestMultiError) Error() string {
+func (m BatchAcceptOrderDeliveryRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -399,11 +503,12 @@ func (m GiveOrderToClientRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GiveOrderToClientRequestMultiError) AllErrors() []error { return m }
+func (m BatchAcceptOrderDeliveryRequestMultiError) AllErrors() []error { return m }
 
-// GiveOrderToClientRequestValidationError is the validation error returned by
-// GiveOrderToClientRequest.Validate if the designated constraints aren't met.
-type GiveOrderToClientRequestValidationError struct {
+// BatchAcceptOrderDeliveryRequestValidationError is the validation error
+// returned by BatchAcceptOrderDeliveryRequest.Validate if the designated
+// constraints aren't met.
+type BatchAcceptOrderDeliveryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -411,24 +516,24 @@ type GiveOrderToClientRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GiveOrderToClientRequestValidationError) Field() string { return e.field }
+func (e BatchAcceptOrderDeliveryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GiveOrderToClientRequestValidationError) Reason() string { return e.reason }
+func (e BatchAcceptOrderDeliveryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GiveOrderToClientRequestValidationError) Cause() error { return e.cause }
+func (e BatchAcceptOrderDeliveryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GiveOrderToClientRequestValidationError) Key() bool { return e.key }
+func (e BatchAcceptOrderDeliveryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GiveOrderToClientRequestValidationError) ErrorName() string {
-	return "GiveOrderToClientRequestValidationError"
+func (e BatchAcceptOrderDeliveryRequestValidationError) ErrorName() string {
+	return "BatchAcceptOrderDeliveryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GiveOrderToClientRequestValidationError) Error() string {
+func (e BatchAcceptOrderDeliveryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -440,14 +545,14 @@ func (e GiveOrderToClientRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGiveOrderToClientRequest.%s: %s%s",
+		"invalid %sBatchAcceptOrderDeliveryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GiveOrderToClientRequestValidationError{}
+var _ error = BatchAcceptOrderDeliveryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -455,104 +560,80 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GiveOrderToClientRequestValidationError{}
+} = BatchAcceptOrderDeliveryRequestValidationError{}
 
-// Validate checks the field values on GetOrdersRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetOrdersRequest) Validate() error {
+// Validate checks the field values on BatchAcceptOrderDeliveryResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *BatchAcceptOrderDeliveryResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetOrdersRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetOrdersRequestMultiError, or nil if none found.
-func (m *GetOrdersRequest) ValidateAll() error {
+// ValidateAll checks the field values on BatchAcceptOrderDeliveryResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// BatchAcceptOrderDeliveryResponseMultiError, or nil if none found.
+func (m *BatchAcceptOrderDeliveryResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetOrdersRequest) validate(all bool) error {
+func (m *BatchAcceptOrderDeliveryResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetUserId()); l < 1 || l > 36 {
-		err := GetOrdersRequestValidationError{
-			field:  "UserId",
-			reason: "value length must be between 1 and 36 runes, inclusive",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.LastN != nil {
-
-		if m.GetLastN() < 0 {
-			err := GetOrdersRequestValidationError{
-				field:  "LastN",
-				reason: "value must be greater than or equal to 0",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	if m.SamePVZ != nil {
-		// no validation rules for SamePVZ
-	}
-
-	if m.Cursor != nil {
-
-		if l := utf8.RuneCountInString(m.GetCursor()); l < 1 || l > 36 {
-			err := GetOrdersRequestValidationError{
-				field:  "Cursor",
-				reason: "value length must be between 1 and 36 runes, inclusive",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-	}
-
-	if m.Limit != nil {
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
 
-		if m.GetLimit() < 0 {
-			err := GetOrdersRequestValidationError{
-				field:  "Limit",
-				reason: "value must be greater than or equal to 0",
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchAcceptOrderDeliveryResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchAcceptOrderDeliveryResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-			if !all {
-				return err
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchAcceptOrderDeliveryResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
-			errors = append(errors, err)
 		}
 
 	}
 
 	if len(errors) > 0 {
-		return GetOrdersRequestMultiError(errors)
+		return BatchAcceptOrderDeliveryResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetOrdersRequestMultiError is an error wrapping multiple validation errors
-// returned by GetOrdersRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetOrdersRequestMultiError []error
+// BatchAcceptOrderDeliveryResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// BatchAcceptOrderDeliveryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type BatchAcceptOrderDeliveryResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetOrdersRequestMultiError) Error() string {
+func (m BatchAcceptOrderDeliveryResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -561,11 +642,12 @@ func (m GetOrdersRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetOrdersRequestMultiError) AllErrors() []error { return m }
+func (m BatchAcceptOrderDeliveryResponseMultiError) AllErrors() []error { return m }
 
-// GetOrdersRequestValidationError is the validation error returned by
-// GetOrdersRequest.Validate if the designated constraints aren't met.
-type GetOrdersRequestValidationError struct {
+// BatchAcceptOrderDeliveryResponseValidationError is the validation error
+// returned by BatchAcceptOrderDeliveryResponse.Validate if the designated
+// constraints aren't met.
+type BatchAcceptOrderDeliveryResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -573,22 +655,24 @@ type GetOrdersRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetOrdersRequestValidationError) Field() string { return e.field }
+func (e BatchAcceptOrderDeliveryResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetOrdersRequestValidationError) Reason() string { return e.reason }
+func (e BatchAcceptOrderDeliveryResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetOrdersRequestValidationError) Cause() error { return e.cause }
+func (e BatchAcceptOrderDeliveryResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetOrdersRequestValidationError) Key() bool { return e.key }
+func (e BatchAcceptOrderDeliveryResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetOrdersRequestValidationError) ErrorName() string { return "GetOrdersRequestValidationError" }
+func (e BatchAcceptOrderDeliveryResponseValidationError) ErrorName() string {
+	return "BatchAcceptOrderDeliveryResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetOrdersRequestValidationError) Error() string {
+func (e BatchAcceptOrderDeliveryResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -600,14 +684,14 @@ func (e GetOrdersRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetOrdersRequest.%s: %s%s",
+		"invalid %sBatchAcceptOrderDeliveryResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetOrdersRequestValidationError{}
+var _ error = BatchAcceptOrderDeliveryResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -615,89 +699,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetOrdersRequestValidationError{}
+} = BatchAcceptOrderDeliveryResponseValidationError{}
 
-// Validate checks the field values on GetOrdersResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetOrdersResponse) Validate() error {
+// Validate checks the field values on AcceptOrderDeliveryResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcceptOrderDeliveryResult) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetOrdersResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AcceptOrderDeliveryResult with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetOrdersResponseMultiError, or nil if none found.
-func (m *GetOrdersResponse) ValidateAll() error {
+// AcceptOrderDeliveryResultMultiError, or nil if none found.
+func (m *AcceptOrderDeliveryResult) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetOrdersResponse) validate(all bool) error {
+func (m *AcceptOrderDeliveryResult) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetOrders()) < 1 {
-		err := GetOrdersResponseValidationError{
-			field:  "Orders",
-			reason: "value must contain at least 1 item(s)",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for OrderId
 
-	for idx, item := range m.GetOrders() {
-		_, _ = idx, item
+	// no validation rules for Success
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetOrdersResponseValidationError{
-						field:  fmt.Sprintf("Orders[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetOrdersResponseValidationError{
-						field:  fmt.Sprintf("Orders[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetOrder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AcceptOrderDeliveryResultValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return GetOrdersResponseValidationError{
-					field:  fmt.Sprintf("Orders[%v]", idx),
+				errors = append(errors, AcceptOrderDeliveryResultValidationError{
+					field:  "Order",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AcceptOrderDeliveryResultValidationError{
+				field:  "Order",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
+	if m.Error != nil {
+		// no validation rules for Error
 	}
 
 	if len(errors) > 0 {
-		return GetOrdersResponseMultiError(errors)
+		return AcceptOrderDeliveryResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetOrdersResponseMultiError is an error wrapping multiple validation errors
-// returned by GetOrdersResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetOrdersResponseMultiError []error
+// AcceptOrderDeliveryResultMultiError is an error wrapping multiple validation
+// errors returned by AcceptOrderDeliveryResult.ValidateAll() if the
+// designated constraints aren't met.
+type AcceptOrderDeliveryResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetOrdersResponseMultiError) Error() string {
+func (m AcceptOrderDeliveryResultMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -706,11 +782,11 @@ func (m GetOrdersResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetOrdersResponseMultiError) AllErrors() []error { return m }
+func (m AcceptOrderDeliveryResultMultiError) AllErrors() []error { return m }
 
-// GetOrdersResponseValidationError is the validation error returned by
-// GetOrdersResponse.Validate if the designated constraints aren't met.
-type GetOrdersResponseValidationError struct {
+// AcceptOrderDeliveryResultValidationError is the validation error returned by
+// AcceptOrderDeliveryResult.Validate if the designated constraints aren't met.
+type AcceptOrderDeliveryResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -718,24 +794,24 @@ type GetOrdersResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetOrdersResponseValidationError) Field() string { return e.field }
+func (e AcceptOrderDeliveryResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetOrdersResponseValidationError) Reason() string { return e.reason }
+func (e AcceptOrderDeliveryResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetOrdersResponseValidationError) Cause() error { return e.cause }
+func (e AcceptOrderDeliveryResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetOrdersResponseValidationError) Key() bool { return e.key }
+func (e AcceptOrderDeliveryResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetOrdersResponseValidationError) ErrorName() string {
-	return "GetOrdersResponseValidationError"
+func (e AcceptOrderDeliveryResultValidationError) ErrorName() string {
+	return "AcceptOrderDeliveryResultValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetOrdersResponseValidationError) Error() string {
+func (e AcceptOrderDeliveryResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -747,14 +823,14 @@ func (e GetOrdersResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetOrdersResponse.%s: %s%s",
+		"invalid %sAcceptOrderDeliveryResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetOrdersResponseValidationError{}
+var _ error = AcceptOrderDeliveryResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -762,33 +838,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetOrdersResponseValidationError{}
+} = AcceptOrderDeliveryResultValidationError{}
 
-// Validate checks the field values on AcceptReturnRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ReturnOrderDeliveryRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AcceptReturnRequest) Validate() error {
+func (m *ReturnOrderDeliveryRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AcceptReturnRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ReturnOrderDeliveryRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AcceptReturnRequestMultiError, or nil if none found.
-func (m *AcceptReturnRequest) ValidateAll() error {
+// ReturnOrderDeliveryRequestMultiError, or nil if none found.
+func (m *ReturnOrderDeliveryRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AcceptReturnRequest) validate(all bool) error {
+func (m *ReturnOrderDeliveryRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetUserId()); l < 1 || l > 36 {
-		err := AcceptReturnRequestValidationError{
-			field:  "UserId",
+	if l := utf8.RuneCountInString(m.GetOrderId()); l < 1 || l > 36 {
+		err := ReturnOrderDeliveryRequestValidationError{
+			field:  "OrderId",
 			reason: "value length must be between 1 and 36 runes, inclusive",
 		}
 		if !all {
@@ -797,31 +873,35 @@ func (m *AcceptReturnRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetOrderId()); l < 1 || l > 36 {
-		err := AcceptReturnRequestValidationError{
-			field:  "OrderId",
-			reason: "value length must be between 1 and 36 runes, inclusive",
-		}
-		if !all {
-			return err
+	if m.ExpectedVersion != nil {
+
+		if m.GetExpectedVersion() < 1 {
+			err := ReturnOrderDeliveryRequestValidationError{
+				field:  "ExpectedVersion",
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
+
 	}
 
 	if len(errors) > 0 {
-		return AcceptReturnRequestMultiError(errors)
+		return ReturnOrderDeliveryRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AcceptReturnRequestMultiError is an error wrapping multiple validation
-// errors returned by AcceptReturnRequest.ValidateAll() if the designated
-// constraints aren't met.
-type AcceptReturnRequestMultiError []error
+// ReturnOrderDeliveryRequestMultiError is an error wrapping multiple
+// validation errors returned by ReturnOrderDeliveryRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ReturnOrderDeliveryRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AcceptReturnRequestMultiError) Error() string {
+func (m ReturnOrderDeliveryRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -830,11 +910,11 @@ func (m AcceptReturnRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AcceptReturnRequestMultiError) AllErrors() []error { return m }
+func (m ReturnOrderDeliveryRequestMultiError) AllErrors() []error { return m }
 
-// AcceptReturnRequestValidationError is the validation error returned by
-// AcceptReturnRequest.Validate if the designated constraints aren't met.
-type AcceptReturnRequestValidationError struct {
+// ReturnOrderDeliveryRequestValidationError is the validation error returned
+// by ReturnOrderDeliveryRequest.Validate if the designated constraints aren't met.
+type ReturnOrderDeliveryRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -842,24 +922,24 @@ type AcceptReturnRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AcceptReturnRequestValidationError) Field() string { return e.field }
+func (e ReturnOrderDeliveryRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AcceptReturnRequestValidationError) Reason() string { return e.reason }
+func (e ReturnOrderDeliveryRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AcceptReturnRequestValidationError) Cause() error { return e.cause }
+func (e ReturnOrderDeliveryRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AcceptReturnRequestValidationError) Key() bool { return e.key }
+func (e ReturnOrderDeliveryRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AcceptReturnRequestValidationError) ErrorName() string {
-	return "AcceptReturnRequestValidationError"
+func (e ReturnOrderDeliveryRequestValidationError) ErrorName() string {
+	return "ReturnOrderDeliveryRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AcceptReturnRequestValidationError) Error() string {
+func (e ReturnOrderDeliveryRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -871,14 +951,14 @@ func (e AcceptReturnRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAcceptReturnRequest.%s: %s%s",
+		"invalid %sReturnOrderDeliveryRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AcceptReturnRequestValidationError{}
+var _ error = ReturnOrderDeliveryRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -886,36 +966,37 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AcceptReturnRequestValidationError{}
+} = ReturnOrderDeliveryRequestValidationError{}
 
-// Validate checks the field values on GetReturnsRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetReturnsRequest) Validate() error {
+// Validate checks the field values on GiveOrderToClientRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GiveOrderToClientRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReturnsRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GiveOrderToClientRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetReturnsRequestMultiError, or nil if none found.
-func (m *GetReturnsRequest) ValidateAll() error {
+// GiveOrderToClientRequestMultiError, or nil if none found.
+func (m *GiveOrderToClientRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReturnsRequest) validate(all bool) error {
+func (m *GiveOrderToClientRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.Page != nil {
+	for idx, item := range m.GetOrderIds() {
+		_, _ = idx, item
 
-		if m.GetPage() < 0 {
-			err := GetReturnsRequestValidationError{
-				field:  "Page",
-				reason: "value must be greater than or equal to 0",
+		if l := utf8.RuneCountInString(item); l < 1 || l > 36 {
+			err := GiveOrderToClientRequestValidationError{
+				field:  fmt.Sprintf("OrderIds[%v]", idx),
+				reason: "value length must be between 1 and 36 runes, inclusive",
 			}
 			if !all {
 				return err
@@ -925,35 +1006,54 @@ func (m *GetReturnsRequest) validate(all bool) error {
 
 	}
 
-	if m.PageSize != nil {
+	for idx, item := range m.GetDecisions() {
+		_, _ = idx, item
 
-		if m.GetPageSize() < 0 {
-			err := GetReturnsRequestValidationError{
-				field:  "PageSize",
-				reason: "value must be greater than or equal to 0",
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GiveOrderToClientRequestValidationError{
+						field:  fmt.Sprintf("Decisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GiveOrderToClientRequestValidationError{
+						field:  fmt.Sprintf("Decisions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-			if !all {
-				return err
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GiveOrderToClientRequestValidationError{
+					field:  fmt.Sprintf("Decisions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
 			}
-			errors = append(errors, err)
 		}
 
 	}
 
 	if len(errors) > 0 {
-		return GetReturnsRequestMultiError(errors)
+		return GiveOrderToClientRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReturnsRequestMultiError is an error wrapping multiple validation errors
-// returned by GetReturnsRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetReturnsRequestMultiError []error
+// GiveOrderToClientRequestMultiError is an error wrapping multiple validation
+// errors returned by GiveOrderToClientRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GiveOrderToClientRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReturnsRequestMultiError) Error() string {
+func (m GiveOrderToClientRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -962,11 +1062,11 @@ func (m GetReturnsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReturnsRequestMultiError) AllErrors() []error { return m }
+func (m GiveOrderToClientRequestMultiError) AllErrors() []error { return m }
 
-// GetReturnsRequestValidationError is the validation error returned by
-// GetReturnsRequest.Validate if the designated constraints aren't met.
-type GetReturnsRequestValidationError struct {
+// GiveOrderToClientRequestValidationError is the validation error returned by
+// GiveOrderToClientRequest.Validate if the designated constraints aren't met.
+type GiveOrderToClientRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -974,24 +1074,24 @@ type GetReturnsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReturnsRequestValidationError) Field() string { return e.field }
+func (e GiveOrderToClientRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReturnsRequestValidationError) Reason() string { return e.reason }
+func (e GiveOrderToClientRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReturnsRequestValidationError) Cause() error { return e.cause }
+func (e GiveOrderToClientRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReturnsRequestValidationError) Key() bool { return e.key }
+func (e GiveOrderToClientRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReturnsRequestValidationError) ErrorName() string {
-	return "GetReturnsRequestValidationError"
+func (e GiveOrderToClientRequestValidationError) ErrorName() string {
+	return "GiveOrderToClientRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetReturnsRequestValidationError) Error() string {
+func (e GiveOrderToClientRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1003,14 +1103,14 @@ func (e GetReturnsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReturnsRequest.%s: %s%s",
+		"invalid %sGiveOrderToClientRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReturnsRequestValidationError{}
+var _ error = GiveOrderToClientRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1018,34 +1118,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReturnsRequestValidationError{}
+} = GiveOrderToClientRequestValidationError{}
 
-// Validate checks the field values on GetReturnsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetReturnsResponse) Validate() error {
+// Validate checks the field values on IssueDecision with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *IssueDecision) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetReturnsResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetReturnsResponseMultiError, or nil if none found.
-func (m *GetReturnsResponse) ValidateAll() error {
+// ValidateAll checks the field values on IssueDecision with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IssueDecisionMultiError, or
+// nil if none found.
+func (m *IssueDecision) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetReturnsResponse) validate(all bool) error {
+func (m *IssueDecision) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetReturns()) < 1 {
-		err := GetReturnsResponseValidationError{
-			field:  "Returns",
-			reason: "value must contain at least 1 item(s)",
+	if l := utf8.RuneCountInString(m.GetOrderId()); l < 1 || l > 36 {
+		err := IssueDecisionValidationError{
+			field:  "OrderId",
+			reason: "value length must be between 1 and 36 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -1053,54 +1153,6748 @@ func (m *GetReturnsResponse) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetReturns() {
+	if _, ok := _IssueDecision_Action_NotInLookup[m.GetAction()]; ok {
+		err := IssueDecisionValidationError{
+			field:  "Action",
+			reason: "value must not be in list [ISSUE_ACTION_UNKNOWN]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := IssueAction_name[int32(m.GetAction())]; !ok {
+		err := IssueDecisionValidationError{
+			field:  "Action",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetStorageFeePaid()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IssueDecisionValidationError{
+					field:  "StorageFeePaid",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IssueDecisionValidationError{
+					field:  "StorageFeePaid",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStorageFeePaid()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IssueDecisionValidationError{
+				field:  "StorageFeePaid",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.RefusalReason != nil {
+
+		if l := utf8.RuneCountInString(m.GetRefusalReason()); l < 1 || l > 255 {
+			err := IssueDecisionValidationError{
+				field:  "RefusalReason",
+				reason: "value length must be between 1 and 255 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.ExpectedVersion != nil {
+
+		if m.GetExpectedVersion() < 1 {
+			err := IssueDecisionValidationError{
+				field:  "ExpectedVersion",
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.PickupCode != nil {
+
+		if !_IssueDecision_PickupCode_Pattern.MatchString(m.GetPickupCode()) {
+			err := IssueDecisionValidationError{
+				field:  "PickupCode",
+				reason: "value does not match regex pattern \"^[0-9]{6}$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return IssueDecisionMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueDecisionMultiError is an error wrapping multiple validation errors
+// returned by IssueDecision.ValidateAll() if the designated constraints
+// aren't met.
+type IssueDecisionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueDecisionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueDecisionMultiError) AllErrors() []error { return m }
+
+// IssueDecisionValidationError is the validation error returned by
+// IssueDecision.Validate if the designated constraints aren't met.
+type IssueDecisionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueDecisionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueDecisionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueDecisionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueDecisionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueDecisionValidationError) ErrorName() string { return "IssueDecisionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IssueDecisionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueDecision.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueDecisionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueDecisionValidationError{}
+
+var _IssueDecision_Action_NotInLookup = map[IssueAction]struct{}{
+	0: {},
+}
+
+var _IssueDecision_PickupCode_Pattern = regexp.MustCompile("^[0-9]{6}$")
+
+// Validate checks the field values on GiveOrderToClientResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GiveOrderToClientResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GiveOrderToClientResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GiveOrderToClientResponseMultiError, or nil if none found.
+func (m *GiveOrderToClientResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GiveOrderToClientResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetReturnsResponseValidationError{
-						field:  fmt.Sprintf("Returns[%v]", idx),
+					errors = append(errors, GiveOrderToClientResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetReturnsResponseValidationError{
-						field:  fmt.Sprintf("Returns[%v]", idx),
+					errors = append(errors, GiveOrderToClientResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GiveOrderToClientResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GiveOrderToClientResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GiveOrderToClientResponseMultiError is an error wrapping multiple validation
+// errors returned by GiveOrderToClientResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GiveOrderToClientResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GiveOrderToClientResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GiveOrderToClientResponseMultiError) AllErrors() []error { return m }
+
+// GiveOrderToClientResponseValidationError is the validation error returned by
+// GiveOrderToClientResponse.Validate if the designated constraints aren't met.
+type GiveOrderToClientResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GiveOrderToClientResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GiveOrderToClientResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GiveOrderToClientResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GiveOrderToClientResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GiveOrderToClientResponseValidationError) ErrorName() string {
+	return "GiveOrderToClientResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GiveOrderToClientResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGiveOrderToClientResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GiveOrderToClientResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GiveOrderToClientResponseValidationError{}
+
+// Validate checks the field values on IssueResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *IssueResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IssueResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in IssueResultMultiError, or
+// nil if none found.
+func (m *IssueResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IssueResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for Action
+
+	// no validation rules for Success
+
+	if m.Error != nil {
+		// no validation rules for Error
+	}
+
+	if len(errors) > 0 {
+		return IssueResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// IssueResultMultiError is an error wrapping multiple validation errors
+// returned by IssueResult.ValidateAll() if the designated constraints aren't met.
+type IssueResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IssueResultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IssueResultMultiError) AllErrors() []error { return m }
+
+// IssueResultValidationError is the validation error returned by
+// IssueResult.Validate if the designated constraints aren't met.
+type IssueResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IssueResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IssueResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IssueResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IssueResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IssueResultValidationError) ErrorName() string { return "IssueResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e IssueResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIssueResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = IssueResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IssueResultValidationError{}
+
+// Validate checks the field values on GetOrdersRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetOrdersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrdersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrdersRequestMultiError, or nil if none found.
+func (m *GetOrdersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrdersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUserId()); l < 1 || l > 36 {
+		err := GetOrdersRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetStatuses() {
+		_, _ = idx, item
+
+		if _, ok := _GetOrdersRequest_Statuses_NotInLookup[item]; ok {
+			err := GetOrdersRequestValidationError{
+				field:  fmt.Sprintf("Statuses[%v]", idx),
+				reason: "value must not be in list [0]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if _, ok := OrderStatus_name[int32(item)]; !ok {
+			err := GetOrdersRequestValidationError{
+				field:  fmt.Sprintf("Statuses[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.LastN != nil {
+
+		if m.GetLastN() < 0 {
+			err := GetOrdersRequestValidationError{
+				field:  "LastN",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.SamePVZ != nil {
+		// no validation rules for SamePVZ
+	}
+
+	if m.Cursor != nil {
+
+		if l := utf8.RuneCountInString(m.GetCursor()); l < 1 || l > 36 {
+			err := GetOrdersRequestValidationError{
+				field:  "Cursor",
+				reason: "value length must be between 1 and 36 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Limit != nil {
+
+		if m.GetLimit() < 0 {
+			err := GetOrdersRequestValidationError{
+				field:  "Limit",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.WeightDiscrepancy != nil {
+		// no validation rules for WeightDiscrepancy
+	}
+
+	if len(errors) > 0 {
+		return GetOrdersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrdersRequestMultiError is an error wrapping multiple validation errors
+// returned by GetOrdersRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetOrdersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrdersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrdersRequestMultiError) AllErrors() []error { return m }
+
+// GetOrdersRequestValidationError is the validation error returned by
+// GetOrdersRequest.Validate if the designated constraints aren't met.
+type GetOrdersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrdersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrdersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrdersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrdersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrdersRequestValidationError) ErrorName() string { return "GetOrdersRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetOrdersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrdersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrdersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrdersRequestValidationError{}
+
+var _GetOrdersRequest_Statuses_NotInLookup = map[OrderStatus]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on GetOrdersResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetOrdersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrdersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrdersResponseMultiError, or nil if none found.
+func (m *GetOrdersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrdersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetOrders()) < 1 {
+		err := GetOrdersResponseValidationError{
+			field:  "Orders",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetOrdersResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetOrdersResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetOrdersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrdersResponseMultiError is an error wrapping multiple validation errors
+// returned by GetOrdersResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetOrdersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrdersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrdersResponseMultiError) AllErrors() []error { return m }
+
+// GetOrdersResponseValidationError is the validation error returned by
+// GetOrdersResponse.Validate if the designated constraints aren't met.
+type GetOrdersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrdersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrdersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrdersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrdersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrdersResponseValidationError) ErrorName() string {
+	return "GetOrdersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOrdersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrdersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrdersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrdersResponseValidationError{}
+
+// Validate checks the field values on AcceptReturnRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcceptReturnRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptReturnRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcceptReturnRequestMultiError, or nil if none found.
+func (m *AcceptReturnRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptReturnRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetUserId()); l < 1 || l > 36 {
+		err := AcceptReturnRequestValidationError{
+			field:  "UserId",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetOrderId()); l < 1 || l > 36 {
+		err := AcceptReturnRequestValidationError{
+			field:  "OrderId",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AcceptReturnRequest_Reason_NotInLookup[m.GetReason()]; ok {
+		err := AcceptReturnRequestValidationError{
+			field:  "Reason",
+			reason: "value must not be in list [RETURN_REASON_UNKNOWN]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ReturnReason_name[int32(m.GetReason())]; !ok {
+		err := AcceptReturnRequestValidationError{
+			field:  "Reason",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _AcceptReturnRequest_Inspection_NotInLookup[m.GetInspection()]; ok {
+		err := AcceptReturnRequestValidationError{
+			field:  "Inspection",
+			reason: "value must not be in list [INSPECTION_OUTCOME_UNKNOWN]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := InspectionOutcome_name[int32(m.GetInspection())]; !ok {
+		err := AcceptReturnRequestValidationError{
+			field:  "Inspection",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetComment()) > 1000 {
+		err := AcceptReturnRequestValidationError{
+			field:  "Comment",
+			reason: "value length must be at most 1000 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.ExpectedVersion != nil {
+
+		if m.GetExpectedVersion() < 1 {
+			err := AcceptReturnRequestValidationError{
+				field:  "ExpectedVersion",
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AcceptReturnRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcceptReturnRequestMultiError is an error wrapping multiple validation
+// errors returned by AcceptReturnRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AcceptReturnRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptReturnRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptReturnRequestMultiError) AllErrors() []error { return m }
+
+// AcceptReturnRequestValidationError is the validation error returned by
+// AcceptReturnRequest.Validate if the designated constraints aren't met.
+type AcceptReturnRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptReturnRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptReturnRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptReturnRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptReturnRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptReturnRequestValidationError) ErrorName() string {
+	return "AcceptReturnRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptReturnRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptReturnRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptReturnRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptReturnRequestValidationError{}
+
+var _AcceptReturnRequest_Reason_NotInLookup = map[ReturnReason]struct{}{
+	0: {},
+}
+
+var _AcceptReturnRequest_Inspection_NotInLookup = map[InspectionOutcome]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on GetReturnsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetReturnsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReturnsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReturnsRequestMultiError, or nil if none found.
+func (m *GetReturnsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReturnsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	_GetReturnsRequest_Reasons_Unique := make(map[ReturnReason]struct{}, len(m.GetReasons()))
+
+	for idx, item := range m.GetReasons() {
+		_, _ = idx, item
+
+		if _, exists := _GetReturnsRequest_Reasons_Unique[item]; exists {
+			err := GetReturnsRequestValidationError{
+				field:  fmt.Sprintf("Reasons[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_GetReturnsRequest_Reasons_Unique[item] = struct{}{}
+		}
+
+		if _, ok := _GetReturnsRequest_Reasons_NotInLookup[item]; ok {
+			err := GetReturnsRequestValidationError{
+				field:  fmt.Sprintf("Reasons[%v]", idx),
+				reason: "value must not be in list [0]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if _, ok := ReturnReason_name[int32(item)]; !ok {
+			err := GetReturnsRequestValidationError{
+				field:  fmt.Sprintf("Reasons[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	_GetReturnsRequest_Inspections_Unique := make(map[InspectionOutcome]struct{}, len(m.GetInspections()))
+
+	for idx, item := range m.GetInspections() {
+		_, _ = idx, item
+
+		if _, exists := _GetReturnsRequest_Inspections_Unique[item]; exists {
+			err := GetReturnsRequestValidationError{
+				field:  fmt.Sprintf("Inspections[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_GetReturnsRequest_Inspections_Unique[item] = struct{}{}
+		}
+
+		if _, ok := _GetReturnsRequest_Inspections_NotInLookup[item]; ok {
+			err := GetReturnsRequestValidationError{
+				field:  fmt.Sprintf("Inspections[%v]", idx),
+				reason: "value must not be in list [0]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if _, ok := InspectionOutcome_name[int32(item)]; !ok {
+			err := GetReturnsRequestValidationError{
+				field:  fmt.Sprintf("Inspections[%v]", idx),
+				reason: "value must be one of the defined enum values",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.Page != nil {
+
+		if m.GetPage() < 0 {
+			err := GetReturnsRequestValidationError{
+				field:  "Page",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.PageSize != nil {
+
+		if m.GetPageSize() < 0 {
+			err := GetReturnsRequestValidationError{
+				field:  "PageSize",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetReturnsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReturnsRequestMultiError is an error wrapping multiple validation errors
+// returned by GetReturnsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetReturnsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReturnsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReturnsRequestMultiError) AllErrors() []error { return m }
+
+// GetReturnsRequestValidationError is the validation error returned by
+// GetReturnsRequest.Validate if the designated constraints aren't met.
+type GetReturnsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReturnsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReturnsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReturnsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReturnsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReturnsRequestValidationError) ErrorName() string {
+	return "GetReturnsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReturnsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReturnsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReturnsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReturnsRequestValidationError{}
+
+var _GetReturnsRequest_Reasons_NotInLookup = map[ReturnReason]struct{}{
+	0: {},
+}
+
+var _GetReturnsRequest_Inspections_NotInLookup = map[InspectionOutcome]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on GetReturnsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReturnsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReturnsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReturnsResponseMultiError, or nil if none found.
+func (m *GetReturnsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReturnsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetReturns()) < 1 {
+		err := GetReturnsResponseValidationError{
+			field:  "Returns",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetReturns() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetReturnsResponseValidationError{
+						field:  fmt.Sprintf("Returns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetReturnsResponseValidationError{
+						field:  fmt.Sprintf("Returns[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetReturnsResponseValidationError{
+					field:  fmt.Sprintf("Returns[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetReturnsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReturnsResponseMultiError is an error wrapping multiple validation errors
+// returned by GetReturnsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetReturnsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReturnsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReturnsResponseMultiError) AllErrors() []error { return m }
+
+// GetReturnsResponseValidationError is the validation error returned by
+// GetReturnsResponse.Validate if the designated constraints aren't met.
+type GetReturnsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReturnsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReturnsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReturnsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReturnsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReturnsResponseValidationError) ErrorName() string {
+	return "GetReturnsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReturnsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReturnsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReturnsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReturnsResponseValidationError{}
+
+// Validate checks the field values on GetOrderHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOrderHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrderHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrderHistoryRequestMultiError, or nil if none found.
+func (m *GetOrderHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrderHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetOrderId()); l < 1 || l > 36 {
+		err := GetOrderHistoryRequestValidationError{
+			field:  "OrderId",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetOrderHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrderHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by GetOrderHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetOrderHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrderHistoryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrderHistoryRequestMultiError) AllErrors() []error { return m }
+
+// GetOrderHistoryRequestValidationError is the validation error returned by
+// GetOrderHistoryRequest.Validate if the designated constraints aren't met.
+type GetOrderHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrderHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrderHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrderHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrderHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrderHistoryRequestValidationError) ErrorName() string {
+	return "GetOrderHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOrderHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrderHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrderHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrderHistoryRequestValidationError{}
+
+// Validate checks the field values on GetOrderHistoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetOrderHistoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetOrderHistoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetOrderHistoryResponseMultiError, or nil if none found.
+func (m *GetOrderHistoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetOrderHistoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetOrderHistoryResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetOrderHistoryResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetOrderHistoryResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetOrderHistoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetOrderHistoryResponseMultiError is an error wrapping multiple validation
+// errors returned by GetOrderHistoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetOrderHistoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetOrderHistoryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetOrderHistoryResponseMultiError) AllErrors() []error { return m }
+
+// GetOrderHistoryResponseValidationError is the validation error returned by
+// GetOrderHistoryResponse.Validate if the designated constraints aren't met.
+type GetOrderHistoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetOrderHistoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetOrderHistoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetOrderHistoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetOrderHistoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetOrderHistoryResponseValidationError) ErrorName() string {
+	return "GetOrderHistoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetOrderHistoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetOrderHistoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetOrderHistoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetOrderHistoryResponseValidationError{}
+
+// Validate checks the field values on OrderEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderEventMultiError, or
+// nil if none found.
+func (m *OrderEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for EventType
+
+	if all {
+		switch v := interface{}(m.GetPayload()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderEventValidationError{
+					field:  "Payload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderEventValidationError{
+					field:  "Payload",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPayload()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderEventValidationError{
+				field:  "Payload",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderEventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderEventValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderEventValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.SentAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetSentAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OrderEventValidationError{
+						field:  "SentAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OrderEventValidationError{
+						field:  "SentAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSentAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OrderEventValidationError{
+					field:  "SentAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OrderEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderEventMultiError is an error wrapping multiple validation errors
+// returned by OrderEvent.ValidateAll() if the designated constraints aren't met.
+type OrderEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderEventMultiError) AllErrors() []error { return m }
+
+// OrderEventValidationError is the validation error returned by
+// OrderEvent.Validate if the designated constraints aren't met.
+type OrderEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderEventValidationError) ErrorName() string { return "OrderEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OrderEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderEventValidationError{}
+
+// Validate checks the field values on ExtendStorageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExtendStorageRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExtendStorageRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExtendStorageRequestMultiError, or nil if none found.
+func (m *ExtendStorageRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExtendStorageRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetOrderId()); l < 1 || l > 36 {
+		err := ExtendStorageRequestValidationError{
+			field:  "OrderId",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetExtension() == nil {
+		err := ExtendStorageRequestValidationError{
+			field:  "Extension",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetExtension(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = ExtendStorageRequestValidationError{
+				field:  "Extension",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := ExtendStorageRequestValidationError{
+					field:  "Extension",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if l := utf8.RuneCountInString(m.GetExtendedBy()); l < 1 || l > 36 {
+		err := ExtendStorageRequestValidationError{
+			field:  "ExtendedBy",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.ExpectedVersion != nil {
+
+		if m.GetExpectedVersion() < 1 {
+			err := ExtendStorageRequestValidationError{
+				field:  "ExpectedVersion",
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ExtendStorageRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExtendStorageRequestMultiError is an error wrapping multiple validation
+// errors returned by ExtendStorageRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExtendStorageRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExtendStorageRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExtendStorageRequestMultiError) AllErrors() []error { return m }
+
+// ExtendStorageRequestValidationError is the validation error returned by
+// ExtendStorageRequest.Validate if the designated constraints aren't met.
+type ExtendStorageRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExtendStorageRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExtendStorageRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExtendStorageRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExtendStorageRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExtendStorageRequestValidationError) ErrorName() string {
+	return "ExtendStorageRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExtendStorageRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExtendStorageRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExtendStorageRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExtendStorageRequestValidationError{}
+
+// Validate checks the field values on AcceptOrderDeliveryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AcceptOrderDeliveryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AcceptOrderDeliveryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AcceptOrderDeliveryResponseMultiError, or nil if none found.
+func (m *AcceptOrderDeliveryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AcceptOrderDeliveryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetOrder()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AcceptOrderDeliveryResponseValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AcceptOrderDeliveryResponseValidationError{
+					field:  "Order",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrder()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AcceptOrderDeliveryResponseValidationError{
+				field:  "Order",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AcceptOrderDeliveryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AcceptOrderDeliveryResponseMultiError is an error wrapping multiple
+// validation errors returned by AcceptOrderDeliveryResponse.ValidateAll() if
+// the designated constraints aren't met.
+type AcceptOrderDeliveryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AcceptOrderDeliveryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AcceptOrderDeliveryResponseMultiError) AllErrors() []error { return m }
+
+// AcceptOrderDeliveryResponseValidationError is the validation error returned
+// by AcceptOrderDeliveryResponse.Validate if the designated constraints
+// aren't met.
+type AcceptOrderDeliveryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AcceptOrderDeliveryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AcceptOrderDeliveryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AcceptOrderDeliveryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AcceptOrderDeliveryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AcceptOrderDeliveryResponseValidationError) ErrorName() string {
+	return "AcceptOrderDeliveryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AcceptOrderDeliveryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAcceptOrderDeliveryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AcceptOrderDeliveryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AcceptOrderDeliveryResponseValidationError{}
+
+// Validate checks the field values on GetWeightDiscrepancyReportRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetWeightDiscrepancyReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWeightDiscrepancyReportRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetWeightDiscrepancyReportRequestMultiError, or nil if none found.
+func (m *GetWeightDiscrepancyReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWeightDiscrepancyReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !_GetWeightDiscrepancyReportRequest_Date_Pattern.MatchString(m.GetDate()) {
+		err := GetWeightDiscrepancyReportRequestValidationError{
+			field:  "Date",
+			reason: "value does not match regex pattern \"^([0-9]{4}-[0-9]{2}-[0-9]{2})?$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetWeightDiscrepancyReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWeightDiscrepancyReportRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// GetWeightDiscrepancyReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetWeightDiscrepancyReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWeightDiscrepancyReportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWeightDiscrepancyReportRequestMultiError) AllErrors() []error { return m }
+
+// GetWeightDiscrepancyReportRequestValidationError is the validation error
+// returned by GetWeightDiscrepancyReportRequest.Validate if the designated
+// constraints aren't met.
+type GetWeightDiscrepancyReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWeightDiscrepancyReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWeightDiscrepancyReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWeightDiscrepancyReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWeightDiscrepancyReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWeightDiscrepancyReportRequestValidationError) ErrorName() string {
+	return "GetWeightDiscrepancyReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWeightDiscrepancyReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWeightDiscrepancyReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWeightDiscrepancyReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWeightDiscrepancyReportRequestValidationError{}
+
+var _GetWeightDiscrepancyReportRequest_Date_Pattern = regexp.MustCompile("^([0-9]{4}-[0-9]{2}-[0-9]{2})?$")
+
+// Validate checks the field values on GetWeightDiscrepancyReportResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *GetWeightDiscrepancyReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetWeightDiscrepancyReportResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// GetWeightDiscrepancyReportResponseMultiError, or nil if none found.
+func (m *GetWeightDiscrepancyReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetWeightDiscrepancyReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Date
+
+	for idx, item := range m.GetOrders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetWeightDiscrepancyReportResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetWeightDiscrepancyReportResponseValidationError{
+						field:  fmt.Sprintf("Orders[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetWeightDiscrepancyReportResponseValidationError{
+					field:  fmt.Sprintf("Orders[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetWeightDiscrepancyReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetWeightDiscrepancyReportResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// GetWeightDiscrepancyReportResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetWeightDiscrepancyReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetWeightDiscrepancyReportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetWeightDiscrepancyReportResponseMultiError) AllErrors() []error { return m }
+
+// GetWeightDiscrepancyReportResponseValidationError is the validation error
+// returned by GetWeightDiscrepancyReportResponse.Validate if the designated
+// constraints aren't met.
+type GetWeightDiscrepancyReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetWeightDiscrepancyReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetWeightDiscrepancyReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetWeightDiscrepancyReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetWeightDiscrepancyReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetWeightDiscrepancyReportResponseValidationError) ErrorName() string {
+	return "GetWeightDiscrepancyReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetWeightDiscrepancyReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetWeightDiscrepancyReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetWeightDiscrepancyReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetWeightDiscrepancyReportResponseValidationError{}
+
+// Validate checks the field values on QuotePackagingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QuotePackagingRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QuotePackagingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QuotePackagingRequestMultiError, or nil if none found.
+func (m *QuotePackagingRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QuotePackagingRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCost() < 0 {
+		err := QuotePackagingRequestValidationError{
+			field:  "Cost",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWeight() < 0 {
+		err := QuotePackagingRequestValidationError{
+			field:  "Weight",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetDimensions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QuotePackagingRequestValidationError{
+					field:  "Dimensions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QuotePackagingRequestValidationError{
+					field:  "Dimensions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDimensions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QuotePackagingRequestValidationError{
+				field:  "Dimensions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCostMoney()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QuotePackagingRequestValidationError{
+					field:  "CostMoney",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QuotePackagingRequestValidationError{
+					field:  "CostMoney",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCostMoney()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QuotePackagingRequestValidationError{
+				field:  "CostMoney",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return QuotePackagingRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuotePackagingRequestMultiError is an error wrapping multiple validation
+// errors returned by QuotePackagingRequest.ValidateAll() if the designated
+// constraints aren't met.
+type QuotePackagingRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuotePackagingRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuotePackagingRequestMultiError) AllErrors() []error { return m }
+
+// QuotePackagingRequestValidationError is the validation error returned by
+// QuotePackagingRequest.Validate if the designated constraints aren't met.
+type QuotePackagingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuotePackagingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuotePackagingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuotePackagingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuotePackagingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuotePackagingRequestValidationError) ErrorName() string {
+	return "QuotePackagingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QuotePackagingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuotePackagingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuotePackagingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuotePackagingRequestValidationError{}
+
+// Validate checks the field values on QuotePackagingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QuotePackagingResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QuotePackagingResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QuotePackagingResponseMultiError, or nil if none found.
+func (m *QuotePackagingResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QuotePackagingResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetQuotes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, QuotePackagingResponseValidationError{
+						field:  fmt.Sprintf("Quotes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, QuotePackagingResponseValidationError{
+						field:  fmt.Sprintf("Quotes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return QuotePackagingResponseValidationError{
+					field:  fmt.Sprintf("Quotes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return QuotePackagingResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// QuotePackagingResponseMultiError is an error wrapping multiple validation
+// errors returned by QuotePackagingResponse.ValidateAll() if the designated
+// constraints aren't met.
+type QuotePackagingResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QuotePackagingResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QuotePackagingResponseMultiError) AllErrors() []error { return m }
+
+// QuotePackagingResponseValidationError is the validation error returned by
+// QuotePackagingResponse.Validate if the designated constraints aren't met.
+type QuotePackagingResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QuotePackagingResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QuotePackagingResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QuotePackagingResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QuotePackagingResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QuotePackagingResponseValidationError) ErrorName() string {
+	return "QuotePackagingResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QuotePackagingResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQuotePackagingResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = QuotePackagingResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QuotePackagingResponseValidationError{}
+
+// Validate checks the field values on PackagingQuote with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PackagingQuote) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PackagingQuote with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PackagingQuoteMultiError,
+// or nil if none found.
+func (m *PackagingQuote) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PackagingQuote) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PackagingCode
+
+	// no validation rules for Price
+
+	// no validation rules for Cheapest
+
+	if all {
+		switch v := interface{}(m.GetPriceMoney()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PackagingQuoteValidationError{
+					field:  "PriceMoney",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PackagingQuoteValidationError{
+					field:  "PriceMoney",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPriceMoney()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PackagingQuoteValidationError{
+				field:  "PriceMoney",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PackagingQuoteMultiError(errors)
+	}
+
+	return nil
+}
+
+// PackagingQuoteMultiError is an error wrapping multiple validation errors
+// returned by PackagingQuote.ValidateAll() if the designated constraints
+// aren't met.
+type PackagingQuoteMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PackagingQuoteMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PackagingQuoteMultiError) AllErrors() []error { return m }
+
+// PackagingQuoteValidationError is the validation error returned by
+// PackagingQuote.Validate if the designated constraints aren't met.
+type PackagingQuoteValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PackagingQuoteValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PackagingQuoteValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PackagingQuoteValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PackagingQuoteValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PackagingQuoteValidationError) ErrorName() string { return "PackagingQuoteValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PackagingQuoteValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPackagingQuote.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PackagingQuoteValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PackagingQuoteValidationError{}
+
+// Validate checks the field values on OpenHandoverSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OpenHandoverSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpenHandoverSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OpenHandoverSessionRequestMultiError, or nil if none found.
+func (m *OpenHandoverSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpenHandoverSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetCourierId()); l < 1 || l > 36 {
+		err := OpenHandoverSessionRequestValidationError{
+			field:  "CourierId",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := len(m.GetExpected()); l < 1 || l > 500 {
+		err := OpenHandoverSessionRequestValidationError{
+			field:  "Expected",
+			reason: "value must contain between 1 and 500 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetExpected() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OpenHandoverSessionRequestValidationError{
+						field:  fmt.Sprintf("Expected[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OpenHandoverSessionRequestValidationError{
+						field:  fmt.Sprintf("Expected[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OpenHandoverSessionRequestValidationError{
+					field:  fmt.Sprintf("Expected[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OpenHandoverSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpenHandoverSessionRequestMultiError is an error wrapping multiple
+// validation errors returned by OpenHandoverSessionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type OpenHandoverSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpenHandoverSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpenHandoverSessionRequestMultiError) AllErrors() []error { return m }
+
+// OpenHandoverSessionRequestValidationError is the validation error returned
+// by OpenHandoverSessionRequest.Validate if the designated constraints aren't met.
+type OpenHandoverSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenHandoverSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenHandoverSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenHandoverSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenHandoverSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenHandoverSessionRequestValidationError) ErrorName() string {
+	return "OpenHandoverSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OpenHandoverSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenHandoverSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenHandoverSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenHandoverSessionRequestValidationError{}
+
+// Validate checks the field values on OpenHandoverSessionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OpenHandoverSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OpenHandoverSessionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OpenHandoverSessionResponseMultiError, or nil if none found.
+func (m *OpenHandoverSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OpenHandoverSessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSession()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OpenHandoverSessionResponseValidationError{
+					field:  "Session",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OpenHandoverSessionResponseValidationError{
+					field:  "Session",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSession()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OpenHandoverSessionResponseValidationError{
+				field:  "Session",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return OpenHandoverSessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// OpenHandoverSessionResponseMultiError is an error wrapping multiple
+// validation errors returned by OpenHandoverSessionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type OpenHandoverSessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OpenHandoverSessionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OpenHandoverSessionResponseMultiError) AllErrors() []error { return m }
+
+// OpenHandoverSessionResponseValidationError is the validation error returned
+// by OpenHandoverSessionResponse.Validate if the designated constraints
+// aren't met.
+type OpenHandoverSessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OpenHandoverSessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OpenHandoverSessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OpenHandoverSessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OpenHandoverSessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OpenHandoverSessionResponseValidationError) ErrorName() string {
+	return "OpenHandoverSessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OpenHandoverSessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOpenHandoverSessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OpenHandoverSessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OpenHandoverSessionResponseValidationError{}
+
+// Validate checks the field values on ScanHandoverParcelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ScanHandoverParcelRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScanHandoverParcelRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScanHandoverParcelRequestMultiError, or nil if none found.
+func (m *ScanHandoverParcelRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScanHandoverParcelRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetSessionId()); err != nil {
+		err = ScanHandoverParcelRequestValidationError{
+			field:  "SessionId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetOrderId()); l < 1 || l > 36 {
+		err := ScanHandoverParcelRequestValidationError{
+			field:  "OrderId",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMeasuredWeight() < 0 {
+		err := ScanHandoverParcelRequestValidationError{
+			field:  "MeasuredWeight",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ScanHandoverParcelRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *ScanHandoverParcelRequest) _validateUuid(uuid string) error {
+	if matched := _pvz_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ScanHandoverParcelRequestMultiError is an error wrapping multiple validation
+// errors returned by ScanHandoverParcelRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ScanHandoverParcelRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScanHandoverParcelRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScanHandoverParcelRequestMultiError) AllErrors() []error { return m }
+
+// ScanHandoverParcelRequestValidationError is the validation error returned by
+// ScanHandoverParcelRequest.Validate if the designated constraints aren't met.
+type ScanHandoverParcelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScanHandoverParcelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScanHandoverParcelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScanHandoverParcelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScanHandoverParcelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScanHandoverParcelRequestValidationError) ErrorName() string {
+	return "ScanHandoverParcelRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScanHandoverParcelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScanHandoverParcelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScanHandoverParcelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScanHandoverParcelRequestValidationError{}
+
+// Validate checks the field values on ScanHandoverParcelResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ScanHandoverParcelResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScanHandoverParcelResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScanHandoverParcelResponseMultiError, or nil if none found.
+func (m *ScanHandoverParcelResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScanHandoverParcelResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetScan()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScanHandoverParcelResponseValidationError{
+					field:  "Scan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScanHandoverParcelResponseValidationError{
+					field:  "Scan",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScan()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScanHandoverParcelResponseValidationError{
+				field:  "Scan",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ScanHandoverParcelResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScanHandoverParcelResponseMultiError is an error wrapping multiple
+// validation errors returned by ScanHandoverParcelResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ScanHandoverParcelResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScanHandoverParcelResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScanHandoverParcelResponseMultiError) AllErrors() []error { return m }
+
+// ScanHandoverParcelResponseValidationError is the validation error returned
+// by ScanHandoverParcelResponse.Validate if the designated constraints aren't met.
+type ScanHandoverParcelResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScanHandoverParcelResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScanHandoverParcelResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScanHandoverParcelResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScanHandoverParcelResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScanHandoverParcelResponseValidationError) ErrorName() string {
+	return "ScanHandoverParcelResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScanHandoverParcelResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScanHandoverParcelResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScanHandoverParcelResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScanHandoverParcelResponseValidationError{}
+
+// Validate checks the field values on CloseHandoverSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CloseHandoverSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloseHandoverSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloseHandoverSessionRequestMultiError, or nil if none found.
+func (m *CloseHandoverSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloseHandoverSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetSessionId()); err != nil {
+		err = CloseHandoverSessionRequestValidationError{
+			field:  "SessionId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CloseHandoverSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *CloseHandoverSessionRequest) _validateUuid(uuid string) error {
+	if matched := _pvz_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// CloseHandoverSessionRequestMultiError is an error wrapping multiple
+// validation errors returned by CloseHandoverSessionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CloseHandoverSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloseHandoverSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloseHandoverSessionRequestMultiError) AllErrors() []error { return m }
+
+// CloseHandoverSessionRequestValidationError is the validation error returned
+// by CloseHandoverSessionRequest.Validate if the designated constraints
+// aren't met.
+type CloseHandoverSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloseHandoverSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloseHandoverSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloseHandoverSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloseHandoverSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloseHandoverSessionRequestValidationError) ErrorName() string {
+	return "CloseHandoverSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloseHandoverSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCloseHandoverSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloseHandoverSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloseHandoverSessionRequestValidationError{}
+
+// Validate checks the field values on CloseHandoverSessionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CloseHandoverSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloseHandoverSessionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloseHandoverSessionResponseMultiError, or nil if none found.
+func (m *CloseHandoverSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloseHandoverSessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CloseHandoverSessionResponseValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CloseHandoverSessionResponseValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CloseHandoverSessionResponseValidationError{
+				field:  "Report",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CloseHandoverSessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CloseHandoverSessionResponseMultiError is an error wrapping multiple
+// validation errors returned by CloseHandoverSessionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CloseHandoverSessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloseHandoverSessionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloseHandoverSessionResponseMultiError) AllErrors() []error { return m }
+
+// CloseHandoverSessionResponseValidationError is the validation error returned
+// by CloseHandoverSessionResponse.Validate if the designated constraints
+// aren't met.
+type CloseHandoverSessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloseHandoverSessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloseHandoverSessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloseHandoverSessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloseHandoverSessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloseHandoverSessionResponseValidationError) ErrorName() string {
+	return "CloseHandoverSessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloseHandoverSessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCloseHandoverSessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloseHandoverSessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloseHandoverSessionResponseValidationError{}
+
+// Validate checks the field values on GetHandoverSessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetHandoverSessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetHandoverSessionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetHandoverSessionRequestMultiError, or nil if none found.
+func (m *GetHandoverSessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetHandoverSessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetSessionId()); err != nil {
+		err = GetHandoverSessionRequestValidationError{
+			field:  "SessionId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetHandoverSessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetHandoverSessionRequest) _validateUuid(uuid string) error {
+	if matched := _pvz_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetHandoverSessionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetHandoverSessionRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetHandoverSessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetHandoverSessionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetHandoverSessionRequestMultiError) AllErrors() []error { return m }
+
+// GetHandoverSessionRequestValidationError is the validation error returned by
+// GetHandoverSessionRequest.Validate if the designated constraints aren't met.
+type GetHandoverSessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetHandoverSessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetHandoverSessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetHandoverSessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetHandoverSessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetHandoverSessionRequestValidationError) ErrorName() string {
+	return "GetHandoverSessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetHandoverSessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetHandoverSessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetHandoverSessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetHandoverSessionRequestValidationError{}
+
+// Validate checks the field values on GetHandoverSessionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetHandoverSessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetHandoverSessionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetHandoverSessionResponseMultiError, or nil if none found.
+func (m *GetHandoverSessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetHandoverSessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSession()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetHandoverSessionResponseValidationError{
+					field:  "Session",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetHandoverSessionResponseValidationError{
+					field:  "Session",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSession()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetHandoverSessionResponseValidationError{
+				field:  "Session",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetHandoverSessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetHandoverSessionResponseMultiError is an error wrapping multiple
+// validation errors returned by GetHandoverSessionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetHandoverSessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetHandoverSessionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetHandoverSessionResponseMultiError) AllErrors() []error { return m }
+
+// GetHandoverSessionResponseValidationError is the validation error returned
+// by GetHandoverSessionResponse.Validate if the designated constraints aren't met.
+type GetHandoverSessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetHandoverSessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetHandoverSessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetHandoverSessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetHandoverSessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetHandoverSessionResponseValidationError) ErrorName() string {
+	return "GetHandoverSessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetHandoverSessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetHandoverSessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetHandoverSessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetHandoverSessionResponseValidationError{}
+
+// Validate checks the field values on HandoverSession with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HandoverSession) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HandoverSession with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HandoverSessionMultiError, or nil if none found.
+func (m *HandoverSession) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HandoverSession) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for PvzId
+
+	// no validation rules for CourierId
+
+	// no validation rules for Status
+
+	for idx, item := range m.GetScans() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HandoverSessionValidationError{
+						field:  fmt.Sprintf("Scans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HandoverSessionValidationError{
+						field:  fmt.Sprintf("Scans[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HandoverSessionValidationError{
+					field:  fmt.Sprintf("Scans[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetOpenedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HandoverSessionValidationError{
+					field:  "OpenedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HandoverSessionValidationError{
+					field:  "OpenedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOpenedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HandoverSessionValidationError{
+				field:  "OpenedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HandoverSessionValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HandoverSessionValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HandoverSessionValidationError{
+				field:  "Report",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.ClosedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetClosedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HandoverSessionValidationError{
+						field:  "ClosedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HandoverSessionValidationError{
+						field:  "ClosedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetClosedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HandoverSessionValidationError{
+					field:  "ClosedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return HandoverSessionMultiError(errors)
+	}
+
+	return nil
+}
+
+// HandoverSessionMultiError is an error wrapping multiple validation errors
+// returned by HandoverSession.ValidateAll() if the designated constraints
+// aren't met.
+type HandoverSessionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HandoverSessionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HandoverSessionMultiError) AllErrors() []error { return m }
+
+// HandoverSessionValidationError is the validation error returned by
+// HandoverSession.Validate if the designated constraints aren't met.
+type HandoverSessionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HandoverSessionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HandoverSessionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HandoverSessionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HandoverSessionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HandoverSessionValidationError) ErrorName() string { return "HandoverSessionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HandoverSessionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHandoverSession.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HandoverSessionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HandoverSessionValidationError{}
+
+// Validate checks the field values on HandoverScan with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HandoverScan) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HandoverScan with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HandoverScanMultiError, or
+// nil if none found.
+func (m *HandoverScan) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HandoverScan) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for MeasuredWeight
+
+	// no validation rules for Result
+
+	// no validation rules for Reason
+
+	if all {
+		switch v := interface{}(m.GetScannedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HandoverScanValidationError{
+					field:  "ScannedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HandoverScanValidationError{
+					field:  "ScannedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScannedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HandoverScanValidationError{
+				field:  "ScannedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return HandoverScanMultiError(errors)
+	}
+
+	return nil
+}
+
+// HandoverScanMultiError is an error wrapping multiple validation errors
+// returned by HandoverScan.ValidateAll() if the designated constraints aren't met.
+type HandoverScanMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HandoverScanMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HandoverScanMultiError) AllErrors() []error { return m }
+
+// HandoverScanValidationError is the validation error returned by
+// HandoverScan.Validate if the designated constraints aren't met.
+type HandoverScanValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HandoverScanValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HandoverScanValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HandoverScanValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HandoverScanValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HandoverScanValidationError) ErrorName() string { return "HandoverScanValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HandoverScanValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHandoverScan.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HandoverScanValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HandoverScanValidationError{}
+
+// Validate checks the field values on HandoverReport with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HandoverReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HandoverReport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HandoverReportMultiError,
+// or nil if none found.
+func (m *HandoverReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HandoverReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SessionId
+
+	for idx, item := range m.GetWeightMismatches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HandoverReportValidationError{
+						field:  fmt.Sprintf("WeightMismatches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HandoverReportValidationError{
+						field:  fmt.Sprintf("WeightMismatches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HandoverReportValidationError{
+					field:  fmt.Sprintf("WeightMismatches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetRejected() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HandoverReportValidationError{
+						field:  fmt.Sprintf("Rejected[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HandoverReportValidationError{
+						field:  fmt.Sprintf("Rejected[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HandoverReportValidationError{
+					field:  fmt.Sprintf("Rejected[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return HandoverReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// HandoverReportMultiError is an error wrapping multiple validation errors
+// returned by HandoverReport.ValidateAll() if the designated constraints
+// aren't met.
+type HandoverReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HandoverReportMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HandoverReportMultiError) AllErrors() []error { return m }
+
+// HandoverReportValidationError is the validation error returned by
+// HandoverReport.Validate if the designated constraints aren't met.
+type HandoverReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HandoverReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HandoverReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HandoverReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HandoverReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HandoverReportValidationError) ErrorName() string { return "HandoverReportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HandoverReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHandoverReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HandoverReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HandoverReportValidationError{}
+
+// Validate checks the field values on HandoverWeightMismatch with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *HandoverWeightMismatch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HandoverWeightMismatch with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HandoverWeightMismatchMultiError, or nil if none found.
+func (m *HandoverWeightMismatch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HandoverWeightMismatch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for DeclaredWeight
+
+	// no validation rules for MeasuredWeight
+
+	if len(errors) > 0 {
+		return HandoverWeightMismatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// HandoverWeightMismatchMultiError is an error wrapping multiple validation
+// errors returned by HandoverWeightMismatch.ValidateAll() if the designated
+// constraints aren't met.
+type HandoverWeightMismatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HandoverWeightMismatchMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HandoverWeightMismatchMultiError) AllErrors() []error { return m }
+
+// HandoverWeightMismatchValidationError is the validation error returned by
+// HandoverWeightMismatch.Validate if the designated constraints aren't met.
+type HandoverWeightMismatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HandoverWeightMismatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HandoverWeightMismatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HandoverWeightMismatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HandoverWeightMismatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HandoverWeightMismatchValidationError) ErrorName() string {
+	return "HandoverWeightMismatchValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HandoverWeightMismatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHandoverWeightMismatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HandoverWeightMismatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HandoverWeightMismatchValidationError{}
+
+// Validate checks the field values on HandoverRejection with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *HandoverRejection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HandoverRejection with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HandoverRejectionMultiError, or nil if none found.
+func (m *HandoverRejection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HandoverRejection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return HandoverRejectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// HandoverRejectionMultiError is an error wrapping multiple validation errors
+// returned by HandoverRejection.ValidateAll() if the designated constraints
+// aren't met.
+type HandoverRejectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HandoverRejectionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HandoverRejectionMultiError) AllErrors() []error { return m }
+
+// HandoverRejectionValidationError is the validation error returned by
+// HandoverRejection.Validate if the designated constraints aren't met.
+type HandoverRejectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HandoverRejectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HandoverRejectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HandoverRejectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HandoverRejectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HandoverRejectionValidationError) ErrorName() string {
+	return "HandoverRejectionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HandoverRejectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHandoverRejection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HandoverRejectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HandoverRejectionValidationError{}
+
+// Validate checks the field values on GetPVZPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPVZPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPVZPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPVZPolicyRequestMultiError, or nil if none found.
+func (m *GetPVZPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPVZPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetPvzId()); l < 1 || l > 255 {
+		err := GetPVZPolicyRequestValidationError{
+			field:  "PvzId",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetPVZPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPVZPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPVZPolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPVZPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPVZPolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPVZPolicyRequestMultiError) AllErrors() []error { return m }
+
+// GetPVZPolicyRequestValidationError is the validation error returned by
+// GetPVZPolicyRequest.Validate if the designated constraints aren't met.
+type GetPVZPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPVZPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPVZPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPVZPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPVZPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPVZPolicyRequestValidationError) ErrorName() string {
+	return "GetPVZPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPVZPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPVZPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPVZPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPVZPolicyRequestValidationError{}
+
+// Validate checks the field values on GetPVZPolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPVZPolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPVZPolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPVZPolicyResponseMultiError, or nil if none found.
+func (m *GetPVZPolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPVZPolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetPVZPolicyResponseValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetPVZPolicyResponseValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetPVZPolicyResponseValidationError{
+				field:  "Policy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetPVZPolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPVZPolicyResponseMultiError is an error wrapping multiple validation
+// errors returned by GetPVZPolicyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetPVZPolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPVZPolicyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPVZPolicyResponseMultiError) AllErrors() []error { return m }
+
+// GetPVZPolicyResponseValidationError is the validation error returned by
+// GetPVZPolicyResponse.Validate if the designated constraints aren't met.
+type GetPVZPolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPVZPolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPVZPolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPVZPolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPVZPolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPVZPolicyResponseValidationError) ErrorName() string {
+	return "GetPVZPolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPVZPolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPVZPolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPVZPolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPVZPolicyResponseValidationError{}
+
+// Validate checks the field values on UpdatePVZPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePVZPolicyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePVZPolicyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePVZPolicyRequestMultiError, or nil if none found.
+func (m *UpdatePVZPolicyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePVZPolicyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetPvzId()); l < 1 || l > 255 {
+		err := UpdatePVZPolicyRequestValidationError{
+			field:  "PvzId",
+			reason: "value length must be between 1 and 255 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetReturnWindow(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = UpdatePVZPolicyRequestValidationError{
+				field:  "ReturnWindow",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := UpdatePVZPolicyRequestValidationError{
+					field:  "ReturnWindow",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if d := m.GetMaxStorageTime(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = UpdatePVZPolicyRequestValidationError{
+				field:  "MaxStorageTime",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := UpdatePVZPolicyRequestValidationError{
+					field:  "MaxStorageTime",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(m.GetAllowedPackaging()) > 32 {
+		err := UpdatePVZPolicyRequestValidationError{
+			field:  "AllowedPackaging",
+			reason: "value must contain no more than 32 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_UpdatePVZPolicyRequest_AllowedPackaging_Unique := make(map[string]struct{}, len(m.GetAllowedPackaging()))
+
+	for idx, item := range m.GetAllowedPackaging() {
+		_, _ = idx, item
+
+		if _, exists := _UpdatePVZPolicyRequest_AllowedPackaging_Unique[item]; exists {
+			err := UpdatePVZPolicyRequestValidationError{
+				field:  fmt.Sprintf("AllowedPackaging[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_UpdatePVZPolicyRequest_AllowedPackaging_Unique[item] = struct{}{}
+		}
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 32 {
+			err := UpdatePVZPolicyRequestValidationError{
+				field:  fmt.Sprintf("AllowedPackaging[%v]", idx),
+				reason: "value length must be between 1 and 32 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if l := utf8.RuneCountInString(m.GetUpdatedBy()); l < 1 || l > 36 {
+		err := UpdatePVZPolicyRequestValidationError{
+			field:  "UpdatedBy",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AllPackagingAllowed
+
+	if m.MaxOrderWeight != nil {
+
+		if m.GetMaxOrderWeight() < 0 {
+			err := UpdatePVZPolicyRequestValidationError{
+				field:  "MaxOrderWeight",
+				reason: "value must be greater than or equal to 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.ExpectedVersion != nil {
+
+		if m.GetExpectedVersion() < 1 {
+			err := UpdatePVZPolicyRequestValidationError{
+				field:  "ExpectedVersion",
+				reason: "value must be greater than or equal to 1",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UpdatePVZPolicyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePVZPolicyRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdatePVZPolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePVZPolicyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePVZPolicyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePVZPolicyRequestMultiError) AllErrors() []error { return m }
+
+// UpdatePVZPolicyRequestValidationError is the validation error returned by
+// UpdatePVZPolicyRequest.Validate if the designated constraints aren't met.
+type UpdatePVZPolicyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePVZPolicyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePVZPolicyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePVZPolicyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePVZPolicyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePVZPolicyRequestValidationError) ErrorName() string {
+	return "UpdatePVZPolicyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePVZPolicyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePVZPolicyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePVZPolicyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePVZPolicyRequestValidationError{}
+
+// Validate checks the field values on UpdatePVZPolicyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdatePVZPolicyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdatePVZPolicyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdatePVZPolicyResponseMultiError, or nil if none found.
+func (m *UpdatePVZPolicyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdatePVZPolicyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdatePVZPolicyResponseValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdatePVZPolicyResponseValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdatePVZPolicyResponseValidationError{
+				field:  "Policy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdatePVZPolicyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdatePVZPolicyResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdatePVZPolicyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdatePVZPolicyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdatePVZPolicyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdatePVZPolicyResponseMultiError) AllErrors() []error { return m }
+
+// UpdatePVZPolicyResponseValidationError is the validation error returned by
+// UpdatePVZPolicyResponse.Validate if the designated constraints aren't met.
+type UpdatePVZPolicyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdatePVZPolicyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdatePVZPolicyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdatePVZPolicyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdatePVZPolicyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdatePVZPolicyResponseValidationError) ErrorName() string {
+	return "UpdatePVZPolicyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdatePVZPolicyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdatePVZPolicyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdatePVZPolicyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdatePVZPolicyResponseValidationError{}
+
+// Validate checks the field values on PVZPolicy with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PVZPolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PVZPolicy with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PVZPolicyMultiError, or nil
+// if none found.
+func (m *PVZPolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PVZPolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PvzId
+
+	if all {
+		switch v := interface{}(m.GetReturnWindow()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PVZPolicyValidationError{
+					field:  "ReturnWindow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PVZPolicyValidationError{
+					field:  "ReturnWindow",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReturnWindow()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PVZPolicyValidationError{
+				field:  "ReturnWindow",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMaxStorageTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PVZPolicyValidationError{
+					field:  "MaxStorageTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PVZPolicyValidationError{
+					field:  "MaxStorageTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxStorageTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PVZPolicyValidationError{
+				field:  "MaxStorageTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MaxOrderWeight
+
+	// no validation rules for Version
+
+	// no validation rules for UpdatedBy
+
+	if m.UpdatedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetUpdatedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PVZPolicyValidationError{
+						field:  "UpdatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PVZPolicyValidationError{
+						field:  "UpdatedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PVZPolicyValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PVZPolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// PVZPolicyMultiError is an error wrapping multiple validation errors returned
+// by PVZPolicy.ValidateAll() if the designated constraints aren't met.
+type PVZPolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PVZPolicyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PVZPolicyMultiError) AllErrors() []error { return m }
+
+// PVZPolicyValidationError is the validation error returned by
+// PVZPolicy.Validate if the designated constraints aren't met.
+type PVZPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PVZPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PVZPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PVZPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PVZPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PVZPolicyValidationError) ErrorName() string { return "PVZPolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PVZPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPVZPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PVZPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PVZPolicyValidationError{}
+
+// Validate checks the field values on CreateReturnShipmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReturnShipmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReturnShipmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReturnShipmentRequestMultiError, or nil if none found.
+func (m *CreateReturnShipmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReturnShipmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetOrderIds()); l < 1 || l > 500 {
+		err := CreateReturnShipmentRequestValidationError{
+			field:  "OrderIds",
+			reason: "value must contain between 1 and 500 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_CreateReturnShipmentRequest_OrderIds_Unique := make(map[string]struct{}, len(m.GetOrderIds()))
+
+	for idx, item := range m.GetOrderIds() {
+		_, _ = idx, item
+
+		if _, exists := _CreateReturnShipmentRequest_OrderIds_Unique[item]; exists {
+			err := CreateReturnShipmentRequestValidationError{
+				field:  fmt.Sprintf("OrderIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_CreateReturnShipmentRequest_OrderIds_Unique[item] = struct{}{}
+		}
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 36 {
+			err := CreateReturnShipmentRequestValidationError{
+				field:  fmt.Sprintf("OrderIds[%v]", idx),
+				reason: "value length must be between 1 and 36 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateReturnShipmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReturnShipmentRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateReturnShipmentRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateReturnShipmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReturnShipmentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReturnShipmentRequestMultiError) AllErrors() []error { return m }
+
+// CreateReturnShipmentRequestValidationError is the validation error returned
+// by CreateReturnShipmentRequest.Validate if the designated constraints
+// aren't met.
+type CreateReturnShipmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReturnShipmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReturnShipmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReturnShipmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReturnShipmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReturnShipmentRequestValidationError) ErrorName() string {
+	return "CreateReturnShipmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReturnShipmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReturnShipmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReturnShipmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReturnShipmentRequestValidationError{}
+
+// Validate checks the field values on CreateReturnShipmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateReturnShipmentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateReturnShipmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateReturnShipmentResponseMultiError, or nil if none found.
+func (m *CreateReturnShipmentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateReturnShipmentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetShipment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateReturnShipmentResponseValidationError{
+					field:  "Shipment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateReturnShipmentResponseValidationError{
+					field:  "Shipment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetShipment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateReturnShipmentResponseValidationError{
+				field:  "Shipment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateReturnShipmentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateReturnShipmentResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateReturnShipmentResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateReturnShipmentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateReturnShipmentResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateReturnShipmentResponseMultiError) AllErrors() []error { return m }
+
+// CreateReturnShipmentResponseValidationError is the validation error returned
+// by CreateReturnShipmentResponse.Validate if the designated constraints
+// aren't met.
+type CreateReturnShipmentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateReturnShipmentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateReturnShipmentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateReturnShipmentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateReturnShipmentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateReturnShipmentResponseValidationError) ErrorName() string {
+	return "CreateReturnShipmentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateReturnShipmentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateReturnShipmentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateReturnShipmentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateReturnShipmentResponseValidationError{}
+
+// Validate checks the field values on DispatchReturnShipmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DispatchReturnShipmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DispatchReturnShipmentRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DispatchReturnShipmentRequestMultiError, or nil if none found.
+func (m *DispatchReturnShipmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DispatchReturnShipmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetShipmentId()); err != nil {
+		err = DispatchReturnShipmentRequestValidationError{
+			field:  "ShipmentId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetCourierId()); l < 1 || l > 36 {
+		err := DispatchReturnShipmentRequestValidationError{
+			field:  "CourierId",
+			reason: "value length must be between 1 and 36 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DispatchReturnShipmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *DispatchReturnShipmentRequest) _validateUuid(uuid string) error {
+	if matched := _pvz_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// DispatchReturnShipmentRequestMultiError is an error wrapping multiple
+// validation errors returned by DispatchReturnShipmentRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DispatchReturnShipmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DispatchReturnShipmentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DispatchReturnShipmentRequestMultiError) AllErrors() []error { return m }
+
+// DispatchReturnShipmentRequestValidationError is the validation error
+// returned by DispatchReturnShipmentRequest.Validate if the designated
+// constraints aren't met.
+type DispatchReturnShipmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DispatchReturnShipmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DispatchReturnShipmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DispatchReturnShipmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DispatchReturnShipmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DispatchReturnShipmentRequestValidationError) ErrorName() string {
+	return "DispatchReturnShipmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DispatchReturnShipmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDispatchReturnShipmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DispatchReturnShipmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DispatchReturnShipmentRequestValidationError{}
+
+// Validate checks the field values on DispatchReturnShipmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DispatchReturnShipmentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DispatchReturnShipmentResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DispatchReturnShipmentResponseMultiError, or nil if none found.
+func (m *DispatchReturnShipmentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DispatchReturnShipmentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetManifest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DispatchReturnShipmentResponseValidationError{
+					field:  "Manifest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DispatchReturnShipmentResponseValidationError{
+					field:  "Manifest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetManifest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DispatchReturnShipmentResponseValidationError{
+				field:  "Manifest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DispatchReturnShipmentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DispatchReturnShipmentResponseMultiError is an error wrapping multiple
+// validation errors returned by DispatchReturnShipmentResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DispatchReturnShipmentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DispatchReturnShipmentResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DispatchReturnShipmentResponseMultiError) AllErrors() []error { return m }
+
+// DispatchReturnShipmentResponseValidationError is the validation error
+// returned by DispatchReturnShipmentResponse.Validate if the designated
+// constraints aren't met.
+type DispatchReturnShipmentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DispatchReturnShipmentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DispatchReturnShipmentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DispatchReturnShipmentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DispatchReturnShipmentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DispatchReturnShipmentResponseValidationError) ErrorName() string {
+	return "DispatchReturnShipmentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DispatchReturnShipmentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDispatchReturnShipmentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DispatchReturnShipmentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DispatchReturnShipmentResponseValidationError{}
+
+// Validate checks the field values on GetReturnShipmentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReturnShipmentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReturnShipmentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReturnShipmentRequestMultiError, or nil if none found.
+func (m *GetReturnShipmentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReturnShipmentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if err := m._validateUuid(m.GetShipmentId()); err != nil {
+		err = GetReturnShipmentRequestValidationError{
+			field:  "ShipmentId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetReturnShipmentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *GetReturnShipmentRequest) _validateUuid(uuid string) error {
+	if matched := _pvz_service_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// GetReturnShipmentRequestMultiError is an error wrapping multiple validation
+// errors returned by GetReturnShipmentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetReturnShipmentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReturnShipmentRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReturnShipmentRequestMultiError) AllErrors() []error { return m }
+
+// GetReturnShipmentRequestValidationError is the validation error returned by
+// GetReturnShipmentRequest.Validate if the designated constraints aren't met.
+type GetReturnShipmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReturnShipmentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReturnShipmentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReturnShipmentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReturnShipmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReturnShipmentRequestValidationError) ErrorName() string {
+	return "GetReturnShipmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReturnShipmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReturnShipmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReturnShipmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReturnShipmentRequestValidationError{}
+
+// Validate checks the field values on GetReturnShipmentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetReturnShipmentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetReturnShipmentResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetReturnShipmentResponseMultiError, or nil if none found.
+func (m *GetReturnShipmentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetReturnShipmentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetShipment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReturnShipmentResponseValidationError{
+					field:  "Shipment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReturnShipmentResponseValidationError{
+					field:  "Shipment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetShipment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReturnShipmentResponseValidationError{
+				field:  "Shipment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetReturnShipmentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetReturnShipmentResponseMultiError is an error wrapping multiple validation
+// errors returned by GetReturnShipmentResponse.ValidateAll() if the
+// designated constraints aren't met.
+type GetReturnShipmentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetReturnShipmentResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetReturnShipmentResponseMultiError) AllErrors() []error { return m }
+
+// GetReturnShipmentResponseValidationError is the validation error returned by
+// GetReturnShipmentResponse.Validate if the designated constraints aren't met.
+type GetReturnShipmentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetReturnShipmentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetReturnShipmentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetReturnShipmentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetReturnShipmentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetReturnShipmentResponseValidationError) ErrorName() string {
+	return "GetReturnShipmentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetReturnShipmentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetReturnShipmentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetReturnShipmentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetReturnShipmentResponseValidationError{}
+
+// Validate checks the field values on ReturnShipment with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReturnShipment) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnShipment with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReturnShipmentMultiError,
+// or nil if none found.
+func (m *ReturnShipment) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnShipment) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for PvzId
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReturnShipmentValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReturnShipmentValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReturnShipmentValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetManifest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReturnShipmentValidationError{
+					field:  "Manifest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReturnShipmentValidationError{
+					field:  "Manifest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetManifest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReturnShipmentValidationError{
+				field:  "Manifest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.CourierId != nil {
+		// no validation rules for CourierId
+	}
+
+	if m.DispatchedAt != nil {
+
+		if all {
+			switch v := interface{}(m.GetDispatchedAt()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReturnShipmentValidationError{
+						field:  "DispatchedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReturnShipmentValidationError{
+						field:  "DispatchedAt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetDispatchedAt()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReturnShipmentValidationError{
+					field:  "DispatchedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReturnShipmentMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReturnShipmentMultiError is an error wrapping multiple validation errors
+// returned by ReturnShipment.ValidateAll() if the designated constraints
+// aren't met.
+type ReturnShipmentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReturnShipmentMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnShipmentMultiError) AllErrors() []error { return m }
+
+// ReturnShipmentValidationError is the validation error returned by
+// ReturnShipment.Validate if the designated constraints aren't met.
+type ReturnShipmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnShipmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnShipmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnShipmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnShipmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnShipmentValidationError) ErrorName() string { return "ReturnShipmentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReturnShipmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnShipment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnShipmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnShipmentValidationError{}
+
+// Validate checks the field values on ReturnManifest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ReturnManifest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnManifest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ReturnManifestMultiError,
+// or nil if none found.
+func (m *ReturnManifest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnManifest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ShipmentId
+
+	// no validation rules for PvzId
+
+	// no validation rules for CourierId
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReturnManifestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReturnManifestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReturnManifestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetDispatchedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReturnManifestValidationError{
+					field:  "DispatchedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReturnManifestValidationError{
+					field:  "DispatchedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDispatchedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReturnManifestValidationError{
+				field:  "DispatchedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ReturnManifestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReturnManifestMultiError is an error wrapping multiple validation errors
+// returned by ReturnManifest.ValidateAll() if the designated constraints
+// aren't met.
+type ReturnManifestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReturnManifestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReturnManifestMultiError) AllErrors() []error { return m }
+
+// ReturnManifestValidationError is the validation error returned by
+// ReturnManifest.Validate if the designated constraints aren't met.
+type ReturnManifestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReturnManifestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReturnManifestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReturnManifestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReturnManifestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReturnManifestValidationError) ErrorName() string { return "ReturnManifestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ReturnManifestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReturnManifest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReturnManifestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReturnManifestValidationError{}
+
+// Validate checks the field values on ReturnManifestItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReturnManifestItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReturnManifestItem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReturnManifestItemMultiError, or nil if none found.
+func (m *ReturnManifestItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReturnManifestItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderId
+
+	// no validation rules for RecipientId
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetCost()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReturnManifestItemValidationError{
+					field:  "Cost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ReturnManifestItemValidationError{
+					field:  "Cost",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCost()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReturnManifestItemValidationError{
+				field:  "Cost",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Weight
+
+	// no validation rules for PackagingCode
+
+	// no validation rules for ReturnReason
+
+	// no validation rules for Inspection
+
+	if all {
+		switch v := interface{}(m.GetReturnedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ReturnManifestItemValidationError{
+					field:  "ReturnedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return GetReturnsResponseValidationError{
-					field:  fmt.Sprintf("Returns[%v]", idx),
+				errors = append(errors, ReturnManifestItemValidationError{
+					field:  "ReturnedAt",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReturnedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ReturnManifestItemValidationError{
+				field:  "ReturnedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
+	if m.ReturnComment != nil {
+		// no validation rules for ReturnComment
 	}
 
 	if len(errors) > 0 {
-		return GetReturnsResponseMultiError(errors)
+		return ReturnManifestItemMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetReturnsResponseMultiError is an error wrapping multiple validation errors
-// returned by GetReturnsResponse.ValidateAll() if the designated constraints
+// ReturnManifestItemMultiError is an error wrapping multiple validation errors
+// returned by ReturnManifestItem.ValidateAll() if the designated constraints
 // aren't met.
-type GetReturnsResponseMultiError []error
+type ReturnManifestItemMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetReturnsResponseMultiError) Error() string {
+func (m ReturnManifestItemMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1109,11 +7903,11 @@ func (m GetReturnsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetReturnsResponseMultiError) AllErrors() []error { return m }
+func (m ReturnManifestItemMultiError) AllErrors() []error { return m }
 
-// GetReturnsResponseValidationError is the validation error returned by
-// GetReturnsResponse.Validate if the designated constraints aren't met.
-type GetReturnsResponseValidationError struct {
+// ReturnManifestItemValidationError is the validation error returned by
+// ReturnManifestItem.Validate if the designated constraints aren't met.
+type ReturnManifestItemValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1121,24 +7915,24 @@ type GetReturnsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetReturnsResponseValidationError) Field() string { return e.field }
+func (e ReturnManifestItemValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetReturnsResponseValidationError) Reason() string { return e.reason }
+func (e ReturnManifestItemValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetReturnsResponseValidationError) Cause() error { return e.cause }
+func (e ReturnManifestItemValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetReturnsResponseValidationError) Key() bool { return e.key }
+func (e ReturnManifestItemValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetReturnsResponseValidationError) ErrorName() string {
-	return "GetReturnsResponseValidationError"
+func (e ReturnManifestItemValidationError) ErrorName() string {
+	return "ReturnManifestItemValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetReturnsResponseValidationError) Error() string {
+func (e ReturnManifestItemValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1150,14 +7944,14 @@ func (e GetReturnsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetReturnsResponse.%s: %s%s",
+		"invalid %sReturnManifestItem.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetReturnsResponseValidationError{}
+var _ error = ReturnManifestItemValidationError{}
 
 var _ interface {
 	Field() string
@@ -1165,7 +7959,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetReturnsResponseValidationError{}
+} = ReturnManifestItemValidationError{}
 
 // Validate checks the field values on PVZOrder with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
@@ -1261,6 +8055,227 @@ func (m *PVZOrder) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Status
+
+	// no validation rules for Version
+
+	// no validation rules for StorageExtensions
+
+	// no validation rules for PackagingCode
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PVZOrderValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PVZOrderValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PVZOrderValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDimensions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PVZOrderValidationError{
+					field:  "Dimensions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PVZOrderValidationError{
+					field:  "Dimensions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDimensions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PVZOrderValidationError{
+				field:  "Dimensions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for VolumetricWeight
+
+	if all {
+		switch v := interface{}(m.GetCostMoney()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PVZOrderValidationError{
+					field:  "CostMoney",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PVZOrderValidationError{
+					field:  "CostMoney",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCostMoney()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PVZOrderValidationError{
+				field:  "CostMoney",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for MeasuredWeight
+
+	// no validation rules for WeightDiscrepancy
+
+	if all {
+		switch v := interface{}(m.GetPaidStorageUntil()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PVZOrderValidationError{
+					field:  "PaidStorageUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PVZOrderValidationError{
+					field:  "PaidStorageUntil",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPaidStorageUntil()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PVZOrderValidationError{
+				field:  "PaidStorageUntil",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDailyStorageFee()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PVZOrderValidationError{
+					field:  "DailyStorageFee",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PVZOrderValidationError{
+					field:  "DailyStorageFee",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDailyStorageFee()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PVZOrderValidationError{
+				field:  "DailyStorageFee",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStorageFee()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PVZOrderValidationError{
+					field:  "StorageFee",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PVZOrderValidationError{
+					field:  "StorageFee",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStorageFee()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PVZOrderValidationError{
+				field:  "StorageFee",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStorageFeePaid()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PVZOrderValidationError{
+					field:  "StorageFeePaid",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PVZOrderValidationError{
+					field:  "StorageFeePaid",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStorageFeePaid()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PVZOrderValidationError{
+				field:  "StorageFeePaid",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ReturnReason
+
+	// no validation rules for Inspection
+
 	if m.IssuedAt != nil {
 
 		if all {
@@ -1327,6 +8342,51 @@ func (m *PVZOrder) validate(all bool) error {
 
 	}
 
+	if m.ExtendedBy != nil {
+		// no validation rules for ExtendedBy
+	}
+
+	if m.WeightOverrideReason != nil {
+		// no validation rules for WeightOverrideReason
+	}
+
+	if m.ReturnDeadline != nil {
+
+		if all {
+			switch v := interface{}(m.GetReturnDeadline()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PVZOrderValidationError{
+						field:  "ReturnDeadline",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PVZOrderValidationError{
+						field:  "ReturnDeadline",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetReturnDeadline()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PVZOrderValidationError{
+					field:  "ReturnDeadline",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.ReturnComment != nil {
+		// no validation rules for ReturnComment
+	}
+
 	if len(errors) > 0 {
 		return PVZOrderMultiError(errors)
 	}
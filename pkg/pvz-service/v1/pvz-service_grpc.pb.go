@@ -20,24 +20,52 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PvzService_AcceptOrderDelivery_FullMethodName = "/pvz.v1.PvzService/AcceptOrderDelivery"
-	PvzService_ReturnOrderDelivery_FullMethodName = "/pvz.v1.PvzService/ReturnOrderDelivery"
-	PvzService_GiveOrderToClient_FullMethodName   = "/pvz.v1.PvzService/GiveOrderToClient"
-	PvzService_GetOrders_FullMethodName           = "/pvz.v1.PvzService/GetOrders"
-	PvzService_AcceptReturn_FullMethodName        = "/pvz.v1.PvzService/AcceptReturn"
-	PvzService_GetReturns_FullMethodName          = "/pvz.v1.PvzService/GetReturns"
+	PvzService_AcceptOrderDelivery_FullMethodName        = "/pvz.v1.PvzService/AcceptOrderDelivery"
+	PvzService_BatchAcceptOrderDelivery_FullMethodName   = "/pvz.v1.PvzService/BatchAcceptOrderDelivery"
+	PvzService_ReturnOrderDelivery_FullMethodName        = "/pvz.v1.PvzService/ReturnOrderDelivery"
+	PvzService_GiveOrderToClient_FullMethodName          = "/pvz.v1.PvzService/GiveOrderToClient"
+	PvzService_GetOrders_FullMethodName                  = "/pvz.v1.PvzService/GetOrders"
+	PvzService_AcceptReturn_FullMethodName               = "/pvz.v1.PvzService/AcceptReturn"
+	PvzService_GetReturns_FullMethodName                 = "/pvz.v1.PvzService/GetReturns"
+	PvzService_GetOrderHistory_FullMethodName            = "/pvz.v1.PvzService/GetOrderHistory"
+	PvzService_ExtendStorage_FullMethodName              = "/pvz.v1.PvzService/ExtendStorage"
+	PvzService_QuotePackaging_FullMethodName             = "/pvz.v1.PvzService/QuotePackaging"
+	PvzService_GetWeightDiscrepancyReport_FullMethodName = "/pvz.v1.PvzService/GetWeightDiscrepancyReport"
+	PvzService_OpenHandoverSession_FullMethodName        = "/pvz.v1.PvzService/OpenHandoverSession"
+	PvzService_ScanHandoverParcel_FullMethodName         = "/pvz.v1.PvzService/ScanHandoverParcel"
+	PvzService_CloseHandoverSession_FullMethodName       = "/pvz.v1.PvzService/CloseHandoverSession"
+	PvzService_GetHandoverSession_FullMethodName         = "/pvz.v1.PvzService/GetHandoverSession"
+	PvzService_GetPVZPolicy_FullMethodName               = "/pvz.v1.PvzService/GetPVZPolicy"
+	PvzService_UpdatePVZPolicy_FullMethodName            = "/pvz.v1.PvzService/UpdatePVZPolicy"
+	PvzService_CreateReturnShipment_FullMethodName       = "/pvz.v1.PvzService/CreateReturnShipment"
+	PvzService_DispatchReturnShipment_FullMethodName     = "/pvz.v1.PvzService/DispatchReturnShipment"
+	PvzService_GetReturnShipment_FullMethodName          = "/pvz.v1.PvzService/GetReturnShipment"
 )
 
 // PvzServiceClient is the client API for PvzService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type PvzServiceClient interface {
-	AcceptOrderDelivery(ctx context.Context, in *AcceptOrderDeliveryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	AcceptOrderDelivery(ctx context.Context, in *AcceptOrderDeliveryRequest, opts ...grpc.CallOption) (*AcceptOrderDeliveryResponse, error)
+	BatchAcceptOrderDelivery(ctx context.Context, in *BatchAcceptOrderDeliveryRequest, opts ...grpc.CallOption) (*BatchAcceptOrderDeliveryResponse, error)
 	ReturnOrderDelivery(ctx context.Context, in *ReturnOrderDeliveryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
-	GiveOrderToClient(ctx context.Context, in *GiveOrderToClientRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GiveOrderToClient(ctx context.Context, in *GiveOrderToClientRequest, opts ...grpc.CallOption) (*GiveOrderToClientResponse, error)
 	GetOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*GetOrdersResponse, error)
 	AcceptReturn(ctx context.Context, in *AcceptReturnRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetReturns(ctx context.Context, in *GetReturnsRequest, opts ...grpc.CallOption) (*GetReturnsResponse, error)
+	GetOrderHistory(ctx context.Context, in *GetOrderHistoryRequest, opts ...grpc.CallOption) (*GetOrderHistoryResponse, error)
+	ExtendStorage(ctx context.Context, in *ExtendStorageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	QuotePackaging(ctx context.Context, in *QuotePackagingRequest, opts ...grpc.CallOption) (*QuotePackagingResponse, error)
+	GetWeightDiscrepancyReport(ctx context.Context, in *GetWeightDiscrepancyReportRequest, opts ...grpc.CallOption) (*GetWeightDiscrepancyReportResponse, error)
+	OpenHandoverSession(ctx context.Context, in *OpenHandoverSessionRequest, opts ...grpc.CallOption) (*OpenHandoverSessionResponse, error)
+	ScanHandoverParcel(ctx context.Context, in *ScanHandoverParcelRequest, opts ...grpc.CallOption) (*ScanHandoverParcelResponse, error)
+	CloseHandoverSession(ctx context.Context, in *CloseHandoverSessionRequest, opts ...grpc.CallOption) (*CloseHandoverSessionResponse, error)
+	GetHandoverSession(ctx context.Context, in *GetHandoverSessionRequest, opts ...grpc.CallOption) (*GetHandoverSessionResponse, error)
+	GetPVZPolicy(ctx context.Context, in *GetPVZPolicyRequest, opts ...grpc.CallOption) (*GetPVZPolicyResponse, error)
+	UpdatePVZPolicy(ctx context.Context, in *UpdatePVZPolicyRequest, opts ...grpc.CallOption) (*UpdatePVZPolicyResponse, error)
+	CreateReturnShipment(ctx context.Context, in *CreateReturnShipmentRequest, opts ...grpc.CallOption) (*CreateReturnShipmentResponse, error)
+	DispatchReturnShipment(ctx context.Context, in *DispatchReturnShipmentRequest, opts ...grpc.CallOption) (*DispatchReturnShipmentResponse, error)
+	GetReturnShipment(ctx context.Context, in *GetReturnShipmentRequest, opts ...grpc.CallOption) (*GetReturnShipmentResponse, error)
 }
 
 type pvzServiceClient struct {
@@ -48,9 +76,9 @@ func NewPvzServiceClient(cc grpc.ClientConnInterface) PvzServiceClient {
 	return &pvzServiceClient{cc}
 }
 
-func (c *pvzServiceClient) AcceptOrderDelivery(ctx context.Context, in *AcceptOrderDeliveryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *pvzServiceClient) AcceptOrderDelivery(ctx context.Context, in *AcceptOrderDeliveryRequest, opts ...grpc.CallOption) (*AcceptOrderDeliveryResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
+	out := new(AcceptOrderDeliveryResponse)
 	err := c.cc.Invoke(ctx, PvzService_AcceptOrderDelivery_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -58,6 +86,16 @@ func (c *pvzServiceClient) AcceptOrderDelivery(ctx context.Context, in *AcceptOr
 	return out, nil
 }
 
+func (c *pvzServiceClient) BatchAcceptOrderDelivery(ctx context.Context, in *BatchAcceptOrderDeliveryRequest, opts ...grpc.CallOption) (*BatchAcceptOrderDeliveryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchAcceptOrderDeliveryResponse)
+	err := c.cc.Invoke(ctx, PvzService_BatchAcceptOrderDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pvzServiceClient) ReturnOrderDelivery(ctx context.Context, in *ReturnOrderDeliveryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -68,9 +106,9 @@ func (c *pvzServiceClient) ReturnOrderDelivery(ctx context.Context, in *ReturnOr
 	return out, nil
 }
 
-func (c *pvzServiceClient) GiveOrderToClient(ctx context.Context, in *GiveOrderToClientRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+func (c *pvzServiceClient) GiveOrderToClient(ctx context.Context, in *GiveOrderToClientRequest, opts ...grpc.CallOption) (*GiveOrderToClientResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(emptypb.Empty)
+	out := new(GiveOrderToClientResponse)
 	err := c.cc.Invoke(ctx, PvzService_GiveOrderToClient_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -108,16 +146,160 @@ func (c *pvzServiceClient) GetReturns(ctx context.Context, in *GetReturnsRequest
 	return out, nil
 }
 
+func (c *pvzServiceClient) GetOrderHistory(ctx context.Context, in *GetOrderHistoryRequest, opts ...grpc.CallOption) (*GetOrderHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderHistoryResponse)
+	err := c.cc.Invoke(ctx, PvzService_GetOrderHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pvzServiceClient) ExtendStorage(ctx context.Context, in *ExtendStorageRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PvzService_ExtendStorage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pvzServiceClient) QuotePackaging(ctx context.Context, in *QuotePackagingRequest, opts ...grpc.CallOption) (*QuotePackagingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuotePackagingResponse)
+	err := c.cc.Invoke(ctx, PvzService_QuotePackaging_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pvzServiceClient) GetWeightDiscrepancyReport(ctx context.Context, in *GetWeightDiscrepancyReportRequest, opts ...grpc.CallOption) (*GetWeightDiscrepancyReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWeightDiscrepancyReportResponse)
+	err := c.cc.Invoke(ctx, PvzService_GetWeightDiscrepancyReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pvzServiceClient) OpenHandoverSession(ctx context.Context, in *OpenHandoverSessionRequest, opts ...grpc.CallOption) (*OpenHandoverSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OpenHandoverSessionResponse)
+	err := c.cc.Invoke(ctx, PvzService_OpenHandoverSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pvzServiceClient) ScanHandoverParcel(ctx context.Context, in *ScanHandoverParcelRequest, opts ...grpc.CallOption) (*ScanHandoverParcelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScanHandoverParcelResponse)
+	err := c.cc.Invoke(ctx, PvzService_ScanHandoverParcel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pvzServiceClient) CloseHandoverSession(ctx context.Context, in *CloseHandoverSessionRequest, opts ...grpc.CallOption) (*CloseHandoverSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseHandoverSessionResponse)
+	err := c.cc.Invoke(ctx, PvzService_CloseHandoverSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pvzServiceClient) GetHandoverSession(ctx context.Context, in *GetHandoverSessionRequest, opts ...grpc.CallOption) (*GetHandoverSessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetHandoverSessionResponse)
+	err := c.cc.Invoke(ctx, PvzService_GetHandoverSession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pvzServiceClient) GetPVZPolicy(ctx context.Context, in *GetPVZPolicyRequest, opts ...grpc.CallOption) (*GetPVZPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPVZPolicyResponse)
+	err := c.cc.Invoke(ctx, PvzService_GetPVZPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pvzServiceClient) UpdatePVZPolicy(ctx context.Context, in *UpdatePVZPolicyRequest, opts ...grpc.CallOption) (*UpdatePVZPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePVZPolicyResponse)
+	err := c.cc.Invoke(ctx, PvzService_UpdatePVZPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pvzServiceClient) CreateReturnShipment(ctx context.Context, in *CreateReturnShipmentRequest, opts ...grpc.CallOption) (*CreateReturnShipmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateReturnShipmentResponse)
+	err := c.cc.Invoke(ctx, PvzService_CreateReturnShipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pvzServiceClient) DispatchReturnShipment(ctx context.Context, in *DispatchReturnShipmentRequest, opts ...grpc.CallOption) (*DispatchReturnShipmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DispatchReturnShipmentResponse)
+	err := c.cc.Invoke(ctx, PvzService_DispatchReturnShipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pvzServiceClient) GetReturnShipment(ctx context.Context, in *GetReturnShipmentRequest, opts ...grpc.CallOption) (*GetReturnShipmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReturnShipmentResponse)
+	err := c.cc.Invoke(ctx, PvzService_GetReturnShipment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PvzServiceServer is the server API for PvzService service.
 // All implementations must embed UnimplementedPvzServiceServer
 // for forward compatibility.
 type PvzServiceServer interface {
-	AcceptOrderDelivery(context.Context, *AcceptOrderDeliveryRequest) (*emptypb.Empty, error)
+	AcceptOrderDelivery(context.Context, *AcceptOrderDeliveryRequest) (*AcceptOrderDeliveryResponse, error)
+	BatchAcceptOrderDelivery(context.Context, *BatchAcceptOrderDeliveryRequest) (*BatchAcceptOrderDeliveryResponse, error)
 	ReturnOrderDelivery(context.Context, *ReturnOrderDeliveryRequest) (*emptypb.Empty, error)
-	GiveOrderToClient(context.Context, *GiveOrderToClientRequest) (*emptypb.Empty, error)
+	GiveOrderToClient(context.Context, *GiveOrderToClientRequest) (*GiveOrderToClientResponse, error)
 	GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
 	AcceptReturn(context.Context, *AcceptReturnRequest) (*emptypb.Empty, error)
 	GetReturns(context.Context, *GetReturnsRequest) (*GetReturnsResponse, error)
+	GetOrderHistory(context.Context, *GetOrderHistoryRequest) (*GetOrderHistoryResponse, error)
+	ExtendStorage(context.Context, *ExtendStorageRequest) (*emptypb.Empty, error)
+	QuotePackaging(context.Context, *QuotePackagingRequest) (*QuotePackagingResponse, error)
+	GetWeightDiscrepancyReport(context.Context, *GetWeightDiscrepancyReportRequest) (*GetWeightDiscrepancyReportResponse, error)
+	OpenHandoverSession(context.Context, *OpenHandoverSessionRequest) (*OpenHandoverSessionResponse, error)
+	ScanHandoverParcel(context.Context, *ScanHandoverParcelRequest) (*ScanHandoverParcelResponse, error)
+	CloseHandoverSession(context.Context, *CloseHandoverSessionRequest) (*CloseHandoverSessionResponse, error)
+	GetHandoverSession(context.Context, *GetHandoverSessionRequest) (*GetHandoverSessionResponse, error)
+	GetPVZPolicy(context.Context, *GetPVZPolicyRequest) (*GetPVZPolicyResponse, error)
+	UpdatePVZPolicy(context.Context, *UpdatePVZPolicyRequest) (*UpdatePVZPolicyResponse, error)
+	CreateReturnShipment(context.Context, *CreateReturnShipmentRequest) (*CreateReturnShipmentResponse, error)
+	DispatchReturnShipment(context.Context, *DispatchReturnShipmentRequest) (*DispatchReturnShipmentResponse, error)
+	GetReturnShipment(context.Context, *GetReturnShipmentRequest) (*GetReturnShipmentResponse, error)
 	mustEmbedUnimplementedPvzServiceServer()
 }
 
@@ -128,13 +310,16 @@ type PvzServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedPvzServiceServer struct{}
 
-func (UnimplementedPvzServiceServer) AcceptOrderDelivery(context.Context, *AcceptOrderDeliveryRequest) (*emptypb.Empty, error) {
+func (UnimplementedPvzServiceServer) AcceptOrderDelivery(context.Context, *AcceptOrderDeliveryRequest) (*AcceptOrderDeliveryResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AcceptOrderDelivery not implemented")
 }
+func (UnimplementedPvzServiceServer) BatchAcceptOrderDelivery(context.Context, *BatchAcceptOrderDeliveryRequest) (*BatchAcceptOrderDeliveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchAcceptOrderDelivery not implemented")
+}
 func (UnimplementedPvzServiceServer) ReturnOrderDelivery(context.Context, *ReturnOrderDeliveryRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReturnOrderDelivery not implemented")
 }
-func (UnimplementedPvzServiceServer) GiveOrderToClient(context.Context, *GiveOrderToClientRequest) (*emptypb.Empty, error) {
+func (UnimplementedPvzServiceServer) GiveOrderToClient(context.Context, *GiveOrderToClientRequest) (*GiveOrderToClientResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GiveOrderToClient not implemented")
 }
 func (UnimplementedPvzServiceServer) GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error) {
@@ -146,6 +331,45 @@ func (UnimplementedPvzServiceServer) AcceptReturn(context.Context, *AcceptReturn
 func (UnimplementedPvzServiceServer) GetReturns(context.Context, *GetReturnsRequest) (*GetReturnsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetReturns not implemented")
 }
+func (UnimplementedPvzServiceServer) GetOrderHistory(context.Context, *GetOrderHistoryRequest) (*GetOrderHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderHistory not implemented")
+}
+func (UnimplementedPvzServiceServer) ExtendStorage(context.Context, *ExtendStorageRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendStorage not implemented")
+}
+func (UnimplementedPvzServiceServer) QuotePackaging(context.Context, *QuotePackagingRequest) (*QuotePackagingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuotePackaging not implemented")
+}
+func (UnimplementedPvzServiceServer) GetWeightDiscrepancyReport(context.Context, *GetWeightDiscrepancyReportRequest) (*GetWeightDiscrepancyReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWeightDiscrepancyReport not implemented")
+}
+func (UnimplementedPvzServiceServer) OpenHandoverSession(context.Context, *OpenHandoverSessionRequest) (*OpenHandoverSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenHandoverSession not implemented")
+}
+func (UnimplementedPvzServiceServer) ScanHandoverParcel(context.Context, *ScanHandoverParcelRequest) (*ScanHandoverParcelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScanHandoverParcel not implemented")
+}
+func (UnimplementedPvzServiceServer) CloseHandoverSession(context.Context, *CloseHandoverSessionRequest) (*CloseHandoverSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseHandoverSession not implemented")
+}
+func (UnimplementedPvzServiceServer) GetHandoverSession(context.Context, *GetHandoverSessionRequest) (*GetHandoverSessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHandoverSession not implemented")
+}
+func (UnimplementedPvzServiceServer) GetPVZPolicy(context.Context, *GetPVZPolicyRequest) (*GetPVZPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPVZPolicy not implemented")
+}
+func (UnimplementedPvzServiceServer) UpdatePVZPolicy(context.Context, *UpdatePVZPolicyRequest) (*UpdatePVZPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePVZPolicy not implemented")
+}
+func (UnimplementedPvzServiceServer) CreateReturnShipment(context.Context, *CreateReturnShipmentRequest) (*CreateReturnShipmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateReturnShipment not implemented")
+}
+func (UnimplementedPvzServiceServer) DispatchReturnShipment(context.Context, *DispatchReturnShipmentRequest) (*DispatchReturnShipmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DispatchReturnShipment not implemented")
+}
+func (UnimplementedPvzServiceServer) GetReturnShipment(context.Context, *GetReturnShipmentRequest) (*GetReturnShipmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReturnShipment not implemented")
+}
 func (UnimplementedPvzServiceServer) mustEmbedUnimplementedPvzServiceServer() {}
 func (UnimplementedPvzServiceServer) testEmbeddedByValue()                    {}
 
@@ -185,6 +409,24 @@ func _PvzService_AcceptOrderDelivery_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PvzService_BatchAcceptOrderDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchAcceptOrderDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PvzServiceServer).BatchAcceptOrderDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PvzService_BatchAcceptOrderDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PvzServiceServer).BatchAcceptOrderDelivery(ctx, req.(*BatchAcceptOrderDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PvzService_ReturnOrderDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReturnOrderDeliveryRequest)
 	if err := dec(in); err != nil {
@@ -275,6 +517,240 @@ func _PvzService_GetReturns_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PvzService_GetOrderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PvzServiceServer).GetOrderHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PvzService_GetOrderHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PvzServiceServer).GetOrderHistory(ctx, req.(*GetOrderHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PvzService_ExtendStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PvzServiceServer).ExtendStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PvzService_ExtendStorage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PvzServiceServer).ExtendStorage(ctx, req.(*ExtendStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PvzService_QuotePackaging_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotePackagingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PvzServiceServer).QuotePackaging(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PvzService_QuotePackaging_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PvzServiceServer).QuotePackaging(ctx, req.(*QuotePackagingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PvzService_GetWeightDiscrepancyReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWeightDiscrepancyReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PvzServiceServer).GetWeightDiscrepancyReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PvzService_GetWeightDiscrepancyReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PvzServiceServer).GetWeightDiscrepancyReport(ctx, req.(*GetWeightDiscrepancyReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PvzService_OpenHandoverSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenHandoverSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PvzServiceServer).OpenHandoverSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PvzService_OpenHandoverSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PvzServiceServer).OpenHandoverSession(ctx, req.(*OpenHandoverSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PvzService_ScanHandoverParcel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScanHandoverParcelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PvzServiceServer).ScanHandoverParcel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PvzService_ScanHandoverParcel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PvzServiceServer).ScanHandoverParcel(ctx, req.(*ScanHandoverParcelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PvzService_CloseHandoverSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseHandoverSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PvzServiceServer).CloseHandoverSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PvzService_CloseHandoverSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PvzServiceServer).CloseHandoverSession(ctx, req.(*CloseHandoverSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PvzService_GetHandoverSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHandoverSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PvzServiceServer).GetHandoverSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PvzService_GetHandoverSession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PvzServiceServer).GetHandoverSession(ctx, req.(*GetHandoverSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PvzService_GetPVZPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPVZPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PvzServiceServer).GetPVZPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PvzService_GetPVZPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PvzServiceServer).GetPVZPolicy(ctx, req.(*GetPVZPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PvzService_UpdatePVZPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePVZPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PvzServiceServer).UpdatePVZPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PvzService_UpdatePVZPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PvzServiceServer).UpdatePVZPolicy(ctx, req.(*UpdatePVZPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PvzService_CreateReturnShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateReturnShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PvzServiceServer).CreateReturnShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PvzService_CreateReturnShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PvzServiceServer).CreateReturnShipment(ctx, req.(*CreateReturnShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PvzService_DispatchReturnShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DispatchReturnShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PvzServiceServer).DispatchReturnShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PvzService_DispatchReturnShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PvzServiceServer).DispatchReturnShipment(ctx, req.(*DispatchReturnShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PvzService_GetReturnShipment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReturnShipmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PvzServiceServer).GetReturnShipment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PvzService_GetReturnShipment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PvzServiceServer).GetReturnShipment(ctx, req.(*GetReturnShipmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PvzService_ServiceDesc is the grpc.ServiceDesc for PvzService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -286,6 +762,10 @@ var PvzService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AcceptOrderDelivery",
 			Handler:    _PvzService_AcceptOrderDelivery_Handler,
 		},
+		{
+			MethodName: "BatchAcceptOrderDelivery",
+			Handler:    _PvzService_BatchAcceptOrderDelivery_Handler,
+		},
 		{
 			MethodName: "ReturnOrderDelivery",
 			Handler:    _PvzService_ReturnOrderDelivery_Handler,
@@ -306,6 +786,58 @@ var PvzService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetReturns",
 			Handler:    _PvzService_GetReturns_Handler,
 		},
+		{
+			MethodName: "GetOrderHistory",
+			Handler:    _PvzService_GetOrderHistory_Handler,
+		},
+		{
+			MethodName: "ExtendStorage",
+			Handler:    _PvzService_ExtendStorage_Handler,
+		},
+		{
+			MethodName: "QuotePackaging",
+			Handler:    _PvzService_QuotePackaging_Handler,
+		},
+		{
+			MethodName: "GetWeightDiscrepancyReport",
+			Handler:    _PvzService_GetWeightDiscrepancyReport_Handler,
+		},
+		{
+			MethodName: "OpenHandoverSession",
+			Handler:    _PvzService_OpenHandoverSession_Handler,
+		},
+		{
+			MethodName: "ScanHandoverParcel",
+			Handler:    _PvzService_ScanHandoverParcel_Handler,
+		},
+		{
+			MethodName: "CloseHandoverSession",
+			Handler:    _PvzService_CloseHandoverSession_Handler,
+		},
+		{
+			MethodName: "GetHandoverSession",
+			Handler:    _PvzService_GetHandoverSession_Handler,
+		},
+		{
+			MethodName: "GetPVZPolicy",
+			Handler:    _PvzService_GetPVZPolicy_Handler,
+		},
+		{
+			MethodName: "UpdatePVZPolicy",
+			Handler:    _PvzService_UpdatePVZPolicy_Handler,
+		},
+		{
+			MethodName: "CreateReturnShipment",
+			Handler:    _PvzService_CreateReturnShipment_Handler,
+		},
+		{
+			MethodName: "DispatchReturnShipment",
+			Handler:    _PvzService_DispatchReturnShipment_Handler,
+		},
+		{
+			MethodName: "GetReturnShipment",
+			Handler:    _PvzService_GetReturnShipment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pvz-service/v1/pvz-service.proto",
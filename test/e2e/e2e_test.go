@@ -10,7 +10,10 @@ import (
 	"time"
 )
 
-const baseURL = "http://localhost:8081"
+const (
+	baseURL = "http://localhost:8081"
+	pvzID   = "PVZ-1"
+)
 
 func mustHTTP(t *testing.T) *http.Client {
 	t.Helper()
@@ -35,6 +38,18 @@ func requireServerUp(t *testing.T) {
 	t.Fatalf("server is not up at %s", baseURL)
 }
 
+// doRequest sends a request on behalf of the test PVZ
+func doRequest(t *testing.T, httpClient *http.Client, method, url string, body io.Reader) (*http.Response, error) {
+	t.Helper()
+	req, err := http.NewRequest(method, url, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Pvz-Id", pvzID)
+	return httpClient.Do(req)
+}
+
 func TestAcceptOrderDeliveryAndGetOrders(t *testing.T) {
 	if os.Getenv("E2E") == "" {
 		t.Skip("E2E env var not set; skip E2E tests")
@@ -57,7 +72,7 @@ func TestAcceptOrderDeliveryAndGetOrders(t *testing.T) {
 		"additionalFilm": false,
 	}
 	body, _ := json.Marshal(payload)
-	resp, err := httpClient.Post(baseURL+"/v1/pvz-service/accept-order-delivery", "application/json", bytes.NewReader(body))
+	resp, err := doRequest(t, httpClient, http.MethodPost, baseURL+"/v1/pvz-service/accept-order-delivery", bytes.NewReader(body))
 	if err != nil {
 		t.Fatalf("accept-order-delivery request failed: %v", err)
 	}
@@ -68,7 +83,7 @@ func TestAcceptOrderDeliveryAndGetOrders(t *testing.T) {
 	}
 
 	// 2) Get orders for the user and ensure our order is present
-	resp, err = httpClient.Get(baseURL + "/v1/pvz-service/get-orders?userId=" + userID + "&samePVZ=true")
+	resp, err = doRequest(t, httpClient, http.MethodGet, baseURL+"/v1/pvz-service/get-orders?userId="+userID+"&samePVZ=true", nil)
 	if err != nil {
 		t.Fatalf("get-orders request failed: %v", err)
 	}
@@ -117,7 +132,7 @@ func TestAcceptOrderDelivery_ValidationError(t *testing.T) {
 		"additionalFilm": true,
 	}
 	body, _ := json.Marshal(payload)
-	resp, err := httpClient.Post(baseURL+"/v1/pvz-service/accept-order-delivery", "application/json", bytes.NewReader(body))
+	resp, err := doRequest(t, httpClient, http.MethodPost, baseURL+"/v1/pvz-service/accept-order-delivery", bytes.NewReader(body))
 	if err != nil {
 		t.Fatalf("accept-order-delivery request failed: %v", err)
 	}
@@ -128,3 +143,38 @@ func TestAcceptOrderDelivery_ValidationError(t *testing.T) {
 		t.Fatalf("expected 400 Bad Request, got %d, body: %s", resp.StatusCode, string(b))
 	}
 }
+
+func TestAcceptOrderDelivery_UnknownPVZ(t *testing.T) {
+	if os.Getenv("E2E") == "" {
+		t.Skip("E2E env var not set; skip E2E tests")
+	}
+	requireServerUp(t)
+
+	httpClient := mustHTTP(t)
+
+	payload := map[string]any{
+		"orderId":     "ord-e2e-unknown-pvz",
+		"recipientId": "user-e2e-unknown-pvz",
+		"storageTime": "3600s",
+		"cost":        100,
+		"weight":      100,
+		"packaging":   "BOX",
+	}
+	body, _ := json.Marshal(payload)
+	req, err := http.NewRequest(http.MethodPost, baseURL+"/v1/pvz-service/accept-order-delivery", bytes.NewReader(body))
+	if err != nil {
+		t.Fatalf("build request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Pvz-Id", "PVZ-unknown")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		t.Fatalf("accept-order-delivery request failed: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadRequest {
+		b, _ := io.ReadAll(resp.Body)
+		t.Fatalf("expected 400 Bad Request, got %d, body: %s", resp.StatusCode, string(b))
+	}
+}
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS status VARCHAR(32) NOT NULL DEFAULT 'accepted';
+
+UPDATE pvz_orders
+SET status = CASE
+                 WHEN deleted_at IS NOT NULL THEN 'returned_to_courier'
+                 WHEN returned_at IS NOT NULL THEN 'returned_by_client'
+                 WHEN issued_at IS NOT NULL THEN 'issued'
+                 ELSE 'accepted'
+    END;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS status;
+-- +goose StatementEnd
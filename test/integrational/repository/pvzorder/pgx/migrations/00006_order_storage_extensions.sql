@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS storage_extensions INT NOT NULL DEFAULT 0;
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS extended_by VARCHAR(64);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS extended_by;
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS storage_extensions;
+-- +goose StatementEnd
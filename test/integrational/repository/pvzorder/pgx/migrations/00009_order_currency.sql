@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pvz_orders ALTER COLUMN cost TYPE BIGINT;
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS currency VARCHAR(3) NOT NULL DEFAULT 'RUB';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS currency;
+ALTER TABLE pvz_orders ALTER COLUMN cost TYPE INT;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS handover_sessions (
+    id UUID PRIMARY KEY,
+    pvz_id VARCHAR(255) NOT NULL,
+    courier_id VARCHAR(255) NOT NULL,
+    status VARCHAR(32) NOT NULL,
+    expected JSONB NOT NULL,
+    report JSONB,
+    opened_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    closed_at TIMESTAMP WITH TIME ZONE
+);
+CREATE TABLE IF NOT EXISTS handover_scans (
+    id BIGSERIAL PRIMARY KEY,
+    session_id UUID NOT NULL REFERENCES handover_sessions (id),
+    order_id VARCHAR(255) NOT NULL,
+    measured_weight INT NOT NULL DEFAULT 0,
+    result VARCHAR(32) NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    scanned_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_handover_scans_session_id ON handover_scans (session_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS handover_scans;
+DROP TABLE IF EXISTS handover_sessions;
+-- +goose StatementEnd
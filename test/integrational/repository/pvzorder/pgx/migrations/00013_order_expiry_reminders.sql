@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS expiry_reminded_at TIMESTAMP WITH TIME ZONE;
+CREATE INDEX IF NOT EXISTS idx_pvz_order_accepted_received_at ON pvz_orders (received_at) WHERE status = 'accepted' AND deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_pvz_order_accepted_received_at;
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS expiry_reminded_at;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS expires_at TIMESTAMP WITH TIME ZONE;
+UPDATE pvz_orders SET expires_at = received_at + storage_time WHERE expires_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_pvz_order_accepted_expires_at ON pvz_orders (expires_at) WHERE status = 'accepted' AND deleted_at IS NULL;
+DROP INDEX IF EXISTS idx_pvz_order_accepted_received_at;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE INDEX IF NOT EXISTS idx_pvz_order_accepted_received_at ON pvz_orders (received_at) WHERE status = 'accepted' AND deleted_at IS NULL;
+DROP INDEX IF EXISTS idx_pvz_order_accepted_expires_at;
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS expires_at;
+-- +goose StatementEnd
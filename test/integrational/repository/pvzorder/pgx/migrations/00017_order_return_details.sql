@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS return_reason VARCHAR(32);
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS return_inspection VARCHAR(32);
+ALTER TABLE pvz_orders ADD COLUMN IF NOT EXISTS return_comment TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS return_comment;
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS return_inspection;
+ALTER TABLE pvz_orders DROP COLUMN IF EXISTS return_reason;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS return_shipments (
+    id UUID PRIMARY KEY,
+    pvz_id VARCHAR(255) NOT NULL,
+    status VARCHAR(32) NOT NULL,
+    courier_id VARCHAR(255),
+    manifest JSONB,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    dispatched_at TIMESTAMP WITH TIME ZONE
+);
+CREATE TABLE IF NOT EXISTS return_shipment_orders (
+    order_id VARCHAR(255) PRIMARY KEY,
+    shipment_id UUID NOT NULL REFERENCES return_shipments (id),
+    position INT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_return_shipment_orders_shipment_id ON return_shipment_orders (shipment_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS return_shipment_orders;
+DROP TABLE IF EXISTS return_shipments;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION mark_event_as_sent(event_id UUID)
+    RETURNS VOID AS $$
+BEGIN
+    UPDATE events
+    SET sent_at = NOW(),
+        payload = payload - 'pickup_code'
+    WHERE id = event_id;
+
+    PERFORM pg_advisory_unlock(hashtext(event_id::text));
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP FUNCTION IF EXISTS mark_event_as_sent;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE pvz_policies
+    ALTER COLUMN max_order_weight DROP NOT NULL,
+    ALTER COLUMN max_order_weight DROP DEFAULT,
+    ALTER COLUMN allowed_packaging DROP NOT NULL,
+    ALTER COLUMN allowed_packaging DROP DEFAULT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE pvz_policies
+    ALTER COLUMN max_order_weight SET DEFAULT 0,
+    ALTER COLUMN max_order_weight SET NOT NULL,
+    ALTER COLUMN allowed_packaging SET DEFAULT '{}',
+    ALTER COLUMN allowed_packaging SET NOT NULL;
+-- +goose StatementEnd
@@ -7,6 +7,9 @@ import (
 	"errors"
 	"fmt"
 	"homework/internal/abstractions"
+	"slices"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -15,7 +18,11 @@ import (
 	"github.com/testcontainers/testcontainers-go/wait"
 
 	"homework/internal/domain"
+	eventsPgx "homework/internal/infrastructure/repositories/events/pgx"
+	handover "homework/internal/infrastructure/repositories/handover/pgx"
+	idempotency "homework/internal/infrastructure/repositories/idempotency/pgx"
 	"homework/internal/infrastructure/repositories/pvzorder/pgx"
+	pvzpolicy "homework/internal/infrastructure/repositories/pvzpolicy/pgx"
 	"homework/internal/infrastructure/repositories/utils/pgx/txmanager"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -161,20 +168,142 @@ func TestPGXRepository_CreateOrder(t *testing.T) {
 		"100",
 		"1",
 		"1",
+		domain.RUB(1000),
 		1000,
-		1000,
+		domain.Dimensions{},
 		24*time.Hour,
 		domain.PackagingTypeBox,
 		false,
 	)
 
-	err := repo.CreateOrder(ctx, order)
+	err := repo.CreateOrder(ctx, order, "")
 	assert.NoError(t, err)
 
 	actual, err := repo.GetOrder(ctx, "100")
 	assert.NoError(t, err)
 	assert.Equal(t, order.ReceivedAt.UnixMilli(), actual.ReceivedAt.UnixMilli())
 	assert.Equal(t, order, actual)
+
+	withCode := domain.NewPVZOrder("101", "1", "1", domain.NewMoney(150000, domain.CurrencyUSD), 1000, domain.Dimensions{Length: 30, Width: 20, Height: 10}, 24*time.Hour, domain.PackagingTypeBox, false)
+	pickupCodeKey := domain.PickupCodeKey("test-pickup-code-key")
+	withCode.PickupCodeHash = pickupCodeKey.HashPickupCode("123456")
+
+	assert.NoError(t, repo.CreateOrder(ctx, withCode, "123456"))
+
+	actual, err = repo.GetOrder(ctx, "101")
+	assert.NoError(t, err)
+	assert.True(t, actual.CheckPickupCode(pickupCodeKey, "123456"))
+	assert.Equal(t, withCode.Dimensions, actual.Dimensions)
+	assert.Equal(t, withCode.Cost, actual.Cost)
+
+	// The code itself is only sent to the recipient
+	events, err := repo.GetOrderHistory(ctx, "101")
+	assert.NoError(t, err)
+	if assert.Len(t, events, 2) {
+		assert.Equal(t, domain.EventTypeOrderPickupCodeIssued, events[1].EventType)
+		assert.Equal(t, "123456", events[1].Payload["pickup_code"])
+	}
+}
+
+func TestPGXRepository_PickupCodeScrubbedWhenSent(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pgxPool, tearDown := setupSuite(ctx, t)
+	defer tearDown()
+
+	manager := txmanager.NewPGXTXManager(pgxPool)
+	repo := pgx.NewPgxPvzOrderFacade(manager)
+	eventsRepo := eventsPgx.NewEventsRepository(manager)
+
+	const pickupCode = "987654"
+
+	order := domain.NewPVZOrder("100", "1", "1", domain.RUB(1000), 1000, domain.Dimensions{}, 24*time.Hour, domain.PackagingTypeBox, false)
+	pickupCodeKey := domain.PickupCodeKey("test-pickup-code-key")
+	order.PickupCodeHash = pickupCodeKey.HashPickupCode(pickupCode)
+	assert.NoError(t, repo.CreateOrder(ctx, order, pickupCode))
+
+	events, err := repo.GetOrderHistory(ctx, "100")
+	if !assert.NoError(t, err) {
+		return
+	}
+	for _, event := range events {
+		assert.NoError(t, eventsRepo.MarkAsSent(ctx, event.ID))
+	}
+
+	// Once the code is delivered to the recipient, only its hash is left in the DB
+	var leaked int
+	err = pgxPool.QueryRow(ctx, `SELECT COUNT(*) FROM events WHERE payload ? 'pickup_code' OR payload::text LIKE '%' || $1 || '%'`, pickupCode).Scan(&leaked)
+	assert.NoError(t, err)
+	assert.Zero(t, leaked)
+
+	err = pgxPool.QueryRow(ctx, `SELECT COUNT(*) FROM pvz_orders WHERE pickup_code_hash = $1`, pickupCode).Scan(&leaked)
+	assert.NoError(t, err)
+	assert.Zero(t, leaked)
+
+	actual, err := repo.GetOrder(ctx, "100")
+	assert.NoError(t, err)
+	assert.True(t, actual.CheckPickupCode(pickupCodeKey, pickupCode))
+}
+
+func TestPGXRepository_CreateOrders(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pgxPool, tearDown := setupSuite(ctx, t)
+	defer tearDown()
+
+	manager := txmanager.NewPGXTXManager(pgxPool)
+	repo := pgx.NewPgxPvzOrderFacade(manager)
+
+	existing := domain.NewPVZOrder("100", "1", "1", domain.RUB(1000), 1000, domain.Dimensions{}, 24*time.Hour, domain.PackagingTypeBox, false)
+	assert.NoError(t, repo.CreateOrder(ctx, existing, ""))
+
+	orders := []domain.PVZOrder{
+		domain.NewPVZOrder("101", "1", "1", domain.RUB(1000), 1000, domain.Dimensions{}, 24*time.Hour, domain.PackagingTypeBox, false),
+		domain.NewPVZOrder("100", "1", "2", domain.RUB(2000), 2000, domain.Dimensions{}, 24*time.Hour, domain.PackagingTypeBag, false),
+		domain.NewPVZOrder("102", "1", "1", domain.NewMoney(500, domain.CurrencyUSD), 500, domain.Dimensions{Length: 30, Width: 20, Height: 10}, 24*time.Hour, domain.PackagingTypeBag, true),
+	}
+
+	created, err := repo.CreateOrders(ctx, orders, map[string]string{"101": "123456"})
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"101", "102"}, created)
+
+	// The existing order is not overwritten
+	actual, err := repo.GetOrder(ctx, "100")
+	assert.NoError(t, err)
+	assert.Equal(t, "1", actual.RecipientID)
+
+	actual, err = repo.GetOrder(ctx, "102")
+	assert.NoError(t, err)
+	assert.Equal(t, orders[2].Cost, actual.Cost)
+	assert.Equal(t, orders[2].Dimensions, actual.Dimensions)
+
+	events, err := repo.GetOrderHistory(ctx, "101")
+	assert.NoError(t, err)
+	assert.Len(t, events, 2)
+
+	events, err = repo.GetOrderHistory(ctx, "102")
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+
+	events, err = repo.GetOrderHistory(ctx, "100")
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
 }
 
 func TestPGXRepository_DeleteOrder(t *testing.T) {
@@ -194,7 +323,7 @@ func TestPGXRepository_DeleteOrder(t *testing.T) {
 	manager := txmanager.NewPGXTXManager(pgxPool)
 	repo := pgx.NewPgxPvzOrderFacade(manager)
 
-	err := repo.DeleteOrder(ctx, "1")
+	err := repo.DeleteOrder(ctx, "1", 0)
 	assert.NoError(t, err)
 
 	_, err = repo.GetOrder(ctx, "1")
@@ -202,7 +331,7 @@ func TestPGXRepository_DeleteOrder(t *testing.T) {
 	assert.ErrorIs(t, err, domain.ErrNotFound)
 }
 
-func TestPGXRepository_SetOrderIssued(t *testing.T) {
+func TestPGXRepository_TransitionErrors(t *testing.T) {
 	t.Parallel()
 
 	if testing.Short() {
@@ -219,15 +348,29 @@ func TestPGXRepository_SetOrderIssued(t *testing.T) {
 	manager := txmanager.NewPGXTXManager(pgxPool)
 	repo := pgx.NewPgxPvzOrderFacade(manager)
 
-	err := repo.SetOrderIssued(ctx, "1")
-	assert.NoError(t, err)
+	details := domain.ReturnDetails{Reason: domain.ReturnReasonDefect, Inspection: domain.InspectionOutcomeDamaged}
 
-	order, err := repo.GetOrder(ctx, "1")
-	assert.NoError(t, err)
-	assert.NotEqual(t, time.Time{}, order.IssuedAt)
+	assert.ErrorIs(t, repo.SetOrdersIssued(ctx, []domain.IssueDecision{domain.NewIssueDecision("unknown")}), domain.ErrNotFound)
+	assert.ErrorIs(t, repo.SetOrderReturned(ctx, "unknown", details, 0), domain.ErrNotFound)
+	assert.ErrorIs(t, repo.DeleteOrder(ctx, "unknown", 0), domain.ErrNotFound)
+
+	// Order 1 is not issued yet
+	assert.ErrorIs(t, repo.SetOrderReturned(ctx, "1", details, 0), domain.ErrConflict)
+
+	// Order 5 is already returned by the client
+	assert.ErrorIs(t, repo.SetOrdersIssued(ctx, []domain.IssueDecision{domain.NewIssueDecision("5")}), domain.ErrConflict)
+	assert.ErrorIs(t, repo.SetOrderReturned(ctx, "5", details, 0), domain.ErrConflict)
+	assert.ErrorIs(t, repo.DeleteOrder(ctx, "5", 0), domain.ErrConflict)
+
+	assert.NoError(t, repo.DeleteOrder(ctx, "2", 0))
+	assert.ErrorIs(t, repo.DeleteOrder(ctx, "2", 0), domain.ErrConflict)
+
+	// Failed transitions must not leave events behind
+	_, err := repo.GetOrderHistory(ctx, "5")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
 }
 
-func TestPGXRepository_SetOrderReturned(t *testing.T) {
+func TestPGXRepository_ExpectedVersion(t *testing.T) {
 	t.Parallel()
 
 	if testing.Short() {
@@ -244,15 +387,29 @@ func TestPGXRepository_SetOrderReturned(t *testing.T) {
 	manager := txmanager.NewPGXTXManager(pgxPool)
 	repo := pgx.NewPgxPvzOrderFacade(manager)
 
-	err := repo.SetOrderReturned(ctx, "1")
+	order, err := repo.GetOrder(ctx, "1")
 	assert.NoError(t, err)
+	assert.Equal(t, int64(1), order.Version)
 
-	order, err := repo.GetOrder(ctx, "1")
+	assert.NoError(t, repo.SetOrdersIssued(ctx, []domain.IssueDecision{{OrderID: "1", Action: domain.IssueActionIssue, ExpectedVersion: order.Version}}))
+
+	order, err = repo.GetOrder(ctx, "1")
 	assert.NoError(t, err)
-	assert.NotEqual(t, time.Time{}, order.ReturnedAt)
+	assert.Equal(t, int64(2), order.Version)
+
+	details := domain.ReturnDetails{Reason: domain.ReturnReasonDefect, Inspection: domain.InspectionOutcomeDamaged}
+
+	// The operator still looks at the first version of the order
+	assert.ErrorIs(t, repo.SetOrderReturned(ctx, "1", details, 1), domain.ErrVersionMismatch)
+	assert.NoError(t, repo.SetOrderReturned(ctx, "1", details, 2))
+
+	assert.ErrorIs(t,
+		repo.SetOrdersIssued(ctx, []domain.IssueDecision{{OrderID: "2", Action: domain.IssueActionIssue, ExpectedVersion: 5}}),
+		domain.ErrVersionMismatch,
+	)
 }
 
-func TestPGXRepository_GetOrders(t *testing.T) {
+func TestPGXRepository_SetOrdersIssued_Concurrent(t *testing.T) {
 	t.Parallel()
 
 	if testing.Short() {
@@ -269,78 +426,72 @@ func TestPGXRepository_GetOrders(t *testing.T) {
 	manager := txmanager.NewPGXTXManager(pgxPool)
 	repo := pgx.NewPgxPvzOrderFacade(manager)
 
-	type args struct {
-		userID string
-		opts   []abstractions.GetOrdersOptFunc
+	const workers = 20
+
+	var (
+		wg        sync.WaitGroup
+		succeeded atomic.Int32
+		conflicts atomic.Int32
+	)
+
+	start := make(chan struct{})
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+
+			err := repo.SetOrdersIssued(ctx, []domain.IssueDecision{domain.NewIssueDecision("1")})
+			switch {
+			case err == nil:
+				succeeded.Add(1)
+			case errors.Is(err, domain.ErrConflict):
+				conflicts.Add(1)
+			default:
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
 	}
+	close(start)
+	wg.Wait()
 
-	tests := []struct {
-		name    string
-		args    args
-		want    func([]domain.PVZOrder) bool
-		wantErr assert.ErrorAssertionFunc
-	}{
-		{
-			name: "Success",
-			args: args{
-				userID: "1",
-				opts:   make([]abstractions.GetOrdersOptFunc, 0),
-			},
-			want: func(orders []domain.PVZOrder) bool {
-				return assert.Len(t, orders, 3)
-			},
-			wantErr: assert.NoError,
-		},
-		{
-			name: "Success with PVZID",
-			args: args{
-				userID: "1",
-				opts: []abstractions.GetOrdersOptFunc{
-					abstractions.WithPVZID("2"),
-				},
-			},
-			want: func(orders []domain.PVZOrder) bool {
-				return assert.Len(t, orders, 1) && assert.Equal(t, "3", orders[0].OrderID)
-			},
-			wantErr: assert.NoError,
-		},
-		{
-			name: "Success with pagination",
-			args: args{
-				userID: "1",
-				opts: []abstractions.GetOrdersOptFunc{
-					abstractions.WithCursorID("3"),
-					abstractions.WithLimit(2),
-				},
-			},
-			want: func(orders []domain.PVZOrder) bool {
-				return assert.Len(t, orders, 2) && assert.Equal(t, "3", orders[0].OrderID) && assert.Equal(t, "2", orders[1].OrderID)
-			},
-			wantErr: assert.NoError,
-		},
-		{
-			name: "Success empty",
-			args: args{
-				userID: "3",
-				opts:   make([]abstractions.GetOrdersOptFunc, 0),
-			},
-			want: func(orders []domain.PVZOrder) bool {
-				return assert.Len(t, orders, 0)
-			},
-			wantErr: assert.NoError,
-		},
+	assert.Equal(t, int32(1), succeeded.Load())
+	assert.Equal(t, int32(workers-1), conflicts.Load())
+
+	events, err := repo.GetOrderHistory(ctx, "1")
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+}
+
+func TestPGXRepository_SetOrdersIssued(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping integration test")
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			orders, err := repo.GetOrders(ctx, tt.args.userID, tt.args.opts...)
-			tt.want(orders)
-			tt.wantErr(t, err)
-		})
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pgxPool, tearDown := setupSuite(ctx, t)
+	defer tearDown()
+
+	manager := txmanager.NewPGXTXManager(pgxPool)
+	repo := pgx.NewPgxPvzOrderFacade(manager)
+
+	err := repo.SetOrdersIssued(ctx, []domain.IssueDecision{domain.NewIssueDecision("1"), domain.NewIssueDecision("2")})
+	assert.NoError(t, err)
+
+	for _, orderID := range []string{"1", "2"} {
+		order, err := repo.GetOrder(ctx, orderID)
+		assert.NoError(t, err)
+		assert.Equal(t, domain.OrderStatusIssued, order.Status)
+		assert.NotEqual(t, time.Time{}, order.IssuedAt)
 	}
 }
 
-func TestPGXRepository_GetOrder(t *testing.T) {
+func TestPGXRepository_SetOrdersIssued_AllOrNothing(t *testing.T) {
 	t.Parallel()
 
 	if testing.Short() {
@@ -357,58 +508,75 @@ func TestPGXRepository_GetOrder(t *testing.T) {
 	manager := txmanager.NewPGXTXManager(pgxPool)
 	repo := pgx.NewPgxPvzOrderFacade(manager)
 
-	type args struct {
-		orderID string
+	// Order 5 is already returned, so order 1 must not be issued either
+	err := repo.SetOrdersIssued(ctx, []domain.IssueDecision{domain.NewIssueDecision("1"), domain.NewIssueDecision("5")})
+	assert.True(t, errors.Is(err, domain.ErrConflict))
+
+	order, err := repo.GetOrder(ctx, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.OrderStatusAccepted, order.Status)
+	assert.Equal(t, time.Time{}, order.IssuedAt)
+
+	err = repo.SetOrdersIssued(ctx, []domain.IssueDecision{domain.NewIssueDecision("1"), domain.NewIssueDecision("unknown")})
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+
+	_, err = repo.GetOrderHistory(ctx, "1")
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+}
+
+func TestPGXRepository_SetOrderReturned(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping integration test")
 	}
 
-	tests := []struct {
-		name    string
-		args    args
-		want    domain.PVZOrder
-		wantErr assert.ErrorAssertionFunc
-	}{
-		{
-			name: "Success",
-			args: args{
-				orderID: "1",
-			},
-			want: domain.PVZOrder{
-				OrderID:        "1",
-				PVZID:          "1",
-				RecipientID:    "1",
-				ReceivedAt:     time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
-				StorageTime:    24 * time.Hour,
-				IssuedAt:       time.Time{},
-				ReturnedAt:     time.Time{},
-				Weight:         1000,
-				Cost:           1000,
-				AdditionalFilm: false,
-				Packaging:      domain.PackagingTypeBox,
-			},
-			wantErr: assert.NoError,
-		},
-		{
-			name: "Order not found",
-			args: args{
-				orderID: "100",
-			},
-			want: domain.PVZOrder{},
-			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
-				return assert.Error(t, err) && errors.Is(err, domain.ErrNotFound)
-			},
-		},
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pgxPool, tearDown := setupSuite(ctx, t)
+	defer tearDown()
+
+	manager := txmanager.NewPGXTXManager(pgxPool)
+	repo := pgx.NewPgxPvzOrderFacade(manager)
+
+	// Only an issued order can be returned
+	err := repo.SetOrdersIssued(ctx, []domain.IssueDecision{domain.NewIssueDecision("1")})
+	assert.NoError(t, err)
+
+	details := domain.ReturnDetails{Reason: domain.ReturnReasonOther, Inspection: domain.InspectionOutcomeIncomplete, Comment: "charger is missing"}
+	err = repo.SetOrderReturned(ctx, "1", details, 0)
+	assert.NoError(t, err)
+
+	order, err := repo.GetOrder(ctx, "1")
+	assert.NoError(t, err)
+	assert.NotEqual(t, time.Time{}, order.ReturnedAt)
+	assert.Equal(t, details, order.ReturnDetails)
+
+	// The returns accepted before the details were introduced have none and do not match the filters
+	returns, err := repo.GetReturns(ctx, "1", abstractions.WithReturnReasons(domain.ReturnReasonOther))
+	assert.NoError(t, err)
+	if assert.Len(t, returns, 1) {
+		assert.Equal(t, "1", returns[0].OrderID)
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			got, err := repo.GetOrder(ctx, tt.args.orderID)
-			assert.Equal(t, tt.want, got)
-			tt.wantErr(t, err)
-		})
+	returns, err = repo.GetReturns(ctx, "1", abstractions.WithInspectionOutcomes(domain.InspectionOutcomeResellable))
+	assert.NoError(t, err)
+	assert.Empty(t, returns)
+
+	events, err := repo.GetOrderHistory(ctx, "1")
+	assert.NoError(t, err)
+	if assert.NotEmpty(t, events) {
+		last := events[len(events)-1]
+		assert.Equal(t, domain.EventTypeOrderReturned, last.EventType)
+		assert.Equal(t, "other", last.Payload["reason"])
+		assert.Equal(t, "incomplete", last.Payload["inspection"])
+		assert.Equal(t, "charger is missing", last.Payload["comment"])
 	}
 }
 
-func TestPGXRepository_GetReturns(t *testing.T) {
+func TestPGXRepository_SetOrderRefused(t *testing.T) {
 	t.Parallel()
 
 	if testing.Short() {
@@ -425,8 +593,315 @@ func TestPGXRepository_GetReturns(t *testing.T) {
 	manager := txmanager.NewPGXTXManager(pgxPool)
 	repo := pgx.NewPgxPvzOrderFacade(manager)
 
-	type args struct {
-		opts []abstractions.PagePaginationOptFunc
+	err := repo.SetOrderRefused(ctx, "1", "damaged", 0)
+	assert.NoError(t, err)
+
+	order, err := repo.GetOrder(ctx, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.OrderStatusRefused, order.Status)
+	assert.NotEqual(t, time.Time{}, order.ReturnedAt)
+
+	events, err := repo.GetOrderHistory(ctx, "1")
+	assert.NoError(t, err)
+	if assert.NotEmpty(t, events) {
+		last := events[len(events)-1]
+		assert.Equal(t, domain.EventTypeOrderRefused, last.EventType)
+		assert.Equal(t, "damaged", last.Payload["reason"])
+	}
+}
+
+func TestPGXRepository_ExtendStorage(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pgxPool, tearDown := setupSuite(ctx, t)
+	defer tearDown()
+
+	manager := txmanager.NewPGXTXManager(pgxPool)
+	repo := pgx.NewPgxPvzOrderFacade(manager)
+
+	order, err := repo.GetOrder(ctx, "1")
+	assert.NoError(t, err)
+	assert.True(t, order.Expiry.Equal(order.ReceivedAt.Add(order.StorageTime)), "expires_at is backfilled")
+
+	// The expiry counted by the PVZ calendar is stored as is
+	expiresAt := order.ReceivedAt.Add(96 * time.Hour).Truncate(time.Microsecond)
+	err = repo.ExtendStorage(ctx, "1", 48*time.Hour, expiresAt, "operator", 1)
+	assert.NoError(t, err)
+
+	order, err = repo.GetOrder(ctx, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, 72*time.Hour, order.StorageTime)
+	assert.True(t, order.ExpiresAt().Equal(expiresAt))
+	assert.Equal(t, 1, order.StorageExtensions)
+	assert.Equal(t, "operator", order.ExtendedBy)
+	assert.Equal(t, int64(2), order.Version)
+
+	events, err := repo.GetOrderHistory(ctx, "1")
+	assert.NoError(t, err)
+	if assert.NotEmpty(t, events) {
+		last := events[len(events)-1]
+		assert.Equal(t, domain.EventTypeOrderStorageExtended, last.EventType)
+		assert.Equal(t, "operator", last.Payload["extended_by"])
+	}
+
+	// Returned orders are not stored in the PVZ anymore
+	assert.ErrorIs(t, repo.ExtendStorage(ctx, "5", time.Hour, time.Now(), "operator", 0), domain.ErrConflict)
+	assert.ErrorIs(t, repo.ExtendStorage(ctx, "unknown", time.Hour, time.Now(), "operator", 0), domain.ErrNotFound)
+}
+
+func TestPGXRepository_RegisterFailedPickupAttempt(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pgxPool, tearDown := setupSuite(ctx, t)
+	defer tearDown()
+
+	manager := txmanager.NewPGXTXManager(pgxPool)
+	repo := pgx.NewPgxPvzOrderFacade(manager)
+
+	const maxAttempts = 3
+
+	for i := 0; i < maxAttempts-1; i++ {
+		lockedUntil, err := repo.RegisterFailedPickupAttempt(ctx, "1", maxAttempts, time.Hour)
+		assert.NoError(t, err)
+		assert.True(t, lockedUntil.IsZero())
+	}
+
+	lockedUntil, err := repo.RegisterFailedPickupAttempt(ctx, "1", maxAttempts, time.Hour)
+	assert.NoError(t, err)
+	assert.False(t, lockedUntil.IsZero())
+
+	// Attempts made while the pickup is locked do not extend the lockout
+	again, err := repo.RegisterFailedPickupAttempt(ctx, "1", maxAttempts, time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, lockedUntil, again)
+
+	order, err := repo.GetOrder(ctx, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, order.PickupAttempts)
+	assert.Equal(t, lockedUntil, order.PickupLockedUntil)
+
+	assert.ErrorIs(t,
+		repo.SetOrdersIssued(ctx, []domain.IssueDecision{domain.NewIssueDecision("1")}),
+		domain.ErrTooManyAttempts,
+	)
+	assert.ErrorIs(t, repo.SetOrderRefused(ctx, "1", "damaged", 0), domain.ErrConflict)
+
+	events, err := repo.GetOrderHistory(ctx, "1")
+	assert.NoError(t, err)
+	if assert.NotEmpty(t, events) {
+		assert.Equal(t, domain.EventTypeOrderPickupLocked, events[len(events)-1].EventType)
+	}
+
+	_, err = repo.RegisterFailedPickupAttempt(ctx, "unknown", maxAttempts, time.Hour)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestPGXRepository_GetOrders(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pgxPool, tearDown := setupSuite(ctx, t)
+	defer tearDown()
+
+	manager := txmanager.NewPGXTXManager(pgxPool)
+	repo := pgx.NewPgxPvzOrderFacade(manager)
+
+	type args struct {
+		userID string
+		opts   []abstractions.GetOrdersOptFunc
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    func([]domain.PVZOrder) bool
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success",
+			args: args{
+				userID: "1",
+				opts:   make([]abstractions.GetOrdersOptFunc, 0),
+			},
+			want: func(orders []domain.PVZOrder) bool {
+				return assert.Len(t, orders, 3)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Success with PVZID",
+			args: args{
+				userID: "1",
+				opts: []abstractions.GetOrdersOptFunc{
+					abstractions.WithPVZID("2"),
+				},
+			},
+			want: func(orders []domain.PVZOrder) bool {
+				return assert.Len(t, orders, 1) && assert.Equal(t, "3", orders[0].OrderID)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Success with pagination",
+			args: args{
+				userID: "1",
+				opts: []abstractions.GetOrdersOptFunc{
+					abstractions.WithCursorID("3"),
+					abstractions.WithLimit(2),
+				},
+			},
+			want: func(orders []domain.PVZOrder) bool {
+				return assert.Len(t, orders, 2) && assert.Equal(t, "3", orders[0].OrderID) && assert.Equal(t, "2", orders[1].OrderID)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Success with statuses",
+			args: args{
+				userID: "2",
+				opts: []abstractions.GetOrdersOptFunc{
+					abstractions.WithStatuses(domain.OrderStatusReturnedByClient),
+				},
+			},
+			want: func(orders []domain.PVZOrder) bool {
+				return assert.Len(t, orders, 3)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Success empty",
+			args: args{
+				userID: "3",
+				opts:   make([]abstractions.GetOrdersOptFunc, 0),
+			},
+			want: func(orders []domain.PVZOrder) bool {
+				return assert.Len(t, orders, 0)
+			},
+			wantErr: assert.NoError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orders, err := repo.GetOrders(ctx, tt.args.userID, tt.args.opts...)
+			tt.want(orders)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestPGXRepository_GetOrder(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pgxPool, tearDown := setupSuite(ctx, t)
+	defer tearDown()
+
+	manager := txmanager.NewPGXTXManager(pgxPool)
+	repo := pgx.NewPgxPvzOrderFacade(manager)
+
+	type args struct {
+		orderID string
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		want    domain.PVZOrder
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success",
+			args: args{
+				orderID: "1",
+			},
+			want: domain.PVZOrder{
+				OrderID:        "1",
+				PVZID:          "1",
+				RecipientID:    "1",
+				ReceivedAt:     time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+				StorageTime:    24 * time.Hour,
+				IssuedAt:       time.Time{},
+				ReturnedAt:     time.Time{},
+				Weight:         1000,
+				Cost:           domain.RUB(1000),
+				AdditionalFilm: false,
+				Packaging:      domain.PackagingTypeBox,
+				Status:         domain.OrderStatusAccepted,
+				Version:        1,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "Order not found",
+			args: args{
+				orderID: "100",
+			},
+			want: domain.PVZOrder{},
+			wantErr: func(t assert.TestingT, err error, i ...interface{}) bool {
+				return assert.Error(t, err) && errors.Is(err, domain.ErrNotFound)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := repo.GetOrder(ctx, tt.args.orderID)
+			assert.Equal(t, tt.want, got)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+func TestPGXRepository_GetReturns(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pgxPool, tearDown := setupSuite(ctx, t)
+	defer tearDown()
+
+	manager := txmanager.NewPGXTXManager(pgxPool)
+	repo := pgx.NewPgxPvzOrderFacade(manager)
+
+	type args struct {
+		opts []abstractions.PagePaginationOptFunc
 	}
 
 	tests := []struct {
@@ -462,9 +937,640 @@ func TestPGXRepository_GetReturns(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			orders, err := repo.GetReturns(ctx, tt.args.opts...)
+			orders, err := repo.GetReturns(ctx, "1", tt.args.opts...)
 			tt.want(orders)
 			tt.wantErr(t, err)
 		})
 	}
 }
+
+func TestPGXRepository_GetOrderHistory(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pgxPool, tearDown := setupSuite(ctx, t)
+	defer tearDown()
+
+	manager := txmanager.NewPGXTXManager(pgxPool)
+	repo := pgx.NewPgxPvzOrderFacade(manager)
+
+	order := domain.NewPVZOrder(
+		"100",
+		"1",
+		"1",
+		domain.RUB(1000),
+		1000,
+		domain.Dimensions{},
+		24*time.Hour,
+		domain.PackagingTypeBox,
+		false,
+	)
+
+	assert.NoError(t, repo.CreateOrder(ctx, order, ""))
+	assert.NoError(t, repo.SetOrdersIssued(ctx, []domain.IssueDecision{domain.NewIssueDecision("100")}))
+
+	events, err := repo.GetOrderHistory(ctx, "100")
+	assert.NoError(t, err)
+	if assert.Len(t, events, 2) {
+		assert.Equal(t, domain.EventTypeOrderDeliveryAccepted, events[0].EventType)
+		assert.Equal(t, domain.EventTypeOrderIssued, events[1].EventType)
+		assert.Equal(t, "100", events[1].Payload["order_id"])
+	}
+
+	_, err = repo.GetOrderHistory(ctx, "unknown")
+	assert.True(t, errors.Is(err, domain.ErrNotFound))
+}
+
+func TestPGXRepository_ReturnShipment(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pgxPool, tearDown := setupSuite(ctx, t)
+	defer tearDown()
+
+	manager := txmanager.NewPGXTXManager(pgxPool)
+	repo := pgx.NewPgxPvzOrderFacade(manager)
+
+	shipment := domain.NewReturnShipment("1", []string{"6", "5"})
+	assert.NoError(t, repo.CreateReturnShipment(ctx, shipment))
+
+	// An order is included in one shipment at most, and only the returned orders are dispatched
+	assert.ErrorIs(t, repo.CreateReturnShipment(ctx, domain.NewReturnShipment("1", []string{"5"})), domain.ErrConflict)
+	assert.ErrorIs(t, repo.CreateReturnShipment(ctx, domain.NewReturnShipment("1", []string{"1"})), domain.ErrConflict)
+
+	got, err := repo.GetReturnShipment(ctx, shipment.ID)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, domain.ReturnShipmentStatusOpen, got.Status)
+	assert.Equal(t, []string{"6", "5"}, got.OrderIDs)
+	assert.Nil(t, got.Manifest)
+
+	dispatchedAt := time.Now().UTC().Truncate(time.Second)
+	manifest, err := repo.DispatchReturnShipment(ctx, shipment.ID, "courier", dispatchedAt)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "courier", manifest.CourierID)
+	if assert.Len(t, manifest.Items, 2) {
+		assert.Equal(t, "6", manifest.Items[0].OrderID)
+		assert.Equal(t, domain.OrderStatusReturnedByClient, manifest.Items[0].Status)
+	}
+
+	_, err = repo.DispatchReturnShipment(ctx, shipment.ID, "courier", dispatchedAt)
+	assert.ErrorIs(t, err, domain.ErrConflict)
+
+	got, err = repo.GetReturnShipment(ctx, shipment.ID)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, domain.ReturnShipmentStatusDispatched, got.Status)
+	assert.Equal(t, "courier", got.CourierID)
+	if assert.NotNil(t, got.Manifest) {
+		assert.Equal(t, []string{"6", "5"}, []string{got.Manifest.Items[0].OrderID, got.Manifest.Items[1].OrderID})
+	}
+
+	// The dispatched orders leave the returns of the PVZ
+	returns, err := repo.GetReturns(ctx, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"7"}, orderIDs(returns))
+
+	order, err := repo.GetOrder(ctx, "5")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.OrderStatusDispatchedToSeller, order.Status)
+
+	events, err := repo.GetOrderHistory(ctx, "5")
+	if assert.NoError(t, err) && assert.NotEmpty(t, events) {
+		last := events[len(events)-1]
+		assert.Equal(t, domain.EventTypeOrderDispatchedToSeller, last.EventType)
+		assert.Equal(t, shipment.ID, last.Payload["shipment_id"])
+
+		// The shipment lists the order among its order_ids
+		assert.True(t, slices.ContainsFunc(events, func(event domain.Event) bool {
+			return event.EventType == domain.EventTypeReturnShipmentCreated && event.Payload["shipment_id"] == shipment.ID
+		}))
+	}
+
+	// The order outside the shipment does not get its events
+	_, err = repo.GetOrderHistory(ctx, "7")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	_, err = repo.GetReturnShipment(ctx, "unknown")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func orderIDs(orders []domain.PVZOrder) []string {
+	ids := make([]string, 0, len(orders))
+	for _, order := range orders {
+		ids = append(ids, order.OrderID)
+	}
+	return ids
+}
+
+func TestPGXRepository_WeightDiscrepancy(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pgxPool, tearDown := setupSuite(ctx, t)
+	defer tearDown()
+
+	manager := txmanager.NewPGXTXManager(pgxPool)
+	repo := pgx.NewPgxPvzOrderFacade(manager)
+
+	newOrder := func(orderID string, measuredWeight int, overrideReason string) domain.PVZOrder {
+		order := domain.NewPVZOrder(orderID, "1", "1", domain.RUB(1000), 1000, domain.Dimensions{}, 24*time.Hour, domain.PackagingTypeBox, false)
+		assert.NoError(t, order.SetMeasuredWeight(measuredWeight, 10, overrideReason))
+		return order
+	}
+
+	flagged := newOrder("100", 1500, "scales checked twice")
+	assert.NoError(t, repo.CreateOrder(ctx, flagged, ""))
+	assert.NoError(t, repo.CreateOrder(ctx, newOrder("101", 1050, ""), ""))
+	_, err := repo.CreateOrders(ctx, []domain.PVZOrder{newOrder("102", 500, "torn packaging")}, nil)
+	assert.NoError(t, err)
+
+	actual, err := repo.GetOrder(ctx, "100")
+	assert.NoError(t, err)
+	assert.Equal(t, 1500, actual.MeasuredWeight)
+	assert.True(t, actual.WeightDiscrepancy)
+	assert.Equal(t, "scales checked twice", actual.WeightOverrideReason)
+
+	orders, err := repo.GetOrders(ctx, "1", abstractions.WithWeightDiscrepancy())
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"100", "102"}, orderIDs(orders))
+
+	from := time.Now().UTC().Truncate(24 * time.Hour)
+	orders, err = repo.GetWeightDiscrepancies(ctx, "1", from, from.Add(24*time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"100", "102"}, orderIDs(orders))
+
+	orders, err = repo.GetWeightDiscrepancies(ctx, "2", from, from.Add(24*time.Hour))
+	assert.NoError(t, err)
+	assert.Empty(t, orders)
+
+	events, err := repo.GetOrderHistory(ctx, "100")
+	assert.NoError(t, err)
+	if assert.Len(t, events, 2) {
+		assert.Equal(t, domain.EventTypeOrderWeightDiscrepancy, events[1].EventType)
+		assert.Equal(t, "scales checked twice", events[1].Payload["override_reason"])
+	}
+}
+
+func TestPGXRepository_ExpireOrders(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pgxPool, tearDown := setupSuite(ctx, t)
+	defer tearDown()
+
+	manager := txmanager.NewPGXTXManager(pgxPool)
+	repo := pgx.NewPgxPvzOrderFacade(manager)
+
+	newOrder := func(orderID, pvzID string, receivedAgo time.Duration) domain.PVZOrder {
+		order := domain.NewPVZOrder(orderID, pvzID, "1", domain.RUB(1000), 1000, domain.Dimensions{}, 24*time.Hour, domain.PackagingTypeBox, false)
+		order.ReceivedAt = time.Now().Add(-receivedAgo)
+		return order
+	}
+
+	assert.NoError(t, repo.CreateOrder(ctx, newOrder("100", "1", 48*time.Hour), ""))
+	assert.NoError(t, repo.CreateOrder(ctx, newOrder("101", "2", 25*time.Hour), ""))
+	assert.NoError(t, repo.CreateOrder(ctx, newOrder("102", "1", 12*time.Hour), ""))
+	assert.NoError(t, repo.CreateOrder(ctx, newOrder("103", "1", time.Hour), ""))
+
+	// The storage time counted in the business days of the PVZ is not over yet
+	businessDays := newOrder("104", "1", 48*time.Hour)
+	businessDays.Expiry = time.Now().Add(48 * time.Hour)
+	assert.NoError(t, repo.CreateOrder(ctx, businessDays, ""))
+
+	now := time.Now()
+
+	expired, lists, err := repo.ExpireOrders(ctx, now, 10, true)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"100", "101"}, orderIDs(expired))
+	if assert.Len(t, lists, 2) {
+		assert.Equal(t, "1", lists[0].PVZID)
+		assert.Equal(t, []string{"100"}, lists[0].OrderIDs)
+		assert.Equal(t, "2", lists[1].PVZID)
+	}
+
+	actual, err := repo.GetOrder(ctx, "100")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.OrderStatusExpired, actual.Status)
+
+	// The expired orders are not expired again
+	expired, _, err = repo.ExpireOrders(ctx, now, 10, true)
+	assert.NoError(t, err)
+	assert.Empty(t, expired)
+
+	reminded, err := repo.RemindExpiringOrders(ctx, now, now.Add(24*time.Hour), 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"102"}, orderIDs(reminded))
+
+	// The recipient is reminded once
+	reminded, err = repo.RemindExpiringOrders(ctx, now, now.Add(24*time.Hour), 10)
+	assert.NoError(t, err)
+	assert.Empty(t, reminded)
+
+	// The history of the expired order includes its courier return list
+	events, err := repo.GetOrderHistory(ctx, "100")
+	assert.NoError(t, err)
+	types := make([]domain.EventType, 0, len(events))
+	for _, event := range events {
+		types = append(types, event.EventType)
+	}
+	assert.ElementsMatch(t, []domain.EventType{
+		domain.EventTypeOrderDeliveryAccepted,
+		domain.EventTypeOrderExpired,
+		domain.EventTypeCourierReturnListCreated,
+	}, types)
+
+	events, err = repo.GetOrderHistory(ctx, "102")
+	assert.NoError(t, err)
+	if assert.Len(t, events, 2) {
+		assert.Equal(t, domain.EventTypeOrderExpiresSoon, events[1].EventType)
+	}
+}
+
+func TestPGXRepository_PaidStorage(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pgxPool, tearDown := setupSuite(ctx, t)
+	defer tearDown()
+
+	manager := txmanager.NewPGXTXManager(pgxPool)
+	repo := pgx.NewPgxPvzOrderFacade(manager)
+
+	paidStorage := domain.PaidStorage{Days: 3, DailyFee: domain.RUB(5000)}
+
+	newOrder := func(orderID string, receivedAgo time.Duration) domain.PVZOrder {
+		order := domain.NewPVZOrder(orderID, "1", "1", domain.RUB(1000), 1000, domain.Dimensions{}, 24*time.Hour, domain.PackagingTypeBox, false)
+		order.ReceivedAt = time.Now().Add(-receivedAgo)
+		order.PaidStorage = paidStorage
+		return order
+	}
+
+	assert.NoError(t, repo.CreateOrder(ctx, newOrder("200", 36*time.Hour), ""))
+	assert.NoError(t, repo.CreateOrder(ctx, newOrder("201", 5*24*time.Hour), ""))
+
+	actual, err := repo.GetOrder(ctx, "200")
+	assert.NoError(t, err)
+	assert.Equal(t, paidStorage, actual.PaidStorage)
+
+	// The order in the paid storage is not expired
+	expired, _, err := repo.ExpireOrders(ctx, time.Now(), 10, false)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"201"}, orderIDs(expired))
+
+	underpaid := domain.NewIssueDecision("200")
+	underpaid.StorageFeePaid = domain.RUB(1000)
+	assert.ErrorIs(t, repo.SetOrdersIssued(ctx, []domain.IssueDecision{underpaid}), domain.ErrInvalidArgument)
+
+	decision := domain.NewIssueDecision("200")
+	decision.StorageFeePaid = domain.RUB(5000)
+	assert.NoError(t, repo.SetOrdersIssued(ctx, []domain.IssueDecision{decision}))
+
+	actual, err = repo.GetOrder(ctx, "200")
+	assert.NoError(t, err)
+	assert.Equal(t, domain.OrderStatusIssued, actual.Status)
+	assert.Equal(t, domain.RUB(5000), actual.StorageFeePaid)
+
+	events, err := repo.GetOrderHistory(ctx, "200")
+	assert.NoError(t, err)
+	if assert.Len(t, events, 3) {
+		assert.Equal(t, domain.EventTypeOrderIssued, events[1].EventType)
+		assert.Equal(t, domain.EventTypeOrderStorageFeePaid, events[2].EventType)
+	}
+}
+
+func TestPGXRepository_Idempotency(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pgxPool, tearDown := setupSuite(ctx, t)
+	defer tearDown()
+
+	manager := txmanager.NewPGXTXManager(pgxPool)
+	repo := pgx.NewPgxPvzOrderFacade(manager)
+	store := idempotency.NewIdempotencyRepository(manager, idempotency.DefaultKeyTTL)
+
+	var calls atomic.Int32
+	createOrder := func(orderID string) func(ctx context.Context) ([]byte, error) {
+		return func(ctx context.Context) ([]byte, error) {
+			calls.Add(1)
+			order := domain.NewPVZOrder(orderID, "1", "1", domain.RUB(1000), 1000, domain.Dimensions{}, 24*time.Hour, domain.PackagingTypeBox, false)
+			if err := repo.CreateOrder(ctx, order, ""); err != nil {
+				return nil, err
+			}
+			return []byte(orderID), nil
+		}
+	}
+
+	response, err := store.Do(ctx, "key", "fingerprint", createOrder("100"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("100"), response)
+
+	// The retry gets the stored response without creating the order again
+	response, err = store.Do(ctx, "key", "fingerprint", createOrder("100"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("100"), response)
+	assert.Equal(t, int32(1), calls.Load())
+
+	_, err = store.Do(ctx, "key", "another fingerprint", createOrder("100"))
+	assert.ErrorIs(t, err, domain.ErrConflict)
+
+	// The failed request is rolled back along with the key, so it may be retried
+	_, err = store.Do(ctx, "failed", "fingerprint", createOrder("100"))
+	assert.Error(t, err)
+
+	response, err = store.Do(ctx, "failed", "fingerprint", createOrder("101"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("101"), response)
+
+	// The writes of the failed request are rolled back along with the reservation
+	_, err = store.Do(ctx, "partial", "fingerprint", func(ctx context.Context) ([]byte, error) {
+		if _, err := createOrder("102")(ctx); err != nil {
+			return nil, err
+		}
+		return nil, errors.New("connection lost")
+	})
+	assert.Error(t, err)
+
+	_, err = repo.GetOrder(ctx, "102")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	// The serializable transitions join the transaction of the key
+	details := domain.ReturnDetails{Reason: domain.ReturnReasonDefect, Inspection: domain.InspectionOutcomeDamaged}
+	assert.NoError(t, repo.SetOrdersIssued(ctx, []domain.IssueDecision{domain.NewIssueDecision("1")}))
+	_, err = store.Do(ctx, "return", "fingerprint", func(ctx context.Context) ([]byte, error) {
+		return []byte("1"), repo.SetOrderReturned(ctx, "1", details, 0)
+	})
+	assert.NoError(t, err)
+}
+
+func TestPGXTXManager_NestedIsolation(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pgxPool, tearDown := setupSuite(ctx, t)
+	defer tearDown()
+
+	manager := txmanager.NewPGXTXManager(pgxPool)
+
+	isolation := func(ctx context.Context) string {
+		var level string
+		err := manager.GetQueryEngine(ctx).QueryRow(ctx, "SHOW transaction_isolation").Scan(&level)
+		assert.NoError(t, err)
+		return level
+	}
+
+	// The nested transaction runs at the level of the outer one if it is strong enough
+	err := manager.RunSerializableTransaction(ctx, func(ctx context.Context) error {
+		return manager.RunReadCommittedTransaction(ctx, func(ctx context.Context) error {
+			assert.Equal(t, "serializable", isolation(ctx))
+			return nil
+		})
+	})
+	assert.NoError(t, err)
+
+	err = manager.RunSerializableTransaction(ctx, func(ctx context.Context) error {
+		return manager.RunSerializableTransaction(ctx, func(ctx context.Context) error {
+			assert.Equal(t, "serializable", isolation(ctx))
+			return nil
+		})
+	})
+	assert.NoError(t, err)
+
+	// and fails instead of silently running at a weaker level
+	err = manager.RunReadCommittedTransaction(ctx, func(ctx context.Context) error {
+		return manager.RunSerializableTransaction(ctx, func(ctx context.Context) error {
+			t.Error("nested serializable transaction must not run inside a read committed one")
+			return nil
+		})
+	})
+	assert.ErrorIs(t, err, txmanager.ErrIsolationLevel)
+
+	err = manager.RunRepeatableReadTransaction(ctx, func(ctx context.Context) error {
+		return manager.RunSerializableTransaction(ctx, func(ctx context.Context) error {
+			return nil
+		})
+	})
+	assert.ErrorIs(t, err, txmanager.ErrIsolationLevel)
+}
+
+func TestPGXRepository_Handover(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pgxPool, tearDown := setupSuite(ctx, t)
+	defer tearDown()
+
+	manager := txmanager.NewPGXTXManager(pgxPool)
+	repo := handover.NewHandoverRepository(manager)
+
+	session := domain.NewHandoverSession("1", "courier", []domain.DeliveryItem{{
+		OrderID:     "200",
+		RecipientID: "1",
+		StorageTime: 24 * time.Hour,
+		Cost:        domain.RUB(1000),
+		Weight:      1000,
+		Dimensions:  domain.Dimensions{Length: 10, Width: 20, Height: 30},
+		Packaging:   domain.PackagingTypeBox,
+	}})
+	assert.NoError(t, repo.CreateSession(ctx, session))
+
+	scan := session.NewScan("200", 1000, 10)
+	assert.NoError(t, repo.AddScan(ctx, scan))
+
+	got, err := repo.GetSession(ctx, session.ID)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, session.Expected, got.Expected)
+	assert.Equal(t, domain.HandoverSessionStatusOpen, got.Status)
+	if assert.Len(t, got.Scans, 1) {
+		assert.Equal(t, domain.HandoverScanResultMatched, got.Scans[0].Result)
+	}
+	assert.Nil(t, got.Report)
+
+	// The locked session makes the other transactions wait to lock it
+	err = manager.RunReadCommittedTransaction(ctx, func(txCtx context.Context) error {
+		locked, err := repo.LockSession(txCtx, session.ID)
+		if err != nil {
+			return err
+		}
+		assert.Len(t, locked.Scans, 1)
+
+		waitCtx, cancel := context.WithTimeout(ctx, 200*time.Millisecond)
+		defer cancel()
+
+		_, err = repo.LockSession(waitCtx, session.ID)
+		assert.Error(t, err)
+		return nil
+	})
+	assert.NoError(t, err)
+
+	got.Scans = append(got.Scans, scan)
+	report := got.NewReport()
+	assert.NoError(t, repo.CloseSession(ctx, report))
+
+	// The closed session takes no more scans and can not be closed again
+	assert.ErrorIs(t, repo.AddScan(ctx, scan), domain.ErrConflict)
+	assert.ErrorIs(t, repo.CloseSession(ctx, report), domain.ErrConflict)
+
+	got, err = repo.GetSession(ctx, session.ID)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, domain.HandoverSessionStatusClosed, got.Status)
+	assert.False(t, got.ClosedAt.IsZero())
+	if assert.NotNil(t, got.Report) {
+		assert.Equal(t, []string{"200"}, got.Report.Accepted)
+	}
+
+	_, err = repo.GetSession(ctx, "unknown")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestPGXRepository_PVZPolicy(t *testing.T) {
+	t.Parallel()
+
+	if testing.Short() {
+		t.Skip("skipping integration test")
+	}
+
+	ctx := context.Background()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pgxPool, tearDown := setupSuite(ctx, t)
+	defer tearDown()
+
+	manager := txmanager.NewPGXTXManager(pgxPool)
+	repo := pvzpolicy.NewPVZPolicyRepository(manager)
+
+	_, err := repo.GetPolicy(ctx, "1")
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	policy := domain.PVZPolicy{
+		PVZID:            "1",
+		MaxStorageTime:   7 * 24 * time.Hour,
+		MaxOrderWeight:   20000,
+		AllowedPackaging: []domain.PackagingType{domain.PackagingTypeBox, "large_box"},
+		SetRules: []domain.PVZPolicyRule{
+			domain.PVZPolicyRuleMaxStorageTime,
+			domain.PVZPolicyRuleMaxOrderWeight,
+			domain.PVZPolicyRuleAllowedPackaging,
+		},
+		UpdatedBy: "admin",
+		UpdatedAt:        time.Now().UTC().Truncate(time.Microsecond),
+	}
+
+	// The version of the missing policy can not be expected
+	_, err = repo.SavePolicy(ctx, policy, 1)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+
+	saved, err := repo.SavePolicy(ctx, policy, 0)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, int64(1), saved.Version)
+
+	got, err := repo.GetPolicy(ctx, "1")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, policy.MaxStorageTime, got.MaxStorageTime)
+	assert.Zero(t, got.ReturnWindow)
+	assert.Equal(t, policy.MaxOrderWeight, got.MaxOrderWeight)
+	assert.Equal(t, policy.AllowedPackaging, got.AllowedPackaging)
+	assert.ElementsMatch(t, policy.SetRules, got.SetRules)
+	assert.True(t, policy.UpdatedAt.Equal(got.UpdatedAt))
+
+	// The limits removed by the administrator are kept apart from the rules which are not set
+	policy.ReturnWindow = 3 * 24 * time.Hour
+	policy.MaxStorageTime = 0
+	policy.MaxOrderWeight = 0
+	policy.AllowedPackaging = nil
+	policy.SetRules = []domain.PVZPolicyRule{
+		domain.PVZPolicyRuleReturnWindow,
+		domain.PVZPolicyRuleMaxStorageTime,
+		domain.PVZPolicyRuleAllowedPackaging,
+	}
+	saved, err = repo.SavePolicy(ctx, policy, 1)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, int64(2), saved.Version)
+	assert.Equal(t, 3*24*time.Hour, saved.ReturnWindow)
+	assert.Zero(t, saved.MaxStorageTime)
+	assert.Empty(t, saved.AllowedPackaging)
+	assert.ElementsMatch(t, policy.SetRules, saved.SetRules)
+	assert.False(t, saved.IsSet(domain.PVZPolicyRuleMaxOrderWeight))
+
+	_, err = repo.SavePolicy(ctx, policy, 1)
+	assert.ErrorIs(t, err, domain.ErrVersionMismatch)
+
+	// The update without the expected version overwrites any version
+	saved, err = repo.SavePolicy(ctx, policy, 0)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, int64(3), saved.Version)
+}